@@ -83,6 +83,10 @@ func (app *App) spinUpBillingEngine() {
 			SnowflakeGen: app.snowflakeGen,
 			HttpRouter:   app.router,
 			Validator:    app.validator,
+			Notification: app.notificationConfig(),
+			Payout:       app.payoutConfig(),
+			Customer:     app.customerConfig(),
+			Loan:         app.loanConfig(),
 		},
 	)
 }
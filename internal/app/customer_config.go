@@ -0,0 +1,9 @@
+package app
+
+import billingengine "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine"
+
+func (app *App) customerConfig() billingengine.CustomerConfig {
+	return billingengine.CustomerConfig{
+		DuplicatePolicy: app.config.GetString("customer.duplicate_policy"),
+	}
+}
@@ -0,0 +1,22 @@
+package app
+
+import (
+	"strings"
+
+	billingengine "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine"
+)
+
+// loanConfig reads the products requiring a guarantor as a comma separated
+// list of product codes.
+func (app *App) loanConfig() billingengine.LoanConfig {
+	var guarantorProducts []string
+	for _, product := range strings.Split(app.config.GetString("loan.guarantor_products"), ",") {
+		if product = strings.ToUpper(strings.TrimSpace(product)); product != "" {
+			guarantorProducts = append(guarantorProducts, product)
+		}
+	}
+
+	return billingengine.LoanConfig{
+		GuarantorProducts: guarantorProducts,
+	}
+}
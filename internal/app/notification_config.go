@@ -0,0 +1,21 @@
+package app
+
+import billingengine "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine"
+
+func (app *App) notificationConfig() billingengine.NotificationConfig {
+	return billingengine.NotificationConfig{
+		SMTPHost:     app.config.GetString("notification.smtp.host"),
+		SMTPPort:     app.config.GetString("notification.smtp.port"),
+		SMTPUsername: app.config.GetString("notification.smtp.username"),
+		SMTPPassword: app.config.GetString("notification.smtp.password"),
+		SMTPFrom:     app.config.GetString("notification.smtp.from"),
+
+		SMSEndpoint: app.config.GetString("notification.sms.endpoint"),
+		SMSAPIKey:   app.config.GetString("notification.sms.api_key"),
+		SMSSender:   app.config.GetString("notification.sms.sender"),
+
+		WhatsAppEndpoint: app.config.GetString("notification.whatsapp.endpoint"),
+		WhatsAppAPIKey:   app.config.GetString("notification.whatsapp.api_key"),
+		WhatsAppSender:   app.config.GetString("notification.whatsapp.sender"),
+	}
+}
@@ -0,0 +1,10 @@
+package app
+
+import billingengine "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine"
+
+func (app *App) payoutConfig() billingengine.PayoutConfig {
+	return billingengine.PayoutConfig{
+		Endpoint: app.config.GetString("payout.endpoint"),
+		APIKey:   app.config.GetString("payout.api_key"),
+	}
+}
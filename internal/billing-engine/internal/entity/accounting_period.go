@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+)
+
+type PeriodStatus string
+
+const (
+	PERIOD_OPEN   PeriodStatus = "OPEN"
+	PERIOD_CLOSED PeriodStatus = "CLOSED"
+)
+
+// AccountingPeriod is a calendar month of the books. Months that were never
+// closed are open.
+type AccountingPeriod struct {
+	Period   string       `json:"period"` // format YYYY-MM
+	Status   PeriodStatus `json:"status"`
+	ClosedBy string       `json:"closed_by"`
+	ClosedAt time.Time    `json:"closed_at"`
+}
+
+// PeriodOf returns the YYYY-MM period of t.
+func PeriodOf(t time.Time) string {
+	return t.Format("2006-01")
+}
+
+// Locks reports whether the closed period locks date. Closing a month locks
+// it together with every month before it, as months are closed in order.
+func (p AccountingPeriod) Locks(date time.Time) bool {
+	if p.Status != PERIOD_CLOSED {
+		return false
+	}
+
+	return PeriodOf(date) <= p.Period
+}
+
+type PeriodCheckName string
+
+const (
+	PERIOD_CHECK_MONTH_ENDED            PeriodCheckName = "MONTH_ENDED"            // the month is over
+	PERIOD_CHECK_PREVIOUS_PERIOD_CLOSED PeriodCheckName = "PREVIOUS_PERIOD_CLOSED" // months are closed in order
+	PERIOD_CHECK_TRIAL_BALANCE          PeriodCheckName = "TRIAL_BALANCE"          // the ledger balances at month end
+	PERIOD_CHECK_INTEREST_ACCRUED       PeriodCheckName = "INTEREST_ACCRUED"       // every loan accrued through month end
+	PERIOD_CHECK_PROVISIONING_RUN       PeriodCheckName = "PROVISIONING_RUN"       // the month's provisions were run
+)
+
+// PeriodCheck is the result of one consistency check run before a month is
+// closed.
+type PeriodCheck struct {
+	Name   PeriodCheckName `json:"name"`
+	Passed bool            `json:"passed"`
+	Detail string          `json:"detail"`
+}
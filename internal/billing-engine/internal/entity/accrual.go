@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type AccrualType string
+
+const (
+	ACCRUAL_DAILY    AccrualType = "ACCRUAL"
+	ACCRUAL_REVERSAL AccrualType = "REVERSAL" // accrued interest reversed on write-off
+)
+
+// accrualDays is the number of days each weekly installment's interest is
+// earned over, ending on its due date.
+const accrualDays = 7
+
+// InterestAccrual is the interest of a loan recognised on one day.
+type InterestAccrual struct {
+	ID             uint64          `json:"id"`
+	LoanID         uint64          `json:"loan_id"`
+	AccrualDate    time.Time       `json:"accrual_date"`
+	Type           AccrualType     `json:"type"`
+	Amount         decimal.Decimal `json:"amount"`
+	JournalEntryID uint64          `json:"journal_entry_id,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// LoanAccrualState is a loan that accrues interest together with the last
+// day accrued so far, zero when it never accrued.
+type LoanAccrualState struct {
+	Loan            Loan
+	LastAccrualDate time.Time
+}
+
+// DailyInterest returns the interest earned on day from a flat-rate weekly
+// schedule. The interest of every installment is earned evenly over the
+// seven days ending on its due date; the last day absorbs the rounding.
+// Installments that were closed by a restructure never accrue.
+func DailyInterest(installments []Installment, annualRate decimal.Decimal, day time.Time) (decimal.Decimal, error) {
+	total := decimal.Zero
+
+	for _, installment := range installments {
+		if installment.Status == INSTALLMENT_CLOSED {
+			continue
+		}
+
+		dueOn, err := installment.DueOn()
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		firstDay := dueOn.AddDate(0, 0, 1-accrualDays)
+		if day.Before(firstDay) || day.After(dueOn) {
+			continue
+		}
+
+		// Holiday interest was recognised when its moratorium was declared
+		amount, err := installment.ScheduledAmount()
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		_, interest := SplitPrincipalInterest(amount, annualRate)
+		daily := interest.Div(decimal.NewFromInt(accrualDays)).RoundDown(2)
+		if sameDay(day, dueOn) {
+			daily = interest.Sub(daily.Mul(decimal.NewFromInt(accrualDays - 1)))
+		}
+
+		total = total.Add(daily)
+	}
+
+	return total, nil
+}
+
+// LastDueDate returns the latest due date of the schedule.
+func LastDueDate(installments []Installment) (time.Time, error) {
+	var last time.Time
+
+	for _, installment := range installments {
+		dueOn, err := installment.DueOn()
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if dueOn.After(last) {
+			last = dueOn
+		}
+	}
+
+	return last, nil
+}
+
+func sameDay(a time.Time, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type CollateralType string
+
+const (
+	COLLATERAL_VEHICLE_BPKB CollateralType = "VEHICLE_BPKB" // vehicle ownership book
+	COLLATERAL_GOLD         CollateralType = "GOLD"
+	COLLATERAL_DEPOSIT      CollateralType = "DEPOSIT" // time deposit held by the lender
+)
+
+type LienStatus string
+
+const (
+	LIEN_PENDING    LienStatus = "PENDING" // recorded, the lien is not registered yet
+	LIEN_REGISTERED LienStatus = "REGISTERED"
+	LIEN_RELEASED   LienStatus = "RELEASED"
+)
+
+// lienTransitions lists the statuses each lien status can move to. A released
+// lien is registered again when its loan is reopened by a payment reversal.
+var lienTransitions = map[LienStatus][]LienStatus{
+	LIEN_PENDING:    {LIEN_REGISTERED, LIEN_RELEASED},
+	LIEN_REGISTERED: {LIEN_RELEASED},
+	LIEN_RELEASED:   {LIEN_REGISTERED},
+}
+
+// CanTransitionTo reports whether a lien in status s can move to status to.
+func (s LienStatus) CanTransitionTo(to LienStatus) bool {
+	for _, next := range lienTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Collateral is an asset pledged against a loan.
+type Collateral struct {
+	ID            uint64          `json:"id"`
+	LoanID        uint64          `json:"loan_id"`
+	Type          CollateralType  `json:"type"`
+	Description   string          `json:"description"`
+	Valuation     decimal.Decimal `json:"valuation"`
+	ValuationDate time.Time       `json:"valuation_date"`
+	LienStatus    LienStatus      `json:"lien_status"`
+	RegisteredBy  string          `json:"registered_by"`
+	RegisteredAt  time.Time       `json:"registered_at"`
+	LienUpdatedBy string          `json:"lien_updated_by"` // empty until the lien status changes
+	LienUpdatedAt time.Time       `json:"lien_updated_at"`
+}
+
+// IsHeld tells whether the collateral still secures its loan.
+func (c Collateral) IsHeld() bool {
+	return c.LienStatus != LIEN_RELEASED
+}
+
+// HeldValuation sums the valuation of the collaterals still held.
+func HeldValuation(collaterals []Collateral) decimal.Decimal {
+	total := decimal.Zero
+	for _, collateral := range collaterals {
+		if collateral.IsHeld() {
+			total = total.Add(collateral.Valuation)
+		}
+	}
+
+	return total
+}
+
+// Exposure is what is at risk on the loan: its principal until it is paid
+// out, then its outstanding, nothing once it is closed.
+func (l Loan) Exposure(outstanding decimal.Decimal) decimal.Decimal {
+	switch l.Status {
+	case LOAN_APPLIED, LOAN_APPROVED:
+		return l.PrincipalAmount
+	case LOAN_DISBURSED:
+		return outstanding
+	default:
+		return decimal.Zero
+	}
+}
+
+// LoanToValue is the exposure over the valuation of the collateral held, e.g.
+// 0.8 for 80%, and zero without collateral.
+func LoanToValue(exposure decimal.Decimal, valuation decimal.Decimal) decimal.Decimal {
+	if !valuation.IsPositive() {
+		return decimal.Zero
+	}
+
+	return exposure.Div(valuation).Round(4)
+}
+
+// CollateralisedLoan is an active loan with the valuation of the collateral
+// held against it.
+type CollateralisedLoan struct {
+	LoanID     uint64          `json:"loan_id"`
+	CustomerID uint64          `json:"customer_id"`
+	LoanStatus LoanStatus      `json:"loan_status"`
+	Exposure   decimal.Decimal `json:"exposure"`
+	Valuation  decimal.Decimal `json:"valuation"`
+}
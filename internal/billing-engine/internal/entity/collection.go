@@ -0,0 +1,157 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type CollectionCaseStatus string
+
+const (
+	COLLECTION_CASE_OPEN   CollectionCaseStatus = "OPEN"
+	COLLECTION_CASE_CLOSED CollectionCaseStatus = "CLOSED"
+)
+
+type CollectionBucket string
+
+const (
+	COLLECTION_BUCKET_1_30    CollectionBucket = "DPD_1_30"
+	COLLECTION_BUCKET_31_60   CollectionBucket = "DPD_31_60"
+	COLLECTION_BUCKET_61_90   CollectionBucket = "DPD_61_90"
+	COLLECTION_BUCKET_90_UP   CollectionBucket = "DPD_90_UP"
+	COLLECTION_BUCKET_ANY     CollectionBucket = "ANY"
+	UNKNOWN_COLLECTION_BUCKET CollectionBucket = "UNKNOWN"
+)
+
+type CollectionAssignmentStrategy string
+
+const (
+	COLLECTION_ASSIGN_ROUND_ROBIN CollectionAssignmentStrategy = "ROUND_ROBIN"
+	COLLECTION_ASSIGN_BY_BUCKET   CollectionAssignmentStrategy = "BY_BUCKET"
+)
+
+type ContactChannel string
+
+const (
+	CONTACT_CHANNEL_CALL     ContactChannel = "CALL"
+	CONTACT_CHANNEL_SMS      ContactChannel = "SMS"
+	CONTACT_CHANNEL_WHATSAPP ContactChannel = "WHATSAPP"
+	CONTACT_CHANNEL_EMAIL    ContactChannel = "EMAIL"
+	CONTACT_CHANNEL_VISIT    ContactChannel = "VISIT"
+)
+
+type ContactOutcome string
+
+const (
+	CONTACT_OUTCOME_NO_ANSWER      ContactOutcome = "NO_ANSWER"
+	CONTACT_OUTCOME_REACHED        ContactOutcome = "REACHED"
+	CONTACT_OUTCOME_PROMISE_TO_PAY ContactOutcome = "PROMISE_TO_PAY"
+	CONTACT_OUTCOME_REFUSED        ContactOutcome = "REFUSED"
+	CONTACT_OUTCOME_WRONG_NUMBER   ContactOutcome = "WRONG_NUMBER"
+)
+
+type PromiseToPayStatus string
+
+const (
+	PROMISE_TO_PAY_PENDING PromiseToPayStatus = "PENDING"
+	PROMISE_TO_PAY_KEPT    PromiseToPayStatus = "KEPT"
+	PROMISE_TO_PAY_BROKEN  PromiseToPayStatus = "BROKEN"
+)
+
+type CollectionAgent struct {
+	ID     uint64           `json:"id"`
+	Name   string           `json:"name"`
+	Bucket CollectionBucket `json:"bucket"`
+	Active bool             `json:"active"`
+}
+
+type CollectionCase struct {
+	ID                 uint64               `json:"id"`
+	LoanID             uint64               `json:"loan_id"`
+	CustomerID         uint64               `json:"customer_id"`
+	AgentID            uint64               `json:"agent_id"`
+	Bucket             CollectionBucket     `json:"bucket"`
+	DaysPastDue        int64                `json:"days_past_due"`
+	MissedInstallments int64                `json:"missed_installments"`
+	Status             CollectionCaseStatus `json:"status"`
+	OpenedAt           time.Time            `json:"opened_at"`
+}
+
+type ContactAttempt struct {
+	ID          uint64         `json:"id"`
+	CaseID      uint64         `json:"case_id"`
+	AgentID     uint64         `json:"agent_id"`
+	Channel     ContactChannel `json:"channel"`
+	Outcome     ContactOutcome `json:"outcome"`
+	Notes       string         `json:"notes"`
+	AttemptedAt time.Time      `json:"attempted_at"`
+}
+
+type PromiseToPay struct {
+	ID             uint64             `json:"id"`
+	CaseID         uint64             `json:"case_id"`
+	LoanID         uint64             `json:"loan_id"`
+	PromisedDate   time.Time          `json:"promised_date"`
+	PromisedAmount decimal.Decimal    `json:"promised_amount"`
+	Status         PromiseToPayStatus `json:"status"`
+	CreatedAt      time.Time          `json:"created_at"`
+}
+
+// DelinquentLoan is a DISBURSED loan that has at least two consecutive
+// missed installments, summarised for collection case generation.
+type DelinquentLoan struct {
+	LoanID             uint64
+	CustomerID         uint64
+	OldestMissedDue    time.Time
+	LatestMissedWeek   int64
+	MissedInstallments int64
+}
+
+// DaysPastDue counts the days between the oldest missed due date and asOf.
+func (d DelinquentLoan) DaysPastDue(asOf time.Time) int64 {
+	days := int64(asOf.Sub(d.OldestMissedDue).Hours() / 24)
+	if days < 0 {
+		return 0
+	}
+
+	return days
+}
+
+// BucketFromDaysPastDue maps days past due into the collection bucket used
+// for agent assignment.
+func BucketFromDaysPastDue(dpd int64) CollectionBucket {
+	switch {
+	case dpd <= 0:
+		return UNKNOWN_COLLECTION_BUCKET
+	case dpd <= 30:
+		return COLLECTION_BUCKET_1_30
+	case dpd <= 60:
+		return COLLECTION_BUCKET_31_60
+	case dpd <= 90:
+		return COLLECTION_BUCKET_61_90
+	default:
+		return COLLECTION_BUCKET_90_UP
+	}
+}
+
+// HasConsecutiveMissedWeeks reports whether the two most recent missed weeks
+// are consecutive, mirroring the delinquency rule of the billing engine.
+func HasConsecutiveMissedWeeks(missedWeeks []int64) bool {
+	if len(missedWeeks) < 2 {
+		return false
+	}
+
+	latest, previous := int64(0), int64(0)
+	for _, week := range missedWeeks {
+		switch {
+		case week > latest:
+			previous = latest
+			latest = week
+		case week > previous && week != latest:
+			previous = week
+		}
+	}
+
+	return latest == previous+1
+}
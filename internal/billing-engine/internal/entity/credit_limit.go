@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// The credit limit of a customer without one of their own.
+const (
+	DEFAULT_CREDIT_LIMIT     = 10000000
+	DEFAULT_MAX_ACTIVE_LOANS = 2
+)
+
+// CreditLimit caps how much a customer may owe across their loans and how
+// many loans they may have open at once.
+type CreditLimit struct {
+	CustomerID     uint64          `json:"customer_id"`
+	Limit          decimal.Decimal `json:"limit"`
+	MaxActiveLoans int64           `json:"max_active_loans"`
+	UpdatedBy      string          `json:"updated_by"`
+	UpdatedAt      time.Time       `json:"updated_at"` // zero for the default limit
+}
+
+func DefaultCreditLimit(customerID uint64) CreditLimit {
+	return CreditLimit{
+		CustomerID:     customerID,
+		Limit:          decimal.NewFromInt(DEFAULT_CREDIT_LIMIT),
+		MaxActiveLoans: DEFAULT_MAX_ACTIVE_LOANS,
+	}
+}
+
+// CustomerExposure is what a customer owes, or is about to owe, across their
+// active loans. Applications and approved loans count with their principal
+// as they are not paid out yet. The obligations on the active loans of other
+// customers they co-borrow or guarantee count in full as well.
+type CustomerExposure struct {
+	CustomerID  uint64          `json:"customer_id"`
+	ActiveLoans int64           `json:"active_loans"` // applied, approved and disbursed
+	Outstanding decimal.Decimal `json:"outstanding"`  // unpaid installments and fees of disbursed loans
+	Committed   decimal.Decimal `json:"committed"`    // principal of applications and approved loans
+	CoBorrowed  decimal.Decimal `json:"co_borrowed"`  // obligations as a co-borrower
+	Guaranteed  decimal.Decimal `json:"guaranteed"`   // obligations as a guarantor
+}
+
+// Total is the exposure counted against the credit limit.
+func (e CustomerExposure) Total() decimal.Decimal {
+	return e.Outstanding.Add(e.Committed).Add(e.CoBorrowed).Add(e.Guaranteed)
+}
+
+// Available is what is left of the limit, never below zero.
+func (l CreditLimit) Available(exposure CustomerExposure) decimal.Decimal {
+	available := l.Limit.Sub(exposure.Total())
+	if available.IsNegative() {
+		return decimal.Zero
+	}
+
+	return available
+}
+
+// Utilisation is the share of the limit used, which exceeds 1 when the limit
+// was lowered below the exposure.
+func (l CreditLimit) Utilisation(exposure CustomerExposure) decimal.Decimal {
+	if !l.Limit.IsPositive() {
+		return decimal.Zero
+	}
+
+	return exposure.Total().Div(l.Limit).Round(4)
+}
+
+// Allows tells whether a new loan of principal fits within the limit.
+func (l CreditLimit) Allows(exposure CustomerExposure, principal decimal.Decimal) bool {
+	return exposure.Total().Add(principal).LessThanOrEqual(l.Limit)
+}
@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DEFAULT_CREDIT_LINE_PRODUCT is the product of the drawdowns of a credit
+// line opened without one.
+const DEFAULT_CREDIT_LINE_PRODUCT = "PAYLATER"
+
+// CreditLine is a revolving limit a customer draws down on. Every drawdown is
+// a loan of its own with its own schedule, and what is repaid on them can be
+// drawn again.
+type CreditLine struct {
+	ID          uint64          `json:"id"`
+	CustomerID  uint64          `json:"customer_id"`
+	Limit       decimal.Decimal `json:"limit"`
+	ProductCode string          `json:"product_code"`
+	OpenedBy    string          `json:"opened_by"`
+	OpenedAt    time.Time       `json:"opened_at"`
+}
+
+// Available is what is left of the limit once used, the exposure of the
+// active drawdowns, is taken off, never below zero.
+func (l CreditLine) Available(used decimal.Decimal) decimal.Decimal {
+	available := l.Limit.Sub(used)
+	if available.IsNegative() {
+		return decimal.Zero
+	}
+
+	return available
+}
+
+// Allows tells whether a drawdown of amount fits within the limit.
+func (l CreditLine) Allows(used decimal.Decimal, amount decimal.Decimal) bool {
+	return used.Add(amount).LessThanOrEqual(l.Limit)
+}
+
+type CreditLineBillStatus string
+
+const (
+	CREDIT_LINE_BILL_OPEN    CreditLineBillStatus = "OPEN"
+	CREDIT_LINE_BILL_OVERDUE CreditLineBillStatus = "OVERDUE" // unpaid past its due date, its installments MISSED
+	CREDIT_LINE_BILL_PAID    CreditLineBillStatus = "PAID"
+)
+
+// CreditLineBill is the consolidated bill of a credit line for a monthly
+// cycle. It aggregates the unpaid installments of every drawdown due by the
+// end of the cycle that no earlier bill carried, and is due at the end of the
+// cycle.
+type CreditLineBill struct {
+	ID           uint64               `json:"id"`
+	CreditLineID uint64               `json:"credit_line_id"`
+	Cycle        string               `json:"cycle"` // YYYY-MM
+	DueDate      time.Time            `json:"due_date"`
+	Amount       decimal.Decimal      `json:"amount"`
+	Status       CreditLineBillStatus `json:"status"`
+	CreatedAt    time.Time            `json:"created_at"`
+	PaidAt       time.Time            `json:"paid_at"` // zero until PAID
+	Items        []CreditLineBillItem `json:"items"`
+}
+
+// CreditLineBillItem is an installment of a drawdown carried by a bill.
+type CreditLineBillItem struct {
+	ID            uint64            `json:"id"`
+	BillID        uint64            `json:"bill_id"`
+	InstallmentID uint64            `json:"installment_id"`
+	LoanID        uint64            `json:"loan_id"`
+	WeekNumber    int64             `json:"week_number"`
+	Amount        decimal.Decimal   `json:"amount"` // amount due and fees when billed
+	Status        InstallmentStatus `json:"status"` // current status of the installment
+}
+
+// IsUnpaid tells whether the installment of the item is still to be paid.
+func (i CreditLineBillItem) IsUnpaid() bool {
+	return i.Status == INSTALLMENT_PENDING || i.Status == INSTALLMENT_MISSED
+}
+
+// NewCreditLineBill bills the installments for a cycle, due on dueDate. The
+// items carry no ID yet.
+func NewCreditLineBill(id uint64, creditLineID uint64, cycle string, dueDate time.Time, installments []Installment, createdAt time.Time) (CreditLineBill, error) {
+	bill := CreditLineBill{
+		ID:           id,
+		CreditLineID: creditLineID,
+		Cycle:        cycle,
+		DueDate:      dueDate,
+		Amount:       decimal.Zero,
+		Status:       CREDIT_LINE_BILL_OPEN,
+		CreatedAt:    createdAt,
+	}
+
+	for _, installment := range installments {
+		amount, err := installment.TotalDue()
+		if err != nil {
+			return CreditLineBill{}, err
+		}
+
+		bill.Amount = bill.Amount.Add(amount)
+		bill.Items = append(bill.Items, CreditLineBillItem{
+			BillID:        id,
+			InstallmentID: installment.ID,
+			LoanID:        installment.LoanID,
+			WeekNumber:    installment.WeekNumber,
+			Amount:        amount,
+			Status:        installment.Status,
+		})
+	}
+
+	return bill, nil
+}
+
+// Outstanding sums the items of the bill still to be paid.
+func (b CreditLineBill) Outstanding() decimal.Decimal {
+	outstanding := decimal.Zero
+	for _, item := range b.Items {
+		if item.IsUnpaid() {
+			outstanding = outstanding.Add(item.Amount)
+		}
+	}
+
+	return outstanding
+}
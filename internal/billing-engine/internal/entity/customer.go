@@ -1,7 +1,101 @@
 package entity
 
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type KYCStatus string
+
+const (
+	KYC_UNVERIFIED KYCStatus = "UNVERIFIED" // no identity documents checked yet
+	KYC_PENDING    KYCStatus = "PENDING"    // documents submitted, awaiting review
+	KYC_VERIFIED   KYCStatus = "VERIFIED"
+	KYC_REJECTED   KYCStatus = "REJECTED"
+)
+
 type Customer struct {
-	ID    uint64 `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID          uint64    `json:"id"`
+	Name        string    `json:"name"`
+	Email       string    `json:"email"`
+	Phone       string    `json:"phone"`
+	Language    Language  `json:"language"`
+	NIK         string    `json:"nik"`           // national identity number
+	DateOfBirth time.Time `json:"date_of_birth"` // zero when unknown
+	Address     string    `json:"address"`
+	KYCStatus   KYCStatus `json:"kyc_status"`
+	DeletedAt   time.Time `json:"deleted_at"` // zero unless soft deleted
+}
+
+// NIK_LENGTH is the number of digits of a national identity number.
+const NIK_LENGTH = 16
+
+// nikProvinceCodes are the first two digits of a NIK in use.
+var nikProvinceCodes = map[int]bool{
+	11: true, 12: true, 13: true, 14: true, 15: true, 16: true, 17: true, 18: true, 19: true,
+	21: true,
+	31: true, 32: true, 33: true, 34: true, 35: true, 36: true,
+	51: true, 52: true, 53: true,
+	61: true, 62: true, 63: true, 64: true, 65: true,
+	71: true, 72: true, 73: true, 74: true, 75: true, 76: true,
+	81: true, 82: true,
+	91: true, 92: true, 93: true, 94: true, 95: true, 96: true, 97: true,
+}
+
+// ValidateNIK checks nik is a NIK: a province, regency and district code, the
+// holder's birth date as DDMMYY with 40 added to the day for women, and a
+// serial number. A NIK carries no check digit, so the birth date it encodes
+// is what is verified, against dateOfBirth unless it is zero.
+func ValidateNIK(nik string, dateOfBirth time.Time) error {
+	if len(nik) != NIK_LENGTH {
+		return fmt.Errorf("nik must be %d digits", NIK_LENGTH)
+	}
+
+	for _, r := range nik {
+		if r < '0' || r > '9' {
+			return fmt.Errorf("nik must be %d digits", NIK_LENGTH)
+		}
+	}
+
+	digits := func(from, to int) int {
+		value, _ := strconv.Atoi(nik[from:to])
+		return value
+	}
+
+	if !nikProvinceCodes[digits(0, 2)] {
+		return fmt.Errorf("nik has an unknown province code %s", nik[0:2])
+	}
+
+	if digits(2, 4) == 0 || digits(4, 6) == 0 {
+		return fmt.Errorf("nik has an invalid regency or district code")
+	}
+
+	day, month, year := digits(6, 8), digits(8, 10), digits(10, 12)
+	if day > 40 {
+		day -= 40
+	}
+
+	// The century is not encoded, the 21st one gets the 29th of February
+	// right for both
+	birthDate := time.Date(2000+year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if day < 1 || birthDate.Day() != day || birthDate.Month() != time.Month(month) {
+		return fmt.Errorf("nik encodes an invalid birth date %s", nik[6:12])
+	}
+
+	if !dateOfBirth.IsZero() &&
+		(dateOfBirth.Day() != day || dateOfBirth.Month() != time.Month(month) || dateOfBirth.Year()%100 != year) {
+		return fmt.Errorf("nik birth date %s does not match the date of birth %s", nik[6:12], dateOfBirth.Format("2006-01-02"))
+	}
+
+	if digits(12, 16) == 0 {
+		return fmt.Errorf("nik has an invalid serial number")
+	}
+
+	return nil
+}
+
+// IsDeleted tells whether the customer was soft deleted.
+func (c Customer) IsDeleted() bool {
+	return !c.DeletedAt.IsZero()
 }
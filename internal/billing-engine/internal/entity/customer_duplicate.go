@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+type DuplicateMatch string
+
+const (
+	DUPLICATE_NIK                DuplicateMatch = "NIK"
+	DUPLICATE_PHONE              DuplicateMatch = "PHONE"
+	DUPLICATE_NAME_DATE_OF_BIRTH DuplicateMatch = "NAME_DATE_OF_BIRTH" // same normalised name and date of birth
+)
+
+type DuplicateStatus string
+
+const (
+	DUPLICATE_OPEN      DuplicateStatus = "OPEN" // awaiting review
+	DUPLICATE_MERGED    DuplicateStatus = "MERGED"
+	DUPLICATE_DISMISSED DuplicateStatus = "DISMISSED" // reviewed, not the same person
+)
+
+// DuplicatePolicy is what happens to a new customer matching an existing one.
+type DuplicatePolicy string
+
+const (
+	DUPLICATE_POLICY_BLOCK  DuplicatePolicy = "BLOCK"  // the customer is not created
+	DUPLICATE_POLICY_REVIEW DuplicatePolicy = "REVIEW" // the customer is created and flagged for review
+)
+
+// CustomerDuplicate flags a customer that matched an existing customer when
+// it was created, once per match.
+type CustomerDuplicate struct {
+	ID                uint64          `json:"id"`
+	CustomerID        uint64          `json:"customer_id"`
+	MatchedCustomerID uint64          `json:"matched_customer_id"`
+	Match             DuplicateMatch  `json:"match"`
+	Status            DuplicateStatus `json:"status"`
+	CreatedAt         time.Time       `json:"created_at"`
+	ResolvedBy        string          `json:"resolved_by"` // empty while OPEN
+	ResolvedAt        time.Time       `json:"resolved_at"`
+}
+
+// CustomerMerge is the audit record of the loans and history of a customer
+// moved onto the surviving customer.
+type CustomerMerge struct {
+	ID               uint64                `json:"id"`
+	SourceCustomerID uint64                `json:"source_customer_id"`
+	TargetCustomerID uint64                `json:"target_customer_id"`
+	Reason           string                `json:"reason"`
+	MergedBy         string                `json:"merged_by"`
+	MergedAt         time.Time             `json:"merged_at"`
+	Records          []CustomerMergeRecord `json:"records"`
+}
+
+// CustomerMergeRecord is a row a merge moved to the target customer.
+type CustomerMergeRecord struct {
+	ID        uint64 `json:"id"`
+	MergeID   uint64 `json:"merge_id"`
+	TableName string `json:"table_name"`
+	RecordID  uint64 `json:"record_id"`
+}
+
+// HasDuplicateKeys tells whether the customer has anything to be matched on.
+func (c Customer) HasDuplicateKeys() bool {
+	return c.NIK != "" || c.Phone != "" || !c.DateOfBirth.IsZero()
+}
+
+// NormalizeName folds a name for comparison: lower case, punctuation dropped
+// and whitespace collapsed, so "Siti  Aminah" and "siti aminah." match.
+func NormalizeName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(words, " ")
+}
+
+// MatchDuplicates lists what customer shares with each of the candidates: the
+// NIK, the phone, or the normalised name together with the date of birth. The
+// flags are OPEN and carry no ID yet.
+func MatchDuplicates(customer Customer, candidates []Customer) []CustomerDuplicate {
+	var duplicates []CustomerDuplicate
+	flag := func(candidate Customer, match DuplicateMatch) {
+		duplicates = append(duplicates, CustomerDuplicate{
+			CustomerID:        customer.ID,
+			MatchedCustomerID: candidate.ID,
+			Match:             match,
+			Status:            DUPLICATE_OPEN,
+		})
+	}
+
+	name := NormalizeName(customer.Name)
+	for _, candidate := range candidates {
+		if candidate.ID == customer.ID || candidate.IsDeleted() {
+			continue
+		}
+
+		if customer.NIK != "" && candidate.NIK == customer.NIK {
+			flag(candidate, DUPLICATE_NIK)
+		}
+
+		if customer.Phone != "" && candidate.Phone == customer.Phone {
+			flag(candidate, DUPLICATE_PHONE)
+		}
+
+		if !customer.DateOfBirth.IsZero() &&
+			candidate.DateOfBirth.Format("2006-01-02") == customer.DateOfBirth.Format("2006-01-02") &&
+			NormalizeName(candidate.Name) == name {
+			flag(candidate, DUPLICATE_NAME_DATE_OF_BIRTH)
+		}
+	}
+
+	return duplicates
+}
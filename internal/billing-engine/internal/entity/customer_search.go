@@ -0,0 +1,31 @@
+package entity
+
+type CustomerSort string
+
+const (
+	CUSTOMER_SORT_OLDEST    CustomerSort = "id" // snowflake IDs grow with the creation time
+	CUSTOMER_SORT_NEWEST    CustomerSort = "-id"
+	CUSTOMER_SORT_NAME      CustomerSort = "name"
+	CUSTOMER_SORT_NAME_DESC CustomerSort = "-name"
+)
+
+// CustomerSearch filters and pages the customers that were not deleted.
+type CustomerSearch struct {
+	NamePrefix    string    // case insensitive
+	Email         string    // case insensitive
+	KYCStatus     KYCStatus // any when empty
+	HasActiveLoan *bool     // any when nil, otherwise whether a loan is applied, approved or disbursed
+	Sort          CustomerSort
+	After         *Cursor // first page when nil
+	Limit         int64
+}
+
+// CursorOf returns the position of customer in the search order.
+func (s CustomerSearch) CursorOf(customer Customer) Cursor {
+	cursor := Cursor{Sort: string(s.Sort), ID: customer.ID}
+	if s.Sort == CUSTOMER_SORT_NAME || s.Sort == CUSTOMER_SORT_NAME_DESC {
+		cursor.Key = customer.Name
+	}
+
+	return cursor
+}
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type DisbursementStatus string
+
+const (
+	DISBURSEMENT_PENDING   DisbursementStatus = "PENDING"   // created, not handed to the provider yet
+	DISBURSEMENT_SENT      DisbursementStatus = "SENT"      // accepted by the provider, waiting for confirmation
+	DISBURSEMENT_FAILED    DisbursementStatus = "FAILED"    // refused or bounced, can be retried
+	DISBURSEMENT_COMPLETED DisbursementStatus = "COMPLETED" // the money reached the borrower
+)
+
+// disbursementTransitions lists the statuses each status can move to. A
+// provider may complete a payout right away, and a failed payout goes back
+// to PENDING when it is retried.
+var disbursementTransitions = map[DisbursementStatus][]DisbursementStatus{
+	DISBURSEMENT_PENDING: {DISBURSEMENT_SENT, DISBURSEMENT_FAILED, DISBURSEMENT_COMPLETED},
+	DISBURSEMENT_SENT:    {DISBURSEMENT_FAILED, DISBURSEMENT_COMPLETED},
+	DISBURSEMENT_FAILED:  {DISBURSEMENT_PENDING},
+}
+
+// CanTransitionTo reports whether a disbursement in status s can move to
+// status to.
+func (s DisbursementStatus) CanTransitionTo(to DisbursementStatus) bool {
+	for _, next := range disbursementTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BankAccount is the account a loan is paid out to.
+type BankAccount struct {
+	BankCode      string `json:"bank_code"`
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+}
+
+// Disbursement is the transfer instruction paying a loan out to the
+// borrower. The borrower receives the principal less the fees deducted.
+type Disbursement struct {
+	ID                uint64             `json:"id"`
+	LoanID            uint64             `json:"loan_id"`
+	CustomerID        uint64             `json:"customer_id"`
+	BankAccount       BankAccount        `json:"bank_account"`
+	Amount            decimal.Decimal    `json:"amount"`
+	FeeAmount         decimal.Decimal    `json:"fee_amount"`
+	Status            DisbursementStatus `json:"status"`
+	ProviderReference string             `json:"provider_reference"`
+	FailureReason     string             `json:"failure_reason"`
+	Attempts          int64              `json:"attempts"`
+	RequestedBy       string             `json:"requested_by"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+	CompletedAt       time.Time          `json:"completed_at"`
+}
+
+// NewDisbursement instructs the payout of the loan to account, deducting
+// feeAmount from the principal.
+func NewDisbursement(id uint64, loan Loan, account BankAccount, feeAmount decimal.Decimal, requestedBy string, at time.Time) Disbursement {
+	return Disbursement{
+		ID:          id,
+		LoanID:      loan.ID,
+		CustomerID:  loan.CustomerID,
+		BankAccount: account,
+		Amount:      loan.PrincipalAmount.Sub(feeAmount),
+		FeeAmount:   feeAmount,
+		Status:      DISBURSEMENT_PENDING,
+		RequestedBy: requestedBy,
+		CreatedAt:   at,
+		UpdatedAt:   at,
+	}
+}
+
+// PayoutResult is what a payout provider answers when handed a disbursement.
+type PayoutResult struct {
+	Reference     string
+	Status        DisbursementStatus // SENT, FAILED or COMPLETED
+	FailureReason string
+}
@@ -0,0 +1,17 @@
+package entity
+
+type EligibilityCheckName string
+
+const (
+	ELIGIBILITY_ACTIVE_LOAN_LIMIT   EligibilityCheckName = "ACTIVE_LOAN_LIMIT"   // fewer active loans than allowed
+	ELIGIBILITY_CREDIT_LIMIT        EligibilityCheckName = "CREDIT_LIMIT"        // the new principal fits within the credit limit
+	ELIGIBILITY_NO_WRITTEN_OFF_LOAN EligibilityCheckName = "NO_WRITTEN_OFF_LOAN" // no loan was ever written off
+)
+
+// EligibilityCheck is the result of one check a customer has to pass before
+// applying for a loan.
+type EligibilityCheck struct {
+	Name   EligibilityCheckName `json:"name"`
+	Passed bool                 `json:"passed"`
+	Detail string               `json:"detail"`
+}
@@ -0,0 +1,218 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type (
+	FeeType        string
+	FeeCalculation string
+	FeeCollection  string
+)
+
+const (
+	FEE_ORIGINATION FeeType = "ORIGINATION"
+	FEE_ADMIN       FeeType = "ADMIN"
+	FEE_INSURANCE   FeeType = "INSURANCE" // credit life insurance premium
+)
+
+const (
+	FEE_PERCENTAGE FeeCalculation = "PERCENTAGE" // a fraction of the principal, e.g. 0.02 for 2%
+	FEE_FLAT       FeeCalculation = "FLAT"       // a fixed amount
+)
+
+const (
+	FEE_DEDUCTED  FeeCollection = "DEDUCTED"  // taken out of the payout
+	FEE_AMORTISED FeeCollection = "AMORTISED" // spread over the installments
+)
+
+// WEEKS_PER_YEAR annualises weekly rates.
+const WEEKS_PER_YEAR = 52
+
+// ProductFee defines a fee charged on every loan of a product.
+type ProductFee struct {
+	ProductCode string          `json:"product_code"`
+	FeeType     FeeType         `json:"fee_type"`
+	Calculation FeeCalculation  `json:"calculation"`
+	Value       decimal.Decimal `json:"value"`
+	Collection  FeeCollection   `json:"collection"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// AmountFor returns the fee charged on principal, rounded to cents.
+func (f ProductFee) AmountFor(principal decimal.Decimal) decimal.Decimal {
+	if f.Calculation == FEE_PERCENTAGE {
+		return principal.Mul(f.Value).Round(2)
+	}
+
+	return f.Value.Round(2)
+}
+
+// LoanFee is a product fee as it was charged on a loan. The definition is
+// copied so later product changes leave the loan untouched.
+type LoanFee struct {
+	ID          uint64          `json:"id"`
+	LoanID      uint64          `json:"loan_id"`
+	FeeType     FeeType         `json:"fee_type"`
+	Calculation FeeCalculation  `json:"calculation"`
+	Value       decimal.Decimal `json:"value"`
+	Collection  FeeCollection   `json:"collection"`
+	Amount      decimal.Decimal `json:"amount"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+func NewLoanFee(id uint64, loan Loan, fee ProductFee, at time.Time) LoanFee {
+	return LoanFee{
+		ID:          id,
+		LoanID:      loan.ID,
+		FeeType:     fee.FeeType,
+		Calculation: fee.Calculation,
+		Value:       fee.Value,
+		Collection:  fee.Collection,
+		Amount:      fee.AmountFor(loan.PrincipalAmount),
+		CreatedAt:   at,
+	}
+}
+
+// FeeReference identifies the journal entry charging a fee of a loan.
+func FeeReference(feeType FeeType) string {
+	return "fee-" + strings.ToLower(string(feeType))
+}
+
+// TotalFees sums the fees collected the given way.
+func TotalFees(fees []LoanFee, collection FeeCollection) decimal.Decimal {
+	total := decimal.Zero
+	for _, fee := range fees {
+		if fee.Collection == collection {
+			total = total.Add(fee.Amount)
+		}
+	}
+
+	return total
+}
+
+// InstallmentFee is the part of an amortised fee due with an installment.
+type InstallmentFee struct {
+	FeeType FeeType         `json:"fee_type"`
+	Amount  decimal.Decimal `json:"amount"`
+}
+
+// FeeSchedule spreads every amortised fee evenly over termWeeks
+// installments. Element i holds the fee lines due in week i+1.
+func FeeSchedule(fees []LoanFee, termWeeks int64) [][]InstallmentFee {
+	if termWeeks <= 0 {
+		return nil
+	}
+
+	schedule := make([][]InstallmentFee, termWeeks)
+	for _, fee := range fees {
+		if fee.Collection != FEE_AMORTISED || !fee.Amount.IsPositive() {
+			continue
+		}
+
+		for i, amount := range SplitEvenly(fee.Amount, termWeeks) {
+			schedule[i] = append(schedule[i], InstallmentFee{FeeType: fee.FeeType, Amount: amount})
+		}
+	}
+
+	return schedule
+}
+
+// TotalInstallmentFees sums the fee lines of an installment.
+func TotalInstallmentFees(lines []InstallmentFee) decimal.Decimal {
+	total := decimal.Zero
+	for _, line := range lines {
+		total = total.Add(line.Amount)
+	}
+
+	return total
+}
+
+// LoanCashFlows returns what the borrower receives at disbursement and the
+// weekly payments of the loan, fees included.
+func LoanCashFlows(loan Loan, fees []LoanFee) (decimal.Decimal, []decimal.Decimal) {
+	received := loan.PrincipalAmount.Sub(TotalFees(fees, FEE_DEDUCTED))
+	if loan.TermWeeks <= 0 {
+		return received, nil
+	}
+
+	feeSchedule := FeeSchedule(fees, loan.TermWeeks)
+
+	payments := InstallmentAmounts(loan)
+	for i := range payments {
+		payments[i] = payments[i].Add(TotalInstallmentFees(feeSchedule[i]))
+	}
+
+	return received, payments
+}
+
+// MAX_EFFECTIVE_ANNUAL_RATE is the largest effective annual rate a loan
+// disclosure can hold.
+const MAX_EFFECTIVE_ANNUAL_RATE = 999999.0
+
+// ErrUnboundedEffectiveRate is returned when what was received is so small
+// against the payments that the effective annual rate overflows.
+var ErrUnboundedEffectiveRate = errors.New("effective annual rate is out of bounds")
+
+// EffectiveAnnualRate is the yearly compounded internal rate of return of
+// borrowing received and repaying payments weekly, so it reflects every fee
+// as well as the interest. It fails when the payments do not exceed what was
+// received, or with ErrUnboundedEffectiveRate when the rate overflows.
+func EffectiveAnnualRate(received decimal.Decimal, payments []decimal.Decimal) (decimal.Decimal, error) {
+	if !received.IsPositive() {
+		return decimal.Zero, fmt.Errorf("received amount must be positive, got %s", received.StringFixed(2))
+	}
+
+	flows := make([]float64, len(payments))
+	total := 0.0
+	for i, payment := range payments {
+		flows[i] = payment.InexactFloat64()
+		total += flows[i]
+	}
+
+	pv := received.InexactFloat64()
+	if total <= pv {
+		return decimal.Zero, fmt.Errorf("payments of %.2f do not exceed the %s received", total, received.StringFixed(2))
+	}
+
+	// The present value falls as the rate grows, so the weekly rate matching
+	// what was received is found by bisection.
+	presentValue := func(rate float64) float64 {
+		value, discount := 0.0, 1.0
+		for _, flow := range flows {
+			discount /= 1 + rate
+			value += flow * discount
+		}
+
+		return value
+	}
+
+	low, high := 0.0, 1.0
+	for presentValue(high) > pv {
+		high *= 2
+	}
+
+	for i := 0; i < 200; i++ {
+		mid := (low + high) / 2
+		if presentValue(mid) > pv {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	weekly := (low + high) / 2
+
+	annual := math.Pow(1+weekly, WEEKS_PER_YEAR) - 1
+	if math.IsNaN(annual) || annual > MAX_EFFECTIVE_ANNUAL_RATE {
+		return decimal.Zero, fmt.Errorf("%w: %s received against payments of %.2f", ErrUnboundedEffectiveRate, received.StringFixed(2), total)
+	}
+
+	return decimal.NewFromFloat(annual).Round(6), nil
+}
@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectiveAnnualRate(t *testing.T) {
+	weekly := func(amount int64, weeks int) []decimal.Decimal {
+		payments := make([]decimal.Decimal, weeks)
+		for i := range payments {
+			payments[i] = decimal.NewFromInt(amount)
+		}
+
+		return payments
+	}
+
+	tests := []struct {
+		name          string
+		received      decimal.Decimal
+		payments      []decimal.Decimal
+		expectedRate  string
+		expectedError error
+	}{
+		{
+			name:         "success - flat interest only",
+			received:     decimal.NewFromInt(5000000),
+			payments:     weekly(110000, 50),
+			expectedRate: "0.218253",
+		},
+		{
+			name:         "success - deducted fee raises the rate",
+			received:     decimal.NewFromInt(4900000),
+			payments:     weekly(110000, 50),
+			expectedRate: "0.271351",
+		},
+		{
+			name:         "success - single payment compounded over the year",
+			received:     decimal.NewFromInt(1000),
+			payments:     weekly(1010, 1),
+			expectedRate: "0.677689",
+		},
+		{
+			name:          "error - nothing received",
+			received:      decimal.Zero,
+			payments:      weekly(110000, 50),
+			expectedError: errors.New("received amount must be positive, got 0.00"),
+		},
+		{
+			name:          "error - payments do not exceed what was received",
+			received:      decimal.NewFromInt(5500000),
+			payments:      weekly(110000, 50),
+			expectedError: errors.New("payments of 5500000.00 do not exceed the 5500000.00 received"),
+		},
+		{
+			name:          "error - tiny net amount would overflow the rate",
+			received:      decimal.NewFromInt(1),
+			payments:      weekly(110000, 50),
+			expectedError: ErrUnboundedEffectiveRate,
+		},
+		{
+			name:          "error - a cent received would make the rate infinite",
+			received:      decimal.RequireFromString("0.01"),
+			payments:      weekly(110000, 50),
+			expectedError: ErrUnboundedEffectiveRate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := EffectiveAnnualRate(tt.received, tt.payments)
+
+			if tt.expectedError != nil {
+				if errors.Is(tt.expectedError, ErrUnboundedEffectiveRate) {
+					assert.ErrorIs(t, err, ErrUnboundedEffectiveRate)
+				} else {
+					assert.EqualError(t, err, tt.expectedError.Error())
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRate, rate.String())
+		})
+	}
+}
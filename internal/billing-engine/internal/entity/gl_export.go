@@ -0,0 +1,180 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// GL_MAPPING_DEFAULT maps an account for every journal event that has no
+// mapping of its own for that account.
+const GL_MAPPING_DEFAULT JournalEvent = "DEFAULT"
+
+// GLMapping maps the postings of a journal event on a ledger account to an
+// account code of the external accounting system. Principal repayments, for
+// example, are the PAYMENT postings on PRINCIPAL_RECEIVABLE.
+type GLMapping struct {
+	Event       JournalEvent `json:"event"`
+	AccountCode string       `json:"account_code"`
+	GLCode      string       `json:"gl_code"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// IsGLMappingEvent reports whether a mapping can be configured for event.
+func IsGLMappingEvent(event JournalEvent) bool {
+	switch event {
+	case GL_MAPPING_DEFAULT, JOURNAL_DISBURSEMENT, JOURNAL_PAYMENT, JOURNAL_FEE, JOURNAL_REVERSAL,
+		JOURNAL_WRITE_OFF, JOURNAL_RECOVERY, JOURNAL_ACCRUAL, JOURNAL_ACCRUAL_REVERSAL, JOURNAL_SETTLEMENT,
+		JOURNAL_RESTRUCTURE, JOURNAL_MORATORIUM:
+		return true
+	}
+
+	return false
+}
+
+// GLChart resolves the GL code of a posting from the configured mappings.
+type GLChart map[JournalEvent]map[string]string
+
+func NewGLChart(mappings []GLMapping) GLChart {
+	chart := make(GLChart)
+	for _, mapping := range mappings {
+		if chart[mapping.Event] == nil {
+			chart[mapping.Event] = make(map[string]string)
+		}
+		chart[mapping.Event][mapping.AccountCode] = mapping.GLCode
+	}
+
+	return chart
+}
+
+// Resolve returns the GL code of the event's postings on the account,
+// falling back to the account's default mapping.
+func (c GLChart) Resolve(event JournalEvent, accountCode string) (string, bool) {
+	if glCode, ok := c[event][accountCode]; ok {
+		return glCode, true
+	}
+
+	glCode, ok := c[GL_MAPPING_DEFAULT][accountCode]
+	return glCode, ok
+}
+
+// GLLine is one posting of the journal export.
+type GLLine struct {
+	BatchID       string
+	EntryID       uint64
+	LineNumber    int
+	LoanID        uint64
+	Event         JournalEvent
+	Reference     string
+	EffectiveDate time.Time
+	AccountCode   string
+	GLCode        string
+	Debit         decimal.Decimal
+	Credit        decimal.Decimal
+	Description   string
+}
+
+// GLBatch groups the lines of one journal event. Every entry in it is
+// balanced, so the batch balances as well.
+type GLBatch struct {
+	ID          string
+	Event       JournalEvent
+	Lines       []GLLine
+	TotalDebit  decimal.Decimal
+	TotalCredit decimal.Decimal
+}
+
+func (b GLBatch) IsBalanced() bool {
+	return b.TotalDebit.Equal(b.TotalCredit)
+}
+
+// GLJournal is the daily journal handed over to the accounting system. The
+// control total is the sum of all debits, which equals the sum of all
+// credits, and lets the receiving side check the file is complete.
+type GLJournal struct {
+	Date         time.Time
+	Batches      []GLBatch
+	LineCount    int
+	ControlTotal decimal.Decimal
+}
+
+// NewGLJournal builds the journal of the entries effective on date, one batch
+// per journal event in the order the events first occur. It fails when a
+// posting has no GL mapping or a batch does not balance.
+func NewGLJournal(date time.Time, entries []JournalEntry, chart GLChart) (GLJournal, error) {
+	journal := GLJournal{Date: date, ControlTotal: decimal.Zero}
+	batchIndex := make(map[JournalEvent]int)
+	unmapped := make(map[string]bool)
+
+	for _, entry := range entries {
+		index, ok := batchIndex[entry.Event]
+		if !ok {
+			index = len(journal.Batches)
+			batchIndex[entry.Event] = index
+			journal.Batches = append(journal.Batches, GLBatch{
+				ID:          fmt.Sprintf("%s-%02d", date.Format("20060102"), index+1),
+				Event:       entry.Event,
+				TotalDebit:  decimal.Zero,
+				TotalCredit: decimal.Zero,
+			})
+		}
+
+		batch := &journal.Batches[index]
+		for i, posting := range entry.Postings {
+			glCode, ok := chart.Resolve(entry.Event, posting.AccountCode)
+			if !ok {
+				unmapped[fmt.Sprintf("%s/%s", entry.Event, posting.AccountCode)] = true
+				continue
+			}
+
+			line := GLLine{
+				BatchID:       batch.ID,
+				EntryID:       entry.ID,
+				LineNumber:    i + 1,
+				LoanID:        entry.LoanID,
+				Event:         entry.Event,
+				Reference:     entry.Reference,
+				EffectiveDate: entry.EffectiveDate,
+				AccountCode:   posting.AccountCode,
+				GLCode:        glCode,
+				Debit:         decimal.Zero,
+				Credit:        decimal.Zero,
+				Description:   entry.Description,
+			}
+
+			if posting.Direction == POSTING_DEBIT {
+				line.Debit = posting.Amount
+				batch.TotalDebit = batch.TotalDebit.Add(posting.Amount)
+			} else {
+				line.Credit = posting.Amount
+				batch.TotalCredit = batch.TotalCredit.Add(posting.Amount)
+			}
+
+			batch.Lines = append(batch.Lines, line)
+		}
+	}
+
+	if len(unmapped) > 0 {
+		keys := make([]string, 0, len(unmapped))
+		for key := range unmapped {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		return GLJournal{}, fmt.Errorf("no GL mapping for %s", strings.Join(keys, ", "))
+	}
+
+	for _, batch := range journal.Batches {
+		if !batch.IsBalanced() {
+			return GLJournal{}, fmt.Errorf("batch %s is not balanced: debit %s, credit %s", batch.ID, batch.TotalDebit.StringFixed(2), batch.TotalCredit.StringFixed(2))
+		}
+
+		journal.LineCount += len(batch.Lines)
+		journal.ControlTotal = journal.ControlTotal.Add(batch.TotalDebit)
+	}
+
+	return journal, nil
+}
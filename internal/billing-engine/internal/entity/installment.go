@@ -1,12 +1,20 @@
 package entity
 
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
 type InstallmentStatus string
 
 const (
-	INSTALLMENT_UNKNOWN InstallmentStatus = "UNKNOWN"
-	INSTALLMENT_PENDING InstallmentStatus = "PENDING"
-	INSTALLMENT_PAID    InstallmentStatus = "PAID"
-	INSTALLMENT_MISSED  InstallmentStatus = "MISSED"
+	INSTALLMENT_UNKNOWN     InstallmentStatus = "UNKNOWN"
+	INSTALLMENT_PENDING     InstallmentStatus = "PENDING"
+	INSTALLMENT_PAID        InstallmentStatus = "PAID"
+	INSTALLMENT_MISSED      InstallmentStatus = "MISSED"
+	INSTALLMENT_CLOSED      InstallmentStatus = "CLOSED"      // superseded by a restructured schedule
+	INSTALLMENT_WRITTEN_OFF InstallmentStatus = "WRITTEN_OFF" // unpaid when the loan was written off
 )
 
 type Installment struct {
@@ -15,5 +23,70 @@ type Installment struct {
 	WeekNumber int64             `json:"week_number"`
 	DueDate    string            `json:"due_date"`
 	AmountDue  string            `json:"amount_due"`
+	FeeAmount  string            `json:"fee_amount"` // amortised fees due on top of the amount due
 	Status     InstallmentStatus `json:"status"`
+
+	HolidayInterest string `json:"holiday_interest"` // moratorium interest included in the amount due
+}
+
+// TotalDue is what has to be paid to settle the installment, its amount due
+// and its fees.
+func (i Installment) TotalDue() (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(i.AmountDue)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	fee, err := i.Fee()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return amount.Add(fee), nil
+}
+
+// Fee parses the fee amount, which is zero for installments without fees.
+func (i Installment) Fee() (decimal.Decimal, error) {
+	if i.FeeAmount == "" {
+		return decimal.Zero, nil
+	}
+
+	return decimal.NewFromString(i.FeeAmount)
+}
+
+// Holiday parses the holiday interest, which is zero for installments never
+// shifted by an interest-bearing moratorium.
+func (i Installment) Holiday() (decimal.Decimal, error) {
+	if i.HolidayInterest == "" {
+		return decimal.Zero, nil
+	}
+
+	return decimal.NewFromString(i.HolidayInterest)
+}
+
+// ScheduledAmount is the part of the amount due built as principal plus flat
+// interest, the amount due without its holiday interest.
+func (i Installment) ScheduledAmount() (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(i.AmountDue)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	holiday, err := i.Holiday()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return amount.Sub(holiday), nil
+}
+
+// DueOn parses the due date, which may carry a time part depending on how it
+// was read, as a local date.
+func (i Installment) DueOn() (time.Time, error) {
+	dueDate := i.DueDate
+	if len(dueDate) > len("2006-01-02") {
+		dueDate = dueDate[:len("2006-01-02")]
+	}
+
+	return time.ParseInLocation("2006-01-02", dueDate, time.Local)
 }
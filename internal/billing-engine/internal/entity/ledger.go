@@ -0,0 +1,318 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type (
+	AccountType      string
+	JournalEvent     string
+	PostingDirection string
+)
+
+const (
+	ACCOUNT_ASSET     AccountType = "ASSET"
+	ACCOUNT_LIABILITY AccountType = "LIABILITY"
+	ACCOUNT_EQUITY    AccountType = "EQUITY"
+	ACCOUNT_INCOME    AccountType = "INCOME"
+	ACCOUNT_EXPENSE   AccountType = "EXPENSE"
+)
+
+// Ledger account codes, seeded by the ledger migration.
+const (
+	ACCOUNT_CASH                 = "CASH"
+	ACCOUNT_PRINCIPAL_RECEIVABLE = "PRINCIPAL_RECEIVABLE"
+	ACCOUNT_FEE_RECEIVABLE       = "FEE_RECEIVABLE"
+	ACCOUNT_INTEREST_RECEIVABLE  = "INTEREST_RECEIVABLE"
+	ACCOUNT_INTEREST_INCOME      = "INTEREST_INCOME"
+	ACCOUNT_FEE_INCOME           = "FEE_INCOME"
+	ACCOUNT_WRITE_OFF_EXPENSE    = "WRITE_OFF_EXPENSE"
+	ACCOUNT_RECOVERY_INCOME      = "RECOVERY_INCOME"
+	ACCOUNT_MERCHANT_PAYABLE     = "MERCHANT_PAYABLE"
+	ACCOUNT_MDR_INCOME           = "MDR_INCOME"
+	ACCOUNT_RESTRUCTURE_CLEARING = "RESTRUCTURE_CLEARING"
+)
+
+const (
+	JOURNAL_DISBURSEMENT     JournalEvent = "DISBURSEMENT"
+	JOURNAL_PAYMENT          JournalEvent = "PAYMENT"
+	JOURNAL_FEE              JournalEvent = "FEE"
+	JOURNAL_REVERSAL         JournalEvent = "REVERSAL"
+	JOURNAL_WRITE_OFF        JournalEvent = "WRITE_OFF"
+	JOURNAL_RECOVERY         JournalEvent = "RECOVERY"
+	JOURNAL_ACCRUAL          JournalEvent = "ACCRUAL"
+	JOURNAL_ACCRUAL_REVERSAL JournalEvent = "ACCRUAL_REVERSAL"
+	JOURNAL_SETTLEMENT       JournalEvent = "SETTLEMENT"
+	JOURNAL_RESTRUCTURE      JournalEvent = "RESTRUCTURE"
+	JOURNAL_MORATORIUM       JournalEvent = "MORATORIUM"
+)
+
+const (
+	POSTING_DEBIT  PostingDirection = "DEBIT"
+	POSTING_CREDIT PostingDirection = "CREDIT"
+)
+
+type LedgerAccount struct {
+	Code string      `json:"code"`
+	Name string      `json:"name"`
+	Type AccountType `json:"type"`
+}
+
+// IsDebitNormal reports whether the account balance grows with debits.
+func (a LedgerAccount) IsDebitNormal() bool {
+	return a.Type == ACCOUNT_ASSET || a.Type == ACCOUNT_EXPENSE
+}
+
+// AccountTotal is the sum of the debit and credit postings of an account.
+type AccountTotal struct {
+	AccountCode string
+	Debit       decimal.Decimal
+	Credit      decimal.Decimal
+}
+
+// JournalEntry is a balanced set of postings recording one money movement.
+type JournalEntry struct {
+	ID              uint64       `json:"id"`
+	LoanID          uint64       `json:"loan_id"`
+	Event           JournalEvent `json:"event"`
+	Reference       string       `json:"reference"`
+	Description     string       `json:"description"`
+	EffectiveDate   time.Time    `json:"effective_date"`
+	ReversesEntryID uint64       `json:"reverses_entry_id,omitempty"`
+	CreatedAt       time.Time    `json:"created_at"`
+	Postings        []Posting    `json:"postings"`
+}
+
+type Posting struct {
+	AccountCode string           `json:"account_code"`
+	Direction   PostingDirection `json:"direction"`
+	Amount      decimal.Decimal  `json:"amount"`
+}
+
+// Debit adds a debit posting, skipping zero amounts.
+func (j *JournalEntry) Debit(accountCode string, amount decimal.Decimal) {
+	j.post(accountCode, POSTING_DEBIT, amount)
+}
+
+// Credit adds a credit posting, skipping zero amounts.
+func (j *JournalEntry) Credit(accountCode string, amount decimal.Decimal) {
+	j.post(accountCode, POSTING_CREDIT, amount)
+}
+
+func (j *JournalEntry) post(accountCode string, direction PostingDirection, amount decimal.Decimal) {
+	if amount.IsZero() {
+		return
+	}
+
+	j.Postings = append(j.Postings, Posting{AccountCode: accountCode, Direction: direction, Amount: amount})
+}
+
+func (j JournalEntry) TotalDebit() decimal.Decimal {
+	return j.total(POSTING_DEBIT)
+}
+
+func (j JournalEntry) TotalCredit() decimal.Decimal {
+	return j.total(POSTING_CREDIT)
+}
+
+func (j JournalEntry) total(direction PostingDirection) decimal.Decimal {
+	total := decimal.Zero
+	for _, posting := range j.Postings {
+		if posting.Direction == direction {
+			total = total.Add(posting.Amount)
+		}
+	}
+
+	return total
+}
+
+// IsBalanced reports whether the entry has postings and its debits equal
+// its credits.
+func (j JournalEntry) IsBalanced() bool {
+	return len(j.Postings) > 0 && j.TotalDebit().Equal(j.TotalCredit())
+}
+
+// Reversal returns an entry that cancels j by swapping every posting.
+func (j JournalEntry) Reversal(id uint64, effectiveDate time.Time, description string) JournalEntry {
+	reversal := JournalEntry{
+		ID:              id,
+		LoanID:          j.LoanID,
+		Event:           JOURNAL_REVERSAL,
+		Reference:       j.Reference,
+		Description:     description,
+		EffectiveDate:   effectiveDate,
+		ReversesEntryID: j.ID,
+		CreatedAt:       time.Now(),
+	}
+
+	for _, posting := range j.Postings {
+		direction := POSTING_DEBIT
+		if posting.Direction == POSTING_DEBIT {
+			direction = POSTING_CREDIT
+		}
+		reversal.post(posting.AccountCode, direction, posting.Amount)
+	}
+
+	return reversal
+}
+
+// PaymentReference identifies the installment a payment entry settles.
+func PaymentReference(weekNumber int64) string {
+	return fmt.Sprintf("week-%d", weekNumber)
+}
+
+// NewDisbursementEntry moves the principal out of cash into the loan
+// receivable. The fees deducted from the payout stay in cash and settle
+// their fee receivable.
+func NewDisbursementEntry(id uint64, loan Loan, deductedFees decimal.Decimal) JournalEntry {
+	entry := newJournalEntry(id, loan.ID, JOURNAL_DISBURSEMENT, fmt.Sprintf("loan-%d", loan.ID), "Disbursement", loan.StartDate)
+	entry.Debit(ACCOUNT_PRINCIPAL_RECEIVABLE, loan.PrincipalAmount)
+	entry.Credit(ACCOUNT_CASH, loan.PrincipalAmount.Sub(deductedFees))
+	entry.Credit(ACCOUNT_FEE_RECEIVABLE, deductedFees)
+
+	return entry
+}
+
+// NewMerchantDisbursementEntry books a loan sold at a merchant. The merchant
+// is owed what would have been paid out less its MDR, which is income, until
+// it is settled.
+func NewMerchantDisbursementEntry(id uint64, loan Loan, deductedFees decimal.Decimal, mdr decimal.Decimal) JournalEntry {
+	entry := newJournalEntry(id, loan.ID, JOURNAL_DISBURSEMENT, fmt.Sprintf("loan-%d", loan.ID), "Disbursement to merchant", loan.StartDate)
+	entry.Debit(ACCOUNT_PRINCIPAL_RECEIVABLE, loan.PrincipalAmount)
+	entry.Credit(ACCOUNT_MERCHANT_PAYABLE, loan.PrincipalAmount.Sub(deductedFees).Sub(mdr))
+	entry.Credit(ACCOUNT_MDR_INCOME, mdr)
+	entry.Credit(ACCOUNT_FEE_RECEIVABLE, deductedFees)
+
+	return entry
+}
+
+// NewSettlementEntry pays the merchant what it was owed for a loan.
+func NewSettlementEntry(id uint64, settlementID uint64, item MerchantSettlementItem, settledAt time.Time) JournalEntry {
+	entry := newJournalEntry(id, item.LoanID, JOURNAL_SETTLEMENT, fmt.Sprintf("settlement-%d", settlementID), "Merchant settlement", settledAt)
+	entry.Debit(ACCOUNT_MERCHANT_PAYABLE, item.NetAmount)
+	entry.Credit(ACCOUNT_CASH, item.NetAmount)
+
+	return entry
+}
+
+// NewPaymentEntry books an installment payment. Its interest part settles
+// the accrued interest, which was recognised as income day by day, and its
+// fee part the fees charged at disbursement.
+func NewPaymentEntry(id uint64, loanID uint64, weekNumber int64, principal decimal.Decimal, interest decimal.Decimal, fee decimal.Decimal, effectiveDate time.Time) JournalEntry {
+	entry := newJournalEntry(id, loanID, JOURNAL_PAYMENT, PaymentReference(weekNumber), "Installment payment", effectiveDate)
+	entry.Debit(ACCOUNT_CASH, principal.Add(interest).Add(fee))
+	entry.Credit(ACCOUNT_PRINCIPAL_RECEIVABLE, principal)
+	entry.Credit(ACCOUNT_INTEREST_RECEIVABLE, interest)
+	entry.Credit(ACCOUNT_FEE_RECEIVABLE, fee)
+
+	return entry
+}
+
+// NewFeeEntry charges a fee to the borrower.
+func NewFeeEntry(id uint64, loanID uint64, reference string, amount decimal.Decimal, effectiveDate time.Time) JournalEntry {
+	entry := newJournalEntry(id, loanID, JOURNAL_FEE, reference, "Fee charged", effectiveDate)
+	entry.Debit(ACCOUNT_FEE_RECEIVABLE, amount)
+	entry.Credit(ACCOUNT_FEE_INCOME, amount)
+
+	return entry
+}
+
+// NewWriteOffEntry expenses the written-off principal and fee receivables.
+// Accrued interest is reversed out of income separately, see
+// NewAccrualReversalEntry.
+func NewWriteOffEntry(id uint64, writeOff WriteOff) JournalEntry {
+	entry := newJournalEntry(id, writeOff.LoanID, JOURNAL_WRITE_OFF, fmt.Sprintf("write-off-%d", writeOff.ID), "Write-off", writeOff.WrittenOffAt)
+	entry.Debit(ACCOUNT_WRITE_OFF_EXPENSE, writeOff.PrincipalAmount.Add(writeOff.FeeAmount))
+	entry.Credit(ACCOUNT_PRINCIPAL_RECEIVABLE, writeOff.PrincipalAmount)
+	entry.Credit(ACCOUNT_FEE_RECEIVABLE, writeOff.FeeAmount)
+
+	return entry
+}
+
+// NewRecoveryEntry books money received on a written-off loan.
+func NewRecoveryEntry(id uint64, recovery Recovery) JournalEntry {
+	entry := newJournalEntry(id, recovery.LoanID, JOURNAL_RECOVERY, fmt.Sprintf("recovery-%d", recovery.ID), "Recovery on written-off loan", recovery.ReceivedAt)
+	entry.Debit(ACCOUNT_CASH, recovery.Amount)
+	entry.Credit(ACCOUNT_RECOVERY_INCOME, recovery.Amount)
+
+	return entry
+}
+
+// NewAccrualEntry recognises the interest earned by a loan on day.
+func NewAccrualEntry(id uint64, loanID uint64, day time.Time, amount decimal.Decimal) JournalEntry {
+	entry := newJournalEntry(id, loanID, JOURNAL_ACCRUAL, "accrual-"+day.Format("2006-01-02"), "Daily interest accrual", day)
+	entry.Debit(ACCOUNT_INTEREST_RECEIVABLE, amount)
+	entry.Credit(ACCOUNT_INTEREST_INCOME, amount)
+
+	return entry
+}
+
+// NewMoratoriumInterestEntry recognises the interest a moratorium adds to the
+// shifted installments of a loan when the moratorium is declared.
+func NewMoratoriumInterestEntry(id uint64, loanID uint64, moratoriumID uint64, startDate time.Time, amount decimal.Decimal) JournalEntry {
+	entry := newJournalEntry(id, loanID, JOURNAL_MORATORIUM, fmt.Sprintf("moratorium-%d", moratoriumID), "Moratorium interest", startDate)
+	entry.Debit(ACCOUNT_INTEREST_RECEIVABLE, amount)
+	entry.Credit(ACCOUNT_INTEREST_INCOME, amount)
+
+	return entry
+}
+
+// NewAccrualReversalEntry takes accrued but unpaid interest back out of
+// income.
+func NewAccrualReversalEntry(id uint64, loanID uint64, day time.Time, amount decimal.Decimal) JournalEntry {
+	entry := newJournalEntry(id, loanID, JOURNAL_ACCRUAL_REVERSAL, "accrual-reversal-"+day.Format("2006-01-02"), "Accrued interest reversal", day)
+	entry.Debit(ACCOUNT_INTEREST_INCOME, amount)
+	entry.Credit(ACCOUNT_INTEREST_RECEIVABLE, amount)
+
+	return entry
+}
+
+// NewRestructureEntries move the receivables of a restructured loan onto the
+// loan carrying its new schedule, through the restructure clearing account.
+// The first entry clears the principal, interest and fee balances of the
+// original loan. The second opens the new loan, which bears no interest of
+// its own, with the whole outstanding as principal; the interest in it that
+// the original loan had not accrued yet is recognised as it is capitalised.
+func NewRestructureEntries(closingID uint64, openingID uint64, restructure LoanRestructure, principal decimal.Decimal, interest decimal.Decimal, fee decimal.Decimal, effectiveDate time.Time) (JournalEntry, JournalEntry) {
+	reference := fmt.Sprintf("restructure-%d", restructure.ID)
+	cleared := principal.Add(interest).Add(fee)
+
+	closing := newJournalEntry(closingID, restructure.LoanID, JOURNAL_RESTRUCTURE, reference, "Restructured into a new loan", effectiveDate)
+	closing.Debit(ACCOUNT_RESTRUCTURE_CLEARING, cleared)
+	closing.settle(ACCOUNT_PRINCIPAL_RECEIVABLE, principal)
+	closing.settle(ACCOUNT_INTEREST_RECEIVABLE, interest)
+	closing.settle(ACCOUNT_FEE_RECEIVABLE, fee)
+
+	opening := newJournalEntry(openingID, restructure.NewLoanID, JOURNAL_RESTRUCTURE, reference, "Opened by a restructure", effectiveDate)
+	opening.Debit(ACCOUNT_PRINCIPAL_RECEIVABLE, restructure.OutstandingAmount)
+	opening.Credit(ACCOUNT_RESTRUCTURE_CLEARING, cleared)
+	opening.settle(ACCOUNT_INTEREST_INCOME, restructure.OutstandingAmount.Sub(cleared))
+
+	return closing, opening
+}
+
+// settle credits a debit balance off the account, or debits a credit
+// balance.
+func (j *JournalEntry) settle(accountCode string, balance decimal.Decimal) {
+	if balance.IsNegative() {
+		j.Debit(accountCode, balance.Neg())
+		return
+	}
+
+	j.Credit(accountCode, balance)
+}
+
+func newJournalEntry(id uint64, loanID uint64, event JournalEvent, reference string, description string, effectiveDate time.Time) JournalEntry {
+	return JournalEntry{
+		ID:            id,
+		LoanID:        loanID,
+		Event:         event,
+		Reference:     reference,
+		Description:   description,
+		EffectiveDate: time.Date(effectiveDate.Year(), effectiveDate.Month(), effectiveDate.Day(), 0, 0, 0, 0, effectiveDate.Location()),
+		CreatedAt:     time.Now(),
+	}
+}
@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -10,10 +12,81 @@ type LoanStatus string
 
 const (
 	UNKNOWN_LOAN_STATUS LoanStatus = "UNKNOWN"
+	LOAN_APPLIED        LoanStatus = "APPLIED"
+	LOAN_APPROVED       LoanStatus = "APPROVED"
+	LOAN_REJECTED       LoanStatus = "REJECTED"
+	LOAN_CANCELLED      LoanStatus = "CANCELLED"
 	LOAN_DISBURSED      LoanStatus = "DISBURSED"
 	LOAN_PAID           LoanStatus = "PAID"
+	LOAN_RESTRUCTURED   LoanStatus = "RESTRUCTURED"
+	LOAN_WRITTEN_OFF    LoanStatus = "WRITTEN_OFF"
 )
 
+// loanTransitions lists the statuses each status can move to. REJECTED,
+// CANCELLED, RESTRUCTURED and WRITTEN_OFF are final. A PAID loan goes back to
+// DISBURSED when one of its payments is reversed.
+var loanTransitions = map[LoanStatus][]LoanStatus{
+	LOAN_APPLIED:   {LOAN_APPROVED, LOAN_REJECTED, LOAN_CANCELLED},
+	LOAN_APPROVED:  {LOAN_DISBURSED, LOAN_CANCELLED},
+	LOAN_DISBURSED: {LOAN_PAID, LOAN_RESTRUCTURED, LOAN_WRITTEN_OFF},
+	LOAN_PAID:      {LOAN_DISBURSED},
+}
+
+// CanTransitionTo reports whether a loan in status s can move to status to.
+func (s LoanStatus) CanTransitionTo(to LoanStatus) bool {
+	for _, next := range loanTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsActive tells whether a loan in status s is applied for, approved or
+// disbursed, i.e. still open.
+func (s LoanStatus) IsActive() bool {
+	return s == LOAN_APPLIED || s == LOAN_APPROVED || s == LOAN_DISBURSED
+}
+
+// SYSTEM_ACTOR is the actor of the transitions made by the engine itself, such
+// as a loan becoming PAID with its last installment.
+const SYSTEM_ACTOR = "system"
+
+var ErrIllegalLoanTransition = errors.New("illegal loan status transition")
+
+// LoanStatusTransition records who moved a loan from one status to another,
+// when and why.
+type LoanStatusTransition struct {
+	ID             uint64     `json:"id"`
+	LoanID         uint64     `json:"loan_id"`
+	FromStatus     LoanStatus `json:"from_status"`
+	ToStatus       LoanStatus `json:"to_status"`
+	Actor          string     `json:"actor"`
+	Reason         string     `json:"reason"`
+	TransitionedAt time.Time  `json:"transitioned_at"`
+}
+
+// NewLoanStatusTransition moves the loan to status to, failing with
+// ErrIllegalLoanTransition when its current status cannot lead there.
+func NewLoanStatusTransition(loan Loan, to LoanStatus, actor string, reason string, at time.Time) (LoanStatusTransition, error) {
+	if !loan.Status.CanTransitionTo(to) {
+		return LoanStatusTransition{}, fmt.Errorf("%w: loan %d cannot move from %s to %s", ErrIllegalLoanTransition, loan.ID, loan.Status, to)
+	}
+
+	return LoanStatusTransition{
+		LoanID:         loan.ID,
+		FromStatus:     loan.Status,
+		ToStatus:       to,
+		Actor:          actor,
+		Reason:         reason,
+		TransitionedAt: at,
+	}, nil
+}
+
+// DEFAULT_LOAN_PRODUCT is the product of loans booked without one.
+const DEFAULT_LOAN_PRODUCT = "STANDARD"
+
 type Loan struct {
 	ID              uint64          `json:"id"`
 	CustomerID      uint64          `json:"customer_id"`
@@ -22,17 +95,33 @@ type Loan struct {
 	TermWeeks       int64           `json:"term_weeks"`
 	StartDate       time.Time       `json:"start_date"`
 	Status          LoanStatus      `json:"status"`
+	ProductCode     string          `json:"product_code"`
+
+	// RequestedBy is the user who applied for the loan, who cannot approve it.
+	RequestedBy string `json:"requested_by,omitempty"`
+
+	// RestructuredFromLoanID links a rescheduled loan to the loan it replaced.
+	RestructuredFromLoanID uint64 `json:"restructured_from_loan_id,omitempty"`
+
+	// CreditLineID links a drawdown to the credit line it was drawn on.
+	CreditLineID uint64 `json:"credit_line_id,omitempty"`
+
+	// MerchantID and OrderRef tag a loan sold at a merchant's checkout.
+	MerchantID uint64 `json:"merchant_id,omitempty"`
+	OrderRef   string `json:"order_ref,omitempty"`
 }
 
 // For simplicity, i use a fixed loan amount and interest rate
-// and term weeks, the status is always INVESTED
-func NewDisbursedLoan(customerID uint64) *Loan {
+// and term weeks. The loan is APPLIED without a start date, which is set to
+// the actual disbursement date once it is disbursed.
+func NewLoanApplication(customerID uint64, requestedBy string) *Loan {
 	return &Loan{
 		CustomerID:      customerID,
 		PrincipalAmount: decimal.NewFromUint64(5000000),
 		InterestRate:    decimal.NewFromFloat(0.1),
 		TermWeeks:       50,
-		StartDate:       time.Now(),
-		Status:          LOAN_DISBURSED,
+		Status:          LOAN_APPLIED,
+		ProductCode:     DEFAULT_LOAN_PRODUCT,
+		RequestedBy:     requestedBy,
 	}
 }
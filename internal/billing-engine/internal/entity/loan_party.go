@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type LoanPartyRole string
+
+const (
+	LOAN_PARTY_CO_BORROWER LoanPartyRole = "CO_BORROWER" // jointly liable with the borrower
+	LOAN_PARTY_GUARANTOR   LoanPartyRole = "GUARANTOR"   // liable when the borrower defaults
+)
+
+// LoanParty is a customer linked to the loan of another customer.
+type LoanParty struct {
+	ID         uint64        `json:"id"`
+	LoanID     uint64        `json:"loan_id"`
+	CustomerID uint64        `json:"customer_id"`
+	Role       LoanPartyRole `json:"role"`
+	AddedBy    string        `json:"added_by"`
+	AddedAt    time.Time     `json:"added_at"`
+}
+
+// LoanObligation is what a customer may be liable for on an active loan they
+// are a party to, counted like their own loans: the principal while it is
+// not paid out, the unpaid installments and fees once disbursed.
+type LoanObligation struct {
+	LoanID     uint64          `json:"loan_id"`
+	BorrowerID uint64          `json:"borrower_id"`
+	Role       LoanPartyRole   `json:"role"`
+	LoanStatus LoanStatus      `json:"loan_status"`
+	Amount     decimal.Decimal `json:"amount"`
+}
+
+// HasParty tells whether one of parties takes role on the loan.
+func HasParty(parties []LoanParty, role LoanPartyRole) bool {
+	for _, party := range parties {
+		if party.Role == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SumObligations adds up the obligations taken in role.
+func SumObligations(obligations []LoanObligation, role LoanPartyRole) decimal.Decimal {
+	total := decimal.Zero
+	for _, obligation := range obligations {
+		if obligation.Role == role {
+			total = total.Add(obligation.Amount)
+		}
+	}
+
+	return total
+}
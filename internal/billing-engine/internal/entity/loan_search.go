@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type LoanSort string
+
+const (
+	LOAN_SORT_OLDEST LoanSort = "id" // snowflake IDs grow with the application time
+	LOAN_SORT_NEWEST LoanSort = "-id"
+)
+
+// LoanSearch filters and pages the loans.
+type LoanSearch struct {
+	CustomerID uint64     // any when zero
+	Status     LoanStatus // any when empty
+	Overdue    *bool      // any when nil, otherwise whether an installment due before AsOf is unpaid
+	StartFrom  time.Time  // no lower bound when zero
+	StartTo    time.Time  // no upper bound when zero, inclusive
+	AsOf       time.Time
+	Sort       LoanSort
+	After      *Cursor // first page when nil
+	Limit      int64
+}
+
+// CursorOf returns the position of loan in the search order.
+func (s LoanSearch) CursorOf(loan Loan) Cursor {
+	return Cursor{Sort: string(s.Sort), ID: loan.ID}
+}
+
+// LoanBalance sums up what is left to pay on a loan at a date.
+type LoanBalance struct {
+	Outstanding     decimal.Decimal // unpaid installments and their fees
+	Overdue         decimal.Decimal // the part of it due before the date
+	OldestUnpaidDue time.Time       // zero when nothing is overdue
+	NextDueDate     time.Time       // zero when nothing is left to pay
+	NextDueAmount   decimal.Decimal
+}
+
+// NewLoanBalance sums the unpaid installments of a loan, in any order, at
+// asOf. An installment is overdue from the day after its due date.
+func NewLoanBalance(installments []Installment, asOf time.Time) (LoanBalance, error) {
+	balance := LoanBalance{
+		Outstanding:   decimal.Zero,
+		Overdue:       decimal.Zero,
+		NextDueAmount: decimal.Zero,
+	}
+
+	for _, installment := range installments {
+		if installment.Status != INSTALLMENT_PENDING && installment.Status != INSTALLMENT_MISSED {
+			continue
+		}
+
+		amount, err := installment.TotalDue()
+		if err != nil {
+			return LoanBalance{}, err
+		}
+
+		dueOn, err := installment.DueOn()
+		if err != nil {
+			return LoanBalance{}, err
+		}
+
+		balance.Outstanding = balance.Outstanding.Add(amount)
+
+		if dueOn.Before(asOf) {
+			balance.Overdue = balance.Overdue.Add(amount)
+			if balance.OldestUnpaidDue.IsZero() || dueOn.Before(balance.OldestUnpaidDue) {
+				balance.OldestUnpaidDue = dueOn
+			}
+			continue
+		}
+
+		if balance.NextDueDate.IsZero() || dueOn.Before(balance.NextDueDate) {
+			balance.NextDueDate = dueOn
+			balance.NextDueAmount = amount
+		}
+	}
+
+	return balance, nil
+}
+
+// DaysPastDue counts the days between the oldest overdue installment and
+// asOf.
+func (b LoanBalance) DaysPastDue(asOf time.Time) int64 {
+	return LoanExposure{OldestUnpaidDue: b.OldestUnpaidDue}.DaysPastDue(asOf)
+}
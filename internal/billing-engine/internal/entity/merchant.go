@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Merchant is a partner whose checkout creates loans. The loans it sells are
+// paid out to it, less its merchant discount rate (MDR), in daily settlement
+// batches.
+type Merchant struct {
+	ID                uint64          `json:"id"`
+	Code              string          `json:"code"`
+	Name              string          `json:"name"`
+	MDRRate           decimal.Decimal `json:"mdr_rate"` // fraction of the sale kept, 0.025 for 2.5%
+	SettlementAccount BankAccount     `json:"settlement_account"`
+	CreatedBy         string          `json:"created_by"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+// MDR is the discount kept on a sale of amount, rounded to the cent.
+func (m Merchant) MDR(amount decimal.Decimal) decimal.Decimal {
+	return amount.Mul(m.MDRRate).Round(2)
+}
+
+type SettlementStatus string
+
+const (
+	SETTLEMENT_PENDING SettlementStatus = "PENDING" // batched, not transferred yet
+	SETTLEMENT_SENT    SettlementStatus = "SENT"    // transfer instructed, waiting for confirmation
+	SETTLEMENT_SETTLED SettlementStatus = "SETTLED" // the money reached the merchant
+	SETTLEMENT_FAILED  SettlementStatus = "FAILED"  // refused or bounced, can be sent again
+)
+
+// settlementTransitions lists the statuses each status can move to. A failed
+// settlement goes back to PENDING to be sent again.
+var settlementTransitions = map[SettlementStatus][]SettlementStatus{
+	SETTLEMENT_PENDING: {SETTLEMENT_SENT, SETTLEMENT_SETTLED, SETTLEMENT_FAILED},
+	SETTLEMENT_SENT:    {SETTLEMENT_SETTLED, SETTLEMENT_FAILED},
+	SETTLEMENT_FAILED:  {SETTLEMENT_PENDING},
+}
+
+// CanTransitionTo reports whether a settlement in status s can move to
+// status to.
+func (s SettlementStatus) CanTransitionTo(to SettlementStatus) bool {
+	for _, next := range settlementTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MerchantSettlementItem is what a merchant is owed for a loan it sold, from
+// the day the loan was paid out.
+type MerchantSettlementItem struct {
+	ID           uint64          `json:"id"`
+	SettlementID uint64          `json:"settlement_id"` // zero until batched
+	MerchantID   uint64          `json:"merchant_id"`
+	LoanID       uint64          `json:"loan_id"`
+	OrderRef     string          `json:"order_ref"`
+	SaleDate     time.Time       `json:"sale_date"`
+	GrossAmount  decimal.Decimal `json:"gross_amount"`
+	MDRAmount    decimal.Decimal `json:"mdr_amount"`
+	NetAmount    decimal.Decimal `json:"net_amount"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+// NewMerchantSettlementItem owes the merchant the gross amount paid out on
+// the loan less its MDR.
+func NewMerchantSettlementItem(id uint64, merchant Merchant, loan Loan, gross decimal.Decimal, saleDate time.Time, createdAt time.Time) MerchantSettlementItem {
+	mdr := merchant.MDR(gross)
+
+	return MerchantSettlementItem{
+		ID:          id,
+		MerchantID:  merchant.ID,
+		LoanID:      loan.ID,
+		OrderRef:    loan.OrderRef,
+		SaleDate:    saleDate,
+		GrossAmount: gross,
+		MDRAmount:   mdr,
+		NetAmount:   gross.Sub(mdr),
+		CreatedAt:   createdAt,
+	}
+}
+
+// MerchantSettlement is the daily batch paying a merchant what it is owed for
+// the loans it sold up to the settlement date.
+type MerchantSettlement struct {
+	ID             uint64                   `json:"id"`
+	MerchantID     uint64                   `json:"merchant_id"`
+	SettlementDate time.Time                `json:"settlement_date"`
+	GrossAmount    decimal.Decimal          `json:"gross_amount"`
+	MDRAmount      decimal.Decimal          `json:"mdr_amount"`
+	NetAmount      decimal.Decimal          `json:"net_amount"`
+	ItemCount      int64                    `json:"item_count"`
+	Status         SettlementStatus         `json:"status"`
+	Reference      string                   `json:"reference"`      // bank transfer reference
+	FailureReason  string                   `json:"failure_reason"` // set while FAILED
+	UpdatedBy      string                   `json:"updated_by"`
+	CreatedAt      time.Time                `json:"created_at"`
+	UpdatedAt      time.Time                `json:"updated_at"`
+	SettledAt      time.Time                `json:"settled_at"` // zero until SETTLED
+	Items          []MerchantSettlementItem `json:"items"`
+}
+
+// NewMerchantSettlement batches the items of a merchant for a settlement
+// date.
+func NewMerchantSettlement(id uint64, merchantID uint64, settlementDate time.Time, items []MerchantSettlementItem, createdBy string, createdAt time.Time) MerchantSettlement {
+	settlement := MerchantSettlement{
+		ID:             id,
+		MerchantID:     merchantID,
+		SettlementDate: settlementDate,
+		GrossAmount:    decimal.Zero,
+		MDRAmount:      decimal.Zero,
+		NetAmount:      decimal.Zero,
+		ItemCount:      int64(len(items)),
+		Status:         SETTLEMENT_PENDING,
+		UpdatedBy:      createdBy,
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt,
+	}
+
+	for _, item := range items {
+		item.SettlementID = id
+		settlement.GrossAmount = settlement.GrossAmount.Add(item.GrossAmount)
+		settlement.MDRAmount = settlement.MDRAmount.Add(item.MDRAmount)
+		settlement.NetAmount = settlement.NetAmount.Add(item.NetAmount)
+		settlement.Items = append(settlement.Items, item)
+	}
+
+	return settlement
+}
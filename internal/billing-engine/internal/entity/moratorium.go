@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type MoratoriumScope string
+
+const (
+	MORATORIUM_SCOPE_LOAN    MoratoriumScope = "LOAN"
+	MORATORIUM_SCOPE_SEGMENT MoratoriumScope = "SEGMENT"
+)
+
+// Moratorium pauses repayments between StartDate and EndDate (inclusive),
+// either for a single loan or for every DISBURSED loan of a segment. A segment
+// is the loans started between SegmentStartFrom and SegmentStartTo, an empty
+// bound leaving that side open.
+type Moratorium struct {
+	ID                   uint64          `json:"id"`
+	Scope                MoratoriumScope `json:"scope"`
+	LoanID               uint64          `json:"loan_id"`
+	SegmentStartFrom     time.Time       `json:"segment_start_from"`
+	SegmentStartTo       time.Time       `json:"segment_start_to"`
+	StartDate            time.Time       `json:"start_date"`
+	EndDate              time.Time       `json:"end_date"`
+	AccrueInterest       bool            `json:"accrue_interest"`
+	Reason               string          `json:"reason"`
+	ShiftWeeks           int64           `json:"shift_weeks"`
+	AffectedLoans        int64           `json:"affected_loans"`
+	AffectedInstallments int64           `json:"affected_installments"`
+	CreatedAt            time.Time       `json:"created_at"`
+}
+
+// MoratoriumInstallment records how a moratorium changed one installment.
+type MoratoriumInstallment struct {
+	ID              uint64            `json:"id"`
+	MoratoriumID    uint64            `json:"moratorium_id"`
+	InstallmentID   uint64            `json:"installment_id"`
+	LoanID          uint64            `json:"loan_id"`
+	WeekNumber      int64             `json:"week_number"`
+	PreviousStatus  InstallmentStatus `json:"previous_status"`
+	OriginalDueDate time.Time         `json:"original_due_date"`
+	NewDueDate      time.Time         `json:"new_due_date"`
+	OriginalAmount  decimal.Decimal   `json:"original_amount"`
+	NewAmount       decimal.Decimal   `json:"new_amount"`
+	AccruedInterest decimal.Decimal   `json:"accrued_interest"`
+}
+
+// HolidayDays is the number of days covered by the moratorium.
+func (m Moratorium) HolidayDays() int64 {
+	return int64(m.EndDate.Sub(m.StartDate).Hours()/24) + 1
+}
+
+// WeeksToShift is the number of whole weeks installments are pushed back so
+// none stays due inside the holiday and the weekly cadence is kept.
+func (m Moratorium) WeeksToShift() int64 {
+	return (m.HolidayDays() + 6) / 7
+}
+
+// Covers reports whether date falls inside the moratorium.
+func (m Moratorium) Covers(date time.Time) bool {
+	return !date.Before(m.StartDate) && !date.After(m.EndDate)
+}
+
+// HolidayInterest is the simple interest earned on outstanding over the
+// holiday, rounded to cents. flatRate is the loan's interest rate, charged
+// over its whole term of termWeeks, so it is spread per day of that term.
+func (m Moratorium) HolidayInterest(outstanding decimal.Decimal, flatRate decimal.Decimal, termWeeks int64) decimal.Decimal {
+	if termWeeks <= 0 {
+		return decimal.Zero
+	}
+
+	return outstanding.
+		Mul(flatRate).
+		Mul(decimal.NewFromInt(m.HolidayDays())).
+		Div(decimal.NewFromInt(termWeeks * 7)).
+		Round(2)
+}
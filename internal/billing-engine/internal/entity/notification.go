@@ -0,0 +1,207 @@
+package entity
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+	"time"
+)
+
+type NotificationChannel string
+
+const (
+	NOTIFICATION_CHANNEL_EMAIL    NotificationChannel = "EMAIL"
+	NOTIFICATION_CHANNEL_SMS      NotificationChannel = "SMS"
+	NOTIFICATION_CHANNEL_WHATSAPP NotificationChannel = "WHATSAPP"
+)
+
+type NotificationEvent string
+
+const (
+	NOTIFICATION_EVENT_REMINDER_T_MINUS_2     NotificationEvent = "REMINDER_T_MINUS_2"
+	NOTIFICATION_EVENT_REMINDER_DUE_DAY       NotificationEvent = "REMINDER_DUE_DAY"
+	NOTIFICATION_EVENT_MISSED_PAYMENT         NotificationEvent = "MISSED_PAYMENT"
+	NOTIFICATION_EVENT_DELINQUENCY_ESCALATION NotificationEvent = "DELINQUENCY_ESCALATION"
+)
+
+type CommunicationStatus string
+
+const (
+	COMMUNICATION_SENT   CommunicationStatus = "SENT"
+	COMMUNICATION_FAILED CommunicationStatus = "FAILED"
+)
+
+type Language string
+
+const (
+	LANGUAGE_INDONESIAN Language = "id"
+	LANGUAGE_ENGLISH    Language = "en"
+)
+
+// NotificationTarget is a borrower that should receive a notification for a
+// loan, optionally about one specific installment.
+type NotificationTarget struct {
+	LoanID        uint64
+	CustomerID    uint64
+	InstallmentID uint64
+	WeekNumber    int64
+	DueDate       time.Time
+	AmountDue     string
+	CustomerName  string
+	Email         string
+	Phone         string
+	Language      Language
+}
+
+// Recipient returns the address of the target on the given channel, or an
+// empty string when the borrower cannot be reached on it.
+func (t NotificationTarget) Recipient(channel NotificationChannel) string {
+	switch channel {
+	case NOTIFICATION_CHANNEL_EMAIL:
+		return t.Email
+	case NOTIFICATION_CHANNEL_SMS, NOTIFICATION_CHANNEL_WHATSAPP:
+		return t.Phone
+	default:
+		return ""
+	}
+}
+
+// NotificationMessage is a rendered message handed to a provider.
+type NotificationMessage struct {
+	Channel   NotificationChannel
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+// Communication is an entry of the per-loan communications log.
+type Communication struct {
+	ID                uint64              `json:"id"`
+	LoanID            uint64              `json:"loan_id"`
+	CustomerID        uint64              `json:"customer_id"`
+	InstallmentID     uint64              `json:"installment_id"`
+	Event             NotificationEvent   `json:"event"`
+	Channel           NotificationChannel `json:"channel"`
+	Language          Language            `json:"language"`
+	Recipient         string              `json:"recipient"`
+	Subject           string              `json:"subject"`
+	Body              string              `json:"body"`
+	Status            CommunicationStatus `json:"status"`
+	ProviderMessageID string              `json:"provider_message_id"`
+	ErrorMessage      string              `json:"error_message"`
+	EventKey          string              `json:"event_key"`
+	SentAt            time.Time           `json:"sent_at"`
+}
+
+// NotificationEventKey identifies one occurrence of an event on one channel so
+// the same notice is never sent twice. Installment events are keyed by the
+// installment, escalations by the loan and its latest missed week.
+func NotificationEventKey(event NotificationEvent, channel NotificationChannel, target NotificationTarget) string {
+	if target.InstallmentID != 0 {
+		return fmt.Sprintf("%s:%s:installment:%d", event, channel, target.InstallmentID)
+	}
+
+	return fmt.Sprintf("%s:%s:loan:%d:week:%d", event, channel, target.LoanID, target.WeekNumber)
+}
+
+type notificationTemplate struct {
+	subject string
+	body    string
+}
+
+var notificationTemplates = map[NotificationEvent]map[Language]notificationTemplate{
+	NOTIFICATION_EVENT_REMINDER_T_MINUS_2: {
+		LANGUAGE_INDONESIAN: {
+			subject: "Pengingat: angsuran minggu ke-{{.WeekNumber}} jatuh tempo dalam 2 hari",
+			body:    "Halo {{.CustomerName}}, angsuran pinjaman {{.LoanID}} minggu ke-{{.WeekNumber}} sebesar Rp {{.AmountDue}} jatuh tempo pada {{.DueDate}}. Mohon siapkan pembayaran Anda.",
+		},
+		LANGUAGE_ENGLISH: {
+			subject: "Reminder: week {{.WeekNumber}} installment is due in 2 days",
+			body:    "Hi {{.CustomerName}}, your loan {{.LoanID}} week {{.WeekNumber}} installment of Rp {{.AmountDue}} is due on {{.DueDate}}. Please get your payment ready.",
+		},
+	},
+	NOTIFICATION_EVENT_REMINDER_DUE_DAY: {
+		LANGUAGE_INDONESIAN: {
+			subject: "Angsuran minggu ke-{{.WeekNumber}} jatuh tempo hari ini",
+			body:    "Halo {{.CustomerName}}, angsuran pinjaman {{.LoanID}} minggu ke-{{.WeekNumber}} sebesar Rp {{.AmountDue}} jatuh tempo hari ini ({{.DueDate}}).",
+		},
+		LANGUAGE_ENGLISH: {
+			subject: "Week {{.WeekNumber}} installment is due today",
+			body:    "Hi {{.CustomerName}}, your loan {{.LoanID}} week {{.WeekNumber}} installment of Rp {{.AmountDue}} is due today ({{.DueDate}}).",
+		},
+	},
+	NOTIFICATION_EVENT_MISSED_PAYMENT: {
+		LANGUAGE_INDONESIAN: {
+			subject: "Angsuran minggu ke-{{.WeekNumber}} terlewat",
+			body:    "Halo {{.CustomerName}}, kami belum menerima angsuran pinjaman {{.LoanID}} minggu ke-{{.WeekNumber}} sebesar Rp {{.AmountDue}} yang jatuh tempo pada {{.DueDate}}. Mohon segera lakukan pembayaran.",
+		},
+		LANGUAGE_ENGLISH: {
+			subject: "Week {{.WeekNumber}} installment missed",
+			body:    "Hi {{.CustomerName}}, we have not received your loan {{.LoanID}} week {{.WeekNumber}} installment of Rp {{.AmountDue}} that was due on {{.DueDate}}. Please pay as soon as possible.",
+		},
+	},
+	NOTIFICATION_EVENT_DELINQUENCY_ESCALATION: {
+		LANGUAGE_INDONESIAN: {
+			subject: "Pinjaman {{.LoanID}} menunggak",
+			body:    "Halo {{.CustomerName}}, pinjaman {{.LoanID}} Anda telah melewatkan dua angsuran berturut-turut dan kini berstatus menunggak. Tim penagihan kami akan menghubungi Anda. Segera lunasi angsuran yang terlewat untuk menghindari tindakan lebih lanjut.",
+		},
+		LANGUAGE_ENGLISH: {
+			subject: "Loan {{.LoanID}} is delinquent",
+			body:    "Hi {{.CustomerName}}, your loan {{.LoanID}} has missed two consecutive installments and is now delinquent. Our collections team will contact you. Please settle the missed installments to avoid further action.",
+		},
+	},
+}
+
+// RenderNotification renders the subject and body of an event for the
+// target's language, falling back to Indonesian for unknown languages.
+func RenderNotification(event NotificationEvent, target NotificationTarget) (string, string, error) {
+	templates, ok := notificationTemplates[event]
+	if !ok {
+		return "", "", fmt.Errorf("no template for notification event %s", event)
+	}
+
+	tmpl, ok := templates[target.Language]
+	if !ok {
+		tmpl = templates[LANGUAGE_INDONESIAN]
+	}
+
+	data := struct {
+		CustomerName string
+		LoanID       uint64
+		WeekNumber   int64
+		AmountDue    string
+		DueDate      string
+	}{
+		CustomerName: target.CustomerName,
+		LoanID:       target.LoanID,
+		WeekNumber:   target.WeekNumber,
+		AmountDue:    target.AmountDue,
+		DueDate:      target.DueDate.Format("2006-01-02"),
+	}
+
+	subject, err := renderTemplate(tmpl.subject, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	body, err := renderTemplate(tmpl.body, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	return subject, body, nil
+}
+
+func renderTemplate(text string, data any) (string, error) {
+	tmpl, err := template.New("notification").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// DEFAULT_PAGE_SIZE and MAX_PAGE_SIZE bound the rows of a listing page.
+const (
+	DEFAULT_PAGE_SIZE = 50
+	MAX_PAGE_SIZE     = 200
+)
+
+// Cursor is the position of the last row of a page, the next page starts
+// right after it. Rows are ordered by Key when sorted on something else than
+// their snowflake ID, then by ID, so the position stays stable while rows
+// are added.
+type Cursor struct {
+	Sort string `json:"sort"`
+	Key  string `json:"key,omitempty"`
+	ID   uint64 `json:"id"`
+}
+
+// Encode returns the cursor as an opaque URL safe string.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor reads a cursor returned with a page sorted by sort.
+func DecodeCursor(value string, sort string) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor")
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == 0 {
+		return Cursor{}, fmt.Errorf("invalid cursor")
+	}
+
+	if cursor.Sort != sort {
+		return Cursor{}, fmt.Errorf("cursor was returned for sort %q, not %q", cursor.Sort, sort)
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,211 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ECLStage is the expected credit loss stage of a loan.
+type ECLStage int64
+
+const (
+	ECL_STAGE_1 ECLStage = 1 // performing
+	ECL_STAGE_2 ECLStage = 2 // significant increase in credit risk
+	ECL_STAGE_3 ECLStage = 3 // credit impaired
+)
+
+type ProvisionMovementType string
+
+const (
+	PROVISION_MOVEMENT_NEW           ProvisionMovementType = "NEW"           // loan provisioned for the first time
+	PROVISION_MOVEMENT_DERECOGNISED  ProvisionMovementType = "DERECOGNISED"  // loan paid, restructured or written off
+	PROVISION_MOVEMENT_DETERIORATION ProvisionMovementType = "DETERIORATION" // loan moved to a worse stage
+	PROVISION_MOVEMENT_IMPROVEMENT   ProvisionMovementType = "IMPROVEMENT"   // loan moved to a better stage
+	PROVISION_MOVEMENT_REMEASUREMENT ProvisionMovementType = "REMEASUREMENT" // loan stayed in its stage
+)
+
+// PROVISION_RATE_DEFAULT_PRODUCT holds the rates of every product that has
+// no rates of its own for a stage.
+const PROVISION_RATE_DEFAULT_PRODUCT = "DEFAULT"
+
+const (
+	stage2DaysPastDue = 30
+	stage3DaysPastDue = 90
+)
+
+// StageFor assigns the ECL stage from days past due. Restructured loans are
+// at least stage 2.
+func StageFor(daysPastDue int64, restructured bool) ECLStage {
+	switch {
+	case daysPastDue > stage3DaysPastDue:
+		return ECL_STAGE_3
+	case daysPastDue > stage2DaysPastDue || restructured:
+		return ECL_STAGE_2
+	default:
+		return ECL_STAGE_1
+	}
+}
+
+// ProvisionRate is the probability of default and loss given default applied
+// to the exposure of a product's loans in a stage.
+type ProvisionRate struct {
+	ProductCode string          `json:"product_code"`
+	Stage       ECLStage        `json:"stage"`
+	PD          decimal.Decimal `json:"pd"`
+	LGD         decimal.Decimal `json:"lgd"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// ProvisionRates resolves the rate of a product and stage.
+type ProvisionRates map[string]map[ECLStage]ProvisionRate
+
+func NewProvisionRates(rates []ProvisionRate) ProvisionRates {
+	resolved := make(ProvisionRates)
+	for _, rate := range rates {
+		if resolved[rate.ProductCode] == nil {
+			resolved[rate.ProductCode] = make(map[ECLStage]ProvisionRate)
+		}
+		resolved[rate.ProductCode][rate.Stage] = rate
+	}
+
+	return resolved
+}
+
+// Resolve returns the product's rate for the stage, falling back to the
+// default product.
+func (r ProvisionRates) Resolve(productCode string, stage ECLStage) (ProvisionRate, bool) {
+	if rate, ok := r[productCode][stage]; ok {
+		return rate, true
+	}
+
+	rate, ok := r[PROVISION_RATE_DEFAULT_PRODUCT][stage]
+	return rate, ok
+}
+
+// LoanExposure is a loan with its unpaid principal and the due date of its
+// oldest unpaid installment, zero when nothing is overdue.
+type LoanExposure struct {
+	Loan                 Loan
+	OutstandingPrincipal decimal.Decimal
+	OldestUnpaidDue      time.Time
+}
+
+// DaysPastDue counts the days between the oldest unpaid due date and asOf.
+func (e LoanExposure) DaysPastDue(asOf time.Time) int64 {
+	if e.OldestUnpaidDue.IsZero() {
+		return 0
+	}
+
+	days := int64(asOf.Sub(e.OldestUnpaidDue).Hours() / 24)
+	if days < 0 {
+		return 0
+	}
+
+	return days
+}
+
+// LoanProvision is the expected credit loss of a loan at a month end.
+type LoanProvision struct {
+	ID                uint64          `json:"id"`
+	Period            string          `json:"period"` // format YYYY-MM
+	LoanID            uint64          `json:"loan_id"`
+	CustomerID        uint64          `json:"customer_id"`
+	ProductCode       string          `json:"product_code"`
+	Stage             ECLStage        `json:"stage"`
+	DaysPastDue       int64           `json:"days_past_due"`
+	ExposureAtDefault decimal.Decimal `json:"exposure_at_default"`
+	PD                decimal.Decimal `json:"pd"`
+	LGD               decimal.Decimal `json:"lgd"`
+	ECL               decimal.Decimal `json:"ecl"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+// NewLoanProvision stages the exposure at asOf and measures its expected
+// credit loss as EAD x PD x LGD.
+func NewLoanProvision(id uint64, period string, exposure LoanExposure, asOf time.Time, rates ProvisionRates) (LoanProvision, error) {
+	loan := exposure.Loan
+	dpd := exposure.DaysPastDue(asOf)
+	stage := StageFor(dpd, loan.RestructuredFromLoanID != 0)
+
+	rate, ok := rates.Resolve(loan.ProductCode, stage)
+	if !ok {
+		return LoanProvision{}, fmt.Errorf("no provision rate for product %s stage %d", loan.ProductCode, stage)
+	}
+
+	return LoanProvision{
+		ID:                id,
+		Period:            period,
+		LoanID:            loan.ID,
+		CustomerID:        loan.CustomerID,
+		ProductCode:       loan.ProductCode,
+		Stage:             stage,
+		DaysPastDue:       dpd,
+		ExposureAtDefault: exposure.OutstandingPrincipal,
+		PD:                rate.PD,
+		LGD:               rate.LGD,
+		ECL:               exposure.OutstandingPrincipal.Mul(rate.PD).Mul(rate.LGD).Round(2),
+		CreatedAt:         time.Now(),
+	}, nil
+}
+
+// ProvisionMovement is the change in provision of the loans that moved the
+// same way between two month ends.
+type ProvisionMovement struct {
+	Type   ProvisionMovementType
+	Loans  int
+	Amount decimal.Decimal
+}
+
+// ProvisionMovements explains the change from the previous month's
+// provisions to the current ones. The amounts add up to the closing minus the
+// opening total.
+func ProvisionMovements(previous []LoanProvision, current []LoanProvision) []ProvisionMovement {
+	movements := []ProvisionMovement{
+		{Type: PROVISION_MOVEMENT_NEW, Amount: decimal.Zero},
+		{Type: PROVISION_MOVEMENT_DETERIORATION, Amount: decimal.Zero},
+		{Type: PROVISION_MOVEMENT_IMPROVEMENT, Amount: decimal.Zero},
+		{Type: PROVISION_MOVEMENT_REMEASUREMENT, Amount: decimal.Zero},
+		{Type: PROVISION_MOVEMENT_DERECOGNISED, Amount: decimal.Zero},
+	}
+	index := make(map[ProvisionMovementType]int, len(movements))
+	for i, movement := range movements {
+		index[movement.Type] = i
+	}
+
+	add := func(movementType ProvisionMovementType, amount decimal.Decimal) {
+		movement := &movements[index[movementType]]
+		movement.Loans++
+		movement.Amount = movement.Amount.Add(amount)
+	}
+
+	opening := make(map[uint64]LoanProvision, len(previous))
+	for _, provision := range previous {
+		opening[provision.LoanID] = provision
+	}
+
+	for _, provision := range current {
+		before, ok := opening[provision.LoanID]
+		delete(opening, provision.LoanID)
+
+		switch {
+		case !ok:
+			add(PROVISION_MOVEMENT_NEW, provision.ECL)
+		case provision.Stage > before.Stage:
+			add(PROVISION_MOVEMENT_DETERIORATION, provision.ECL.Sub(before.ECL))
+		case provision.Stage < before.Stage:
+			add(PROVISION_MOVEMENT_IMPROVEMENT, provision.ECL.Sub(before.ECL))
+		default:
+			add(PROVISION_MOVEMENT_REMEASUREMENT, provision.ECL.Sub(before.ECL))
+		}
+	}
+
+	for _, provision := range previous {
+		if _, ok := opening[provision.LoanID]; ok {
+			add(PROVISION_MOVEMENT_DERECOGNISED, provision.ECL.Neg())
+		}
+	}
+
+	return movements
+}
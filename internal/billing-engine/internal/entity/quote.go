@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ScheduledInstallment is an installment of a quoted loan.
+type ScheduledInstallment struct {
+	WeekNumber int64            `json:"week_number"`
+	DueDate    time.Time        `json:"due_date"`
+	Principal  decimal.Decimal  `json:"principal"`
+	Interest   decimal.Decimal  `json:"interest"`
+	FeeAmount  decimal.Decimal  `json:"fee_amount"`
+	Fees       []InstallmentFee `json:"fees"`
+}
+
+// TotalDue is the installment amount plus its fees.
+func (s ScheduledInstallment) TotalDue() decimal.Decimal {
+	return s.Principal.Add(s.Interest).Add(s.FeeAmount)
+}
+
+// LoanQuote is the full cost of a loan as shown to the borrower before they
+// accept it.
+type LoanQuote struct {
+	ProductCode         string                 `json:"product_code"`
+	PrincipalAmount     decimal.Decimal        `json:"principal_amount"`
+	InterestRate        decimal.Decimal        `json:"interest_rate"`
+	TermWeeks           int64                  `json:"term_weeks"`
+	AmountReceived      decimal.Decimal        `json:"amount_received"` // principal less the fees deducted at disbursement
+	TotalInterest       decimal.Decimal        `json:"total_interest"`
+	TotalFees           decimal.Decimal        `json:"total_fees"`
+	TotalRepayment      decimal.Decimal        `json:"total_repayment"`
+	EffectiveAnnualRate decimal.Decimal        `json:"effective_annual_rate"`
+	Fees                []LoanFee              `json:"fees"`
+	Schedule            []ScheduledInstallment `json:"schedule"`
+}
+
+// InstallmentAmounts spreads the principal and the flat interest of a loan
+// over its installments, the way its schedule is generated.
+func InstallmentAmounts(loan Loan) []decimal.Decimal {
+	total := loan.PrincipalAmount.Mul(decimal.NewFromInt(1).Add(loan.InterestRate))
+
+	return SplitEvenly(total, loan.TermWeeks)
+}
+
+// PreviewLoanFees charges the product fees on a loan that is not booked yet.
+func PreviewLoanFees(loan Loan, productFees []ProductFee, at time.Time) []LoanFee {
+	var fees []LoanFee
+	for _, productFee := range productFees {
+		fee := NewLoanFee(0, loan, productFee, at)
+		if fee.Amount.IsPositive() {
+			fees = append(fees, fee)
+		}
+	}
+
+	return fees
+}
+
+// QuoteLoan simulates the schedule of loan disbursed on startDate with fees
+// charged. It fails when the fees leave nothing to pay out.
+func QuoteLoan(loan Loan, fees []LoanFee, startDate time.Time) (LoanQuote, error) {
+	received, payments := LoanCashFlows(loan, fees)
+
+	effectiveRate, err := EffectiveAnnualRate(received, payments)
+	if err != nil {
+		return LoanQuote{}, err
+	}
+
+	quote := LoanQuote{
+		ProductCode:         loan.ProductCode,
+		PrincipalAmount:     loan.PrincipalAmount,
+		InterestRate:        loan.InterestRate,
+		TermWeeks:           loan.TermWeeks,
+		AmountReceived:      received,
+		TotalInterest:       decimal.Zero,
+		TotalFees:           TotalFees(fees, FEE_DEDUCTED).Add(TotalFees(fees, FEE_AMORTISED)),
+		TotalRepayment:      decimal.Zero,
+		EffectiveAnnualRate: effectiveRate,
+		Fees:                fees,
+	}
+
+	// The principal is spread like the installments, so the interest of the
+	// schedule adds up to the flat interest of the loan
+	principals := SplitEvenly(loan.PrincipalAmount, loan.TermWeeks)
+	feeSchedule := FeeSchedule(fees, loan.TermWeeks)
+	for i, amount := range InstallmentAmounts(loan) {
+		week := int64(i + 1)
+		interest := amount.Sub(principals[i])
+
+		installment := ScheduledInstallment{
+			WeekNumber: week,
+			DueDate:    startDate.AddDate(0, 0, int(week*7)),
+			Principal:  principals[i],
+			Interest:   interest,
+			FeeAmount:  TotalInstallmentFees(feeSchedule[i]),
+			Fees:       feeSchedule[i],
+		}
+
+		quote.TotalInterest = quote.TotalInterest.Add(interest)
+		quote.TotalRepayment = quote.TotalRepayment.Add(installment.TotalDue())
+		quote.Schedule = append(quote.Schedule, installment)
+	}
+
+	return quote, nil
+}
+
+// LoanDisclosure records the terms disclosed to the borrower when they
+// applied for a loan.
+type LoanDisclosure struct {
+	LoanID              uint64          `json:"loan_id"`
+	ProductCode         string          `json:"product_code"`
+	PrincipalAmount     decimal.Decimal `json:"principal_amount"`
+	InterestRate        decimal.Decimal `json:"interest_rate"`
+	TermWeeks           int64           `json:"term_weeks"`
+	AmountReceived      decimal.Decimal `json:"amount_received"`
+	TotalInterest       decimal.Decimal `json:"total_interest"`
+	TotalFees           decimal.Decimal `json:"total_fees"`
+	TotalRepayment      decimal.Decimal `json:"total_repayment"`
+	EffectiveAnnualRate decimal.Decimal `json:"effective_annual_rate"`
+	DisclosedAt         time.Time       `json:"disclosed_at"`
+}
+
+func NewLoanDisclosure(loanID uint64, quote LoanQuote, at time.Time) LoanDisclosure {
+	return LoanDisclosure{
+		LoanID:              loanID,
+		ProductCode:         quote.ProductCode,
+		PrincipalAmount:     quote.PrincipalAmount,
+		InterestRate:        quote.InterestRate,
+		TermWeeks:           quote.TermWeeks,
+		AmountReceived:      quote.AmountReceived,
+		TotalInterest:       quote.TotalInterest,
+		TotalFees:           quote.TotalFees,
+		TotalRepayment:      quote.TotalRepayment,
+		EffectiveAnnualRate: quote.EffectiveAnnualRate,
+		DisclosedAt:         at,
+	}
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type RestructureType string
+
+const (
+	RESTRUCTURE_EXTEND_TERM        RestructureType = "EXTEND_TERM"
+	RESTRUCTURE_REDUCE_INSTALLMENT RestructureType = "REDUCE_INSTALLMENT"
+	RESTRUCTURE_CAPITALISE_ARREARS RestructureType = "CAPITALISE_ARREARS"
+)
+
+// MAX_TERM_WEEKS is the longest schedule a loan can have.
+const MAX_TERM_WEEKS = 520
+
+// LoanRestructure is the audit record linking a restructured loan to the loan
+// that carries its new schedule.
+type LoanRestructure struct {
+	ID                   uint64          `json:"id"`
+	LoanID               uint64          `json:"loan_id"`
+	NewLoanID            uint64          `json:"new_loan_id"`
+	Type                 RestructureType `json:"type"`
+	OutstandingAmount    decimal.Decimal `json:"outstanding_amount"`
+	ArrearsAmount        decimal.Decimal `json:"arrears_amount"`
+	ClosedInstallments   int64           `json:"closed_installments"`
+	NewTermWeeks         int64           `json:"new_term_weeks"`
+	NewInstallmentAmount decimal.Decimal `json:"new_installment_amount"`
+	Reason               string          `json:"reason"`
+	RestructuredAt       time.Time       `json:"restructured_at"`
+}
+
+// SplitEvenly spreads total over termWeeks installments rounded to cents, the
+// last installment absorbing the rounding difference.
+func SplitEvenly(total decimal.Decimal, termWeeks int64) []decimal.Decimal {
+	if termWeeks <= 0 {
+		return nil
+	}
+
+	amount := total.Div(decimal.NewFromInt(termWeeks)).RoundDown(2)
+
+	return splitByAmount(total, amount, termWeeks)
+}
+
+// SplitByInstallmentAmount schedules total in installments of amount, the
+// last installment carrying whatever is left.
+func SplitByInstallmentAmount(total decimal.Decimal, amount decimal.Decimal) []decimal.Decimal {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	termWeeks := total.Div(amount).Ceil().IntPart()
+
+	return splitByAmount(total, amount, termWeeks)
+}
+
+func splitByAmount(total decimal.Decimal, amount decimal.Decimal, termWeeks int64) []decimal.Decimal {
+	if termWeeks <= 0 {
+		return nil
+	}
+
+	amounts := make([]decimal.Decimal, termWeeks)
+	for i := range amounts {
+		amounts[i] = amount
+	}
+	amounts[termWeeks-1] = total.Sub(amount.Mul(decimal.NewFromInt(termWeeks - 1)))
+
+	return amounts
+}
+
+// NewWeeklySchedule builds PENDING installments for loanID, the first one due
+// a week after startDate.
+func NewWeeklySchedule(loanID uint64, startDate time.Time, amounts []decimal.Decimal) []Installment {
+	installments := make([]Installment, len(amounts))
+	for i, amount := range amounts {
+		week := int64(i + 1)
+		installments[i] = Installment{
+			LoanID:     loanID,
+			WeekNumber: week,
+			DueDate:    startDate.AddDate(0, 0, int(week*7)).Format("2006-01-02"),
+			AmountDue:  amount.StringFixed(2),
+			FeeAmount:  decimal.Zero.StringFixed(2),
+			Status:     INSTALLMENT_PENDING,
+		}
+	}
+
+	return installments
+}
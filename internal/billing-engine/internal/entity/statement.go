@@ -0,0 +1,185 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type StatementLineType string
+
+const (
+	STATEMENT_CHARGE    StatementLineType = "CHARGE" // principal disbursed, fees charged and interest accrued
+	STATEMENT_PAYMENT   StatementLineType = "PAYMENT"
+	STATEMENT_REVERSAL  StatementLineType = "REVERSAL"
+	STATEMENT_WRITE_OFF StatementLineType = "WRITE_OFF"
+)
+
+// StatementAccounts are the ledger accounts holding what a borrower owes.
+// Recoveries on written-off loans do not touch them and are not listed.
+var StatementAccounts = []string{
+	ACCOUNT_PRINCIPAL_RECEIVABLE,
+	ACCOUNT_INTEREST_RECEIVABLE,
+	ACCOUNT_FEE_RECEIVABLE,
+}
+
+// StatementLine is a journal entry as the borrower sees it. A debit adds to
+// what they owe, a credit takes from it.
+type StatementLine struct {
+	Date        time.Time
+	LoanID      uint64
+	Type        StatementLineType
+	Reference   string
+	Description string
+	Debit       decimal.Decimal
+	Credit      decimal.Decimal
+	Balance     decimal.Decimal // owed after the line
+}
+
+// Statement lists the movements of what a customer owes over a date range.
+// The closing balance is the opening balance plus the charges, less the
+// payments, plus the reversals, less the write-offs.
+type Statement struct {
+	CustomerID     uint64
+	LoanID         uint64 // zero for every loan of the customer
+	From           time.Time
+	To             time.Time
+	OpeningBalance decimal.Decimal
+	TotalCharges   decimal.Decimal
+	TotalPayments  decimal.Decimal
+	TotalReversals decimal.Decimal
+	TotalWriteOffs decimal.Decimal
+	ClosingBalance decimal.Decimal
+	Lines          []StatementLine
+}
+
+// NewStatement builds the statement of the entries effective from from to to
+// on top of the opening balance. Interest accrues daily, so the accruals of a
+// loan are shown as one charge per month, dated on the last day accrued.
+func NewStatement(customerID uint64, loanID uint64, from time.Time, to time.Time, openingBalance decimal.Decimal, entries []JournalEntry) Statement {
+	statement := Statement{
+		CustomerID:     customerID,
+		LoanID:         loanID,
+		From:           from,
+		To:             to,
+		OpeningBalance: openingBalance,
+		TotalCharges:   decimal.Zero,
+		TotalPayments:  decimal.Zero,
+		TotalReversals: decimal.Zero,
+		TotalWriteOffs: decimal.Zero,
+	}
+
+	accruals := make(map[string]int)
+	for _, entry := range entries {
+		debit, credit := statementAmounts(entry)
+		if debit.IsZero() && credit.IsZero() {
+			continue
+		}
+
+		if entry.Event == JOURNAL_ACCRUAL {
+			month := entry.EffectiveDate.Format("2006-01")
+			key := fmt.Sprintf("%d/%s", entry.LoanID, month)
+			if index, ok := accruals[key]; ok {
+				line := &statement.Lines[index]
+				line.Debit = line.Debit.Add(debit)
+				line.Credit = line.Credit.Add(credit)
+				if entry.EffectiveDate.After(line.Date) {
+					line.Date = entry.EffectiveDate
+				}
+				continue
+			}
+
+			accruals[key] = len(statement.Lines)
+			statement.Lines = append(statement.Lines, StatementLine{
+				Date:        entry.EffectiveDate,
+				LoanID:      entry.LoanID,
+				Type:        STATEMENT_CHARGE,
+				Reference:   "accrual-" + month,
+				Description: "Interest accrued " + month,
+				Debit:       debit,
+				Credit:      credit,
+			})
+			continue
+		}
+
+		statement.Lines = append(statement.Lines, StatementLine{
+			Date:        entry.EffectiveDate,
+			LoanID:      entry.LoanID,
+			Type:        statementLineType(entry.Event),
+			Reference:   entry.Reference,
+			Description: entry.Description,
+			Debit:       debit,
+			Credit:      credit,
+		})
+	}
+
+	sort.SliceStable(statement.Lines, func(i, j int) bool {
+		return statement.Lines[i].Date.Before(statement.Lines[j].Date)
+	})
+
+	balance := openingBalance
+	for i := range statement.Lines {
+		line := &statement.Lines[i]
+		change := line.Debit.Sub(line.Credit)
+		balance = balance.Add(change)
+		line.Balance = balance
+
+		switch line.Type {
+		case STATEMENT_CHARGE:
+			statement.TotalCharges = statement.TotalCharges.Add(change)
+		case STATEMENT_PAYMENT:
+			statement.TotalPayments = statement.TotalPayments.Sub(change)
+		case STATEMENT_REVERSAL:
+			statement.TotalReversals = statement.TotalReversals.Add(change)
+		case STATEMENT_WRITE_OFF:
+			statement.TotalWriteOffs = statement.TotalWriteOffs.Sub(change)
+		}
+	}
+	statement.ClosingBalance = balance
+
+	return statement
+}
+
+// statementAmounts sums the debits and credits of the entry on the accounts
+// of what the borrower owes.
+func statementAmounts(entry JournalEntry) (decimal.Decimal, decimal.Decimal) {
+	debit, credit := decimal.Zero, decimal.Zero
+	for _, posting := range entry.Postings {
+		if !isStatementAccount(posting.AccountCode) {
+			continue
+		}
+
+		if posting.Direction == POSTING_DEBIT {
+			debit = debit.Add(posting.Amount)
+		} else {
+			credit = credit.Add(posting.Amount)
+		}
+	}
+
+	return debit, credit
+}
+
+func isStatementAccount(accountCode string) bool {
+	for _, code := range StatementAccounts {
+		if code == accountCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+func statementLineType(event JournalEvent) StatementLineType {
+	switch event {
+	case JOURNAL_PAYMENT:
+		return STATEMENT_PAYMENT
+	case JOURNAL_REVERSAL:
+		return STATEMENT_REVERSAL
+	case JOURNAL_WRITE_OFF, JOURNAL_ACCRUAL_REVERSAL:
+		return STATEMENT_WRITE_OFF
+	default:
+		return STATEMENT_CHARGE
+	}
+}
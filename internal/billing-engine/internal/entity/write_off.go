@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// WriteOff records the unpaid balance of a loan taken off the books.
+type WriteOff struct {
+	ID              uint64          `json:"id"`
+	LoanID          uint64          `json:"loan_id"`
+	CustomerID      uint64          `json:"customer_id"`
+	PrincipalAmount decimal.Decimal `json:"principal_amount"`
+	InterestAmount  decimal.Decimal `json:"interest_amount"`
+	FeeAmount       decimal.Decimal `json:"fee_amount"`
+	Reason          string          `json:"reason"`
+	WrittenOffBy    string          `json:"written_off_by"`
+	WrittenOffAt    time.Time       `json:"written_off_at"`
+}
+
+// Total is the whole balance written off.
+func (w WriteOff) Total() decimal.Decimal {
+	return w.PrincipalAmount.Add(w.InterestAmount).Add(w.FeeAmount)
+}
+
+// Recovery is money received on a loan after it was written off.
+type Recovery struct {
+	ID         uint64          `json:"id"`
+	LoanID     uint64          `json:"loan_id"`
+	WriteOffID uint64          `json:"write_off_id"`
+	Amount     decimal.Decimal `json:"amount"`
+	ReceivedAt time.Time       `json:"received_at"`
+}
+
+// LoanRecoverySummary aggregates the recoveries of one loan over a period.
+type LoanRecoverySummary struct {
+	LoanID          uint64
+	CustomerID      uint64
+	WrittenOff      decimal.Decimal
+	Recovered       decimal.Decimal
+	RecoveriesCount int64
+}
+
+// SplitPrincipalInterest splits an installment amount built as principal
+// plus flat interest at annualRate into its principal and interest parts.
+func SplitPrincipalInterest(amount decimal.Decimal, annualRate decimal.Decimal) (decimal.Decimal, decimal.Decimal) {
+	principal := amount.Div(decimal.NewFromInt(1).Add(annualRate)).Round(2)
+
+	return principal, amount.Sub(principal)
+}
@@ -0,0 +1,42 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	getAccountingPeriodsPath  = "/accounting/periods"
+	checkAccountingPeriodPath = "/accounting/period/checks"
+	closeAccountingPeriodPath = "/accounting/period/close"
+)
+
+func NewAccountingPeriodHTTPGateway(
+	httpRouter *httprouter.Router,
+	accountingPeriodEndpoint *AccountingPeriodEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getAccountingPeriodsPath,
+		server.Serve(accountingPeriodEndpoint.GetAccountingPeriods),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+checkAccountingPeriodPath,
+		server.Serve(accountingPeriodEndpoint.CheckAccountingPeriod),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+closeAccountingPeriodPath,
+		server.Serve(accountingPeriodEndpoint.CloseAccountingPeriod),
+	)
+}
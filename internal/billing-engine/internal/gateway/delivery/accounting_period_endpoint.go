@@ -0,0 +1,99 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// AccountingPeriodEndpoint serves the month-end close: the closed periods,
+// the pre-close checks and the close itself.
+type AccountingPeriodEndpoint struct {
+	getAccountingPeriodsUsecase  usecases.GetAccountingPeriodsUsecase
+	checkAccountingPeriodUsecase usecases.CheckAccountingPeriodUsecase
+	closeAccountingPeriodUsecase usecases.CloseAccountingPeriodUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewAccountingPeriodEndpoint(
+	getAccountingPeriodsUsecase usecases.GetAccountingPeriodsUsecase,
+	checkAccountingPeriodUsecase usecases.CheckAccountingPeriodUsecase,
+	closeAccountingPeriodUsecase usecases.CloseAccountingPeriodUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *AccountingPeriodEndpoint {
+	return &AccountingPeriodEndpoint{
+		getAccountingPeriodsUsecase:  getAccountingPeriodsUsecase,
+		checkAccountingPeriodUsecase: checkAccountingPeriodUsecase,
+		closeAccountingPeriodUsecase: closeAccountingPeriodUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (a *AccountingPeriodEndpoint) GetAccountingPeriods(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	output, err := a.getAccountingPeriodsUsecase.Execute(ctx)
+	if err != nil {
+		a.logger.Errorw("failed to get accounting periods", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (a *AccountingPeriodEndpoint) CheckAccountingPeriod(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	input := usecases.CheckAccountingPeriodInput{
+		Month: request.URL().Query().Get("month"),
+	}
+
+	if err := a.validator.Struct(input); err != nil {
+		a.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := a.checkAccountingPeriodUsecase.Execute(ctx, input)
+	if err != nil {
+		a.logger.Errorw("failed to check accounting period", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (a *AccountingPeriodEndpoint) CloseAccountingPeriod(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.CloseAccountingPeriodInput
+	if err := request.Decode(&input); err != nil {
+		a.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := a.validator.Struct(input); err != nil {
+		a.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := a.closeAccountingPeriodUsecase.Execute(ctx, input)
+	if err != nil {
+		a.logger.Errorw("failed to close accounting period", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,35 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	runInterestAccrualPath  = "/accrual/run"
+	getInterestAccrualsPath = "/accruals"
+)
+
+func NewAccrualHTTPGateway(
+	httpRouter *httprouter.Router,
+	accrualEndpoint *AccrualEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+runInterestAccrualPath,
+		server.Serve(accrualEndpoint.RunInterestAccrual),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getInterestAccrualsPath,
+		server.Serve(accrualEndpoint.GetInterestAccruals),
+	)
+}
@@ -0,0 +1,94 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// AccrualEndpoint serves the daily interest accrual job and its history.
+type AccrualEndpoint struct {
+	runInterestAccrualUsecase  usecases.RunInterestAccrualUsecase
+	getInterestAccrualsUsecase usecases.GetInterestAccrualsUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewAccrualEndpoint(
+	runInterestAccrualUsecase usecases.RunInterestAccrualUsecase,
+	getInterestAccrualsUsecase usecases.GetInterestAccrualsUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *AccrualEndpoint {
+	return &AccrualEndpoint{
+		runInterestAccrualUsecase:  runInterestAccrualUsecase,
+		getInterestAccrualsUsecase: getInterestAccrualsUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (a *AccrualEndpoint) RunInterestAccrual(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.RunInterestAccrualInput
+	if err := request.Decode(&input); err != nil {
+		a.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := a.validator.Struct(input); err != nil {
+		a.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := a.runInterestAccrualUsecase.Execute(ctx, input)
+	if err != nil {
+		a.logger.Errorw("failed to run interest accrual", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (a *AccrualEndpoint) GetInterestAccruals(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	query := request.URL().Query()
+	input := usecases.GetInterestAccrualsInput{
+		From: query.Get("from"),
+		To:   query.Get("to"),
+	}
+
+	if loanID := query.Get("loan_id"); loanID != "" {
+		loanIDUint, err := strconv.ParseUint(loanID, 10, 64)
+		if err != nil {
+			a.logger.Errorw("failed to parse loan_id", "error", err)
+			return nil, pkgerror.ValidationErrorFrom(err)
+		}
+		input.LoanID = loanIDUint
+	}
+
+	if err := a.validator.Struct(input); err != nil {
+		a.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := a.getInterestAccrualsUsecase.Execute(ctx, input)
+	if err != nil {
+		a.logger.Errorw("failed to get interest accruals", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -81,8 +81,31 @@ func (b *BillingEngineEndpoint) GetAllCustomer(
 	ctx context.Context,
 	request pkghttp.Request,
 ) (any, error) {
+	query := request.URL().Query()
+	input := usecases.GetAllCustomerInput{
+		Cursor:        query.Get("cursor"),
+		Name:          query.Get("name"),
+		Email:         query.Get("email"),
+		KYCStatus:     query.Get("kyc_status"),
+		HasActiveLoan: query.Get("has_active_loan"),
+		Sort:          query.Get("sort"),
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		limitInt, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil {
+			b.logger.Errorw("failed to parse limit", "error", err)
+			return nil, pkgerror.ValidationErrorFrom(err)
+		}
+		input.Limit = limitInt
+	}
 
-	output, err := b.getAllCustomerUsecase.Execute(ctx)
+	if err := b.validator.Struct(input); err != nil {
+		b.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := b.getAllCustomerUsecase.Execute(ctx, input)
 	if err != nil {
 		b.logger.Errorw("failed to get all customer", "error", err)
 
@@ -96,9 +119,7 @@ func (b *BillingEngineEndpoint) CreateLoan(
 	ctx context.Context,
 	request pkghttp.Request,
 ) (any, error) {
-	var input struct {
-		CustomerID uint64 `json:"customer_id" validate:"required"`
-	}
+	var input usecases.CreateLoanInput
 	if err := request.Decode(&input); err != nil {
 		b.logger.Errorw("failed to decode request", "error", err)
 		return nil, pkgerror.ValidationErrorFrom(err)
@@ -109,9 +130,9 @@ func (b *BillingEngineEndpoint) CreateLoan(
 		return nil, pkgerror.ValidationErrorFrom(err)
 	}
 
-	output, err := b.createLoanUsecase.Execute(ctx, input.CustomerID)
+	output, err := b.createLoanUsecase.Execute(ctx, input)
 	if err != nil {
-		b.logger.Errorw("failed to create loan", "error", err)
+		b.logger.Errorw("failed to apply for loan", "error", err)
 		return nil, err
 	}
 
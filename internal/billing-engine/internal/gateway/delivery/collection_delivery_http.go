@@ -0,0 +1,63 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	createCollectionAgentPath     = "/collection/agent"
+	generateCollectionCasesPath   = "/collection/cases/generate"
+	getCollectionCasePath         = "/collection/case/:case_id"
+	getCollectionCasesByAgentPath = "/collection/agent/:agent_id/cases"
+	logContactAttemptPath         = "/collection/contact-attempt"
+	evaluatePromisesToPayPath     = "/collection/promises/evaluate"
+)
+
+func NewCollectionHTTPGateway(
+	httpRouter *httprouter.Router,
+	collectionEndpoint *CollectionEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+createCollectionAgentPath,
+		server.Serve(collectionEndpoint.CreateCollectionAgent),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+generateCollectionCasesPath,
+		server.Serve(collectionEndpoint.GenerateCollectionCases),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getCollectionCasePath,
+		server.Serve(collectionEndpoint.GetCollectionCase),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getCollectionCasesByAgentPath,
+		server.Serve(collectionEndpoint.GetCollectionCasesByAgent),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+logContactAttemptPath,
+		server.Serve(collectionEndpoint.LogContactAttempt),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+evaluatePromisesToPayPath,
+		server.Serve(collectionEndpoint.EvaluatePromisesToPay),
+	)
+}
@@ -0,0 +1,189 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+type CollectionEndpoint struct {
+	createCollectionAgentUsecase     usecases.CreateCollectionAgentUsecase
+	generateCollectionCasesUsecase   usecases.GenerateCollectionCasesUsecase
+	getCollectionCaseUsecase         usecases.GetCollectionCaseUsecase
+	getCollectionCasesByAgentUsecase usecases.GetCollectionCasesByAgentUsecase
+	logContactAttemptUsecase         usecases.LogContactAttemptUsecase
+	evaluatePromisesToPayUsecase     usecases.EvaluatePromisesToPayUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewCollectionEndpoint(
+	createCollectionAgentUsecase usecases.CreateCollectionAgentUsecase,
+	generateCollectionCasesUsecase usecases.GenerateCollectionCasesUsecase,
+	getCollectionCaseUsecase usecases.GetCollectionCaseUsecase,
+	getCollectionCasesByAgentUsecase usecases.GetCollectionCasesByAgentUsecase,
+	logContactAttemptUsecase usecases.LogContactAttemptUsecase,
+	evaluatePromisesToPayUsecase usecases.EvaluatePromisesToPayUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *CollectionEndpoint {
+	return &CollectionEndpoint{
+		createCollectionAgentUsecase:     createCollectionAgentUsecase,
+		generateCollectionCasesUsecase:   generateCollectionCasesUsecase,
+		getCollectionCaseUsecase:         getCollectionCaseUsecase,
+		getCollectionCasesByAgentUsecase: getCollectionCasesByAgentUsecase,
+		logContactAttemptUsecase:         logContactAttemptUsecase,
+		evaluatePromisesToPayUsecase:     evaluatePromisesToPayUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (c *CollectionEndpoint) CreateCollectionAgent(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.CreateCollectionAgentInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.createCollectionAgentUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to create collection agent", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CollectionEndpoint) GenerateCollectionCases(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.GenerateCollectionCasesInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.generateCollectionCasesUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to generate collection cases", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CollectionEndpoint) GetCollectionCase(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	caseID := params.ByName("case_id")
+
+	caseIDUint, err := strconv.ParseUint(caseID, 10, 64)
+	if err != nil {
+		c.logger.Errorw("failed to parse case_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.getCollectionCaseUsecase.Execute(ctx, caseIDUint)
+	if err != nil {
+		c.logger.Errorw("failed to get collection case", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CollectionEndpoint) GetCollectionCasesByAgent(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	agentID := params.ByName("agent_id")
+
+	agentIDUint, err := strconv.ParseUint(agentID, 10, 64)
+	if err != nil {
+		c.logger.Errorw("failed to parse agent_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.getCollectionCasesByAgentUsecase.Execute(ctx, agentIDUint)
+	if err != nil {
+		c.logger.Errorw("failed to get collection cases by agent", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CollectionEndpoint) LogContactAttempt(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.LogContactAttemptInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.logContactAttemptUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to log contact attempt", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CollectionEndpoint) EvaluatePromisesToPay(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.EvaluatePromisesToPayInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.evaluatePromisesToPayUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to evaluate promises to pay", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	openCreditLinePath             = "/credit-line"
+	drawCreditLinePath             = "/credit-line/drawdown"
+	getCreditLinePath              = "/credit-line/:credit_line_id"
+	generateCreditLineBillPath     = "/credit-line/bill"
+	payCreditLineBillPath          = "/credit-line/bill/pay"
+	markOverdueCreditLineBillsPath = "/credit-line/bill/overdue"
+	getCreditLineBillsPath         = "/credit-line/:credit_line_id/bills"
+)
+
+func NewCreditLineHTTPGateway(
+	httpRouter *httprouter.Router,
+	creditLineEndpoint *CreditLineEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+openCreditLinePath,
+		server.Serve(creditLineEndpoint.OpenCreditLine),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+drawCreditLinePath,
+		server.Serve(creditLineEndpoint.DrawCreditLine),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getCreditLinePath,
+		server.Serve(creditLineEndpoint.GetCreditLine),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+generateCreditLineBillPath,
+		server.Serve(creditLineEndpoint.GenerateCreditLineBill),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+payCreditLineBillPath,
+		server.Serve(creditLineEndpoint.PayCreditLineBill),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+markOverdueCreditLineBillsPath,
+		server.Serve(creditLineEndpoint.MarkOverdueCreditLineBills),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getCreditLineBillsPath,
+		server.Serve(creditLineEndpoint.GetCreditLineBills),
+	)
+}
@@ -0,0 +1,220 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+type CreditLineEndpoint struct {
+	openCreditLineUsecase             usecases.OpenCreditLineUsecase
+	drawCreditLineUsecase             usecases.DrawCreditLineUsecase
+	getCreditLineUsecase              usecases.GetCreditLineUsecase
+	generateCreditLineBillUsecase     usecases.GenerateCreditLineBillUsecase
+	payCreditLineBillUsecase          usecases.PayCreditLineBillUsecase
+	markOverdueCreditLineBillsUsecase usecases.MarkOverdueCreditLineBillsUsecase
+	getCreditLineBillsUsecase         usecases.GetCreditLineBillsUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewCreditLineEndpoint(
+	openCreditLineUsecase usecases.OpenCreditLineUsecase,
+	drawCreditLineUsecase usecases.DrawCreditLineUsecase,
+	getCreditLineUsecase usecases.GetCreditLineUsecase,
+	generateCreditLineBillUsecase usecases.GenerateCreditLineBillUsecase,
+	payCreditLineBillUsecase usecases.PayCreditLineBillUsecase,
+	markOverdueCreditLineBillsUsecase usecases.MarkOverdueCreditLineBillsUsecase,
+	getCreditLineBillsUsecase usecases.GetCreditLineBillsUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *CreditLineEndpoint {
+	return &CreditLineEndpoint{
+		openCreditLineUsecase:             openCreditLineUsecase,
+		drawCreditLineUsecase:             drawCreditLineUsecase,
+		getCreditLineUsecase:              getCreditLineUsecase,
+		generateCreditLineBillUsecase:     generateCreditLineBillUsecase,
+		payCreditLineBillUsecase:          payCreditLineBillUsecase,
+		markOverdueCreditLineBillsUsecase: markOverdueCreditLineBillsUsecase,
+		getCreditLineBillsUsecase:         getCreditLineBillsUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (c *CreditLineEndpoint) OpenCreditLine(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.OpenCreditLineInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.openCreditLineUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to open credit line", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CreditLineEndpoint) DrawCreditLine(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.DrawCreditLineInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.drawCreditLineUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to draw credit line", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CreditLineEndpoint) GetCreditLine(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	creditLineID, err := c.creditLineID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := c.getCreditLineUsecase.Execute(ctx, creditLineID)
+	if err != nil {
+		c.logger.Errorw("failed to get credit line", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CreditLineEndpoint) GenerateCreditLineBill(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.GenerateCreditLineBillInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.generateCreditLineBillUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to generate credit line bill", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CreditLineEndpoint) PayCreditLineBill(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.PayCreditLineBillInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.payCreditLineBillUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to pay credit line bill", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CreditLineEndpoint) MarkOverdueCreditLineBills(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.MarkOverdueCreditLineBillsInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.markOverdueCreditLineBillsUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to mark overdue credit line bills", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CreditLineEndpoint) GetCreditLineBills(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	creditLineID, err := c.creditLineID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := c.getCreditLineBillsUsecase.Execute(ctx, creditLineID)
+	if err != nil {
+		c.logger.Errorw("failed to get credit line bills", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CreditLineEndpoint) creditLineID(ctx context.Context) (uint64, error) {
+	params := httprouter.ParamsFromContext(ctx)
+
+	creditLineID, err := strconv.ParseUint(params.ByName("credit_line_id"), 10, 64)
+	if err != nil {
+		c.logger.Errorw("failed to parse credit_line_id", "error", err)
+		return 0, pkgerror.ValidationErrorFrom(err)
+	}
+
+	return creditLineID, nil
+}
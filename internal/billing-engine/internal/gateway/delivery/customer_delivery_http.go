@@ -0,0 +1,90 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	customerPath           = "/customer/:customer_id"
+	saveCreditLimitPath    = "/customer/credit-limit"
+	getCustomerSummaryPath = "/customer/:customer_id/summary"
+	getStatementPath       = "/customer/:customer_id/statement"
+
+	getCustomerDuplicatesPath    = "/customers/duplicates"
+	dismissCustomerDuplicatePath = "/customer/duplicate/dismiss"
+	mergeCustomersPath           = "/customer/merge"
+	getCustomerMergesPath        = "/customer/:customer_id/merges"
+)
+
+func NewCustomerHTTPGateway(
+	httpRouter *httprouter.Router,
+	customerEndpoint *CustomerEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+customerPath,
+		server.Serve(customerEndpoint.GetCustomer),
+	)
+
+	httpRouter.Handler(
+		http.MethodPatch,
+		basePath+customerPath,
+		server.Serve(customerEndpoint.UpdateCustomer),
+	)
+
+	httpRouter.Handler(
+		http.MethodDelete,
+		basePath+customerPath,
+		server.Serve(customerEndpoint.DeleteCustomer),
+	)
+
+	httpRouter.Handler(
+		http.MethodPut,
+		basePath+saveCreditLimitPath,
+		server.Serve(customerEndpoint.SaveCreditLimit),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getCustomerSummaryPath,
+		server.Serve(customerEndpoint.GetCustomerSummary),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getStatementPath,
+		server.Serve(customerEndpoint.GetStatement, pkghttp.WithEndpointResponseEncoder(fileResponseEncoder)),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getCustomerDuplicatesPath,
+		server.Serve(customerEndpoint.GetCustomerDuplicates),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+dismissCustomerDuplicatePath,
+		server.Serve(customerEndpoint.DismissCustomerDuplicate),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+mergeCustomersPath,
+		server.Serve(customerEndpoint.MergeCustomers),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getCustomerMergesPath,
+		server.Serve(customerEndpoint.GetCustomerMerges),
+	)
+}
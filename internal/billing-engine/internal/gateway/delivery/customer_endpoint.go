@@ -0,0 +1,340 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// CustomerEndpoint serves the profiles of the customers, their credit limits
+// and how much of them is used, their statements, and the review and merge
+// of duplicate customers.
+type CustomerEndpoint struct {
+	getCustomerUsecase        usecases.GetCustomerUsecase
+	updateCustomerUsecase     usecases.UpdateCustomerUsecase
+	deleteCustomerUsecase     usecases.DeleteCustomerUsecase
+	saveCreditLimitUsecase    usecases.SaveCreditLimitUsecase
+	getCustomerSummaryUsecase usecases.GetCustomerSummaryUsecase
+	getStatementUsecase       usecases.GetStatementUsecase
+
+	getCustomerDuplicatesUsecase    usecases.GetCustomerDuplicatesUsecase
+	dismissCustomerDuplicateUsecase usecases.DismissCustomerDuplicateUsecase
+	mergeCustomersUsecase           usecases.MergeCustomersUsecase
+	getCustomerMergesUsecase        usecases.GetCustomerMergesUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewCustomerEndpoint(
+	getCustomerUsecase usecases.GetCustomerUsecase,
+	updateCustomerUsecase usecases.UpdateCustomerUsecase,
+	deleteCustomerUsecase usecases.DeleteCustomerUsecase,
+	saveCreditLimitUsecase usecases.SaveCreditLimitUsecase,
+	getCustomerSummaryUsecase usecases.GetCustomerSummaryUsecase,
+	getStatementUsecase usecases.GetStatementUsecase,
+
+	getCustomerDuplicatesUsecase usecases.GetCustomerDuplicatesUsecase,
+	dismissCustomerDuplicateUsecase usecases.DismissCustomerDuplicateUsecase,
+	mergeCustomersUsecase usecases.MergeCustomersUsecase,
+	getCustomerMergesUsecase usecases.GetCustomerMergesUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *CustomerEndpoint {
+	return &CustomerEndpoint{
+		getCustomerUsecase:        getCustomerUsecase,
+		updateCustomerUsecase:     updateCustomerUsecase,
+		deleteCustomerUsecase:     deleteCustomerUsecase,
+		saveCreditLimitUsecase:    saveCreditLimitUsecase,
+		getCustomerSummaryUsecase: getCustomerSummaryUsecase,
+		getStatementUsecase:       getStatementUsecase,
+
+		getCustomerDuplicatesUsecase:    getCustomerDuplicatesUsecase,
+		dismissCustomerDuplicateUsecase: dismissCustomerDuplicateUsecase,
+		mergeCustomersUsecase:           mergeCustomersUsecase,
+		getCustomerMergesUsecase:        getCustomerMergesUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (c *CustomerEndpoint) GetCustomer(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	customerID := params.ByName("customer_id")
+
+	customerIDUint, err := strconv.ParseUint(customerID, 10, 64)
+	if err != nil {
+		c.logger.Errorw("failed to parse customer_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.getCustomerUsecase.Execute(ctx, customerIDUint)
+	if err != nil {
+		c.logger.Errorw("failed to get customer", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CustomerEndpoint) UpdateCustomer(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	customerID := params.ByName("customer_id")
+
+	customerIDUint, err := strconv.ParseUint(customerID, 10, 64)
+	if err != nil {
+		c.logger.Errorw("failed to parse customer_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	var input usecases.UpdateCustomerInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+	input.CustomerID = customerIDUint
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.updateCustomerUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to update customer", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CustomerEndpoint) DeleteCustomer(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	customerID := params.ByName("customer_id")
+
+	customerIDUint, err := strconv.ParseUint(customerID, 10, 64)
+	if err != nil {
+		c.logger.Errorw("failed to parse customer_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.deleteCustomerUsecase.Execute(ctx, customerIDUint)
+	if err != nil {
+		c.logger.Errorw("failed to delete customer", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CustomerEndpoint) SaveCreditLimit(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.SaveCreditLimitInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.saveCreditLimitUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to save credit limit", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CustomerEndpoint) GetCustomerSummary(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	customerID := params.ByName("customer_id")
+
+	customerIDUint, err := strconv.ParseUint(customerID, 10, 64)
+	if err != nil {
+		c.logger.Errorw("failed to parse customer_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.getCustomerSummaryUsecase.Execute(ctx, customerIDUint)
+	if err != nil {
+		c.logger.Errorw("failed to get customer summary", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CustomerEndpoint) GetStatement(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	customerID := params.ByName("customer_id")
+
+	customerIDUint, err := strconv.ParseUint(customerID, 10, 64)
+	if err != nil {
+		c.logger.Errorw("failed to parse customer_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	query := request.URL().Query()
+	input := usecases.GetStatementInput{
+		CustomerID: customerIDUint,
+		From:       query.Get("from"),
+		To:         query.Get("to"),
+		Format:     query.Get("format"),
+	}
+
+	if loanID := query.Get("loan_id"); loanID != "" {
+		input.LoanID, err = strconv.ParseUint(loanID, 10, 64)
+		if err != nil {
+			c.logger.Errorw("failed to parse loan_id", "error", err)
+			return nil, pkgerror.ValidationErrorFrom(err)
+		}
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.getStatementUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to get statement", "error", err)
+		return nil, err
+	}
+
+	return fileResponse{
+		fileName:    output.FileName,
+		contentType: output.ContentType,
+		content:     output.Content,
+	}, nil
+}
+
+func (c *CustomerEndpoint) GetCustomerDuplicates(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	query := request.URL().Query()
+	input := usecases.GetCustomerDuplicatesInput{
+		Status: query.Get("status"),
+	}
+
+	if customerID := query.Get("customer_id"); customerID != "" {
+		customerIDUint, err := strconv.ParseUint(customerID, 10, 64)
+		if err != nil {
+			c.logger.Errorw("failed to parse customer_id", "error", err)
+			return nil, pkgerror.ValidationErrorFrom(err)
+		}
+		input.CustomerID = customerIDUint
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.getCustomerDuplicatesUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to get customer duplicates", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CustomerEndpoint) DismissCustomerDuplicate(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.DismissCustomerDuplicateInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.dismissCustomerDuplicateUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to dismiss customer duplicate", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CustomerEndpoint) MergeCustomers(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.MergeCustomersInput
+	if err := request.Decode(&input); err != nil {
+		c.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.mergeCustomersUsecase.Execute(ctx, input)
+	if err != nil {
+		c.logger.Errorw("failed to merge customers", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *CustomerEndpoint) GetCustomerMerges(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	customerID := params.ByName("customer_id")
+
+	customerIDUint, err := strconv.ParseUint(customerID, 10, 64)
+	if err != nil {
+		c.logger.Errorw("failed to parse customer_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := c.getCustomerMergesUsecase.Execute(ctx, customerIDUint)
+	if err != nil {
+		c.logger.Errorw("failed to get customer merges", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,42 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	saveProductFeePath = "/product/fee"
+	getProductFeesPath = "/product/fees"
+	getLoanFeesPath    = "/loan/:loan_id/fees"
+)
+
+func NewFeeHTTPGateway(
+	httpRouter *httprouter.Router,
+	feeEndpoint *FeeEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPut,
+		basePath+saveProductFeePath,
+		server.Serve(feeEndpoint.SaveProductFee),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getProductFeesPath,
+		server.Serve(feeEndpoint.GetProductFees),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoanFeesPath,
+		server.Serve(feeEndpoint.GetLoanFees),
+	)
+}
@@ -0,0 +1,110 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// FeeEndpoint serves the fee definitions of the loan products and the fees
+// charged on a loan.
+type FeeEndpoint struct {
+	saveProductFeeUsecase usecases.SaveProductFeeUsecase
+	getProductFeesUsecase usecases.GetProductFeesUsecase
+	getLoanFeesUsecase    usecases.GetLoanFeesUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewFeeEndpoint(
+	saveProductFeeUsecase usecases.SaveProductFeeUsecase,
+	getProductFeesUsecase usecases.GetProductFeesUsecase,
+	getLoanFeesUsecase usecases.GetLoanFeesUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *FeeEndpoint {
+	return &FeeEndpoint{
+		saveProductFeeUsecase: saveProductFeeUsecase,
+		getProductFeesUsecase: getProductFeesUsecase,
+		getLoanFeesUsecase:    getLoanFeesUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (f *FeeEndpoint) SaveProductFee(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.SaveProductFeeInput
+	if err := request.Decode(&input); err != nil {
+		f.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := f.validator.Struct(input); err != nil {
+		f.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := f.saveProductFeeUsecase.Execute(ctx, input)
+	if err != nil {
+		f.logger.Errorw("failed to save product fee", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (f *FeeEndpoint) GetProductFees(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	input := usecases.GetProductFeesInput{
+		ProductCode: request.URL().Query().Get("product_code"),
+	}
+
+	if err := f.validator.Struct(input); err != nil {
+		f.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := f.getProductFeesUsecase.Execute(ctx, input)
+	if err != nil {
+		f.logger.Errorw("failed to get product fees", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (f *FeeEndpoint) GetLoanFees(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	loanID := params.ByName("loan_id")
+
+	loanIDUint, err := strconv.ParseUint(loanID, 10, 64)
+	if err != nil {
+		f.logger.Errorw("failed to parse loan_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := f.getLoanFeesUsecase.Execute(ctx, loanIDUint)
+	if err != nil {
+		f.logger.Errorw("failed to get loan fees", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
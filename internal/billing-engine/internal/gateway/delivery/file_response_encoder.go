@@ -0,0 +1,33 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+)
+
+// fileResponse is returned by endpoints that download a file instead of a
+// JSON body.
+type fileResponse struct {
+	fileName    string
+	contentType string
+	content     []byte
+}
+
+// fileResponseEncoder writes a fileResponse as an attachment and encodes any
+// other response as JSON.
+func fileResponseEncoder(ctx context.Context, w http.ResponseWriter, response any) error {
+	file, ok := response.(fileResponse)
+	if !ok {
+		return pkghttp.DefaultResponseEncoder(ctx, w, response)
+	}
+
+	w.Header().Set("Content-Type", file.contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.fileName))
+	w.WriteHeader(http.StatusOK)
+
+	_, err := w.Write(file.content)
+	return err
+}
@@ -0,0 +1,42 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	getGLMappingsPath   = "/gl/mappings"
+	saveGLMappingPath   = "/gl/mapping"
+	exportGLJournalPath = "/gl/export"
+)
+
+func NewGLExportHTTPGateway(
+	httpRouter *httprouter.Router,
+	glExportEndpoint *GLExportEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getGLMappingsPath,
+		server.Serve(glExportEndpoint.GetGLMappings),
+	)
+
+	httpRouter.Handler(
+		http.MethodPut,
+		basePath+saveGLMappingPath,
+		server.Serve(glExportEndpoint.SaveGLMapping),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+exportGLJournalPath,
+		server.Serve(glExportEndpoint.ExportGLJournal, pkghttp.WithEndpointResponseEncoder(fileResponseEncoder)),
+	)
+}
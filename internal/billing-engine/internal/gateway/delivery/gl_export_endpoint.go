@@ -0,0 +1,105 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// GLExportEndpoint serves the chart-of-accounts mapping and the daily journal
+// export for the external accounting system.
+type GLExportEndpoint struct {
+	getGLMappingsUsecase   usecases.GetGLMappingsUsecase
+	saveGLMappingUsecase   usecases.SaveGLMappingUsecase
+	exportGLJournalUsecase usecases.ExportGLJournalUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewGLExportEndpoint(
+	getGLMappingsUsecase usecases.GetGLMappingsUsecase,
+	saveGLMappingUsecase usecases.SaveGLMappingUsecase,
+	exportGLJournalUsecase usecases.ExportGLJournalUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *GLExportEndpoint {
+	return &GLExportEndpoint{
+		getGLMappingsUsecase:   getGLMappingsUsecase,
+		saveGLMappingUsecase:   saveGLMappingUsecase,
+		exportGLJournalUsecase: exportGLJournalUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (g *GLExportEndpoint) GetGLMappings(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	output, err := g.getGLMappingsUsecase.Execute(ctx)
+	if err != nil {
+		g.logger.Errorw("failed to get GL mappings", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (g *GLExportEndpoint) SaveGLMapping(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.SaveGLMappingInput
+	if err := request.Decode(&input); err != nil {
+		g.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := g.saveGLMappingUsecase.Execute(ctx, input)
+	if err != nil {
+		g.logger.Errorw("failed to save GL mapping", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (g *GLExportEndpoint) ExportGLJournal(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	query := request.URL().Query()
+	input := usecases.ExportGLJournalInput{
+		Date:   query.Get("date"),
+		Format: query.Get("format"),
+	}
+
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := g.exportGLJournalUsecase.Execute(ctx, input)
+	if err != nil {
+		g.logger.Errorw("failed to export GL journal", "error", err)
+		return nil, err
+	}
+
+	return fileResponse{
+		fileName:    output.FileName,
+		contentType: output.ContentType,
+		content:     output.Content,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	getTrialBalancePath = "/ledger/trial-balance"
+	getLoanJournalPath  = "/loan/:loan_id/journal"
+)
+
+func NewLedgerHTTPGateway(
+	httpRouter *httprouter.Router,
+	ledgerEndpoint *LedgerEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getTrialBalancePath,
+		server.Serve(ledgerEndpoint.GetTrialBalance),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoanJournalPath,
+		server.Serve(ledgerEndpoint.GetLoanJournal),
+	)
+}
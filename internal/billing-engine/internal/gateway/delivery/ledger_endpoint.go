@@ -0,0 +1,82 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// LedgerEndpoint serves the general ledger reports.
+type LedgerEndpoint struct {
+	getTrialBalanceUsecase usecases.GetTrialBalanceUsecase
+	getLoanJournalUsecase  usecases.GetLoanJournalUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewLedgerEndpoint(
+	getTrialBalanceUsecase usecases.GetTrialBalanceUsecase,
+	getLoanJournalUsecase usecases.GetLoanJournalUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *LedgerEndpoint {
+	return &LedgerEndpoint{
+		getTrialBalanceUsecase: getTrialBalanceUsecase,
+		getLoanJournalUsecase:  getLoanJournalUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (l *LedgerEndpoint) GetTrialBalance(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	input := usecases.GetTrialBalanceInput{
+		AsOf: request.URL().Query().Get("as_of"),
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.getTrialBalanceUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to get trial balance", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LedgerEndpoint) GetLoanJournal(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	loanID := params.ByName("loan_id")
+
+	loanIDUint, err := strconv.ParseUint(loanID, 10, 64)
+	if err != nil {
+		l.logger.Errorw("failed to parse loan_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.getLoanJournalUsecase.Execute(ctx, loanIDUint)
+	if err != nil {
+		l.logger.Errorw("failed to get loan journal", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,77 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	approveLoanPath         = "/loan/approve"
+	rejectLoanPath          = "/loan/reject"
+	disburseLoanPath        = "/loan/disburse"
+	confirmDisbursementPath = "/disbursement/confirm"
+	retryDisbursementPath   = "/disbursement/retry"
+	getLoanDisbursementPath = "/loan/:loan_id/disbursement"
+	quoteLoanPath           = "/loan/quote"
+	getLoanDisclosurePath   = "/loan/:loan_id/disclosure"
+)
+
+func NewLoanApplicationHTTPGateway(
+	httpRouter *httprouter.Router,
+	loanApplicationEndpoint *LoanApplicationEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+approveLoanPath,
+		server.Serve(loanApplicationEndpoint.ApproveLoan),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+rejectLoanPath,
+		server.Serve(loanApplicationEndpoint.RejectLoan),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+disburseLoanPath,
+		server.Serve(loanApplicationEndpoint.DisburseLoan),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+confirmDisbursementPath,
+		server.Serve(loanApplicationEndpoint.ConfirmDisbursement),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+retryDisbursementPath,
+		server.Serve(loanApplicationEndpoint.RetryDisbursement),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoanDisbursementPath,
+		server.Serve(loanApplicationEndpoint.GetLoanDisbursement),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+quoteLoanPath,
+		server.Serve(loanApplicationEndpoint.QuoteLoan),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoanDisclosurePath,
+		server.Serve(loanApplicationEndpoint.GetLoanDisclosure),
+	)
+}
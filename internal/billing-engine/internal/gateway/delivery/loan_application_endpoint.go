@@ -0,0 +1,246 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// LoanApplicationEndpoint serves the quotes and disclosed terms of loans, the
+// approval and disbursement of loan applications, and the tracking of their
+// payouts.
+type LoanApplicationEndpoint struct {
+	approveLoanUsecase         usecases.ApproveLoanUsecase
+	rejectLoanUsecase          usecases.RejectLoanUsecase
+	disburseLoanUsecase        usecases.DisburseLoanUsecase
+	confirmDisbursementUsecase usecases.ConfirmDisbursementUsecase
+	retryDisbursementUsecase   usecases.RetryDisbursementUsecase
+	getLoanDisbursementUsecase usecases.GetLoanDisbursementUsecase
+	quoteLoanUsecase           usecases.QuoteLoanUsecase
+	getLoanDisclosureUsecase   usecases.GetLoanDisclosureUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewLoanApplicationEndpoint(
+	approveLoanUsecase usecases.ApproveLoanUsecase,
+	rejectLoanUsecase usecases.RejectLoanUsecase,
+	disburseLoanUsecase usecases.DisburseLoanUsecase,
+	confirmDisbursementUsecase usecases.ConfirmDisbursementUsecase,
+	retryDisbursementUsecase usecases.RetryDisbursementUsecase,
+	getLoanDisbursementUsecase usecases.GetLoanDisbursementUsecase,
+	quoteLoanUsecase usecases.QuoteLoanUsecase,
+	getLoanDisclosureUsecase usecases.GetLoanDisclosureUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *LoanApplicationEndpoint {
+	return &LoanApplicationEndpoint{
+		approveLoanUsecase:         approveLoanUsecase,
+		rejectLoanUsecase:          rejectLoanUsecase,
+		disburseLoanUsecase:        disburseLoanUsecase,
+		confirmDisbursementUsecase: confirmDisbursementUsecase,
+		retryDisbursementUsecase:   retryDisbursementUsecase,
+		getLoanDisbursementUsecase: getLoanDisbursementUsecase,
+		quoteLoanUsecase:           quoteLoanUsecase,
+		getLoanDisclosureUsecase:   getLoanDisclosureUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (l *LoanApplicationEndpoint) ApproveLoan(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.ApproveLoanInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.approveLoanUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to approve loan", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanApplicationEndpoint) RejectLoan(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.RejectLoanInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.rejectLoanUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to reject loan", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanApplicationEndpoint) DisburseLoan(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.DisburseLoanInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.disburseLoanUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to disburse loan", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanApplicationEndpoint) ConfirmDisbursement(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.ConfirmDisbursementInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.confirmDisbursementUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to confirm disbursement", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanApplicationEndpoint) RetryDisbursement(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.RetryDisbursementInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.retryDisbursementUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to retry disbursement", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanApplicationEndpoint) GetLoanDisbursement(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	loanID := params.ByName("loan_id")
+
+	loanIDUint, err := strconv.ParseUint(loanID, 10, 64)
+	if err != nil {
+		l.logger.Errorw("failed to parse loan_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.getLoanDisbursementUsecase.Execute(ctx, loanIDUint)
+	if err != nil {
+		l.logger.Errorw("failed to get loan disbursement", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanApplicationEndpoint) QuoteLoan(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.QuoteLoanInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.quoteLoanUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to quote loan", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanApplicationEndpoint) GetLoanDisclosure(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	loanID := params.ByName("loan_id")
+
+	loanIDUint, err := strconv.ParseUint(loanID, 10, 64)
+	if err != nil {
+		l.logger.Errorw("failed to parse loan_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.getLoanDisclosureUsecase.Execute(ctx, loanIDUint)
+	if err != nil {
+		l.logger.Errorw("failed to get loan disclosure", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
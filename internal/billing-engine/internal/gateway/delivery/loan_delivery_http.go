@@ -0,0 +1,112 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	restructureLoanPath     = "/loan/restructure"
+	getLoanRestructuresPath = "/loan/:loan_id/restructures"
+	reversePaymentPath      = "/loan/payment/reversal"
+	getLoanTransitionsPath  = "/loan/:loan_id/transitions"
+	getLoansPath            = "/loans"
+	getCustomerLoansPath    = "/customer/:customer_id/loans"
+	addLoanPartyPath        = "/loan/party"
+	getLoanPartiesPath      = "/loan/:loan_id/parties"
+	registerCollateralPath  = "/loan/collateral"
+	revalueCollateralPath   = "/loan/collateral/revalue"
+	collateralLienPath      = "/loan/collateral/lien"
+	getLoanCollateralPath   = "/loan/:loan_id/collateral"
+	getCollateralReportPath = "/collateral/report"
+)
+
+func NewLoanHTTPGateway(
+	httpRouter *httprouter.Router,
+	loanEndpoint *LoanEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+restructureLoanPath,
+		server.Serve(loanEndpoint.RestructureLoan),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoanRestructuresPath,
+		server.Serve(loanEndpoint.GetLoanRestructures),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+reversePaymentPath,
+		server.Serve(loanEndpoint.ReversePayment),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoanTransitionsPath,
+		server.Serve(loanEndpoint.GetLoanStatusHistory),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoansPath,
+		server.Serve(loanEndpoint.GetLoans),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getCustomerLoansPath,
+		server.Serve(loanEndpoint.GetCustomerLoans),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+addLoanPartyPath,
+		server.Serve(loanEndpoint.AddLoanParty),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoanPartiesPath,
+		server.Serve(loanEndpoint.GetLoanParties),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+registerCollateralPath,
+		server.Serve(loanEndpoint.RegisterCollateral),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+revalueCollateralPath,
+		server.Serve(loanEndpoint.RevalueCollateral),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+collateralLienPath,
+		server.Serve(loanEndpoint.UpdateCollateralLien),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoanCollateralPath,
+		server.Serve(loanEndpoint.GetLoanCollateral),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getCollateralReportPath,
+		server.Serve(loanEndpoint.GetCollateralReport),
+	)
+}
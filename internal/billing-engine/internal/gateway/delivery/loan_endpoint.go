@@ -0,0 +1,392 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// LoanEndpoint serves the servicing operations on an existing loan.
+type LoanEndpoint struct {
+	restructureLoanUsecase      usecases.RestructureLoanUsecase
+	getLoanRestructuresUsecase  usecases.GetLoanRestructuresUsecase
+	reversePaymentUsecase       usecases.ReversePaymentUsecase
+	getLoanStatusHistoryUsecase usecases.GetLoanStatusHistoryUsecase
+	getLoansUsecase             usecases.GetLoansUsecase
+	addLoanPartyUsecase         usecases.AddLoanPartyUsecase
+	getLoanPartiesUsecase       usecases.GetLoanPartiesUsecase
+	registerCollateralUsecase   usecases.RegisterCollateralUsecase
+	revalueCollateralUsecase    usecases.RevalueCollateralUsecase
+	updateCollateralLienUsecase usecases.UpdateCollateralLienUsecase
+	getLoanCollateralUsecase    usecases.GetLoanCollateralUsecase
+	getCollateralReportUsecase  usecases.GetCollateralReportUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewLoanEndpoint(
+	restructureLoanUsecase usecases.RestructureLoanUsecase,
+	getLoanRestructuresUsecase usecases.GetLoanRestructuresUsecase,
+	reversePaymentUsecase usecases.ReversePaymentUsecase,
+	getLoanStatusHistoryUsecase usecases.GetLoanStatusHistoryUsecase,
+	getLoansUsecase usecases.GetLoansUsecase,
+	addLoanPartyUsecase usecases.AddLoanPartyUsecase,
+	getLoanPartiesUsecase usecases.GetLoanPartiesUsecase,
+	registerCollateralUsecase usecases.RegisterCollateralUsecase,
+	revalueCollateralUsecase usecases.RevalueCollateralUsecase,
+	updateCollateralLienUsecase usecases.UpdateCollateralLienUsecase,
+	getLoanCollateralUsecase usecases.GetLoanCollateralUsecase,
+	getCollateralReportUsecase usecases.GetCollateralReportUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *LoanEndpoint {
+	return &LoanEndpoint{
+		restructureLoanUsecase:      restructureLoanUsecase,
+		getLoanRestructuresUsecase:  getLoanRestructuresUsecase,
+		reversePaymentUsecase:       reversePaymentUsecase,
+		getLoanStatusHistoryUsecase: getLoanStatusHistoryUsecase,
+		getLoansUsecase:             getLoansUsecase,
+		addLoanPartyUsecase:         addLoanPartyUsecase,
+		getLoanPartiesUsecase:       getLoanPartiesUsecase,
+		registerCollateralUsecase:   registerCollateralUsecase,
+		revalueCollateralUsecase:    revalueCollateralUsecase,
+		updateCollateralLienUsecase: updateCollateralLienUsecase,
+		getLoanCollateralUsecase:    getLoanCollateralUsecase,
+		getCollateralReportUsecase:  getCollateralReportUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (l *LoanEndpoint) RestructureLoan(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.RestructureLoanInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.restructureLoanUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to restructure loan", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) GetLoanRestructures(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	loanID, err := l.loanIDFromPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := l.getLoanRestructuresUsecase.Execute(ctx, loanID)
+	if err != nil {
+		l.logger.Errorw("failed to get loan restructures", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) ReversePayment(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.ReversePaymentInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.reversePaymentUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to reverse payment", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) GetLoanStatusHistory(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	loanID, err := l.loanIDFromPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := l.getLoanStatusHistoryUsecase.Execute(ctx, loanID)
+	if err != nil {
+		l.logger.Errorw("failed to get loan status history", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) GetLoans(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	input, err := l.getLoansInput(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if customerID := request.URL().Query().Get("customer_id"); customerID != "" {
+		input.CustomerID, err = strconv.ParseUint(customerID, 10, 64)
+		if err != nil {
+			l.logger.Errorw("failed to parse customer_id", "error", err)
+			return nil, pkgerror.ValidationErrorFrom(err)
+		}
+	}
+
+	output, err := l.getLoansUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to get loans", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) GetCustomerLoans(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	input, err := l.getLoansInput(request)
+	if err != nil {
+		return nil, err
+	}
+
+	params := httprouter.ParamsFromContext(ctx)
+	input.CustomerID, err = strconv.ParseUint(params.ByName("customer_id"), 10, 64)
+	if err != nil {
+		l.logger.Errorw("failed to parse customer_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.getLoansUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to get customer loans", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) AddLoanParty(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.AddLoanPartyInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.addLoanPartyUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to add loan party", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) GetLoanParties(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	loanID, err := l.loanIDFromPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := l.getLoanPartiesUsecase.Execute(ctx, loanID)
+	if err != nil {
+		l.logger.Errorw("failed to get loan parties", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) RegisterCollateral(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.RegisterCollateralInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.registerCollateralUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to register collateral", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) RevalueCollateral(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.RevalueCollateralInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.revalueCollateralUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to revalue collateral", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) UpdateCollateralLien(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.UpdateCollateralLienInput
+	if err := request.Decode(&input); err != nil {
+		l.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := l.updateCollateralLienUsecase.Execute(ctx, input)
+	if err != nil {
+		l.logger.Errorw("failed to update collateral lien", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) GetLoanCollateral(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	loanID, err := l.loanIDFromPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := l.getLoanCollateralUsecase.Execute(ctx, loanID)
+	if err != nil {
+		l.logger.Errorw("failed to get loan collateral", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (l *LoanEndpoint) GetCollateralReport(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	output, err := l.getCollateralReportUsecase.Execute(ctx)
+	if err != nil {
+		l.logger.Errorw("failed to get collateral report", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// getLoansInput reads the filters and the page of a loan listing from the
+// query string.
+func (l *LoanEndpoint) getLoansInput(request pkghttp.Request) (usecases.GetLoansInput, error) {
+	query := request.URL().Query()
+	input := usecases.GetLoansInput{
+		Cursor:    query.Get("cursor"),
+		Status:    query.Get("status"),
+		Overdue:   query.Get("overdue"),
+		StartFrom: query.Get("start_from"),
+		StartTo:   query.Get("start_to"),
+		AsOf:      query.Get("as_of"),
+		Sort:      query.Get("sort"),
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		limitInt, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil {
+			l.logger.Errorw("failed to parse limit", "error", err)
+			return usecases.GetLoansInput{}, pkgerror.ValidationErrorFrom(err)
+		}
+		input.Limit = limitInt
+	}
+
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("failed to validate request", "error", err)
+		return usecases.GetLoansInput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	return input, nil
+}
+
+func (l *LoanEndpoint) loanIDFromPath(ctx context.Context) (uint64, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	loanID := params.ByName("loan_id")
+
+	loanIDUint, err := strconv.ParseUint(loanID, 10, 64)
+	if err != nil {
+		l.logger.Errorw("failed to parse loan_id", "error", err)
+		return 0, pkgerror.ValidationErrorFrom(err)
+	}
+
+	return loanIDUint, nil
+}
@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	createMerchantPath                 = "/merchant"
+	getMerchantPath                    = "/merchant/:merchant_id"
+	getMerchantSettlementsPath         = "/merchant/:merchant_id/settlements"
+	generateMerchantSettlementsPath    = "/merchant-settlement"
+	updateMerchantSettlementStatusPath = "/merchant-settlement/status"
+	getMerchantSettlementPath          = "/merchant-settlement/:settlement_id"
+	exportMerchantSettlementsPath      = "/merchant-settlements/export"
+)
+
+func NewMerchantHTTPGateway(
+	httpRouter *httprouter.Router,
+	merchantEndpoint *MerchantEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+createMerchantPath,
+		server.Serve(merchantEndpoint.CreateMerchant),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getMerchantPath,
+		server.Serve(merchantEndpoint.GetMerchant),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getMerchantSettlementsPath,
+		server.Serve(merchantEndpoint.GetMerchantSettlements),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+generateMerchantSettlementsPath,
+		server.Serve(merchantEndpoint.GenerateMerchantSettlements),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+updateMerchantSettlementStatusPath,
+		server.Serve(merchantEndpoint.UpdateMerchantSettlementStatus),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getMerchantSettlementPath,
+		server.Serve(merchantEndpoint.GetMerchantSettlement),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+exportMerchantSettlementsPath,
+		server.Serve(merchantEndpoint.ExportMerchantSettlements, pkghttp.WithEndpointResponseEncoder(fileResponseEncoder)),
+	)
+}
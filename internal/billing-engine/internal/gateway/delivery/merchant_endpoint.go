@@ -0,0 +1,218 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// MerchantEndpoint serves the merchant partners and their daily settlement
+// batches.
+type MerchantEndpoint struct {
+	createMerchantUsecase                 usecases.CreateMerchantUsecase
+	getMerchantUsecase                    usecases.GetMerchantUsecase
+	getMerchantSettlementsUsecase         usecases.GetMerchantSettlementsUsecase
+	generateMerchantSettlementsUsecase    usecases.GenerateMerchantSettlementsUsecase
+	updateMerchantSettlementStatusUsecase usecases.UpdateMerchantSettlementStatusUsecase
+	getMerchantSettlementUsecase          usecases.GetMerchantSettlementUsecase
+	exportMerchantSettlementsUsecase      usecases.ExportMerchantSettlementsUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewMerchantEndpoint(
+	createMerchantUsecase usecases.CreateMerchantUsecase,
+	getMerchantUsecase usecases.GetMerchantUsecase,
+	getMerchantSettlementsUsecase usecases.GetMerchantSettlementsUsecase,
+	generateMerchantSettlementsUsecase usecases.GenerateMerchantSettlementsUsecase,
+	updateMerchantSettlementStatusUsecase usecases.UpdateMerchantSettlementStatusUsecase,
+	getMerchantSettlementUsecase usecases.GetMerchantSettlementUsecase,
+	exportMerchantSettlementsUsecase usecases.ExportMerchantSettlementsUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *MerchantEndpoint {
+	return &MerchantEndpoint{
+		createMerchantUsecase:                 createMerchantUsecase,
+		getMerchantUsecase:                    getMerchantUsecase,
+		getMerchantSettlementsUsecase:         getMerchantSettlementsUsecase,
+		generateMerchantSettlementsUsecase:    generateMerchantSettlementsUsecase,
+		updateMerchantSettlementStatusUsecase: updateMerchantSettlementStatusUsecase,
+		getMerchantSettlementUsecase:          getMerchantSettlementUsecase,
+		exportMerchantSettlementsUsecase:      exportMerchantSettlementsUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (m *MerchantEndpoint) CreateMerchant(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.CreateMerchantInput
+	if err := request.Decode(&input); err != nil {
+		m.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := m.validator.Struct(input); err != nil {
+		m.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := m.createMerchantUsecase.Execute(ctx, input)
+	if err != nil {
+		m.logger.Errorw("failed to create merchant", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (m *MerchantEndpoint) GetMerchant(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	merchantID, err := m.pathID(ctx, "merchant_id")
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := m.getMerchantUsecase.Execute(ctx, merchantID)
+	if err != nil {
+		m.logger.Errorw("failed to get merchant", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (m *MerchantEndpoint) GetMerchantSettlements(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	merchantID, err := m.pathID(ctx, "merchant_id")
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := m.getMerchantSettlementsUsecase.Execute(ctx, merchantID)
+	if err != nil {
+		m.logger.Errorw("failed to get merchant settlements", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (m *MerchantEndpoint) GenerateMerchantSettlements(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.GenerateMerchantSettlementsInput
+	if err := request.Decode(&input); err != nil {
+		m.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := m.validator.Struct(input); err != nil {
+		m.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := m.generateMerchantSettlementsUsecase.Execute(ctx, input)
+	if err != nil {
+		m.logger.Errorw("failed to generate merchant settlements", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (m *MerchantEndpoint) UpdateMerchantSettlementStatus(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.UpdateMerchantSettlementStatusInput
+	if err := request.Decode(&input); err != nil {
+		m.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := m.validator.Struct(input); err != nil {
+		m.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := m.updateMerchantSettlementStatusUsecase.Execute(ctx, input)
+	if err != nil {
+		m.logger.Errorw("failed to update merchant settlement status", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (m *MerchantEndpoint) GetMerchantSettlement(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	settlementID, err := m.pathID(ctx, "settlement_id")
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := m.getMerchantSettlementUsecase.Execute(ctx, settlementID)
+	if err != nil {
+		m.logger.Errorw("failed to get merchant settlement", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (m *MerchantEndpoint) ExportMerchantSettlements(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	input := usecases.ExportMerchantSettlementsInput{
+		Date: request.URL().Query().Get("date"),
+	}
+
+	if err := m.validator.Struct(input); err != nil {
+		m.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := m.exportMerchantSettlementsUsecase.Execute(ctx, input)
+	if err != nil {
+		m.logger.Errorw("failed to export merchant settlements", "error", err)
+		return nil, err
+	}
+
+	return fileResponse{
+		fileName:    output.FileName,
+		contentType: output.ContentType,
+		content:     output.Content,
+	}, nil
+}
+
+func (m *MerchantEndpoint) pathID(ctx context.Context, name string) (uint64, error) {
+	params := httprouter.ParamsFromContext(ctx)
+
+	id, err := strconv.ParseUint(params.ByName(name), 10, 64)
+	if err != nil {
+		m.logger.Errorw("failed to parse "+name, "error", err)
+		return 0, pkgerror.ValidationErrorFrom(err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,35 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	declareMoratoriumPath = "/moratorium"
+	getMoratoriumPath     = "/moratorium/:moratorium_id"
+)
+
+func NewMoratoriumHTTPGateway(
+	httpRouter *httprouter.Router,
+	moratoriumEndpoint *MoratoriumEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+declareMoratoriumPath,
+		server.Serve(moratoriumEndpoint.DeclareMoratorium),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getMoratoriumPath,
+		server.Serve(moratoriumEndpoint.GetMoratorium),
+	)
+}
@@ -0,0 +1,83 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+type MoratoriumEndpoint struct {
+	declareMoratoriumUsecase usecases.DeclareMoratoriumUsecase
+	getMoratoriumUsecase     usecases.GetMoratoriumUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewMoratoriumEndpoint(
+	declareMoratoriumUsecase usecases.DeclareMoratoriumUsecase,
+	getMoratoriumUsecase usecases.GetMoratoriumUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *MoratoriumEndpoint {
+	return &MoratoriumEndpoint{
+		declareMoratoriumUsecase: declareMoratoriumUsecase,
+		getMoratoriumUsecase:     getMoratoriumUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (m *MoratoriumEndpoint) DeclareMoratorium(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.DeclareMoratoriumInput
+	if err := request.Decode(&input); err != nil {
+		m.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := m.validator.Struct(input); err != nil {
+		m.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := m.declareMoratoriumUsecase.Execute(ctx, input)
+	if err != nil {
+		m.logger.Errorw("failed to declare moratorium", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (m *MoratoriumEndpoint) GetMoratorium(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	moratoriumID := params.ByName("moratorium_id")
+
+	moratoriumIDUint, err := strconv.ParseUint(moratoriumID, 10, 64)
+	if err != nil {
+		m.logger.Errorw("failed to parse moratorium_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := m.getMoratoriumUsecase.Execute(ctx, moratoriumIDUint)
+	if err != nil {
+		m.logger.Errorw("failed to get moratorium", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,35 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	dispatchNotificationsPath = "/notification/dispatch"
+	getLoanCommunicationsPath = "/loan/:loan_id/communications"
+)
+
+func NewNotificationHTTPGateway(
+	httpRouter *httprouter.Router,
+	notificationEndpoint *NotificationEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+dispatchNotificationsPath,
+		server.Serve(notificationEndpoint.DispatchNotifications),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoanCommunicationsPath,
+		server.Serve(notificationEndpoint.GetLoanCommunications),
+	)
+}
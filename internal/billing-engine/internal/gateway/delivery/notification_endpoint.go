@@ -0,0 +1,83 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+type NotificationEndpoint struct {
+	dispatchNotificationsUsecase usecases.DispatchNotificationsUsecase
+	getLoanCommunicationsUsecase usecases.GetLoanCommunicationsUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewNotificationEndpoint(
+	dispatchNotificationsUsecase usecases.DispatchNotificationsUsecase,
+	getLoanCommunicationsUsecase usecases.GetLoanCommunicationsUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *NotificationEndpoint {
+	return &NotificationEndpoint{
+		dispatchNotificationsUsecase: dispatchNotificationsUsecase,
+		getLoanCommunicationsUsecase: getLoanCommunicationsUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (n *NotificationEndpoint) DispatchNotifications(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.DispatchNotificationsInput
+	if err := request.Decode(&input); err != nil {
+		n.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := n.validator.Struct(input); err != nil {
+		n.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := n.dispatchNotificationsUsecase.Execute(ctx, input)
+	if err != nil {
+		n.logger.Errorw("failed to dispatch notifications", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (n *NotificationEndpoint) GetLoanCommunications(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	loanID := params.ByName("loan_id")
+
+	loanIDUint, err := strconv.ParseUint(loanID, 10, 64)
+	if err != nil {
+		n.logger.Errorw("failed to parse loan_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := n.getLoanCommunicationsUsecase.Execute(ctx, loanIDUint)
+	if err != nil {
+		n.logger.Errorw("failed to get loan communications", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,49 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	runProvisioningPath       = "/provisioning/run"
+	getProvisioningReportPath = "/provisioning/report"
+	getProvisionRatesPath     = "/provisioning/rates"
+	saveProvisionRatePath     = "/provisioning/rate"
+)
+
+func NewProvisioningHTTPGateway(
+	httpRouter *httprouter.Router,
+	provisioningEndpoint *ProvisioningEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+runProvisioningPath,
+		server.Serve(provisioningEndpoint.RunProvisioning),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getProvisioningReportPath,
+		server.Serve(provisioningEndpoint.GetProvisioningReport),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getProvisionRatesPath,
+		server.Serve(provisioningEndpoint.GetProvisionRates),
+	)
+
+	httpRouter.Handler(
+		http.MethodPut,
+		basePath+saveProvisionRatePath,
+		server.Serve(provisioningEndpoint.SaveProvisionRate),
+	)
+}
@@ -0,0 +1,126 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// ProvisioningEndpoint serves the expected credit loss provisioning run, its
+// monthly report and the PD/LGD rates.
+type ProvisioningEndpoint struct {
+	runProvisioningUsecase       usecases.RunProvisioningUsecase
+	getProvisioningReportUsecase usecases.GetProvisioningReportUsecase
+	getProvisionRatesUsecase     usecases.GetProvisionRatesUsecase
+	saveProvisionRateUsecase     usecases.SaveProvisionRateUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewProvisioningEndpoint(
+	runProvisioningUsecase usecases.RunProvisioningUsecase,
+	getProvisioningReportUsecase usecases.GetProvisioningReportUsecase,
+	getProvisionRatesUsecase usecases.GetProvisionRatesUsecase,
+	saveProvisionRateUsecase usecases.SaveProvisionRateUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *ProvisioningEndpoint {
+	return &ProvisioningEndpoint{
+		runProvisioningUsecase:       runProvisioningUsecase,
+		getProvisioningReportUsecase: getProvisioningReportUsecase,
+		getProvisionRatesUsecase:     getProvisionRatesUsecase,
+		saveProvisionRateUsecase:     saveProvisionRateUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (p *ProvisioningEndpoint) RunProvisioning(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.RunProvisioningInput
+	if err := request.Decode(&input); err != nil {
+		p.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := p.validator.Struct(input); err != nil {
+		p.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := p.runProvisioningUsecase.Execute(ctx, input)
+	if err != nil {
+		p.logger.Errorw("failed to run provisioning", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (p *ProvisioningEndpoint) GetProvisioningReport(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	input := usecases.GetProvisioningReportInput{
+		Month: request.URL().Query().Get("month"),
+	}
+
+	if err := p.validator.Struct(input); err != nil {
+		p.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := p.getProvisioningReportUsecase.Execute(ctx, input)
+	if err != nil {
+		p.logger.Errorw("failed to get provisioning report", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (p *ProvisioningEndpoint) GetProvisionRates(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	output, err := p.getProvisionRatesUsecase.Execute(ctx)
+	if err != nil {
+		p.logger.Errorw("failed to get provision rates", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (p *ProvisioningEndpoint) SaveProvisionRate(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.SaveProvisionRateInput
+	if err := request.Decode(&input); err != nil {
+		p.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := p.validator.Struct(input); err != nil {
+		p.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := p.saveProvisionRateUsecase.Execute(ctx, input)
+	if err != nil {
+		p.logger.Errorw("failed to save provision rate", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
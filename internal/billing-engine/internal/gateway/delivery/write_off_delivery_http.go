@@ -0,0 +1,42 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	writeOffLoanPath      = "/loan/write-off"
+	getLoanRecoveriesPath = "/loan/:loan_id/recoveries"
+	getRecoveryReportPath = "/recoveries"
+)
+
+func NewWriteOffHTTPGateway(
+	httpRouter *httprouter.Router,
+	writeOffEndpoint *WriteOffEndpoint,
+) {
+	server := pkghttp.NewServer(
+		pkghttp.WithResponseEncoder(pkghttp.DefaultResponseEncoder),
+		pkghttp.WithErrorResponseEncoder(pkghttp.DefaultErrorEncoder),
+	)
+
+	httpRouter.Handler(
+		http.MethodPost,
+		basePath+writeOffLoanPath,
+		server.Serve(writeOffEndpoint.WriteOffLoan),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getLoanRecoveriesPath,
+		server.Serve(writeOffEndpoint.GetLoanRecoveries),
+	)
+
+	httpRouter.Handler(
+		http.MethodGet,
+		basePath+getRecoveryReportPath,
+		server.Serve(writeOffEndpoint.GetRecoveryReport),
+	)
+}
@@ -0,0 +1,111 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkghttp/v1"
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// WriteOffEndpoint serves loan write-offs and the recoveries booked on them.
+type WriteOffEndpoint struct {
+	writeOffLoanUsecase      usecases.WriteOffLoanUsecase
+	getLoanRecoveriesUsecase usecases.GetLoanRecoveriesUsecase
+	getRecoveryReportUsecase usecases.GetRecoveryReportUsecase
+
+	logger    *zap.SugaredLogger
+	validator *validator.Validate
+}
+
+func NewWriteOffEndpoint(
+	writeOffLoanUsecase usecases.WriteOffLoanUsecase,
+	getLoanRecoveriesUsecase usecases.GetLoanRecoveriesUsecase,
+	getRecoveryReportUsecase usecases.GetRecoveryReportUsecase,
+
+	logger *zap.SugaredLogger,
+	validator *validator.Validate,
+) *WriteOffEndpoint {
+	return &WriteOffEndpoint{
+		writeOffLoanUsecase:      writeOffLoanUsecase,
+		getLoanRecoveriesUsecase: getLoanRecoveriesUsecase,
+		getRecoveryReportUsecase: getRecoveryReportUsecase,
+
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (w *WriteOffEndpoint) WriteOffLoan(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	var input usecases.WriteOffLoanInput
+	if err := request.Decode(&input); err != nil {
+		w.logger.Errorw("failed to decode request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if err := w.validator.Struct(input); err != nil {
+		w.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := w.writeOffLoanUsecase.Execute(ctx, input)
+	if err != nil {
+		w.logger.Errorw("failed to write off loan", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (w *WriteOffEndpoint) GetLoanRecoveries(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	loanID := params.ByName("loan_id")
+
+	loanIDUint, err := strconv.ParseUint(loanID, 10, 64)
+	if err != nil {
+		w.logger.Errorw("failed to parse loan_id", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := w.getLoanRecoveriesUsecase.Execute(ctx, loanIDUint)
+	if err != nil {
+		w.logger.Errorw("failed to get loan recoveries", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (w *WriteOffEndpoint) GetRecoveryReport(
+	ctx context.Context,
+	request pkghttp.Request,
+) (any, error) {
+	query := request.URL().Query()
+	input := usecases.GetRecoveryReportInput{
+		From: query.Get("from"),
+		To:   query.Get("to"),
+	}
+
+	if err := w.validator.Struct(input); err != nil {
+		w.logger.Errorw("failed to validate request", "error", err)
+		return nil, pkgerror.ValidationErrorFrom(err)
+	}
+
+	output, err := w.getRecoveryReportUsecase.Execute(ctx, input)
+	if err != nil {
+		w.logger.Errorw("failed to get recovery report", "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,73 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"go.uber.org/zap"
+)
+
+// FakeProvider keeps every message in memory instead of delivering it. It is
+// used in tests and as the fallback for channels without credentials.
+type FakeProvider struct {
+	channel entity.NotificationChannel
+	logger  *zap.SugaredLogger
+
+	mu   sync.Mutex
+	sent []entity.NotificationMessage
+	err  error
+}
+
+func NewFakeProvider(channel entity.NotificationChannel, logger *zap.SugaredLogger) *FakeProvider {
+	return &FakeProvider{
+		channel: channel,
+		logger:  logger,
+	}
+}
+
+func (f *FakeProvider) Channel() entity.NotificationChannel {
+	return f.channel
+}
+
+func (f *FakeProvider) Send(_ context.Context, message entity.NotificationMessage) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return "", f.err
+	}
+
+	f.sent = append(f.sent, message)
+
+	if f.logger != nil {
+		f.logger.Infow("fake notification sent",
+			"channel", message.Channel,
+			"recipient", message.Recipient,
+			"subject", message.Subject,
+		)
+	}
+
+	return fmt.Sprintf("fake-%s-%d", f.channel, len(f.sent)), nil
+}
+
+// FailWith makes every following Send return err, or succeed again when err
+// is nil.
+func (f *FakeProvider) FailWith(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.err = err
+}
+
+// Sent returns a copy of the messages accepted so far.
+func (f *FakeProvider) Sent() []entity.NotificationMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sent := make([]entity.NotificationMessage, len(f.sent))
+	copy(sent, f.sent)
+
+	return sent
+}
@@ -0,0 +1,85 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+)
+
+type HTTPMessageConfig struct {
+	Endpoint string
+	APIKey   string
+	Sender   string
+}
+
+// HTTPMessageProvider delivers SMS and WhatsApp notifications through an
+// HTTP messaging gateway that accepts a JSON payload and answers with the
+// gateway message id.
+type HTTPMessageProvider struct {
+	channel entity.NotificationChannel
+	config  HTTPMessageConfig
+	client  *http.Client
+}
+
+func NewSMSProvider(config HTTPMessageConfig) *HTTPMessageProvider {
+	return newHTTPMessageProvider(entity.NOTIFICATION_CHANNEL_SMS, config)
+}
+
+func NewWhatsAppProvider(config HTTPMessageConfig) *HTTPMessageProvider {
+	return newHTTPMessageProvider(entity.NOTIFICATION_CHANNEL_WHATSAPP, config)
+}
+
+func newHTTPMessageProvider(channel entity.NotificationChannel, config HTTPMessageConfig) *HTTPMessageProvider {
+	return &HTTPMessageProvider{
+		channel: channel,
+		config:  config,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (h *HTTPMessageProvider) Channel() entity.NotificationChannel {
+	return h.channel
+}
+
+func (h *HTTPMessageProvider) Send(ctx context.Context, message entity.NotificationMessage) (string, error) {
+	payload, err := json.Marshal(map[string]string{
+		"channel": string(h.channel),
+		"from":    h.config.Sender,
+		"to":      message.Recipient,
+		"text":    message.Body,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.config.Endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+h.config.APIKey)
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("%s gateway responded with status %d", h.channel, res.StatusCode)
+	}
+
+	var response struct {
+		MessageID string `json:"message_id"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return "", err
+	}
+
+	return response.MessageID, nil
+}
@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPEmailProvider delivers email notifications through an SMTP relay.
+type SMTPEmailProvider struct {
+	config   SMTPConfig
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSMTPEmailProvider(config SMTPConfig) *SMTPEmailProvider {
+	return &SMTPEmailProvider{
+		config:   config,
+		sendMail: smtp.SendMail,
+	}
+}
+
+func (s *SMTPEmailProvider) Channel() entity.NotificationChannel {
+	return entity.NOTIFICATION_CHANNEL_EMAIL
+}
+
+func (s *SMTPEmailProvider) Send(_ context.Context, message entity.NotificationMessage) (string, error) {
+	messageID := fmt.Sprintf("<%d@%s>", time.Now().UnixNano(), s.config.Host)
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+
+	headers := []string{
+		"From: " + s.config.From,
+		"To: " + message.Recipient,
+		"Subject: " + message.Subject,
+		"Message-ID: " + messageID,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=\"UTF-8\"",
+	}
+	body := strings.Join(headers, "\r\n") + "\r\n\r\n" + message.Body
+
+	addr := net.JoinHostPort(s.config.Host, s.config.Port)
+	if err := s.sendMail(addr, auth, s.config.From, []string{message.Recipient}, []byte(body)); err != nil {
+		return "", err
+	}
+
+	return messageID, nil
+}
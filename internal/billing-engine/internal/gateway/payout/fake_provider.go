@@ -0,0 +1,68 @@
+package payout
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"go.uber.org/zap"
+)
+
+// FAKE_REJECTED_ACCOUNT_PREFIX marks the account numbers the fake provider
+// refuses, so failed payouts and their retries can be tried locally.
+const FAKE_REJECTED_ACCOUNT_PREFIX = "999"
+
+// FakeProvider pays nothing out. It completes every payout right away except
+// for the accounts starting with FAKE_REJECTED_ACCOUNT_PREFIX, which fail. It
+// stands in when no bank transfer gateway is configured.
+type FakeProvider struct {
+	logger *zap.SugaredLogger
+
+	mu   sync.Mutex
+	sent []entity.Disbursement
+}
+
+func NewFakeProvider(logger *zap.SugaredLogger) *FakeProvider {
+	return &FakeProvider{
+		logger: logger,
+	}
+}
+
+func (f *FakeProvider) Send(_ context.Context, disbursement entity.Disbursement) (entity.PayoutResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sent = append(f.sent, disbursement)
+	reference := fmt.Sprintf("fake-payout-%d", len(f.sent))
+
+	if f.logger != nil {
+		f.logger.Infow("fake payout sent",
+			"disbursement_id", disbursement.ID,
+			"bank_code", disbursement.BankAccount.BankCode,
+			"amount", disbursement.Amount.StringFixed(2),
+		)
+	}
+
+	if strings.HasPrefix(disbursement.BankAccount.AccountNumber, FAKE_REJECTED_ACCOUNT_PREFIX) {
+		return entity.PayoutResult{
+			Reference:     reference,
+			Status:        entity.DISBURSEMENT_FAILED,
+			FailureReason: "account rejected by the fake provider",
+		}, nil
+	}
+
+	return entity.PayoutResult{Reference: reference, Status: entity.DISBURSEMENT_COMPLETED}, nil
+}
+
+// Sent returns a copy of the disbursements handed over so far.
+func (f *FakeProvider) Sent() []entity.Disbursement {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sent := make([]entity.Disbursement, len(f.sent))
+	copy(sent, f.sent)
+
+	return sent
+}
@@ -0,0 +1,87 @@
+package payout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+)
+
+type HTTPConfig struct {
+	Endpoint string
+	APIKey   string
+}
+
+// HTTPProvider hands payouts to a bank transfer gateway that accepts a JSON
+// transfer instruction and answers with its reference and status. A transfer
+// left SENT is confirmed later through POST /disbursement/confirm. The
+// disbursement ID is the idempotency key of the instruction, so a request
+// resent after a timeout is not paid twice.
+type HTTPProvider struct {
+	config HTTPConfig
+	client *http.Client
+}
+
+func NewHTTPProvider(config HTTPConfig) *HTTPProvider {
+	return &HTTPProvider{
+		config: config,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (h *HTTPProvider) Send(ctx context.Context, disbursement entity.Disbursement) (entity.PayoutResult, error) {
+	payload, err := json.Marshal(map[string]any{
+		"disbursement_id": fmt.Sprintf("%d", disbursement.ID),
+		"bank_code":       disbursement.BankAccount.BankCode,
+		"account_number":  disbursement.BankAccount.AccountNumber,
+		"account_name":    disbursement.BankAccount.AccountName,
+		"amount":          disbursement.Amount.StringFixed(2),
+	})
+	if err != nil {
+		return entity.PayoutResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.config.Endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return entity.PayoutResult{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+h.config.APIKey)
+	req.Header.Set("Idempotency-Key", fmt.Sprintf("%d", disbursement.ID))
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return entity.PayoutResult{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return entity.PayoutResult{}, fmt.Errorf("payout gateway responded with status %d", res.StatusCode)
+	}
+
+	var response struct {
+		Reference     string `json:"reference"`
+		Status        string `json:"status"`
+		FailureReason string `json:"failure_reason"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return entity.PayoutResult{}, err
+	}
+
+	status := entity.DisbursementStatus(response.Status)
+	switch status {
+	case entity.DISBURSEMENT_SENT, entity.DISBURSEMENT_COMPLETED, entity.DISBURSEMENT_FAILED:
+	default:
+		return entity.PayoutResult{}, fmt.Errorf("payout gateway answered unknown status %q", response.Status)
+	}
+
+	return entity.PayoutResult{
+		Reference:     response.Reference,
+		Status:        status,
+		FailureReason: response.FailureReason,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Accounting Period Usecases
+
+// GetLatestClosedPeriod returns the last month that was closed, or an open
+// period without a month when none was closed yet.
+func (b *BillingEngineRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	var period models.AccountingPeriod
+
+	query := b.queryBuilder.
+		Select(period.Columns()...).
+		From(b.accountingPeriodTableName).
+		Where(goqu.Ex{"status": string(entity.PERIOD_CLOSED)}).
+		Order(goqu.C("period").Desc()).
+		Limit(1)
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.AccountingPeriod{}, err
+	}
+
+	if err := row.Scan(period.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.AccountingPeriod{Status: entity.PERIOD_OPEN}, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return entity.AccountingPeriod{}, err
+	}
+
+	return toAccountingPeriodEntity(period), nil
+}
+
+func (b *BillingEngineRepository) GetAccountingPeriods(ctx context.Context) ([]entity.AccountingPeriod, error) {
+	var period models.AccountingPeriod
+
+	query := b.queryBuilder.
+		Select(period.Columns()...).
+		From(b.accountingPeriodTableName).
+		Order(goqu.C("period").Desc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var periods []entity.AccountingPeriod
+	for rows.Next() {
+		if err := rows.Scan(period.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		periods = append(periods, toAccountingPeriodEntity(period))
+	}
+
+	return periods, nil
+}
+
+func (b *BillingEngineRepository) CloseAccountingPeriod(ctx context.Context, period entity.AccountingPeriod) (entity.AccountingPeriod, error) {
+	closePeriod := models.AccountingPeriod{
+		Period:   sql.NullString{String: period.Period, Valid: true},
+		Status:   sql.NullString{String: string(entity.PERIOD_CLOSED), Valid: true},
+		ClosedBy: sql.NullString{String: period.ClosedBy, Valid: true},
+		ClosedAt: sql.NullTime{Time: period.ClosedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.accountingPeriodTableName, &closePeriod); err != nil {
+		return entity.AccountingPeriod{}, err
+	}
+
+	period.Status = entity.PERIOD_CLOSED
+	return period, nil
+}
+
+func toAccountingPeriodEntity(period models.AccountingPeriod) entity.AccountingPeriod {
+	return entity.AccountingPeriod{
+		Period:   period.Period.String,
+		Status:   entity.PeriodStatus(period.Status.String),
+		ClosedBy: period.ClosedBy.String,
+		ClosedAt: period.ClosedAt.Time,
+	}
+}
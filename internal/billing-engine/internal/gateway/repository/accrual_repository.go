@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Interest Accrual Usecases
+
+// GetLoanAccrualStates returns every DISBURSED loan bearing interest with the
+// last day it accrued.
+func (b *BillingEngineRepository) GetLoanAccrualStates(ctx context.Context) ([]entity.LoanAccrualState, error) {
+	var loan models.Loan
+
+	columns := make([]any, 0, len(loan.Columns())+1)
+	for _, column := range loan.StringColumns() {
+		columns = append(columns, goqu.I("l."+column))
+	}
+	columns = append(columns, goqu.MAX(goqu.I("a.accrual_date")))
+
+	query := b.queryBuilder.
+		Select(columns...).
+		From(goqu.T(b.loanTableName).As("l")).
+		LeftJoin(goqu.T(b.interestAccrualTableName).As("a"), goqu.On(
+			goqu.I("a.loan_id").Eq(goqu.I("l.id")),
+			goqu.I("a.type").Eq(string(entity.ACCRUAL_DAILY)),
+		)).
+		Where(goqu.I("l.status").Eq(string(entity.LOAN_DISBURSED))).
+		Where(goqu.I("l.annual_rate").Gt(0)).
+		GroupBy(goqu.I("l.id")).
+		Order(goqu.I("l.id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var states []entity.LoanAccrualState
+	for rows.Next() {
+		var lastAccrualDate sql.NullTime
+		if err := rows.Scan(append(loan.Values(), &lastAccrualDate)...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		states = append(states, entity.LoanAccrualState{
+			Loan:            toLoanEntity(loan),
+			LastAccrualDate: lastAccrualDate.Time,
+		})
+	}
+
+	return states, nil
+}
+
+func (b *BillingEngineRepository) CreateInterestAccrual(ctx context.Context, accrual entity.InterestAccrual) (entity.InterestAccrual, error) {
+	createAccrual := models.InterestAccrual{
+		ID:             sql.NullInt64{Int64: int64(accrual.ID), Valid: true},
+		LoanID:         sql.NullInt64{Int64: int64(accrual.LoanID), Valid: true},
+		AccrualDate:    sql.NullTime{Time: accrual.AccrualDate, Valid: true},
+		Type:           sql.NullString{String: string(accrual.Type), Valid: true},
+		Amount:         accrual.Amount,
+		JournalEntryID: sql.NullInt64{Int64: int64(accrual.JournalEntryID), Valid: accrual.JournalEntryID != 0},
+		CreatedAt:      sql.NullTime{Time: accrual.CreatedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.interestAccrualTableName, &createAccrual); err != nil {
+		return entity.InterestAccrual{}, err
+	}
+
+	return accrual, nil
+}
+
+// GetInterestAccruals returns the accruals with accrual_date in [from, to],
+// of a single loan when loanID is not zero.
+func (b *BillingEngineRepository) GetInterestAccruals(ctx context.Context, loanID uint64, from time.Time, to time.Time) ([]entity.InterestAccrual, error) {
+	var accrual models.InterestAccrual
+
+	query := b.queryBuilder.
+		Select(accrual.Columns()...).
+		From(b.interestAccrualTableName).
+		Where(goqu.C("accrual_date").Gte(from.Format("2006-01-02"))).
+		Where(goqu.C("accrual_date").Lte(to.Format("2006-01-02"))).
+		Order(goqu.C("loan_id").Asc(), goqu.C("accrual_date").Asc(), goqu.C("type").Asc())
+
+	if loanID != 0 {
+		query = query.Where(goqu.Ex{"loan_id": loanID})
+	}
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accruals []entity.InterestAccrual
+	for rows.Next() {
+		if err := rows.Scan(accrual.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		accruals = append(accruals, entity.InterestAccrual{
+			ID:             uint64(accrual.ID.Int64),
+			LoanID:         uint64(accrual.LoanID.Int64),
+			AccrualDate:    accrual.AccrualDate.Time,
+			Type:           entity.AccrualType(accrual.Type.String),
+			Amount:         accrual.Amount,
+			JournalEntryID: uint64(accrual.JournalEntryID.Int64),
+			CreatedAt:      accrual.CreatedAt.Time,
+		})
+	}
+
+	return accruals, nil
+}
+
+// GetLoanAccountBalance returns the debits less the credits posted to an
+// account for a loan.
+func (b *BillingEngineRepository) GetLoanAccountBalance(ctx context.Context, loanID uint64, accountCode string) (decimal.Decimal, error) {
+	query := b.queryBuilder.
+		Select(goqu.COALESCE(goqu.SUM(goqu.L(
+			"CASE WHEN ? = ? THEN ? ELSE -? END",
+			goqu.I("p.direction"), string(entity.POSTING_DEBIT), goqu.I("p.amount"), goqu.I("p.amount"),
+		)), 0)).
+		From(goqu.T(b.postingTableName).As("p")).
+		Join(goqu.T(b.journalEntryTableName).As("j"), goqu.On(goqu.I("j.id").Eq(goqu.I("p.journal_entry_id")))).
+		Where(goqu.I("j.loan_id").Eq(loanID)).
+		Where(goqu.I("p.account_code").Eq(accountCode))
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var balance decimal.Decimal
+	if err := row.Scan(&balance); err != nil {
+		b.logger.Errorw("failed to scan row", "error", err)
+		return decimal.Zero, err
+	}
+
+	return balance, nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestBillingEngineRepository_GetLoanAccrualStates(t *testing.T) {
+	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	lastAccrualDate := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	columns := []string{
+		"id", "customer_id", "principal", "annual_rate", "term_weeks", "start_date", "status", "product_code",
+		"requested_by", "restructured_from_loan_id", "credit_line_id", "merchant_id", "order_ref", "max",
+	}
+
+	// Only the DISBURSED loans with a positive annual rate are accrued, with
+	// the date of the latest daily accrual of each
+	expectedQuery := regexp.QuoteMeta(`FROM "loans" AS "l" LEFT JOIN "interest_accruals" AS "a" ON (("a"."loan_id" = "l"."id") AND ("a"."type" = 'ACCRUAL')) ` +
+		`WHERE (("l"."status" = 'DISBURSED') AND ("l"."annual_rate" > 0)) GROUP BY "l"."id" ORDER BY "l"."id" ASC`)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(sqlmock.Sqlmock)
+		expectedStates []entity.LoanAccrualState
+		expectedError  error
+	}{
+		{
+			name: "success - loans with and without a previous accrual",
+			setupMocks: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(expectedQuery).WillReturnRows(
+					sqlmock.NewRows(columns).
+						AddRow(100, 1, "5000000.00", "0.1", 50, startDate, "DISBURSED", "DEFAULT", "ops", nil, nil, nil, nil, lastAccrualDate).
+						AddRow(200, 2, "1000000.00", "0.2", 25, startDate, "DISBURSED", "DEFAULT", "ops", nil, nil, nil, nil, nil),
+				)
+			},
+			expectedStates: []entity.LoanAccrualState{
+				{
+					Loan: entity.Loan{
+						ID: 100, CustomerID: 1, PrincipalAmount: decimal.RequireFromString("5000000.00"), InterestRate: decimal.RequireFromString("0.1"),
+						TermWeeks: 50, StartDate: startDate, Status: entity.LOAN_DISBURSED, ProductCode: "DEFAULT", RequestedBy: "ops",
+					},
+					LastAccrualDate: lastAccrualDate,
+				},
+				{
+					Loan: entity.Loan{
+						ID: 200, CustomerID: 2, PrincipalAmount: decimal.RequireFromString("1000000.00"), InterestRate: decimal.RequireFromString("0.2"),
+						TermWeeks: 25, StartDate: startDate, Status: entity.LOAN_DISBURSED, ProductCode: "DEFAULT", RequestedBy: "ops",
+					},
+				},
+			},
+		},
+		{
+			name: "success - no loan to accrue",
+			setupMocks: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(expectedQuery).WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "error - query fails",
+			setupMocks: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectQuery(expectedQuery).WillReturnError(errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mockDB, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			tt.setupMocks(mockDB)
+
+			repository := NewBillingEngineRepository(db, zap.NewNop().Sugar(), goqu.Dialect("postgres"), pkgmocks.NewMockSnowflake(t))
+
+			states, err := repository.GetLoanAccrualStates(context.Background())
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStates, states)
+			}
+			assert.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
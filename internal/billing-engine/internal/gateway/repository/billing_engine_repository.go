@@ -26,6 +26,44 @@ type BillingEngineRepository struct {
 	loanTableName        string
 	installmentTableName string
 	paymentTableName     string
+
+	loanRestructureTableName        string
+	moratoriumTableName             string
+	moratoriumInstallmentTableName  string
+	writeOffTableName               string
+	recoveryTableName               string
+	ledgerAccountTableName          string
+	journalEntryTableName           string
+	postingTableName                string
+	interestAccrualTableName        string
+	glMappingTableName              string
+	provisionRateTableName          string
+	loanProvisionTableName          string
+	accountingPeriodTableName       string
+	loanStatusTransitionTableName   string
+	disbursementTableName           string
+	productFeeTableName             string
+	loanFeeTableName                string
+	loanDisclosureTableName         string
+	creditLimitTableName            string
+	customerDuplicateTableName      string
+	customerMergeTableName          string
+	customerMergeRecordTableName    string
+	loanPartyTableName              string
+	collateralTableName             string
+	creditLineTableName             string
+	creditLineBillTableName         string
+	creditLineBillItemTableName     string
+	merchantTableName               string
+	merchantSettlementTableName     string
+	merchantSettlementItemTableName string
+
+	collectionAgentTableName string
+	collectionCaseTableName  string
+	contactAttemptTableName  string
+	promiseToPayTableName    string
+
+	communicationTableName string
 }
 
 func NewBillingEngineRepository(
@@ -45,6 +83,44 @@ func NewBillingEngineRepository(
 		loanTableName:        "loans",
 		installmentTableName: "installments",
 		paymentTableName:     "payments",
+
+		loanRestructureTableName:        "loan_restructures",
+		moratoriumTableName:             "moratoriums",
+		moratoriumInstallmentTableName:  "moratorium_installments",
+		writeOffTableName:               "write_offs",
+		recoveryTableName:               "recoveries",
+		ledgerAccountTableName:          "ledger_accounts",
+		journalEntryTableName:           "journal_entries",
+		postingTableName:                "postings",
+		interestAccrualTableName:        "interest_accruals",
+		glMappingTableName:              "gl_mappings",
+		provisionRateTableName:          "provision_rates",
+		loanProvisionTableName:          "loan_provisions",
+		accountingPeriodTableName:       "accounting_periods",
+		loanStatusTransitionTableName:   "loan_status_transitions",
+		disbursementTableName:           "disbursements",
+		productFeeTableName:             "product_fees",
+		loanFeeTableName:                "loan_fees",
+		loanDisclosureTableName:         "loan_disclosures",
+		creditLimitTableName:            "credit_limits",
+		customerDuplicateTableName:      "customer_duplicates",
+		customerMergeTableName:          "customer_merges",
+		customerMergeRecordTableName:    "customer_merge_records",
+		loanPartyTableName:              "loan_parties",
+		collateralTableName:             "collaterals",
+		creditLineTableName:             "credit_lines",
+		creditLineBillTableName:         "credit_line_bills",
+		creditLineBillItemTableName:     "credit_line_bill_items",
+		merchantTableName:               "merchants",
+		merchantSettlementTableName:     "merchant_settlements",
+		merchantSettlementItemTableName: "merchant_settlement_items",
+
+		collectionAgentTableName: "collection_agents",
+		collectionCaseTableName:  "collection_cases",
+		contactAttemptTableName:  "contact_attempts",
+		promiseToPayTableName:    "promises_to_pay",
+
+		communicationTableName: "communications",
 	}
 }
 
@@ -52,9 +128,16 @@ func (b *BillingEngineRepository) CreateCustomer(
 	ctx context.Context, customer entity.Customer) (entity.Customer, error) {
 
 	createCustomer := models.Customer{
-		ID:    sql.NullInt64{Int64: int64(customer.ID), Valid: true},
-		Name:  sql.NullString{String: customer.Name, Valid: true},
-		Email: sql.NullString{String: customer.Email, Valid: true},
+		ID:       sql.NullInt64{Int64: int64(customer.ID), Valid: true},
+		Name:     sql.NullString{String: customer.Name, Valid: true},
+		Email:    sql.NullString{String: customer.Email, Valid: true},
+		Phone:    sql.NullString{String: customer.Phone, Valid: customer.Phone != ""},
+		Language: sql.NullString{String: string(customer.Language), Valid: true},
+
+		NIK:         sql.NullString{String: customer.NIK, Valid: customer.NIK != ""},
+		DateOfBirth: sql.NullTime{Time: customer.DateOfBirth, Valid: !customer.DateOfBirth.IsZero()},
+		Address:     sql.NullString{String: customer.Address, Valid: customer.Address != ""},
+		KYCStatus:   sql.NullString{String: string(customer.KYCStatus), Valid: true},
 	}
 
 	query := b.queryBuilder.
@@ -69,7 +152,7 @@ func (b *BillingEngineRepository) CreateCustomer(
 		return entity.Customer{}, err
 	}
 
-	res, err := b.db.ExecContext(ctx, sql)
+	res, err := b.conn(ctx).ExecContext(ctx, sql)
 	if err != nil {
 		b.logger.Errorw("failed to execute query", "error", err)
 		return entity.Customer{}, err
@@ -89,47 +172,6 @@ func (b *BillingEngineRepository) CreateCustomer(
 	return customer, nil
 }
 
-func (b *BillingEngineRepository) GetAllCustomer(ctx context.Context) ([]entity.Customer, error) {
-	var customer models.Customer
-
-	query := b.queryBuilder.
-		Select(customer.Columns()...).
-		From(b.customerTableName)
-
-	sql, _, err := query.ToSQL()
-	if err != nil {
-		b.logger.Errorw("failed to build query", "error", err)
-
-		return nil, err
-	}
-
-	rows, err := b.db.QueryContext(ctx, sql)
-	if err != nil {
-		b.logger.Errorw("failed to execute query", "error", err)
-
-		return nil, err
-	}
-	defer rows.Close()
-
-	var customers []entity.Customer
-	for rows.Next() {
-		err := rows.Scan(customer.Values()...)
-		if err != nil {
-			b.logger.Errorw("failed to scan row", "error", err)
-
-			return nil, err
-		}
-
-		customers = append(customers, entity.Customer{
-			ID:    uint64(customer.ID.Int64),
-			Name:  customer.Name.String,
-			Email: customer.Email.String,
-		})
-	}
-
-	return customers, nil
-}
-
 // Loan Usecases
 func (b *BillingEngineRepository) IsCustomerExist(ctx context.Context, customerID uint64) (bool, error) {
 	var customer models.Customer
@@ -137,7 +179,7 @@ func (b *BillingEngineRepository) IsCustomerExist(ctx context.Context, customerI
 	query := b.queryBuilder.
 		Select("id").
 		From(b.customerTableName).
-		Where(goqu.Ex{"id": customerID})
+		Where(goqu.Ex{"id": customerID, "deleted_at": nil})
 
 	sqlQuery, _, err := query.ToSQL()
 	if err != nil {
@@ -145,7 +187,7 @@ func (b *BillingEngineRepository) IsCustomerExist(ctx context.Context, customerI
 		return false, err
 	}
 
-	row := b.db.QueryRowContext(ctx, sqlQuery)
+	row := b.conn(ctx).QueryRowContext(ctx, sqlQuery)
 	err = row.Scan(&customer.ID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -158,34 +200,6 @@ func (b *BillingEngineRepository) IsCustomerExist(ctx context.Context, customerI
 	return true, nil
 }
 
-func (b *BillingEngineRepository) IsCustomerHasNonPaidLoan(ctx context.Context, customerID uint64) (bool, error) {
-	var loan models.Loan
-
-	query := b.queryBuilder.
-		Select("id").
-		From(b.loanTableName).
-		Where(goqu.Ex{"customer_id": customerID}).
-		Where(goqu.Ex{"status": "DISBURSED"})
-
-	sqlQuery, _, err := query.ToSQL()
-	if err != nil {
-		b.logger.Errorw("failed to build query", "error", err)
-		return false, err
-	}
-
-	row := b.db.QueryRowContext(ctx, sqlQuery)
-	err = row.Scan(&loan.ID)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, nil
-		}
-		b.logger.Errorw("failed to scan row", "error", err)
-		return false, err
-	}
-
-	return true, nil
-}
-
 func (b *BillingEngineRepository) IsLoanBelongsToCustomer(ctx context.Context, customerID uint64, loanID uint64) (bool, error) {
 	var loan models.Loan
 
@@ -201,7 +215,7 @@ func (b *BillingEngineRepository) IsLoanBelongsToCustomer(ctx context.Context, c
 		return false, err
 	}
 
-	row := b.db.QueryRowContext(ctx, sqlQuery)
+	row := b.conn(ctx).QueryRowContext(ctx, sqlQuery)
 	err = row.Scan(&loan.ID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -221,8 +235,15 @@ func (b *BillingEngineRepository) CreateLoan(ctx context.Context, loan entity.Lo
 		PrincipalAmount: loan.PrincipalAmount,
 		InterestRate:    loan.InterestRate,
 		TermWeeks:       sql.NullInt64{Int64: loan.TermWeeks, Valid: true},
-		StartDate:       sql.NullTime{Time: loan.StartDate, Valid: true},
+		StartDate:       sql.NullTime{Time: loan.StartDate, Valid: !loan.StartDate.IsZero()},
 		Status:          sql.NullString{String: string(loan.Status), Valid: true},
+		ProductCode:     sql.NullString{String: loan.ProductCode, Valid: true},
+		RequestedBy:     sql.NullString{String: loan.RequestedBy, Valid: loan.RequestedBy != ""},
+
+		RestructuredFromLoanID: sql.NullInt64{Int64: int64(loan.RestructuredFromLoanID), Valid: loan.RestructuredFromLoanID != 0},
+		CreditLineID:           sql.NullInt64{Int64: int64(loan.CreditLineID), Valid: loan.CreditLineID != 0},
+		MerchantID:             sql.NullInt64{Int64: int64(loan.MerchantID), Valid: loan.MerchantID != 0},
+		OrderRef:               sql.NullString{String: loan.OrderRef, Valid: loan.OrderRef != ""},
 	}
 
 	query := b.queryBuilder.
@@ -236,7 +257,7 @@ func (b *BillingEngineRepository) CreateLoan(ctx context.Context, loan entity.Lo
 		return entity.Loan{}, err
 	}
 
-	res, err := b.db.ExecContext(ctx, sqlQuery)
+	res, err := b.conn(ctx).ExecContext(ctx, sqlQuery)
 	if err != nil {
 		b.logger.Errorw("failed to execute query", "error", err)
 		return entity.Loan{}, err
@@ -255,22 +276,29 @@ func (b *BillingEngineRepository) CreateLoan(ctx context.Context, loan entity.Lo
 	return loan, nil
 }
 
-func (b *BillingEngineRepository) CreateInstallmentFromLoan(ctx context.Context, loan *entity.Loan) (bool, error) {
-	// Calculate weekly payment amount (principal + interest) / number of weeks
-	totalAmount := loan.PrincipalAmount.Mul(decimal.NewFromFloat(1.1)) // 10% interest
-	weeklyAmount := totalAmount.Div(decimal.NewFromInt(loan.TermWeeks))
+func (b *BillingEngineRepository) CreateInstallmentFromLoan(ctx context.Context, loan *entity.Loan, fees []entity.LoanFee) (bool, error) {
+	// Calculate weekly payment amount (principal + interest) / number of weeks,
+	// the last week absorbing the rounding to cents
+	weeklyAmounts := entity.InstallmentAmounts(*loan)
+
+	// Amortised fees are due on top of each installment
+	feeSchedule := entity.FeeSchedule(fees, loan.TermWeeks)
 
 	// Create installments for each week
 	for week := int64(1); week <= loan.TermWeeks; week++ {
 		dueDate := loan.StartDate.AddDate(0, 0, int(week*7)) // Add weeks
+		feeAmount := entity.TotalInstallmentFees(feeSchedule[week-1])
 
 		installment := models.Installment{
 			ID:         sql.NullInt64{Int64: int64(b.snowflakeGen.Generate()), Valid: true},
 			LoanID:     sql.NullInt64{Int64: int64(loan.ID), Valid: true},
 			WeekNumber: sql.NullInt64{Int64: week, Valid: true},
 			DueDate:    sql.NullString{String: dueDate.Format("2006-01-02"), Valid: true},
-			AmountDue:  sql.NullString{String: weeklyAmount.String(), Valid: true},
+			AmountDue:  sql.NullString{String: weeklyAmounts[week-1].StringFixed(2), Valid: true},
+			FeeAmount:  sql.NullString{String: feeAmount.StringFixed(2), Valid: true},
 			Status:     sql.NullString{String: "PENDING", Valid: true},
+
+			HolidayInterest: sql.NullString{String: decimal.Zero.StringFixed(2), Valid: true},
 		}
 
 		query := b.queryBuilder.
@@ -284,7 +312,7 @@ func (b *BillingEngineRepository) CreateInstallmentFromLoan(ctx context.Context,
 			return false, err
 		}
 
-		_, err = b.db.ExecContext(ctx, sqlQuery)
+		_, err = b.conn(ctx).ExecContext(ctx, sqlQuery)
 		if err != nil {
 			b.logger.Errorw("failed to execute query", "error", err)
 			return false, err
@@ -310,7 +338,7 @@ func (b *BillingEngineRepository) GetInstallments(ctx context.Context, loanID ui
 		return nil, err
 	}
 
-	rows, err := b.db.QueryContext(ctx, sqlQuery)
+	rows, err := b.conn(ctx).QueryContext(ctx, sqlQuery)
 	if err != nil {
 		b.logger.Errorw("failed to execute query", "error", err)
 		return nil, err
@@ -331,7 +359,10 @@ func (b *BillingEngineRepository) GetInstallments(ctx context.Context, loanID ui
 			WeekNumber: installment.WeekNumber.Int64,
 			DueDate:    installment.DueDate.String,
 			AmountDue:  installment.AmountDue.String,
+			FeeAmount:  installment.FeeAmount.String,
 			Status:     entity.InstallmentStatus(installment.Status.String),
+
+			HolidayInterest: installment.HolidayInterest.String,
 		})
 	}
 
@@ -343,7 +374,7 @@ func (b *BillingEngineRepository) GetOutstanding(ctx context.Context, loanID uin
 	var installment models.Installment
 
 	query := b.queryBuilder.
-		Select("amount_due").
+		Select("amount_due", "fee_amount").
 		From(b.installmentTableName).
 		Where(goqu.Ex{"loan_id": loanID}).
 		Where(goqu.Ex{"status": []string{"PENDING", "MISSED"}})
@@ -354,7 +385,7 @@ func (b *BillingEngineRepository) GetOutstanding(ctx context.Context, loanID uin
 		return decimal.Zero, err
 	}
 
-	rows, err := b.db.QueryContext(ctx, sqlQuery)
+	rows, err := b.conn(ctx).QueryContext(ctx, sqlQuery)
 	if err != nil {
 		b.logger.Errorw("failed to execute query", "error", err)
 		return decimal.Zero, err
@@ -363,13 +394,13 @@ func (b *BillingEngineRepository) GetOutstanding(ctx context.Context, loanID uin
 
 	totalOutstanding := decimal.Zero
 	for rows.Next() {
-		err := rows.Scan(&installment.AmountDue)
+		err := rows.Scan(&installment.AmountDue, &installment.FeeAmount)
 		if err != nil {
 			b.logger.Errorw("failed to scan row", "error", err)
 			return decimal.Zero, err
 		}
 
-		amount, err := decimal.NewFromString(installment.AmountDue.String)
+		amount, err := entity.Installment{AmountDue: installment.AmountDue.String, FeeAmount: installment.FeeAmount.String}.TotalDue()
 		if err != nil {
 			b.logger.Errorw("failed to parse amount", "error", err)
 			return decimal.Zero, err
@@ -399,7 +430,7 @@ func (b *BillingEngineRepository) IsDelinquent(ctx context.Context, loanID uint6
 		return false, err
 	}
 
-	rows, err := b.db.QueryContext(ctx, sqlQuery)
+	rows, err := b.conn(ctx).QueryContext(ctx, sqlQuery)
 	if err != nil {
 		b.logger.Errorw("failed to execute query", "error", err)
 		return false, err
@@ -427,7 +458,7 @@ func (b *BillingEngineRepository) IsDelinquent(ctx context.Context, loanID uint6
 	return false, nil
 }
 
-func (b *BillingEngineRepository) MakePayment(ctx context.Context, loanID uint64, weekNumber int64, amount string) error {
+func (b *BillingEngineRepository) MakePayment(ctx context.Context, loanID uint64, weekNumber int64, amount string, paidAt time.Time) error {
 	// First, find the installment for the specified week
 	var installment models.Installment
 
@@ -443,7 +474,7 @@ func (b *BillingEngineRepository) MakePayment(ctx context.Context, loanID uint64
 		return err
 	}
 
-	row := b.db.QueryRowContext(ctx, sqlQuery)
+	row := b.conn(ctx).QueryRowContext(ctx, sqlQuery)
 	err = row.Scan(installment.Values()...)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -453,9 +484,24 @@ func (b *BillingEngineRepository) MakePayment(ctx context.Context, loanID uint64
 		return err
 	}
 
-	// Check if the payment amount matches the amount due
-	if installment.AmountDue.String != amount {
-		return fmt.Errorf("payment amount %s does not match amount due %s", amount, installment.AmountDue.String)
+	// Check if the payment amount matches the amount due, plus the fees due
+	// with the installment
+	amountDue := installment.AmountDue.String
+	fee, err := decimal.NewFromString(installment.FeeAmount.String)
+	if err != nil {
+		return err
+	}
+
+	if fee.IsPositive() {
+		due, err := decimal.NewFromString(amountDue)
+		if err != nil {
+			return err
+		}
+		amountDue = due.Add(fee).StringFixed(2)
+	}
+
+	if amountDue != amount {
+		return fmt.Errorf("payment amount %s does not match amount due %s", amount, amountDue)
 	}
 
 	// Check if installment is already paid
@@ -463,11 +509,17 @@ func (b *BillingEngineRepository) MakePayment(ctx context.Context, loanID uint64
 		return fmt.Errorf("installment for loan %d week %d is already paid", loanID, weekNumber)
 	}
 
+	// Installments closed by a restructure or a write-off can no longer be paid
+	switch entity.InstallmentStatus(installment.Status.String) {
+	case entity.INSTALLMENT_CLOSED, entity.INSTALLMENT_WRITTEN_OFF:
+		return fmt.Errorf("installment for loan %d week %d is %s", loanID, weekNumber, installment.Status.String)
+	}
+
 	// Create payment record
 	payment := models.Payment{
 		ID:            sql.NullInt64{Int64: int64(b.snowflakeGen.Generate()), Valid: true},
 		InstallmentID: sql.NullInt64{Int64: installment.ID.Int64, Valid: true},
-		PaidAt:        sql.NullTime{Time: time.Now(), Valid: true},
+		PaidAt:        sql.NullTime{Time: paidAt, Valid: true},
 		AmountPaid:    sql.NullString{String: amount, Valid: true},
 	}
 
@@ -482,7 +534,7 @@ func (b *BillingEngineRepository) MakePayment(ctx context.Context, loanID uint64
 		return err
 	}
 
-	_, err = b.db.ExecContext(ctx, paymentSQL)
+	_, err = b.conn(ctx).ExecContext(ctx, paymentSQL)
 	if err != nil {
 		b.logger.Errorw("failed to execute payment query", "error", err)
 		return err
@@ -500,7 +552,7 @@ func (b *BillingEngineRepository) MakePayment(ctx context.Context, loanID uint64
 		return err
 	}
 
-	_, err = b.db.ExecContext(ctx, updateSQL)
+	_, err = b.conn(ctx).ExecContext(ctx, updateSQL)
 	if err != nil {
 		b.logger.Errorw("failed to execute update query", "error", err)
 		return err
@@ -520,7 +572,7 @@ func (b *BillingEngineRepository) MakePayment(ctx context.Context, loanID uint64
 	}
 
 	var unpaidCount int64
-	row = b.db.QueryRowContext(ctx, allPaidSQL)
+	row = b.conn(ctx).QueryRowContext(ctx, allPaidSQL)
 	err = row.Scan(&unpaidCount)
 	if err != nil {
 		b.logger.Errorw("failed to scan unpaid count", "error", err)
@@ -529,25 +581,108 @@ func (b *BillingEngineRepository) MakePayment(ctx context.Context, loanID uint64
 
 	// If no unpaid installments, mark loan as PAID
 	if unpaidCount == 0 {
-		loanUpdateQuery := b.queryBuilder.
-			Update(b.loanTableName).
-			Set(goqu.Record{"status": "PAID"}).
-			Where(goqu.Ex{"id": loanID})
-
-		loanUpdateSQL, _, err := loanUpdateQuery.ToSQL()
-		if err != nil {
-			b.logger.Errorw("failed to build loan update query", "error", err)
+		if _, err := b.moveLoanStatus(ctx, entity.LoanStatusTransition{
+			LoanID:         loanID,
+			FromStatus:     entity.LOAN_DISBURSED,
+			ToStatus:       entity.LOAN_PAID,
+			Actor:          entity.SYSTEM_ACTOR,
+			Reason:         "all installments paid",
+			TransitionedAt: paidAt,
+		}); err != nil {
 			return err
 		}
+	}
 
-		_, err = b.db.ExecContext(ctx, loanUpdateSQL)
-		if err != nil {
-			b.logger.Errorw("failed to execute loan update query", "error", err)
-			return err
+	return nil
+}
+
+// ReversePayment undoes the latest payment of a PAID installment: the payment
+// is flagged as reversed, the installment goes back to PENDING, or MISSED when
+// it is already past due on asOf, and a PAID loan is reopened. It returns the
+// reversed amount and the installment's new status.
+func (b *BillingEngineRepository) ReversePayment(ctx context.Context, loanID uint64, weekNumber int64, asOf time.Time) (string, entity.InstallmentStatus, error) {
+	var installment models.Installment
+
+	query := b.queryBuilder.
+		Select(installment.Columns()...).
+		From(b.installmentTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Where(goqu.Ex{"week_number": weekNumber})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := row.Scan(installment.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return "", "", fmt.Errorf("installment not found for loan %d week %d", loanID, weekNumber)
 		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return "", "", err
 	}
 
-	return nil
+	if installment.Status.String != string(entity.INSTALLMENT_PAID) {
+		return "", "", fmt.Errorf("installment for loan %d week %d is not paid", loanID, weekNumber)
+	}
+
+	var payment models.Payment
+
+	paymentQuery := b.queryBuilder.
+		Select(payment.Columns()...).
+		From(b.paymentTableName).
+		Where(goqu.Ex{"installment_id": installment.ID.Int64}).
+		Where(goqu.C("reversed_at").IsNull()).
+		Order(goqu.C("paid_at").Desc()).
+		Limit(1)
+
+	row, err = b.queryRow(ctx, paymentQuery)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := row.Scan(payment.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return "", "", fmt.Errorf("payment not found for loan %d week %d", loanID, weekNumber)
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return "", "", err
+	}
+
+	if _, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.paymentTableName).
+		Set(goqu.Record{"reversed_at": time.Now()}).
+		Where(goqu.Ex{"id": payment.ID.Int64}),
+	); err != nil {
+		return "", "", err
+	}
+
+	status := entity.INSTALLMENT_PENDING
+	if installment.DueDate.String < asOf.Format("2006-01-02") {
+		status = entity.INSTALLMENT_MISSED
+	}
+
+	if _, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.installmentTableName).
+		Set(goqu.Record{"status": string(status)}).
+		Where(goqu.Ex{"id": installment.ID.Int64}),
+	); err != nil {
+		return "", "", err
+	}
+
+	// A paid loan is open again once one of its installments is unpaid
+	if _, err := b.moveLoanStatus(ctx, entity.LoanStatusTransition{
+		LoanID:         loanID,
+		FromStatus:     entity.LOAN_PAID,
+		ToStatus:       entity.LOAN_DISBURSED,
+		Actor:          entity.SYSTEM_ACTOR,
+		Reason:         "payment reversed",
+		TransitionedAt: time.Now(),
+	}); err != nil {
+		return "", "", err
+	}
+
+	return payment.AmountPaid.String, status, nil
 }
 
 func (b *BillingEngineRepository) UpdateMissedInstallments(ctx context.Context, loanID uint64) error {
@@ -565,7 +700,7 @@ func (b *BillingEngineRepository) UpdateMissedInstallments(ctx context.Context,
 		return err
 	}
 
-	_, err = b.db.ExecContext(ctx, sqlQuery)
+	_, err = b.conn(ctx).ExecContext(ctx, sqlQuery)
 	if err != nil {
 		b.logger.Errorw("failed to execute query", "error", err)
 		return err
@@ -601,7 +736,7 @@ func (b *BillingEngineRepository) GetInstallmentsForDelinquency(ctx context.Cont
 		return nil, err
 	}
 
-	rows, err := b.db.QueryContext(ctx, sqlQuery)
+	rows, err := b.conn(ctx).QueryContext(ctx, sqlQuery)
 	if err != nil {
 		b.logger.Errorw("failed to execute query", "error", err)
 		return nil, err
@@ -646,7 +781,7 @@ func (b *BillingEngineRepository) GetAllInstallments(ctx context.Context, loanID
 		return nil, err
 	}
 
-	rows, err := b.db.QueryContext(ctx, sqlQuery)
+	rows, err := b.conn(ctx).QueryContext(ctx, sqlQuery)
 	if err != nil {
 		b.logger.Errorw("failed to execute query", "error", err)
 		return nil, err
@@ -667,9 +802,52 @@ func (b *BillingEngineRepository) GetAllInstallments(ctx context.Context, loanID
 			WeekNumber: installment.WeekNumber.Int64,
 			DueDate:    installment.DueDate.String,
 			AmountDue:  installment.AmountDue.String,
+			FeeAmount:  installment.FeeAmount.String,
 			Status:     entity.InstallmentStatus(installment.Status.String),
+
+			HolidayInterest: installment.HolidayInterest.String,
 		})
 	}
 
 	return installments, nil
 }
+
+func (b *BillingEngineRepository) GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error) {
+	var customer models.Customer
+
+	query := b.queryBuilder.
+		Select(customer.Columns()...).
+		From(b.customerTableName).
+		Where(goqu.Ex{"id": customerID, "deleted_at": nil})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.Customer{}, err
+	}
+
+	if err := row.Scan(customer.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Customer{}, fmt.Errorf("customer %d not found", customerID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return entity.Customer{}, err
+	}
+
+	return toCustomerEntity(customer), nil
+}
+
+func toCustomerEntity(customer models.Customer) entity.Customer {
+	return entity.Customer{
+		ID:       uint64(customer.ID.Int64),
+		Name:     customer.Name.String,
+		Email:    customer.Email.String,
+		Phone:    customer.Phone.String,
+		Language: entity.Language(customer.Language.String),
+
+		NIK:         customer.NIK.String,
+		DateOfBirth: customer.DateOfBirth.Time,
+		Address:     customer.Address.String,
+		KYCStatus:   entity.KYCStatus(customer.KYCStatus.String),
+		DeletedAt:   customer.DeletedAt.Time,
+	}
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Collateral Usecases
+
+func (b *BillingEngineRepository) CreateCollateral(ctx context.Context, collateral entity.Collateral) (entity.Collateral, error) {
+	createCollateral := models.Collateral{
+		ID:            sql.NullInt64{Int64: int64(collateral.ID), Valid: true},
+		LoanID:        sql.NullInt64{Int64: int64(collateral.LoanID), Valid: true},
+		Type:          sql.NullString{String: string(collateral.Type), Valid: true},
+		Description:   sql.NullString{String: collateral.Description, Valid: true},
+		Valuation:     collateral.Valuation,
+		ValuationDate: sql.NullTime{Time: collateral.ValuationDate, Valid: true},
+		LienStatus:    sql.NullString{String: string(collateral.LienStatus), Valid: true},
+		RegisteredBy:  sql.NullString{String: collateral.RegisteredBy, Valid: true},
+		RegisteredAt:  sql.NullTime{Time: collateral.RegisteredAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.collateralTableName, &createCollateral); err != nil {
+		return entity.Collateral{}, err
+	}
+
+	return collateral, nil
+}
+
+func (b *BillingEngineRepository) GetCollateral(ctx context.Context, collateralID uint64) (entity.Collateral, error) {
+	var collateral models.Collateral
+
+	query := b.queryBuilder.
+		Select(collateral.Columns()...).
+		From(b.collateralTableName).
+		Where(goqu.Ex{"id": collateralID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.Collateral{}, err
+	}
+
+	if err := row.Scan(collateral.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Collateral{}, fmt.Errorf("collateral %d not found", collateralID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "collateral_id", collateralID)
+		return entity.Collateral{}, err
+	}
+
+	return toCollateralEntity(collateral), nil
+}
+
+// GetLoanCollaterals returns the collateral pledged against a loan, released
+// or not, in the order it was registered.
+func (b *BillingEngineRepository) GetLoanCollaterals(ctx context.Context, loanID uint64) ([]entity.Collateral, error) {
+	var collateral models.Collateral
+
+	query := b.queryBuilder.
+		Select(collateral.Columns()...).
+		From(b.collateralTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Order(goqu.C("registered_at").Asc(), goqu.C("id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var collaterals []entity.Collateral
+	for rows.Next() {
+		if err := rows.Scan(collateral.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err, "loan_id", loanID)
+			return nil, err
+		}
+
+		collaterals = append(collaterals, toCollateralEntity(collateral))
+	}
+
+	return collaterals, nil
+}
+
+func (b *BillingEngineRepository) UpdateCollateralValuation(ctx context.Context, collateralID uint64, valuation decimal.Decimal, valuationDate time.Time) error {
+	_, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.collateralTableName).
+		Set(goqu.Record{
+			"valuation":      valuation,
+			"valuation_date": valuationDate.Format("2006-01-02"),
+		}).
+		Where(goqu.Ex{"id": collateralID}),
+	)
+
+	return err
+}
+
+// UpdateCollateralLien moves the lien of a collateral only when it is still
+// in status from, and reports whether it was.
+func (b *BillingEngineRepository) UpdateCollateralLien(ctx context.Context, collateralID uint64, from entity.LienStatus, to entity.LienStatus, updatedBy string, updatedAt time.Time) (bool, error) {
+	updated, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.collateralTableName).
+		Set(goqu.Record{
+			"lien_status":     string(to),
+			"lien_updated_by": updatedBy,
+			"lien_updated_at": updatedAt,
+		}).
+		Where(goqu.Ex{"id": collateralID}).
+		Where(goqu.Ex{"lien_status": string(from)}),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return updated > 0, nil
+}
+
+// ReleaseLoanCollaterals releases the liens still held against a loan on
+// behalf of the system and returns how many were released.
+func (b *BillingEngineRepository) ReleaseLoanCollaterals(ctx context.Context, loanID uint64, releasedAt time.Time) (int64, error) {
+	return b.execUpdate(ctx, b.queryBuilder.
+		Update(b.collateralTableName).
+		Set(goqu.Record{
+			"lien_status":     string(entity.LIEN_RELEASED),
+			"lien_updated_by": entity.SYSTEM_ACTOR,
+			"lien_updated_at": releasedAt,
+		}).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Where(goqu.Ex{"lien_status": goqu.Op{"neq": string(entity.LIEN_RELEASED)}}),
+	)
+}
+
+// PledgeLoanCollaterals registers again the liens of a loan the system
+// released, and returns how many were registered. Liens released by hand are
+// left released.
+func (b *BillingEngineRepository) PledgeLoanCollaterals(ctx context.Context, loanID uint64, pledgedAt time.Time) (int64, error) {
+	return b.execUpdate(ctx, b.queryBuilder.
+		Update(b.collateralTableName).
+		Set(goqu.Record{
+			"lien_status":     string(entity.LIEN_REGISTERED),
+			"lien_updated_by": entity.SYSTEM_ACTOR,
+			"lien_updated_at": pledgedAt,
+		}).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Where(goqu.Ex{"lien_status": string(entity.LIEN_RELEASED)}).
+		Where(goqu.Ex{"lien_updated_by": entity.SYSTEM_ACTOR}),
+	)
+}
+
+// GetCollateralisedLoans returns the active loans with collateral still held
+// against them, with what is at risk on each and the valuation held.
+func (b *BillingEngineRepository) GetCollateralisedLoans(ctx context.Context) ([]entity.CollateralisedLoan, error) {
+	query := b.queryBuilder.
+		Select(
+			goqu.I("l.id"),
+			goqu.I("l.customer_id"),
+			goqu.I("l.status"),
+			b.loanExposure(),
+			goqu.SUM(goqu.I("c.valuation")),
+		).
+		From(goqu.T(b.loanTableName).As("l")).
+		Join(goqu.T(b.collateralTableName).As("c"), goqu.On(goqu.I("c.loan_id").Eq(goqu.I("l.id")))).
+		Where(goqu.I("l.status").In(activeLoanStatuses)).
+		Where(goqu.I("c.lien_status").Neq(string(entity.LIEN_RELEASED))).
+		GroupBy(goqu.I("l.id")).
+		Order(goqu.I("l.id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var loans []entity.CollateralisedLoan
+	for rows.Next() {
+		var (
+			loan   entity.CollateralisedLoan
+			status string
+		)
+		if err := rows.Scan(&loan.LoanID, &loan.CustomerID, &status, &loan.Exposure, &loan.Valuation); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		loan.LoanStatus = entity.LoanStatus(status)
+		loans = append(loans, loan)
+	}
+
+	return loans, nil
+}
+
+func toCollateralEntity(collateral models.Collateral) entity.Collateral {
+	return entity.Collateral{
+		ID:            uint64(collateral.ID.Int64),
+		LoanID:        uint64(collateral.LoanID.Int64),
+		Type:          entity.CollateralType(collateral.Type.String),
+		Description:   collateral.Description.String,
+		Valuation:     collateral.Valuation,
+		ValuationDate: collateral.ValuationDate.Time,
+		LienStatus:    entity.LienStatus(collateral.LienStatus.String),
+		RegisteredBy:  collateral.RegisteredBy.String,
+		RegisteredAt:  collateral.RegisteredAt.Time,
+		LienUpdatedBy: collateral.LienUpdatedBy.String,
+		LienUpdatedAt: collateral.LienUpdatedAt.Time,
+	}
+}
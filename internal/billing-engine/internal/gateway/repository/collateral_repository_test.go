@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestBillingEngineRepository_LoanCollaterals(t *testing.T) {
+	updatedAt := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		update        func(*BillingEngineRepository) (int64, error)
+		expectedQuery string
+		setupResult   func(*sqlmock.ExpectedExec)
+		expectedRows  int64
+		expectedError error
+	}{
+		{
+			name: "success - liens still held released by the system",
+			update: func(repository *BillingEngineRepository) (int64, error) {
+				return repository.ReleaseLoanCollaterals(context.Background(), 100, updatedAt)
+			},
+			expectedQuery: `UPDATE "collaterals" SET "lien_status"='RELEASED',"lien_updated_at"='2024-03-05T00:00:00Z',"lien_updated_by"='system' ` +
+				`WHERE (("loan_id" = 100) AND ("lien_status" != 'RELEASED'))`,
+			setupResult:  func(exec *sqlmock.ExpectedExec) { exec.WillReturnResult(sqlmock.NewResult(0, 2)) },
+			expectedRows: 2,
+		},
+		{
+			name: "success - only the liens the system released pledged again",
+			update: func(repository *BillingEngineRepository) (int64, error) {
+				return repository.PledgeLoanCollaterals(context.Background(), 100, updatedAt)
+			},
+			expectedQuery: `UPDATE "collaterals" SET "lien_status"='REGISTERED',"lien_updated_at"='2024-03-05T00:00:00Z',"lien_updated_by"='system' ` +
+				`WHERE (("loan_id" = 100) AND ("lien_status" = 'RELEASED') AND ("lien_updated_by" = 'system'))`,
+			setupResult:  func(exec *sqlmock.ExpectedExec) { exec.WillReturnResult(sqlmock.NewResult(0, 1)) },
+			expectedRows: 1,
+		},
+		{
+			name: "error - update fails",
+			update: func(repository *BillingEngineRepository) (int64, error) {
+				return repository.PledgeLoanCollaterals(context.Background(), 100, updatedAt)
+			},
+			expectedQuery: `UPDATE "collaterals"`,
+			setupResult:   func(exec *sqlmock.ExpectedExec) { exec.WillReturnError(errors.New("db error")) },
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mockDB, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			tt.setupResult(mockDB.ExpectExec(regexp.QuoteMeta(tt.expectedQuery)))
+
+			repository := NewBillingEngineRepository(db, zap.NewNop().Sugar(), goqu.Dialect("postgres"), pkgmocks.NewMockSnowflake(t))
+
+			rows, err := tt.update(repository)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRows, rows)
+			}
+			assert.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
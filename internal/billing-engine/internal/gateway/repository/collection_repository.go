@@ -0,0 +1,456 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Collection Usecases
+func (b *BillingEngineRepository) CreateCollectionAgent(ctx context.Context, agent entity.CollectionAgent) (entity.CollectionAgent, error) {
+	createAgent := models.CollectionAgent{
+		ID:     sql.NullInt64{Int64: int64(agent.ID), Valid: true},
+		Name:   sql.NullString{String: agent.Name, Valid: true},
+		Bucket: sql.NullString{String: string(agent.Bucket), Valid: true},
+		Active: sql.NullBool{Bool: agent.Active, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.collectionAgentTableName, &createAgent); err != nil {
+		return entity.CollectionAgent{}, err
+	}
+
+	return agent, nil
+}
+
+func (b *BillingEngineRepository) GetActiveCollectionAgents(ctx context.Context) ([]entity.CollectionAgent, error) {
+	var agent models.CollectionAgent
+
+	query := b.queryBuilder.
+		Select(agent.Columns()...).
+		From(b.collectionAgentTableName).
+		Where(goqu.Ex{"active": true}).
+		Order(goqu.C("id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var agents []entity.CollectionAgent
+	for rows.Next() {
+		if err := rows.Scan(agent.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		agents = append(agents, entity.CollectionAgent{
+			ID:     uint64(agent.ID.Int64),
+			Name:   agent.Name.String,
+			Bucket: entity.CollectionBucket(agent.Bucket.String),
+			Active: agent.Active.Bool,
+		})
+	}
+
+	return agents, nil
+}
+
+// MarkMissedInstallments flags every PENDING installment of a DISBURSED loan
+// whose due date is before asOf as MISSED. Loans in a moratorium on asOf are
+// left untouched.
+func (b *BillingEngineRepository) MarkMissedInstallments(ctx context.Context, asOf time.Time) error {
+	activeLoans := b.queryBuilder.
+		From(b.loanTableName).
+		Select("id").
+		Where(goqu.Ex{"status": string(entity.LOAN_DISBURSED)})
+
+	query := b.queryBuilder.
+		Update(b.installmentTableName).
+		Set(goqu.Record{"status": string(entity.INSTALLMENT_MISSED)}).
+		Where(goqu.Ex{"status": string(entity.INSTALLMENT_PENDING)}).
+		Where(goqu.Ex{"due_date": goqu.Op{"lt": asOf.Format("2006-01-02")}}).
+		Where(goqu.Ex{"loan_id": activeLoans}).
+		Where(goqu.Ex{"loan_id": goqu.Op{"notIn": b.loansUnderMoratorium(asOf)}})
+
+	_, err := b.execUpdate(ctx, query)
+
+	return err
+}
+
+func (b *BillingEngineRepository) GetDelinquentLoans(ctx context.Context) ([]entity.DelinquentLoan, error) {
+	query := b.queryBuilder.
+		Select(
+			goqu.I("i.loan_id"),
+			goqu.I("l.customer_id"),
+			goqu.I("i.week_number"),
+			goqu.I("i.due_date"),
+		).
+		From(goqu.T(b.installmentTableName).As("i")).
+		Join(goqu.T(b.loanTableName).As("l"), goqu.On(goqu.I("l.id").Eq(goqu.I("i.loan_id")))).
+		Where(goqu.I("i.status").Eq(string(entity.INSTALLMENT_MISSED))).
+		Where(goqu.I("l.status").Eq(string(entity.LOAN_DISBURSED))).
+		Order(goqu.I("i.loan_id").Asc(), goqu.I("i.week_number").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		order       []uint64
+		loans       = map[uint64]*entity.DelinquentLoan{}
+		missedWeeks = map[uint64][]int64{}
+	)
+	for rows.Next() {
+		var loanID, customerID, weekNumber sql.NullInt64
+		var dueDate sql.NullTime
+		if err := rows.Scan(&loanID, &customerID, &weekNumber, &dueDate); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		id := uint64(loanID.Int64)
+		loan, ok := loans[id]
+		if !ok {
+			loan = &entity.DelinquentLoan{
+				LoanID:          id,
+				CustomerID:      uint64(customerID.Int64),
+				OldestMissedDue: dueDate.Time,
+			}
+			loans[id] = loan
+			order = append(order, id)
+		}
+
+		loan.MissedInstallments++
+		loan.LatestMissedWeek = weekNumber.Int64
+		missedWeeks[id] = append(missedWeeks[id], weekNumber.Int64)
+	}
+
+	var delinquentLoans []entity.DelinquentLoan
+	for _, id := range order {
+		if entity.HasConsecutiveMissedWeeks(missedWeeks[id]) {
+			delinquentLoans = append(delinquentLoans, *loans[id])
+		}
+	}
+
+	return delinquentLoans, nil
+}
+
+func (b *BillingEngineRepository) GetOpenCollectionCaseLoanIDs(ctx context.Context) ([]uint64, error) {
+	query := b.queryBuilder.
+		Select("loan_id").
+		From(b.collectionCaseTableName).
+		Where(goqu.Ex{"status": string(entity.COLLECTION_CASE_OPEN)})
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var loanIDs []uint64
+	for rows.Next() {
+		var loanID sql.NullInt64
+		if err := rows.Scan(&loanID); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		loanIDs = append(loanIDs, uint64(loanID.Int64))
+	}
+
+	return loanIDs, nil
+}
+
+// GetLastAssignedCollectionAgentID returns the agent that received the most
+// recent case so round-robin assignment continues across runs. It returns 0
+// when no case was ever assigned.
+func (b *BillingEngineRepository) GetLastAssignedCollectionAgentID(ctx context.Context) (uint64, error) {
+	query := b.queryBuilder.
+		Select("agent_id").
+		From(b.collectionCaseTableName).
+		Order(goqu.C("opened_at").Desc(), goqu.C("id").Desc()).
+		Limit(1)
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	var agentID sql.NullInt64
+	if err := row.Scan(&agentID); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return 0, err
+	}
+
+	return uint64(agentID.Int64), nil
+}
+
+func (b *BillingEngineRepository) CreateCollectionCase(ctx context.Context, collectionCase entity.CollectionCase) (entity.CollectionCase, error) {
+	createCase := models.CollectionCase{
+		ID:                 sql.NullInt64{Int64: int64(collectionCase.ID), Valid: true},
+		LoanID:             sql.NullInt64{Int64: int64(collectionCase.LoanID), Valid: true},
+		CustomerID:         sql.NullInt64{Int64: int64(collectionCase.CustomerID), Valid: true},
+		AgentID:            sql.NullInt64{Int64: int64(collectionCase.AgentID), Valid: true},
+		Bucket:             sql.NullString{String: string(collectionCase.Bucket), Valid: true},
+		DaysPastDue:        sql.NullInt64{Int64: collectionCase.DaysPastDue, Valid: true},
+		MissedInstallments: sql.NullInt64{Int64: collectionCase.MissedInstallments, Valid: true},
+		Status:             sql.NullString{String: string(collectionCase.Status), Valid: true},
+		OpenedAt:           sql.NullTime{Time: collectionCase.OpenedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.collectionCaseTableName, &createCase); err != nil {
+		return entity.CollectionCase{}, err
+	}
+
+	return collectionCase, nil
+}
+
+func (b *BillingEngineRepository) GetCollectionCase(ctx context.Context, caseID uint64) (entity.CollectionCase, error) {
+	var collectionCase models.CollectionCase
+
+	query := b.queryBuilder.
+		Select(collectionCase.Columns()...).
+		From(b.collectionCaseTableName).
+		Where(goqu.Ex{"id": caseID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.CollectionCase{}, err
+	}
+
+	if err := row.Scan(collectionCase.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.CollectionCase{}, fmt.Errorf("collection case %d not found", caseID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return entity.CollectionCase{}, err
+	}
+
+	return toCollectionCaseEntity(collectionCase), nil
+}
+
+func (b *BillingEngineRepository) GetCollectionCasesByAgent(ctx context.Context, agentID uint64) ([]entity.CollectionCase, error) {
+	var collectionCase models.CollectionCase
+
+	query := b.queryBuilder.
+		Select(collectionCase.Columns()...).
+		From(b.collectionCaseTableName).
+		Where(goqu.Ex{"agent_id": agentID}).
+		Order(goqu.C("opened_at").Desc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cases []entity.CollectionCase
+	for rows.Next() {
+		if err := rows.Scan(collectionCase.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		cases = append(cases, toCollectionCaseEntity(collectionCase))
+	}
+
+	return cases, nil
+}
+
+func (b *BillingEngineRepository) CreateContactAttempt(ctx context.Context, attempt entity.ContactAttempt) (entity.ContactAttempt, error) {
+	createAttempt := models.ContactAttempt{
+		ID:          sql.NullInt64{Int64: int64(attempt.ID), Valid: true},
+		CaseID:      sql.NullInt64{Int64: int64(attempt.CaseID), Valid: true},
+		AgentID:     sql.NullInt64{Int64: int64(attempt.AgentID), Valid: true},
+		Channel:     sql.NullString{String: string(attempt.Channel), Valid: true},
+		Outcome:     sql.NullString{String: string(attempt.Outcome), Valid: true},
+		Notes:       sql.NullString{String: attempt.Notes, Valid: true},
+		AttemptedAt: sql.NullTime{Time: attempt.AttemptedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.contactAttemptTableName, &createAttempt); err != nil {
+		return entity.ContactAttempt{}, err
+	}
+
+	return attempt, nil
+}
+
+func (b *BillingEngineRepository) GetContactAttemptsByCase(ctx context.Context, caseID uint64) ([]entity.ContactAttempt, error) {
+	var attempt models.ContactAttempt
+
+	query := b.queryBuilder.
+		Select(attempt.Columns()...).
+		From(b.contactAttemptTableName).
+		Where(goqu.Ex{"case_id": caseID}).
+		Order(goqu.C("attempted_at").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []entity.ContactAttempt
+	for rows.Next() {
+		if err := rows.Scan(attempt.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		attempts = append(attempts, entity.ContactAttempt{
+			ID:          uint64(attempt.ID.Int64),
+			CaseID:      uint64(attempt.CaseID.Int64),
+			AgentID:     uint64(attempt.AgentID.Int64),
+			Channel:     entity.ContactChannel(attempt.Channel.String),
+			Outcome:     entity.ContactOutcome(attempt.Outcome.String),
+			Notes:       attempt.Notes.String,
+			AttemptedAt: attempt.AttemptedAt.Time,
+		})
+	}
+
+	return attempts, nil
+}
+
+func (b *BillingEngineRepository) CreatePromiseToPay(ctx context.Context, promise entity.PromiseToPay) (entity.PromiseToPay, error) {
+	createPromise := models.PromiseToPay{
+		ID:             sql.NullInt64{Int64: int64(promise.ID), Valid: true},
+		CaseID:         sql.NullInt64{Int64: int64(promise.CaseID), Valid: true},
+		LoanID:         sql.NullInt64{Int64: int64(promise.LoanID), Valid: true},
+		PromisedDate:   sql.NullTime{Time: promise.PromisedDate, Valid: true},
+		PromisedAmount: promise.PromisedAmount,
+		Status:         sql.NullString{String: string(promise.Status), Valid: true},
+		CreatedAt:      sql.NullTime{Time: promise.CreatedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.promiseToPayTableName, &createPromise); err != nil {
+		return entity.PromiseToPay{}, err
+	}
+
+	return promise, nil
+}
+
+func (b *BillingEngineRepository) GetPromisesToPayByCase(ctx context.Context, caseID uint64) ([]entity.PromiseToPay, error) {
+	var promise models.PromiseToPay
+
+	query := b.queryBuilder.
+		Select(promise.Columns()...).
+		From(b.promiseToPayTableName).
+		Where(goqu.Ex{"case_id": caseID}).
+		Order(goqu.C("created_at").Asc())
+
+	return b.scanPromisesToPay(ctx, query)
+}
+
+// GetPendingPromisesToPayDueBefore returns PENDING promises whose promised
+// date is strictly before asOf, i.e. the promised day has fully passed.
+func (b *BillingEngineRepository) GetPendingPromisesToPayDueBefore(ctx context.Context, asOf time.Time) ([]entity.PromiseToPay, error) {
+	var promise models.PromiseToPay
+
+	query := b.queryBuilder.
+		Select(promise.Columns()...).
+		From(b.promiseToPayTableName).
+		Where(goqu.Ex{"status": string(entity.PROMISE_TO_PAY_PENDING)}).
+		Where(goqu.Ex{"promised_date": goqu.Op{"lt": asOf.Format("2006-01-02")}}).
+		Order(goqu.C("promised_date").Asc())
+
+	return b.scanPromisesToPay(ctx, query)
+}
+
+// GetTotalPaidForLoanBetween sums the payments booked against a loan's
+// installments with paid_at in [from, to), leaving out reversed payments.
+func (b *BillingEngineRepository) GetTotalPaidForLoanBetween(ctx context.Context, loanID uint64, from time.Time, to time.Time) (decimal.Decimal, error) {
+	query := b.queryBuilder.
+		Select(goqu.COALESCE(goqu.SUM(goqu.I("p.amount_paid")), 0)).
+		From(goqu.T(b.paymentTableName).As("p")).
+		Join(goqu.T(b.installmentTableName).As("i"), goqu.On(goqu.I("i.id").Eq(goqu.I("p.installment_id")))).
+		Where(goqu.I("i.loan_id").Eq(loanID)).
+		Where(goqu.I("p.reversed_at").IsNull()).
+		Where(goqu.I("p.paid_at").Gte(from)).
+		Where(goqu.I("p.paid_at").Lt(to))
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var total decimal.Decimal
+	if err := row.Scan(&total); err != nil {
+		b.logger.Errorw("failed to scan row", "error", err)
+		return decimal.Zero, err
+	}
+
+	return total, nil
+}
+
+func (b *BillingEngineRepository) UpdatePromiseToPayStatus(ctx context.Context, promiseID uint64, status entity.PromiseToPayStatus) error {
+	query := b.queryBuilder.
+		Update(b.promiseToPayTableName).
+		Set(goqu.Record{"status": string(status)}).
+		Where(goqu.Ex{"id": promiseID})
+
+	row, err := b.execUpdate(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if row == 0 {
+		return fmt.Errorf("promise to pay %d not found", promiseID)
+	}
+
+	return nil
+}
+
+func (b *BillingEngineRepository) scanPromisesToPay(ctx context.Context, query *goqu.SelectDataset) ([]entity.PromiseToPay, error) {
+	var promise models.PromiseToPay
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var promises []entity.PromiseToPay
+	for rows.Next() {
+		if err := rows.Scan(promise.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		promises = append(promises, entity.PromiseToPay{
+			ID:             uint64(promise.ID.Int64),
+			CaseID:         uint64(promise.CaseID.Int64),
+			LoanID:         uint64(promise.LoanID.Int64),
+			PromisedDate:   promise.PromisedDate.Time,
+			PromisedAmount: promise.PromisedAmount,
+			Status:         entity.PromiseToPayStatus(promise.Status.String),
+			CreatedAt:      promise.CreatedAt.Time,
+		})
+	}
+
+	return promises, nil
+}
+
+func toCollectionCaseEntity(collectionCase models.CollectionCase) entity.CollectionCase {
+	return entity.CollectionCase{
+		ID:                 uint64(collectionCase.ID.Int64),
+		LoanID:             uint64(collectionCase.LoanID.Int64),
+		CustomerID:         uint64(collectionCase.CustomerID.Int64),
+		AgentID:            uint64(collectionCase.AgentID.Int64),
+		Bucket:             entity.CollectionBucket(collectionCase.Bucket.String),
+		DaysPastDue:        collectionCase.DaysPastDue.Int64,
+		MissedInstallments: collectionCase.MissedInstallments.Int64,
+		Status:             entity.CollectionCaseStatus(collectionCase.Status.String),
+		OpenedAt:           collectionCase.OpenedAt.Time,
+	}
+}
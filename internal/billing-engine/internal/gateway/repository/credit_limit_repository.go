@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Credit Limit Usecases
+
+// GetCreditLimit returns the credit limit of a customer, or the default one
+// when none was set.
+func (b *BillingEngineRepository) GetCreditLimit(ctx context.Context, customerID uint64) (entity.CreditLimit, error) {
+	var limit models.CreditLimit
+
+	query := b.queryBuilder.
+		Select(limit.Columns()...).
+		From(b.creditLimitTableName).
+		Where(goqu.Ex{"customer_id": customerID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.CreditLimit{}, err
+	}
+
+	if err := row.Scan(limit.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.DefaultCreditLimit(customerID), nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return entity.CreditLimit{}, err
+	}
+
+	return entity.CreditLimit{
+		CustomerID:     uint64(limit.CustomerID.Int64),
+		Limit:          limit.CreditLimit,
+		MaxActiveLoans: limit.MaxActiveLoans.Int64,
+		UpdatedBy:      limit.UpdatedBy.String,
+		UpdatedAt:      limit.UpdatedAt.Time,
+	}, nil
+}
+
+// SaveCreditLimit sets the credit limit of a customer, replacing the previous
+// one.
+func (b *BillingEngineRepository) SaveCreditLimit(ctx context.Context, limit entity.CreditLimit) (entity.CreditLimit, error) {
+	saveLimit := models.CreditLimit{
+		CustomerID:     sql.NullInt64{Int64: int64(limit.CustomerID), Valid: true},
+		CreditLimit:    limit.Limit,
+		MaxActiveLoans: sql.NullInt64{Int64: limit.MaxActiveLoans, Valid: true},
+		UpdatedBy:      sql.NullString{String: limit.UpdatedBy, Valid: true},
+		UpdatedAt:      sql.NullTime{Time: limit.UpdatedAt, Valid: true},
+	}
+
+	query := b.queryBuilder.
+		Insert(b.creditLimitTableName).
+		Cols(saveLimit.Columns()...).
+		Vals(saveLimit.Values()).
+		OnConflict(goqu.DoUpdate("customer_id", goqu.Record{
+			"credit_limit":     goqu.L("EXCLUDED.credit_limit"),
+			"max_active_loans": goqu.L("EXCLUDED.max_active_loans"),
+			"updated_by":       goqu.L("EXCLUDED.updated_by"),
+			"updated_at":       goqu.L("EXCLUDED.updated_at"),
+		}))
+
+	sqlQuery, _, err := query.ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build query", "error", err, "table", b.creditLimitTableName)
+		return entity.CreditLimit{}, err
+	}
+
+	if _, err := b.conn(ctx).ExecContext(ctx, sqlQuery); err != nil {
+		b.logger.Errorw("failed to execute query", "error", err, "table", b.creditLimitTableName)
+		return entity.CreditLimit{}, err
+	}
+
+	return limit, nil
+}
+
+// GetCustomerExposure counts the active loans of a customer and sums what
+// they owe on them, and on the loans they co-borrow or guarantee.
+func (b *BillingEngineRepository) GetCustomerExposure(ctx context.Context, customerID uint64) (entity.CustomerExposure, error) {
+	exposure := entity.CustomerExposure{CustomerID: customerID}
+
+	loanQuery := b.queryBuilder.
+		Select(
+			goqu.COUNT("id"),
+			goqu.COALESCE(goqu.SUM(goqu.L(
+				"CASE WHEN ? IN ? THEN ? ELSE 0 END",
+				goqu.I("status"),
+				[]string{string(entity.LOAN_APPLIED), string(entity.LOAN_APPROVED)},
+				goqu.I("principal"),
+			)), 0),
+		).
+		From(b.loanTableName).
+		Where(goqu.Ex{"customer_id": customerID}).
+		Where(goqu.Ex{"status": []string{
+			string(entity.LOAN_APPLIED),
+			string(entity.LOAN_APPROVED),
+			string(entity.LOAN_DISBURSED),
+		}})
+
+	row, err := b.queryRow(ctx, loanQuery)
+	if err != nil {
+		return entity.CustomerExposure{}, err
+	}
+
+	if err := row.Scan(&exposure.ActiveLoans, &exposure.Committed); err != nil {
+		b.logger.Errorw("failed to scan row", "error", err, "customer_id", customerID)
+		return entity.CustomerExposure{}, err
+	}
+
+	installmentQuery := b.queryBuilder.
+		Select(goqu.COALESCE(goqu.SUM(goqu.L("? + ?", goqu.I("i.amount_due"), goqu.I("i.fee_amount"))), 0)).
+		From(goqu.T(b.installmentTableName).As("i")).
+		Join(goqu.T(b.loanTableName).As("l"), goqu.On(goqu.I("l.id").Eq(goqu.I("i.loan_id")))).
+		Where(goqu.I("l.customer_id").Eq(customerID)).
+		Where(goqu.I("l.status").Eq(string(entity.LOAN_DISBURSED))).
+		Where(goqu.I("i.status").In(string(entity.INSTALLMENT_PENDING), string(entity.INSTALLMENT_MISSED)))
+
+	row, err = b.queryRow(ctx, installmentQuery)
+	if err != nil {
+		return entity.CustomerExposure{}, err
+	}
+
+	var outstanding decimal.Decimal
+	if err := row.Scan(&outstanding); err != nil {
+		b.logger.Errorw("failed to scan row", "error", err, "customer_id", customerID)
+		return entity.CustomerExposure{}, err
+	}
+	exposure.Outstanding = outstanding
+
+	obligations, err := b.GetLoanObligations(ctx, customerID)
+	if err != nil {
+		return entity.CustomerExposure{}, err
+	}
+	exposure.CoBorrowed = entity.SumObligations(obligations, entity.LOAN_PARTY_CO_BORROWER)
+	exposure.Guaranteed = entity.SumObligations(obligations, entity.LOAN_PARTY_GUARANTOR)
+
+	return exposure, nil
+}
@@ -0,0 +1,444 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Credit Line Usecases
+
+func (b *BillingEngineRepository) CreateCreditLine(ctx context.Context, line entity.CreditLine) (entity.CreditLine, error) {
+	createLine := models.CreditLine{
+		ID:          sql.NullInt64{Int64: int64(line.ID), Valid: true},
+		CustomerID:  sql.NullInt64{Int64: int64(line.CustomerID), Valid: true},
+		CreditLimit: line.Limit,
+		ProductCode: sql.NullString{String: line.ProductCode, Valid: true},
+		OpenedBy:    sql.NullString{String: line.OpenedBy, Valid: true},
+		OpenedAt:    sql.NullTime{Time: line.OpenedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.creditLineTableName, &createLine); err != nil {
+		return entity.CreditLine{}, err
+	}
+
+	return line, nil
+}
+
+func (b *BillingEngineRepository) GetCreditLine(ctx context.Context, creditLineID uint64) (entity.CreditLine, error) {
+	var line models.CreditLine
+
+	query := b.queryBuilder.
+		Select(line.Columns()...).
+		From(b.creditLineTableName).
+		Where(goqu.Ex{"id": creditLineID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.CreditLine{}, err
+	}
+
+	if err := row.Scan(line.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.CreditLine{}, fmt.Errorf("credit line %d not found", creditLineID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "credit_line_id", creditLineID)
+		return entity.CreditLine{}, err
+	}
+
+	return entity.CreditLine{
+		ID:          uint64(line.ID.Int64),
+		CustomerID:  uint64(line.CustomerID.Int64),
+		Limit:       line.CreditLimit,
+		ProductCode: line.ProductCode.String,
+		OpenedBy:    line.OpenedBy.String,
+		OpenedAt:    line.OpenedAt.Time,
+	}, nil
+}
+
+// GetCreditLineUsed sums the exposure of the active drawdowns of a credit
+// line, counted as against a customer's credit limit.
+func (b *BillingEngineRepository) GetCreditLineUsed(ctx context.Context, creditLineID uint64) (decimal.Decimal, error) {
+	query := b.queryBuilder.
+		Select(goqu.COALESCE(goqu.SUM(b.loanExposure()), 0)).
+		From(goqu.T(b.loanTableName).As("l")).
+		Where(goqu.I("l.credit_line_id").Eq(creditLineID)).
+		Where(goqu.I("l.status").In(activeLoanStatuses))
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var used decimal.Decimal
+	if err := row.Scan(&used); err != nil {
+		b.logger.Errorw("failed to scan row", "error", err, "credit_line_id", creditLineID)
+		return decimal.Zero, err
+	}
+
+	return used, nil
+}
+
+// GetCreditLineDrawdowns returns every loan drawn on a credit line, oldest
+// first.
+func (b *BillingEngineRepository) GetCreditLineDrawdowns(ctx context.Context, creditLineID uint64) ([]entity.Loan, error) {
+	var loan models.Loan
+
+	query := b.queryBuilder.
+		Select(loan.Columns()...).
+		From(b.loanTableName).
+		Where(goqu.Ex{"credit_line_id": creditLineID}).
+		Order(goqu.C("id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var loans []entity.Loan
+	for rows.Next() {
+		if err := rows.Scan(loan.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err, "credit_line_id", creditLineID)
+			return nil, err
+		}
+
+		loans = append(loans, toLoanEntity(loan))
+	}
+
+	return loans, nil
+}
+
+// IsCreditLineOverdue tells whether a bill of the credit line went unpaid
+// past its due date.
+func (b *BillingEngineRepository) IsCreditLineOverdue(ctx context.Context, creditLineID uint64) (bool, error) {
+	var id sql.NullInt64
+
+	query := b.queryBuilder.
+		Select("id").
+		From(b.creditLineBillTableName).
+		Where(goqu.Ex{"credit_line_id": creditLineID}).
+		Where(goqu.Ex{"status": string(entity.CREDIT_LINE_BILL_OVERDUE)}).
+		Limit(1)
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "credit_line_id", creditLineID)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetCreditLineUnbilledInstallments returns the unpaid installments of the
+// disbursed drawdowns of a credit line due by dueBy that no bill carries yet,
+// earliest due first.
+func (b *BillingEngineRepository) GetCreditLineUnbilledInstallments(ctx context.Context, creditLineID uint64, dueBy time.Time) ([]entity.Installment, error) {
+	var installment models.Installment
+
+	columns := make([]any, 0, len(installment.Columns()))
+	for _, column := range installment.StringColumns() {
+		columns = append(columns, goqu.I("i."+column))
+	}
+
+	billed := b.queryBuilder.
+		Select(goqu.L("1")).
+		From(goqu.T(b.creditLineBillItemTableName).As("bi")).
+		Where(goqu.I("bi.installment_id").Eq(goqu.I("i.id")))
+
+	query := b.queryBuilder.
+		Select(columns...).
+		From(goqu.T(b.installmentTableName).As("i")).
+		Join(goqu.T(b.loanTableName).As("l"), goqu.On(goqu.I("l.id").Eq(goqu.I("i.loan_id")))).
+		Where(goqu.I("l.credit_line_id").Eq(creditLineID)).
+		Where(goqu.I("l.status").Eq(string(entity.LOAN_DISBURSED))).
+		Where(goqu.I("i.status").In(string(entity.INSTALLMENT_PENDING), string(entity.INSTALLMENT_MISSED))).
+		Where(goqu.I("i.due_date").Lte(dueBy.Format("2006-01-02"))).
+		Where(goqu.L("NOT EXISTS ?", billed)).
+		Order(goqu.I("i.due_date").Asc(), goqu.I("i.loan_id").Asc(), goqu.I("i.week_number").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var installments []entity.Installment
+	for rows.Next() {
+		if err := rows.Scan(installment.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err, "credit_line_id", creditLineID)
+			return nil, err
+		}
+
+		installments = append(installments, entity.Installment{
+			ID:         uint64(installment.ID.Int64),
+			LoanID:     uint64(installment.LoanID.Int64),
+			WeekNumber: installment.WeekNumber.Int64,
+			DueDate:    installment.DueDate.String,
+			AmountDue:  installment.AmountDue.String,
+			FeeAmount:  installment.FeeAmount.String,
+			Status:     entity.InstallmentStatus(installment.Status.String),
+
+			HolidayInterest: installment.HolidayInterest.String,
+		})
+	}
+
+	return installments, nil
+}
+
+// CreateCreditLineBill records the bill and its items, giving the items their
+// IDs.
+func (b *BillingEngineRepository) CreateCreditLineBill(ctx context.Context, bill entity.CreditLineBill) (entity.CreditLineBill, error) {
+	createBill := models.CreditLineBill{
+		ID:           sql.NullInt64{Int64: int64(bill.ID), Valid: true},
+		CreditLineID: sql.NullInt64{Int64: int64(bill.CreditLineID), Valid: true},
+		Cycle:        sql.NullString{String: bill.Cycle, Valid: true},
+		DueDate:      sql.NullTime{Time: bill.DueDate, Valid: true},
+		Amount:       bill.Amount,
+		Status:       sql.NullString{String: string(bill.Status), Valid: true},
+		CreatedAt:    sql.NullTime{Time: bill.CreatedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.creditLineBillTableName, &createBill); err != nil {
+		return entity.CreditLineBill{}, err
+	}
+
+	for i, item := range bill.Items {
+		item.ID = b.snowflakeGen.Generate()
+
+		createItem := models.CreditLineBillItem{
+			ID:            sql.NullInt64{Int64: int64(item.ID), Valid: true},
+			BillID:        sql.NullInt64{Int64: int64(bill.ID), Valid: true},
+			InstallmentID: sql.NullInt64{Int64: int64(item.InstallmentID), Valid: true},
+			LoanID:        sql.NullInt64{Int64: int64(item.LoanID), Valid: true},
+			WeekNumber:    sql.NullInt64{Int64: item.WeekNumber, Valid: true},
+			Amount:        item.Amount,
+		}
+
+		if err := b.insertRecord(ctx, b.creditLineBillItemTableName, &createItem); err != nil {
+			return entity.CreditLineBill{}, err
+		}
+
+		bill.Items[i] = item
+	}
+
+	return bill, nil
+}
+
+func (b *BillingEngineRepository) IsCreditLineBilled(ctx context.Context, creditLineID uint64, cycle string) (bool, error) {
+	var id sql.NullInt64
+
+	query := b.queryBuilder.
+		Select("id").
+		From(b.creditLineBillTableName).
+		Where(goqu.Ex{"credit_line_id": creditLineID}).
+		Where(goqu.Ex{"cycle": cycle})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "credit_line_id", creditLineID)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetCreditLineBill returns the bill with its items.
+func (b *BillingEngineRepository) GetCreditLineBill(ctx context.Context, billID uint64) (entity.CreditLineBill, error) {
+	var bill models.CreditLineBill
+
+	query := b.queryBuilder.
+		Select(bill.Columns()...).
+		From(b.creditLineBillTableName).
+		Where(goqu.Ex{"id": billID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.CreditLineBill{}, err
+	}
+
+	if err := row.Scan(bill.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.CreditLineBill{}, fmt.Errorf("credit line bill %d not found", billID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "bill_id", billID)
+		return entity.CreditLineBill{}, err
+	}
+
+	return b.withCreditLineBillItems(ctx, toCreditLineBillEntity(bill))
+}
+
+// GetCreditLineBills returns the bills of a credit line with their items,
+// latest cycle first.
+func (b *BillingEngineRepository) GetCreditLineBills(ctx context.Context, creditLineID uint64) ([]entity.CreditLineBill, error) {
+	var bill models.CreditLineBill
+
+	return b.scanCreditLineBills(ctx, b.queryBuilder.
+		Select(bill.Columns()...).
+		From(b.creditLineBillTableName).
+		Where(goqu.Ex{"credit_line_id": creditLineID}).
+		Order(goqu.C("cycle").Desc()),
+	)
+}
+
+// GetOverdueCreditLineBills returns the OPEN bills due before asOf with their
+// items, earliest due first.
+func (b *BillingEngineRepository) GetOverdueCreditLineBills(ctx context.Context, asOf time.Time) ([]entity.CreditLineBill, error) {
+	var bill models.CreditLineBill
+
+	return b.scanCreditLineBills(ctx, b.queryBuilder.
+		Select(bill.Columns()...).
+		From(b.creditLineBillTableName).
+		Where(goqu.Ex{"status": string(entity.CREDIT_LINE_BILL_OPEN)}).
+		Where(goqu.C("due_date").Lt(asOf.Format("2006-01-02"))).
+		Order(goqu.C("due_date").Asc(), goqu.C("id").Asc()),
+	)
+}
+
+// UpdateCreditLineBillStatus moves the bill only when it is still in status
+// from, and reports whether it was. paidAt is recorded when it moves to PAID.
+func (b *BillingEngineRepository) UpdateCreditLineBillStatus(ctx context.Context, billID uint64, from entity.CreditLineBillStatus, to entity.CreditLineBillStatus, paidAt time.Time) (bool, error) {
+	record := goqu.Record{"status": string(to)}
+	if to == entity.CREDIT_LINE_BILL_PAID {
+		record["paid_at"] = paidAt
+	}
+
+	updated, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.creditLineBillTableName).
+		Set(record).
+		Where(goqu.Ex{"id": billID}).
+		Where(goqu.Ex{"status": string(from)}),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return updated > 0, nil
+}
+
+// MissCreditLineBillInstallments marks the installments of a bill still
+// PENDING as MISSED, and returns how many were.
+func (b *BillingEngineRepository) MissCreditLineBillInstallments(ctx context.Context, billID uint64) (int64, error) {
+	billed := b.queryBuilder.
+		Select("installment_id").
+		From(b.creditLineBillItemTableName).
+		Where(goqu.Ex{"bill_id": billID})
+
+	return b.execUpdate(ctx, b.queryBuilder.
+		Update(b.installmentTableName).
+		Set(goqu.Record{"status": string(entity.INSTALLMENT_MISSED)}).
+		Where(goqu.L("? IN ?", goqu.C("id"), billed)).
+		Where(goqu.Ex{"status": string(entity.INSTALLMENT_PENDING)}),
+	)
+}
+
+func (b *BillingEngineRepository) scanCreditLineBills(ctx context.Context, query *goqu.SelectDataset) ([]entity.CreditLineBill, error) {
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		bill  models.CreditLineBill
+		bills []entity.CreditLineBill
+	)
+	for rows.Next() {
+		if err := rows.Scan(bill.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		bills = append(bills, toCreditLineBillEntity(bill))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range bills {
+		if bills[i], err = b.withCreditLineBillItems(ctx, bills[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return bills, nil
+}
+
+// withCreditLineBillItems loads the items of the bill with the current status
+// of their installments.
+func (b *BillingEngineRepository) withCreditLineBillItems(ctx context.Context, bill entity.CreditLineBill) (entity.CreditLineBill, error) {
+	var item models.CreditLineBillItem
+
+	columns := make([]any, 0, len(item.Columns())+1)
+	for _, column := range item.StringColumns() {
+		columns = append(columns, goqu.I("bi."+column))
+	}
+	columns = append(columns, goqu.I("i.status"))
+
+	query := b.queryBuilder.
+		Select(columns...).
+		From(goqu.T(b.creditLineBillItemTableName).As("bi")).
+		Join(goqu.T(b.installmentTableName).As("i"), goqu.On(goqu.I("i.id").Eq(goqu.I("bi.installment_id")))).
+		Where(goqu.I("bi.bill_id").Eq(bill.ID)).
+		Order(goqu.I("bi.loan_id").Asc(), goqu.I("bi.week_number").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return entity.CreditLineBill{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var status sql.NullString
+		if err := rows.Scan(append(item.Values(), &status)...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err, "bill_id", bill.ID)
+			return entity.CreditLineBill{}, err
+		}
+
+		bill.Items = append(bill.Items, entity.CreditLineBillItem{
+			ID:            uint64(item.ID.Int64),
+			BillID:        uint64(item.BillID.Int64),
+			InstallmentID: uint64(item.InstallmentID.Int64),
+			LoanID:        uint64(item.LoanID.Int64),
+			WeekNumber:    item.WeekNumber.Int64,
+			Amount:        item.Amount,
+			Status:        entity.InstallmentStatus(status.String),
+		})
+	}
+
+	return bill, nil
+}
+
+func toCreditLineBillEntity(bill models.CreditLineBill) entity.CreditLineBill {
+	return entity.CreditLineBill{
+		ID:           uint64(bill.ID.Int64),
+		CreditLineID: uint64(bill.CreditLineID.Int64),
+		Cycle:        bill.Cycle.String,
+		DueDate:      bill.DueDate.Time,
+		Amount:       bill.Amount,
+		Status:       entity.CreditLineBillStatus(bill.Status.String),
+		CreatedAt:    bill.CreatedAt.Time,
+		PaidAt:       bill.PaidAt.Time,
+	}
+}
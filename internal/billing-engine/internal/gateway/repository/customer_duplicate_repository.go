@@ -0,0 +1,342 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+// Customer Duplicate Usecases
+
+// FindDuplicateCandidates returns the customers that were not deleted and
+// share the NIK, the phone or the date of birth of customer. Names are
+// compared once normalised, by the caller.
+func (b *BillingEngineRepository) FindDuplicateCandidates(ctx context.Context, customer entity.Customer) ([]entity.Customer, error) {
+	var candidate models.Customer
+
+	var keys []exp.Expression
+	if customer.NIK != "" {
+		keys = append(keys, goqu.Ex{"nik": customer.NIK})
+	}
+	if customer.Phone != "" {
+		keys = append(keys, goqu.Ex{"phone": customer.Phone})
+	}
+	if !customer.DateOfBirth.IsZero() {
+		keys = append(keys, goqu.Ex{"date_of_birth": customer.DateOfBirth.Format("2006-01-02")})
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	query := b.queryBuilder.
+		Select(candidate.Columns()...).
+		From(b.customerTableName).
+		Where(goqu.Ex{"deleted_at": nil}).
+		Where(goqu.Or(keys...)).
+		Order(goqu.C("id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []entity.Customer
+	for rows.Next() {
+		if err := rows.Scan(candidate.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		candidates = append(candidates, toCustomerEntity(candidate))
+	}
+
+	return candidates, nil
+}
+
+func (b *BillingEngineRepository) CreateCustomerDuplicates(ctx context.Context, duplicates []entity.CustomerDuplicate) error {
+	for _, duplicate := range duplicates {
+		createDuplicate := models.CustomerDuplicate{
+			ID:                sql.NullInt64{Int64: int64(duplicate.ID), Valid: true},
+			CustomerID:        sql.NullInt64{Int64: int64(duplicate.CustomerID), Valid: true},
+			MatchedCustomerID: sql.NullInt64{Int64: int64(duplicate.MatchedCustomerID), Valid: true},
+			MatchType:         sql.NullString{String: string(duplicate.Match), Valid: true},
+			Status:            sql.NullString{String: string(duplicate.Status), Valid: true},
+			CreatedAt:         sql.NullTime{Time: duplicate.CreatedAt, Valid: true},
+			ResolvedBy:        sql.NullString{String: duplicate.ResolvedBy, Valid: duplicate.ResolvedBy != ""},
+			ResolvedAt:        sql.NullTime{Time: duplicate.ResolvedAt, Valid: !duplicate.ResolvedAt.IsZero()},
+		}
+
+		if err := b.insertRecord(ctx, b.customerDuplicateTableName, &createDuplicate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCustomerDuplicates returns the duplicate flags in status, of customerID
+// on either side unless it is zero, oldest first.
+func (b *BillingEngineRepository) GetCustomerDuplicates(ctx context.Context, status entity.DuplicateStatus, customerID uint64) ([]entity.CustomerDuplicate, error) {
+	var duplicate models.CustomerDuplicate
+
+	query := b.queryBuilder.
+		Select(duplicate.Columns()...).
+		From(b.customerDuplicateTableName).
+		Where(goqu.Ex{"status": string(status)}).
+		Order(goqu.C("created_at").Asc(), goqu.C("id").Asc())
+
+	if customerID != 0 {
+		query = query.Where(goqu.Or(
+			goqu.Ex{"customer_id": customerID},
+			goqu.Ex{"matched_customer_id": customerID},
+		))
+	}
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var duplicates []entity.CustomerDuplicate
+	for rows.Next() {
+		if err := rows.Scan(duplicate.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		duplicates = append(duplicates, entity.CustomerDuplicate{
+			ID:                uint64(duplicate.ID.Int64),
+			CustomerID:        uint64(duplicate.CustomerID.Int64),
+			MatchedCustomerID: uint64(duplicate.MatchedCustomerID.Int64),
+			Match:             entity.DuplicateMatch(duplicate.MatchType.String),
+			Status:            entity.DuplicateStatus(duplicate.Status.String),
+			CreatedAt:         duplicate.CreatedAt.Time,
+			ResolvedBy:        duplicate.ResolvedBy.String,
+			ResolvedAt:        duplicate.ResolvedAt.Time,
+		})
+	}
+
+	return duplicates, nil
+}
+
+// ResolveCustomerDuplicate closes an OPEN duplicate flag with status. It
+// reports whether the flag was still open.
+func (b *BillingEngineRepository) ResolveCustomerDuplicate(ctx context.Context, duplicateID uint64, status entity.DuplicateStatus, resolvedBy string, resolvedAt time.Time) (bool, error) {
+	row, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.customerDuplicateTableName).
+		Set(goqu.Record{"status": string(status), "resolved_by": resolvedBy, "resolved_at": resolvedAt}).
+		Where(goqu.Ex{"id": duplicateID, "status": string(entity.DUPLICATE_OPEN)}),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return row > 0, nil
+}
+
+// ResolveCustomerDuplicatesOf closes with status every OPEN duplicate flag
+// of customerID, on either side, and returns how many were closed.
+func (b *BillingEngineRepository) ResolveCustomerDuplicatesOf(ctx context.Context, customerID uint64, status entity.DuplicateStatus, resolvedBy string, resolvedAt time.Time) (int64, error) {
+	return b.execUpdate(ctx, b.queryBuilder.
+		Update(b.customerDuplicateTableName).
+		Set(goqu.Record{"status": string(status), "resolved_by": resolvedBy, "resolved_at": resolvedAt}).
+		Where(goqu.Ex{"status": string(entity.DUPLICATE_OPEN)}).
+		Where(goqu.Or(
+			goqu.Ex{"customer_id": customerID},
+			goqu.Ex{"matched_customer_id": customerID},
+		)),
+	)
+}
+
+// mergedCustomerTables are the tables whose rows belong to a customer and
+// follow them when they are merged. Credit limits stay, the target keeps its
+// own.
+func (b *BillingEngineRepository) mergedCustomerTables() []string {
+	return []string{
+		b.loanTableName,
+		b.disbursementTableName,
+		b.writeOffTableName,
+		b.loanProvisionTableName,
+		b.collectionCaseTableName,
+		b.communicationTableName,
+		b.loanPartyTableName,
+		b.creditLineTableName,
+	}
+}
+
+// MergeCustomer records the merge, then moves the rows of the source customer
+// onto the target customer, recording every row moved, in one transaction.
+func (b *BillingEngineRepository) MergeCustomer(ctx context.Context, merge entity.CustomerMerge) (entity.CustomerMerge, error) {
+	createMerge := models.CustomerMerge{
+		ID:               sql.NullInt64{Int64: int64(merge.ID), Valid: true},
+		SourceCustomerID: sql.NullInt64{Int64: int64(merge.SourceCustomerID), Valid: true},
+		TargetCustomerID: sql.NullInt64{Int64: int64(merge.TargetCustomerID), Valid: true},
+		Reason:           sql.NullString{String: merge.Reason, Valid: true},
+		MergedBy:         sql.NullString{String: merge.MergedBy, Valid: true},
+		MergedAt:         sql.NullTime{Time: merge.MergedAt, Valid: true},
+	}
+
+	err := b.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := b.insertRecord(ctx, b.customerMergeTableName, &createMerge); err != nil {
+			return err
+		}
+
+		merge.Records = nil
+		for _, tableName := range b.mergedCustomerTables() {
+			recordIDs, err := b.moveCustomerRows(ctx, tableName, merge.SourceCustomerID, merge.TargetCustomerID)
+			if err != nil {
+				return err
+			}
+
+			for _, recordID := range recordIDs {
+				record := entity.CustomerMergeRecord{
+					ID:        b.snowflakeGen.Generate(),
+					MergeID:   merge.ID,
+					TableName: tableName,
+					RecordID:  recordID,
+				}
+
+				createRecord := models.CustomerMergeRecord{
+					ID:        sql.NullInt64{Int64: int64(record.ID), Valid: true},
+					MergeID:   sql.NullInt64{Int64: int64(record.MergeID), Valid: true},
+					TableName: sql.NullString{String: record.TableName, Valid: true},
+					RecordID:  sql.NullInt64{Int64: int64(record.RecordID), Valid: true},
+				}
+
+				if err := b.insertRecord(ctx, b.customerMergeRecordTableName, &createRecord); err != nil {
+					return err
+				}
+
+				merge.Records = append(merge.Records, record)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.CustomerMerge{}, err
+	}
+
+	return merge, nil
+}
+
+// moveCustomerRows hands the rows of tableName from the source to the target
+// customer and returns their ids.
+func (b *BillingEngineRepository) moveCustomerRows(ctx context.Context, tableName string, sourceCustomerID uint64, targetCustomerID uint64) ([]uint64, error) {
+	sqlQuery, _, err := b.queryBuilder.
+		Update(tableName).
+		Set(goqu.Record{"customer_id": targetCustomerID}).
+		Where(goqu.Ex{"customer_id": sourceCustomerID}).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build update query", "error", err, "table", tableName)
+		return nil, err
+	}
+
+	rows, err := b.conn(ctx).QueryContext(ctx, sqlQuery)
+	if err != nil {
+		b.logger.Errorw("failed to execute update query", "error", err, "table", tableName)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uint64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err, "table", tableName)
+			return nil, err
+		}
+
+		ids = append(ids, uint64(id))
+	}
+
+	return ids, rows.Err()
+}
+
+// GetCustomerMerges returns the merges customerID took part in, either as the
+// source or as the target, oldest first, with the rows each one moved.
+func (b *BillingEngineRepository) GetCustomerMerges(ctx context.Context, customerID uint64) ([]entity.CustomerMerge, error) {
+	var merge models.CustomerMerge
+
+	query := b.queryBuilder.
+		Select(merge.Columns()...).
+		From(b.customerMergeTableName).
+		Where(goqu.Or(
+			goqu.Ex{"source_customer_id": customerID},
+			goqu.Ex{"target_customer_id": customerID},
+		)).
+		Order(goqu.C("merged_at").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var merges []entity.CustomerMerge
+	var mergeIDs []uint64
+	for rows.Next() {
+		if err := rows.Scan(merge.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		merges = append(merges, entity.CustomerMerge{
+			ID:               uint64(merge.ID.Int64),
+			SourceCustomerID: uint64(merge.SourceCustomerID.Int64),
+			TargetCustomerID: uint64(merge.TargetCustomerID.Int64),
+			Reason:           merge.Reason.String,
+			MergedBy:         merge.MergedBy.String,
+			MergedAt:         merge.MergedAt.Time,
+		})
+		mergeIDs = append(mergeIDs, uint64(merge.ID.Int64))
+	}
+
+	if len(merges) == 0 {
+		return merges, nil
+	}
+
+	var record models.CustomerMergeRecord
+
+	recordRows, err := b.queryRows(ctx, b.queryBuilder.
+		Select(record.Columns()...).
+		From(b.customerMergeRecordTableName).
+		Where(goqu.Ex{"merge_id": mergeIDs}).
+		Order(goqu.C("table_name").Asc(), goqu.C("record_id").Asc()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer recordRows.Close()
+
+	byMerge := make(map[uint64][]entity.CustomerMergeRecord)
+	for recordRows.Next() {
+		if err := recordRows.Scan(record.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		mergeID := uint64(record.MergeID.Int64)
+		byMerge[mergeID] = append(byMerge[mergeID], entity.CustomerMergeRecord{
+			ID:        uint64(record.ID.Int64),
+			MergeID:   mergeID,
+			TableName: record.TableName.String,
+			RecordID:  uint64(record.RecordID.Int64),
+		})
+	}
+
+	for i := range merges {
+		merges[i].Records = byMerge[merges[i].ID]
+	}
+
+	return merges, nil
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Customer Profile Usecases
+
+// activeLoanStatuses are the statuses of a loan that is still open.
+var activeLoanStatuses = []string{
+	string(entity.LOAN_APPLIED),
+	string(entity.LOAN_APPROVED),
+	string(entity.LOAN_DISBURSED),
+}
+
+// SearchCustomers returns a page of the customers that were not deleted and
+// match the search, in its order.
+func (b *BillingEngineRepository) SearchCustomers(ctx context.Context, search entity.CustomerSearch) ([]entity.Customer, error) {
+	var customer models.Customer
+
+	query := b.queryBuilder.
+		Select(customer.Columns()...).
+		From(goqu.T(b.customerTableName).As("c")).
+		Where(goqu.Ex{"deleted_at": nil}).
+		Limit(uint(search.Limit))
+
+	if search.NamePrefix != "" {
+		query = query.Where(goqu.Func("LOWER", goqu.I("name")).Like(escapeLike(strings.ToLower(search.NamePrefix)) + "%"))
+	}
+
+	if search.Email != "" {
+		query = query.Where(goqu.Func("LOWER", goqu.I("email")).Eq(strings.ToLower(search.Email)))
+	}
+
+	if search.KYCStatus != "" {
+		query = query.Where(goqu.Ex{"kyc_status": string(search.KYCStatus)})
+	}
+
+	if search.HasActiveLoan != nil {
+		activeLoans := b.queryBuilder.
+			Select(goqu.L("1")).
+			From(goqu.T(b.loanTableName).As("l")).
+			Where(goqu.I("l.customer_id").Eq(goqu.I("c.id"))).
+			Where(goqu.I("l.status").In(activeLoanStatuses))
+
+		if *search.HasActiveLoan {
+			query = query.Where(goqu.L("EXISTS ?", activeLoans))
+		} else {
+			query = query.Where(goqu.L("NOT EXISTS ?", activeLoans))
+		}
+	}
+
+	switch search.Sort {
+	case entity.CUSTOMER_SORT_NEWEST:
+		if search.After != nil {
+			query = query.Where(goqu.I("id").Lt(search.After.ID))
+		}
+		query = query.Order(goqu.I("id").Desc())
+	case entity.CUSTOMER_SORT_NAME:
+		if search.After != nil {
+			query = query.Where(goqu.L("(?, ?) > (?, ?)", goqu.I("name"), goqu.I("id"), search.After.Key, search.After.ID))
+		}
+		query = query.Order(goqu.I("name").Asc(), goqu.I("id").Asc())
+	case entity.CUSTOMER_SORT_NAME_DESC:
+		if search.After != nil {
+			query = query.Where(goqu.L("(?, ?) < (?, ?)", goqu.I("name"), goqu.I("id"), search.After.Key, search.After.ID))
+		}
+		query = query.Order(goqu.I("name").Desc(), goqu.I("id").Desc())
+	default:
+		if search.After != nil {
+			query = query.Where(goqu.I("id").Gt(search.After.ID))
+		}
+		query = query.Order(goqu.I("id").Asc())
+	}
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var customers []entity.Customer
+	for rows.Next() {
+		if err := rows.Scan(customer.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		customers = append(customers, toCustomerEntity(customer))
+	}
+
+	return customers, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// UpdateCustomer stores the profile of a customer that was not deleted.
+func (b *BillingEngineRepository) UpdateCustomer(ctx context.Context, customer entity.Customer) (entity.Customer, error) {
+	updateCustomer := models.Customer{
+		Name:        sql.NullString{String: customer.Name, Valid: true},
+		Email:       sql.NullString{String: customer.Email, Valid: true},
+		Phone:       sql.NullString{String: customer.Phone, Valid: customer.Phone != ""},
+		Language:    sql.NullString{String: string(customer.Language), Valid: true},
+		NIK:         sql.NullString{String: customer.NIK, Valid: customer.NIK != ""},
+		DateOfBirth: sql.NullTime{Time: customer.DateOfBirth, Valid: !customer.DateOfBirth.IsZero()},
+		Address:     sql.NullString{String: customer.Address, Valid: customer.Address != ""},
+		KYCStatus:   sql.NullString{String: string(customer.KYCStatus), Valid: true},
+	}
+
+	row, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.customerTableName).
+		Set(goqu.Record{
+			"name":          updateCustomer.Name,
+			"email":         updateCustomer.Email,
+			"phone":         updateCustomer.Phone,
+			"language":      updateCustomer.Language,
+			"nik":           updateCustomer.NIK,
+			"date_of_birth": updateCustomer.DateOfBirth,
+			"address":       updateCustomer.Address,
+			"kyc_status":    updateCustomer.KYCStatus,
+		}).
+		Where(goqu.Ex{"id": customer.ID, "deleted_at": nil}),
+	)
+	if err != nil {
+		return entity.Customer{}, err
+	}
+
+	if row == 0 {
+		return entity.Customer{}, fmt.Errorf("customer %d not found", customer.ID)
+	}
+
+	return customer, nil
+}
+
+// DeleteCustomer soft deletes a customer, only when none of their loans is
+// still applied, approved or disbursed. It reports whether the customer was
+// deleted.
+func (b *BillingEngineRepository) DeleteCustomer(ctx context.Context, customerID uint64, deletedAt time.Time) (bool, error) {
+	openLoans := b.queryBuilder.
+		Select(goqu.L("1")).
+		From(b.loanTableName).
+		Where(goqu.Ex{"customer_id": customerID}).
+		Where(goqu.Ex{"status": activeLoanStatuses})
+
+	row, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.customerTableName).
+		Set(goqu.Record{"deleted_at": deletedAt}).
+		Where(goqu.Ex{"id": customerID, "deleted_at": nil}).
+		Where(goqu.L("NOT EXISTS ?", openLoans)),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return row > 0, nil
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Disbursement Usecases
+func (b *BillingEngineRepository) CreateDisbursement(ctx context.Context, disbursement entity.Disbursement) (entity.Disbursement, error) {
+	createDisbursement := toDisbursementModel(disbursement)
+
+	if err := b.insertRecord(ctx, b.disbursementTableName, &createDisbursement); err != nil {
+		return entity.Disbursement{}, err
+	}
+
+	return disbursement, nil
+}
+
+// UpdateDisbursement stores the status, provider answer and attempts of the
+// disbursement, only when it is still in status from.
+func (b *BillingEngineRepository) UpdateDisbursement(ctx context.Context, disbursement entity.Disbursement, from entity.DisbursementStatus) error {
+	updateDisbursement := toDisbursementModel(disbursement)
+
+	row, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.disbursementTableName).
+		Set(goqu.Record{
+			"status":             updateDisbursement.Status,
+			"provider_reference": updateDisbursement.ProviderReference,
+			"failure_reason":     updateDisbursement.FailureReason,
+			"attempts":           updateDisbursement.Attempts,
+			"updated_at":         updateDisbursement.UpdatedAt,
+			"completed_at":       updateDisbursement.CompletedAt,
+		}).
+		Where(goqu.Ex{"id": disbursement.ID}).
+		Where(goqu.Ex{"status": string(from)}),
+	)
+	if err != nil {
+		return err
+	}
+
+	if row == 0 {
+		return fmt.Errorf("disbursement %d is no longer %s", disbursement.ID, from)
+	}
+
+	return nil
+}
+
+func (b *BillingEngineRepository) GetDisbursement(ctx context.Context, disbursementID uint64) (entity.Disbursement, error) {
+	return b.getDisbursement(ctx, goqu.Ex{"id": disbursementID}, fmt.Sprintf("disbursement %d not found", disbursementID))
+}
+
+func (b *BillingEngineRepository) GetDisbursementByLoan(ctx context.Context, loanID uint64) (entity.Disbursement, error) {
+	return b.getDisbursement(ctx, goqu.Ex{"loan_id": loanID}, fmt.Sprintf("disbursement of loan %d not found", loanID))
+}
+
+func (b *BillingEngineRepository) IsLoanHasDisbursement(ctx context.Context, loanID uint64) (bool, error) {
+	var id sql.NullInt64
+
+	query := b.queryBuilder.
+		Select("id").
+		From(b.disbursementTableName).
+		Where(goqu.Ex{"loan_id": loanID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (b *BillingEngineRepository) getDisbursement(ctx context.Context, where goqu.Ex, notFound string) (entity.Disbursement, error) {
+	var disbursement models.Disbursement
+
+	query := b.queryBuilder.
+		Select(disbursement.Columns()...).
+		From(b.disbursementTableName).
+		Where(where)
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.Disbursement{}, err
+	}
+
+	if err := row.Scan(disbursement.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Disbursement{}, errors.New(notFound)
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return entity.Disbursement{}, err
+	}
+
+	return toDisbursementEntity(disbursement), nil
+}
+
+func toDisbursementModel(disbursement entity.Disbursement) models.Disbursement {
+	return models.Disbursement{
+		ID:                sql.NullInt64{Int64: int64(disbursement.ID), Valid: true},
+		LoanID:            sql.NullInt64{Int64: int64(disbursement.LoanID), Valid: true},
+		CustomerID:        sql.NullInt64{Int64: int64(disbursement.CustomerID), Valid: true},
+		BankCode:          sql.NullString{String: disbursement.BankAccount.BankCode, Valid: true},
+		AccountNumber:     sql.NullString{String: disbursement.BankAccount.AccountNumber, Valid: true},
+		AccountName:       sql.NullString{String: disbursement.BankAccount.AccountName, Valid: true},
+		Amount:            disbursement.Amount,
+		FeeAmount:         disbursement.FeeAmount,
+		Status:            sql.NullString{String: string(disbursement.Status), Valid: true},
+		ProviderReference: sql.NullString{String: disbursement.ProviderReference, Valid: disbursement.ProviderReference != ""},
+		FailureReason:     sql.NullString{String: disbursement.FailureReason, Valid: disbursement.FailureReason != ""},
+		Attempts:          sql.NullInt64{Int64: disbursement.Attempts, Valid: true},
+		RequestedBy:       sql.NullString{String: disbursement.RequestedBy, Valid: true},
+		CreatedAt:         sql.NullTime{Time: disbursement.CreatedAt, Valid: true},
+		UpdatedAt:         sql.NullTime{Time: disbursement.UpdatedAt, Valid: true},
+		CompletedAt:       sql.NullTime{Time: disbursement.CompletedAt, Valid: !disbursement.CompletedAt.IsZero()},
+	}
+}
+
+func toDisbursementEntity(disbursement models.Disbursement) entity.Disbursement {
+	return entity.Disbursement{
+		ID:         uint64(disbursement.ID.Int64),
+		LoanID:     uint64(disbursement.LoanID.Int64),
+		CustomerID: uint64(disbursement.CustomerID.Int64),
+		BankAccount: entity.BankAccount{
+			BankCode:      disbursement.BankCode.String,
+			AccountNumber: disbursement.AccountNumber.String,
+			AccountName:   disbursement.AccountName.String,
+		},
+		Amount:            disbursement.Amount,
+		FeeAmount:         disbursement.FeeAmount,
+		Status:            entity.DisbursementStatus(disbursement.Status.String),
+		ProviderReference: disbursement.ProviderReference.String,
+		FailureReason:     disbursement.FailureReason.String,
+		Attempts:          disbursement.Attempts.Int64,
+		RequestedBy:       disbursement.RequestedBy.String,
+		CreatedAt:         disbursement.CreatedAt.Time,
+		UpdatedAt:         disbursement.UpdatedAt.Time,
+		CompletedAt:       disbursement.CompletedAt.Time,
+	}
+}
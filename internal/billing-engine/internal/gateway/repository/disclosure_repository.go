@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Loan Disclosure Usecases
+
+func (b *BillingEngineRepository) CreateLoanDisclosure(ctx context.Context, disclosure entity.LoanDisclosure) error {
+	createDisclosure := models.LoanDisclosure{
+		LoanID:              sql.NullInt64{Int64: int64(disclosure.LoanID), Valid: true},
+		ProductCode:         sql.NullString{String: disclosure.ProductCode, Valid: true},
+		PrincipalAmount:     disclosure.PrincipalAmount,
+		InterestRate:        disclosure.InterestRate,
+		TermWeeks:           sql.NullInt64{Int64: disclosure.TermWeeks, Valid: true},
+		AmountReceived:      disclosure.AmountReceived,
+		TotalInterest:       disclosure.TotalInterest,
+		TotalFees:           disclosure.TotalFees,
+		TotalRepayment:      disclosure.TotalRepayment,
+		EffectiveAnnualRate: disclosure.EffectiveAnnualRate,
+		DisclosedAt:         sql.NullTime{Time: disclosure.DisclosedAt, Valid: true},
+	}
+
+	return b.insertRecord(ctx, b.loanDisclosureTableName, &createDisclosure)
+}
+
+func (b *BillingEngineRepository) GetLoanDisclosure(ctx context.Context, loanID uint64) (entity.LoanDisclosure, error) {
+	var disclosure models.LoanDisclosure
+
+	query := b.queryBuilder.
+		Select(disclosure.Columns()...).
+		From(b.loanDisclosureTableName).
+		Where(goqu.Ex{"loan_id": loanID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.LoanDisclosure{}, err
+	}
+
+	if err := row.Scan(disclosure.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.LoanDisclosure{}, fmt.Errorf("disclosure of loan %d not found", loanID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return entity.LoanDisclosure{}, err
+	}
+
+	return entity.LoanDisclosure{
+		LoanID:              uint64(disclosure.LoanID.Int64),
+		ProductCode:         disclosure.ProductCode.String,
+		PrincipalAmount:     disclosure.PrincipalAmount,
+		InterestRate:        disclosure.InterestRate,
+		TermWeeks:           disclosure.TermWeeks.Int64,
+		AmountReceived:      disclosure.AmountReceived,
+		TotalInterest:       disclosure.TotalInterest,
+		TotalFees:           disclosure.TotalFees,
+		TotalRepayment:      disclosure.TotalRepayment,
+		EffectiveAnnualRate: disclosure.EffectiveAnnualRate,
+		DisclosedAt:         disclosure.DisclosedAt.Time,
+	}, nil
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Fee Usecases
+
+// GetProductFees returns the fees of a product, or of every product when
+// productCode is empty.
+func (b *BillingEngineRepository) GetProductFees(ctx context.Context, productCode string) ([]entity.ProductFee, error) {
+	var fee models.ProductFee
+
+	query := b.queryBuilder.
+		Select(fee.Columns()...).
+		From(b.productFeeTableName).
+		Order(goqu.C("product_code").Asc(), goqu.C("fee_type").Asc())
+
+	if productCode != "" {
+		query = query.Where(goqu.Ex{"product_code": productCode})
+	}
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fees []entity.ProductFee
+	for rows.Next() {
+		if err := rows.Scan(fee.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		fees = append(fees, entity.ProductFee{
+			ProductCode: fee.ProductCode.String,
+			FeeType:     entity.FeeType(fee.FeeType.String),
+			Calculation: entity.FeeCalculation(fee.Calculation.String),
+			Value:       fee.Value,
+			Collection:  entity.FeeCollection(fee.Collection.String),
+			UpdatedAt:   fee.UpdatedAt.Time,
+		})
+	}
+
+	return fees, nil
+}
+
+// SaveProductFee creates the fee of the product, or replaces its definition
+// when the product already charges a fee of that type.
+func (b *BillingEngineRepository) SaveProductFee(ctx context.Context, fee entity.ProductFee) (entity.ProductFee, error) {
+	saveFee := models.ProductFee{
+		ProductCode: sql.NullString{String: fee.ProductCode, Valid: true},
+		FeeType:     sql.NullString{String: string(fee.FeeType), Valid: true},
+		Calculation: sql.NullString{String: string(fee.Calculation), Valid: true},
+		Value:       fee.Value,
+		Collection:  sql.NullString{String: string(fee.Collection), Valid: true},
+		UpdatedAt:   sql.NullTime{Time: fee.UpdatedAt, Valid: true},
+	}
+
+	query := b.queryBuilder.
+		Insert(b.productFeeTableName).
+		Cols(saveFee.Columns()...).
+		Vals(saveFee.Values()).
+		OnConflict(goqu.DoUpdate("product_code, fee_type", goqu.Record{
+			"calculation": goqu.L("EXCLUDED.calculation"),
+			"value":       goqu.L("EXCLUDED.value"),
+			"collection":  goqu.L("EXCLUDED.collection"),
+			"updated_at":  goqu.L("EXCLUDED.updated_at"),
+		}))
+
+	sqlQuery, _, err := query.ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build query", "error", err, "table", b.productFeeTableName)
+		return entity.ProductFee{}, err
+	}
+
+	if _, err := b.conn(ctx).ExecContext(ctx, sqlQuery); err != nil {
+		b.logger.Errorw("failed to execute query", "error", err, "table", b.productFeeTableName)
+		return entity.ProductFee{}, err
+	}
+
+	return fee, nil
+}
+
+func (b *BillingEngineRepository) CreateLoanFees(ctx context.Context, fees []entity.LoanFee) error {
+	for _, fee := range fees {
+		createFee := models.LoanFee{
+			ID:          sql.NullInt64{Int64: int64(fee.ID), Valid: true},
+			LoanID:      sql.NullInt64{Int64: int64(fee.LoanID), Valid: true},
+			FeeType:     sql.NullString{String: string(fee.FeeType), Valid: true},
+			Calculation: sql.NullString{String: string(fee.Calculation), Valid: true},
+			Value:       fee.Value,
+			Collection:  sql.NullString{String: string(fee.Collection), Valid: true},
+			Amount:      fee.Amount,
+			CreatedAt:   sql.NullTime{Time: fee.CreatedAt, Valid: true},
+		}
+
+		if err := b.insertRecord(ctx, b.loanFeeTableName, &createFee); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *BillingEngineRepository) GetLoanFees(ctx context.Context, loanID uint64) ([]entity.LoanFee, error) {
+	var fee models.LoanFee
+
+	query := b.queryBuilder.
+		Select(fee.Columns()...).
+		From(b.loanFeeTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Order(goqu.C("fee_type").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fees []entity.LoanFee
+	for rows.Next() {
+		if err := rows.Scan(fee.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		fees = append(fees, entity.LoanFee{
+			ID:          uint64(fee.ID.Int64),
+			LoanID:      uint64(fee.LoanID.Int64),
+			FeeType:     entity.FeeType(fee.FeeType.String),
+			Calculation: entity.FeeCalculation(fee.Calculation.String),
+			Value:       fee.Value,
+			Collection:  entity.FeeCollection(fee.Collection.String),
+			Amount:      fee.Amount,
+			CreatedAt:   fee.CreatedAt.Time,
+		})
+	}
+
+	return fees, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// GL Export Usecases
+func (b *BillingEngineRepository) GetGLMappings(ctx context.Context) ([]entity.GLMapping, error) {
+	var mapping models.GLMapping
+
+	query := b.queryBuilder.
+		Select(mapping.Columns()...).
+		From(b.glMappingTableName).
+		Order(goqu.C("event").Asc(), goqu.C("account_code").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mappings []entity.GLMapping
+	for rows.Next() {
+		if err := rows.Scan(mapping.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		mappings = append(mappings, entity.GLMapping{
+			Event:       entity.JournalEvent(mapping.Event.String),
+			AccountCode: mapping.AccountCode.String,
+			GLCode:      mapping.GLCode.String,
+			UpdatedAt:   mapping.UpdatedAt.Time,
+		})
+	}
+
+	return mappings, nil
+}
+
+// SaveGLMapping creates the mapping of the event and account, or replaces its
+// GL code when it already exists.
+func (b *BillingEngineRepository) SaveGLMapping(ctx context.Context, mapping entity.GLMapping) (entity.GLMapping, error) {
+	saveMapping := models.GLMapping{
+		Event:       sql.NullString{String: string(mapping.Event), Valid: true},
+		AccountCode: sql.NullString{String: mapping.AccountCode, Valid: true},
+		GLCode:      sql.NullString{String: mapping.GLCode, Valid: true},
+		UpdatedAt:   sql.NullTime{Time: mapping.UpdatedAt, Valid: true},
+	}
+
+	query := b.queryBuilder.
+		Insert(b.glMappingTableName).
+		Cols(saveMapping.Columns()...).
+		Vals(saveMapping.Values()).
+		OnConflict(goqu.DoUpdate("event, account_code", goqu.Record{
+			"gl_code":    goqu.L("EXCLUDED.gl_code"),
+			"updated_at": goqu.L("EXCLUDED.updated_at"),
+		}))
+
+	sqlQuery, _, err := query.ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build query", "error", err, "table", b.glMappingTableName)
+		return entity.GLMapping{}, err
+	}
+
+	if _, err := b.conn(ctx).ExecContext(ctx, sqlQuery); err != nil {
+		b.logger.Errorw("failed to execute query", "error", err, "table", b.glMappingTableName)
+		return entity.GLMapping{}, err
+	}
+
+	return mapping, nil
+}
+
+// GetJournalEntriesByDate returns the entries effective on date in the order
+// they were posted.
+func (b *BillingEngineRepository) GetJournalEntriesByDate(ctx context.Context, date time.Time) ([]entity.JournalEntry, error) {
+	var entry models.JournalEntry
+
+	query := b.queryBuilder.
+		Select(entry.Columns()...).
+		From(b.journalEntryTableName).
+		Where(goqu.Ex{"effective_date": date.Format("2006-01-02")}).
+		Order(goqu.C("created_at").Asc(), goqu.C("id").Asc())
+
+	return b.scanJournalEntries(ctx, query)
+}
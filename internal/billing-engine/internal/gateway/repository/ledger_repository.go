@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Ledger Usecases
+func (b *BillingEngineRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	if !entry.IsBalanced() {
+		return entity.JournalEntry{}, fmt.Errorf("journal entry %d is not balanced", entry.ID)
+	}
+
+	createEntry := models.JournalEntry{
+		ID:              sql.NullInt64{Int64: int64(entry.ID), Valid: true},
+		LoanID:          sql.NullInt64{Int64: int64(entry.LoanID), Valid: true},
+		Event:           sql.NullString{String: string(entry.Event), Valid: true},
+		Reference:       sql.NullString{String: entry.Reference, Valid: true},
+		Description:     sql.NullString{String: entry.Description, Valid: true},
+		EffectiveDate:   sql.NullTime{Time: entry.EffectiveDate, Valid: true},
+		ReversesEntryID: sql.NullInt64{Int64: int64(entry.ReversesEntryID), Valid: entry.ReversesEntryID != 0},
+		CreatedAt:       sql.NullTime{Time: entry.CreatedAt, Valid: true},
+	}
+
+	// The header and its postings are written together, joining the
+	// transaction of the business change the entry books when there is one.
+	err := b.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := b.insertRecord(ctx, b.journalEntryTableName, &createEntry); err != nil {
+			return err
+		}
+
+		var posting models.Posting
+		rows := make([][]any, len(entry.Postings))
+		for i, p := range entry.Postings {
+			createPosting := models.Posting{
+				JournalEntryID: sql.NullInt64{Int64: int64(entry.ID), Valid: true},
+				LineNumber:     sql.NullInt64{Int64: int64(i + 1), Valid: true},
+				AccountCode:    sql.NullString{String: p.AccountCode, Valid: true},
+				Direction:      sql.NullString{String: string(p.Direction), Valid: true},
+				Amount:         p.Amount,
+			}
+			rows[i] = createPosting.Values()
+		}
+
+		query := b.queryBuilder.
+			Insert(b.postingTableName).
+			Cols(posting.Columns()...).
+			Vals(rows...)
+
+		sqlQuery, _, err := query.ToSQL()
+		if err != nil {
+			b.logger.Errorw("failed to build query", "error", err, "table", b.postingTableName)
+			return err
+		}
+
+		if _, err := b.conn(ctx).ExecContext(ctx, sqlQuery); err != nil {
+			b.logger.Errorw("failed to execute query", "error", err, "table", b.postingTableName)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.JournalEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// GetUnreversedJournalEntry returns the latest entry of the event and
+// reference on a loan that has not been reversed yet.
+func (b *BillingEngineRepository) GetUnreversedJournalEntry(ctx context.Context, loanID uint64, event entity.JournalEvent, reference string) (entity.JournalEntry, error) {
+	var entry models.JournalEntry
+
+	reversed := b.queryBuilder.
+		Select("reverses_entry_id").
+		From(b.journalEntryTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Where(goqu.C("reverses_entry_id").IsNotNull())
+
+	query := b.queryBuilder.
+		Select(entry.Columns()...).
+		From(b.journalEntryTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Where(goqu.Ex{"event": string(event)}).
+		Where(goqu.Ex{"reference": reference}).
+		Where(goqu.C("id").NotIn(reversed)).
+		Order(goqu.C("created_at").Desc()).
+		Limit(1)
+
+	entries, err := b.scanJournalEntries(ctx, query)
+	if err != nil {
+		return entity.JournalEntry{}, err
+	}
+
+	if len(entries) == 0 {
+		return entity.JournalEntry{}, fmt.Errorf("no %s entry %s to reverse on loan %d", event, reference, loanID)
+	}
+
+	return entries[0], nil
+}
+
+func (b *BillingEngineRepository) GetJournalEntriesByLoan(ctx context.Context, loanID uint64) ([]entity.JournalEntry, error) {
+	var entry models.JournalEntry
+
+	query := b.queryBuilder.
+		Select(entry.Columns()...).
+		From(b.journalEntryTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Order(goqu.C("effective_date").Asc(), goqu.C("created_at").Asc())
+
+	return b.scanJournalEntries(ctx, query)
+}
+
+func (b *BillingEngineRepository) GetLedgerAccounts(ctx context.Context) ([]entity.LedgerAccount, error) {
+	var account models.LedgerAccount
+
+	query := b.queryBuilder.
+		Select(account.Columns()...).
+		From(b.ledgerAccountTableName).
+		Order(goqu.C("code").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []entity.LedgerAccount
+	for rows.Next() {
+		if err := rows.Scan(account.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		accounts = append(accounts, entity.LedgerAccount{
+			Code: account.Code.String,
+			Name: account.Name.String,
+			Type: entity.AccountType(account.Type.String),
+		})
+	}
+
+	return accounts, nil
+}
+
+// GetAccountTotals sums the postings per account of the entries effective
+// on or before asOf.
+func (b *BillingEngineRepository) GetAccountTotals(ctx context.Context, asOf time.Time) ([]entity.AccountTotal, error) {
+	query := b.queryBuilder.
+		Select(
+			goqu.I("p.account_code"),
+			goqu.COALESCE(goqu.SUM(goqu.L("CASE WHEN ? = ? THEN ? END", goqu.I("p.direction"), string(entity.POSTING_DEBIT), goqu.I("p.amount"))), 0),
+			goqu.COALESCE(goqu.SUM(goqu.L("CASE WHEN ? = ? THEN ? END", goqu.I("p.direction"), string(entity.POSTING_CREDIT), goqu.I("p.amount"))), 0),
+		).
+		From(goqu.T(b.postingTableName).As("p")).
+		Join(goqu.T(b.journalEntryTableName).As("j"), goqu.On(goqu.I("j.id").Eq(goqu.I("p.journal_entry_id")))).
+		Where(goqu.I("j.effective_date").Lte(asOf.Format("2006-01-02"))).
+		GroupBy(goqu.I("p.account_code")).
+		Order(goqu.I("p.account_code").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []entity.AccountTotal
+	for rows.Next() {
+		var (
+			accountCode   sql.NullString
+			debit, credit decimal.Decimal
+		)
+		if err := rows.Scan(&accountCode, &debit, &credit); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		totals = append(totals, entity.AccountTotal{
+			AccountCode: accountCode.String,
+			Debit:       debit,
+			Credit:      credit,
+		})
+	}
+
+	return totals, nil
+}
+
+// scanJournalEntries runs the entry query and loads the postings of every
+// returned entry in one extra query.
+func (b *BillingEngineRepository) scanJournalEntries(ctx context.Context, query *goqu.SelectDataset) ([]entity.JournalEntry, error) {
+	var entry models.JournalEntry
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		entries []entity.JournalEntry
+		ids     []int64
+	)
+	for rows.Next() {
+		if err := rows.Scan(entry.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		entries = append(entries, entity.JournalEntry{
+			ID:              uint64(entry.ID.Int64),
+			LoanID:          uint64(entry.LoanID.Int64),
+			Event:           entity.JournalEvent(entry.Event.String),
+			Reference:       entry.Reference.String,
+			Description:     entry.Description.String,
+			EffectiveDate:   entry.EffectiveDate.Time,
+			ReversesEntryID: uint64(entry.ReversesEntryID.Int64),
+			CreatedAt:       entry.CreatedAt.Time,
+		})
+		ids = append(ids, entry.ID.Int64)
+	}
+
+	if len(entries) == 0 {
+		return entries, nil
+	}
+
+	postings, err := b.getPostings(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		entries[i].Postings = postings[entries[i].ID]
+	}
+
+	return entries, nil
+}
+
+func (b *BillingEngineRepository) getPostings(ctx context.Context, journalEntryIDs []int64) (map[uint64][]entity.Posting, error) {
+	var posting models.Posting
+
+	query := b.queryBuilder.
+		Select(posting.Columns()...).
+		From(b.postingTableName).
+		Where(goqu.C("journal_entry_id").In(journalEntryIDs)).
+		Order(goqu.C("journal_entry_id").Asc(), goqu.C("line_number").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	postings := make(map[uint64][]entity.Posting)
+	for rows.Next() {
+		if err := rows.Scan(posting.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		entryID := uint64(posting.JournalEntryID.Int64)
+		postings[entryID] = append(postings[entryID], entity.Posting{
+			AccountCode: posting.AccountCode.String,
+			Direction:   entity.PostingDirection(posting.Direction.String),
+			Amount:      posting.Amount,
+		})
+	}
+
+	return postings, nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Loan Application Usecases
+
+// CreateLoanApplication creates the APPLIED loan and records the application
+// as the first transition of its history.
+func (b *BillingEngineRepository) CreateLoanApplication(ctx context.Context, loan entity.Loan) (entity.Loan, error) {
+	createdLoan, err := b.CreateLoan(ctx, loan)
+	if err != nil {
+		return entity.Loan{}, err
+	}
+
+	if err := b.createLoanStatusTransition(ctx, entity.LoanStatusTransition{
+		LoanID:         createdLoan.ID,
+		ToStatus:       entity.LOAN_APPLIED,
+		Actor:          createdLoan.RequestedBy,
+		Reason:         "loan applied",
+		TransitionedAt: time.Now(),
+	}); err != nil {
+		return entity.Loan{}, err
+	}
+
+	return createdLoan, nil
+}
+
+func (b *BillingEngineRepository) IsCustomerHasWrittenOffLoan(ctx context.Context, customerID uint64) (bool, error) {
+	var id sql.NullInt64
+
+	query := b.queryBuilder.
+		Select("id").
+		From(b.loanTableName).
+		Where(goqu.Ex{"customer_id": customerID}).
+		Where(goqu.Ex{"status": string(entity.LOAN_WRITTEN_OFF)}).
+		Limit(1)
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SetLoanStartDate starts the loan on its disbursement date.
+func (b *BillingEngineRepository) SetLoanStartDate(ctx context.Context, loanID uint64, startDate time.Time) error {
+	row, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.loanTableName).
+		Set(goqu.Record{"start_date": startDate.Format("2006-01-02")}).
+		Where(goqu.Ex{"id": loanID}),
+	)
+	if err != nil {
+		return err
+	}
+
+	if row == 0 {
+		return fmt.Errorf("loan %d not found", loanID)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Loan Listing Usecases
+
+// SearchLoans returns a page of the loans matching the search, in its order.
+func (b *BillingEngineRepository) SearchLoans(ctx context.Context, search entity.LoanSearch) ([]entity.Loan, error) {
+	var loan models.Loan
+
+	columns := make([]any, 0, len(loan.Columns()))
+	for _, column := range loan.StringColumns() {
+		columns = append(columns, goqu.I("l."+column))
+	}
+
+	query := b.queryBuilder.
+		Select(columns...).
+		From(goqu.T(b.loanTableName).As("l")).
+		Limit(uint(search.Limit))
+
+	if search.CustomerID != 0 {
+		query = query.Where(goqu.I("l.customer_id").Eq(search.CustomerID))
+	}
+
+	if search.Status != "" {
+		query = query.Where(goqu.I("l.status").Eq(string(search.Status)))
+	}
+
+	if !search.StartFrom.IsZero() {
+		query = query.Where(goqu.I("l.start_date").Gte(search.StartFrom.Format("2006-01-02")))
+	}
+
+	if !search.StartTo.IsZero() {
+		query = query.Where(goqu.I("l.start_date").Lt(search.StartTo.AddDate(0, 0, 1).Format("2006-01-02")))
+	}
+
+	if search.Overdue != nil {
+		overdueInstallments := b.queryBuilder.
+			Select(goqu.L("1")).
+			From(goqu.T(b.installmentTableName).As("i")).
+			Where(goqu.I("i.loan_id").Eq(goqu.I("l.id"))).
+			Where(goqu.I("i.status").In(string(entity.INSTALLMENT_PENDING), string(entity.INSTALLMENT_MISSED))).
+			Where(goqu.I("i.due_date").Lt(search.AsOf.Format("2006-01-02")))
+
+		if *search.Overdue {
+			query = query.Where(goqu.L("EXISTS ?", overdueInstallments))
+		} else {
+			query = query.Where(goqu.L("NOT EXISTS ?", overdueInstallments))
+		}
+	}
+
+	switch search.Sort {
+	case entity.LOAN_SORT_NEWEST:
+		if search.After != nil {
+			query = query.Where(goqu.I("l.id").Lt(search.After.ID))
+		}
+		query = query.Order(goqu.I("l.id").Desc())
+	default:
+		if search.After != nil {
+			query = query.Where(goqu.I("l.id").Gt(search.After.ID))
+		}
+		query = query.Order(goqu.I("l.id").Asc())
+	}
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var loans []entity.Loan
+	for rows.Next() {
+		if err := rows.Scan(loan.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		loans = append(loans, toLoanEntity(loan))
+	}
+
+	return loans, nil
+}
+
+// GetUnpaidInstallmentsOfLoans returns the pending and missed installments of
+// every given loan at once, by loan then due date.
+func (b *BillingEngineRepository) GetUnpaidInstallmentsOfLoans(ctx context.Context, loanIDs []uint64) ([]entity.Installment, error) {
+	if len(loanIDs) == 0 {
+		return nil, nil
+	}
+
+	var installment models.Installment
+
+	query := b.queryBuilder.
+		Select(installment.Columns()...).
+		From(b.installmentTableName).
+		Where(goqu.Ex{"loan_id": loanIDs}).
+		Where(goqu.Ex{"status": []string{string(entity.INSTALLMENT_PENDING), string(entity.INSTALLMENT_MISSED)}}).
+		Order(goqu.C("loan_id").Asc(), goqu.C("due_date").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var installments []entity.Installment
+	for rows.Next() {
+		if err := rows.Scan(installment.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		installments = append(installments, entity.Installment{
+			ID:         uint64(installment.ID.Int64),
+			LoanID:     uint64(installment.LoanID.Int64),
+			WeekNumber: installment.WeekNumber.Int64,
+			DueDate:    installment.DueDate.String,
+			AmountDue:  installment.AmountDue.String,
+			FeeAmount:  installment.FeeAmount.String,
+			Status:     entity.InstallmentStatus(installment.Status.String),
+
+			HolidayInterest: installment.HolidayInterest.String,
+		})
+	}
+
+	return installments, nil
+}
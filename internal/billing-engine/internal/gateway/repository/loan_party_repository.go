@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+// Loan Party Usecases
+
+func (b *BillingEngineRepository) AddLoanParty(ctx context.Context, party entity.LoanParty) (entity.LoanParty, error) {
+	addParty := models.LoanParty{
+		ID:         sql.NullInt64{Int64: int64(party.ID), Valid: true},
+		LoanID:     sql.NullInt64{Int64: int64(party.LoanID), Valid: true},
+		CustomerID: sql.NullInt64{Int64: int64(party.CustomerID), Valid: true},
+		Role:       sql.NullString{String: string(party.Role), Valid: true},
+		AddedBy:    sql.NullString{String: party.AddedBy, Valid: true},
+		AddedAt:    sql.NullTime{Time: party.AddedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.loanPartyTableName, &addParty); err != nil {
+		return entity.LoanParty{}, err
+	}
+
+	return party, nil
+}
+
+// GetLoanParties returns the customers linked to a loan, in the order they
+// were added.
+func (b *BillingEngineRepository) GetLoanParties(ctx context.Context, loanID uint64) ([]entity.LoanParty, error) {
+	var party models.LoanParty
+
+	query := b.queryBuilder.
+		Select(party.Columns()...).
+		From(b.loanPartyTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Order(goqu.C("added_at").Asc(), goqu.C("id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var parties []entity.LoanParty
+	for rows.Next() {
+		if err := rows.Scan(party.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err, "loan_id", loanID)
+			return nil, err
+		}
+
+		parties = append(parties, entity.LoanParty{
+			ID:         uint64(party.ID.Int64),
+			LoanID:     uint64(party.LoanID.Int64),
+			CustomerID: uint64(party.CustomerID.Int64),
+			Role:       entity.LoanPartyRole(party.Role.String),
+			AddedBy:    party.AddedBy.String,
+			AddedAt:    party.AddedAt.Time,
+		})
+	}
+
+	return parties, nil
+}
+
+// GetLoanObligations returns what a customer may be liable for on the active
+// loans of other customers they are a party to: the principal of applications
+// and approved loans, the unpaid installments and fees of disbursed loans.
+func (b *BillingEngineRepository) GetLoanObligations(ctx context.Context, customerID uint64) ([]entity.LoanObligation, error) {
+	query := b.queryBuilder.
+		Select(
+			goqu.I("l.id"),
+			goqu.I("l.customer_id"),
+			goqu.I("p.role"),
+			goqu.I("l.status"),
+			b.loanExposure(),
+		).
+		From(goqu.T(b.loanPartyTableName).As("p")).
+		Join(goqu.T(b.loanTableName).As("l"), goqu.On(goqu.I("l.id").Eq(goqu.I("p.loan_id")))).
+		Where(goqu.I("p.customer_id").Eq(customerID)).
+		Where(goqu.I("l.status").In(activeLoanStatuses)).
+		Order(goqu.I("l.id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var obligations []entity.LoanObligation
+	for rows.Next() {
+		var (
+			obligation entity.LoanObligation
+			role       string
+			status     string
+		)
+		if err := rows.Scan(&obligation.LoanID, &obligation.BorrowerID, &role, &status, &obligation.Amount); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err, "customer_id", customerID)
+			return nil, err
+		}
+
+		obligation.Role = entity.LoanPartyRole(role)
+		obligation.LoanStatus = entity.LoanStatus(status)
+		obligations = append(obligations, obligation)
+	}
+
+	return obligations, nil
+}
+
+// loanExposure selects what is at risk on the loan aliased l: its principal
+// until it is paid out, then its unpaid installments and fees.
+func (b *BillingEngineRepository) loanExposure() exp.LiteralExpression {
+	unpaid := b.queryBuilder.
+		Select(goqu.COALESCE(goqu.SUM(goqu.L("? + ?", goqu.I("i.amount_due"), goqu.I("i.fee_amount"))), 0)).
+		From(goqu.T(b.installmentTableName).As("i")).
+		Where(goqu.I("i.loan_id").Eq(goqu.I("l.id"))).
+		Where(goqu.I("i.status").In(string(entity.INSTALLMENT_PENDING), string(entity.INSTALLMENT_MISSED)))
+
+	return goqu.L(
+		"CASE WHEN ? IN ? THEN ? ELSE ? END",
+		goqu.I("l.status"),
+		[]string{string(entity.LOAN_APPLIED), string(entity.LOAN_APPROVED)},
+		goqu.I("l.principal"),
+		unpaid,
+	)
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Loan Servicing Usecases
+func (b *BillingEngineRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	var loan models.Loan
+
+	query := b.queryBuilder.
+		Select(loan.Columns()...).
+		From(b.loanTableName).
+		Where(goqu.Ex{"id": loanID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.Loan{}, err
+	}
+
+	if err := row.Scan(loan.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Loan{}, fmt.Errorf("loan %d not found", loanID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return entity.Loan{}, err
+	}
+
+	return toLoanEntity(loan), nil
+}
+
+func (b *BillingEngineRepository) GetInstallment(ctx context.Context, loanID uint64, weekNumber int64) (entity.Installment, error) {
+	var installment models.Installment
+
+	query := b.queryBuilder.
+		Select(installment.Columns()...).
+		From(b.installmentTableName).
+		Where(goqu.Ex{"loan_id": loanID, "week_number": weekNumber})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.Installment{}, err
+	}
+
+	if err := row.Scan(installment.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Installment{}, fmt.Errorf("installment not found for loan %d week %d", loanID, weekNumber)
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return entity.Installment{}, err
+	}
+
+	return entity.Installment{
+		ID:         uint64(installment.ID.Int64),
+		LoanID:     uint64(installment.LoanID.Int64),
+		WeekNumber: installment.WeekNumber.Int64,
+		DueDate:    installment.DueDate.String,
+		AmountDue:  installment.AmountDue.String,
+		FeeAmount:  installment.FeeAmount.String,
+		Status:     entity.InstallmentStatus(installment.Status.String),
+
+		HolidayInterest: installment.HolidayInterest.String,
+	}, nil
+}
+
+// CreateInstallments inserts a precomputed schedule, generating the ids.
+func (b *BillingEngineRepository) CreateInstallments(ctx context.Context, installments []entity.Installment) error {
+	for _, installment := range installments {
+		createInstallment := models.Installment{
+			ID:         sql.NullInt64{Int64: int64(b.snowflakeGen.Generate()), Valid: true},
+			LoanID:     sql.NullInt64{Int64: int64(installment.LoanID), Valid: true},
+			WeekNumber: sql.NullInt64{Int64: installment.WeekNumber, Valid: true},
+			DueDate:    sql.NullString{String: installment.DueDate, Valid: true},
+			AmountDue:  sql.NullString{String: installment.AmountDue, Valid: true},
+			FeeAmount:  sql.NullString{String: installment.FeeAmount, Valid: true},
+			Status:     sql.NullString{String: string(installment.Status), Valid: true},
+
+			HolidayInterest: sql.NullString{String: decimal.Zero.StringFixed(2), Valid: true},
+		}
+
+		if err := b.insertRecord(ctx, b.installmentTableName, &createInstallment); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetOpenInstallmentsStatus moves every PENDING or MISSED installment of the
+// loan to status and returns how many were updated.
+func (b *BillingEngineRepository) SetOpenInstallmentsStatus(ctx context.Context, loanID uint64, status entity.InstallmentStatus) (int64, error) {
+	query := b.queryBuilder.
+		Update(b.installmentTableName).
+		Set(goqu.Record{"status": string(status)}).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Where(goqu.Ex{"status": []string{string(entity.INSTALLMENT_PENDING), string(entity.INSTALLMENT_MISSED)}})
+
+	return b.execUpdate(ctx, query)
+}
+
+func (b *BillingEngineRepository) CreateLoanRestructure(ctx context.Context, restructure entity.LoanRestructure) (entity.LoanRestructure, error) {
+	createRestructure := models.LoanRestructure{
+		ID:                   sql.NullInt64{Int64: int64(restructure.ID), Valid: true},
+		LoanID:               sql.NullInt64{Int64: int64(restructure.LoanID), Valid: true},
+		NewLoanID:            sql.NullInt64{Int64: int64(restructure.NewLoanID), Valid: true},
+		Type:                 sql.NullString{String: string(restructure.Type), Valid: true},
+		OutstandingAmount:    restructure.OutstandingAmount,
+		ArrearsAmount:        restructure.ArrearsAmount,
+		ClosedInstallments:   sql.NullInt64{Int64: restructure.ClosedInstallments, Valid: true},
+		NewTermWeeks:         sql.NullInt64{Int64: restructure.NewTermWeeks, Valid: true},
+		NewInstallmentAmount: restructure.NewInstallmentAmount,
+		Reason:               sql.NullString{String: restructure.Reason, Valid: true},
+		RestructuredAt:       sql.NullTime{Time: restructure.RestructuredAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.loanRestructureTableName, &createRestructure); err != nil {
+		return entity.LoanRestructure{}, err
+	}
+
+	return restructure, nil
+}
+
+// GetLoanRestructures returns the restructures a loan took part in, either as
+// the original or as the rescheduled loan, oldest first.
+func (b *BillingEngineRepository) GetLoanRestructures(ctx context.Context, loanID uint64) ([]entity.LoanRestructure, error) {
+	var restructure models.LoanRestructure
+
+	query := b.queryBuilder.
+		Select(restructure.Columns()...).
+		From(b.loanRestructureTableName).
+		Where(goqu.Or(
+			goqu.Ex{"loan_id": loanID},
+			goqu.Ex{"new_loan_id": loanID},
+		)).
+		Order(goqu.C("restructured_at").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var restructures []entity.LoanRestructure
+	for rows.Next() {
+		if err := rows.Scan(restructure.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		restructures = append(restructures, entity.LoanRestructure{
+			ID:                   uint64(restructure.ID.Int64),
+			LoanID:               uint64(restructure.LoanID.Int64),
+			NewLoanID:            uint64(restructure.NewLoanID.Int64),
+			Type:                 entity.RestructureType(restructure.Type.String),
+			OutstandingAmount:    restructure.OutstandingAmount,
+			ArrearsAmount:        restructure.ArrearsAmount,
+			ClosedInstallments:   restructure.ClosedInstallments.Int64,
+			NewTermWeeks:         restructure.NewTermWeeks.Int64,
+			NewInstallmentAmount: restructure.NewInstallmentAmount,
+			Reason:               restructure.Reason.String,
+			RestructuredAt:       restructure.RestructuredAt.Time,
+		})
+	}
+
+	return restructures, nil
+}
+
+func toLoanEntity(loan models.Loan) entity.Loan {
+	return entity.Loan{
+		ID:                     uint64(loan.ID.Int64),
+		CustomerID:             uint64(loan.CustomerID.Int64),
+		PrincipalAmount:        loan.PrincipalAmount,
+		InterestRate:           loan.InterestRate,
+		TermWeeks:              loan.TermWeeks.Int64,
+		StartDate:              loan.StartDate.Time,
+		Status:                 entity.LoanStatus(loan.Status.String),
+		ProductCode:            loan.ProductCode.String,
+		RequestedBy:            loan.RequestedBy.String,
+		RestructuredFromLoanID: uint64(loan.RestructuredFromLoanID.Int64),
+		CreditLineID:           uint64(loan.CreditLineID.Int64),
+		MerchantID:             uint64(loan.MerchantID.Int64),
+		OrderRef:               loan.OrderRef.String,
+	}
+}
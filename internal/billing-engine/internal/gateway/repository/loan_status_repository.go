@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Loan Lifecycle Usecases
+
+// TransitionLoanStatus moves the loan to the transition's status and records
+// the transition. It fails when the loan is no longer in the status the
+// transition starts from, e.g. when it was moved concurrently.
+func (b *BillingEngineRepository) TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error {
+	moved, err := b.moveLoanStatus(ctx, transition)
+	if err != nil {
+		return err
+	}
+
+	if !moved {
+		return fmt.Errorf("loan %d is no longer %s", transition.LoanID, transition.FromStatus)
+	}
+
+	return nil
+}
+
+// moveLoanStatus moves the loan and records the transition only when the
+// loan is in the status the transition starts from, and reports whether it
+// was.
+func (b *BillingEngineRepository) moveLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) (bool, error) {
+	row, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.loanTableName).
+		Set(goqu.Record{"status": string(transition.ToStatus)}).
+		Where(goqu.Ex{"id": transition.LoanID}).
+		Where(goqu.Ex{"status": string(transition.FromStatus)}),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if row == 0 {
+		return false, nil
+	}
+
+	if err := b.createLoanStatusTransition(ctx, transition); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (b *BillingEngineRepository) createLoanStatusTransition(ctx context.Context, transition entity.LoanStatusTransition) error {
+	createTransition := models.LoanStatusTransition{
+		ID:             sql.NullInt64{Int64: int64(b.snowflakeGen.Generate()), Valid: true},
+		LoanID:         sql.NullInt64{Int64: int64(transition.LoanID), Valid: true},
+		FromStatus:     sql.NullString{String: string(transition.FromStatus), Valid: transition.FromStatus != ""},
+		ToStatus:       sql.NullString{String: string(transition.ToStatus), Valid: true},
+		Actor:          sql.NullString{String: transition.Actor, Valid: true},
+		Reason:         sql.NullString{String: transition.Reason, Valid: true},
+		TransitionedAt: sql.NullTime{Time: transition.TransitionedAt, Valid: true},
+	}
+
+	return b.insertRecord(ctx, b.loanStatusTransitionTableName, &createTransition)
+}
+
+func (b *BillingEngineRepository) GetLoanStatusTransitions(ctx context.Context, loanID uint64) ([]entity.LoanStatusTransition, error) {
+	var transition models.LoanStatusTransition
+
+	query := b.queryBuilder.
+		Select(transition.Columns()...).
+		From(b.loanStatusTransitionTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Order(goqu.C("transitioned_at").Asc(), goqu.C("id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transitions []entity.LoanStatusTransition
+	for rows.Next() {
+		if err := rows.Scan(transition.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		transitions = append(transitions, entity.LoanStatusTransition{
+			ID:             uint64(transition.ID.Int64),
+			LoanID:         uint64(transition.LoanID.Int64),
+			FromStatus:     entity.LoanStatus(transition.FromStatus.String),
+			ToStatus:       entity.LoanStatus(transition.ToStatus.String),
+			Actor:          transition.Actor.String,
+			Reason:         transition.Reason.String,
+			TransitionedAt: transition.TransitionedAt.Time,
+		})
+	}
+
+	return transitions, nil
+}
@@ -0,0 +1,412 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Merchant Usecases
+
+func (b *BillingEngineRepository) CreateMerchant(ctx context.Context, merchant entity.Merchant) (entity.Merchant, error) {
+	createMerchant := models.Merchant{
+		ID:            sql.NullInt64{Int64: int64(merchant.ID), Valid: true},
+		Code:          sql.NullString{String: merchant.Code, Valid: true},
+		Name:          sql.NullString{String: merchant.Name, Valid: true},
+		MDRRate:       merchant.MDRRate,
+		BankCode:      sql.NullString{String: merchant.SettlementAccount.BankCode, Valid: true},
+		AccountNumber: sql.NullString{String: merchant.SettlementAccount.AccountNumber, Valid: true},
+		AccountName:   sql.NullString{String: merchant.SettlementAccount.AccountName, Valid: true},
+		CreatedBy:     sql.NullString{String: merchant.CreatedBy, Valid: true},
+		CreatedAt:     sql.NullTime{Time: merchant.CreatedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.merchantTableName, &createMerchant); err != nil {
+		return entity.Merchant{}, err
+	}
+
+	return merchant, nil
+}
+
+func (b *BillingEngineRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	var merchant models.Merchant
+
+	query := b.queryBuilder.
+		Select(merchant.Columns()...).
+		From(b.merchantTableName).
+		Where(goqu.Ex{"id": merchantID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.Merchant{}, err
+	}
+
+	if err := row.Scan(merchant.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Merchant{}, fmt.Errorf("merchant %d not found", merchantID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "merchant_id", merchantID)
+		return entity.Merchant{}, err
+	}
+
+	return entity.Merchant{
+		ID:      uint64(merchant.ID.Int64),
+		Code:    merchant.Code.String,
+		Name:    merchant.Name.String,
+		MDRRate: merchant.MDRRate,
+		SettlementAccount: entity.BankAccount{
+			BankCode:      merchant.BankCode.String,
+			AccountNumber: merchant.AccountNumber.String,
+			AccountName:   merchant.AccountName.String,
+		},
+		CreatedBy: merchant.CreatedBy.String,
+		CreatedAt: merchant.CreatedAt.Time,
+	}, nil
+}
+
+func (b *BillingEngineRepository) IsMerchantCodeExist(ctx context.Context, code string) (bool, error) {
+	var id sql.NullInt64
+
+	query := b.queryBuilder.
+		Select("id").
+		From(b.merchantTableName).
+		Where(goqu.Ex{"code": code})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "code", code)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IsMerchantOrderExist tells whether a loan was already booked for the order
+// of the merchant.
+func (b *BillingEngineRepository) IsMerchantOrderExist(ctx context.Context, merchantID uint64, orderRef string) (bool, error) {
+	var id sql.NullInt64
+
+	query := b.queryBuilder.
+		Select("id").
+		From(b.loanTableName).
+		Where(goqu.Ex{"merchant_id": merchantID}).
+		Where(goqu.Ex{"order_ref": orderRef})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "merchant_id", merchantID)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (b *BillingEngineRepository) CreateMerchantSettlementItem(ctx context.Context, item entity.MerchantSettlementItem) error {
+	createItem := models.MerchantSettlementItem{
+		ID:          sql.NullInt64{Int64: int64(item.ID), Valid: true},
+		MerchantID:  sql.NullInt64{Int64: int64(item.MerchantID), Valid: true},
+		LoanID:      sql.NullInt64{Int64: int64(item.LoanID), Valid: true},
+		OrderRef:    sql.NullString{String: item.OrderRef, Valid: true},
+		SaleDate:    sql.NullTime{Time: item.SaleDate, Valid: true},
+		GrossAmount: item.GrossAmount,
+		MDRAmount:   item.MDRAmount,
+		NetAmount:   item.NetAmount,
+		CreatedAt:   sql.NullTime{Time: item.CreatedAt, Valid: true},
+	}
+
+	return b.insertRecord(ctx, b.merchantSettlementItemTableName, &createItem)
+}
+
+// GetUnsettledMerchantItems returns the items of every merchant sold up to
+// saleDate that no settlement batched yet, by merchant and sale.
+func (b *BillingEngineRepository) GetUnsettledMerchantItems(ctx context.Context, saleDate time.Time) ([]entity.MerchantSettlementItem, error) {
+	var item models.MerchantSettlementItem
+
+	return b.scanMerchantSettlementItems(ctx, b.queryBuilder.
+		Select(item.Columns()...).
+		From(b.merchantSettlementItemTableName).
+		Where(goqu.C("settlement_id").IsNull()).
+		Where(goqu.C("sale_date").Lte(saleDate.Format("2006-01-02"))).
+		Order(goqu.C("merchant_id").Asc(), goqu.C("sale_date").Asc(), goqu.C("id").Asc()),
+	)
+}
+
+func (b *BillingEngineRepository) IsMerchantSettled(ctx context.Context, merchantID uint64, settlementDate time.Time) (bool, error) {
+	var id sql.NullInt64
+
+	query := b.queryBuilder.
+		Select("id").
+		From(b.merchantSettlementTableName).
+		Where(goqu.Ex{"merchant_id": merchantID}).
+		Where(goqu.Ex{"settlement_date": settlementDate.Format("2006-01-02")})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "merchant_id", merchantID)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CreateMerchantSettlement records the batch and assigns it its items in one
+// transaction, failing and rolling back when one of them was batched in the
+// meantime.
+func (b *BillingEngineRepository) CreateMerchantSettlement(ctx context.Context, settlement entity.MerchantSettlement) (entity.MerchantSettlement, error) {
+	createSettlement := models.MerchantSettlement{
+		ID:             sql.NullInt64{Int64: int64(settlement.ID), Valid: true},
+		MerchantID:     sql.NullInt64{Int64: int64(settlement.MerchantID), Valid: true},
+		SettlementDate: sql.NullTime{Time: settlement.SettlementDate, Valid: true},
+		GrossAmount:    settlement.GrossAmount,
+		MDRAmount:      settlement.MDRAmount,
+		NetAmount:      settlement.NetAmount,
+		ItemCount:      sql.NullInt64{Int64: settlement.ItemCount, Valid: true},
+		Status:         sql.NullString{String: string(settlement.Status), Valid: true},
+		Reference:      sql.NullString{String: settlement.Reference, Valid: settlement.Reference != ""},
+		FailureReason:  sql.NullString{String: settlement.FailureReason, Valid: settlement.FailureReason != ""},
+		UpdatedBy:      sql.NullString{String: settlement.UpdatedBy, Valid: true},
+		CreatedAt:      sql.NullTime{Time: settlement.CreatedAt, Valid: true},
+		UpdatedAt:      sql.NullTime{Time: settlement.UpdatedAt, Valid: true},
+		SettledAt:      sql.NullTime{Time: settlement.SettledAt, Valid: !settlement.SettledAt.IsZero()},
+	}
+
+	itemIDs := make([]uint64, len(settlement.Items))
+	for i, item := range settlement.Items {
+		itemIDs[i] = item.ID
+	}
+
+	// The header and the assignment of its items are written together, so a
+	// batch losing one of its items to another leaves nothing behind.
+	err := b.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := b.insertRecord(ctx, b.merchantSettlementTableName, &createSettlement); err != nil {
+			return err
+		}
+
+		batched, err := b.execUpdate(ctx, b.queryBuilder.
+			Update(b.merchantSettlementItemTableName).
+			Set(goqu.Record{"settlement_id": settlement.ID}).
+			Where(goqu.C("id").In(itemIDs)).
+			Where(goqu.C("settlement_id").IsNull()),
+		)
+		if err != nil {
+			return err
+		}
+
+		if batched != int64(len(itemIDs)) {
+			return fmt.Errorf(
+				"%d of the %d items of merchant settlement %d were already batched",
+				int64(len(itemIDs))-batched, len(itemIDs), settlement.ID,
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.MerchantSettlement{}, err
+	}
+
+	return settlement, nil
+}
+
+// GetMerchantSettlement returns the settlement with its items.
+func (b *BillingEngineRepository) GetMerchantSettlement(ctx context.Context, settlementID uint64) (entity.MerchantSettlement, error) {
+	var settlement models.MerchantSettlement
+
+	query := b.queryBuilder.
+		Select(settlement.Columns()...).
+		From(b.merchantSettlementTableName).
+		Where(goqu.Ex{"id": settlementID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.MerchantSettlement{}, err
+	}
+
+	if err := row.Scan(settlement.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.MerchantSettlement{}, fmt.Errorf("merchant settlement %d not found", settlementID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "settlement_id", settlementID)
+		return entity.MerchantSettlement{}, err
+	}
+
+	return b.withMerchantSettlementItems(ctx, toMerchantSettlementEntity(settlement))
+}
+
+// GetMerchantSettlements returns the settlements of a merchant with their
+// items, latest first.
+func (b *BillingEngineRepository) GetMerchantSettlements(ctx context.Context, merchantID uint64) ([]entity.MerchantSettlement, error) {
+	var settlement models.MerchantSettlement
+
+	return b.scanMerchantSettlements(ctx, b.queryBuilder.
+		Select(settlement.Columns()...).
+		From(b.merchantSettlementTableName).
+		Where(goqu.Ex{"merchant_id": merchantID}).
+		Order(goqu.C("settlement_date").Desc()),
+	)
+}
+
+// GetMerchantSettlementsByDate returns the settlements of every merchant for
+// a settlement date with their items.
+func (b *BillingEngineRepository) GetMerchantSettlementsByDate(ctx context.Context, settlementDate time.Time) ([]entity.MerchantSettlement, error) {
+	var settlement models.MerchantSettlement
+
+	return b.scanMerchantSettlements(ctx, b.queryBuilder.
+		Select(settlement.Columns()...).
+		From(b.merchantSettlementTableName).
+		Where(goqu.Ex{"settlement_date": settlementDate.Format("2006-01-02")}).
+		Order(goqu.C("merchant_id").Asc()),
+	)
+}
+
+// UpdateMerchantSettlement stores the status of the settlement only when it
+// is still in status from, and reports whether it was.
+func (b *BillingEngineRepository) UpdateMerchantSettlement(ctx context.Context, settlement entity.MerchantSettlement, from entity.SettlementStatus) (bool, error) {
+	updated, err := b.execUpdate(ctx, b.queryBuilder.
+		Update(b.merchantSettlementTableName).
+		Set(goqu.Record{
+			"status":         string(settlement.Status),
+			"reference":      sql.NullString{String: settlement.Reference, Valid: settlement.Reference != ""},
+			"failure_reason": sql.NullString{String: settlement.FailureReason, Valid: settlement.FailureReason != ""},
+			"updated_by":     settlement.UpdatedBy,
+			"updated_at":     settlement.UpdatedAt,
+			"settled_at":     sql.NullTime{Time: settlement.SettledAt, Valid: !settlement.SettledAt.IsZero()},
+		}).
+		Where(goqu.Ex{"id": settlement.ID}).
+		Where(goqu.Ex{"status": string(from)}),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return updated > 0, nil
+}
+
+func (b *BillingEngineRepository) scanMerchantSettlements(ctx context.Context, query *goqu.SelectDataset) ([]entity.MerchantSettlement, error) {
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		settlement  models.MerchantSettlement
+		settlements []entity.MerchantSettlement
+	)
+	for rows.Next() {
+		if err := rows.Scan(settlement.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		settlements = append(settlements, toMerchantSettlementEntity(settlement))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range settlements {
+		if settlements[i], err = b.withMerchantSettlementItems(ctx, settlements[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return settlements, nil
+}
+
+func (b *BillingEngineRepository) withMerchantSettlementItems(ctx context.Context, settlement entity.MerchantSettlement) (entity.MerchantSettlement, error) {
+	var item models.MerchantSettlementItem
+
+	items, err := b.scanMerchantSettlementItems(ctx, b.queryBuilder.
+		Select(item.Columns()...).
+		From(b.merchantSettlementItemTableName).
+		Where(goqu.Ex{"settlement_id": settlement.ID}).
+		Order(goqu.C("sale_date").Asc(), goqu.C("id").Asc()),
+	)
+	if err != nil {
+		return entity.MerchantSettlement{}, err
+	}
+
+	settlement.Items = items
+	return settlement, nil
+}
+
+func (b *BillingEngineRepository) scanMerchantSettlementItems(ctx context.Context, query *goqu.SelectDataset) ([]entity.MerchantSettlementItem, error) {
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		item  models.MerchantSettlementItem
+		items []entity.MerchantSettlementItem
+	)
+	for rows.Next() {
+		if err := rows.Scan(item.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		items = append(items, entity.MerchantSettlementItem{
+			ID:           uint64(item.ID.Int64),
+			SettlementID: uint64(item.SettlementID.Int64),
+			MerchantID:   uint64(item.MerchantID.Int64),
+			LoanID:       uint64(item.LoanID.Int64),
+			OrderRef:     item.OrderRef.String,
+			SaleDate:     item.SaleDate.Time,
+			GrossAmount:  item.GrossAmount,
+			MDRAmount:    item.MDRAmount,
+			NetAmount:    item.NetAmount,
+			CreatedAt:    item.CreatedAt.Time,
+		})
+	}
+
+	return items, rows.Err()
+}
+
+func toMerchantSettlementEntity(settlement models.MerchantSettlement) entity.MerchantSettlement {
+	return entity.MerchantSettlement{
+		ID:             uint64(settlement.ID.Int64),
+		MerchantID:     uint64(settlement.MerchantID.Int64),
+		SettlementDate: settlement.SettlementDate.Time,
+		GrossAmount:    settlement.GrossAmount,
+		MDRAmount:      settlement.MDRAmount,
+		NetAmount:      settlement.NetAmount,
+		ItemCount:      settlement.ItemCount.Int64,
+		Status:         entity.SettlementStatus(settlement.Status.String),
+		Reference:      settlement.Reference.String,
+		FailureReason:  settlement.FailureReason.String,
+		UpdatedBy:      settlement.UpdatedBy.String,
+		CreatedAt:      settlement.CreatedAt.Time,
+		UpdatedAt:      settlement.UpdatedAt.Time,
+		SettledAt:      settlement.SettledAt.Time,
+	}
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestBillingEngineRepository_CreateMerchantSettlement(t *testing.T) {
+	saleDate := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	items := []entity.MerchantSettlementItem{
+		{ID: 11, MerchantID: 5, LoanID: 100, OrderRef: "ORD-1", SaleDate: saleDate, GrossAmount: decimal.NewFromInt(1000000), MDRAmount: decimal.NewFromInt(20000), NetAmount: decimal.NewFromInt(980000)},
+		{ID: 12, MerchantID: 5, LoanID: 101, OrderRef: "ORD-2", SaleDate: saleDate, GrossAmount: decimal.NewFromInt(500000), MDRAmount: decimal.NewFromInt(10000), NetAmount: decimal.NewFromInt(490000)},
+	}
+	settlement := entity.NewMerchantSettlement(70, 5, saleDate.AddDate(0, 0, 1), items, "ops", saleDate.AddDate(0, 0, 1))
+
+	tests := []struct {
+		name          string
+		setupMocks    func(sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "success - batch and its items committed together",
+			setupMocks: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`INSERT INTO "merchant_settlements"`).WillReturnResult(sqlmock.NewResult(0, 1))
+				mockDB.ExpectExec(`UPDATE "merchant_settlement_items" SET "settlement_id"=70 WHERE \(\("id" IN \(11, 12\)\) AND \("settlement_id" IS NULL\)\)`).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "error - batch rolled back when an item was already batched",
+			setupMocks: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`INSERT INTO "merchant_settlements"`).WillReturnResult(sqlmock.NewResult(0, 1))
+				mockDB.ExpectExec(`UPDATE "merchant_settlement_items"`).WillReturnResult(sqlmock.NewResult(0, 1))
+				mockDB.ExpectRollback()
+			},
+			expectedError: errors.New("1 of the 2 items of merchant settlement 70 were already batched"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mockDB, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			tt.setupMocks(mockDB)
+
+			repository := NewBillingEngineRepository(db, zap.NewNop().Sugar(), goqu.Dialect("postgres"), pkgmocks.NewMockSnowflake(t))
+
+			created, err := repository.CreateMerchantSettlement(context.Background(), settlement)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Empty(t, created.ID)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(70), created.ID)
+				assert.Equal(t, int64(2), created.ItemCount)
+			}
+			assert.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
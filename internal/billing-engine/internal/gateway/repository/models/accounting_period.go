@@ -0,0 +1,61 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type AccountingPeriod struct {
+	Period   sql.NullString `json:"period"`
+	Status   sql.NullString `json:"status"`
+	ClosedBy sql.NullString `json:"closed_by"`
+	ClosedAt sql.NullTime   `json:"closed_at"`
+}
+
+func (a *AccountingPeriod) Columns() []any {
+	return []any{
+		"period",
+		"status",
+		"closed_by",
+		"closed_at",
+	}
+}
+
+func (a *AccountingPeriod) StringColumns() []string {
+	vals := make([]string, len(a.Columns()))
+	for i, col := range a.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (a *AccountingPeriod) Values() []any {
+	return []any{
+		&a.Period,
+		&a.Status,
+		&a.ClosedBy,
+		&a.ClosedAt,
+	}
+}
+
+func (a AccountingPeriod) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(a.Values()))
+	for i, v := range a.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (a AccountingPeriod) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"period":    a.Period.String,
+		"status":    a.Status.String,
+		"closed_by": a.ClosedBy.String,
+		"closed_at": a.ClosedAt.Time,
+	}
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type Collateral struct {
+	ID            sql.NullInt64   `json:"id"`
+	LoanID        sql.NullInt64   `json:"loan_id"`
+	Type          sql.NullString  `json:"type"`
+	Description   sql.NullString  `json:"description"`
+	Valuation     decimal.Decimal `json:"valuation"`
+	ValuationDate sql.NullTime    `json:"valuation_date"`
+	LienStatus    sql.NullString  `json:"lien_status"`
+	RegisteredBy  sql.NullString  `json:"registered_by"`
+	RegisteredAt  sql.NullTime    `json:"registered_at"`
+	LienUpdatedBy sql.NullString  `json:"lien_updated_by"`
+	LienUpdatedAt sql.NullTime    `json:"lien_updated_at"`
+}
+
+func (c *Collateral) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"type",
+		"description",
+		"valuation",
+		"valuation_date",
+		"lien_status",
+		"registered_by",
+		"registered_at",
+		"lien_updated_by",
+		"lien_updated_at",
+	}
+}
+
+func (c *Collateral) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *Collateral) Values() []any {
+	return []any{
+		&c.ID,
+		&c.LoanID,
+		&c.Type,
+		&c.Description,
+		&c.Valuation,
+		&c.ValuationDate,
+		&c.LienStatus,
+		&c.RegisteredBy,
+		&c.RegisteredAt,
+		&c.LienUpdatedBy,
+		&c.LienUpdatedAt,
+	}
+}
+
+func (c Collateral) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c Collateral) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":              c.ID.Int64,
+		"loan_id":         c.LoanID.Int64,
+		"type":            c.Type.String,
+		"description":     c.Description.String,
+		"valuation":       c.Valuation,
+		"valuation_date":  c.ValuationDate.Time,
+		"lien_status":     c.LienStatus.String,
+		"registered_by":   c.RegisteredBy.String,
+		"registered_at":   c.RegisteredAt.Time,
+		"lien_updated_by": c.LienUpdatedBy.String,
+		"lien_updated_at": c.LienUpdatedAt.Time,
+	}
+}
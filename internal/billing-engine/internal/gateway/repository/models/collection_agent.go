@@ -0,0 +1,61 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type CollectionAgent struct {
+	ID     sql.NullInt64  `json:"id"`
+	Name   sql.NullString `json:"name"`
+	Bucket sql.NullString `json:"bucket"`
+	Active sql.NullBool   `json:"active"`
+}
+
+func (a *CollectionAgent) Columns() []any {
+	return []any{
+		"id",
+		"name",
+		"bucket",
+		"active",
+	}
+}
+
+func (a *CollectionAgent) StringColumns() []string {
+	vals := make([]string, len(a.Columns()))
+	for i, col := range a.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (a *CollectionAgent) Values() []any {
+	return []any{
+		&a.ID,
+		&a.Name,
+		&a.Bucket,
+		&a.Active,
+	}
+}
+
+func (a CollectionAgent) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(a.Values()))
+	for i, v := range a.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (a CollectionAgent) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":     a.ID.Int64,
+		"name":   a.Name.String,
+		"bucket": a.Bucket.String,
+		"active": a.Active.Bool,
+	}
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type CollectionCase struct {
+	ID                 sql.NullInt64  `json:"id"`
+	LoanID             sql.NullInt64  `json:"loan_id"`
+	CustomerID         sql.NullInt64  `json:"customer_id"`
+	AgentID            sql.NullInt64  `json:"agent_id"`
+	Bucket             sql.NullString `json:"bucket"`
+	DaysPastDue        sql.NullInt64  `json:"days_past_due"`
+	MissedInstallments sql.NullInt64  `json:"missed_installments"`
+	Status             sql.NullString `json:"status"`
+	OpenedAt           sql.NullTime   `json:"opened_at"`
+}
+
+func (c *CollectionCase) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"customer_id",
+		"agent_id",
+		"bucket",
+		"days_past_due",
+		"missed_installments",
+		"status",
+		"opened_at",
+	}
+}
+
+func (c *CollectionCase) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *CollectionCase) Values() []any {
+	return []any{
+		&c.ID,
+		&c.LoanID,
+		&c.CustomerID,
+		&c.AgentID,
+		&c.Bucket,
+		&c.DaysPastDue,
+		&c.MissedInstallments,
+		&c.Status,
+		&c.OpenedAt,
+	}
+}
+
+func (c CollectionCase) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c CollectionCase) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":                  c.ID.Int64,
+		"loan_id":             c.LoanID.Int64,
+		"customer_id":         c.CustomerID.Int64,
+		"agent_id":            c.AgentID.Int64,
+		"bucket":              c.Bucket.String,
+		"days_past_due":       c.DaysPastDue.Int64,
+		"missed_installments": c.MissedInstallments.Int64,
+		"status":              c.Status.String,
+		"opened_at":           c.OpenedAt.Time,
+	}
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type Communication struct {
+	ID                sql.NullInt64  `json:"id"`
+	LoanID            sql.NullInt64  `json:"loan_id"`
+	CustomerID        sql.NullInt64  `json:"customer_id"`
+	InstallmentID     sql.NullInt64  `json:"installment_id"`
+	Event             sql.NullString `json:"event"`
+	Channel           sql.NullString `json:"channel"`
+	Language          sql.NullString `json:"language"`
+	Recipient         sql.NullString `json:"recipient"`
+	Subject           sql.NullString `json:"subject"`
+	Body              sql.NullString `json:"body"`
+	Status            sql.NullString `json:"status"`
+	ProviderMessageID sql.NullString `json:"provider_message_id"`
+	ErrorMessage      sql.NullString `json:"error_message"`
+	EventKey          sql.NullString `json:"event_key"`
+	SentAt            sql.NullTime   `json:"sent_at"`
+}
+
+func (c *Communication) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"customer_id",
+		"installment_id",
+		"event",
+		"channel",
+		"language",
+		"recipient",
+		"subject",
+		"body",
+		"status",
+		"provider_message_id",
+		"error_message",
+		"event_key",
+		"sent_at",
+	}
+}
+
+func (c *Communication) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *Communication) Values() []any {
+	return []any{
+		&c.ID,
+		&c.LoanID,
+		&c.CustomerID,
+		&c.InstallmentID,
+		&c.Event,
+		&c.Channel,
+		&c.Language,
+		&c.Recipient,
+		&c.Subject,
+		&c.Body,
+		&c.Status,
+		&c.ProviderMessageID,
+		&c.ErrorMessage,
+		&c.EventKey,
+		&c.SentAt,
+	}
+}
+
+func (c Communication) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c Communication) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":                  c.ID.Int64,
+		"loan_id":             c.LoanID.Int64,
+		"customer_id":         c.CustomerID.Int64,
+		"installment_id":      c.InstallmentID.Int64,
+		"event":               c.Event.String,
+		"channel":             c.Channel.String,
+		"language":            c.Language.String,
+		"recipient":           c.Recipient.String,
+		"subject":             c.Subject.String,
+		"body":                c.Body.String,
+		"status":              c.Status.String,
+		"provider_message_id": c.ProviderMessageID.String,
+		"error_message":       c.ErrorMessage.String,
+		"event_key":           c.EventKey.String,
+		"sent_at":             c.SentAt.Time,
+	}
+}
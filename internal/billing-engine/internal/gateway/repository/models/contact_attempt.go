@@ -0,0 +1,73 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type ContactAttempt struct {
+	ID          sql.NullInt64  `json:"id"`
+	CaseID      sql.NullInt64  `json:"case_id"`
+	AgentID     sql.NullInt64  `json:"agent_id"`
+	Channel     sql.NullString `json:"channel"`
+	Outcome     sql.NullString `json:"outcome"`
+	Notes       sql.NullString `json:"notes"`
+	AttemptedAt sql.NullTime   `json:"attempted_at"`
+}
+
+func (c *ContactAttempt) Columns() []any {
+	return []any{
+		"id",
+		"case_id",
+		"agent_id",
+		"channel",
+		"outcome",
+		"notes",
+		"attempted_at",
+	}
+}
+
+func (c *ContactAttempt) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *ContactAttempt) Values() []any {
+	return []any{
+		&c.ID,
+		&c.CaseID,
+		&c.AgentID,
+		&c.Channel,
+		&c.Outcome,
+		&c.Notes,
+		&c.AttemptedAt,
+	}
+}
+
+func (c ContactAttempt) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c ContactAttempt) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":           c.ID.Int64,
+		"case_id":      c.CaseID.Int64,
+		"agent_id":     c.AgentID.Int64,
+		"channel":      c.Channel.String,
+		"outcome":      c.Outcome.String,
+		"notes":        c.Notes.String,
+		"attempted_at": c.AttemptedAt.Time,
+	}
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreditLimit struct {
+	CustomerID     sql.NullInt64   `json:"customer_id"`
+	CreditLimit    decimal.Decimal `json:"credit_limit"`
+	MaxActiveLoans sql.NullInt64   `json:"max_active_loans"`
+	UpdatedBy      sql.NullString  `json:"updated_by"`
+	UpdatedAt      sql.NullTime    `json:"updated_at"`
+}
+
+func (c *CreditLimit) Columns() []any {
+	return []any{
+		"customer_id",
+		"credit_limit",
+		"max_active_loans",
+		"updated_by",
+		"updated_at",
+	}
+}
+
+func (c *CreditLimit) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *CreditLimit) Values() []any {
+	return []any{
+		&c.CustomerID,
+		&c.CreditLimit,
+		&c.MaxActiveLoans,
+		&c.UpdatedBy,
+		&c.UpdatedAt,
+	}
+}
+
+func (c CreditLimit) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c CreditLimit) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"customer_id":      c.CustomerID.Int64,
+		"credit_limit":     c.CreditLimit,
+		"max_active_loans": c.MaxActiveLoans.Int64,
+		"updated_by":       c.UpdatedBy.String,
+		"updated_at":       c.UpdatedAt.Time,
+	}
+}
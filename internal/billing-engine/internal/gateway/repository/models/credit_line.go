@@ -0,0 +1,71 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreditLine struct {
+	ID          sql.NullInt64   `json:"id"`
+	CustomerID  sql.NullInt64   `json:"customer_id"`
+	CreditLimit decimal.Decimal `json:"credit_limit"`
+	ProductCode sql.NullString  `json:"product_code"`
+	OpenedBy    sql.NullString  `json:"opened_by"`
+	OpenedAt    sql.NullTime    `json:"opened_at"`
+}
+
+func (c *CreditLine) Columns() []any {
+	return []any{
+		"id",
+		"customer_id",
+		"credit_limit",
+		"product_code",
+		"opened_by",
+		"opened_at",
+	}
+}
+
+func (c *CreditLine) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *CreditLine) Values() []any {
+	return []any{
+		&c.ID,
+		&c.CustomerID,
+		&c.CreditLimit,
+		&c.ProductCode,
+		&c.OpenedBy,
+		&c.OpenedAt,
+	}
+}
+
+func (c CreditLine) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c CreditLine) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":           c.ID.Int64,
+		"customer_id":  c.CustomerID.Int64,
+		"credit_limit": c.CreditLimit,
+		"product_code": c.ProductCode.String,
+		"opened_by":    c.OpenedBy.String,
+		"opened_at":    c.OpenedAt.Time,
+	}
+}
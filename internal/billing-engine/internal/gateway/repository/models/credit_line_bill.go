@@ -0,0 +1,79 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreditLineBill struct {
+	ID           sql.NullInt64   `json:"id"`
+	CreditLineID sql.NullInt64   `json:"credit_line_id"`
+	Cycle        sql.NullString  `json:"cycle"`
+	DueDate      sql.NullTime    `json:"due_date"`
+	Amount       decimal.Decimal `json:"amount"`
+	Status       sql.NullString  `json:"status"`
+	CreatedAt    sql.NullTime    `json:"created_at"`
+	PaidAt       sql.NullTime    `json:"paid_at"`
+}
+
+func (c *CreditLineBill) Columns() []any {
+	return []any{
+		"id",
+		"credit_line_id",
+		"cycle",
+		"due_date",
+		"amount",
+		"status",
+		"created_at",
+		"paid_at",
+	}
+}
+
+func (c *CreditLineBill) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *CreditLineBill) Values() []any {
+	return []any{
+		&c.ID,
+		&c.CreditLineID,
+		&c.Cycle,
+		&c.DueDate,
+		&c.Amount,
+		&c.Status,
+		&c.CreatedAt,
+		&c.PaidAt,
+	}
+}
+
+func (c CreditLineBill) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c CreditLineBill) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":             c.ID.Int64,
+		"credit_line_id": c.CreditLineID.Int64,
+		"cycle":          c.Cycle.String,
+		"due_date":       c.DueDate.Time,
+		"amount":         c.Amount,
+		"status":         c.Status.String,
+		"created_at":     c.CreatedAt.Time,
+		"paid_at":        c.PaidAt.Time,
+	}
+}
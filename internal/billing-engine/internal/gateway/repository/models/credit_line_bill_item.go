@@ -0,0 +1,71 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreditLineBillItem struct {
+	ID            sql.NullInt64   `json:"id"`
+	BillID        sql.NullInt64   `json:"bill_id"`
+	InstallmentID sql.NullInt64   `json:"installment_id"`
+	LoanID        sql.NullInt64   `json:"loan_id"`
+	WeekNumber    sql.NullInt64   `json:"week_number"`
+	Amount        decimal.Decimal `json:"amount"`
+}
+
+func (c *CreditLineBillItem) Columns() []any {
+	return []any{
+		"id",
+		"bill_id",
+		"installment_id",
+		"loan_id",
+		"week_number",
+		"amount",
+	}
+}
+
+func (c *CreditLineBillItem) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *CreditLineBillItem) Values() []any {
+	return []any{
+		&c.ID,
+		&c.BillID,
+		&c.InstallmentID,
+		&c.LoanID,
+		&c.WeekNumber,
+		&c.Amount,
+	}
+}
+
+func (c CreditLineBillItem) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c CreditLineBillItem) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":             c.ID.Int64,
+		"bill_id":        c.BillID.Int64,
+		"installment_id": c.InstallmentID.Int64,
+		"loan_id":        c.LoanID.Int64,
+		"week_number":    c.WeekNumber.Int64,
+		"amount":         c.Amount,
+	}
+}
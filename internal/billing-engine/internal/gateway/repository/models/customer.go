@@ -6,9 +6,16 @@ import (
 )
 
 type Customer struct {
-	ID    sql.NullInt64  `json:"id"`
-	Name  sql.NullString `json:"name"`
-	Email sql.NullString `json:"email"`
+	ID          sql.NullInt64  `json:"id"`
+	Name        sql.NullString `json:"name"`
+	Email       sql.NullString `json:"email"`
+	Phone       sql.NullString `json:"phone"`
+	Language    sql.NullString `json:"language"`
+	NIK         sql.NullString `json:"nik"`
+	DateOfBirth sql.NullTime   `json:"date_of_birth"`
+	Address     sql.NullString `json:"address"`
+	KYCStatus   sql.NullString `json:"kyc_status"`
+	DeletedAt   sql.NullTime   `json:"deleted_at"`
 }
 
 func (c *Customer) Columns() []any {
@@ -16,6 +23,13 @@ func (c *Customer) Columns() []any {
 		"id",
 		"name",
 		"email",
+		"phone",
+		"language",
+		"nik",
+		"date_of_birth",
+		"address",
+		"kyc_status",
+		"deleted_at",
 	}
 }
 
@@ -36,6 +50,13 @@ func (c *Customer) Values() []any {
 		&c.ID,
 		&c.Name,
 		&c.Email,
+		&c.Phone,
+		&c.Language,
+		&c.NIK,
+		&c.DateOfBirth,
+		&c.Address,
+		&c.KYCStatus,
+		&c.DeletedAt,
 	}
 }
 
@@ -50,8 +71,15 @@ func (c Customer) DriverValues() []driver.Value {
 
 func (c Customer) MappedValues() map[string]driver.Value {
 	return map[string]driver.Value{
-		"id":    c.ID,
-		"name":  c.Name.String,
-		"email": c.Email.String,
+		"id":            c.ID,
+		"name":          c.Name.String,
+		"email":         c.Email.String,
+		"phone":         c.Phone.String,
+		"language":      c.Language.String,
+		"nik":           c.NIK.String,
+		"date_of_birth": c.DateOfBirth.Time,
+		"address":       c.Address.String,
+		"kyc_status":    c.KYCStatus.String,
+		"deleted_at":    c.DeletedAt.Time,
 	}
 }
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type CustomerDuplicate struct {
+	ID                sql.NullInt64  `json:"id"`
+	CustomerID        sql.NullInt64  `json:"customer_id"`
+	MatchedCustomerID sql.NullInt64  `json:"matched_customer_id"`
+	MatchType         sql.NullString `json:"match_type"`
+	Status            sql.NullString `json:"status"`
+	CreatedAt         sql.NullTime   `json:"created_at"`
+	ResolvedBy        sql.NullString `json:"resolved_by"`
+	ResolvedAt        sql.NullTime   `json:"resolved_at"`
+}
+
+func (c *CustomerDuplicate) Columns() []any {
+	return []any{
+		"id",
+		"customer_id",
+		"matched_customer_id",
+		"match_type",
+		"status",
+		"created_at",
+		"resolved_by",
+		"resolved_at",
+	}
+}
+
+func (c *CustomerDuplicate) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *CustomerDuplicate) Values() []any {
+	return []any{
+		&c.ID,
+		&c.CustomerID,
+		&c.MatchedCustomerID,
+		&c.MatchType,
+		&c.Status,
+		&c.CreatedAt,
+		&c.ResolvedBy,
+		&c.ResolvedAt,
+	}
+}
+
+func (c CustomerDuplicate) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c CustomerDuplicate) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":                  c.ID.Int64,
+		"customer_id":         c.CustomerID.Int64,
+		"matched_customer_id": c.MatchedCustomerID.Int64,
+		"match_type":          c.MatchType.String,
+		"status":              c.Status.String,
+		"created_at":          c.CreatedAt.Time,
+		"resolved_by":         c.ResolvedBy.String,
+		"resolved_at":         c.ResolvedAt.Time,
+	}
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type CustomerMerge struct {
+	ID               sql.NullInt64  `json:"id"`
+	SourceCustomerID sql.NullInt64  `json:"source_customer_id"`
+	TargetCustomerID sql.NullInt64  `json:"target_customer_id"`
+	Reason           sql.NullString `json:"reason"`
+	MergedBy         sql.NullString `json:"merged_by"`
+	MergedAt         sql.NullTime   `json:"merged_at"`
+}
+
+func (c *CustomerMerge) Columns() []any {
+	return []any{
+		"id",
+		"source_customer_id",
+		"target_customer_id",
+		"reason",
+		"merged_by",
+		"merged_at",
+	}
+}
+
+func (c *CustomerMerge) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *CustomerMerge) Values() []any {
+	return []any{
+		&c.ID,
+		&c.SourceCustomerID,
+		&c.TargetCustomerID,
+		&c.Reason,
+		&c.MergedBy,
+		&c.MergedAt,
+	}
+}
+
+func (c CustomerMerge) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c CustomerMerge) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":                 c.ID.Int64,
+		"source_customer_id": c.SourceCustomerID.Int64,
+		"target_customer_id": c.TargetCustomerID.Int64,
+		"reason":             c.Reason.String,
+		"merged_by":          c.MergedBy.String,
+		"merged_at":          c.MergedAt.Time,
+	}
+}
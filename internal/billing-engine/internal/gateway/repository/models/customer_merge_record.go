@@ -0,0 +1,61 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type CustomerMergeRecord struct {
+	ID        sql.NullInt64  `json:"id"`
+	MergeID   sql.NullInt64  `json:"merge_id"`
+	TableName sql.NullString `json:"table_name"`
+	RecordID  sql.NullInt64  `json:"record_id"`
+}
+
+func (c *CustomerMergeRecord) Columns() []any {
+	return []any{
+		"id",
+		"merge_id",
+		"table_name",
+		"record_id",
+	}
+}
+
+func (c *CustomerMergeRecord) StringColumns() []string {
+	vals := make([]string, len(c.Columns()))
+	for i, col := range c.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (c *CustomerMergeRecord) Values() []any {
+	return []any{
+		&c.ID,
+		&c.MergeID,
+		&c.TableName,
+		&c.RecordID,
+	}
+}
+
+func (c CustomerMergeRecord) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(c.Values()))
+	for i, v := range c.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (c CustomerMergeRecord) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":         c.ID.Int64,
+		"merge_id":   c.MergeID.Int64,
+		"table_name": c.TableName.String,
+		"record_id":  c.RecordID.Int64,
+	}
+}
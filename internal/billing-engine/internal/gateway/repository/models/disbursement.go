@@ -0,0 +1,111 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type Disbursement struct {
+	ID                sql.NullInt64   `json:"id"`
+	LoanID            sql.NullInt64   `json:"loan_id"`
+	CustomerID        sql.NullInt64   `json:"customer_id"`
+	BankCode          sql.NullString  `json:"bank_code"`
+	AccountNumber     sql.NullString  `json:"account_number"`
+	AccountName       sql.NullString  `json:"account_name"`
+	Amount            decimal.Decimal `json:"amount"`
+	FeeAmount         decimal.Decimal `json:"fee_amount"`
+	Status            sql.NullString  `json:"status"`
+	ProviderReference sql.NullString  `json:"provider_reference"`
+	FailureReason     sql.NullString  `json:"failure_reason"`
+	Attempts          sql.NullInt64   `json:"attempts"`
+	RequestedBy       sql.NullString  `json:"requested_by"`
+	CreatedAt         sql.NullTime    `json:"created_at"`
+	UpdatedAt         sql.NullTime    `json:"updated_at"`
+	CompletedAt       sql.NullTime    `json:"completed_at"`
+}
+
+func (d *Disbursement) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"customer_id",
+		"bank_code",
+		"account_number",
+		"account_name",
+		"amount",
+		"fee_amount",
+		"status",
+		"provider_reference",
+		"failure_reason",
+		"attempts",
+		"requested_by",
+		"created_at",
+		"updated_at",
+		"completed_at",
+	}
+}
+
+func (d *Disbursement) StringColumns() []string {
+	vals := make([]string, len(d.Columns()))
+	for i, col := range d.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (d *Disbursement) Values() []any {
+	return []any{
+		&d.ID,
+		&d.LoanID,
+		&d.CustomerID,
+		&d.BankCode,
+		&d.AccountNumber,
+		&d.AccountName,
+		&d.Amount,
+		&d.FeeAmount,
+		&d.Status,
+		&d.ProviderReference,
+		&d.FailureReason,
+		&d.Attempts,
+		&d.RequestedBy,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+		&d.CompletedAt,
+	}
+}
+
+func (d Disbursement) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(d.Values()))
+	for i, v := range d.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (d Disbursement) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":                 d.ID.Int64,
+		"loan_id":            d.LoanID.Int64,
+		"customer_id":        d.CustomerID.Int64,
+		"bank_code":          d.BankCode.String,
+		"account_number":     d.AccountNumber.String,
+		"account_name":       d.AccountName.String,
+		"amount":             d.Amount,
+		"fee_amount":         d.FeeAmount,
+		"status":             d.Status.String,
+		"provider_reference": d.ProviderReference.String,
+		"failure_reason":     d.FailureReason.String,
+		"attempts":           d.Attempts.Int64,
+		"requested_by":       d.RequestedBy.String,
+		"created_at":         d.CreatedAt.Time,
+		"updated_at":         d.UpdatedAt.Time,
+		"completed_at":       d.CompletedAt.Time,
+	}
+}
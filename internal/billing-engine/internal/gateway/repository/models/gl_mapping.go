@@ -0,0 +1,61 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type GLMapping struct {
+	Event       sql.NullString `json:"event"`
+	AccountCode sql.NullString `json:"account_code"`
+	GLCode      sql.NullString `json:"gl_code"`
+	UpdatedAt   sql.NullTime   `json:"updated_at"`
+}
+
+func (g *GLMapping) Columns() []any {
+	return []any{
+		"event",
+		"account_code",
+		"gl_code",
+		"updated_at",
+	}
+}
+
+func (g *GLMapping) StringColumns() []string {
+	vals := make([]string, len(g.Columns()))
+	for i, col := range g.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (g *GLMapping) Values() []any {
+	return []any{
+		&g.Event,
+		&g.AccountCode,
+		&g.GLCode,
+		&g.UpdatedAt,
+	}
+}
+
+func (g GLMapping) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(g.Values()))
+	for i, v := range g.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (g GLMapping) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"event":        g.Event.String,
+		"account_code": g.AccountCode.String,
+		"gl_code":      g.GLCode.String,
+		"updated_at":   g.UpdatedAt.Time,
+	}
+}
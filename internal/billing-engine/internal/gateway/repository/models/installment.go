@@ -11,7 +11,10 @@ type Installment struct {
 	WeekNumber sql.NullInt64  `json:"week_number"`
 	DueDate    sql.NullString `json:"due_date"`
 	AmountDue  sql.NullString `json:"amount_due"`
+	FeeAmount  sql.NullString `json:"fee_amount"`
 	Status     sql.NullString `json:"status"`
+
+	HolidayInterest sql.NullString `json:"holiday_interest"`
 }
 
 func (i *Installment) Columns() []any {
@@ -21,7 +24,9 @@ func (i *Installment) Columns() []any {
 		"week_number",
 		"due_date",
 		"amount_due",
+		"fee_amount",
 		"status",
+		"holiday_interest",
 	}
 }
 
@@ -44,7 +49,9 @@ func (i *Installment) Values() []any {
 		&i.WeekNumber,
 		&i.DueDate,
 		&i.AmountDue,
+		&i.FeeAmount,
 		&i.Status,
+		&i.HolidayInterest,
 	}
 }
 
@@ -64,6 +71,9 @@ func (i Installment) MappedValues() map[string]driver.Value {
 		"week_number": i.WeekNumber.Int64,
 		"due_date":    i.DueDate.String,
 		"amount_due":  i.AmountDue.String,
+		"fee_amount":  i.FeeAmount.String,
 		"status":      i.Status.String,
+
+		"holiday_interest": i.HolidayInterest.String,
 	}
 }
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type InterestAccrual struct {
+	ID             sql.NullInt64   `json:"id"`
+	LoanID         sql.NullInt64   `json:"loan_id"`
+	AccrualDate    sql.NullTime    `json:"accrual_date"`
+	Type           sql.NullString  `json:"type"`
+	Amount         decimal.Decimal `json:"amount"`
+	JournalEntryID sql.NullInt64   `json:"journal_entry_id"`
+	CreatedAt      sql.NullTime    `json:"created_at"`
+}
+
+func (i *InterestAccrual) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"accrual_date",
+		"type",
+		"amount",
+		"journal_entry_id",
+		"created_at",
+	}
+}
+
+func (i *InterestAccrual) StringColumns() []string {
+	vals := make([]string, len(i.Columns()))
+	for j, col := range i.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[j] = c
+		}
+	}
+
+	return vals
+}
+
+func (i *InterestAccrual) Values() []any {
+	return []any{
+		&i.ID,
+		&i.LoanID,
+		&i.AccrualDate,
+		&i.Type,
+		&i.Amount,
+		&i.JournalEntryID,
+		&i.CreatedAt,
+	}
+}
+
+func (i InterestAccrual) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(i.Values()))
+	for j, v := range i.Values() {
+		vals[j] = v
+	}
+
+	return vals
+}
+
+func (i InterestAccrual) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":               i.ID.Int64,
+		"loan_id":          i.LoanID.Int64,
+		"accrual_date":     i.AccrualDate.Time,
+		"type":             i.Type.String,
+		"amount":           i.Amount,
+		"journal_entry_id": i.JournalEntryID.Int64,
+		"created_at":       i.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type JournalEntry struct {
+	ID              sql.NullInt64  `json:"id"`
+	LoanID          sql.NullInt64  `json:"loan_id"`
+	Event           sql.NullString `json:"event"`
+	Reference       sql.NullString `json:"reference"`
+	Description     sql.NullString `json:"description"`
+	EffectiveDate   sql.NullTime   `json:"effective_date"`
+	ReversesEntryID sql.NullInt64  `json:"reverses_entry_id"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+}
+
+func (j *JournalEntry) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"event",
+		"reference",
+		"description",
+		"effective_date",
+		"reverses_entry_id",
+		"created_at",
+	}
+}
+
+func (j *JournalEntry) StringColumns() []string {
+	vals := make([]string, len(j.Columns()))
+	for i, col := range j.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (j *JournalEntry) Values() []any {
+	return []any{
+		&j.ID,
+		&j.LoanID,
+		&j.Event,
+		&j.Reference,
+		&j.Description,
+		&j.EffectiveDate,
+		&j.ReversesEntryID,
+		&j.CreatedAt,
+	}
+}
+
+func (j JournalEntry) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(j.Values()))
+	for i, v := range j.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (j JournalEntry) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":                j.ID.Int64,
+		"loan_id":           j.LoanID.Int64,
+		"event":             j.Event.String,
+		"reference":         j.Reference.String,
+		"description":       j.Description.String,
+		"effective_date":    j.EffectiveDate.Time,
+		"reverses_entry_id": j.ReversesEntryID.Int64,
+		"created_at":        j.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type LedgerAccount struct {
+	Code sql.NullString `json:"code"`
+	Name sql.NullString `json:"name"`
+	Type sql.NullString `json:"type"`
+}
+
+func (l *LedgerAccount) Columns() []any {
+	return []any{
+		"code",
+		"name",
+		"type",
+	}
+}
+
+func (l *LedgerAccount) StringColumns() []string {
+	vals := make([]string, len(l.Columns()))
+	for i, col := range l.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (l *LedgerAccount) Values() []any {
+	return []any{
+		&l.Code,
+		&l.Name,
+		&l.Type,
+	}
+}
+
+func (l LedgerAccount) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(l.Values()))
+	for i, v := range l.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (l LedgerAccount) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"code": l.Code.String,
+		"name": l.Name.String,
+		"type": l.Type.String,
+	}
+}
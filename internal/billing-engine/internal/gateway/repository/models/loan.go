@@ -15,6 +15,13 @@ type Loan struct {
 	TermWeeks       sql.NullInt64   `json:"term_weeks"`
 	StartDate       sql.NullTime    `json:"start_date"`
 	Status          sql.NullString  `json:"status"`
+	ProductCode     sql.NullString  `json:"product_code"`
+	RequestedBy     sql.NullString  `json:"requested_by"`
+
+	RestructuredFromLoanID sql.NullInt64  `json:"restructured_from_loan_id"`
+	CreditLineID           sql.NullInt64  `json:"credit_line_id"`
+	MerchantID             sql.NullInt64  `json:"merchant_id"`
+	OrderRef               sql.NullString `json:"order_ref"`
 }
 
 func (l *Loan) Columns() []any {
@@ -26,6 +33,12 @@ func (l *Loan) Columns() []any {
 		"term_weeks",
 		"start_date",
 		"status",
+		"product_code",
+		"requested_by",
+		"restructured_from_loan_id",
+		"credit_line_id",
+		"merchant_id",
+		"order_ref",
 	}
 }
 
@@ -50,6 +63,12 @@ func (l *Loan) Values() []any {
 		&l.TermWeeks,
 		&l.StartDate,
 		&l.Status,
+		&l.ProductCode,
+		&l.RequestedBy,
+		&l.RestructuredFromLoanID,
+		&l.CreditLineID,
+		&l.MerchantID,
+		&l.OrderRef,
 	}
 }
 
@@ -64,12 +83,19 @@ func (l Loan) DriverValues() []driver.Value {
 
 func (l Loan) MappedValues() map[string]driver.Value {
 	return map[string]driver.Value{
-		"id":          l.ID.Int64,
-		"customer_id": l.CustomerID.Int64,
-		"principal":   l.PrincipalAmount,
-		"annual_rate": l.InterestRate,
-		"term_weeks":  l.TermWeeks.Int64,
-		"start_date":  l.StartDate.Time,
-		"status":      l.Status.String,
+		"id":           l.ID.Int64,
+		"customer_id":  l.CustomerID.Int64,
+		"principal":    l.PrincipalAmount,
+		"annual_rate":  l.InterestRate,
+		"term_weeks":   l.TermWeeks.Int64,
+		"start_date":   l.StartDate.Time,
+		"status":       l.Status.String,
+		"product_code": l.ProductCode.String,
+		"requested_by": l.RequestedBy.String,
+
+		"restructured_from_loan_id": l.RestructuredFromLoanID.Int64,
+		"credit_line_id":            l.CreditLineID.Int64,
+		"merchant_id":               l.MerchantID.Int64,
+		"order_ref":                 l.OrderRef.String,
 	}
 }
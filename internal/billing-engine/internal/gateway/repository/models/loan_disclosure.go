@@ -0,0 +1,91 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type LoanDisclosure struct {
+	LoanID              sql.NullInt64   `json:"loan_id"`
+	ProductCode         sql.NullString  `json:"product_code"`
+	PrincipalAmount     decimal.Decimal `json:"principal"`
+	InterestRate        decimal.Decimal `json:"annual_rate"`
+	TermWeeks           sql.NullInt64   `json:"term_weeks"`
+	AmountReceived      decimal.Decimal `json:"amount_received"`
+	TotalInterest       decimal.Decimal `json:"total_interest"`
+	TotalFees           decimal.Decimal `json:"total_fees"`
+	TotalRepayment      decimal.Decimal `json:"total_repayment"`
+	EffectiveAnnualRate decimal.Decimal `json:"effective_annual_rate"`
+	DisclosedAt         sql.NullTime    `json:"disclosed_at"`
+}
+
+func (l *LoanDisclosure) Columns() []any {
+	return []any{
+		"loan_id",
+		"product_code",
+		"principal",
+		"annual_rate",
+		"term_weeks",
+		"amount_received",
+		"total_interest",
+		"total_fees",
+		"total_repayment",
+		"effective_annual_rate",
+		"disclosed_at",
+	}
+}
+
+func (l *LoanDisclosure) StringColumns() []string {
+	vals := make([]string, len(l.Columns()))
+	for i, col := range l.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (l *LoanDisclosure) Values() []any {
+	return []any{
+		&l.LoanID,
+		&l.ProductCode,
+		&l.PrincipalAmount,
+		&l.InterestRate,
+		&l.TermWeeks,
+		&l.AmountReceived,
+		&l.TotalInterest,
+		&l.TotalFees,
+		&l.TotalRepayment,
+		&l.EffectiveAnnualRate,
+		&l.DisclosedAt,
+	}
+}
+
+func (l LoanDisclosure) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(l.Values()))
+	for i, v := range l.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (l LoanDisclosure) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"loan_id":               l.LoanID.Int64,
+		"product_code":          l.ProductCode.String,
+		"principal":             l.PrincipalAmount,
+		"annual_rate":           l.InterestRate,
+		"term_weeks":            l.TermWeeks.Int64,
+		"amount_received":       l.AmountReceived,
+		"total_interest":        l.TotalInterest,
+		"total_fees":            l.TotalFees,
+		"total_repayment":       l.TotalRepayment,
+		"effective_annual_rate": l.EffectiveAnnualRate,
+		"disclosed_at":          l.DisclosedAt.Time,
+	}
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type LoanFee struct {
+	ID          sql.NullInt64   `json:"id"`
+	LoanID      sql.NullInt64   `json:"loan_id"`
+	FeeType     sql.NullString  `json:"fee_type"`
+	Calculation sql.NullString  `json:"calculation"`
+	Value       decimal.Decimal `json:"value"`
+	Collection  sql.NullString  `json:"collection"`
+	Amount      decimal.Decimal `json:"amount"`
+	CreatedAt   sql.NullTime    `json:"created_at"`
+}
+
+func (l *LoanFee) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"fee_type",
+		"calculation",
+		"value",
+		"collection",
+		"amount",
+		"created_at",
+	}
+}
+
+func (l *LoanFee) StringColumns() []string {
+	vals := make([]string, len(l.Columns()))
+	for i, col := range l.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (l *LoanFee) Values() []any {
+	return []any{
+		&l.ID,
+		&l.LoanID,
+		&l.FeeType,
+		&l.Calculation,
+		&l.Value,
+		&l.Collection,
+		&l.Amount,
+		&l.CreatedAt,
+	}
+}
+
+func (l LoanFee) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(l.Values()))
+	for i, v := range l.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (l LoanFee) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":          l.ID.Int64,
+		"loan_id":     l.LoanID.Int64,
+		"fee_type":    l.FeeType.String,
+		"calculation": l.Calculation.String,
+		"value":       l.Value,
+		"collection":  l.Collection.String,
+		"amount":      l.Amount,
+		"created_at":  l.CreatedAt.Time,
+	}
+}
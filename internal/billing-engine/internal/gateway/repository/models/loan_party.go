@@ -0,0 +1,69 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type LoanParty struct {
+	ID         sql.NullInt64  `json:"id"`
+	LoanID     sql.NullInt64  `json:"loan_id"`
+	CustomerID sql.NullInt64  `json:"customer_id"`
+	Role       sql.NullString `json:"role"`
+	AddedBy    sql.NullString `json:"added_by"`
+	AddedAt    sql.NullTime   `json:"added_at"`
+}
+
+func (l *LoanParty) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"customer_id",
+		"role",
+		"added_by",
+		"added_at",
+	}
+}
+
+func (l *LoanParty) StringColumns() []string {
+	vals := make([]string, len(l.Columns()))
+	for i, col := range l.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (l *LoanParty) Values() []any {
+	return []any{
+		&l.ID,
+		&l.LoanID,
+		&l.CustomerID,
+		&l.Role,
+		&l.AddedBy,
+		&l.AddedAt,
+	}
+}
+
+func (l LoanParty) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(l.Values()))
+	for i, v := range l.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (l LoanParty) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":          l.ID.Int64,
+		"loan_id":     l.LoanID.Int64,
+		"customer_id": l.CustomerID.Int64,
+		"role":        l.Role.String,
+		"added_by":    l.AddedBy.String,
+		"added_at":    l.AddedAt.Time,
+	}
+}
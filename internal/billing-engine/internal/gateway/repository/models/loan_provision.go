@@ -0,0 +1,95 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type LoanProvision struct {
+	ID                sql.NullInt64   `json:"id"`
+	Period            sql.NullString  `json:"period"`
+	LoanID            sql.NullInt64   `json:"loan_id"`
+	CustomerID        sql.NullInt64   `json:"customer_id"`
+	ProductCode       sql.NullString  `json:"product_code"`
+	Stage             sql.NullInt64   `json:"stage"`
+	DaysPastDue       sql.NullInt64   `json:"days_past_due"`
+	ExposureAtDefault decimal.Decimal `json:"exposure_at_default"`
+	PD                decimal.Decimal `json:"pd"`
+	LGD               decimal.Decimal `json:"lgd"`
+	ECL               decimal.Decimal `json:"ecl"`
+	CreatedAt         sql.NullTime    `json:"created_at"`
+}
+
+func (p *LoanProvision) Columns() []any {
+	return []any{
+		"id",
+		"period",
+		"loan_id",
+		"customer_id",
+		"product_code",
+		"stage",
+		"days_past_due",
+		"exposure_at_default",
+		"pd",
+		"lgd",
+		"ecl",
+		"created_at",
+	}
+}
+
+func (p *LoanProvision) StringColumns() []string {
+	vals := make([]string, len(p.Columns()))
+	for i, col := range p.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (p *LoanProvision) Values() []any {
+	return []any{
+		&p.ID,
+		&p.Period,
+		&p.LoanID,
+		&p.CustomerID,
+		&p.ProductCode,
+		&p.Stage,
+		&p.DaysPastDue,
+		&p.ExposureAtDefault,
+		&p.PD,
+		&p.LGD,
+		&p.ECL,
+		&p.CreatedAt,
+	}
+}
+
+func (p LoanProvision) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(p.Values()))
+	for i, v := range p.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (p LoanProvision) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":                  p.ID.Int64,
+		"period":              p.Period.String,
+		"loan_id":             p.LoanID.Int64,
+		"customer_id":         p.CustomerID.Int64,
+		"product_code":        p.ProductCode.String,
+		"stage":               p.Stage.Int64,
+		"days_past_due":       p.DaysPastDue.Int64,
+		"exposure_at_default": p.ExposureAtDefault,
+		"pd":                  p.PD,
+		"lgd":                 p.LGD,
+		"ecl":                 p.ECL,
+		"created_at":          p.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type LoanRestructure struct {
+	ID                   sql.NullInt64   `json:"id"`
+	LoanID               sql.NullInt64   `json:"loan_id"`
+	NewLoanID            sql.NullInt64   `json:"new_loan_id"`
+	Type                 sql.NullString  `json:"type"`
+	OutstandingAmount    decimal.Decimal `json:"outstanding_amount"`
+	ArrearsAmount        decimal.Decimal `json:"arrears_amount"`
+	ClosedInstallments   sql.NullInt64   `json:"closed_installments"`
+	NewTermWeeks         sql.NullInt64   `json:"new_term_weeks"`
+	NewInstallmentAmount decimal.Decimal `json:"new_installment_amount"`
+	Reason               sql.NullString  `json:"reason"`
+	RestructuredAt       sql.NullTime    `json:"restructured_at"`
+}
+
+func (l *LoanRestructure) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"new_loan_id",
+		"type",
+		"outstanding_amount",
+		"arrears_amount",
+		"closed_installments",
+		"new_term_weeks",
+		"new_installment_amount",
+		"reason",
+		"restructured_at",
+	}
+}
+
+func (l *LoanRestructure) StringColumns() []string {
+	vals := make([]string, len(l.Columns()))
+	for i, col := range l.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (l *LoanRestructure) Values() []any {
+	return []any{
+		&l.ID,
+		&l.LoanID,
+		&l.NewLoanID,
+		&l.Type,
+		&l.OutstandingAmount,
+		&l.ArrearsAmount,
+		&l.ClosedInstallments,
+		&l.NewTermWeeks,
+		&l.NewInstallmentAmount,
+		&l.Reason,
+		&l.RestructuredAt,
+	}
+}
+
+func (l LoanRestructure) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(l.Values()))
+	for i, v := range l.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (l LoanRestructure) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":                     l.ID.Int64,
+		"loan_id":                l.LoanID.Int64,
+		"new_loan_id":            l.NewLoanID.Int64,
+		"type":                   l.Type.String,
+		"outstanding_amount":     l.OutstandingAmount,
+		"arrears_amount":         l.ArrearsAmount,
+		"closed_installments":    l.ClosedInstallments.Int64,
+		"new_term_weeks":         l.NewTermWeeks.Int64,
+		"new_installment_amount": l.NewInstallmentAmount,
+		"reason":                 l.Reason.String,
+		"restructured_at":        l.RestructuredAt.Time,
+	}
+}
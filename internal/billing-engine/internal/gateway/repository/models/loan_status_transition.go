@@ -0,0 +1,73 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type LoanStatusTransition struct {
+	ID             sql.NullInt64  `json:"id"`
+	LoanID         sql.NullInt64  `json:"loan_id"`
+	FromStatus     sql.NullString `json:"from_status"`
+	ToStatus       sql.NullString `json:"to_status"`
+	Actor          sql.NullString `json:"actor"`
+	Reason         sql.NullString `json:"reason"`
+	TransitionedAt sql.NullTime   `json:"transitioned_at"`
+}
+
+func (l *LoanStatusTransition) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"from_status",
+		"to_status",
+		"actor",
+		"reason",
+		"transitioned_at",
+	}
+}
+
+func (l *LoanStatusTransition) StringColumns() []string {
+	vals := make([]string, len(l.Columns()))
+	for i, col := range l.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (l *LoanStatusTransition) Values() []any {
+	return []any{
+		&l.ID,
+		&l.LoanID,
+		&l.FromStatus,
+		&l.ToStatus,
+		&l.Actor,
+		&l.Reason,
+		&l.TransitionedAt,
+	}
+}
+
+func (l LoanStatusTransition) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(l.Values()))
+	for i, v := range l.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (l LoanStatusTransition) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":              l.ID.Int64,
+		"loan_id":         l.LoanID.Int64,
+		"from_status":     l.FromStatus.String,
+		"to_status":       l.ToStatus.String,
+		"actor":           l.Actor.String,
+		"reason":          l.Reason.String,
+		"transitioned_at": l.TransitionedAt.Time,
+	}
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type Merchant struct {
+	ID            sql.NullInt64   `json:"id"`
+	Code          sql.NullString  `json:"code"`
+	Name          sql.NullString  `json:"name"`
+	MDRRate       decimal.Decimal `json:"mdr_rate"`
+	BankCode      sql.NullString  `json:"bank_code"`
+	AccountNumber sql.NullString  `json:"account_number"`
+	AccountName   sql.NullString  `json:"account_name"`
+	CreatedBy     sql.NullString  `json:"created_by"`
+	CreatedAt     sql.NullTime    `json:"created_at"`
+}
+
+func (m *Merchant) Columns() []any {
+	return []any{
+		"id",
+		"code",
+		"name",
+		"mdr_rate",
+		"bank_code",
+		"account_number",
+		"account_name",
+		"created_by",
+		"created_at",
+	}
+}
+
+func (m *Merchant) StringColumns() []string {
+	vals := make([]string, len(m.Columns()))
+	for i, col := range m.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (m *Merchant) Values() []any {
+	return []any{
+		&m.ID,
+		&m.Code,
+		&m.Name,
+		&m.MDRRate,
+		&m.BankCode,
+		&m.AccountNumber,
+		&m.AccountName,
+		&m.CreatedBy,
+		&m.CreatedAt,
+	}
+}
+
+func (m Merchant) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(m.Values()))
+	for i, v := range m.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (m Merchant) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":             m.ID.Int64,
+		"code":           m.Code.String,
+		"name":           m.Name.String,
+		"mdr_rate":       m.MDRRate,
+		"bank_code":      m.BankCode.String,
+		"account_number": m.AccountNumber.String,
+		"account_name":   m.AccountName.String,
+		"created_by":     m.CreatedBy.String,
+		"created_at":     m.CreatedAt.Time,
+	}
+}
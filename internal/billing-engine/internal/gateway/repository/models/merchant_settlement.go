@@ -0,0 +1,103 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type MerchantSettlement struct {
+	ID             sql.NullInt64   `json:"id"`
+	MerchantID     sql.NullInt64   `json:"merchant_id"`
+	SettlementDate sql.NullTime    `json:"settlement_date"`
+	GrossAmount    decimal.Decimal `json:"gross_amount"`
+	MDRAmount      decimal.Decimal `json:"mdr_amount"`
+	NetAmount      decimal.Decimal `json:"net_amount"`
+	ItemCount      sql.NullInt64   `json:"item_count"`
+	Status         sql.NullString  `json:"status"`
+	Reference      sql.NullString  `json:"reference"`
+	FailureReason  sql.NullString  `json:"failure_reason"`
+	UpdatedBy      sql.NullString  `json:"updated_by"`
+	CreatedAt      sql.NullTime    `json:"created_at"`
+	UpdatedAt      sql.NullTime    `json:"updated_at"`
+	SettledAt      sql.NullTime    `json:"settled_at"`
+}
+
+func (m *MerchantSettlement) Columns() []any {
+	return []any{
+		"id",
+		"merchant_id",
+		"settlement_date",
+		"gross_amount",
+		"mdr_amount",
+		"net_amount",
+		"item_count",
+		"status",
+		"reference",
+		"failure_reason",
+		"updated_by",
+		"created_at",
+		"updated_at",
+		"settled_at",
+	}
+}
+
+func (m *MerchantSettlement) StringColumns() []string {
+	vals := make([]string, len(m.Columns()))
+	for i, col := range m.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (m *MerchantSettlement) Values() []any {
+	return []any{
+		&m.ID,
+		&m.MerchantID,
+		&m.SettlementDate,
+		&m.GrossAmount,
+		&m.MDRAmount,
+		&m.NetAmount,
+		&m.ItemCount,
+		&m.Status,
+		&m.Reference,
+		&m.FailureReason,
+		&m.UpdatedBy,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+		&m.SettledAt,
+	}
+}
+
+func (m MerchantSettlement) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(m.Values()))
+	for i, v := range m.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (m MerchantSettlement) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":              m.ID.Int64,
+		"merchant_id":     m.MerchantID.Int64,
+		"settlement_date": m.SettlementDate.Time,
+		"gross_amount":    m.GrossAmount,
+		"mdr_amount":      m.MDRAmount,
+		"net_amount":      m.NetAmount,
+		"item_count":      m.ItemCount.Int64,
+		"status":          m.Status.String,
+		"reference":       m.Reference.String,
+		"failure_reason":  m.FailureReason.String,
+		"updated_by":      m.UpdatedBy.String,
+		"created_at":      m.CreatedAt.Time,
+		"updated_at":      m.UpdatedAt.Time,
+		"settled_at":      m.SettledAt.Time,
+	}
+}
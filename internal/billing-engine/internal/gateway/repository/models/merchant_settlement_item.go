@@ -0,0 +1,87 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type MerchantSettlementItem struct {
+	ID           sql.NullInt64   `json:"id"`
+	SettlementID sql.NullInt64   `json:"settlement_id"`
+	MerchantID   sql.NullInt64   `json:"merchant_id"`
+	LoanID       sql.NullInt64   `json:"loan_id"`
+	OrderRef     sql.NullString  `json:"order_ref"`
+	SaleDate     sql.NullTime    `json:"sale_date"`
+	GrossAmount  decimal.Decimal `json:"gross_amount"`
+	MDRAmount    decimal.Decimal `json:"mdr_amount"`
+	NetAmount    decimal.Decimal `json:"net_amount"`
+	CreatedAt    sql.NullTime    `json:"created_at"`
+}
+
+func (m *MerchantSettlementItem) Columns() []any {
+	return []any{
+		"id",
+		"settlement_id",
+		"merchant_id",
+		"loan_id",
+		"order_ref",
+		"sale_date",
+		"gross_amount",
+		"mdr_amount",
+		"net_amount",
+		"created_at",
+	}
+}
+
+func (m *MerchantSettlementItem) StringColumns() []string {
+	vals := make([]string, len(m.Columns()))
+	for i, col := range m.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (m *MerchantSettlementItem) Values() []any {
+	return []any{
+		&m.ID,
+		&m.SettlementID,
+		&m.MerchantID,
+		&m.LoanID,
+		&m.OrderRef,
+		&m.SaleDate,
+		&m.GrossAmount,
+		&m.MDRAmount,
+		&m.NetAmount,
+		&m.CreatedAt,
+	}
+}
+
+func (m MerchantSettlementItem) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(m.Values()))
+	for i, v := range m.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (m MerchantSettlementItem) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":            m.ID.Int64,
+		"settlement_id": m.SettlementID.Int64,
+		"merchant_id":   m.MerchantID.Int64,
+		"loan_id":       m.LoanID.Int64,
+		"order_ref":     m.OrderRef.String,
+		"sale_date":     m.SaleDate.Time,
+		"gross_amount":  m.GrossAmount,
+		"mdr_amount":    m.MDRAmount,
+		"net_amount":    m.NetAmount,
+		"created_at":    m.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type Moratorium struct {
+	ID                   sql.NullInt64  `json:"id"`
+	Scope                sql.NullString `json:"scope"`
+	LoanID               sql.NullInt64  `json:"loan_id"`
+	SegmentStartFrom     sql.NullTime   `json:"segment_start_from"`
+	SegmentStartTo       sql.NullTime   `json:"segment_start_to"`
+	StartDate            sql.NullTime   `json:"start_date"`
+	EndDate              sql.NullTime   `json:"end_date"`
+	AccrueInterest       sql.NullBool   `json:"accrue_interest"`
+	Reason               sql.NullString `json:"reason"`
+	ShiftWeeks           sql.NullInt64  `json:"shift_weeks"`
+	AffectedLoans        sql.NullInt64  `json:"affected_loans"`
+	AffectedInstallments sql.NullInt64  `json:"affected_installments"`
+	CreatedAt            sql.NullTime   `json:"created_at"`
+}
+
+func (m *Moratorium) Columns() []any {
+	return []any{
+		"id",
+		"scope",
+		"loan_id",
+		"segment_start_from",
+		"segment_start_to",
+		"start_date",
+		"end_date",
+		"accrue_interest",
+		"reason",
+		"shift_weeks",
+		"affected_loans",
+		"affected_installments",
+		"created_at",
+	}
+}
+
+func (m *Moratorium) StringColumns() []string {
+	vals := make([]string, len(m.Columns()))
+	for i, col := range m.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (m *Moratorium) Values() []any {
+	return []any{
+		&m.ID,
+		&m.Scope,
+		&m.LoanID,
+		&m.SegmentStartFrom,
+		&m.SegmentStartTo,
+		&m.StartDate,
+		&m.EndDate,
+		&m.AccrueInterest,
+		&m.Reason,
+		&m.ShiftWeeks,
+		&m.AffectedLoans,
+		&m.AffectedInstallments,
+		&m.CreatedAt,
+	}
+}
+
+func (m Moratorium) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(m.Values()))
+	for i, v := range m.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (m Moratorium) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":                    m.ID.Int64,
+		"scope":                 m.Scope.String,
+		"loan_id":               m.LoanID.Int64,
+		"segment_start_from":    m.SegmentStartFrom.Time,
+		"segment_start_to":      m.SegmentStartTo.Time,
+		"start_date":            m.StartDate.Time,
+		"end_date":              m.EndDate.Time,
+		"accrue_interest":       m.AccrueInterest.Bool,
+		"reason":                m.Reason.String,
+		"shift_weeks":           m.ShiftWeeks.Int64,
+		"affected_loans":        m.AffectedLoans.Int64,
+		"affected_installments": m.AffectedInstallments.Int64,
+		"created_at":            m.CreatedAt.Time,
+	}
+}
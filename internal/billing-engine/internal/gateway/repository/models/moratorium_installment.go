@@ -0,0 +1,91 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type MoratoriumInstallment struct {
+	ID              sql.NullInt64   `json:"id"`
+	MoratoriumID    sql.NullInt64   `json:"moratorium_id"`
+	InstallmentID   sql.NullInt64   `json:"installment_id"`
+	LoanID          sql.NullInt64   `json:"loan_id"`
+	WeekNumber      sql.NullInt64   `json:"week_number"`
+	PreviousStatus  sql.NullString  `json:"previous_status"`
+	OriginalDueDate sql.NullTime    `json:"original_due_date"`
+	NewDueDate      sql.NullTime    `json:"new_due_date"`
+	OriginalAmount  decimal.Decimal `json:"original_amount"`
+	NewAmount       decimal.Decimal `json:"new_amount"`
+	AccruedInterest decimal.Decimal `json:"accrued_interest"`
+}
+
+func (m *MoratoriumInstallment) Columns() []any {
+	return []any{
+		"id",
+		"moratorium_id",
+		"installment_id",
+		"loan_id",
+		"week_number",
+		"previous_status",
+		"original_due_date",
+		"new_due_date",
+		"original_amount",
+		"new_amount",
+		"accrued_interest",
+	}
+}
+
+func (m *MoratoriumInstallment) StringColumns() []string {
+	vals := make([]string, len(m.Columns()))
+	for i, col := range m.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (m *MoratoriumInstallment) Values() []any {
+	return []any{
+		&m.ID,
+		&m.MoratoriumID,
+		&m.InstallmentID,
+		&m.LoanID,
+		&m.WeekNumber,
+		&m.PreviousStatus,
+		&m.OriginalDueDate,
+		&m.NewDueDate,
+		&m.OriginalAmount,
+		&m.NewAmount,
+		&m.AccruedInterest,
+	}
+}
+
+func (m MoratoriumInstallment) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(m.Values()))
+	for i, v := range m.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (m MoratoriumInstallment) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":                m.ID.Int64,
+		"moratorium_id":     m.MoratoriumID.Int64,
+		"installment_id":    m.InstallmentID.Int64,
+		"loan_id":           m.LoanID.Int64,
+		"week_number":       m.WeekNumber.Int64,
+		"previous_status":   m.PreviousStatus.String,
+		"original_due_date": m.OriginalDueDate.Time,
+		"new_due_date":      m.NewDueDate.Time,
+		"original_amount":   m.OriginalAmount,
+		"new_amount":        m.NewAmount,
+		"accrued_interest":  m.AccruedInterest,
+	}
+}
@@ -10,6 +10,7 @@ type Payment struct {
 	InstallmentID sql.NullInt64  `json:"installment_id"`
 	PaidAt        sql.NullTime   `json:"paid_at"`
 	AmountPaid    sql.NullString `json:"amount_paid"`
+	ReversedAt    sql.NullTime   `json:"reversed_at"`
 }
 
 func (p *Payment) Columns() []any {
@@ -18,6 +19,7 @@ func (p *Payment) Columns() []any {
 		"installment_id",
 		"paid_at",
 		"amount_paid",
+		"reversed_at",
 	}
 }
 
@@ -39,6 +41,7 @@ func (p *Payment) Values() []any {
 		&p.InstallmentID,
 		&p.PaidAt,
 		&p.AmountPaid,
+		&p.ReversedAt,
 	}
 }
 
@@ -57,5 +60,6 @@ func (p Payment) MappedValues() map[string]driver.Value {
 		"installment_id": p.InstallmentID.Int64,
 		"paid_at":        p.PaidAt.Time,
 		"amount_paid":    p.AmountPaid.String,
+		"reversed_at":    p.ReversedAt.Time,
 	}
 }
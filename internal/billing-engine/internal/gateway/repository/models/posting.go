@@ -0,0 +1,67 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type Posting struct {
+	JournalEntryID sql.NullInt64   `json:"journal_entry_id"`
+	LineNumber     sql.NullInt64   `json:"line_number"`
+	AccountCode    sql.NullString  `json:"account_code"`
+	Direction      sql.NullString  `json:"direction"`
+	Amount         decimal.Decimal `json:"amount"`
+}
+
+func (p *Posting) Columns() []any {
+	return []any{
+		"journal_entry_id",
+		"line_number",
+		"account_code",
+		"direction",
+		"amount",
+	}
+}
+
+func (p *Posting) StringColumns() []string {
+	vals := make([]string, len(p.Columns()))
+	for i, col := range p.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (p *Posting) Values() []any {
+	return []any{
+		&p.JournalEntryID,
+		&p.LineNumber,
+		&p.AccountCode,
+		&p.Direction,
+		&p.Amount,
+	}
+}
+
+func (p Posting) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(p.Values()))
+	for i, v := range p.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (p Posting) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"journal_entry_id": p.JournalEntryID.Int64,
+		"line_number":      p.LineNumber.Int64,
+		"account_code":     p.AccountCode.String,
+		"direction":        p.Direction.String,
+		"amount":           p.Amount,
+	}
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type ProductFee struct {
+	ProductCode sql.NullString  `json:"product_code"`
+	FeeType     sql.NullString  `json:"fee_type"`
+	Calculation sql.NullString  `json:"calculation"`
+	Value       decimal.Decimal `json:"value"`
+	Collection  sql.NullString  `json:"collection"`
+	UpdatedAt   sql.NullTime    `json:"updated_at"`
+}
+
+func (p *ProductFee) Columns() []any {
+	return []any{
+		"product_code",
+		"fee_type",
+		"calculation",
+		"value",
+		"collection",
+		"updated_at",
+	}
+}
+
+func (p *ProductFee) StringColumns() []string {
+	vals := make([]string, len(p.Columns()))
+	for i, col := range p.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (p *ProductFee) Values() []any {
+	return []any{
+		&p.ProductCode,
+		&p.FeeType,
+		&p.Calculation,
+		&p.Value,
+		&p.Collection,
+		&p.UpdatedAt,
+	}
+}
+
+func (p ProductFee) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(p.Values()))
+	for i, v := range p.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (p ProductFee) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"product_code": p.ProductCode.String,
+		"fee_type":     p.FeeType.String,
+		"calculation":  p.Calculation.String,
+		"value":        p.Value,
+		"collection":   p.Collection.String,
+		"updated_at":   p.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type PromiseToPay struct {
+	ID             sql.NullInt64   `json:"id"`
+	CaseID         sql.NullInt64   `json:"case_id"`
+	LoanID         sql.NullInt64   `json:"loan_id"`
+	PromisedDate   sql.NullTime    `json:"promised_date"`
+	PromisedAmount decimal.Decimal `json:"promised_amount"`
+	Status         sql.NullString  `json:"status"`
+	CreatedAt      sql.NullTime    `json:"created_at"`
+}
+
+func (p *PromiseToPay) Columns() []any {
+	return []any{
+		"id",
+		"case_id",
+		"loan_id",
+		"promised_date",
+		"promised_amount",
+		"status",
+		"created_at",
+	}
+}
+
+func (p *PromiseToPay) StringColumns() []string {
+	vals := make([]string, len(p.Columns()))
+	for i, col := range p.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (p *PromiseToPay) Values() []any {
+	return []any{
+		&p.ID,
+		&p.CaseID,
+		&p.LoanID,
+		&p.PromisedDate,
+		&p.PromisedAmount,
+		&p.Status,
+		&p.CreatedAt,
+	}
+}
+
+func (p PromiseToPay) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(p.Values()))
+	for i, v := range p.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (p PromiseToPay) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":              p.ID.Int64,
+		"case_id":         p.CaseID.Int64,
+		"loan_id":         p.LoanID.Int64,
+		"promised_date":   p.PromisedDate.Time,
+		"promised_amount": p.PromisedAmount,
+		"status":          p.Status.String,
+		"created_at":      p.CreatedAt.Time,
+	}
+}
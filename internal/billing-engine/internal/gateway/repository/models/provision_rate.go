@@ -0,0 +1,67 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type ProvisionRate struct {
+	ProductCode sql.NullString  `json:"product_code"`
+	Stage       sql.NullInt64   `json:"stage"`
+	PD          decimal.Decimal `json:"pd"`
+	LGD         decimal.Decimal `json:"lgd"`
+	UpdatedAt   sql.NullTime    `json:"updated_at"`
+}
+
+func (p *ProvisionRate) Columns() []any {
+	return []any{
+		"product_code",
+		"stage",
+		"pd",
+		"lgd",
+		"updated_at",
+	}
+}
+
+func (p *ProvisionRate) StringColumns() []string {
+	vals := make([]string, len(p.Columns()))
+	for i, col := range p.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (p *ProvisionRate) Values() []any {
+	return []any{
+		&p.ProductCode,
+		&p.Stage,
+		&p.PD,
+		&p.LGD,
+		&p.UpdatedAt,
+	}
+}
+
+func (p ProvisionRate) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(p.Values()))
+	for i, v := range p.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (p ProvisionRate) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"product_code": p.ProductCode.String,
+		"stage":        p.Stage.Int64,
+		"pd":           p.PD,
+		"lgd":          p.LGD,
+		"updated_at":   p.UpdatedAt.Time,
+	}
+}
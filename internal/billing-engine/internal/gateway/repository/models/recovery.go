@@ -0,0 +1,67 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type Recovery struct {
+	ID         sql.NullInt64   `json:"id"`
+	LoanID     sql.NullInt64   `json:"loan_id"`
+	WriteOffID sql.NullInt64   `json:"write_off_id"`
+	Amount     decimal.Decimal `json:"amount"`
+	ReceivedAt sql.NullTime    `json:"received_at"`
+}
+
+func (r *Recovery) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"write_off_id",
+		"amount",
+		"received_at",
+	}
+}
+
+func (r *Recovery) StringColumns() []string {
+	vals := make([]string, len(r.Columns()))
+	for i, col := range r.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (r *Recovery) Values() []any {
+	return []any{
+		&r.ID,
+		&r.LoanID,
+		&r.WriteOffID,
+		&r.Amount,
+		&r.ReceivedAt,
+	}
+}
+
+func (r Recovery) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(r.Values()))
+	for i, v := range r.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (r Recovery) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":           r.ID.Int64,
+		"loan_id":      r.LoanID.Int64,
+		"write_off_id": r.WriteOffID.Int64,
+		"amount":       r.Amount,
+		"received_at":  r.ReceivedAt.Time,
+	}
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/shopspring/decimal"
+)
+
+type WriteOff struct {
+	ID              sql.NullInt64   `json:"id"`
+	LoanID          sql.NullInt64   `json:"loan_id"`
+	CustomerID      sql.NullInt64   `json:"customer_id"`
+	PrincipalAmount decimal.Decimal `json:"principal_amount"`
+	InterestAmount  decimal.Decimal `json:"interest_amount"`
+	FeeAmount       decimal.Decimal `json:"fee_amount"`
+	Reason          sql.NullString  `json:"reason"`
+	WrittenOffBy    sql.NullString  `json:"written_off_by"`
+	WrittenOffAt    sql.NullTime    `json:"written_off_at"`
+}
+
+func (w *WriteOff) Columns() []any {
+	return []any{
+		"id",
+		"loan_id",
+		"customer_id",
+		"principal_amount",
+		"interest_amount",
+		"fee_amount",
+		"reason",
+		"written_off_by",
+		"written_off_at",
+	}
+}
+
+func (w *WriteOff) StringColumns() []string {
+	vals := make([]string, len(w.Columns()))
+	for i, col := range w.Columns() {
+		c, ok := col.(string)
+		if ok {
+			vals[i] = c
+		}
+	}
+
+	return vals
+}
+
+func (w *WriteOff) Values() []any {
+	return []any{
+		&w.ID,
+		&w.LoanID,
+		&w.CustomerID,
+		&w.PrincipalAmount,
+		&w.InterestAmount,
+		&w.FeeAmount,
+		&w.Reason,
+		&w.WrittenOffBy,
+		&w.WrittenOffAt,
+	}
+}
+
+func (w WriteOff) DriverValues() []driver.Value {
+	vals := make([]driver.Value, len(w.Values()))
+	for i, v := range w.Values() {
+		vals[i] = v
+	}
+
+	return vals
+}
+
+func (w WriteOff) MappedValues() map[string]driver.Value {
+	return map[string]driver.Value{
+		"id":               w.ID.Int64,
+		"loan_id":          w.LoanID.Int64,
+		"customer_id":      w.CustomerID.Int64,
+		"principal_amount": w.PrincipalAmount,
+		"interest_amount":  w.InterestAmount,
+		"fee_amount":       w.FeeAmount,
+		"reason":           w.Reason.String,
+		"written_off_by":   w.WrittenOffBy.String,
+		"written_off_at":   w.WrittenOffAt.Time,
+	}
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Moratorium Usecases
+
+// GetDisbursedLoansStartedBetween returns the DISBURSED loans started between
+// from and to (inclusive). A zero bound leaves that side open.
+func (b *BillingEngineRepository) GetDisbursedLoansStartedBetween(ctx context.Context, from time.Time, to time.Time) ([]entity.Loan, error) {
+	var loan models.Loan
+
+	query := b.queryBuilder.
+		Select(loan.Columns()...).
+		From(b.loanTableName).
+		Where(goqu.Ex{"status": string(entity.LOAN_DISBURSED)}).
+		Order(goqu.C("id").Asc())
+
+	if !from.IsZero() {
+		query = query.Where(goqu.C("start_date").Gte(from.Format("2006-01-02")))
+	}
+
+	if !to.IsZero() {
+		query = query.Where(goqu.C("start_date").Lt(to.AddDate(0, 0, 1).Format("2006-01-02")))
+	}
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var loans []entity.Loan
+	for rows.Next() {
+		if err := rows.Scan(loan.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		loans = append(loans, toLoanEntity(loan))
+	}
+
+	return loans, nil
+}
+
+// GetUnpaidInstallmentsDueFrom returns the PENDING and MISSED installments of a
+// loan due on or after from, ordered by week.
+func (b *BillingEngineRepository) GetUnpaidInstallmentsDueFrom(ctx context.Context, loanID uint64, from time.Time) ([]entity.Installment, error) {
+	query := b.queryBuilder.
+		Select("id", "loan_id", "week_number", "due_date", "amount_due", "status", "holiday_interest").
+		From(b.installmentTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Where(goqu.Ex{"status": []string{string(entity.INSTALLMENT_PENDING), string(entity.INSTALLMENT_MISSED)}}).
+		Where(goqu.C("due_date").Gte(from.Format("2006-01-02"))).
+		Order(goqu.C("week_number").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var installments []entity.Installment
+	for rows.Next() {
+		var (
+			id, installmentLoanID, weekNumber sql.NullInt64
+			dueDate                           sql.NullTime
+			amountDue, status, holiday        sql.NullString
+		)
+		if err := rows.Scan(&id, &installmentLoanID, &weekNumber, &dueDate, &amountDue, &status, &holiday); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		installments = append(installments, entity.Installment{
+			ID:         uint64(id.Int64),
+			LoanID:     uint64(installmentLoanID.Int64),
+			WeekNumber: weekNumber.Int64,
+			DueDate:    dueDate.Time.Format("2006-01-02"),
+			AmountDue:  amountDue.String,
+			Status:     entity.InstallmentStatus(status.String),
+
+			HolidayInterest: holiday.String,
+		})
+	}
+
+	return installments, nil
+}
+
+func (b *BillingEngineRepository) UpdateInstallmentSchedule(ctx context.Context, installmentID uint64, dueDate time.Time, amountDue decimal.Decimal, holidayInterest decimal.Decimal, status entity.InstallmentStatus) error {
+	query := b.queryBuilder.
+		Update(b.installmentTableName).
+		Set(goqu.Record{
+			"due_date":         dueDate.Format("2006-01-02"),
+			"amount_due":       amountDue.StringFixed(2),
+			"holiday_interest": holidayInterest.StringFixed(2),
+			"status":           string(status),
+		}).
+		Where(goqu.Ex{"id": installmentID})
+
+	row, err := b.execUpdate(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if row == 0 {
+		return fmt.Errorf("installment %d not found", installmentID)
+	}
+
+	return nil
+}
+
+func (b *BillingEngineRepository) CreateMoratorium(ctx context.Context, moratorium entity.Moratorium) (entity.Moratorium, error) {
+	createMoratorium := models.Moratorium{
+		ID:                   sql.NullInt64{Int64: int64(moratorium.ID), Valid: true},
+		Scope:                sql.NullString{String: string(moratorium.Scope), Valid: true},
+		LoanID:               sql.NullInt64{Int64: int64(moratorium.LoanID), Valid: moratorium.LoanID != 0},
+		SegmentStartFrom:     sql.NullTime{Time: moratorium.SegmentStartFrom, Valid: !moratorium.SegmentStartFrom.IsZero()},
+		SegmentStartTo:       sql.NullTime{Time: moratorium.SegmentStartTo, Valid: !moratorium.SegmentStartTo.IsZero()},
+		StartDate:            sql.NullTime{Time: moratorium.StartDate, Valid: true},
+		EndDate:              sql.NullTime{Time: moratorium.EndDate, Valid: true},
+		AccrueInterest:       sql.NullBool{Bool: moratorium.AccrueInterest, Valid: true},
+		Reason:               sql.NullString{String: moratorium.Reason, Valid: true},
+		ShiftWeeks:           sql.NullInt64{Int64: moratorium.ShiftWeeks, Valid: true},
+		AffectedLoans:        sql.NullInt64{Int64: moratorium.AffectedLoans, Valid: true},
+		AffectedInstallments: sql.NullInt64{Int64: moratorium.AffectedInstallments, Valid: true},
+		CreatedAt:            sql.NullTime{Time: moratorium.CreatedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.moratoriumTableName, &createMoratorium); err != nil {
+		return entity.Moratorium{}, err
+	}
+
+	return moratorium, nil
+}
+
+func (b *BillingEngineRepository) CreateMoratoriumInstallment(ctx context.Context, change entity.MoratoriumInstallment) error {
+	createChange := models.MoratoriumInstallment{
+		ID:              sql.NullInt64{Int64: int64(change.ID), Valid: true},
+		MoratoriumID:    sql.NullInt64{Int64: int64(change.MoratoriumID), Valid: true},
+		InstallmentID:   sql.NullInt64{Int64: int64(change.InstallmentID), Valid: true},
+		LoanID:          sql.NullInt64{Int64: int64(change.LoanID), Valid: true},
+		WeekNumber:      sql.NullInt64{Int64: change.WeekNumber, Valid: true},
+		PreviousStatus:  sql.NullString{String: string(change.PreviousStatus), Valid: true},
+		OriginalDueDate: sql.NullTime{Time: change.OriginalDueDate, Valid: true},
+		NewDueDate:      sql.NullTime{Time: change.NewDueDate, Valid: true},
+		OriginalAmount:  change.OriginalAmount,
+		NewAmount:       change.NewAmount,
+		AccruedInterest: change.AccruedInterest,
+	}
+
+	return b.insertRecord(ctx, b.moratoriumInstallmentTableName, &createChange)
+}
+
+// HasOverlappingMoratorium reports whether a moratorium covering any day
+// between from and to (inclusive) was declared on the loan or shifted its
+// installments.
+func (b *BillingEngineRepository) HasOverlappingMoratorium(ctx context.Context, loanID uint64, from time.Time, to time.Time) (bool, error) {
+	var id sql.NullInt64
+
+	shifted := b.queryBuilder.
+		Select("moratorium_id").
+		From(b.moratoriumInstallmentTableName).
+		Where(goqu.Ex{"loan_id": loanID})
+
+	query := b.queryBuilder.
+		Select("id").
+		From(b.moratoriumTableName).
+		Where(goqu.Or(
+			goqu.Ex{"loan_id": loanID},
+			goqu.L("? IN ?", goqu.C("id"), shifted),
+		)).
+		Where(goqu.C("start_date").Lte(to.Format("2006-01-02"))).
+		Where(goqu.C("end_date").Gte(from.Format("2006-01-02"))).
+		Limit(1)
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err, "loan_id", loanID)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (b *BillingEngineRepository) GetMoratorium(ctx context.Context, moratoriumID uint64) (entity.Moratorium, error) {
+	var moratorium models.Moratorium
+
+	query := b.queryBuilder.
+		Select(moratorium.Columns()...).
+		From(b.moratoriumTableName).
+		Where(goqu.Ex{"id": moratoriumID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.Moratorium{}, err
+	}
+
+	if err := row.Scan(moratorium.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Moratorium{}, fmt.Errorf("moratorium %d not found", moratoriumID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return entity.Moratorium{}, err
+	}
+
+	return entity.Moratorium{
+		ID:                   uint64(moratorium.ID.Int64),
+		Scope:                entity.MoratoriumScope(moratorium.Scope.String),
+		LoanID:               uint64(moratorium.LoanID.Int64),
+		SegmentStartFrom:     moratorium.SegmentStartFrom.Time,
+		SegmentStartTo:       moratorium.SegmentStartTo.Time,
+		StartDate:            moratorium.StartDate.Time,
+		EndDate:              moratorium.EndDate.Time,
+		AccrueInterest:       moratorium.AccrueInterest.Bool,
+		Reason:               moratorium.Reason.String,
+		ShiftWeeks:           moratorium.ShiftWeeks.Int64,
+		AffectedLoans:        moratorium.AffectedLoans.Int64,
+		AffectedInstallments: moratorium.AffectedInstallments.Int64,
+		CreatedAt:            moratorium.CreatedAt.Time,
+	}, nil
+}
+
+func (b *BillingEngineRepository) GetMoratoriumInstallments(ctx context.Context, moratoriumID uint64) ([]entity.MoratoriumInstallment, error) {
+	var change models.MoratoriumInstallment
+
+	query := b.queryBuilder.
+		Select(change.Columns()...).
+		From(b.moratoriumInstallmentTableName).
+		Where(goqu.Ex{"moratorium_id": moratoriumID}).
+		Order(goqu.C("loan_id").Asc(), goqu.C("week_number").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []entity.MoratoriumInstallment
+	for rows.Next() {
+		if err := rows.Scan(change.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		changes = append(changes, entity.MoratoriumInstallment{
+			ID:              uint64(change.ID.Int64),
+			MoratoriumID:    uint64(change.MoratoriumID.Int64),
+			InstallmentID:   uint64(change.InstallmentID.Int64),
+			LoanID:          uint64(change.LoanID.Int64),
+			WeekNumber:      change.WeekNumber.Int64,
+			PreviousStatus:  entity.InstallmentStatus(change.PreviousStatus.String),
+			OriginalDueDate: change.OriginalDueDate.Time,
+			NewDueDate:      change.NewDueDate.Time,
+			OriginalAmount:  change.OriginalAmount,
+			NewAmount:       change.NewAmount,
+			AccruedInterest: change.AccruedInterest,
+		})
+	}
+
+	return changes, nil
+}
+
+// loansUnderMoratorium selects the loans with a moratorium covering asOf.
+func (b *BillingEngineRepository) loansUnderMoratorium(asOf time.Time) *goqu.SelectDataset {
+	date := asOf.Format("2006-01-02")
+
+	return b.queryBuilder.
+		From(goqu.T(b.moratoriumInstallmentTableName).As("mi")).
+		Join(goqu.T(b.moratoriumTableName).As("m"), goqu.On(goqu.I("m.id").Eq(goqu.I("mi.moratorium_id")))).
+		Select(goqu.I("mi.loan_id")).
+		Where(goqu.I("m.start_date").Lte(date)).
+		Where(goqu.I("m.end_date").Gte(date))
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// Notification Usecases
+func (b *BillingEngineRepository) GetPendingInstallmentTargetsDueOn(ctx context.Context, dueDate time.Time) ([]entity.NotificationTarget, error) {
+	query := b.installmentTargetsQuery().
+		Where(goqu.I("i.status").Eq(string(entity.INSTALLMENT_PENDING))).
+		Where(goqu.I("i.due_date").Eq(dueDate.Format("2006-01-02")))
+
+	return b.scanNotificationTargets(ctx, query)
+}
+
+// GetMissedInstallmentTargets returns every MISSED installment of a DISBURSED
+// loan together with the borrower contact details.
+func (b *BillingEngineRepository) GetMissedInstallmentTargets(ctx context.Context) ([]entity.NotificationTarget, error) {
+	query := b.installmentTargetsQuery().
+		Where(goqu.I("i.status").Eq(string(entity.INSTALLMENT_MISSED)))
+
+	return b.scanNotificationTargets(ctx, query)
+}
+
+func (b *BillingEngineRepository) IsCommunicationSent(ctx context.Context, eventKey string) (bool, error) {
+	query := b.queryBuilder.
+		Select("id").
+		From(b.communicationTableName).
+		Where(goqu.Ex{"event_key": eventKey}).
+		Where(goqu.Ex{"status": string(entity.COMMUNICATION_SENT)}).
+		Limit(1)
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	var id sql.NullInt64
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (b *BillingEngineRepository) CreateCommunication(ctx context.Context, communication entity.Communication) (entity.Communication, error) {
+	createCommunication := models.Communication{
+		ID:                sql.NullInt64{Int64: int64(communication.ID), Valid: true},
+		LoanID:            sql.NullInt64{Int64: int64(communication.LoanID), Valid: true},
+		CustomerID:        sql.NullInt64{Int64: int64(communication.CustomerID), Valid: true},
+		InstallmentID:     sql.NullInt64{Int64: int64(communication.InstallmentID), Valid: communication.InstallmentID != 0},
+		Event:             sql.NullString{String: string(communication.Event), Valid: true},
+		Channel:           sql.NullString{String: string(communication.Channel), Valid: true},
+		Language:          sql.NullString{String: string(communication.Language), Valid: true},
+		Recipient:         sql.NullString{String: communication.Recipient, Valid: true},
+		Subject:           sql.NullString{String: communication.Subject, Valid: true},
+		Body:              sql.NullString{String: communication.Body, Valid: true},
+		Status:            sql.NullString{String: string(communication.Status), Valid: true},
+		ProviderMessageID: sql.NullString{String: communication.ProviderMessageID, Valid: true},
+		ErrorMessage:      sql.NullString{String: communication.ErrorMessage, Valid: true},
+		EventKey:          sql.NullString{String: communication.EventKey, Valid: true},
+		SentAt:            sql.NullTime{Time: communication.SentAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.communicationTableName, &createCommunication); err != nil {
+		return entity.Communication{}, err
+	}
+
+	return communication, nil
+}
+
+func (b *BillingEngineRepository) GetCommunicationsByLoan(ctx context.Context, loanID uint64) ([]entity.Communication, error) {
+	var communication models.Communication
+
+	query := b.queryBuilder.
+		Select(communication.Columns()...).
+		From(b.communicationTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Order(goqu.C("sent_at").Desc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var communications []entity.Communication
+	for rows.Next() {
+		if err := rows.Scan(communication.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		communications = append(communications, entity.Communication{
+			ID:                uint64(communication.ID.Int64),
+			LoanID:            uint64(communication.LoanID.Int64),
+			CustomerID:        uint64(communication.CustomerID.Int64),
+			InstallmentID:     uint64(communication.InstallmentID.Int64),
+			Event:             entity.NotificationEvent(communication.Event.String),
+			Channel:           entity.NotificationChannel(communication.Channel.String),
+			Language:          entity.Language(communication.Language.String),
+			Recipient:         communication.Recipient.String,
+			Subject:           communication.Subject.String,
+			Body:              communication.Body.String,
+			Status:            entity.CommunicationStatus(communication.Status.String),
+			ProviderMessageID: communication.ProviderMessageID.String,
+			ErrorMessage:      communication.ErrorMessage.String,
+			EventKey:          communication.EventKey.String,
+			SentAt:            communication.SentAt.Time,
+		})
+	}
+
+	return communications, nil
+}
+
+func (b *BillingEngineRepository) installmentTargetsQuery() *goqu.SelectDataset {
+	return b.queryBuilder.
+		Select(
+			goqu.I("i.loan_id"),
+			goqu.I("l.customer_id"),
+			goqu.I("i.id"),
+			goqu.I("i.week_number"),
+			goqu.I("i.due_date"),
+			goqu.L("i.amount_due + i.fee_amount"), // reminders quote the fees due too
+			goqu.I("c.name"),
+			goqu.I("c.email"),
+			goqu.I("c.phone"),
+			goqu.I("c.language"),
+		).
+		From(goqu.T(b.installmentTableName).As("i")).
+		Join(goqu.T(b.loanTableName).As("l"), goqu.On(goqu.I("l.id").Eq(goqu.I("i.loan_id")))).
+		Join(goqu.T(b.customerTableName).As("c"), goqu.On(goqu.I("c.id").Eq(goqu.I("l.customer_id")))).
+		Where(goqu.I("l.status").Eq(string(entity.LOAN_DISBURSED))).
+		Order(goqu.I("i.loan_id").Asc(), goqu.I("i.week_number").Asc())
+}
+
+func (b *BillingEngineRepository) scanNotificationTargets(ctx context.Context, query *goqu.SelectDataset) ([]entity.NotificationTarget, error) {
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var targets []entity.NotificationTarget
+	for rows.Next() {
+		var (
+			installment models.Installment
+			customer    models.Customer
+			customerID  sql.NullInt64
+			dueDate     sql.NullTime
+		)
+		err := rows.Scan(
+			&installment.LoanID,
+			&customerID,
+			&installment.ID,
+			&installment.WeekNumber,
+			&dueDate,
+			&installment.AmountDue,
+			&customer.Name,
+			&customer.Email,
+			&customer.Phone,
+			&customer.Language,
+		)
+		if err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		targets = append(targets, entity.NotificationTarget{
+			LoanID:        uint64(installment.LoanID.Int64),
+			CustomerID:    uint64(customerID.Int64),
+			InstallmentID: uint64(installment.ID.Int64),
+			WeekNumber:    installment.WeekNumber.Int64,
+			DueDate:       dueDate.Time,
+			AmountDue:     installment.AmountDue.String,
+			CustomerName:  customer.Name.String,
+			Email:         customer.Email.String,
+			Phone:         customer.Phone.String,
+			Language:      entity.Language(customer.Language.String),
+		})
+	}
+
+	return targets, nil
+}
@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Provisioning Usecases
+
+// GetLoanExposures returns every DISBURSED loan started on or before asOf
+// with the principal of its unpaid installments and its oldest unpaid due
+// date on or before asOf.
+func (b *BillingEngineRepository) GetLoanExposures(ctx context.Context, asOf time.Time) ([]entity.LoanExposure, error) {
+	var loan models.Loan
+
+	columns := make([]any, 0, len(loan.Columns())+3)
+	for _, column := range loan.StringColumns() {
+		columns = append(columns, goqu.I("l."+column))
+	}
+	columns = append(columns, goqu.I("i.amount_due"), goqu.I("i.due_date"), goqu.I("i.holiday_interest"))
+
+	query := b.queryBuilder.
+		Select(columns...).
+		From(goqu.T(b.loanTableName).As("l")).
+		LeftJoin(goqu.T(b.installmentTableName).As("i"), goqu.On(
+			goqu.I("i.loan_id").Eq(goqu.I("l.id")),
+			goqu.I("i.status").In(string(entity.INSTALLMENT_PENDING), string(entity.INSTALLMENT_MISSED)),
+		)).
+		Where(goqu.I("l.status").Eq(string(entity.LOAN_DISBURSED))).
+		Where(goqu.I("l.start_date").Lte(asOf.Format("2006-01-02"))).
+		Order(goqu.I("l.id").Asc(), goqu.I("i.due_date").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var exposures []entity.LoanExposure
+	for rows.Next() {
+		var amountDue, dueDate, holiday sql.NullString
+		if err := rows.Scan(append(loan.Values(), &amountDue, &dueDate, &holiday)...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		if len(exposures) == 0 || exposures[len(exposures)-1].Loan.ID != uint64(loan.ID.Int64) {
+			exposures = append(exposures, entity.LoanExposure{
+				Loan:                 toLoanEntity(loan),
+				OutstandingPrincipal: decimal.Zero,
+			})
+		}
+
+		if !amountDue.Valid {
+			continue
+		}
+
+		exposure := &exposures[len(exposures)-1]
+		installment := entity.Installment{DueDate: dueDate.String, AmountDue: amountDue.String, HolidayInterest: holiday.String}
+
+		amount, err := installment.ScheduledAmount()
+		if err != nil {
+			return nil, err
+		}
+		principal, _ := entity.SplitPrincipalInterest(amount, exposure.Loan.InterestRate)
+		exposure.OutstandingPrincipal = exposure.OutstandingPrincipal.Add(principal)
+
+		dueOn, err := installment.DueOn()
+		if err != nil {
+			return nil, err
+		}
+		if exposure.OldestUnpaidDue.IsZero() && !dueOn.After(asOf) {
+			exposure.OldestUnpaidDue = dueOn
+		}
+	}
+
+	return exposures, nil
+}
+
+func (b *BillingEngineRepository) GetProvisionRates(ctx context.Context) ([]entity.ProvisionRate, error) {
+	var rate models.ProvisionRate
+
+	query := b.queryBuilder.
+		Select(rate.Columns()...).
+		From(b.provisionRateTableName).
+		Order(goqu.C("product_code").Asc(), goqu.C("stage").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rates []entity.ProvisionRate
+	for rows.Next() {
+		if err := rows.Scan(rate.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		rates = append(rates, entity.ProvisionRate{
+			ProductCode: rate.ProductCode.String,
+			Stage:       entity.ECLStage(rate.Stage.Int64),
+			PD:          rate.PD,
+			LGD:         rate.LGD,
+			UpdatedAt:   rate.UpdatedAt.Time,
+		})
+	}
+
+	return rates, nil
+}
+
+// SaveProvisionRate creates the rate of the product and stage, or replaces
+// its PD and LGD when it already exists.
+func (b *BillingEngineRepository) SaveProvisionRate(ctx context.Context, rate entity.ProvisionRate) (entity.ProvisionRate, error) {
+	saveRate := models.ProvisionRate{
+		ProductCode: sql.NullString{String: rate.ProductCode, Valid: true},
+		Stage:       sql.NullInt64{Int64: int64(rate.Stage), Valid: true},
+		PD:          rate.PD,
+		LGD:         rate.LGD,
+		UpdatedAt:   sql.NullTime{Time: rate.UpdatedAt, Valid: true},
+	}
+
+	query := b.queryBuilder.
+		Insert(b.provisionRateTableName).
+		Cols(saveRate.Columns()...).
+		Vals(saveRate.Values()).
+		OnConflict(goqu.DoUpdate("product_code, stage", goqu.Record{
+			"pd":         goqu.L("EXCLUDED.pd"),
+			"lgd":        goqu.L("EXCLUDED.lgd"),
+			"updated_at": goqu.L("EXCLUDED.updated_at"),
+		}))
+
+	sqlQuery, _, err := query.ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build query", "error", err, "table", b.provisionRateTableName)
+		return entity.ProvisionRate{}, err
+	}
+
+	if _, err := b.conn(ctx).ExecContext(ctx, sqlQuery); err != nil {
+		b.logger.Errorw("failed to execute query", "error", err, "table", b.provisionRateTableName)
+		return entity.ProvisionRate{}, err
+	}
+
+	return rate, nil
+}
+
+// ReplaceLoanProvisions stores the provisions of a period in place of the
+// ones of an earlier run for the same period.
+func (b *BillingEngineRepository) ReplaceLoanProvisions(ctx context.Context, period string, provisions []entity.LoanProvision) error {
+	deleteQuery := b.queryBuilder.
+		Delete(b.loanProvisionTableName).
+		Where(goqu.Ex{"period": period})
+
+	sqlQuery, _, err := deleteQuery.ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build query", "error", err, "table", b.loanProvisionTableName)
+		return err
+	}
+
+	if _, err := b.conn(ctx).ExecContext(ctx, sqlQuery); err != nil {
+		b.logger.Errorw("failed to execute query", "error", err, "table", b.loanProvisionTableName)
+		return err
+	}
+
+	if len(provisions) == 0 {
+		return nil
+	}
+
+	var provision models.LoanProvision
+	rows := make([][]any, len(provisions))
+	for i, p := range provisions {
+		createProvision := models.LoanProvision{
+			ID:                sql.NullInt64{Int64: int64(p.ID), Valid: true},
+			Period:            sql.NullString{String: p.Period, Valid: true},
+			LoanID:            sql.NullInt64{Int64: int64(p.LoanID), Valid: true},
+			CustomerID:        sql.NullInt64{Int64: int64(p.CustomerID), Valid: true},
+			ProductCode:       sql.NullString{String: p.ProductCode, Valid: true},
+			Stage:             sql.NullInt64{Int64: int64(p.Stage), Valid: true},
+			DaysPastDue:       sql.NullInt64{Int64: p.DaysPastDue, Valid: true},
+			ExposureAtDefault: p.ExposureAtDefault,
+			PD:                p.PD,
+			LGD:               p.LGD,
+			ECL:               p.ECL,
+			CreatedAt:         sql.NullTime{Time: p.CreatedAt, Valid: true},
+		}
+		rows[i] = createProvision.Values()
+	}
+
+	insertQuery := b.queryBuilder.
+		Insert(b.loanProvisionTableName).
+		Cols(provision.Columns()...).
+		Vals(rows...)
+
+	sqlQuery, _, err = insertQuery.ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build query", "error", err, "table", b.loanProvisionTableName)
+		return err
+	}
+
+	if _, err := b.conn(ctx).ExecContext(ctx, sqlQuery); err != nil {
+		b.logger.Errorw("failed to execute query", "error", err, "table", b.loanProvisionTableName)
+		return err
+	}
+
+	return nil
+}
+
+func (b *BillingEngineRepository) GetLoanProvisions(ctx context.Context, period string) ([]entity.LoanProvision, error) {
+	var provision models.LoanProvision
+
+	query := b.queryBuilder.
+		Select(provision.Columns()...).
+		From(b.loanProvisionTableName).
+		Where(goqu.Ex{"period": period}).
+		Order(goqu.C("loan_id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var provisions []entity.LoanProvision
+	for rows.Next() {
+		if err := rows.Scan(provision.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		provisions = append(provisions, entity.LoanProvision{
+			ID:                uint64(provision.ID.Int64),
+			Period:            provision.Period.String,
+			LoanID:            uint64(provision.LoanID.Int64),
+			CustomerID:        uint64(provision.CustomerID.Int64),
+			ProductCode:       provision.ProductCode.String,
+			Stage:             entity.ECLStage(provision.Stage.Int64),
+			DaysPastDue:       provision.DaysPastDue.Int64,
+			ExposureAtDefault: provision.ExposureAtDefault,
+			PD:                provision.PD,
+			LGD:               provision.LGD,
+			ECL:               provision.ECL,
+			CreatedAt:         provision.CreatedAt.Time,
+		})
+	}
+
+	return provisions, nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// insertRecord inserts a single model into the given table and fails when no
+// row was written.
+func (b *BillingEngineRepository) insertRecord(ctx context.Context, tableName string, record models.Entity) error {
+	query := b.queryBuilder.
+		Insert(tableName).
+		Cols(record.Columns()...).
+		Vals(record.Values())
+
+	sqlQuery, _, err := query.ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build query", "error", err, "table", tableName)
+		return err
+	}
+
+	res, err := b.conn(ctx).ExecContext(ctx, sqlQuery)
+	if err != nil {
+		b.logger.Errorw("failed to execute query", "error", err, "table", tableName)
+		return err
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		b.logger.Errorw("failed to get rows affected", "error", err, "table", tableName)
+		return err
+	}
+
+	if row == 0 {
+		return fmt.Errorf("failed to insert into %s", tableName)
+	}
+
+	return nil
+}
+
+// execUpdate runs an update statement and returns the number of affected rows.
+func (b *BillingEngineRepository) execUpdate(ctx context.Context, query *goqu.UpdateDataset) (int64, error) {
+	sqlQuery, _, err := query.ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build update query", "error", err)
+		return 0, err
+	}
+
+	res, err := b.conn(ctx).ExecContext(ctx, sqlQuery)
+	if err != nil {
+		b.logger.Errorw("failed to execute update query", "error", err)
+		return 0, err
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		b.logger.Errorw("failed to get rows affected", "error", err)
+		return 0, err
+	}
+
+	return row, nil
+}
+
+// queryRows builds and runs a select statement returning multiple rows. The
+// caller owns closing the returned rows.
+func (b *BillingEngineRepository) queryRows(ctx context.Context, query *goqu.SelectDataset) (*sql.Rows, error) {
+	sqlQuery, _, err := query.ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build query", "error", err)
+		return nil, err
+	}
+
+	rows, err := b.conn(ctx).QueryContext(ctx, sqlQuery)
+	if err != nil {
+		b.logger.Errorw("failed to execute query", "error", err)
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// queryRow builds and runs a select statement expected to return one row.
+func (b *BillingEngineRepository) queryRow(ctx context.Context, query *goqu.SelectDataset) (*sql.Row, error) {
+	sqlQuery, _, err := query.ToSQL()
+	if err != nil {
+		b.logger.Errorw("failed to build query", "error", err)
+		return nil, err
+	}
+
+	return b.conn(ctx).QueryRowContext(ctx, sqlQuery), nil
+}
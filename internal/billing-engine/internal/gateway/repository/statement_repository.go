@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Statement Usecases
+
+// GetStatementOpeningBalance sums what a customer owed on their loans, or on
+// loanID only unless it is zero, before the entries effective on before.
+func (b *BillingEngineRepository) GetStatementOpeningBalance(ctx context.Context, customerID uint64, loanID uint64, before time.Time) (decimal.Decimal, error) {
+	query := b.queryBuilder.
+		Select(goqu.COALESCE(goqu.SUM(goqu.L(
+			"CASE WHEN ? = ? THEN ? ELSE -? END",
+			goqu.I("p.direction"),
+			string(entity.POSTING_DEBIT),
+			goqu.I("p.amount"),
+			goqu.I("p.amount"),
+		)), 0)).
+		From(goqu.T(b.postingTableName).As("p")).
+		Join(goqu.T(b.journalEntryTableName).As("j"), goqu.On(goqu.I("j.id").Eq(goqu.I("p.journal_entry_id")))).
+		Where(goqu.L("? IN ?", goqu.I("j.loan_id"), b.customerLoanIDs(customerID, loanID))).
+		Where(goqu.I("j.effective_date").Lt(before.Format("2006-01-02"))).
+		Where(goqu.I("p.account_code").In(entity.StatementAccounts))
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var balance decimal.Decimal
+	if err := row.Scan(&balance); err != nil {
+		b.logger.Errorw("failed to scan row", "error", err, "customer_id", customerID)
+		return decimal.Zero, err
+	}
+
+	return balance, nil
+}
+
+// GetStatementEntries returns the journal entries on the loans of a customer,
+// or on loanID only unless it is zero, effective from from to to, oldest
+// first.
+func (b *BillingEngineRepository) GetStatementEntries(ctx context.Context, customerID uint64, loanID uint64, from time.Time, to time.Time) ([]entity.JournalEntry, error) {
+	var entry models.JournalEntry
+
+	query := b.queryBuilder.
+		Select(entry.Columns()...).
+		From(b.journalEntryTableName).
+		Where(goqu.L("? IN ?", goqu.C("loan_id"), b.customerLoanIDs(customerID, loanID))).
+		Where(goqu.C("effective_date").Gte(from.Format("2006-01-02"))).
+		Where(goqu.C("effective_date").Lte(to.Format("2006-01-02"))).
+		Order(goqu.C("effective_date").Asc(), goqu.C("created_at").Asc())
+
+	return b.scanJournalEntries(ctx, query)
+}
+
+// customerLoanIDs selects the ids of the loans of a customer, or loanID only
+// unless it is zero.
+func (b *BillingEngineRepository) customerLoanIDs(customerID uint64, loanID uint64) *goqu.SelectDataset {
+	query := b.queryBuilder.
+		Select("id").
+		From(b.loanTableName).
+		Where(goqu.Ex{"customer_id": customerID})
+
+	if loanID != 0 {
+		query = query.Where(goqu.Ex{"id": loanID})
+	}
+
+	return query
+}
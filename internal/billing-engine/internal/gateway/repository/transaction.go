@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+)
+
+type txKey struct{}
+
+// executor runs statements either on the database or on a transaction.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// WithinTransaction runs fn in a database transaction, committed when fn
+// succeeds and rolled back when it fails. Every repository call made with the
+// context handed to fn joins the transaction, and a nested call reuses it.
+func (b *BillingEngineRepository) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		b.logger.Errorw("failed to begin transaction", "error", err)
+		return err
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			b.logger.Errorw("failed to rollback transaction", "error", rollbackErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		b.logger.Errorw("failed to commit transaction", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// inTransaction reports whether ctx carries a transaction.
+func inTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*sql.Tx)
+	return ok
+}
+
+// conn returns the transaction carried by ctx, or the database outside one.
+func (b *BillingEngineRepository) conn(ctx context.Context) executor {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+
+	return b.db
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestBillingEngineRepository_WithinTransaction(t *testing.T) {
+	tests := []struct {
+		name          string
+		fn            func(*BillingEngineRepository) func(ctx context.Context) error
+		setupMocks    func(sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "success - committed, nested calls join the transaction",
+			fn: func(repository *BillingEngineRepository) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					if _, err := repository.conn(ctx).ExecContext(ctx, "UPDATE customers SET deleted_at = NOW()"); err != nil {
+						return err
+					}
+					return repository.WithinTransaction(ctx, func(ctx context.Context) error {
+						_, err := repository.conn(ctx).ExecContext(ctx, "UPDATE loans SET customer_id = 1")
+						return err
+					})
+				}
+			},
+			setupMocks: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE customers").WillReturnResult(sqlmock.NewResult(0, 1))
+				mockDB.ExpectExec("UPDATE loans").WillReturnResult(sqlmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "error - rolled back when fn fails",
+			fn: func(repository *BillingEngineRepository) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					if _, err := repository.conn(ctx).ExecContext(ctx, "UPDATE loans SET customer_id = 1"); err != nil {
+						return err
+					}
+					return errors.New("customer 2 still has open loans")
+				}
+			},
+			setupMocks: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE loans").WillReturnResult(sqlmock.NewResult(0, 1))
+				mockDB.ExpectRollback()
+			},
+			expectedError: errors.New("customer 2 still has open loans"),
+		},
+		{
+			name: "error - begin fails",
+			fn: func(*BillingEngineRepository) func(ctx context.Context) error {
+				return func(context.Context) error { return nil }
+			},
+			setupMocks: func(mockDB sqlmock.Sqlmock) {
+				mockDB.ExpectBegin().WillReturnError(errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mockDB, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			tt.setupMocks(mockDB)
+
+			repository := NewBillingEngineRepository(db, zap.NewNop().Sugar(), goqu.Dialect("postgres"), pkgmocks.NewMockSnowflake(t))
+
+			err = repository.WithinTransaction(context.Background(), tt.fn(repository))
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository/models"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/shopspring/decimal"
+)
+
+// Write-off Usecases
+func (b *BillingEngineRepository) CreateWriteOff(ctx context.Context, writeOff entity.WriteOff) (entity.WriteOff, error) {
+	createWriteOff := models.WriteOff{
+		ID:              sql.NullInt64{Int64: int64(writeOff.ID), Valid: true},
+		LoanID:          sql.NullInt64{Int64: int64(writeOff.LoanID), Valid: true},
+		CustomerID:      sql.NullInt64{Int64: int64(writeOff.CustomerID), Valid: true},
+		PrincipalAmount: writeOff.PrincipalAmount,
+		InterestAmount:  writeOff.InterestAmount,
+		FeeAmount:       writeOff.FeeAmount,
+		Reason:          sql.NullString{String: writeOff.Reason, Valid: true},
+		WrittenOffBy:    sql.NullString{String: writeOff.WrittenOffBy, Valid: true},
+		WrittenOffAt:    sql.NullTime{Time: writeOff.WrittenOffAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.writeOffTableName, &createWriteOff); err != nil {
+		return entity.WriteOff{}, err
+	}
+
+	return writeOff, nil
+}
+
+func (b *BillingEngineRepository) GetWriteOffByLoan(ctx context.Context, loanID uint64) (entity.WriteOff, error) {
+	var writeOff models.WriteOff
+
+	query := b.queryBuilder.
+		Select(writeOff.Columns()...).
+		From(b.writeOffTableName).
+		Where(goqu.Ex{"loan_id": loanID})
+
+	// Recoveries are checked against the balance left under the lock of the
+	// write-off, so two of them cannot both take the last of it
+	if inTransaction(ctx) {
+		query = query.ForUpdate(exp.Wait)
+	}
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return entity.WriteOff{}, err
+	}
+
+	if err := row.Scan(writeOff.Values()...); err != nil {
+		if err == sql.ErrNoRows {
+			return entity.WriteOff{}, fmt.Errorf("write-off of loan %d not found", loanID)
+		}
+		b.logger.Errorw("failed to scan row", "error", err)
+		return entity.WriteOff{}, err
+	}
+
+	return entity.WriteOff{
+		ID:              uint64(writeOff.ID.Int64),
+		LoanID:          uint64(writeOff.LoanID.Int64),
+		CustomerID:      uint64(writeOff.CustomerID.Int64),
+		PrincipalAmount: writeOff.PrincipalAmount,
+		InterestAmount:  writeOff.InterestAmount,
+		FeeAmount:       writeOff.FeeAmount,
+		Reason:          writeOff.Reason.String,
+		WrittenOffBy:    writeOff.WrittenOffBy.String,
+		WrittenOffAt:    writeOff.WrittenOffAt.Time,
+	}, nil
+}
+
+func (b *BillingEngineRepository) CreateRecovery(ctx context.Context, recovery entity.Recovery) (entity.Recovery, error) {
+	createRecovery := models.Recovery{
+		ID:         sql.NullInt64{Int64: int64(recovery.ID), Valid: true},
+		LoanID:     sql.NullInt64{Int64: int64(recovery.LoanID), Valid: true},
+		WriteOffID: sql.NullInt64{Int64: int64(recovery.WriteOffID), Valid: true},
+		Amount:     recovery.Amount,
+		ReceivedAt: sql.NullTime{Time: recovery.ReceivedAt, Valid: true},
+	}
+
+	if err := b.insertRecord(ctx, b.recoveryTableName, &createRecovery); err != nil {
+		return entity.Recovery{}, err
+	}
+
+	return recovery, nil
+}
+
+func (b *BillingEngineRepository) GetRecoveriesByLoan(ctx context.Context, loanID uint64) ([]entity.Recovery, error) {
+	var recovery models.Recovery
+
+	query := b.queryBuilder.
+		Select(recovery.Columns()...).
+		From(b.recoveryTableName).
+		Where(goqu.Ex{"loan_id": loanID}).
+		Order(goqu.C("received_at").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recoveries []entity.Recovery
+	for rows.Next() {
+		if err := rows.Scan(recovery.Values()...); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		recoveries = append(recoveries, entity.Recovery{
+			ID:         uint64(recovery.ID.Int64),
+			LoanID:     uint64(recovery.LoanID.Int64),
+			WriteOffID: uint64(recovery.WriteOffID.Int64),
+			Amount:     recovery.Amount,
+			ReceivedAt: recovery.ReceivedAt.Time,
+		})
+	}
+
+	return recoveries, nil
+}
+
+func (b *BillingEngineRepository) GetTotalRecovered(ctx context.Context, loanID uint64) (decimal.Decimal, error) {
+	query := b.queryBuilder.
+		Select(goqu.COALESCE(goqu.SUM("amount"), 0)).
+		From(b.recoveryTableName).
+		Where(goqu.Ex{"loan_id": loanID})
+
+	row, err := b.queryRow(ctx, query)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var total decimal.Decimal
+	if err := row.Scan(&total); err != nil {
+		b.logger.Errorw("failed to scan row", "error", err)
+		return decimal.Zero, err
+	}
+
+	return total, nil
+}
+
+// GetRecoverySummaries aggregates per loan the recoveries received in
+// [from, to).
+func (b *BillingEngineRepository) GetRecoverySummaries(ctx context.Context, from time.Time, to time.Time) ([]entity.LoanRecoverySummary, error) {
+	query := b.queryBuilder.
+		Select(
+			goqu.I("r.loan_id"),
+			goqu.I("w.customer_id"),
+			goqu.L("? + ? + ?", goqu.I("w.principal_amount"), goqu.I("w.interest_amount"), goqu.I("w.fee_amount")),
+			goqu.SUM(goqu.I("r.amount")),
+			goqu.COUNT(goqu.I("r.id")),
+		).
+		From(goqu.T(b.recoveryTableName).As("r")).
+		Join(goqu.T(b.writeOffTableName).As("w"), goqu.On(goqu.I("w.id").Eq(goqu.I("r.write_off_id")))).
+		Where(goqu.I("r.received_at").Gte(from)).
+		Where(goqu.I("r.received_at").Lt(to)).
+		GroupBy(goqu.I("r.loan_id"), goqu.I("w.customer_id"), goqu.I("w.principal_amount"), goqu.I("w.interest_amount"), goqu.I("w.fee_amount")).
+		Order(goqu.I("r.loan_id").Asc())
+
+	rows, err := b.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []entity.LoanRecoverySummary
+	for rows.Next() {
+		var (
+			loanID, customerID, count sql.NullInt64
+			writtenOff, recovered     decimal.Decimal
+		)
+		if err := rows.Scan(&loanID, &customerID, &writtenOff, &recovered, &count); err != nil {
+			b.logger.Errorw("failed to scan row", "error", err)
+			return nil, err
+		}
+
+		summaries = append(summaries, entity.LoanRecoverySummary{
+			LoanID:          uint64(loanID.Int64),
+			CustomerID:      uint64(customerID.Int64),
+			WrittenOff:      writtenOff,
+			Recovered:       recovered,
+			RecoveriesCount: count.Int64,
+		})
+	}
+
+	return summaries, nil
+}
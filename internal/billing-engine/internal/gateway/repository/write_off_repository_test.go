@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestBillingEngineRepository_GetWriteOffByLoan(t *testing.T) {
+	columns := []string{"id", "loan_id", "customer_id", "principal_amount", "interest_amount", "fee_amount", "reason", "written_off_by", "written_off_at"}
+	selectQuery := `SELECT "id", "loan_id", "customer_id", "principal_amount", "interest_amount", "fee_amount", "reason", "written_off_by", "written_off_at" ` +
+		`FROM "write_offs" WHERE ("loan_id" = 100)`
+
+	tests := []struct {
+		name          string
+		transactional bool
+		expectedQuery string
+	}{
+		{
+			name:          "success - read outside a transaction without a lock",
+			expectedQuery: selectQuery,
+		},
+		{
+			name:          "success - read in a transaction locks the write-off",
+			transactional: true,
+			expectedQuery: selectQuery + ` FOR UPDATE`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mockDB, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			if tt.transactional {
+				mockDB.ExpectBegin()
+			}
+			mockDB.ExpectQuery(regexp.QuoteMeta(tt.expectedQuery) + `$`).
+				WillReturnRows(sqlmock.NewRows(columns).AddRow(7, 100, 1, "200000.00", "20000.00", "0.00", "deceased", "risk-officer", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)))
+			if tt.transactional {
+				mockDB.ExpectCommit()
+			}
+
+			repository := NewBillingEngineRepository(db, zap.NewNop().Sugar(), goqu.Dialect("postgres"), pkgmocks.NewMockSnowflake(t))
+
+			var writeOff entity.WriteOff
+			read := func(ctx context.Context) error {
+				writeOff, err = repository.GetWriteOffByLoan(ctx, 100)
+				return err
+			}
+
+			if tt.transactional {
+				err = repository.WithinTransaction(context.Background(), read)
+			} else {
+				err = read(context.Background())
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(7), writeOff.ID)
+			assert.Equal(t, "220000", writeOff.Total().String())
+			assert.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.AddLoanPartyUsecase = (*AddLoanPartyInteractor)(nil)
+
+type (
+	AddLoanPartyRepository interface {
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error)
+		GetLoanParties(ctx context.Context, loanID uint64) ([]entity.LoanParty, error)
+		AddLoanParty(ctx context.Context, party entity.LoanParty) (entity.LoanParty, error)
+	}
+
+	AddLoanPartyInteractorDependencies struct {
+		AddLoanPartyRepository AddLoanPartyRepository
+		Logger                 *zap.SugaredLogger
+		Validator              *validator.Validate
+		SnowflakeGen           pkguid.Snowflake
+	}
+
+	AddLoanPartyInteractor struct {
+		repository   AddLoanPartyRepository `validate:"required"`
+		logger       *zap.SugaredLogger     `validate:"required"`
+		validator    *validator.Validate    `validate:"required"`
+		snowflakeGen pkguid.Snowflake       `validate:"required"`
+	}
+)
+
+func NewAddLoanPartyInteractor(
+	deps AddLoanPartyInteractorDependencies,
+) *AddLoanPartyInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &AddLoanPartyInteractor{
+		repository:   deps.AddLoanPartyRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.AddLoanPartyUsecase.
+//
+// Parties are linked while the loan is applied for or approved, before it is
+// paid out. A customer takes one role on a loan and never on their own.
+func (a *AddLoanPartyInteractor) Execute(ctx context.Context, input usecases.AddLoanPartyInput) (usecases.LoanPartyOutput, error) {
+	if err := a.validator.Struct(input); err != nil {
+		a.logger.Errorw("invalid input", "error", err)
+		return usecases.LoanPartyOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	loan, err := a.repository.GetLoan(ctx, input.LoanID)
+	if err != nil {
+		a.logger.Errorw("failed to get loan", "error", err, "loan_id", input.LoanID)
+		return usecases.LoanPartyOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if loan.Status != entity.LOAN_APPLIED && loan.Status != entity.LOAN_APPROVED {
+		return usecases.LoanPartyOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("loan %d is %s, parties are linked before it is disbursed", loan.ID, loan.Status),
+		)
+	}
+
+	if loan.CustomerID == input.CustomerID {
+		return usecases.LoanPartyOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("customer %d is the borrower of loan %d", input.CustomerID, loan.ID),
+		)
+	}
+
+	customer, err := a.repository.GetCustomer(ctx, input.CustomerID)
+	if err != nil {
+		a.logger.Errorw("failed to get customer", "error", err, "customer_id", input.CustomerID)
+		return usecases.LoanPartyOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if customer.IsDeleted() {
+		return usecases.LoanPartyOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("customer %d is deleted", customer.ID),
+		)
+	}
+
+	parties, err := a.repository.GetLoanParties(ctx, loan.ID)
+	if err != nil {
+		a.logger.Errorw("failed to get loan parties", "error", err, "loan_id", loan.ID)
+		return usecases.LoanPartyOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	for _, party := range parties {
+		if party.CustomerID == input.CustomerID {
+			return usecases.LoanPartyOutput{}, pkgerror.NewBusinessError(
+				fmt.Sprintf("customer %d already is a %s on loan %d", party.CustomerID, party.Role, loan.ID),
+			)
+		}
+	}
+
+	party, err := a.repository.AddLoanParty(ctx, entity.LoanParty{
+		ID:         a.snowflakeGen.Generate(),
+		LoanID:     loan.ID,
+		CustomerID: input.CustomerID,
+		Role:       entity.LoanPartyRole(input.Role),
+		AddedBy:    input.AddedBy,
+		AddedAt:    time.Now(),
+	})
+	if err != nil {
+		a.logger.Errorw("failed to add loan party", "error", err, "loan_id", loan.ID, "customer_id", input.CustomerID)
+		return usecases.LoanPartyOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toLoanPartyOutput(party), nil
+}
+
+func toLoanPartyOutput(party entity.LoanParty) usecases.LoanPartyOutput {
+	return usecases.LoanPartyOutput{
+		ID:         party.ID,
+		LoanID:     party.LoanID,
+		CustomerID: party.CustomerID,
+		Role:       string(party.Role),
+		AddedBy:    party.AddedBy,
+		AddedAt:    party.AddedAt.Format(time.RFC3339),
+	}
+}
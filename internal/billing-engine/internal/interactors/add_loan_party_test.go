@@ -0,0 +1,140 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestAddLoanPartyInteractor_Execute(t *testing.T) {
+	appliedLoan := entity.Loan{ID: 100, CustomerID: 1, Status: entity.LOAN_APPLIED}
+	input := usecases.AddLoanPartyInput{LoanID: 100, CustomerID: 2, Role: "GUARANTOR", AddedBy: "sales-agent"}
+
+	tests := []struct {
+		name          string
+		input         usecases.AddLoanPartyInput
+		setupMocks    func(*billingenginemocks.MockAddLoanPartyRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.LoanPartyOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - guarantor linked to an application",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockAddLoanPartyRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(appliedLoan, nil)
+				mockRepo.On("GetCustomer", mock.Anything, uint64(2)).Return(entity.Customer{ID: 2}, nil)
+				mockRepo.On("GetLoanParties", mock.Anything, uint64(100)).Return([]entity.LoanParty{
+					{ID: 10, LoanID: 100, CustomerID: 3, Role: entity.LOAN_PARTY_CO_BORROWER},
+				}, nil)
+				mockSnowflake.On("Generate").Return(uint64(11))
+				mockRepo.EXPECT().AddLoanParty(mock.Anything, mock.MatchedBy(func(party entity.LoanParty) bool {
+					return party.ID == 11 && party.LoanID == 100 && party.CustomerID == 2 &&
+						party.Role == entity.LOAN_PARTY_GUARANTOR && party.AddedBy == "sales-agent" && !party.AddedAt.IsZero()
+				})).RunAndReturn(func(_ context.Context, party entity.LoanParty) (entity.LoanParty, error) {
+					return party, nil
+				})
+			},
+			expectedCheck: func(t *testing.T, output usecases.LoanPartyOutput) {
+				assert.Equal(t, uint64(11), output.ID)
+				assert.Equal(t, uint64(100), output.LoanID)
+				assert.Equal(t, uint64(2), output.CustomerID)
+				assert.Equal(t, "GUARANTOR", output.Role)
+				_, err := time.Parse(time.RFC3339, output.AddedAt)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:  "error - validation error (unknown role)",
+			input: usecases.AddLoanPartyInput{LoanID: 100, CustomerID: 2, Role: "WITNESS", AddedBy: "sales-agent"},
+			setupMocks: func(*billingenginemocks.MockAddLoanPartyRepository, *pkgmocks.MockSnowflake) {
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan already disbursed",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockAddLoanPartyRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, CustomerID: 1, Status: entity.LOAN_DISBURSED}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - borrower linked to their own loan",
+			input: usecases.AddLoanPartyInput{LoanID: 100, CustomerID: 1, Role: "CO_BORROWER", AddedBy: "sales-agent"},
+			setupMocks: func(mockRepo *billingenginemocks.MockAddLoanPartyRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(appliedLoan, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer deleted",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockAddLoanPartyRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(appliedLoan, nil)
+				mockRepo.On("GetCustomer", mock.Anything, uint64(2)).Return(entity.Customer{ID: 2, DeletedAt: time.Now()}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer already a party to the loan",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockAddLoanPartyRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(appliedLoan, nil)
+				mockRepo.On("GetCustomer", mock.Anything, uint64(2)).Return(entity.Customer{ID: 2}, nil)
+				mockRepo.On("GetLoanParties", mock.Anything, uint64(100)).Return([]entity.LoanParty{
+					{ID: 10, LoanID: 100, CustomerID: 2, Role: entity.LOAN_PARTY_CO_BORROWER},
+				}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on AddLoanParty",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockAddLoanPartyRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(appliedLoan, nil)
+				mockRepo.On("GetCustomer", mock.Anything, uint64(2)).Return(entity.Customer{ID: 2}, nil)
+				mockRepo.On("GetLoanParties", mock.Anything, uint64(100)).Return(nil, nil)
+				mockSnowflake.On("Generate").Return(uint64(11))
+				mockRepo.On("AddLoanParty", mock.Anything, mock.Anything).Return(entity.LoanParty{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockAddLoanPartyRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewAddLoanPartyInteractor(AddLoanPartyInteractorDependencies{
+				AddLoanPartyRepository: mockRepo,
+				Logger:                 zap.NewNop().Sugar(),
+				Validator:              validator.New(),
+				SnowflakeGen:           mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
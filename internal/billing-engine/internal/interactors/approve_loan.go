@@ -0,0 +1,110 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.ApproveLoanUsecase = (*ApproveLoanInteractor)(nil)
+
+type (
+	ApproveLoanRepository interface {
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		GetLoanParties(ctx context.Context, loanID uint64) ([]entity.LoanParty, error)
+		TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error
+	}
+
+	ApproveLoanInteractorDependencies struct {
+		ApproveLoanRepository ApproveLoanRepository
+		Logger                *zap.SugaredLogger
+		Validator             *validator.Validate
+
+		// GuarantorProducts are the products whose loans are approved only
+		// with a guarantor linked.
+		GuarantorProducts []string
+	}
+
+	ApproveLoanInteractor struct {
+		repository        ApproveLoanRepository `validate:"required"`
+		logger            *zap.SugaredLogger    `validate:"required"`
+		validator         *validator.Validate   `validate:"required"`
+		guarantorProducts []string
+	}
+)
+
+func NewApproveLoanInteractor(
+	deps ApproveLoanInteractorDependencies,
+) *ApproveLoanInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &ApproveLoanInteractor{
+		repository:        deps.ApproveLoanRepository,
+		logger:            deps.Logger,
+		validator:         deps.Validator,
+		guarantorProducts: deps.GuarantorProducts,
+	}
+}
+
+// Execute implements usecases.ApproveLoanUsecase.
+//
+// An application is approved by someone other than who requested it, and
+// with a guarantor linked when its product requires one.
+func (a *ApproveLoanInteractor) Execute(ctx context.Context, input usecases.ApproveLoanInput) (usecases.LoanOutput, error) {
+	if err := a.validator.Struct(input); err != nil {
+		a.logger.Errorw("invalid input", "error", err)
+		return usecases.LoanOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	loan, err := a.repository.GetLoan(ctx, input.LoanID)
+	if err != nil {
+		a.logger.Errorw("failed to get loan", "error", err, "loan_id", input.LoanID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if strings.EqualFold(input.ApprovedBy, loan.RequestedBy) {
+		return usecases.LoanOutput{}, pkgerror.NewBusinessError("a loan application cannot be approved by its requester")
+	}
+
+	if slices.Contains(a.guarantorProducts, loan.ProductCode) {
+		parties, err := a.repository.GetLoanParties(ctx, loan.ID)
+		if err != nil {
+			a.logger.Errorw("failed to get loan parties", "error", err, "loan_id", loan.ID)
+			return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		if !entity.HasParty(parties, entity.LOAN_PARTY_GUARANTOR) {
+			return usecases.LoanOutput{}, pkgerror.NewBusinessError(
+				fmt.Sprintf("product %s requires a guarantor, link one to loan %d before approving it", loan.ProductCode, loan.ID),
+			)
+		}
+	}
+
+	reason := input.Note
+	if reason == "" {
+		reason = "loan approved"
+	}
+
+	transition, err := newLoanTransition(loan, entity.LOAN_APPROVED, input.ApprovedBy, reason)
+	if err != nil {
+		return usecases.LoanOutput{}, err
+	}
+
+	if err := a.repository.TransitionLoanStatus(ctx, transition); err != nil {
+		a.logger.Errorw("failed to approve loan", "error", err, "loan_id", loan.ID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	loan.Status = entity.LOAN_APPROVED
+	return toLoanOutput(loan), nil
+}
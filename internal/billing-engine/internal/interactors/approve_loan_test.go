@@ -0,0 +1,135 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestApproveLoanInteractor_Execute(t *testing.T) {
+	appliedLoan := entity.Loan{
+		ID: 100, CustomerID: 1, PrincipalAmount: decimal.NewFromInt(5000000), InterestRate: decimal.NewFromFloat(0.1),
+		TermWeeks: 50, Status: entity.LOAN_APPLIED, RequestedBy: "sales-agent",
+	}
+	securedLoan := appliedLoan
+	securedLoan.ProductCode = "SECURED"
+
+	tests := []struct {
+		name           string
+		input          usecases.ApproveLoanInput
+		setupMocks     func(*billingenginemocks.MockApproveLoanRepository)
+		expectedOutput usecases.LoanOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - application approved by another user",
+			input: usecases.ApproveLoanInput{LoanID: 100, ApprovedBy: "credit-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockApproveLoanRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(appliedLoan, nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.MatchedBy(func(transition entity.LoanStatusTransition) bool {
+					return transition.LoanID == 100 && transition.FromStatus == entity.LOAN_APPLIED && transition.ToStatus == entity.LOAN_APPROVED &&
+						transition.Actor == "credit-officer" && transition.Reason == "loan approved"
+				})).Return(nil)
+			},
+			expectedOutput: usecases.LoanOutput{
+				ID: 100, CustomerID: 1, PrincipalAmount: "5000000", InterestRate: "0.1", TermWeeks: 50,
+				Status: "APPROVED", RequestedBy: "sales-agent",
+			},
+		},
+		{
+			name:  "success - guarantor linked for a product requiring one",
+			input: usecases.ApproveLoanInput{LoanID: 100, ApprovedBy: "credit-officer", Note: "guarantor verified"},
+			setupMocks: func(mockRepo *billingenginemocks.MockApproveLoanRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(securedLoan, nil)
+				mockRepo.On("GetLoanParties", mock.Anything, uint64(100)).Return([]entity.LoanParty{
+					{ID: 1, LoanID: 100, CustomerID: 2, Role: entity.LOAN_PARTY_GUARANTOR},
+				}, nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.MatchedBy(func(transition entity.LoanStatusTransition) bool {
+					return transition.ToStatus == entity.LOAN_APPROVED && transition.Reason == "guarantor verified"
+				})).Return(nil)
+			},
+			expectedOutput: usecases.LoanOutput{
+				ID: 100, CustomerID: 1, PrincipalAmount: "5000000", InterestRate: "0.1", TermWeeks: 50,
+				Status: "APPROVED", ProductCode: "SECURED", RequestedBy: "sales-agent",
+			},
+		},
+		{
+			name:  "error - product requires a guarantor and only a co-borrower is linked",
+			input: usecases.ApproveLoanInput{LoanID: 100, ApprovedBy: "credit-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockApproveLoanRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(securedLoan, nil)
+				mockRepo.On("GetLoanParties", mock.Anything, uint64(100)).Return([]entity.LoanParty{
+					{ID: 1, LoanID: 100, CustomerID: 2, Role: entity.LOAN_PARTY_CO_BORROWER},
+				}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (missing approved_by)",
+			input:         usecases.ApproveLoanInput{LoanID: 100},
+			setupMocks:    func(*billingenginemocks.MockApproveLoanRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - approver is the requester",
+			input: usecases.ApproveLoanInput{LoanID: 100, ApprovedBy: "Sales-Agent"},
+			setupMocks: func(mockRepo *billingenginemocks.MockApproveLoanRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(appliedLoan, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - illegal transition from a disbursed loan",
+			input: usecases.ApproveLoanInput{LoanID: 100, ApprovedBy: "credit-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockApproveLoanRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_DISBURSED, RequestedBy: "sales-agent"}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan approved concurrently",
+			input: usecases.ApproveLoanInput{LoanID: 100, ApprovedBy: "credit-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockApproveLoanRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(appliedLoan, nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.Anything).Return(errors.New("loan 100 is no longer APPLIED"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockApproveLoanRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewApproveLoanInteractor(ApproveLoanInteractorDependencies{
+				ApproveLoanRepository: mockRepo,
+				Logger:                zap.NewNop().Sugar(),
+				Validator:             validator.New(),
+				GuarantorProducts:     []string{"SECURED"},
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
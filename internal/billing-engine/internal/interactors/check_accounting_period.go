@@ -0,0 +1,260 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.CheckAccountingPeriodUsecase = (*CheckAccountingPeriodInteractor)(nil)
+
+type (
+	CheckAccountingPeriodRepository interface {
+		PeriodLockRepository
+		GetAccountTotals(ctx context.Context, asOf time.Time) ([]entity.AccountTotal, error)
+		GetLoanAccrualStates(ctx context.Context) ([]entity.LoanAccrualState, error)
+		GetAllInstallments(ctx context.Context, loanID uint64) ([]entity.Installment, error)
+		GetLoanProvisions(ctx context.Context, period string) ([]entity.LoanProvision, error)
+		GetLoanExposures(ctx context.Context, asOf time.Time) ([]entity.LoanExposure, error)
+	}
+
+	CheckAccountingPeriodInteractorDependencies struct {
+		CheckAccountingPeriodRepository CheckAccountingPeriodRepository
+		Logger                          *zap.SugaredLogger
+		Validator                       *validator.Validate
+	}
+
+	CheckAccountingPeriodInteractor struct {
+		repository CheckAccountingPeriodRepository `validate:"required"`
+		logger     *zap.SugaredLogger              `validate:"required"`
+		validator  *validator.Validate             `validate:"required"`
+	}
+)
+
+func NewCheckAccountingPeriodInteractor(
+	deps CheckAccountingPeriodInteractorDependencies,
+) *CheckAccountingPeriodInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &CheckAccountingPeriodInteractor{
+		repository: deps.CheckAccountingPeriodRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.CheckAccountingPeriodUsecase.
+//
+// The consistency checks of the month close are run without closing the
+// month, so the failures can be fixed beforehand.
+func (c *CheckAccountingPeriodInteractor) Execute(ctx context.Context, input usecases.CheckAccountingPeriodInput) (usecases.CheckAccountingPeriodOutput, error) {
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("invalid input", "error", err)
+		return usecases.CheckAccountingPeriodOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	month, err := parseMonth(input.Month)
+	if err != nil {
+		return usecases.CheckAccountingPeriodOutput{}, err
+	}
+
+	checks, err := runPeriodChecks(ctx, c.repository, month)
+	if err != nil {
+		c.logger.Errorw("failed to run period checks", "error", err, "month", input.Month)
+		return usecases.CheckAccountingPeriodOutput{}, err
+	}
+
+	return usecases.CheckAccountingPeriodOutput{
+		Month:  input.Month,
+		Passed: len(failedPeriodChecks(checks)) == 0,
+		Checks: toPeriodCheckOutputs(checks),
+	}, nil
+}
+
+// runPeriodChecks runs the consistency checks a month has to pass before it
+// can be closed. It fails when the month is already closed.
+func runPeriodChecks(ctx context.Context, repository CheckAccountingPeriodRepository, month time.Time) ([]entity.PeriodCheck, error) {
+	period := entity.PeriodOf(month)
+	asOf := endOfMonth(month)
+
+	latest, err := repository.GetLatestClosedPeriod(ctx)
+	if err != nil {
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if latest.Locks(month) {
+		return nil, pkgerror.NewBusinessError(fmt.Sprintf("accounting period %s is already closed", period))
+	}
+
+	checks := []entity.PeriodCheck{
+		checkMonthEnded(asOf),
+		checkPreviousPeriodClosed(latest, month),
+	}
+
+	trialBalance, err := checkTrialBalance(ctx, repository, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	accrued, err := checkInterestAccrued(ctx, repository, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	provisioned, err := checkProvisioningRun(ctx, repository, period, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(checks, trialBalance, accrued, provisioned), nil
+}
+
+func checkMonthEnded(asOf time.Time) entity.PeriodCheck {
+	check := entity.PeriodCheck{Name: entity.PERIOD_CHECK_MONTH_ENDED, Passed: true}
+	if !asOf.Before(startOfDay(time.Now())) {
+		check.Passed = false
+		check.Detail = "the month ends on " + asOf.Format(dateLayout)
+	}
+
+	return check
+}
+
+// checkPreviousPeriodClosed passes for the first month ever closed, or the
+// month right after the last closed one.
+func checkPreviousPeriodClosed(latest entity.AccountingPeriod, month time.Time) entity.PeriodCheck {
+	check := entity.PeriodCheck{Name: entity.PERIOD_CHECK_PREVIOUS_PERIOD_CLOSED, Passed: true}
+	if latest.Status != entity.PERIOD_CLOSED {
+		return check
+	}
+
+	previous := entity.PeriodOf(month.AddDate(0, -1, 0))
+	if latest.Period != previous {
+		check.Passed = false
+		check.Detail = fmt.Sprintf("the last closed period is %s, %s has to be closed first", latest.Period, previous)
+	}
+
+	return check
+}
+
+func checkTrialBalance(ctx context.Context, repository CheckAccountingPeriodRepository, asOf time.Time) (entity.PeriodCheck, error) {
+	totals, err := repository.GetAccountTotals(ctx, asOf)
+	if err != nil {
+		return entity.PeriodCheck{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	debit, credit := decimal.Zero, decimal.Zero
+	for _, total := range totals {
+		debit = debit.Add(total.Debit)
+		credit = credit.Add(total.Credit)
+	}
+
+	return entity.PeriodCheck{
+		Name:   entity.PERIOD_CHECK_TRIAL_BALANCE,
+		Passed: debit.Equal(credit),
+		Detail: fmt.Sprintf("debit %s, credit %s", debit.StringFixed(2), credit.StringFixed(2)),
+	}, nil
+}
+
+// checkInterestAccrued passes when every disbursed loan accrued its interest
+// through the month end, or through its last due date when that comes first.
+func checkInterestAccrued(ctx context.Context, repository CheckAccountingPeriodRepository, asOf time.Time) (entity.PeriodCheck, error) {
+	states, err := repository.GetLoanAccrualStates(ctx)
+	if err != nil {
+		return entity.PeriodCheck{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	pending := 0
+	for _, state := range states {
+		accruedThrough := startOfDay(state.Loan.StartDate)
+		if !state.LastAccrualDate.IsZero() {
+			accruedThrough = startOfDay(state.LastAccrualDate)
+		}
+
+		if !accruedThrough.Before(asOf) {
+			continue
+		}
+
+		installments, err := repository.GetAllInstallments(ctx, state.Loan.ID)
+		if err != nil {
+			return entity.PeriodCheck{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		lastDue, err := entity.LastDueDate(installments)
+		if err != nil {
+			return entity.PeriodCheck{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		if accruedThrough.Before(lastDue) {
+			pending++
+		}
+	}
+
+	check := entity.PeriodCheck{Name: entity.PERIOD_CHECK_INTEREST_ACCRUED, Passed: pending == 0}
+	if pending > 0 {
+		check.Detail = fmt.Sprintf("%d loans not accrued through %s", pending, asOf.Format(dateLayout))
+	}
+
+	return check, nil
+}
+
+// checkProvisioningRun passes when the month was provisioned, or when there
+// was nothing to provision.
+func checkProvisioningRun(ctx context.Context, repository CheckAccountingPeriodRepository, period string, asOf time.Time) (entity.PeriodCheck, error) {
+	check := entity.PeriodCheck{Name: entity.PERIOD_CHECK_PROVISIONING_RUN, Passed: true}
+
+	provisions, err := repository.GetLoanProvisions(ctx, period)
+	if err != nil {
+		return entity.PeriodCheck{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if len(provisions) > 0 {
+		check.Detail = fmt.Sprintf("%d loans provisioned", len(provisions))
+		return check, nil
+	}
+
+	exposures, err := repository.GetLoanExposures(ctx, asOf)
+	if err != nil {
+		return entity.PeriodCheck{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if len(exposures) > 0 {
+		check.Passed = false
+		check.Detail = "provisioning has not been run for " + period
+	}
+
+	return check, nil
+}
+
+func failedPeriodChecks(checks []entity.PeriodCheck) []string {
+	var failed []string
+	for _, check := range checks {
+		if !check.Passed {
+			failed = append(failed, string(check.Name))
+		}
+	}
+
+	return failed
+}
+
+func toPeriodCheckOutputs(checks []entity.PeriodCheck) []usecases.PeriodCheckOutput {
+	outputs := make([]usecases.PeriodCheckOutput, len(checks))
+	for i, check := range checks {
+		outputs[i] = usecases.PeriodCheckOutput{
+			Name:   string(check.Name),
+			Passed: check.Passed,
+			Detail: check.Detail,
+		}
+	}
+
+	return outputs
+}
@@ -0,0 +1,136 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCheckAccountingPeriodInteractor_Execute(t *testing.T) {
+	marchEnd := time.Date(2024, 3, 31, 0, 0, 0, 0, time.Local)
+	balanced := []entity.AccountTotal{
+		{AccountCode: entity.ACCOUNT_CASH, Debit: decimal.NewFromInt(100000), Credit: decimal.NewFromInt(1000000)},
+		{AccountCode: entity.ACCOUNT_PRINCIPAL_RECEIVABLE, Debit: decimal.NewFromInt(1000000), Credit: decimal.NewFromInt(100000)},
+	}
+	loan := entity.Loan{ID: 1, StartDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local), Status: entity.LOAN_DISBURSED}
+	installments := []entity.Installment{
+		{ID: 1, LoanID: 1, WeekNumber: 1, AmountDue: "110000.00", DueDate: "2024-03-08T00:00:00Z", Status: entity.INSTALLMENT_PAID},
+		{ID: 2, LoanID: 1, WeekNumber: 2, AmountDue: "110000.00", DueDate: "2024-04-05T00:00:00Z", Status: entity.INSTALLMENT_PENDING},
+	}
+
+	tests := []struct {
+		name           string
+		input          usecases.CheckAccountingPeriodInput
+		setupMocks     func(*billingenginemocks.MockCheckAccountingPeriodRepository)
+		expectedOutput usecases.CheckAccountingPeriodOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - every check passes",
+			input: usecases.CheckAccountingPeriodInput{Month: "2024-03"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCheckAccountingPeriodRepository) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("GetAccountTotals", mock.Anything, marchEnd).Return(balanced, nil)
+				mockRepo.On("GetLoanAccrualStates", mock.Anything).Return([]entity.LoanAccrualState{{Loan: loan, LastAccrualDate: marchEnd}}, nil)
+				mockRepo.On("GetLoanProvisions", mock.Anything, "2024-03").Return([]entity.LoanProvision{{LoanID: 1}}, nil)
+			},
+			expectedOutput: usecases.CheckAccountingPeriodOutput{
+				Month:  "2024-03",
+				Passed: true,
+				Checks: []usecases.PeriodCheckOutput{
+					{Name: "MONTH_ENDED", Passed: true},
+					{Name: "PREVIOUS_PERIOD_CLOSED", Passed: true},
+					{Name: "TRIAL_BALANCE", Passed: true, Detail: "debit 1100000.00, credit 1100000.00"},
+					{Name: "INTEREST_ACCRUED", Passed: true},
+					{Name: "PROVISIONING_RUN", Passed: true, Detail: "1 loans provisioned"},
+				},
+			},
+		},
+		{
+			name:  "success - failing checks are reported",
+			input: usecases.CheckAccountingPeriodInput{Month: "2024-03"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCheckAccountingPeriodRepository) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-01", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("GetAccountTotals", mock.Anything, marchEnd).Return([]entity.AccountTotal{
+					{AccountCode: entity.ACCOUNT_CASH, Debit: decimal.NewFromInt(100000), Credit: decimal.Zero},
+				}, nil)
+				mockRepo.On("GetLoanAccrualStates", mock.Anything).Return([]entity.LoanAccrualState{
+					{Loan: loan, LastAccrualDate: time.Date(2024, 3, 20, 0, 0, 0, 0, time.Local)},
+				}, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(1)).Return(installments, nil)
+				mockRepo.On("GetLoanProvisions", mock.Anything, "2024-03").Return(nil, nil)
+				mockRepo.On("GetLoanExposures", mock.Anything, marchEnd).Return([]entity.LoanExposure{{Loan: loan}}, nil)
+			},
+			expectedOutput: usecases.CheckAccountingPeriodOutput{
+				Month:  "2024-03",
+				Passed: false,
+				Checks: []usecases.PeriodCheckOutput{
+					{Name: "MONTH_ENDED", Passed: true},
+					{Name: "PREVIOUS_PERIOD_CLOSED", Passed: false, Detail: "the last closed period is 2024-01, 2024-02 has to be closed first"},
+					{Name: "TRIAL_BALANCE", Passed: false, Detail: "debit 100000.00, credit 0.00"},
+					{Name: "INTEREST_ACCRUED", Passed: false, Detail: "1 loans not accrued through 2024-03-31"},
+					{Name: "PROVISIONING_RUN", Passed: false, Detail: "provisioning has not been run for 2024-03"},
+				},
+			},
+		},
+		{
+			name:  "error - month already closed",
+			input: usecases.CheckAccountingPeriodInput{Month: "2024-03"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCheckAccountingPeriodRepository) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-03", Status: entity.PERIOD_CLOSED}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (invalid month)",
+			input:         usecases.CheckAccountingPeriodInput{Month: "2024-13"},
+			setupMocks:    func(*billingenginemocks.MockCheckAccountingPeriodRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on GetAccountTotals",
+			input: usecases.CheckAccountingPeriodInput{Month: "2024-03"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCheckAccountingPeriodRepository) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Status: entity.PERIOD_OPEN}, nil)
+				mockRepo.On("GetAccountTotals", mock.Anything, marchEnd).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockCheckAccountingPeriodRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewCheckAccountingPeriodInteractor(CheckAccountingPeriodInteractorDependencies{
+				CheckAccountingPeriodRepository: mockRepo,
+				Logger:                          zap.NewNop().Sugar(),
+				Validator:                       validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
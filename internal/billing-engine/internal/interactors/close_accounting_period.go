@@ -0,0 +1,95 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.CloseAccountingPeriodUsecase = (*CloseAccountingPeriodInteractor)(nil)
+
+type (
+	CloseAccountingPeriodRepository interface {
+		CheckAccountingPeriodRepository
+		CloseAccountingPeriod(ctx context.Context, period entity.AccountingPeriod) (entity.AccountingPeriod, error)
+	}
+
+	CloseAccountingPeriodInteractorDependencies struct {
+		CloseAccountingPeriodRepository CloseAccountingPeriodRepository
+		Logger                          *zap.SugaredLogger
+		Validator                       *validator.Validate
+	}
+
+	CloseAccountingPeriodInteractor struct {
+		repository CloseAccountingPeriodRepository `validate:"required"`
+		logger     *zap.SugaredLogger              `validate:"required"`
+		validator  *validator.Validate             `validate:"required"`
+	}
+)
+
+func NewCloseAccountingPeriodInteractor(
+	deps CloseAccountingPeriodInteractorDependencies,
+) *CloseAccountingPeriodInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &CloseAccountingPeriodInteractor{
+		repository: deps.CloseAccountingPeriodRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.CloseAccountingPeriodUsecase.
+//
+// The month is closed only when every consistency check passes. Once closed,
+// no payment, reversal, loan or other write can be booked on a date in the
+// month or any month before it.
+func (c *CloseAccountingPeriodInteractor) Execute(ctx context.Context, input usecases.CloseAccountingPeriodInput) (usecases.CloseAccountingPeriodOutput, error) {
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("invalid input", "error", err)
+		return usecases.CloseAccountingPeriodOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	month, err := parseMonth(input.Month)
+	if err != nil {
+		return usecases.CloseAccountingPeriodOutput{}, err
+	}
+
+	checks, err := runPeriodChecks(ctx, c.repository, month)
+	if err != nil {
+		c.logger.Errorw("failed to run period checks", "error", err, "month", input.Month)
+		return usecases.CloseAccountingPeriodOutput{}, err
+	}
+
+	if failed := failedPeriodChecks(checks); len(failed) > 0 {
+		return usecases.CloseAccountingPeriodOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("accounting period %s failed the checks: %s", input.Month, strings.Join(failed, ", ")),
+		)
+	}
+
+	period, err := c.repository.CloseAccountingPeriod(ctx, entity.AccountingPeriod{
+		Period:   entity.PeriodOf(month),
+		Status:   entity.PERIOD_CLOSED,
+		ClosedBy: input.ClosedBy,
+		ClosedAt: time.Now(),
+	})
+	if err != nil {
+		c.logger.Errorw("failed to close accounting period", "error", err, "month", input.Month)
+		return usecases.CloseAccountingPeriodOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return usecases.CloseAccountingPeriodOutput{
+		Period: toAccountingPeriodOutput(period),
+		Checks: toPeriodCheckOutputs(checks),
+	}, nil
+}
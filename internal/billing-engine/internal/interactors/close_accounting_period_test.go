@@ -0,0 +1,108 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCloseAccountingPeriodInteractor_Execute(t *testing.T) {
+	echoPeriod := func(_ context.Context, period entity.AccountingPeriod) (entity.AccountingPeriod, error) {
+		return period, nil
+	}
+
+	passingChecks := func(mockRepo *billingenginemocks.MockCloseAccountingPeriodRepository) {
+		mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Status: entity.PERIOD_OPEN}, nil)
+		mockRepo.On("GetAccountTotals", mock.Anything, mock.Anything).Return([]entity.AccountTotal{
+			{AccountCode: entity.ACCOUNT_CASH, Debit: decimal.NewFromInt(1000), Credit: decimal.Zero},
+			{AccountCode: entity.ACCOUNT_INTEREST_INCOME, Debit: decimal.Zero, Credit: decimal.NewFromInt(1000)},
+		}, nil)
+		mockRepo.On("GetLoanAccrualStates", mock.Anything).Return(nil, nil)
+		mockRepo.On("GetLoanProvisions", mock.Anything, "2024-03").Return(nil, nil)
+		mockRepo.On("GetLoanExposures", mock.Anything, mock.Anything).Return(nil, nil)
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.CloseAccountingPeriodInput
+		setupMocks    func(*billingenginemocks.MockCloseAccountingPeriodRepository)
+		expectedError error
+	}{
+		{
+			name:  "success - month closed after every check passes",
+			input: usecases.CloseAccountingPeriodInput{Month: "2024-03", ClosedBy: "finance-1"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCloseAccountingPeriodRepository) {
+				passingChecks(mockRepo)
+				mockRepo.EXPECT().CloseAccountingPeriod(mock.Anything, mock.MatchedBy(func(period entity.AccountingPeriod) bool {
+					return period.Period == "2024-03" && period.ClosedBy == "finance-1"
+				})).RunAndReturn(echoPeriod)
+			},
+		},
+		{
+			name:  "error - failing check keeps the month open",
+			input: usecases.CloseAccountingPeriodInput{Month: "2024-03", ClosedBy: "finance-1"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCloseAccountingPeriodRepository) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Status: entity.PERIOD_OPEN}, nil)
+				mockRepo.On("GetAccountTotals", mock.Anything, mock.Anything).Return([]entity.AccountTotal{
+					{AccountCode: entity.ACCOUNT_CASH, Debit: decimal.NewFromInt(1000), Credit: decimal.Zero},
+				}, nil)
+				mockRepo.On("GetLoanAccrualStates", mock.Anything).Return(nil, nil)
+				mockRepo.On("GetLoanProvisions", mock.Anything, "2024-03").Return(nil, nil)
+				mockRepo.On("GetLoanExposures", mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (missing closed_by)",
+			input:         usecases.CloseAccountingPeriodInput{Month: "2024-03"},
+			setupMocks:    func(*billingenginemocks.MockCloseAccountingPeriodRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on CloseAccountingPeriod",
+			input: usecases.CloseAccountingPeriodInput{Month: "2024-03", ClosedBy: "finance-1"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCloseAccountingPeriodRepository) {
+				passingChecks(mockRepo)
+				mockRepo.On("CloseAccountingPeriod", mock.Anything, mock.Anything).Return(entity.AccountingPeriod{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockCloseAccountingPeriodRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewCloseAccountingPeriodInteractor(CloseAccountingPeriodInteractorDependencies{
+				CloseAccountingPeriodRepository: mockRepo,
+				Logger:                          zap.NewNop().Sugar(),
+				Validator:                       validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "2024-03", output.Period.Period)
+			assert.Equal(t, "CLOSED", output.Period.Status)
+			assert.Len(t, output.Checks, 5)
+		})
+	}
+}
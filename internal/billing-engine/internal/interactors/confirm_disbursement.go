@@ -0,0 +1,113 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.ConfirmDisbursementUsecase = (*ConfirmDisbursementInteractor)(nil)
+
+type (
+	ConfirmDisbursementRepository interface {
+		DisbursementRepository
+		GetDisbursement(ctx context.Context, disbursementID uint64) (entity.Disbursement, error)
+	}
+
+	ConfirmDisbursementInteractorDependencies struct {
+		ConfirmDisbursementRepository ConfirmDisbursementRepository
+		Logger                        *zap.SugaredLogger
+		Validator                     *validator.Validate
+		SnowflakeGen                  pkguid.Snowflake
+	}
+
+	ConfirmDisbursementInteractor struct {
+		repository   ConfirmDisbursementRepository `validate:"required"`
+		logger       *zap.SugaredLogger            `validate:"required"`
+		validator    *validator.Validate           `validate:"required"`
+		snowflakeGen pkguid.Snowflake              `validate:"required"`
+	}
+)
+
+func NewConfirmDisbursementInteractor(
+	deps ConfirmDisbursementInteractorDependencies,
+) *ConfirmDisbursementInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &ConfirmDisbursementInteractor{
+		repository:   deps.ConfirmDisbursementRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.ConfirmDisbursementUsecase.
+//
+// The provider's final answer on a payout is recorded. A completed payout
+// disburses the loan on the effective date.
+func (c *ConfirmDisbursementInteractor) Execute(ctx context.Context, input usecases.ConfirmDisbursementInput) (usecases.DisbursementOutput, error) {
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("invalid input", "error", err)
+		return usecases.DisbursementOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	completedAt, err := parseEffectiveDate(input.EffectiveDate)
+	if err != nil {
+		return usecases.DisbursementOutput{}, err
+	}
+
+	disbursement, err := c.repository.GetDisbursement(ctx, input.DisbursementID)
+	if err != nil {
+		c.logger.Errorw("failed to get disbursement", "error", err, "disbursement_id", input.DisbursementID)
+		return usecases.DisbursementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	// The money cannot have reached the borrower before the payout was
+	// instructed
+	if completedAt.Before(startOfDay(disbursement.CreatedAt)) {
+		return usecases.DisbursementOutput{}, pkgerror.NewValidationError(
+			fmt.Sprintf("effective_date cannot be before the payout was instructed on %s", disbursement.CreatedAt.Format(dateLayout)),
+		)
+	}
+
+	status := entity.DisbursementStatus(input.Status)
+	if !disbursement.Status.CanTransitionTo(status) {
+		return usecases.DisbursementOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("disbursement %d is %s and cannot be %s", disbursement.ID, disbursement.Status, status),
+		)
+	}
+
+	from := disbursement.Status
+
+	if status == entity.DISBURSEMENT_COMPLETED {
+		completed, err := completeDisbursement(ctx, c.repository, c.snowflakeGen, disbursement, from, completedAt)
+		if err != nil {
+			c.logger.Errorw("failed to complete disbursement", "error", err, "disbursement_id", disbursement.ID)
+			return usecases.DisbursementOutput{}, err
+		}
+
+		return toDisbursementOutput(completed), nil
+	}
+
+	disbursement.Status = entity.DISBURSEMENT_FAILED
+	disbursement.FailureReason = input.FailureReason
+	disbursement.UpdatedAt = time.Now()
+
+	if err := c.repository.UpdateDisbursement(ctx, disbursement, from); err != nil {
+		c.logger.Errorw("failed to fail disbursement", "error", err, "disbursement_id", disbursement.ID)
+		return usecases.DisbursementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toDisbursementOutput(disbursement), nil
+}
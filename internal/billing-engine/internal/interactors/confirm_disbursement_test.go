@@ -0,0 +1,193 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestConfirmDisbursementInteractor_Execute(t *testing.T) {
+	approvedLoan := entity.Loan{
+		ID: 100, CustomerID: 1, PrincipalAmount: decimal.NewFromInt(5000000), InterestRate: decimal.NewFromFloat(0.1),
+		TermWeeks: 50, Status: entity.LOAN_APPROVED,
+	}
+	completedAt := startOfDay(time.Now()).AddDate(0, 0, -1)
+	sent := entity.Disbursement{
+		ID: 300, LoanID: 100, CustomerID: 1, Amount: decimal.NewFromInt(5000000),
+		Status: entity.DISBURSEMENT_SENT, ProviderReference: "ref-1", Attempts: 1,
+		CreatedAt: completedAt.AddDate(0, 0, -2).Add(10 * time.Hour),
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.ConfirmDisbursementInput
+		setupMocks    func(*billingenginemocks.MockConfirmDisbursementRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.DisbursementOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - completed payout disburses the loan on the effective date",
+			input: usecases.ConfirmDisbursementInput{DisbursementID: 300, Status: "COMPLETED", EffectiveDate: completedAt.Format(dateLayout)},
+			setupMocks: func(mockRepo *billingenginemocks.MockConfirmDisbursementRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetDisbursement", mock.Anything, uint64(300)).Return(sent, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Status == entity.DISBURSEMENT_COMPLETED && d.CompletedAt.Equal(completedAt)
+				}), entity.DISBURSEMENT_SENT).Return(nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("SetLoanStartDate", mock.Anything, uint64(100), completedAt).Return(nil)
+				mockRepo.On("GetLoanFees", mock.Anything, uint64(100)).Return([]entity.LoanFee(nil), nil)
+				mockRepo.On("CreateInstallmentFromLoan", mock.Anything, mock.Anything, []entity.LoanFee(nil)).Return(true, nil)
+				mockSnowflake.On("Generate").Return(uint64(400))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.Event == entity.JOURNAL_DISBURSEMENT && entry.EffectiveDate.Equal(completedAt)
+				})).Return(entity.JournalEntry{}, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.DisbursementOutput) {
+				assert.Equal(t, "COMPLETED", output.Status)
+				assert.Equal(t, completedAt.Format(time.RFC3339), output.CompletedAt)
+			},
+		},
+		{
+			name:  "error - completion rolled back when its journal entry fails",
+			input: usecases.ConfirmDisbursementInput{DisbursementID: 300, Status: "COMPLETED", EffectiveDate: completedAt.Format(dateLayout)},
+			setupMocks: func(mockRepo *billingenginemocks.MockConfirmDisbursementRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetDisbursement", mock.Anything, uint64(300)).Return(sent, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.Anything, entity.DISBURSEMENT_SENT).Return(nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("SetLoanStartDate", mock.Anything, uint64(100), completedAt).Return(nil)
+				mockRepo.On("GetLoanFees", mock.Anything, uint64(100)).Return([]entity.LoanFee(nil), nil)
+				mockRepo.On("CreateInstallmentFromLoan", mock.Anything, mock.Anything, []entity.LoanFee(nil)).Return(true, nil)
+				mockSnowflake.On("Generate").Return(uint64(400))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.Anything).Return(entity.JournalEntry{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "success - fees are charged and the deducted ones settled by the payout",
+			input: usecases.ConfirmDisbursementInput{DisbursementID: 300, Status: "COMPLETED", EffectiveDate: completedAt.Format(dateLayout)},
+			setupMocks: func(mockRepo *billingenginemocks.MockConfirmDisbursementRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				withFee := sent
+				withFee.Amount = decimal.NewFromInt(4900000)
+				withFee.FeeAmount = decimal.NewFromInt(100000)
+				fees := []entity.LoanFee{
+					{ID: 1, LoanID: 100, FeeType: entity.FEE_ORIGINATION, Collection: entity.FEE_DEDUCTED, Amount: decimal.NewFromInt(100000)},
+					{ID: 2, LoanID: 100, FeeType: entity.FEE_INSURANCE, Collection: entity.FEE_AMORTISED, Amount: decimal.NewFromInt(50000)},
+				}
+
+				mockRepo.On("GetDisbursement", mock.Anything, uint64(300)).Return(withFee, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.Anything, entity.DISBURSEMENT_SENT).Return(nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("SetLoanStartDate", mock.Anything, uint64(100), completedAt).Return(nil)
+				mockRepo.On("GetLoanFees", mock.Anything, uint64(100)).Return(fees, nil)
+				mockRepo.On("CreateInstallmentFromLoan", mock.Anything, mock.Anything, fees).Return(true, nil)
+				mockSnowflake.On("Generate").Return(uint64(400))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.Event == entity.JOURNAL_FEE && entry.Reference == "fee-origination"
+				})).Return(entity.JournalEntry{}, nil).Once()
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.Event == entity.JOURNAL_FEE && entry.Reference == "fee-insurance"
+				})).Return(entity.JournalEntry{}, nil).Once()
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					if entry.Event != entity.JOURNAL_DISBURSEMENT {
+						return false
+					}
+
+					for _, posting := range entry.Postings {
+						if posting.AccountCode == entity.ACCOUNT_CASH && !posting.Amount.Equal(decimal.NewFromInt(4900000)) {
+							return false
+						}
+					}
+
+					return true
+				})).Return(entity.JournalEntry{}, nil).Once()
+			},
+			expectedCheck: func(t *testing.T, output usecases.DisbursementOutput) {
+				assert.Equal(t, "COMPLETED", output.Status)
+			},
+		},
+		{
+			name:  "success - failed payout records the reason",
+			input: usecases.ConfirmDisbursementInput{DisbursementID: 300, Status: "FAILED", FailureReason: "account closed"},
+			setupMocks: func(mockRepo *billingenginemocks.MockConfirmDisbursementRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetDisbursement", mock.Anything, uint64(300)).Return(sent, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Status == entity.DISBURSEMENT_FAILED && d.FailureReason == "account closed"
+				}), entity.DISBURSEMENT_SENT).Return(nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.DisbursementOutput) {
+				assert.Equal(t, "FAILED", output.Status)
+				assert.Equal(t, "account closed", output.FailureReason)
+			},
+		},
+		{
+			name:          "error - validation error (failure without a reason)",
+			input:         usecases.ConfirmDisbursementInput{DisbursementID: 300, Status: "FAILED"},
+			setupMocks:    func(*billingenginemocks.MockConfirmDisbursementRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - effective date before the payout was instructed",
+			input: usecases.ConfirmDisbursementInput{DisbursementID: 300, Status: "COMPLETED", EffectiveDate: completedAt.AddDate(0, 0, -3).Format(dateLayout)},
+			setupMocks: func(mockRepo *billingenginemocks.MockConfirmDisbursementRepository, _ *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetDisbursement", mock.Anything, uint64(300)).Return(sent, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - disbursement already completed",
+			input: usecases.ConfirmDisbursementInput{DisbursementID: 300, Status: "FAILED", FailureReason: "account closed"},
+			setupMocks: func(mockRepo *billingenginemocks.MockConfirmDisbursementRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				completed := sent
+				completed.Status = entity.DISBURSEMENT_COMPLETED
+				mockRepo.On("GetDisbursement", mock.Anything, uint64(300)).Return(completed, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockConfirmDisbursementRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+
+			mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction).Maybe()
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewConfirmDisbursementInteractor(ConfirmDisbursementInteractorDependencies{
+				ConfirmDisbursementRepository: mockRepo,
+				Logger:                        zap.NewNop().Sugar(),
+				Validator:                     validator.New(),
+				SnowflakeGen:                  mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
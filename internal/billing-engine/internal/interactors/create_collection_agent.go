@@ -0,0 +1,76 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.CreateCollectionAgentUsecase = (*CreateCollectionAgentInteractor)(nil)
+
+type (
+	CreateCollectionAgentRepository interface {
+		CreateCollectionAgent(ctx context.Context, agent entity.CollectionAgent) (entity.CollectionAgent, error)
+	}
+
+	CreateCollectionAgentInteractorDependencies struct {
+		CreateCollectionAgentRepository CreateCollectionAgentRepository
+		Logger                          *zap.SugaredLogger
+		Validator                       *validator.Validate
+		SnowflakeGen                    pkguid.Snowflake
+	}
+
+	CreateCollectionAgentInteractor struct {
+		repository   CreateCollectionAgentRepository `validate:"required"`
+		logger       *zap.SugaredLogger              `validate:"required"`
+		validator    *validator.Validate             `validate:"required"`
+		snowflakeGen pkguid.Snowflake                `validate:"required"`
+	}
+)
+
+func NewCreateCollectionAgentInteractor(
+	deps CreateCollectionAgentInteractorDependencies,
+) *CreateCollectionAgentInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &CreateCollectionAgentInteractor{
+		repository:   deps.CreateCollectionAgentRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.CreateCollectionAgentUsecase.
+func (c *CreateCollectionAgentInteractor) Execute(ctx context.Context, input usecases.CreateCollectionAgentInput) (usecases.CollectionAgentOutput, error) {
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("invalid input", "error", err)
+		return usecases.CollectionAgentOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	agent, err := c.repository.CreateCollectionAgent(ctx, entity.CollectionAgent{
+		ID:     c.snowflakeGen.Generate(),
+		Name:   input.Name,
+		Bucket: entity.CollectionBucket(input.Bucket),
+		Active: true,
+	})
+	if err != nil {
+		c.logger.Errorw("failed to create collection agent", "error", err)
+		return usecases.CollectionAgentOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return usecases.CollectionAgentOutput{
+		ID:     agent.ID,
+		Name:   agent.Name,
+		Bucket: string(agent.Bucket),
+		Active: agent.Active,
+	}, nil
+}
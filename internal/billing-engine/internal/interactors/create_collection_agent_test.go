@@ -0,0 +1,79 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCreateCollectionAgentInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          usecases.CreateCollectionAgentInput
+		setupMocks     func(*billingenginemocks.MockCreateCollectionAgentRepository, *pkgmocks.MockSnowflake)
+		expectedOutput usecases.CollectionAgentOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - agent created",
+			input: usecases.CreateCollectionAgentInput{Name: "Budi", Bucket: "DPD_1_30"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateCollectionAgentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockSnowflake.On("Generate").Return(uint64(10))
+				agent := entity.CollectionAgent{ID: 10, Name: "Budi", Bucket: entity.COLLECTION_BUCKET_1_30, Active: true}
+				mockRepo.On("CreateCollectionAgent", mock.Anything, agent).Return(agent, nil)
+			},
+			expectedOutput: usecases.CollectionAgentOutput{ID: 10, Name: "Budi", Bucket: "DPD_1_30", Active: true},
+		},
+		{
+			name:          "error - invalid bucket",
+			input:         usecases.CreateCollectionAgentInput{Name: "Budi", Bucket: "DPD_1_7"},
+			setupMocks:    func(*billingenginemocks.MockCreateCollectionAgentRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error",
+			input: usecases.CreateCollectionAgentInput{Name: "Budi", Bucket: "ANY"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateCollectionAgentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockSnowflake.On("Generate").Return(uint64(11))
+				mockRepo.On("CreateCollectionAgent", mock.Anything, mock.Anything).Return(entity.CollectionAgent{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockCreateCollectionAgentRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewCreateCollectionAgentInteractor(CreateCollectionAgentInteractorDependencies{
+				CreateCollectionAgentRepository: mockRepo,
+				Logger:                          zap.NewNop().Sugar(),
+				Validator:                       validator.New(),
+				SnowflakeGen:                    mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package interactors
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
@@ -17,6 +20,8 @@ type (
 	CreateCustomerRepository interface {
 		CreateCustomer(
 			ctx context.Context, customer entity.Customer) (entity.Customer, error)
+		FindDuplicateCandidates(ctx context.Context, customer entity.Customer) ([]entity.Customer, error)
+		CreateCustomerDuplicates(ctx context.Context, duplicates []entity.CustomerDuplicate) error
 	}
 
 	CreateCustomerInteractorDependencies struct {
@@ -24,13 +29,15 @@ type (
 		Logger             *zap.SugaredLogger
 		Validator          *validator.Validate
 		SnowflakeGen       pkguid.Snowflake
+		DuplicatePolicy    entity.DuplicatePolicy // BLOCK when empty
 	}
 
 	CreateCustomerInteractor struct {
-		repository   CreateCustomerRepository `validate:"required"`
-		logger       *zap.SugaredLogger       `validate:"required"`
-		validator    *validator.Validate      `validate:"required"`
-		snowflakeGen pkguid.Snowflake         `validate:"required"`
+		repository      CreateCustomerRepository `validate:"required"`
+		logger          *zap.SugaredLogger       `validate:"required"`
+		validator       *validator.Validate      `validate:"required"`
+		snowflakeGen    pkguid.Snowflake         `validate:"required"`
+		duplicatePolicy entity.DuplicatePolicy
 	}
 )
 
@@ -44,15 +51,29 @@ func NewCreateCustomerInteractor(
 		panic(err)
 	}
 
+	duplicatePolicy := deps.DuplicatePolicy
+	switch duplicatePolicy {
+	case "":
+		duplicatePolicy = entity.DUPLICATE_POLICY_BLOCK
+	case entity.DUPLICATE_POLICY_BLOCK, entity.DUPLICATE_POLICY_REVIEW:
+	default:
+		panic(fmt.Sprintf("unknown duplicate policy %q", duplicatePolicy))
+	}
+
 	return &CreateCustomerInteractor{
-		repository:   deps.CustomerRepository,
-		logger:       deps.Logger,
-		validator:    deps.Validator,
-		snowflakeGen: deps.SnowflakeGen,
+		repository:      deps.CustomerRepository,
+		logger:          deps.Logger,
+		validator:       deps.Validator,
+		snowflakeGen:    deps.SnowflakeGen,
+		duplicatePolicy: duplicatePolicy,
 	}
 }
 
 // Execute implements usecases.CreateCustomerUsecase.
+//
+// A customer sharing the NIK, the phone, or the normalised name and date of
+// birth of an existing customer is not created under the BLOCK policy, and
+// is created with a flag per match for ops to review under the REVIEW one.
 func (c *CreateCustomerInteractor) Execute(ctx context.Context, input usecases.CreateCustomerInput) (usecases.CreateCustomerOutput, error) {
 	if err := c.validator.Struct(input); err != nil {
 		c.logger.Errorw("invalid input", "error", err)
@@ -61,11 +82,56 @@ func (c *CreateCustomerInteractor) Execute(ctx context.Context, input usecases.C
 		)
 	}
 
-	customer, err := c.repository.CreateCustomer(ctx, entity.Customer{
-		ID:    c.snowflakeGen.Generate(),
-		Name:  input.Name,
-		Email: input.Email,
-	})
+	language := entity.Language(input.Language)
+	if language == "" {
+		language = entity.LANGUAGE_INDONESIAN
+	}
+
+	dateOfBirth, err := parseOptionalDate(input.DateOfBirth)
+	if err != nil {
+		return usecases.CreateCustomerOutput{}, err
+	}
+
+	newCustomer := entity.Customer{
+		Name:        input.Name,
+		Email:       input.Email,
+		Phone:       input.Phone,
+		Language:    language,
+		NIK:         input.NIK,
+		DateOfBirth: dateOfBirth,
+		Address:     input.Address,
+		KYCStatus:   entity.KYC_UNVERIFIED,
+	}
+
+	if err := validateCustomerIdentity(newCustomer); err != nil {
+		return usecases.CreateCustomerOutput{}, err
+	}
+
+	var duplicates []entity.CustomerDuplicate
+	if newCustomer.HasDuplicateKeys() {
+		candidates, err := c.repository.FindDuplicateCandidates(ctx, newCustomer)
+		if err != nil {
+			c.logger.Errorw("failed to find duplicate candidates", "error", err)
+			return usecases.CreateCustomerOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		duplicates = entity.MatchDuplicates(newCustomer, candidates)
+	}
+
+	if len(duplicates) > 0 && c.duplicatePolicy == entity.DUPLICATE_POLICY_BLOCK {
+		matches := make([]string, len(duplicates))
+		for i, duplicate := range duplicates {
+			matches[i] = fmt.Sprintf("customer %d on %s", duplicate.MatchedCustomerID, duplicate.Match)
+		}
+
+		return usecases.CreateCustomerOutput{}, pkgerror.NewBusinessError(
+			"customer matches an existing " + strings.Join(matches, ", "),
+		)
+	}
+
+	newCustomer.ID = c.snowflakeGen.Generate()
+
+	customer, err := c.repository.CreateCustomer(ctx, newCustomer)
 
 	if err != nil {
 		c.logger.Errorw("failed to create customer", "error", err)
@@ -74,9 +140,50 @@ func (c *CreateCustomerInteractor) Execute(ctx context.Context, input usecases.C
 		)
 	}
 
+	if len(duplicates) > 0 {
+		now := time.Now()
+		for i := range duplicates {
+			duplicates[i].ID = c.snowflakeGen.Generate()
+			duplicates[i].CustomerID = customer.ID
+			duplicates[i].CreatedAt = now
+		}
+
+		if err := c.repository.CreateCustomerDuplicates(ctx, duplicates); err != nil {
+			c.logger.Errorw("failed to flag customer duplicates", "error", err, "customer_id", customer.ID)
+			return usecases.CreateCustomerOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+	}
+
+	profile := toCustomerProfileOutput(customer)
+
 	return usecases.CreateCustomerOutput{
-		ID:    customer.ID,
-		Name:  customer.Name,
-		Email: customer.Email,
+		ID:          profile.ID,
+		Name:        profile.Name,
+		Email:       profile.Email,
+		Phone:       profile.Phone,
+		Language:    profile.Language,
+		NIK:         profile.NIK,
+		DateOfBirth: profile.DateOfBirth,
+		Address:     profile.Address,
+		KYCStatus:   profile.KYCStatus,
+		Duplicates:  toCustomerDuplicateOutputs(duplicates),
 	}, nil
 }
+
+// validateCustomerIdentity checks a customer was born in the past and that
+// their NIK is valid and, when known, matches their date of birth.
+func validateCustomerIdentity(customer entity.Customer) error {
+	if customer.DateOfBirth.After(time.Now()) {
+		return pkgerror.NewValidationError("date of birth must be in the past")
+	}
+
+	if customer.NIK == "" {
+		return nil
+	}
+
+	if err := entity.ValidateNIK(customer.NIK, customer.DateOfBirth); err != nil {
+		return pkgerror.ValidationErrorFrom(err)
+	}
+
+	return nil
+}
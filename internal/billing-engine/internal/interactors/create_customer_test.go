@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
 	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
@@ -20,8 +21,10 @@ func TestCreateCustomerInteractor_Execute(t *testing.T) {
 	tests := []struct {
 		name           string
 		input          usecases.CreateCustomerInput
+		policy         entity.DuplicatePolicy
 		setupMocks     func(*billingenginemocks.MockCreateCustomerRepository, *pkgmocks.MockSnowflake)
 		expectedOutput usecases.CreateCustomerOutput
+		expectedCheck  func(*testing.T, usecases.CreateCustomerOutput)
 		expectedError  error
 	}{
 		{
@@ -50,6 +53,144 @@ func TestCreateCustomerInteractor_Execute(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "success - customer with a NIK matching their date of birth",
+			input: usecases.CreateCustomerInput{
+				Name:        "Siti Aminah",
+				Email:       "siti@example.com",
+				NIK:         "3171015705900001",
+				DateOfBirth: "1990-05-17",
+				Address:     "Jl. Merdeka No. 1, Jakarta",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateCustomerRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.EXPECT().FindDuplicateCandidates(mock.Anything, mock.Anything).Return(nil, nil)
+				mockSnowflake.On("Generate").Return(uint64(42))
+				mockRepo.EXPECT().CreateCustomer(mock.Anything, mock.MatchedBy(func(customer entity.Customer) bool {
+					return customer.NIK == "3171015705900001" && customer.DateOfBirth.Format("2006-01-02") == "1990-05-17" &&
+						customer.KYCStatus == entity.KYC_UNVERIFIED && customer.Language == entity.LANGUAGE_INDONESIAN
+				})).RunAndReturn(func(_ context.Context, customer entity.Customer) (entity.Customer, error) { return customer, nil })
+			},
+			expectedOutput: usecases.CreateCustomerOutput{
+				ID:          42,
+				Name:        "Siti Aminah",
+				Email:       "siti@example.com",
+				Language:    "id",
+				NIK:         "3171015705900001",
+				DateOfBirth: "1990-05-17",
+				Address:     "Jl. Merdeka No. 1, Jakarta",
+				KYCStatus:   "UNVERIFIED",
+			},
+		},
+		{
+			name:   "business error - NIK of an existing customer under the block policy",
+			policy: entity.DUPLICATE_POLICY_BLOCK,
+			input: usecases.CreateCustomerInput{
+				Name:        "Siti Aminah",
+				Email:       "siti.new@example.com",
+				NIK:         "3171015705900001",
+				DateOfBirth: "1990-05-17",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateCustomerRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.EXPECT().FindDuplicateCandidates(mock.Anything, mock.MatchedBy(func(customer entity.Customer) bool {
+					return customer.NIK == "3171015705900001"
+				})).Return([]entity.Customer{
+					{ID: 7, Name: "Siti Aminah", Email: "siti@example.com", NIK: "3171015705900001"},
+				}, nil)
+			},
+			expectedOutput: usecases.CreateCustomerOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name:   "success - phone, name and date of birth of existing customers flagged under the review policy",
+			policy: entity.DUPLICATE_POLICY_REVIEW,
+			input: usecases.CreateCustomerInput{
+				Name:        "SITI  aminah.",
+				Email:       "siti.new@example.com",
+				Phone:       "+6281234567890",
+				DateOfBirth: "1990-05-17",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateCustomerRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.EXPECT().FindDuplicateCandidates(mock.Anything, mock.Anything).Return([]entity.Customer{
+					{ID: 7, Name: "Budi Santoso", Phone: "+6281234567890"},
+					{ID: 8, Name: "Siti Aminah", DateOfBirth: time.Date(1990, 5, 17, 0, 0, 0, 0, time.Local)},
+					{ID: 9, Name: "Siti Rahma", DateOfBirth: time.Date(1990, 5, 17, 0, 0, 0, 0, time.Local)},
+				}, nil)
+				mockSnowflake.On("Generate").Return(uint64(42)).Once()
+				mockSnowflake.On("Generate").Return(uint64(43)).Once()
+				mockSnowflake.On("Generate").Return(uint64(44)).Once()
+				mockRepo.EXPECT().CreateCustomer(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, customer entity.Customer) (entity.Customer, error) { return customer, nil })
+				mockRepo.EXPECT().CreateCustomerDuplicates(mock.Anything, mock.MatchedBy(func(duplicates []entity.CustomerDuplicate) bool {
+					return len(duplicates) == 2 &&
+						duplicates[0].ID == 43 && duplicates[0].CustomerID == 42 && duplicates[0].MatchedCustomerID == 7 &&
+						duplicates[0].Match == entity.DUPLICATE_PHONE && duplicates[0].Status == entity.DUPLICATE_OPEN &&
+						duplicates[1].ID == 44 && duplicates[1].MatchedCustomerID == 8 && duplicates[1].Match == entity.DUPLICATE_NAME_DATE_OF_BIRTH
+				})).Return(nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CreateCustomerOutput) {
+				assert.Equal(t, uint64(42), output.ID)
+				if assert.Len(t, output.Duplicates, 2) {
+					assert.Equal(t, "PHONE", output.Duplicates[0].Match)
+					assert.Equal(t, "NAME_DATE_OF_BIRTH", output.Duplicates[1].Match)
+					assert.Equal(t, "OPEN", output.Duplicates[1].Status)
+				}
+			},
+		},
+		{
+			name:   "success - deleted customers are not matched",
+			policy: entity.DUPLICATE_POLICY_BLOCK,
+			input: usecases.CreateCustomerInput{
+				Name:  "Siti Aminah",
+				Email: "siti.new@example.com",
+				Phone: "+6281234567890",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateCustomerRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.EXPECT().FindDuplicateCandidates(mock.Anything, mock.Anything).Return([]entity.Customer{
+					{ID: 7, Phone: "+6281234567890", DeletedAt: time.Now()},
+				}, nil)
+				mockSnowflake.On("Generate").Return(uint64(42))
+				mockRepo.EXPECT().CreateCustomer(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, customer entity.Customer) (entity.Customer, error) { return customer, nil })
+			},
+			expectedCheck: func(t *testing.T, output usecases.CreateCustomerOutput) {
+				assert.Equal(t, uint64(42), output.ID)
+				assert.Empty(t, output.Duplicates)
+			},
+		},
+		{
+			name: "validation error - NIK birth date does not match the date of birth",
+			input: usecases.CreateCustomerInput{
+				Name:        "Siti Aminah",
+				Email:       "siti@example.com",
+				NIK:         "3171015705900001",
+				DateOfBirth: "1990-05-18",
+			},
+			setupMocks:     func(*billingenginemocks.MockCreateCustomerRepository, *pkgmocks.MockSnowflake) {},
+			expectedOutput: usecases.CreateCustomerOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name: "validation error - NIK with an unknown province code",
+			input: usecases.CreateCustomerInput{
+				Name:  "Siti Aminah",
+				Email: "siti@example.com",
+				NIK:   "9971011705900001",
+			},
+			setupMocks:     func(*billingenginemocks.MockCreateCustomerRepository, *pkgmocks.MockSnowflake) {},
+			expectedOutput: usecases.CreateCustomerOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name: "validation error - NIK encoding an impossible birth date",
+			input: usecases.CreateCustomerInput{
+				Name:  "Siti Aminah",
+				Email: "siti@example.com",
+				NIK:   "3171013102900001",
+			},
+			setupMocks:     func(*billingenginemocks.MockCreateCustomerRepository, *pkgmocks.MockSnowflake) {},
+			expectedOutput: usecases.CreateCustomerOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
 		{
 			name: "validation error - empty name",
 			input: usecases.CreateCustomerInput{
@@ -141,6 +282,7 @@ func TestCreateCustomerInteractor_Execute(t *testing.T) {
 				Logger:             logger,
 				Validator:          validator,
 				SnowflakeGen:       mockSnowflake,
+				DuplicatePolicy:    tt.policy,
 			})
 
 			// Execute
@@ -150,6 +292,9 @@ func TestCreateCustomerInteractor_Execute(t *testing.T) {
 			if tt.expectedError != nil {
 				assert.Error(t, err)
 				assert.IsType(t, tt.expectedError, err)
+			} else if tt.expectedCheck != nil {
+				assert.NoError(t, err)
+				tt.expectedCheck(t, output)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expectedOutput, output)
@@ -208,6 +353,17 @@ func TestNewCreateCustomerInteractor(t *testing.T) {
 			},
 			expectPanic: false,
 		},
+		{
+			name: "panic - unknown duplicate policy",
+			deps: CreateCustomerInteractorDependencies{
+				CustomerRepository: billingenginemocks.NewMockCreateCustomerRepository(t),
+				Logger:             zap.NewNop().Sugar(),
+				Validator:          validator.New(),
+				SnowflakeGen:       pkgmocks.NewMockSnowflake(t),
+				DuplicatePolicy:    "WARN",
+			},
+			expectPanic: true,
+		},
 		{
 			name: "success - nil snowflake generator (no validation on dependencies struct)",
 			deps: CreateCustomerInteractorDependencies{
@@ -2,6 +2,8 @@ package interactors
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
@@ -17,20 +19,24 @@ var _ usecases.CreateLoanUsecase = (*CreateLoanInteractor)(nil)
 type (
 	CreateLoanRepository interface {
 		IsCustomerExist(ctx context.Context, customerID uint64) (bool, error)
-		IsCustomerHasNonPaidLoan(ctx context.Context, customerID uint64) (bool, error)
-		CreateLoan(ctx context.Context, loan entity.Loan) (entity.Loan, error)
-		CreateInstallmentFromLoan(ctx context.Context, loan *entity.Loan) (bool, error)
+		CreateLoanApplication(ctx context.Context, loan entity.Loan) (entity.Loan, error)
+		CreateLoanDisclosure(ctx context.Context, disclosure entity.LoanDisclosure) error
+		ProductFeeRepository
+		EligibilityRepository
+		MerchantOrderRepository
 	}
 
 	CreateLoanInteractorDependencies struct {
 		CreateLoanRepository CreateLoanRepository
 		Logger               *zap.SugaredLogger
+		Validator            *validator.Validate
 		SnowflakeGen         pkguid.Snowflake
 	}
 
 	CreateLoanInteractor struct {
 		repository   CreateLoanRepository `validate:"required"`
 		logger       *zap.SugaredLogger   `validate:"required"`
+		validator    *validator.Validate  `validate:"required"`
 		snowflakeGen pkguid.Snowflake     `validate:"required"`
 	}
 )
@@ -46,76 +52,108 @@ func NewCreateLoanInteractor(
 	return &CreateLoanInteractor{
 		repository:   deps.CreateLoanRepository,
 		logger:       deps.Logger,
+		validator:    deps.Validator,
 		snowflakeGen: deps.SnowflakeGen,
 	}
 }
 
 // Execute implements usecases.CreateLoanUsecase.
-func (c *CreateLoanInteractor) Execute(ctx context.Context, customerID uint64) (usecases.CreateLoanOutput, error) {
+//
+// The loan is only applied for here. It is booked once it is approved and
+// disbursed.
+func (c *CreateLoanInteractor) Execute(ctx context.Context, input usecases.CreateLoanInput) (usecases.LoanOutput, error) {
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("invalid input", "error", err)
+		return usecases.LoanOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
 
-	isCustomerExist, err := c.repository.IsCustomerExist(ctx, customerID)
+	isCustomerExist, err := c.repository.IsCustomerExist(ctx, input.CustomerID)
 	if err != nil {
 		c.logger.Error("failed to check if customer exist", zap.Error(err))
-		return usecases.CreateLoanOutput{}, pkgerror.BusinessErrorFrom(
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(
 			err,
 		)
 	}
 
 	if !isCustomerExist {
-		return usecases.CreateLoanOutput{}, pkgerror.NewBusinessError(
+		return usecases.LoanOutput{}, pkgerror.NewBusinessError(
 			"customer not found",
 		)
 	}
 
-	// check if customer has non paid loan
-	// this to mitigate the case where customer has non paid loan
-	// and then create a new loan, the installment will be created
-	// but the loan will be paid, which is not what we want
-	isCustomerHasNonPaidLoan, err := c.repository.IsCustomerHasNonPaidLoan(ctx, customerID)
+	loan := entity.NewLoanApplication(input.CustomerID, input.RequestedBy)
+	if err := applyRequestedTerms(loan, input.ProductCode, input.PrincipalAmount, input.TermWeeks); err != nil {
+		return usecases.LoanOutput{}, err
+	}
+
+	if err := tagMerchantOrder(ctx, c.repository, loan, input.MerchantID, input.OrderRef); err != nil {
+		c.logger.Errorw("failed to tag merchant order", "error", err, "merchant_id", input.MerchantID)
+		return usecases.LoanOutput{}, err
+	}
+
+	checks, err := runEligibilityChecks(ctx, c.repository, input.CustomerID, loan.PrincipalAmount)
 	if err != nil {
-		c.logger.Error("failed to check if customer has non paid loan", zap.Error(err))
-		return usecases.CreateLoanOutput{}, pkgerror.BusinessErrorFrom(
-			err,
-		)
+		c.logger.Error("failed to run eligibility checks", zap.Error(err))
+		return usecases.LoanOutput{}, err
 	}
 
-	if isCustomerHasNonPaidLoan {
-		return usecases.CreateLoanOutput{}, pkgerror.NewBusinessError(
-			"customer has non paid loan",
+	if failed := failedEligibilityChecks(checks); len(failed) > 0 {
+		return usecases.LoanOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("customer %d is not eligible for a loan: %s", input.CustomerID, strings.Join(failed, ", ")),
 		)
 	}
 
-	loan := entity.NewDisbursedLoan(customerID)
+	// The borrower is shown the full cost of the loan they apply for, which
+	// is kept as its disclosed terms
+	now := time.Now()
+	quote, err := quoteLoan(ctx, c.repository, *loan, startOfDay(now))
+	if err != nil {
+		c.logger.Error("failed to quote loan", zap.Error(err))
+		return usecases.LoanOutput{}, err
+	}
+
 	loan.ID = c.snowflakeGen.Generate()
-	createdLoan, err := c.repository.CreateLoan(ctx, *loan)
+
+	createdLoan, err := c.repository.CreateLoanApplication(ctx, *loan)
 	if err != nil {
-		c.logger.Error("failed to create loan", zap.Error(err))
-		return usecases.CreateLoanOutput{}, pkgerror.BusinessErrorFrom(
+		c.logger.Error("failed to create loan application", zap.Error(err))
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(
 			err,
 		)
 	}
 
-	createInstallment, err := c.repository.CreateInstallmentFromLoan(ctx, &createdLoan)
-	if err != nil {
-		c.logger.Error("failed to create installment from loan", zap.Error(err))
-		return usecases.CreateLoanOutput{}, pkgerror.BusinessErrorFrom(
+	disclosure := entity.NewLoanDisclosure(createdLoan.ID, quote, now)
+	if err := c.repository.CreateLoanDisclosure(ctx, disclosure); err != nil {
+		c.logger.Error("failed to create loan disclosure", zap.Error(err))
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(
 			err,
 		)
 	}
 
-	if !createInstallment {
-		return usecases.CreateLoanOutput{}, pkgerror.NewBusinessError(
-			"failed to create installment from loan",
-		)
+	output := toLoanOutput(createdLoan)
+	disclosureOutput := toLoanDisclosureOutput(disclosure)
+	output.Disclosure = &disclosureOutput
+
+	return output, nil
+}
+
+func toLoanOutput(loan entity.Loan) usecases.LoanOutput {
+	output := usecases.LoanOutput{
+		ID:              loan.ID,
+		CustomerID:      loan.CustomerID,
+		PrincipalAmount: loan.PrincipalAmount.String(),
+		InterestRate:    loan.InterestRate.String(),
+		TermWeeks:       loan.TermWeeks,
+		Status:          string(loan.Status),
+		RequestedBy:     loan.RequestedBy,
+		ProductCode:     loan.ProductCode,
+		CreditLineID:    loan.CreditLineID,
+		MerchantID:      loan.MerchantID,
+		OrderRef:        loan.OrderRef,
+	}
+	if !loan.StartDate.IsZero() {
+		output.StartDate = loan.StartDate.Format(time.RFC3339)
 	}
 
-	return usecases.CreateLoanOutput{
-		ID:              createdLoan.ID,
-		CustomerID:      createdLoan.CustomerID,
-		PrincipalAmount: createdLoan.PrincipalAmount.String(),
-		InterestRate:    createdLoan.InterestRate.String(),
-		TermWeeks:       createdLoan.TermWeeks,
-		StartDate:       createdLoan.StartDate.Format(time.RFC3339),
-		Status:          string(createdLoan.Status),
-	}, nil
+	return output
 }
@@ -4,13 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
-	"time"
 
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
 	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
@@ -19,140 +20,318 @@ import (
 func TestCreateLoanInteractor_Execute(t *testing.T) {
 	tests := []struct {
 		name           string
-		customerID     uint64
+		input          usecases.CreateLoanInput
 		setupMocks     func(*billingenginemocks.MockCreateLoanRepository, *pkgmocks.MockSnowflake)
-		expectedOutput usecases.CreateLoanOutput
+		expectedOutput usecases.LoanOutput
 		expectedError  error
 	}{
 		{
-			name:       "success - loan created successfully",
-			customerID: 123,
+			name:  "success - loan application created successfully",
+			input: usecases.CreateLoanInput{CustomerID: 123, RequestedBy: "sales-agent"},
 			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(123)).Return(true, nil)
-				mockRepo.On("IsCustomerHasNonPaidLoan", mock.Anything, uint64(123)).Return(false, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(123)).Return(entity.DefaultCreditLimit(123), nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(123)).Return(entity.CustomerExposure{CustomerID: 123}, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(123)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
 				mockSnowflake.On("Generate").Return(uint64(999))
 
-				loan := entity.NewDisbursedLoan(123)
+				loan := entity.NewLoanApplication(123, "sales-agent")
 				loan.ID = 999
-				createdLoan := *loan
-				mockRepo.On("CreateLoan", mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
-					return loan.CustomerID == 123 && loan.ID == 999 && loan.Status == entity.LOAN_DISBURSED
-				})).Return(createdLoan, nil)
-				mockRepo.On("CreateInstallmentFromLoan", mock.Anything, mock.MatchedBy(func(loan *entity.Loan) bool {
-					return loan.CustomerID == 123 && loan.ID == 999 && loan.Status == entity.LOAN_DISBURSED
-				})).Return(true, nil)
-			},
-			expectedOutput: func() usecases.CreateLoanOutput {
-				loan := entity.NewDisbursedLoan(123)
-				loan.ID = 999
-				return usecases.CreateLoanOutput{
+				mockRepo.On("CreateLoanApplication", mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
+					return loan.CustomerID == 123 && loan.ID == 999 && loan.Status == entity.LOAN_APPLIED &&
+						loan.RequestedBy == "sales-agent" && loan.StartDate.IsZero()
+				})).Return(*loan, nil)
+				mockRepo.On("CreateLoanDisclosure", mock.Anything, mock.MatchedBy(func(disclosure entity.LoanDisclosure) bool {
+					return disclosure.LoanID == 999 && disclosure.TotalRepayment.Equal(decimal.NewFromInt(5500000))
+				})).Return(nil)
+			},
+			expectedOutput: func() usecases.LoanOutput {
+				loan := entity.NewLoanApplication(123, "sales-agent")
+				return usecases.LoanOutput{
 					ID:              999,
 					CustomerID:      123,
 					PrincipalAmount: loan.PrincipalAmount.String(),
 					InterestRate:    loan.InterestRate.String(),
 					TermWeeks:       loan.TermWeeks,
-					StartDate:       loan.StartDate.Format(time.RFC3339),
-					Status:          string(loan.Status),
+					Status:          "APPLIED",
+					RequestedBy:     "sales-agent",
+					ProductCode:     entity.DEFAULT_LOAN_PRODUCT,
+					Disclosure: &usecases.LoanDisclosureOutput{
+						LoanID:              999,
+						ProductCode:         entity.DEFAULT_LOAN_PRODUCT,
+						PrincipalAmount:     "5000000.00",
+						InterestRate:        "0.1",
+						TermWeeks:           50,
+						AmountReceived:      "5000000.00",
+						TotalInterest:       "500000.00",
+						TotalFees:           "0.00",
+						TotalRepayment:      "5500000.00",
+						EffectiveAnnualRate: "0.2183",
+					},
 				}
 			}(),
 			expectedError: nil,
 		},
 		{
-			name:       "error - customer not found",
-			customerID: 124,
+			name: "success - requested terms are applied and disclosed with the product fees",
+			input: usecases.CreateLoanInput{
+				CustomerID: 123, RequestedBy: "sales-agent", ProductCode: "PAYDAY", PrincipalAmount: "1000000", TermWeeks: 10,
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(123)).Return(true, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(123)).Return(entity.DefaultCreditLimit(123), nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(123)).Return(entity.CustomerExposure{CustomerID: 123}, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(123)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, "PAYDAY").Return([]entity.ProductFee{
+					{ProductCode: "PAYDAY", FeeType: entity.FEE_ORIGINATION, Calculation: entity.FEE_PERCENTAGE, Value: decimal.NewFromFloat(0.03), Collection: entity.FEE_DEDUCTED},
+				}, nil)
+				mockSnowflake.On("Generate").Return(uint64(999))
+				mockRepo.On("CreateLoanApplication", mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
+					return loan.ProductCode == "PAYDAY" && loan.PrincipalAmount.Equal(decimal.NewFromInt(1000000)) && loan.TermWeeks == 10
+				})).Return(entity.Loan{
+					ID: 999, CustomerID: 123, PrincipalAmount: decimal.NewFromInt(1000000), InterestRate: decimal.NewFromFloat(0.1),
+					TermWeeks: 10, Status: entity.LOAN_APPLIED, ProductCode: "PAYDAY", RequestedBy: "sales-agent",
+				}, nil)
+				mockRepo.On("CreateLoanDisclosure", mock.Anything, mock.MatchedBy(func(disclosure entity.LoanDisclosure) bool {
+					return disclosure.AmountReceived.Equal(decimal.NewFromInt(970000)) && disclosure.TotalFees.Equal(decimal.NewFromInt(30000)) &&
+						disclosure.TotalRepayment.Equal(decimal.NewFromInt(1100000))
+				})).Return(nil)
+			},
+			expectedOutput: usecases.LoanOutput{
+				ID:              999,
+				CustomerID:      123,
+				PrincipalAmount: "1000000",
+				InterestRate:    "0.1",
+				TermWeeks:       10,
+				Status:          "APPLIED",
+				RequestedBy:     "sales-agent",
+				ProductCode:     "PAYDAY",
+				Disclosure: &usecases.LoanDisclosureOutput{
+					LoanID:              999,
+					ProductCode:         "PAYDAY",
+					PrincipalAmount:     "1000000.00",
+					InterestRate:        "0.1",
+					TermWeeks:           10,
+					AmountReceived:      "970000.00",
+					TotalInterest:       "100000.00",
+					TotalFees:           "30000.00",
+					TotalRepayment:      "1100000.00",
+					EffectiveAnnualRate: "2.3542",
+				},
+			},
+		},
+		{
+			name:  "error - principal with fractions of a cent",
+			input: usecases.CreateLoanInput{CustomerID: 123, RequestedBy: "sales-agent", PrincipalAmount: "1000.001"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(123)).Return(true, nil)
+			},
+			expectedOutput: usecases.LoanOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name:           "error - validation error (missing requested_by)",
+			input:          usecases.CreateLoanInput{CustomerID: 123},
+			setupMocks:     func(*billingenginemocks.MockCreateLoanRepository, *pkgmocks.MockSnowflake) {},
+			expectedOutput: usecases.LoanOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer not found",
+			input: usecases.CreateLoanInput{CustomerID: 124, RequestedBy: "sales-agent"},
 			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(124)).Return(false, nil)
 			},
-			expectedOutput: usecases.CreateLoanOutput{},
+			expectedOutput: usecases.LoanOutput{},
 			expectedError:  &pkgerror.Error{},
 		},
 		{
-			name:       "error - customer has non paid loan",
-			customerID: 125,
+			name:  "success - loan sold at a merchant's checkout is tagged with the order",
+			input: usecases.CreateLoanInput{CustomerID: 126, RequestedBy: "sales-agent", PrincipalAmount: "2000000", TermWeeks: 10, MerchantID: 900, OrderRef: "ORD-1"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(126)).Return(true, nil)
+				mockRepo.On("GetMerchant", mock.Anything, uint64(900)).Return(entity.Merchant{ID: 900, Code: "TOKO"}, nil)
+				mockRepo.On("IsMerchantOrderExist", mock.Anything, uint64(900), "ORD-1").Return(false, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(126)).Return(entity.DefaultCreditLimit(126), nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(126)).Return(entity.CustomerExposure{CustomerID: 126}, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(126)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
+				mockSnowflake.On("Generate").Return(uint64(778))
+				mockRepo.EXPECT().CreateLoanApplication(mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
+					return loan.ID == 778 && loan.MerchantID == 900 && loan.OrderRef == "ORD-1"
+				})).RunAndReturn(func(_ context.Context, loan entity.Loan) (entity.Loan, error) {
+					return loan, nil
+				})
+				mockRepo.On("CreateLoanDisclosure", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedOutput: usecases.LoanOutput{
+				ID:              778,
+				CustomerID:      126,
+				PrincipalAmount: "2000000",
+				InterestRate:    "0.1",
+				TermWeeks:       10,
+				Status:          "APPLIED",
+				RequestedBy:     "sales-agent",
+				ProductCode:     entity.DEFAULT_LOAN_PRODUCT,
+				MerchantID:      900,
+				OrderRef:        "ORD-1",
+				Disclosure: &usecases.LoanDisclosureOutput{
+					LoanID:              778,
+					ProductCode:         entity.DEFAULT_LOAN_PRODUCT,
+					PrincipalAmount:     "2000000.00",
+					InterestRate:        "0.1",
+					TermWeeks:           10,
+					AmountReceived:      "2000000.00",
+					TotalInterest:       "200000.00",
+					TotalFees:           "0.00",
+					TotalRepayment:      "2200000.00",
+					EffectiveAnnualRate: "1.4921",
+				},
+			},
+		},
+		{
+			name:  "error - merchant order already financed",
+			input: usecases.CreateLoanInput{CustomerID: 126, RequestedBy: "sales-agent", MerchantID: 900, OrderRef: "ORD-1"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(126)).Return(true, nil)
+				mockRepo.On("GetMerchant", mock.Anything, uint64(900)).Return(entity.Merchant{ID: 900, Code: "TOKO"}, nil)
+				mockRepo.On("IsMerchantOrderExist", mock.Anything, uint64(900), "ORD-1").Return(true, nil)
+			},
+			expectedOutput: usecases.LoanOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name:  "error - order reference without a merchant",
+			input: usecases.CreateLoanInput{CustomerID: 126, RequestedBy: "sales-agent", OrderRef: "ORD-1"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(126)).Return(true, nil)
+			},
+			expectedOutput: usecases.LoanOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name:  "success - customer with an open loan applies within their limit",
+			input: usecases.CreateLoanInput{CustomerID: 125, RequestedBy: "sales-agent", PrincipalAmount: "2000000", TermWeeks: 10},
 			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(125)).Return(true, nil)
-				mockRepo.On("IsCustomerHasNonPaidLoan", mock.Anything, uint64(125)).Return(true, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(125)).Return(entity.DefaultCreditLimit(125), nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(125)).Return(entity.CustomerExposure{
+					CustomerID: 125, ActiveLoans: 1, Outstanding: decimal.NewFromInt(8000000), Committed: decimal.Zero,
+				}, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(125)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
+				mockSnowflake.On("Generate").Return(uint64(777))
+				mockRepo.On("CreateLoanApplication", mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
+					return loan.CustomerID == 125 && loan.PrincipalAmount.Equal(decimal.NewFromInt(2000000))
+				})).Return(entity.Loan{
+					ID: 777, CustomerID: 125, PrincipalAmount: decimal.NewFromInt(2000000), InterestRate: decimal.NewFromFloat(0.1),
+					TermWeeks: 10, Status: entity.LOAN_APPLIED, ProductCode: entity.DEFAULT_LOAN_PRODUCT, RequestedBy: "sales-agent",
+				}, nil)
+				mockRepo.On("CreateLoanDisclosure", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedOutput: usecases.LoanOutput{
+				ID:              777,
+				CustomerID:      125,
+				PrincipalAmount: "2000000",
+				InterestRate:    "0.1",
+				TermWeeks:       10,
+				Status:          "APPLIED",
+				RequestedBy:     "sales-agent",
+				ProductCode:     entity.DEFAULT_LOAN_PRODUCT,
+				Disclosure: &usecases.LoanDisclosureOutput{
+					LoanID:              777,
+					ProductCode:         entity.DEFAULT_LOAN_PRODUCT,
+					PrincipalAmount:     "2000000.00",
+					InterestRate:        "0.1",
+					TermWeeks:           10,
+					AmountReceived:      "2000000.00",
+					TotalInterest:       "200000.00",
+					TotalFees:           "0.00",
+					TotalRepayment:      "2200000.00",
+					EffectiveAnnualRate: "1.4921",
+				},
 			},
-			expectedOutput: usecases.CreateLoanOutput{},
+		},
+		{
+			name:  "error - customer reached their active loan limit",
+			input: usecases.CreateLoanInput{CustomerID: 125, RequestedBy: "sales-agent"},
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(125)).Return(true, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(125)).Return(entity.CreditLimit{
+					CustomerID: 125, Limit: decimal.NewFromInt(50000000), MaxActiveLoans: 1,
+				}, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(125)).Return(entity.CustomerExposure{
+					CustomerID: 125, ActiveLoans: 1, Committed: decimal.NewFromInt(1000000),
+				}, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(125)).Return(false, nil)
+			},
+			expectedOutput: usecases.LoanOutput{},
 			expectedError:  &pkgerror.Error{},
 		},
 		{
-			name:       "error - repository error on IsCustomerExist",
-			customerID: 126,
+			name:  "error - principal exceeds what is left of the credit limit",
+			input: usecases.CreateLoanInput{CustomerID: 125, RequestedBy: "sales-agent"},
 			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
-				repoErr := errors.New("db error")
-				mockRepo.On("IsCustomerExist", mock.Anything, uint64(126)).Return(false, repoErr)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(125)).Return(true, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(125)).Return(entity.DefaultCreditLimit(125), nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(125)).Return(entity.CustomerExposure{
+					CustomerID: 125, ActiveLoans: 1, Outstanding: decimal.NewFromInt(5500000),
+				}, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(125)).Return(false, nil)
 			},
-			expectedOutput: usecases.CreateLoanOutput{},
+			expectedOutput: usecases.LoanOutput{},
 			expectedError:  &pkgerror.Error{},
 		},
 		{
-			name:       "error - repository error on IsCustomerHasNonPaidLoan",
-			customerID: 127,
+			name:  "error - customer has a written off loan",
+			input: usecases.CreateLoanInput{CustomerID: 125, RequestedBy: "sales-agent"},
 			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
-				mockRepo.On("IsCustomerExist", mock.Anything, uint64(127)).Return(true, nil)
-				repoErr := errors.New("db error")
-				mockRepo.On("IsCustomerHasNonPaidLoan", mock.Anything, uint64(127)).Return(false, repoErr)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(125)).Return(true, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(125)).Return(entity.DefaultCreditLimit(125), nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(125)).Return(entity.CustomerExposure{CustomerID: 125}, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(125)).Return(true, nil)
 			},
-			expectedOutput: usecases.CreateLoanOutput{},
+			expectedOutput: usecases.LoanOutput{},
 			expectedError:  &pkgerror.Error{},
 		},
 		{
-			name:       "error - repository error on CreateLoan",
-			customerID: 128,
+			name:  "error - repository error on IsCustomerExist",
+			input: usecases.CreateLoanInput{CustomerID: 126, RequestedBy: "sales-agent"},
 			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
-				mockRepo.On("IsCustomerExist", mock.Anything, uint64(128)).Return(true, nil)
-				mockRepo.On("IsCustomerHasNonPaidLoan", mock.Anything, uint64(128)).Return(false, nil)
-				mockSnowflake.On("Generate").Return(uint64(888))
-				loan := entity.NewDisbursedLoan(128)
-				loan.ID = 888
 				repoErr := errors.New("db error")
-				mockRepo.On("CreateLoan", mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
-					return loan.CustomerID == 128 && loan.ID == 888 && loan.Status == entity.LOAN_DISBURSED
-				})).Return(entity.Loan{}, repoErr)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(126)).Return(false, repoErr)
 			},
-			expectedOutput: usecases.CreateLoanOutput{},
+			expectedOutput: usecases.LoanOutput{},
 			expectedError:  &pkgerror.Error{},
 		},
 		{
-			name:       "error - repository error on CreateInstallmentFromLoan",
-			customerID: 129,
+			name:  "error - repository error on GetCreditLimit",
+			input: usecases.CreateLoanInput{CustomerID: 127, RequestedBy: "sales-agent"},
 			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
-				mockRepo.On("IsCustomerExist", mock.Anything, uint64(129)).Return(true, nil)
-				mockRepo.On("IsCustomerHasNonPaidLoan", mock.Anything, uint64(129)).Return(false, nil)
-				mockSnowflake.On("Generate").Return(uint64(777))
-				loan := entity.NewDisbursedLoan(129)
-				loan.ID = 777
-				createdLoan := *loan
-				mockRepo.On("CreateLoan", mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
-					return loan.CustomerID == 129 && loan.ID == 777 && loan.Status == entity.LOAN_DISBURSED
-				})).Return(createdLoan, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(127)).Return(true, nil)
 				repoErr := errors.New("db error")
-				mockRepo.On("CreateInstallmentFromLoan", mock.Anything, mock.MatchedBy(func(loan *entity.Loan) bool {
-					return loan.CustomerID == 129 && loan.ID == 777 && loan.Status == entity.LOAN_DISBURSED
-				})).Return(false, repoErr)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(127)).Return(entity.CreditLimit{}, repoErr)
 			},
-			expectedOutput: usecases.CreateLoanOutput{},
+			expectedOutput: usecases.LoanOutput{},
 			expectedError:  &pkgerror.Error{},
 		},
 		{
-			name:       "error - failed to create installment from loan",
-			customerID: 130,
+			name:  "error - repository error on CreateLoanApplication",
+			input: usecases.CreateLoanInput{CustomerID: 128, RequestedBy: "sales-agent"},
 			setupMocks: func(mockRepo *billingenginemocks.MockCreateLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
-				mockRepo.On("IsCustomerExist", mock.Anything, uint64(130)).Return(true, nil)
-				mockRepo.On("IsCustomerHasNonPaidLoan", mock.Anything, uint64(130)).Return(false, nil)
-				mockSnowflake.On("Generate").Return(uint64(666))
-				loan := entity.NewDisbursedLoan(130)
-				loan.ID = 666
-				createdLoan := *loan
-				mockRepo.On("CreateLoan", mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
-					return loan.CustomerID == 130 && loan.ID == 666 && loan.Status == entity.LOAN_DISBURSED
-				})).Return(createdLoan, nil)
-				mockRepo.On("CreateInstallmentFromLoan", mock.Anything, mock.MatchedBy(func(loan *entity.Loan) bool {
-					return loan.CustomerID == 130 && loan.ID == 666 && loan.Status == entity.LOAN_DISBURSED
-				})).Return(false, nil)
-			},
-			expectedOutput: usecases.CreateLoanOutput{},
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(128)).Return(true, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(128)).Return(entity.DefaultCreditLimit(128), nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(128)).Return(entity.CustomerExposure{CustomerID: 128}, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(128)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
+				mockSnowflake.On("Generate").Return(uint64(888))
+				repoErr := errors.New("db error")
+				mockRepo.On("CreateLoanApplication", mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
+					return loan.CustomerID == 128 && loan.ID == 888 && loan.Status == entity.LOAN_APPLIED
+				})).Return(entity.Loan{}, repoErr)
+			},
+			expectedOutput: usecases.LoanOutput{},
 			expectedError:  &pkgerror.Error{},
 		},
 	}
@@ -168,16 +347,20 @@ func TestCreateLoanInteractor_Execute(t *testing.T) {
 			interactor := NewCreateLoanInteractor(CreateLoanInteractorDependencies{
 				CreateLoanRepository: mockRepo,
 				Logger:               logger,
+				Validator:            validator.New(),
 				SnowflakeGen:         mockSnowflake,
 			})
 
-			output, err := interactor.Execute(context.Background(), tt.customerID)
+			output, err := interactor.Execute(context.Background(), tt.input)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
 				assert.IsType(t, tt.expectedError, err)
 			} else {
 				assert.NoError(t, err)
+				if output.Disclosure != nil {
+					output.Disclosure.DisclosedAt = ""
+				}
 				assert.Equal(t, tt.expectedOutput, output)
 			}
 
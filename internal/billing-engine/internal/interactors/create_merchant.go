@@ -0,0 +1,115 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.CreateMerchantUsecase = (*CreateMerchantInteractor)(nil)
+
+type (
+	CreateMerchantRepository interface {
+		IsMerchantCodeExist(ctx context.Context, code string) (bool, error)
+		CreateMerchant(ctx context.Context, merchant entity.Merchant) (entity.Merchant, error)
+	}
+
+	CreateMerchantInteractorDependencies struct {
+		CreateMerchantRepository CreateMerchantRepository
+		Logger                   *zap.SugaredLogger
+		Validator                *validator.Validate
+		SnowflakeGen             pkguid.Snowflake
+	}
+
+	CreateMerchantInteractor struct {
+		repository   CreateMerchantRepository `validate:"required"`
+		logger       *zap.SugaredLogger       `validate:"required"`
+		validator    *validator.Validate      `validate:"required"`
+		snowflakeGen pkguid.Snowflake         `validate:"required"`
+	}
+)
+
+func NewCreateMerchantInteractor(
+	deps CreateMerchantInteractorDependencies,
+) *CreateMerchantInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &CreateMerchantInteractor{
+		repository:   deps.CreateMerchantRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.CreateMerchantUsecase.
+func (c *CreateMerchantInteractor) Execute(ctx context.Context, input usecases.CreateMerchantInput) (usecases.MerchantOutput, error) {
+	if err := c.validator.Struct(input); err != nil {
+		c.logger.Errorw("invalid input", "error", err)
+		return usecases.MerchantOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	rate, err := decimal.NewFromString(input.MDRRate)
+	if err != nil {
+		return usecases.MerchantOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if rate.IsNegative() || rate.GreaterThanOrEqual(decimal.NewFromInt(1)) || rate.Exponent() < -4 {
+		return usecases.MerchantOutput{}, pkgerror.NewValidationError("mdr rate must be a fraction below 1 with at most 4 decimals")
+	}
+
+	isCodeExist, err := c.repository.IsMerchantCodeExist(ctx, input.Code)
+	if err != nil {
+		c.logger.Errorw("failed to check merchant code", "error", err, "code", input.Code)
+		return usecases.MerchantOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if isCodeExist {
+		return usecases.MerchantOutput{}, pkgerror.NewBusinessError(fmt.Sprintf("merchant %s already exists", input.Code))
+	}
+
+	merchant, err := c.repository.CreateMerchant(ctx, entity.Merchant{
+		ID:      c.snowflakeGen.Generate(),
+		Code:    input.Code,
+		Name:    input.Name,
+		MDRRate: rate,
+		SettlementAccount: entity.BankAccount{
+			BankCode:      input.BankCode,
+			AccountNumber: input.AccountNumber,
+			AccountName:   input.AccountName,
+		},
+		CreatedBy: input.CreatedBy,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		c.logger.Errorw("failed to create merchant", "error", err, "code", input.Code)
+		return usecases.MerchantOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toMerchantOutput(merchant), nil
+}
+
+func toMerchantOutput(merchant entity.Merchant) usecases.MerchantOutput {
+	return usecases.MerchantOutput{
+		ID:            merchant.ID,
+		Code:          merchant.Code,
+		Name:          merchant.Name,
+		MDRRate:       merchant.MDRRate.String(),
+		BankCode:      merchant.SettlementAccount.BankCode,
+		AccountNumber: merchant.SettlementAccount.AccountNumber,
+		AccountName:   merchant.SettlementAccount.AccountName,
+		CreatedBy:     merchant.CreatedBy,
+		CreatedAt:     merchant.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,115 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCreateMerchantInteractor_Execute(t *testing.T) {
+	input := usecases.CreateMerchantInput{
+		Code: "TOKO", Name: "Toko Maju", MDRRate: "0.025", BankCode: "BNI",
+		AccountNumber: "555000111", AccountName: "Toko Maju", CreatedBy: "partnerships",
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.CreateMerchantInput
+		setupMocks    func(*billingenginemocks.MockCreateMerchantRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.MerchantOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - merchant created with its settlement account",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateMerchantRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsMerchantCodeExist", mock.Anything, "TOKO").Return(false, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.EXPECT().CreateMerchant(mock.Anything, mock.MatchedBy(func(merchant entity.Merchant) bool {
+					return merchant.ID == 900 && merchant.MDRRate.Equal(decimal.NewFromFloat(0.025)) &&
+						merchant.SettlementAccount.AccountNumber == "555000111" && merchant.CreatedBy == "partnerships"
+				})).RunAndReturn(func(_ context.Context, merchant entity.Merchant) (entity.Merchant, error) {
+					return merchant, nil
+				})
+			},
+			expectedCheck: func(t *testing.T, output usecases.MerchantOutput) {
+				assert.Equal(t, uint64(900), output.ID)
+				assert.Equal(t, "TOKO", output.Code)
+				assert.Equal(t, "0.025", output.MDRRate)
+				assert.Equal(t, "BNI", output.BankCode)
+				assert.NotEmpty(t, output.CreatedAt)
+			},
+		},
+		{
+			name: "error - mdr rate of 1 or more",
+			input: usecases.CreateMerchantInput{
+				Code: "TOKO", Name: "Toko Maju", MDRRate: "1", BankCode: "BNI",
+				AccountNumber: "555000111", AccountName: "Toko Maju", CreatedBy: "partnerships",
+			},
+			setupMocks:    func(*billingenginemocks.MockCreateMerchantRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - mdr rate with more than 4 decimals",
+			input: usecases.CreateMerchantInput{
+				Code: "TOKO", Name: "Toko Maju", MDRRate: "0.02505", BankCode: "BNI",
+				AccountNumber: "555000111", AccountName: "Toko Maju", CreatedBy: "partnerships",
+			},
+			setupMocks:    func(*billingenginemocks.MockCreateMerchantRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - merchant code already taken",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateMerchantRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsMerchantCodeExist", mock.Anything, "TOKO").Return(true, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockCreateMerchantRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsMerchantCodeExist", mock.Anything, "TOKO").Return(false, errors.New("database error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockCreateMerchantRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewCreateMerchantInteractor(CreateMerchantInteractorDependencies{
+				CreateMerchantRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+				Validator:                validator.New(),
+				SnowflakeGen:             mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package interactors
+
+import (
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+)
+
+const dateLayout = "2006-01-02"
+
+// parseAsOfDate parses an optional YYYY-MM-DD date, falling back to today's
+// date when the value is empty.
+func parseAsOfDate(value string) (time.Time, error) {
+	if value == "" {
+		return startOfDay(time.Now()), nil
+	}
+
+	asOf, err := time.ParseInLocation(dateLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	return asOf, nil
+}
+
+// parseMarkingDate parses the optional YYYY-MM-DD date installments are
+// marked MISSED against, falling back to today's date. It cannot be in the
+// future, so installments not yet due are never marked.
+func parseMarkingDate(value string) (time.Time, error) {
+	asOf, err := parseAsOfDate(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if asOf.After(startOfDay(time.Now())) {
+		return time.Time{}, pkgerror.NewValidationError("as_of cannot be in the future")
+	}
+
+	return asOf, nil
+}
+
+// parseOptionalDate parses an optional YYYY-MM-DD date, the zero time when
+// the value is empty.
+func parseOptionalDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.ParseInLocation(dateLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	return date, nil
+}
+
+// startOfDay truncates t to midnight in its own location.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+const monthLayout = "2006-01"
+
+// parseMonth parses a YYYY-MM month into its first day.
+func parseMonth(value string) (time.Time, error) {
+	month, err := time.ParseInLocation(monthLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	return month, nil
+}
+
+// endOfMonth returns the last day of the month of t.
+func endOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,344 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.DeclareMoratoriumUsecase = (*DeclareMoratoriumInteractor)(nil)
+
+type (
+	DeclareMoratoriumRepository interface {
+		PeriodLockRepository
+		TransactionRepository
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		GetDisbursedLoansStartedBetween(ctx context.Context, from time.Time, to time.Time) ([]entity.Loan, error)
+		GetOutstanding(ctx context.Context, loanID uint64) (decimal.Decimal, error)
+		GetUnpaidInstallmentsDueFrom(ctx context.Context, loanID uint64, from time.Time) ([]entity.Installment, error)
+		UpdateInstallmentSchedule(ctx context.Context, installmentID uint64, dueDate time.Time, amountDue decimal.Decimal, holidayInterest decimal.Decimal, status entity.InstallmentStatus) error
+		CreateMoratoriumInstallment(ctx context.Context, change entity.MoratoriumInstallment) error
+		HasOverlappingMoratorium(ctx context.Context, loanID uint64, from time.Time, to time.Time) (bool, error)
+		CreateMoratorium(ctx context.Context, moratorium entity.Moratorium) (entity.Moratorium, error)
+		CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error)
+	}
+
+	DeclareMoratoriumInteractorDependencies struct {
+		DeclareMoratoriumRepository DeclareMoratoriumRepository
+		Logger                      *zap.SugaredLogger
+		Validator                   *validator.Validate
+		SnowflakeGen                pkguid.Snowflake
+	}
+
+	DeclareMoratoriumInteractor struct {
+		repository   DeclareMoratoriumRepository `validate:"required"`
+		logger       *zap.SugaredLogger          `validate:"required"`
+		validator    *validator.Validate         `validate:"required"`
+		snowflakeGen pkguid.Snowflake            `validate:"required"`
+	}
+)
+
+func NewDeclareMoratoriumInteractor(
+	deps DeclareMoratoriumInteractorDependencies,
+) *DeclareMoratoriumInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &DeclareMoratoriumInteractor{
+		repository:   deps.DeclareMoratoriumRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.DeclareMoratoriumUsecase.
+//
+// Every PENDING or MISSED installment of the affected loans due on or after
+// the start date is pushed back by the whole weeks the moratorium covers and
+// set back to PENDING, so nothing inside the holiday is missed or counts
+// toward delinquency. With AccrueInterest the simple interest earned on the
+// outstanding during the holiday is spread over the shifted installments as
+// their holiday interest, recognised in the ledger on the start date. A loan
+// can only be under one moratorium at a time: a loan moratorium overlapping
+// another is rejected, while a segment moratorium skips the loans already
+// paused and reports them. The whole declaration is written in one
+// transaction.
+func (d *DeclareMoratoriumInteractor) Execute(ctx context.Context, input usecases.DeclareMoratoriumInput) (usecases.MoratoriumOutput, error) {
+	if err := d.validator.Struct(input); err != nil {
+		d.logger.Errorw("invalid input", "error", err)
+		return usecases.MoratoriumOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	moratorium, err := newMoratorium(input)
+	if err != nil {
+		return usecases.MoratoriumOutput{}, err
+	}
+
+	// A backdated moratorium reschedules installments and books interest from
+	// its start date, which must fall in an open accounting period
+	if err := ensurePeriodOpen(ctx, d.repository, moratorium.StartDate); err != nil {
+		d.logger.Errorw("failed to check period lock", "error", err, "start_date", moratorium.StartDate.Format(dateLayout))
+		return usecases.MoratoriumOutput{}, err
+	}
+	moratorium.ID = d.snowflakeGen.Generate()
+
+	loans, err := d.affectedLoans(ctx, moratorium)
+	if err != nil {
+		return usecases.MoratoriumOutput{}, err
+	}
+
+	var (
+		changes []entity.MoratoriumInstallment
+		skipped []uint64
+	)
+	err = d.repository.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, loan := range loans {
+			overlapping, err := d.repository.HasOverlappingMoratorium(ctx, loan.ID, moratorium.StartDate, moratorium.EndDate)
+			if err != nil {
+				d.logger.Errorw("failed to check overlapping moratorium", "error", err, "loan_id", loan.ID)
+				return pkgerror.BusinessErrorFrom(err)
+			}
+
+			if overlapping {
+				if moratorium.Scope == entity.MORATORIUM_SCOPE_LOAN {
+					return pkgerror.NewBusinessError(fmt.Sprintf("loan %d is already under a moratorium overlapping these dates", loan.ID))
+				}
+
+				skipped = append(skipped, loan.ID)
+				continue
+			}
+
+			loanChanges, err := d.shiftLoan(ctx, moratorium, loan)
+			if err != nil {
+				return pkgerror.BusinessErrorFrom(err)
+			}
+
+			if len(loanChanges) > 0 {
+				moratorium.AffectedLoans++
+			}
+			changes = append(changes, loanChanges...)
+		}
+		moratorium.AffectedInstallments = int64(len(changes))
+
+		moratorium, err = d.repository.CreateMoratorium(ctx, moratorium)
+		if err != nil {
+			d.logger.Errorw("failed to create moratorium", "error", err)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return usecases.MoratoriumOutput{}, err
+	}
+
+	output := toMoratoriumOutput(moratorium, changes)
+	output.SkippedLoanIDs = skipped
+
+	return output, nil
+}
+
+func newMoratorium(input usecases.DeclareMoratoriumInput) (entity.Moratorium, error) {
+	moratorium := entity.Moratorium{
+		Scope:          entity.MoratoriumScope(input.Scope),
+		AccrueInterest: input.AccrueInterest,
+		Reason:         input.Reason,
+		CreatedAt:      time.Now(),
+	}
+
+	dates := []struct {
+		value  string
+		target *time.Time
+	}{
+		{input.StartDate, &moratorium.StartDate},
+		{input.EndDate, &moratorium.EndDate},
+		{input.SegmentStartFrom, &moratorium.SegmentStartFrom},
+		{input.SegmentStartTo, &moratorium.SegmentStartTo},
+	}
+	for _, date := range dates {
+		if date.value == "" {
+			continue
+		}
+
+		parsed, err := time.ParseInLocation(dateLayout, date.value, time.Local)
+		if err != nil {
+			return entity.Moratorium{}, pkgerror.ValidationErrorFrom(err)
+		}
+		*date.target = parsed
+	}
+
+	if moratorium.EndDate.Before(moratorium.StartDate) {
+		return entity.Moratorium{}, pkgerror.NewValidationError("end date must not be before start date")
+	}
+
+	if moratorium.Scope == entity.MORATORIUM_SCOPE_LOAN {
+		moratorium.LoanID = input.LoanID
+		moratorium.SegmentStartFrom = time.Time{}
+		moratorium.SegmentStartTo = time.Time{}
+	}
+
+	moratorium.ShiftWeeks = moratorium.WeeksToShift()
+
+	return moratorium, nil
+}
+
+func (d *DeclareMoratoriumInteractor) affectedLoans(ctx context.Context, moratorium entity.Moratorium) ([]entity.Loan, error) {
+	if moratorium.Scope == entity.MORATORIUM_SCOPE_LOAN {
+		loan, err := d.repository.GetLoan(ctx, moratorium.LoanID)
+		if err != nil {
+			d.logger.Errorw("failed to get loan", "error", err, "loan_id", moratorium.LoanID)
+			return nil, pkgerror.BusinessErrorFrom(err)
+		}
+
+		if loan.Status != entity.LOAN_DISBURSED {
+			return nil, pkgerror.NewBusinessError("moratorium can only be declared on a disbursed loan")
+		}
+
+		return []entity.Loan{loan}, nil
+	}
+
+	loans, err := d.repository.GetDisbursedLoansStartedBetween(ctx, moratorium.SegmentStartFrom, moratorium.SegmentStartTo)
+	if err != nil {
+		d.logger.Errorw("failed to get loans of segment", "error", err)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return loans, nil
+}
+
+func (d *DeclareMoratoriumInteractor) shiftLoan(ctx context.Context, moratorium entity.Moratorium, loan entity.Loan) ([]entity.MoratoriumInstallment, error) {
+	installments, err := d.repository.GetUnpaidInstallmentsDueFrom(ctx, loan.ID, moratorium.StartDate)
+	if err != nil {
+		d.logger.Errorw("failed to get unpaid installments", "error", err, "loan_id", loan.ID)
+		return nil, err
+	}
+
+	if len(installments) == 0 {
+		return nil, nil
+	}
+
+	interest := decimal.Zero
+	interestShares := make([]decimal.Decimal, len(installments))
+	for i := range interestShares {
+		interestShares[i] = decimal.Zero
+	}
+
+	if moratorium.AccrueInterest {
+		outstanding, err := d.repository.GetOutstanding(ctx, loan.ID)
+		if err != nil {
+			d.logger.Errorw("failed to get outstanding", "error", err, "loan_id", loan.ID)
+			return nil, err
+		}
+
+		interest = moratorium.HolidayInterest(outstanding, loan.InterestRate, loan.TermWeeks)
+		if interest.IsPositive() {
+			interestShares = entity.SplitEvenly(interest, int64(len(installments)))
+		}
+	}
+
+	changes := make([]entity.MoratoriumInstallment, len(installments))
+	for i, installment := range installments {
+		dueDate, err := time.ParseInLocation(dateLayout, installment.DueDate, time.Local)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := decimal.NewFromString(installment.AmountDue)
+		if err != nil {
+			return nil, err
+		}
+
+		holiday, err := installment.Holiday()
+		if err != nil {
+			return nil, err
+		}
+
+		change := entity.MoratoriumInstallment{
+			ID:              d.snowflakeGen.Generate(),
+			MoratoriumID:    moratorium.ID,
+			InstallmentID:   installment.ID,
+			LoanID:          loan.ID,
+			WeekNumber:      installment.WeekNumber,
+			PreviousStatus:  installment.Status,
+			OriginalDueDate: dueDate,
+			NewDueDate:      dueDate.AddDate(0, 0, int(moratorium.ShiftWeeks*7)),
+			OriginalAmount:  amount,
+			NewAmount:       amount.Add(interestShares[i]),
+			AccruedInterest: interestShares[i],
+		}
+
+		if err := d.repository.UpdateInstallmentSchedule(ctx, installment.ID, change.NewDueDate, change.NewAmount, holiday.Add(change.AccruedInterest), entity.INSTALLMENT_PENDING); err != nil {
+			d.logger.Errorw("failed to shift installment", "error", err, "installment_id", installment.ID)
+			return nil, err
+		}
+
+		if err := d.repository.CreateMoratoriumInstallment(ctx, change); err != nil {
+			d.logger.Errorw("failed to record moratorium installment", "error", err, "installment_id", installment.ID)
+			return nil, err
+		}
+
+		changes[i] = change
+	}
+
+	if interest.IsPositive() {
+		entry := entity.NewMoratoriumInterestEntry(d.snowflakeGen.Generate(), loan.ID, moratorium.ID, moratorium.StartDate, interest)
+		if _, err := d.repository.CreateJournalEntry(ctx, entry); err != nil {
+			d.logger.Errorw("failed to post moratorium interest journal entry", "error", err, "loan_id", loan.ID)
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
+
+func toMoratoriumOutput(moratorium entity.Moratorium, changes []entity.MoratoriumInstallment) usecases.MoratoriumOutput {
+	output := usecases.MoratoriumOutput{
+		ID:                   moratorium.ID,
+		Scope:                string(moratorium.Scope),
+		LoanID:               moratorium.LoanID,
+		StartDate:            moratorium.StartDate.Format(dateLayout),
+		EndDate:              moratorium.EndDate.Format(dateLayout),
+		AccrueInterest:       moratorium.AccrueInterest,
+		Reason:               moratorium.Reason,
+		ShiftWeeks:           moratorium.ShiftWeeks,
+		AffectedLoans:        moratorium.AffectedLoans,
+		AffectedInstallments: moratorium.AffectedInstallments,
+		CreatedAt:            moratorium.CreatedAt.Format(time.RFC3339),
+		Installments:         make([]usecases.MoratoriumInstallmentOutput, len(changes)),
+	}
+
+	if !moratorium.SegmentStartFrom.IsZero() {
+		output.SegmentStartFrom = moratorium.SegmentStartFrom.Format(dateLayout)
+	}
+
+	if !moratorium.SegmentStartTo.IsZero() {
+		output.SegmentStartTo = moratorium.SegmentStartTo.Format(dateLayout)
+	}
+
+	for i, change := range changes {
+		output.Installments[i] = usecases.MoratoriumInstallmentOutput{
+			InstallmentID:   change.InstallmentID,
+			LoanID:          change.LoanID,
+			WeekNumber:      change.WeekNumber,
+			PreviousStatus:  string(change.PreviousStatus),
+			OriginalDueDate: change.OriginalDueDate.Format(dateLayout),
+			NewDueDate:      change.NewDueDate.Format(dateLayout),
+			OriginalAmount:  change.OriginalAmount.StringFixed(2),
+			NewAmount:       change.NewAmount.StringFixed(2),
+			AccruedInterest: change.AccruedInterest.StringFixed(2),
+		}
+	}
+
+	return output
+}
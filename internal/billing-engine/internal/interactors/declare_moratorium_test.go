@@ -0,0 +1,215 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestDeclareMoratoriumInteractor_Execute(t *testing.T) {
+	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	loan := entity.Loan{ID: 100, CustomerID: 1, InterestRate: decimal.NewFromFloat(0.1), TermWeeks: 50, Status: entity.LOAN_DISBURSED}
+	unpaid := []entity.Installment{
+		{ID: 5, LoanID: 100, WeekNumber: 5, DueDate: "2024-03-04", AmountDue: "110000.00", Status: entity.INSTALLMENT_MISSED},
+		{ID: 6, LoanID: 100, WeekNumber: 6, DueDate: "2024-03-11", AmountDue: "110000.00", Status: entity.INSTALLMENT_PENDING},
+	}
+	echoMoratorium := func(_ context.Context, moratorium entity.Moratorium) (entity.Moratorium, error) {
+		return moratorium, nil
+	}
+	inTransaction := func(mockRepo *billingenginemocks.MockDeclareMoratoriumRepository) {
+		mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction)
+	}
+
+	tests := []struct {
+		name                 string
+		input                usecases.DeclareMoratoriumInput
+		setupMocks           func(*billingenginemocks.MockDeclareMoratoriumRepository, *pkgmocks.MockSnowflake)
+		expectedShiftWeeks   int64
+		expectedInstallments []usecases.MoratoriumInstallmentOutput
+		expectedSkipped      []uint64
+		expectedError        error
+	}{
+		{
+			name: "success - loan installments are shifted and set back to pending",
+			input: usecases.DeclareMoratoriumInput{
+				Scope: "LOAN", LoanID: 100, StartDate: "2024-03-01", EndDate: "2024-03-10", Reason: "flood",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockDeclareMoratoriumRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockSnowflake.On("Generate").Return(uint64(1))
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(loan, nil)
+				inTransaction(mockRepo)
+				mockRepo.On("HasOverlappingMoratorium", mock.Anything, uint64(100), startDate, mock.Anything).Return(false, nil)
+				mockRepo.On("GetUnpaidInstallmentsDueFrom", mock.Anything, uint64(100), startDate).Return(unpaid, nil)
+				mockRepo.On("UpdateInstallmentSchedule", mock.Anything, uint64(5), time.Date(2024, 3, 18, 0, 0, 0, 0, time.Local), mock.Anything, decimal.Zero, entity.INSTALLMENT_PENDING).Return(nil)
+				mockRepo.On("UpdateInstallmentSchedule", mock.Anything, uint64(6), time.Date(2024, 3, 25, 0, 0, 0, 0, time.Local), mock.Anything, decimal.Zero, entity.INSTALLMENT_PENDING).Return(nil)
+				mockRepo.On("CreateMoratoriumInstallment", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.EXPECT().CreateMoratorium(mock.Anything, mock.Anything).RunAndReturn(echoMoratorium)
+			},
+			expectedShiftWeeks: 2,
+			expectedInstallments: []usecases.MoratoriumInstallmentOutput{
+				{InstallmentID: 5, LoanID: 100, WeekNumber: 5, PreviousStatus: "MISSED", OriginalDueDate: "2024-03-04", NewDueDate: "2024-03-18", OriginalAmount: "110000.00", NewAmount: "110000.00", AccruedInterest: "0.00"},
+				{InstallmentID: 6, LoanID: 100, WeekNumber: 6, PreviousStatus: "PENDING", OriginalDueDate: "2024-03-11", NewDueDate: "2024-03-25", OriginalAmount: "110000.00", NewAmount: "110000.00", AccruedInterest: "0.00"},
+			},
+		},
+		{
+			name: "success - segment moratorium accrues interest over the holiday",
+			input: usecases.DeclareMoratoriumInput{
+				Scope: "SEGMENT", SegmentStartTo: "2024-02-01", StartDate: "2024-03-01", EndDate: "2024-03-07", AccrueInterest: true, Reason: "regulator relief",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockDeclareMoratoriumRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockSnowflake.On("Generate").Return(uint64(1))
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("GetDisbursedLoansStartedBetween", mock.Anything, time.Time{}, time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local)).
+					Return([]entity.Loan{loan}, nil)
+				inTransaction(mockRepo)
+				mockRepo.On("HasOverlappingMoratorium", mock.Anything, uint64(100), startDate, mock.Anything).Return(false, nil)
+				mockRepo.On("GetUnpaidInstallmentsDueFrom", mock.Anything, uint64(100), startDate).Return(unpaid, nil)
+				mockRepo.On("GetOutstanding", mock.Anything, uint64(100)).Return(decimal.NewFromInt(365000), nil)
+				mockRepo.On("UpdateInstallmentSchedule", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.MatchedBy(func(holiday decimal.Decimal) bool {
+					return holiday.Equal(decimal.NewFromInt(365))
+				}), entity.INSTALLMENT_PENDING).Return(nil)
+				mockRepo.On("CreateMoratoriumInstallment", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.LoanID == 100 && entry.Event == entity.JOURNAL_MORATORIUM && entry.IsBalanced() &&
+						entry.Postings[0].AccountCode == entity.ACCOUNT_INTEREST_RECEIVABLE && entry.TotalDebit().Equal(decimal.NewFromInt(730))
+				})).Return(entity.JournalEntry{}, nil).Once()
+				mockRepo.EXPECT().CreateMoratorium(mock.Anything, mock.Anything).RunAndReturn(echoMoratorium)
+			},
+			expectedShiftWeeks: 1,
+			expectedInstallments: []usecases.MoratoriumInstallmentOutput{
+				{InstallmentID: 5, LoanID: 100, WeekNumber: 5, PreviousStatus: "MISSED", OriginalDueDate: "2024-03-04", NewDueDate: "2024-03-11", OriginalAmount: "110000.00", NewAmount: "110365.00", AccruedInterest: "365.00"},
+				{InstallmentID: 6, LoanID: 100, WeekNumber: 6, PreviousStatus: "PENDING", OriginalDueDate: "2024-03-11", NewDueDate: "2024-03-18", OriginalAmount: "110000.00", NewAmount: "110365.00", AccruedInterest: "365.00"},
+			},
+		},
+		{
+			name: "success - segment moratorium skips loans already under an overlapping moratorium",
+			input: usecases.DeclareMoratoriumInput{
+				Scope: "SEGMENT", SegmentStartTo: "2024-02-01", StartDate: "2024-03-01", EndDate: "2024-03-10", Reason: "regulator relief",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockDeclareMoratoriumRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockSnowflake.On("Generate").Return(uint64(1))
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("GetDisbursedLoansStartedBetween", mock.Anything, time.Time{}, time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local)).
+					Return([]entity.Loan{{ID: 99, Status: entity.LOAN_DISBURSED}, loan}, nil)
+				inTransaction(mockRepo)
+				mockRepo.On("HasOverlappingMoratorium", mock.Anything, uint64(99), startDate, mock.Anything).Return(true, nil)
+				mockRepo.On("HasOverlappingMoratorium", mock.Anything, uint64(100), startDate, mock.Anything).Return(false, nil)
+				mockRepo.On("GetUnpaidInstallmentsDueFrom", mock.Anything, uint64(100), startDate).Return(unpaid, nil)
+				mockRepo.On("UpdateInstallmentSchedule", mock.Anything, mock.Anything, mock.Anything, mock.Anything, decimal.Zero, entity.INSTALLMENT_PENDING).Return(nil)
+				mockRepo.On("CreateMoratoriumInstallment", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.EXPECT().CreateMoratorium(mock.Anything, mock.Anything).RunAndReturn(echoMoratorium)
+			},
+			expectedShiftWeeks: 2,
+			expectedInstallments: []usecases.MoratoriumInstallmentOutput{
+				{InstallmentID: 5, LoanID: 100, WeekNumber: 5, PreviousStatus: "MISSED", OriginalDueDate: "2024-03-04", NewDueDate: "2024-03-18", OriginalAmount: "110000.00", NewAmount: "110000.00", AccruedInterest: "0.00"},
+				{InstallmentID: 6, LoanID: 100, WeekNumber: 6, PreviousStatus: "PENDING", OriginalDueDate: "2024-03-11", NewDueDate: "2024-03-25", OriginalAmount: "110000.00", NewAmount: "110000.00", AccruedInterest: "0.00"},
+			},
+			expectedSkipped: []uint64{99},
+		},
+		{
+			name: "error - end date before start date",
+			input: usecases.DeclareMoratoriumInput{
+				Scope: "LOAN", LoanID: 100, StartDate: "2024-03-10", EndDate: "2024-03-01", Reason: "flood",
+			},
+			setupMocks:    func(*billingenginemocks.MockDeclareMoratoriumRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - moratorium backdated into a closed period",
+			input: usecases.DeclareMoratoriumInput{
+				Scope: "LOAN", LoanID: 100, StartDate: "2024-02-26", EndDate: "2024-03-10", Reason: "flood",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockDeclareMoratoriumRepository, _ *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - loan is not disbursed",
+			input: usecases.DeclareMoratoriumInput{
+				Scope: "LOAN", LoanID: 100, StartDate: "2024-03-01", EndDate: "2024-03-10", Reason: "flood",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockDeclareMoratoriumRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockSnowflake.On("Generate").Return(uint64(1))
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_PAID}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - loan already under an overlapping moratorium",
+			input: usecases.DeclareMoratoriumInput{
+				Scope: "LOAN", LoanID: 100, StartDate: "2024-03-01", EndDate: "2024-03-10", Reason: "flood",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockDeclareMoratoriumRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockSnowflake.On("Generate").Return(uint64(1))
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(loan, nil)
+				inTransaction(mockRepo)
+				mockRepo.On("HasOverlappingMoratorium", mock.Anything, uint64(100), startDate, time.Date(2024, 3, 10, 0, 0, 0, 0, time.Local)).
+					Return(true, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - repository error on shift",
+			input: usecases.DeclareMoratoriumInput{
+				Scope: "LOAN", LoanID: 100, StartDate: "2024-03-01", EndDate: "2024-03-10", Reason: "flood",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockDeclareMoratoriumRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockSnowflake.On("Generate").Return(uint64(1))
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(loan, nil)
+				inTransaction(mockRepo)
+				mockRepo.On("HasOverlappingMoratorium", mock.Anything, uint64(100), startDate, mock.Anything).Return(false, nil)
+				mockRepo.On("GetUnpaidInstallmentsDueFrom", mock.Anything, uint64(100), startDate).Return(unpaid, nil)
+				mockRepo.On("UpdateInstallmentSchedule", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockDeclareMoratoriumRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewDeclareMoratoriumInteractor(DeclareMoratoriumInteractorDependencies{
+				DeclareMoratoriumRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+				Validator:                   validator.New(),
+				SnowflakeGen:                mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedShiftWeeks, output.ShiftWeeks)
+				assert.Equal(t, int64(1), output.AffectedLoans)
+				assert.Equal(t, int64(len(tt.expectedInstallments)), output.AffectedInstallments)
+				assert.Equal(t, tt.expectedInstallments, output.Installments)
+				assert.Equal(t, tt.expectedSkipped, output.SkippedLoanIDs)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.DeleteCustomerUsecase = (*DeleteCustomerInteractor)(nil)
+
+type (
+	DeleteCustomerRepository interface {
+		IsCustomerExist(ctx context.Context, customerID uint64) (bool, error)
+		GetCustomerExposure(ctx context.Context, customerID uint64) (entity.CustomerExposure, error)
+		DeleteCustomer(ctx context.Context, customerID uint64, deletedAt time.Time) (bool, error)
+	}
+
+	DeleteCustomerInteractorDependencies struct {
+		DeleteCustomerRepository DeleteCustomerRepository
+		Logger                   *zap.SugaredLogger
+	}
+
+	DeleteCustomerInteractor struct {
+		repository DeleteCustomerRepository `validate:"required"`
+		logger     *zap.SugaredLogger       `validate:"required"`
+	}
+)
+
+func NewDeleteCustomerInteractor(
+	deps DeleteCustomerInteractorDependencies,
+) *DeleteCustomerInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &DeleteCustomerInteractor{
+		repository: deps.DeleteCustomerRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.DeleteCustomerUsecase.
+//
+// The customer is only marked deleted so their paid and written off loans
+// keep their history. It is refused while a loan is applied for, approved
+// or disbursed.
+func (d *DeleteCustomerInteractor) Execute(ctx context.Context, customerID uint64) (usecases.DeleteCustomerOutput, error) {
+	isCustomerExist, err := d.repository.IsCustomerExist(ctx, customerID)
+	if err != nil {
+		d.logger.Errorw("failed to check if customer exists", "error", err, "customer_id", customerID)
+		return usecases.DeleteCustomerOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !isCustomerExist {
+		return usecases.DeleteCustomerOutput{}, pkgerror.NewBusinessError("customer not found")
+	}
+
+	exposure, err := d.repository.GetCustomerExposure(ctx, customerID)
+	if err != nil {
+		d.logger.Errorw("failed to get customer exposure", "error", err, "customer_id", customerID)
+		return usecases.DeleteCustomerOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if exposure.ActiveLoans > 0 {
+		return usecases.DeleteCustomerOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("customer %d still has %d open loans", customerID, exposure.ActiveLoans),
+		)
+	}
+
+	deletedAt := time.Now()
+	deleted, err := d.repository.DeleteCustomer(ctx, customerID, deletedAt)
+	if err != nil {
+		d.logger.Errorw("failed to delete customer", "error", err, "customer_id", customerID)
+		return usecases.DeleteCustomerOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	// A loan applied for in the meantime keeps the customer
+	if !deleted {
+		return usecases.DeleteCustomerOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("customer %d could not be deleted, a loan was opened or the customer was already deleted", customerID),
+		)
+	}
+
+	return usecases.DeleteCustomerOutput{
+		ID:        customerID,
+		DeletedAt: deletedAt.Format(time.RFC3339),
+	}, nil
+}
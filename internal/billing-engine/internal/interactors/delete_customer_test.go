@@ -0,0 +1,98 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestDeleteCustomerInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		customerID    uint64
+		setupMocks    func(*billingenginemocks.MockDeleteCustomerRepository)
+		expectedError error
+	}{
+		{
+			name:       "success - customer without open loans is deleted",
+			customerID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockDeleteCustomerRepository) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(1)).Return(true, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(1)).Return(entity.CustomerExposure{CustomerID: 1}, nil)
+				mockRepo.On("DeleteCustomer", mock.Anything, uint64(1), mock.Anything).Return(true, nil)
+			},
+		},
+		{
+			name:       "error - customer has an open loan",
+			customerID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockDeleteCustomerRepository) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(1)).Return(true, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(1)).Return(entity.CustomerExposure{
+					CustomerID: 1, ActiveLoans: 1, Outstanding: decimal.NewFromInt(1100000),
+				}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:       "error - loan opened before the customer was deleted",
+			customerID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockDeleteCustomerRepository) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(1)).Return(true, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(1)).Return(entity.CustomerExposure{CustomerID: 1}, nil)
+				mockRepo.On("DeleteCustomer", mock.Anything, uint64(1), mock.Anything).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:       "error - customer not found",
+			customerID: 404,
+			setupMocks: func(mockRepo *billingenginemocks.MockDeleteCustomerRepository) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(404)).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:       "error - repository error on DeleteCustomer",
+			customerID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockDeleteCustomerRepository) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(1)).Return(true, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(1)).Return(entity.CustomerExposure{CustomerID: 1}, nil)
+				mockRepo.On("DeleteCustomer", mock.Anything, uint64(1), mock.Anything).Return(false, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockDeleteCustomerRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewDeleteCustomerInteractor(DeleteCustomerInteractorDependencies{
+				DeleteCustomerRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.customerID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.customerID, output.ID)
+			assert.NotEmpty(t, output.DeletedAt)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.DisburseLoanUsecase = (*DisburseLoanInteractor)(nil)
+
+type (
+	DisburseLoanRepository interface {
+		DisbursementRepository
+		IsLoanHasDisbursement(ctx context.Context, loanID uint64) (bool, error)
+		GetProductFees(ctx context.Context, productCode string) ([]entity.ProductFee, error)
+		CreateLoanFees(ctx context.Context, fees []entity.LoanFee) error
+		CreateDisbursement(ctx context.Context, disbursement entity.Disbursement) (entity.Disbursement, error)
+	}
+
+	DisburseLoanInteractorDependencies struct {
+		DisburseLoanRepository DisburseLoanRepository
+		PayoutProvider         PayoutProvider
+		Logger                 *zap.SugaredLogger
+		Validator              *validator.Validate
+		SnowflakeGen           pkguid.Snowflake
+	}
+
+	DisburseLoanInteractor struct {
+		repository   DisburseLoanRepository `validate:"required"`
+		provider     PayoutProvider         `validate:"required"`
+		logger       *zap.SugaredLogger     `validate:"required"`
+		validator    *validator.Validate    `validate:"required"`
+		snowflakeGen pkguid.Snowflake       `validate:"required"`
+	}
+)
+
+func NewDisburseLoanInteractor(
+	deps DisburseLoanInteractorDependencies,
+) *DisburseLoanInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &DisburseLoanInteractor{
+		repository:   deps.DisburseLoanRepository,
+		provider:     deps.PayoutProvider,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.DisburseLoanUsecase.
+//
+// The payout of an approved loan is handed to the provider, less the product
+// fees deducted at disbursement. The fees are fixed on the loan now, in the
+// same transaction as the disbursement, so later product changes leave it
+// untouched and a request losing the race to disburse the loan leaves no fees
+// behind. The loan is only DISBURSED, and its schedule started, once the
+// provider confirms the payout.
+//
+// A merchant loan is not paid out to the borrower. It is disbursed right away
+// and what it pays for is owed to the merchant's settlement account.
+func (d *DisburseLoanInteractor) Execute(ctx context.Context, input usecases.DisburseLoanInput) (usecases.DisbursementOutput, error) {
+	if err := d.validator.Struct(input); err != nil {
+		d.logger.Errorw("invalid input", "error", err)
+		return usecases.DisbursementOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	loan, err := d.repository.GetLoan(ctx, input.LoanID)
+	if err != nil {
+		d.logger.Errorw("failed to get loan", "error", err, "loan_id", input.LoanID)
+		return usecases.DisbursementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if _, err := newLoanTransition(loan, entity.LOAN_DISBURSED, input.DisbursedBy, "loan disbursed"); err != nil {
+		return usecases.DisbursementOutput{}, err
+	}
+
+	hasDisbursement, err := d.repository.IsLoanHasDisbursement(ctx, loan.ID)
+	if err != nil {
+		d.logger.Errorw("failed to check loan disbursement", "error", err, "loan_id", loan.ID)
+		return usecases.DisbursementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if hasDisbursement {
+		return usecases.DisbursementOutput{}, pkgerror.NewBusinessError(fmt.Sprintf("loan %d already has a disbursement", loan.ID))
+	}
+
+	account, err := d.payoutAccount(ctx, loan, input)
+	if err != nil {
+		return usecases.DisbursementOutput{}, err
+	}
+
+	fees, err := d.loanFees(ctx, loan)
+	if err != nil {
+		return usecases.DisbursementOutput{}, err
+	}
+
+	deducted := entity.TotalFees(fees, entity.FEE_DEDUCTED)
+	if deducted.GreaterThanOrEqual(loan.PrincipalAmount) {
+		return usecases.DisbursementOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("fees of %s deducted at disbursement leave nothing to pay out of loan %d", deducted.StringFixed(2), loan.ID),
+		)
+	}
+
+	disbursement := entity.NewDisbursement(d.snowflakeGen.Generate(), loan, account, deducted, input.DisbursedBy, time.Now())
+
+	err = d.repository.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := d.repository.CreateLoanFees(ctx, fees); err != nil {
+			d.logger.Errorw("failed to create loan fees", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		if _, err := d.repository.CreateDisbursement(ctx, disbursement); err != nil {
+			d.logger.Errorw("failed to create disbursement", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return usecases.DisbursementOutput{}, err
+	}
+
+	if loan.MerchantID != 0 {
+		completed, err := completeDisbursement(ctx, d.repository, d.snowflakeGen, disbursement, disbursement.Status, startOfDay(time.Now()))
+		if err != nil {
+			d.logger.Errorw("failed to complete merchant disbursement", "error", err, "disbursement_id", disbursement.ID)
+			return usecases.DisbursementOutput{}, err
+		}
+
+		return toDisbursementOutput(completed), nil
+	}
+
+	sent, err := sendPayout(ctx, d.repository, d.provider, d.snowflakeGen, d.logger, disbursement)
+	if err != nil {
+		d.logger.Errorw("failed to send payout", "error", err, "disbursement_id", disbursement.ID)
+		return usecases.DisbursementOutput{}, err
+	}
+
+	return toDisbursementOutput(sent), nil
+}
+
+// payoutAccount is the merchant's settlement account for a merchant loan and
+// the borrower's account given in the input otherwise.
+func (d *DisburseLoanInteractor) payoutAccount(ctx context.Context, loan entity.Loan, input usecases.DisburseLoanInput) (entity.BankAccount, error) {
+	if loan.MerchantID != 0 {
+		merchant, err := d.repository.GetMerchant(ctx, loan.MerchantID)
+		if err != nil {
+			d.logger.Errorw("failed to get merchant", "error", err, "merchant_id", loan.MerchantID)
+			return entity.BankAccount{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		return merchant.SettlementAccount, nil
+	}
+
+	if input.BankCode == "" || input.AccountNumber == "" || input.AccountName == "" {
+		return entity.BankAccount{}, pkgerror.NewValidationError("bank_code, account_number and account_name are required")
+	}
+
+	return entity.BankAccount{
+		BankCode:      input.BankCode,
+		AccountNumber: input.AccountNumber,
+		AccountName:   input.AccountName,
+	}, nil
+}
+
+// loanFees charges the fees of the loan's product on the loan, skipping the
+// ones that come to nothing.
+func (d *DisburseLoanInteractor) loanFees(ctx context.Context, loan entity.Loan) ([]entity.LoanFee, error) {
+	productFees, err := d.repository.GetProductFees(ctx, loan.ProductCode)
+	if err != nil {
+		d.logger.Errorw("failed to get product fees", "error", err, "product_code", loan.ProductCode)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	now := time.Now()
+
+	var fees []entity.LoanFee
+	for _, productFee := range productFees {
+		if !productFee.AmountFor(loan.PrincipalAmount).IsPositive() {
+			continue
+		}
+
+		fees = append(fees, entity.NewLoanFee(d.snowflakeGen.Generate(), loan, productFee, now))
+	}
+
+	return fees, nil
+}
@@ -0,0 +1,337 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestDisburseLoanInteractor_Execute(t *testing.T) {
+	approvedLoan := entity.Loan{
+		ID: 100, CustomerID: 1, PrincipalAmount: decimal.NewFromInt(5000000), InterestRate: decimal.NewFromFloat(0.1),
+		TermWeeks: 50, Status: entity.LOAN_APPROVED, RequestedBy: "sales-agent", ProductCode: entity.DEFAULT_LOAN_PRODUCT,
+	}
+	input := usecases.DisburseLoanInput{
+		LoanID: 100, DisbursedBy: "finance", BankCode: "BCA", AccountNumber: "1234567890", AccountName: "Budi",
+	}
+	merchantLoan := approvedLoan
+	merchantLoan.MerchantID = 900
+	merchantLoan.OrderRef = "ORD-1"
+	merchant := entity.Merchant{
+		ID: 900, Code: "TOKO", Name: "Toko Maju", MDRRate: decimal.NewFromFloat(0.025),
+		SettlementAccount: entity.BankAccount{BankCode: "BNI", AccountNumber: "555000111", AccountName: "Toko Maju"},
+	}
+	today := startOfDay(time.Now())
+
+	tests := []struct {
+		name          string
+		input         usecases.DisburseLoanInput
+		setupMocks    func(*billingenginemocks.MockDisburseLoanRepository, *billingenginemocks.MockPayoutProvider, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.DisbursementOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - completed payout disburses the loan today",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
+				mockRepo.On("CreateLoanFees", mock.Anything, []entity.LoanFee(nil)).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(300)).Once()
+				mockRepo.On("CreateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.ID == 300 && d.LoanID == 100 && d.Status == entity.DISBURSEMENT_PENDING &&
+						d.Amount.Equal(decimal.NewFromInt(5000000)) && d.BankAccount.AccountNumber == "1234567890"
+				})).Return(entity.Disbursement{}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.ID == 300 && d.Status == entity.DISBURSEMENT_SENT && d.Attempts == 1
+				}), entity.DISBURSEMENT_PENDING).Return(nil)
+				mockProvider.On("Send", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Status == entity.DISBURSEMENT_SENT && d.Attempts == 1
+				})).Return(entity.PayoutResult{Reference: "ref-1", Status: entity.DISBURSEMENT_COMPLETED}, nil)
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Status == entity.DISBURSEMENT_COMPLETED && d.ProviderReference == "ref-1" &&
+						d.Attempts == 1 && d.CompletedAt.Equal(today)
+				}), entity.DISBURSEMENT_SENT).Return(nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.MatchedBy(func(transition entity.LoanStatusTransition) bool {
+					return transition.LoanID == 100 && transition.FromStatus == entity.LOAN_APPROVED &&
+						transition.ToStatus == entity.LOAN_DISBURSED && transition.Actor == entity.SYSTEM_ACTOR
+				})).Return(nil)
+				mockRepo.On("SetLoanStartDate", mock.Anything, uint64(100), today).Return(nil)
+				mockRepo.On("GetLoanFees", mock.Anything, uint64(100)).Return([]entity.LoanFee(nil), nil)
+				mockRepo.On("CreateInstallmentFromLoan", mock.Anything, mock.MatchedBy(func(loan *entity.Loan) bool {
+					return loan.ID == 100 && loan.Status == entity.LOAN_DISBURSED && loan.StartDate.Equal(today)
+				}), []entity.LoanFee(nil)).Return(true, nil)
+				mockSnowflake.On("Generate").Return(uint64(400)).Once()
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.Event == entity.JOURNAL_DISBURSEMENT && entry.LoanID == 100 && entry.IsBalanced() &&
+						entry.EffectiveDate.Equal(today)
+				})).Return(entity.JournalEntry{}, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.DisbursementOutput) {
+				assert.Equal(t, uint64(300), output.ID)
+				assert.Equal(t, "COMPLETED", output.Status)
+				assert.Equal(t, "5000000.00", output.Amount)
+				assert.Equal(t, "0.00", output.FeeAmount)
+				assert.Equal(t, "ref-1", output.ProviderReference)
+				assert.Equal(t, today.Format(time.RFC3339), output.CompletedAt)
+			},
+		},
+		{
+			name:  "success - failed payout leaves the loan approved",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
+				mockRepo.On("CreateLoanFees", mock.Anything, []entity.LoanFee(nil)).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(300))
+				mockRepo.On("CreateDisbursement", mock.Anything, mock.Anything).Return(entity.Disbursement{}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.ID == 300 && d.Status == entity.DISBURSEMENT_SENT && d.Attempts == 1
+				}), entity.DISBURSEMENT_PENDING).Return(nil)
+				mockProvider.On("Send", mock.Anything, mock.Anything).
+					Return(entity.PayoutResult{Reference: "ref-1", Status: entity.DISBURSEMENT_FAILED, FailureReason: "account closed"}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Status == entity.DISBURSEMENT_FAILED && d.FailureReason == "account closed" && d.Attempts == 1
+				}), entity.DISBURSEMENT_SENT).Return(nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.DisbursementOutput) {
+				assert.Equal(t, "FAILED", output.Status)
+				assert.Equal(t, "account closed", output.FailureReason)
+				assert.Empty(t, output.CompletedAt)
+			},
+		},
+		{
+			name:  "success - provider error leaves the payout sent awaiting confirmation",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
+				mockRepo.On("CreateLoanFees", mock.Anything, []entity.LoanFee(nil)).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(300))
+				mockRepo.On("CreateDisbursement", mock.Anything, mock.Anything).Return(entity.Disbursement{}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.ID == 300 && d.Status == entity.DISBURSEMENT_SENT && d.Attempts == 1
+				}), entity.DISBURSEMENT_PENDING).Return(nil)
+				mockProvider.On("Send", mock.Anything, mock.Anything).Return(entity.PayoutResult{}, errors.New("provider timeout"))
+			},
+			expectedCheck: func(t *testing.T, output usecases.DisbursementOutput) {
+				assert.Equal(t, "SENT", output.Status)
+				assert.Empty(t, output.FailureReason)
+				assert.Empty(t, output.CompletedAt)
+			},
+		},
+		{
+			name:  "error - payout already sent by a concurrent request",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
+				mockRepo.On("CreateLoanFees", mock.Anything, []entity.LoanFee(nil)).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(300))
+				mockRepo.On("CreateDisbursement", mock.Anything, mock.Anything).Return(entity.Disbursement{}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.Anything, entity.DISBURSEMENT_PENDING).
+					Return(errors.New("disbursement 300 is no longer PENDING"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "success - deducted fees are taken out of the payout",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee{
+					{ProductCode: entity.DEFAULT_LOAN_PRODUCT, FeeType: entity.FEE_ORIGINATION, Calculation: entity.FEE_PERCENTAGE, Value: decimal.NewFromFloat(0.02), Collection: entity.FEE_DEDUCTED},
+					{ProductCode: entity.DEFAULT_LOAN_PRODUCT, FeeType: entity.FEE_ADMIN, Calculation: entity.FEE_FLAT, Value: decimal.NewFromInt(50000), Collection: entity.FEE_DEDUCTED},
+					{ProductCode: entity.DEFAULT_LOAN_PRODUCT, FeeType: entity.FEE_INSURANCE, Calculation: entity.FEE_FLAT, Value: decimal.NewFromInt(100000), Collection: entity.FEE_AMORTISED},
+				}, nil)
+				mockSnowflake.On("Generate").Return(uint64(200)).Times(3)
+				mockRepo.On("CreateLoanFees", mock.Anything, mock.MatchedBy(func(fees []entity.LoanFee) bool {
+					return len(fees) == 3 && fees[0].Amount.Equal(decimal.NewFromInt(100000)) &&
+						fees[1].Amount.Equal(decimal.NewFromInt(50000)) && fees[2].Collection == entity.FEE_AMORTISED
+				})).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(300)).Once()
+				mockRepo.On("CreateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Amount.Equal(decimal.NewFromInt(4850000)) && d.FeeAmount.Equal(decimal.NewFromInt(150000))
+				})).Return(entity.Disbursement{}, nil)
+				mockProvider.On("Send", mock.Anything, mock.Anything).
+					Return(entity.PayoutResult{Reference: "ref-1", Status: entity.DISBURSEMENT_SENT}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.Anything, entity.DISBURSEMENT_PENDING).Return(nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Status == entity.DISBURSEMENT_SENT && d.ProviderReference == "ref-1"
+				}), entity.DISBURSEMENT_SENT).Return(nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.DisbursementOutput) {
+				assert.Equal(t, "SENT", output.Status)
+				assert.Equal(t, "4850000.00", output.Amount)
+				assert.Equal(t, "150000.00", output.FeeAmount)
+			},
+		},
+		{
+			name:  "success - merchant loan is disbursed at once and owed to the merchant less its MDR",
+			input: usecases.DisburseLoanInput{LoanID: 100, DisbursedBy: "finance"},
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(merchantLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(false, nil)
+				mockRepo.On("GetMerchant", mock.Anything, uint64(900)).Return(merchant, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
+				mockRepo.On("CreateLoanFees", mock.Anything, []entity.LoanFee(nil)).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(300)).Once()
+				mockRepo.On("CreateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.ID == 300 && d.BankAccount.AccountNumber == "555000111"
+				})).Return(entity.Disbursement{}, nil)
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Status == entity.DISBURSEMENT_COMPLETED && d.Attempts == 0 && d.CompletedAt.Equal(today)
+				}), entity.DISBURSEMENT_PENDING).Return(nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("SetLoanStartDate", mock.Anything, uint64(100), today).Return(nil)
+				mockRepo.On("GetLoanFees", mock.Anything, uint64(100)).Return([]entity.LoanFee(nil), nil)
+				mockRepo.On("CreateInstallmentFromLoan", mock.Anything, mock.Anything, []entity.LoanFee(nil)).Return(true, nil)
+				mockSnowflake.On("Generate").Return(uint64(500)).Once()
+				mockRepo.On("CreateMerchantSettlementItem", mock.Anything, mock.MatchedBy(func(item entity.MerchantSettlementItem) bool {
+					return item.ID == 500 && item.MerchantID == 900 && item.LoanID == 100 && item.OrderRef == "ORD-1" &&
+						item.SaleDate.Equal(today) && item.GrossAmount.Equal(decimal.NewFromInt(5000000)) &&
+						item.MDRAmount.Equal(decimal.NewFromInt(125000)) && item.NetAmount.Equal(decimal.NewFromInt(4875000))
+				})).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(400)).Once()
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.ID == 400 && entry.Event == entity.JOURNAL_DISBURSEMENT && entry.IsBalanced() && len(entry.Postings) == 3 &&
+						entry.Postings[1].AccountCode == entity.ACCOUNT_MERCHANT_PAYABLE && entry.Postings[1].Amount.Equal(decimal.NewFromInt(4875000)) &&
+						entry.Postings[2].AccountCode == entity.ACCOUNT_MDR_INCOME && entry.Postings[2].Amount.Equal(decimal.NewFromInt(125000))
+				})).Return(entity.JournalEntry{}, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.DisbursementOutput) {
+				assert.Equal(t, "COMPLETED", output.Status)
+				assert.Equal(t, "5000000.00", output.Amount)
+				assert.Equal(t, today.Format(time.RFC3339), output.CompletedAt)
+			},
+		},
+		{
+			name:  "error - borrower loan without a bank account",
+			input: usecases.DisburseLoanInput{LoanID: 100, DisbursedBy: "finance"},
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - validation error (non numeric account number)",
+			input: usecases.DisburseLoanInput{LoanID: 100, DisbursedBy: "finance", BankCode: "BCA", AccountNumber: "12-34", AccountName: "Budi"},
+			setupMocks: func(*billingenginemocks.MockDisburseLoanRepository, *billingenginemocks.MockPayoutProvider, *pkgmocks.MockSnowflake) {
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - illegal transition from an application not yet approved",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_APPLIED}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan already has a disbursement",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(true, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - deducted fees leave nothing to pay out",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee{
+					{ProductCode: entity.DEFAULT_LOAN_PRODUCT, FeeType: entity.FEE_ADMIN, Calculation: entity.FEE_FLAT, Value: decimal.NewFromInt(5000000), Collection: entity.FEE_DEDUCTED},
+				}, nil)
+				mockSnowflake.On("Generate").Return(uint64(200))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - fees rolled back when the disbursement cannot be created",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
+				mockSnowflake.On("Generate").Return(uint64(300))
+				mockRepo.On("CreateLoanFees", mock.Anything, []entity.LoanFee(nil)).Return(nil)
+				mockRepo.On("CreateDisbursement", mock.Anything, mock.Anything).
+					Return(entity.Disbursement{}, errors.New("duplicate key value violates unique constraint \"disbursements_loan_id_key\""))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - completion in a closed period",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDisburseLoanRepository, mockProvider *billingenginemocks.MockPayoutProvider, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(approvedLoan, nil)
+				mockRepo.On("IsLoanHasDisbursement", mock.Anything, uint64(100)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee(nil), nil)
+				mockRepo.On("CreateLoanFees", mock.Anything, []entity.LoanFee(nil)).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(300))
+				mockRepo.On("CreateDisbursement", mock.Anything, mock.Anything).Return(entity.Disbursement{}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.Anything, entity.DISBURSEMENT_PENDING).Return(nil)
+				mockProvider.On("Send", mock.Anything, mock.Anything).
+					Return(entity.PayoutResult{Reference: "ref-1", Status: entity.DISBURSEMENT_COMPLETED}, nil)
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{
+					Period: entity.PeriodOf(time.Now()), Status: entity.PERIOD_CLOSED,
+				}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockDisburseLoanRepository(t)
+			mockProvider := billingenginemocks.NewMockPayoutProvider(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+
+			mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction).Maybe()
+			tt.setupMocks(mockRepo, mockProvider, mockSnowflake)
+
+			interactor := NewDisburseLoanInteractor(DisburseLoanInteractorDependencies{
+				DisburseLoanRepository: mockRepo,
+				PayoutProvider:         mockProvider,
+				Logger:                 zap.NewNop().Sugar(),
+				Validator:              validator.New(),
+				SnowflakeGen:           mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
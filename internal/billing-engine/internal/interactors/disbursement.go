@@ -0,0 +1,171 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"go.uber.org/zap"
+)
+
+type (
+	// PayoutProvider hands a disbursement over to the bank transfer provider.
+	// A provider may answer SENT and confirm the payout later, or complete or
+	// fail it right away. It must not pay the same disbursement twice when it
+	// is sent again.
+	PayoutProvider interface {
+		Send(ctx context.Context, disbursement entity.Disbursement) (entity.PayoutResult, error)
+	}
+
+	// DisbursementRepository is what sending a payout and completing it
+	// needs.
+	DisbursementRepository interface {
+		PeriodLockRepository
+		TransactionRepository
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		UpdateDisbursement(ctx context.Context, disbursement entity.Disbursement, from entity.DisbursementStatus) error
+		TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error
+		SetLoanStartDate(ctx context.Context, loanID uint64, startDate time.Time) error
+		GetLoanFees(ctx context.Context, loanID uint64) ([]entity.LoanFee, error)
+		CreateInstallmentFromLoan(ctx context.Context, loan *entity.Loan, fees []entity.LoanFee) (bool, error)
+		CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error)
+		GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error)
+		CreateMerchantSettlementItem(ctx context.Context, item entity.MerchantSettlementItem) error
+	}
+)
+
+// sendPayout marks the PENDING disbursement SENT, counting the attempt,
+// before handing it to the provider, so a concurrent send of the same payout
+// fails its compare-and-swap instead of paying twice. A provider error leaves
+// the outcome unknown, the transfer may have gone through, so the payout stays
+// SENT until it is confirmed. A payout failed by the provider can be retried,
+// and one completed right away disburses the loan today.
+func sendPayout(ctx context.Context, repository DisbursementRepository, provider PayoutProvider, snowflakeGen pkguid.Snowflake, logger *zap.SugaredLogger, disbursement entity.Disbursement) (entity.Disbursement, error) {
+	sent := disbursement
+	sent.Status = entity.DISBURSEMENT_SENT
+	sent.FailureReason = ""
+	sent.Attempts++
+	sent.UpdatedAt = time.Now()
+
+	if err := repository.UpdateDisbursement(ctx, sent, disbursement.Status); err != nil {
+		return entity.Disbursement{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	result, err := provider.Send(ctx, sent)
+	if err != nil {
+		logger.Warnw("payout outcome unknown, awaiting confirmation", "error", err, "disbursement_id", sent.ID)
+		return sent, nil
+	}
+
+	answered := sent
+	answered.Status = result.Status
+	answered.ProviderReference = result.Reference
+	answered.FailureReason = result.FailureReason
+	answered.UpdatedAt = time.Now()
+
+	if answered.Status == entity.DISBURSEMENT_COMPLETED {
+		return completeDisbursement(ctx, repository, snowflakeGen, answered, entity.DISBURSEMENT_SENT, startOfDay(time.Now()))
+	}
+
+	if err := repository.UpdateDisbursement(ctx, answered, entity.DISBURSEMENT_SENT); err != nil {
+		return entity.Disbursement{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return answered, nil
+}
+
+// completeDisbursement marks the payout COMPLETED and disburses its loan. The
+// loan and its schedule start on the day the money reached the borrower, and
+// its fees are charged that day. The payout of a merchant loan is owed to the
+// merchant, less its MDR, until its settlement batch pays it. Everything the
+// completion writes is written in one transaction.
+func completeDisbursement(ctx context.Context, repository DisbursementRepository, snowflakeGen pkguid.Snowflake, disbursement entity.Disbursement, from entity.DisbursementStatus, completedAt time.Time) (entity.Disbursement, error) {
+	loan, err := repository.GetLoan(ctx, disbursement.LoanID)
+	if err != nil {
+		return entity.Disbursement{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	transition, err := newLoanTransition(loan, entity.LOAN_DISBURSED, entity.SYSTEM_ACTOR, "payout completed")
+	if err != nil {
+		return entity.Disbursement{}, err
+	}
+
+	if err := ensurePeriodOpen(ctx, repository, completedAt); err != nil {
+		return entity.Disbursement{}, err
+	}
+
+	disbursement.Status = entity.DISBURSEMENT_COMPLETED
+	disbursement.CompletedAt = completedAt
+	disbursement.UpdatedAt = time.Now()
+
+	err = repository.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := repository.UpdateDisbursement(ctx, disbursement, from); err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		if err := repository.TransitionLoanStatus(ctx, transition); err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		loan.Status = entity.LOAN_DISBURSED
+		loan.StartDate = completedAt
+
+		if err := repository.SetLoanStartDate(ctx, loan.ID, loan.StartDate); err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		fees, err := repository.GetLoanFees(ctx, loan.ID)
+		if err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		createInstallment, err := repository.CreateInstallmentFromLoan(ctx, &loan, fees)
+		if err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		if !createInstallment {
+			return pkgerror.NewBusinessError("failed to create installment from loan")
+		}
+
+		for _, fee := range fees {
+			entry := entity.NewFeeEntry(snowflakeGen.Generate(), loan.ID, entity.FeeReference(fee.FeeType), fee.Amount, completedAt)
+			if _, err := repository.CreateJournalEntry(ctx, entry); err != nil {
+				return pkgerror.BusinessErrorFrom(err)
+			}
+		}
+
+		if loan.MerchantID == 0 {
+			entry := entity.NewDisbursementEntry(snowflakeGen.Generate(), loan, disbursement.FeeAmount)
+			if _, err := repository.CreateJournalEntry(ctx, entry); err != nil {
+				return pkgerror.BusinessErrorFrom(err)
+			}
+
+			return nil
+		}
+
+		merchant, err := repository.GetMerchant(ctx, loan.MerchantID)
+		if err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		item := entity.NewMerchantSettlementItem(snowflakeGen.Generate(), merchant, loan, disbursement.Amount, completedAt, time.Now())
+		if err := repository.CreateMerchantSettlementItem(ctx, item); err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		entry := entity.NewMerchantDisbursementEntry(snowflakeGen.Generate(), loan, disbursement.FeeAmount, item.MDRAmount)
+		if _, err := repository.CreateJournalEntry(ctx, entry); err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Disbursement{}, err
+	}
+
+	return disbursement, nil
+}
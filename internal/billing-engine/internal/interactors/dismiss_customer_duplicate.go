@@ -0,0 +1,79 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.DismissCustomerDuplicateUsecase = (*DismissCustomerDuplicateInteractor)(nil)
+
+type (
+	DismissCustomerDuplicateRepository interface {
+		ResolveCustomerDuplicate(ctx context.Context, duplicateID uint64, status entity.DuplicateStatus, resolvedBy string, resolvedAt time.Time) (bool, error)
+	}
+
+	DismissCustomerDuplicateInteractorDependencies struct {
+		DismissCustomerDuplicateRepository DismissCustomerDuplicateRepository
+		Logger                             *zap.SugaredLogger
+		Validator                          *validator.Validate
+	}
+
+	DismissCustomerDuplicateInteractor struct {
+		repository DismissCustomerDuplicateRepository `validate:"required"`
+		logger     *zap.SugaredLogger                 `validate:"required"`
+		validator  *validator.Validate                `validate:"required"`
+	}
+)
+
+func NewDismissCustomerDuplicateInteractor(
+	deps DismissCustomerDuplicateInteractorDependencies,
+) *DismissCustomerDuplicateInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &DismissCustomerDuplicateInteractor{
+		repository: deps.DismissCustomerDuplicateRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.DismissCustomerDuplicateUsecase.
+//
+// A dismissed flag was reviewed and the two customers are different people,
+// both stay as they are.
+func (d *DismissCustomerDuplicateInteractor) Execute(ctx context.Context, input usecases.DismissCustomerDuplicateInput) (usecases.DismissCustomerDuplicateOutput, error) {
+	if err := d.validator.Struct(input); err != nil {
+		d.logger.Errorw("invalid input", "error", err)
+		return usecases.DismissCustomerDuplicateOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	dismissedAt := time.Now()
+	dismissed, err := d.repository.ResolveCustomerDuplicate(ctx, input.DuplicateID, entity.DUPLICATE_DISMISSED, input.DismissedBy, dismissedAt)
+	if err != nil {
+		d.logger.Errorw("failed to dismiss customer duplicate", "error", err, "duplicate_id", input.DuplicateID)
+		return usecases.DismissCustomerDuplicateOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !dismissed {
+		return usecases.DismissCustomerDuplicateOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("customer duplicate %d not found or already resolved", input.DuplicateID),
+		)
+	}
+
+	return usecases.DismissCustomerDuplicateOutput{
+		ID:          input.DuplicateID,
+		Status:      string(entity.DUPLICATE_DISMISSED),
+		DismissedBy: input.DismissedBy,
+		DismissedAt: dismissedAt.Format(time.RFC3339),
+	}, nil
+}
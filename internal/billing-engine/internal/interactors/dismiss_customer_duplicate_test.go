@@ -0,0 +1,86 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestDismissCustomerDuplicateInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         usecases.DismissCustomerDuplicateInput
+		setupMocks    func(*billingenginemocks.MockDismissCustomerDuplicateRepository)
+		expectedError error
+	}{
+		{
+			name:  "success - open flag dismissed",
+			input: usecases.DismissCustomerDuplicateInput{DuplicateID: 5, DismissedBy: "ops@example.com"},
+			setupMocks: func(mockRepo *billingenginemocks.MockDismissCustomerDuplicateRepository) {
+				mockRepo.On("ResolveCustomerDuplicate", mock.Anything, uint64(5), entity.DUPLICATE_DISMISSED, "ops@example.com", mock.Anything).
+					Return(true, nil)
+			},
+		},
+		{
+			name:  "business error - flag already resolved",
+			input: usecases.DismissCustomerDuplicateInput{DuplicateID: 5, DismissedBy: "ops@example.com"},
+			setupMocks: func(mockRepo *billingenginemocks.MockDismissCustomerDuplicateRepository) {
+				mockRepo.On("ResolveCustomerDuplicate", mock.Anything, uint64(5), entity.DUPLICATE_DISMISSED, "ops@example.com", mock.Anything).
+					Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "validation error - missing dismissed_by",
+			input:         usecases.DismissCustomerDuplicateInput{DuplicateID: 5},
+			setupMocks:    func(*billingenginemocks.MockDismissCustomerDuplicateRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "repository error",
+			input: usecases.DismissCustomerDuplicateInput{DuplicateID: 5, DismissedBy: "ops@example.com"},
+			setupMocks: func(mockRepo *billingenginemocks.MockDismissCustomerDuplicateRepository) {
+				mockRepo.On("ResolveCustomerDuplicate", mock.Anything, uint64(5), entity.DUPLICATE_DISMISSED, "ops@example.com", mock.Anything).
+					Return(false, errors.New("database error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockDismissCustomerDuplicateRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewDismissCustomerDuplicateInteractor(DismissCustomerDuplicateInteractorDependencies{
+				DismissCustomerDuplicateRepository: mockRepo,
+				Logger:                             zap.NewNop().Sugar(),
+				Validator:                          validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.input.DuplicateID, output.ID)
+				assert.Equal(t, "DISMISSED", output.Status)
+				assert.Equal(t, tt.input.DismissedBy, output.DismissedBy)
+				assert.NotEmpty(t, output.DismissedAt)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
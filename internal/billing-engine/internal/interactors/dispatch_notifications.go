@@ -0,0 +1,280 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.DispatchNotificationsUsecase = (*DispatchNotificationsInteractor)(nil)
+
+// reminderLeadDays is how many days before the due date the first reminder
+// is sent.
+const reminderLeadDays = 2
+
+type (
+	DispatchNotificationsRepository interface {
+		MarkMissedInstallments(ctx context.Context, asOf time.Time) error
+		GetPendingInstallmentTargetsDueOn(ctx context.Context, dueDate time.Time) ([]entity.NotificationTarget, error)
+		GetMissedInstallmentTargets(ctx context.Context) ([]entity.NotificationTarget, error)
+		GetDelinquentLoans(ctx context.Context) ([]entity.DelinquentLoan, error)
+		GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error)
+		IsCommunicationSent(ctx context.Context, eventKey string) (bool, error)
+		CreateCommunication(ctx context.Context, communication entity.Communication) (entity.Communication, error)
+	}
+
+	// NotificationProvider delivers a rendered message on a single channel and
+	// returns the provider's message id.
+	NotificationProvider interface {
+		Channel() entity.NotificationChannel
+		Send(ctx context.Context, message entity.NotificationMessage) (string, error)
+	}
+
+	DispatchNotificationsInteractorDependencies struct {
+		DispatchNotificationsRepository DispatchNotificationsRepository
+		NotificationProviders           []NotificationProvider
+		Logger                          *zap.SugaredLogger
+		Validator                       *validator.Validate
+		SnowflakeGen                    pkguid.Snowflake
+	}
+
+	DispatchNotificationsInteractor struct {
+		repository   DispatchNotificationsRepository `validate:"required"`
+		providers    []NotificationProvider          `validate:"required"`
+		logger       *zap.SugaredLogger              `validate:"required"`
+		validator    *validator.Validate             `validate:"required"`
+		snowflakeGen pkguid.Snowflake                `validate:"required"`
+	}
+
+	notificationJob struct {
+		event  entity.NotificationEvent
+		target entity.NotificationTarget
+	}
+)
+
+func NewDispatchNotificationsInteractor(
+	deps DispatchNotificationsInteractorDependencies,
+) *DispatchNotificationsInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &DispatchNotificationsInteractor{
+		repository:   deps.DispatchNotificationsRepository,
+		providers:    deps.NotificationProviders,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.DispatchNotificationsUsecase.
+//
+// It sends the T-2 and due-day reminders, the missed payment notices and the
+// delinquency escalations on every channel the borrower can be reached on.
+// Each event is sent at most once per channel; a failed delivery is logged
+// as FAILED and retried on the next run.
+func (d *DispatchNotificationsInteractor) Execute(ctx context.Context, input usecases.DispatchNotificationsInput) (usecases.DispatchNotificationsOutput, error) {
+	if err := d.validator.Struct(input); err != nil {
+		d.logger.Errorw("invalid input", "error", err)
+		return usecases.DispatchNotificationsOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	asOf, err := parseMarkingDate(input.AsOf)
+	if err != nil {
+		return usecases.DispatchNotificationsOutput{}, err
+	}
+
+	if err := d.repository.MarkMissedInstallments(ctx, asOf); err != nil {
+		d.logger.Errorw("failed to mark missed installments", "error", err)
+		return usecases.DispatchNotificationsOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	jobs, err := d.collectJobs(ctx, asOf)
+	if err != nil {
+		return usecases.DispatchNotificationsOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.DispatchNotificationsOutput{
+		AsOf:           asOf.Format(dateLayout),
+		Communications: []usecases.CommunicationOutput{},
+	}
+
+	for _, job := range jobs {
+		for _, provider := range d.providers {
+			communication, sent, err := d.dispatch(ctx, provider, job)
+			if err != nil {
+				return usecases.DispatchNotificationsOutput{}, pkgerror.BusinessErrorFrom(err)
+			}
+
+			if !sent {
+				continue
+			}
+
+			if communication.Status == entity.COMMUNICATION_SENT {
+				output.Sent++
+			} else {
+				output.Failed++
+			}
+
+			output.Communications = append(output.Communications, toCommunicationOutput(communication))
+		}
+	}
+
+	return output, nil
+}
+
+func (d *DispatchNotificationsInteractor) collectJobs(ctx context.Context, asOf time.Time) ([]notificationJob, error) {
+	var jobs []notificationJob
+
+	upcoming, err := d.repository.GetPendingInstallmentTargetsDueOn(ctx, asOf.AddDate(0, 0, reminderLeadDays))
+	if err != nil {
+		d.logger.Errorw("failed to get upcoming installments", "error", err)
+		return nil, err
+	}
+	jobs = appendNotificationJobs(jobs, entity.NOTIFICATION_EVENT_REMINDER_T_MINUS_2, upcoming)
+
+	dueToday, err := d.repository.GetPendingInstallmentTargetsDueOn(ctx, asOf)
+	if err != nil {
+		d.logger.Errorw("failed to get installments due today", "error", err)
+		return nil, err
+	}
+	jobs = appendNotificationJobs(jobs, entity.NOTIFICATION_EVENT_REMINDER_DUE_DAY, dueToday)
+
+	missed, err := d.repository.GetMissedInstallmentTargets(ctx)
+	if err != nil {
+		d.logger.Errorw("failed to get missed installments", "error", err)
+		return nil, err
+	}
+	jobs = appendNotificationJobs(jobs, entity.NOTIFICATION_EVENT_MISSED_PAYMENT, missed)
+
+	delinquentLoans, err := d.repository.GetDelinquentLoans(ctx)
+	if err != nil {
+		d.logger.Errorw("failed to get delinquent loans", "error", err)
+		return nil, err
+	}
+
+	for _, loan := range delinquentLoans {
+		customer, err := d.repository.GetCustomer(ctx, loan.CustomerID)
+		if err != nil {
+			d.logger.Errorw("failed to get customer", "error", err, "customer_id", loan.CustomerID)
+			return nil, err
+		}
+
+		jobs = append(jobs, notificationJob{
+			event: entity.NOTIFICATION_EVENT_DELINQUENCY_ESCALATION,
+			target: entity.NotificationTarget{
+				LoanID:       loan.LoanID,
+				CustomerID:   loan.CustomerID,
+				WeekNumber:   loan.LatestMissedWeek,
+				DueDate:      loan.OldestMissedDue,
+				CustomerName: customer.Name,
+				Email:        customer.Email,
+				Phone:        customer.Phone,
+				Language:     customer.Language,
+			},
+		})
+	}
+
+	return jobs, nil
+}
+
+// dispatch sends one job on one provider. It reports false when the borrower
+// has no address on the channel or the notice was already delivered.
+func (d *DispatchNotificationsInteractor) dispatch(ctx context.Context, provider NotificationProvider, job notificationJob) (entity.Communication, bool, error) {
+	channel := provider.Channel()
+
+	recipient := job.target.Recipient(channel)
+	if recipient == "" {
+		return entity.Communication{}, false, nil
+	}
+
+	eventKey := entity.NotificationEventKey(job.event, channel, job.target)
+
+	alreadySent, err := d.repository.IsCommunicationSent(ctx, eventKey)
+	if err != nil {
+		d.logger.Errorw("failed to check communication log", "error", err, "event_key", eventKey)
+		return entity.Communication{}, false, err
+	}
+
+	if alreadySent {
+		return entity.Communication{}, false, nil
+	}
+
+	subject, body, err := entity.RenderNotification(job.event, job.target)
+	if err != nil {
+		d.logger.Errorw("failed to render notification", "error", err, "event", job.event)
+		return entity.Communication{}, false, err
+	}
+
+	communication := entity.Communication{
+		ID:            d.snowflakeGen.Generate(),
+		LoanID:        job.target.LoanID,
+		CustomerID:    job.target.CustomerID,
+		InstallmentID: job.target.InstallmentID,
+		Event:         job.event,
+		Channel:       channel,
+		Language:      job.target.Language,
+		Recipient:     recipient,
+		Subject:       subject,
+		Body:          body,
+		Status:        entity.COMMUNICATION_SENT,
+		EventKey:      eventKey,
+		SentAt:        time.Now(),
+	}
+
+	messageID, err := provider.Send(ctx, entity.NotificationMessage{
+		Channel:   channel,
+		Recipient: recipient,
+		Subject:   subject,
+		Body:      body,
+	})
+	if err != nil {
+		d.logger.Errorw("failed to send notification", "error", err, "loan_id", job.target.LoanID, "channel", channel)
+		communication.Status = entity.COMMUNICATION_FAILED
+		communication.ErrorMessage = err.Error()
+	}
+	communication.ProviderMessageID = messageID
+
+	communication, err = d.repository.CreateCommunication(ctx, communication)
+	if err != nil {
+		d.logger.Errorw("failed to create communication", "error", err, "loan_id", job.target.LoanID)
+		return entity.Communication{}, false, err
+	}
+
+	return communication, true, nil
+}
+
+func appendNotificationJobs(jobs []notificationJob, event entity.NotificationEvent, targets []entity.NotificationTarget) []notificationJob {
+	for _, target := range targets {
+		jobs = append(jobs, notificationJob{event: event, target: target})
+	}
+
+	return jobs
+}
+
+func toCommunicationOutput(communication entity.Communication) usecases.CommunicationOutput {
+	return usecases.CommunicationOutput{
+		ID:                communication.ID,
+		LoanID:            communication.LoanID,
+		CustomerID:        communication.CustomerID,
+		InstallmentID:     communication.InstallmentID,
+		Event:             string(communication.Event),
+		Channel:           string(communication.Channel),
+		Language:          string(communication.Language),
+		Recipient:         communication.Recipient,
+		Subject:           communication.Subject,
+		Body:              communication.Body,
+		Status:            string(communication.Status),
+		ProviderMessageID: communication.ProviderMessageID,
+		ErrorMessage:      communication.ErrorMessage,
+		SentAt:            communication.SentAt,
+	}
+}
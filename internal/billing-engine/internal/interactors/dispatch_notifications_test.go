@@ -0,0 +1,182 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestDispatchNotificationsInteractor_Execute(t *testing.T) {
+	asOf := time.Date(2024, 3, 10, 0, 0, 0, 0, time.Local)
+	upcoming := entity.NotificationTarget{
+		LoanID: 100, CustomerID: 1, InstallmentID: 7, WeekNumber: 7,
+		DueDate: asOf.AddDate(0, 0, 2), AmountDue: "110000",
+		CustomerName: "Budi", Email: "budi@example.com", Phone: "+628111111111", Language: entity.LANGUAGE_INDONESIAN,
+	}
+	missedNoPhone := entity.NotificationTarget{
+		LoanID: 200, CustomerID: 2, InstallmentID: 15, WeekNumber: 3,
+		DueDate: asOf.AddDate(0, 0, -7), AmountDue: "110000",
+		CustomerName: "Jane", Email: "jane@example.com", Language: entity.LANGUAGE_ENGLISH,
+	}
+	delinquentLoan := entity.DelinquentLoan{
+		LoanID: 200, CustomerID: 2, OldestMissedDue: asOf.AddDate(0, 0, -14), LatestMissedWeek: 3, MissedInstallments: 2,
+	}
+	customer := entity.Customer{ID: 2, Name: "Jane", Email: "jane@example.com", Language: entity.LANGUAGE_ENGLISH}
+
+	echoCommunication := func(_ context.Context, communication entity.Communication) (entity.Communication, error) {
+		return communication, nil
+	}
+
+	tests := []struct {
+		name           string
+		input          usecases.DispatchNotificationsInput
+		setupMocks     func(*billingenginemocks.MockDispatchNotificationsRepository, *billingenginemocks.MockNotificationProvider, *billingenginemocks.MockNotificationProvider, *pkgmocks.MockSnowflake)
+		expectedSent   int
+		expectedFailed int
+		expectedEvents []string
+		expectedError  error
+	}{
+		{
+			name:  "success - reminders, missed notice and escalation are sent on reachable channels",
+			input: usecases.DispatchNotificationsInput{AsOf: "2024-03-10"},
+			setupMocks: func(
+				mockRepo *billingenginemocks.MockDispatchNotificationsRepository,
+				emailProvider *billingenginemocks.MockNotificationProvider,
+				smsProvider *billingenginemocks.MockNotificationProvider,
+				mockSnowflake *pkgmocks.MockSnowflake,
+			) {
+				mockRepo.On("MarkMissedInstallments", mock.Anything, asOf).Return(nil)
+				mockRepo.On("GetPendingInstallmentTargetsDueOn", mock.Anything, asOf.AddDate(0, 0, 2)).
+					Return([]entity.NotificationTarget{upcoming}, nil)
+				mockRepo.On("GetPendingInstallmentTargetsDueOn", mock.Anything, asOf).
+					Return([]entity.NotificationTarget{}, nil)
+				mockRepo.On("GetMissedInstallmentTargets", mock.Anything).
+					Return([]entity.NotificationTarget{missedNoPhone}, nil)
+				mockRepo.On("GetDelinquentLoans", mock.Anything).
+					Return([]entity.DelinquentLoan{delinquentLoan}, nil)
+				mockRepo.On("GetCustomer", mock.Anything, uint64(2)).Return(customer, nil)
+
+				emailProvider.On("Channel").Return(entity.NOTIFICATION_CHANNEL_EMAIL)
+				smsProvider.On("Channel").Return(entity.NOTIFICATION_CHANNEL_SMS)
+
+				mockRepo.On("IsCommunicationSent", mock.Anything, "MISSED_PAYMENT:EMAIL:installment:15").Return(true, nil)
+				mockRepo.On("IsCommunicationSent", mock.Anything, mock.Anything).Return(false, nil)
+
+				emailProvider.On("Send", mock.Anything, mock.Anything).Return("email-1", nil)
+				smsProvider.On("Send", mock.Anything, mock.Anything).Return("", errors.New("gateway down"))
+
+				mockSnowflake.On("Generate").Return(uint64(1))
+				mockRepo.EXPECT().CreateCommunication(mock.Anything, mock.Anything).RunAndReturn(echoCommunication)
+			},
+			expectedSent:   2,
+			expectedFailed: 1,
+			expectedEvents: []string{"REMINDER_T_MINUS_2", "REMINDER_T_MINUS_2", "DELINQUENCY_ESCALATION"},
+		},
+		{
+			name:  "error - invalid as of date",
+			input: usecases.DispatchNotificationsInput{AsOf: "10-03-2024"},
+			setupMocks: func(*billingenginemocks.MockDispatchNotificationsRepository, *billingenginemocks.MockNotificationProvider, *billingenginemocks.MockNotificationProvider, *pkgmocks.MockSnowflake) {
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - as_of in the future would mark installments not yet due",
+			input: usecases.DispatchNotificationsInput{AsOf: time.Now().AddDate(0, 0, 7).Format("2006-01-02")},
+			setupMocks: func(*billingenginemocks.MockDispatchNotificationsRepository, *billingenginemocks.MockNotificationProvider, *billingenginemocks.MockNotificationProvider, *pkgmocks.MockSnowflake) {
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on mark missed installments",
+			input: usecases.DispatchNotificationsInput{AsOf: "2024-03-10"},
+			setupMocks: func(
+				mockRepo *billingenginemocks.MockDispatchNotificationsRepository,
+				_ *billingenginemocks.MockNotificationProvider,
+				_ *billingenginemocks.MockNotificationProvider,
+				_ *pkgmocks.MockSnowflake,
+			) {
+				mockRepo.On("MarkMissedInstallments", mock.Anything, asOf).Return(errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on create communication",
+			input: usecases.DispatchNotificationsInput{AsOf: "2024-03-10"},
+			setupMocks: func(
+				mockRepo *billingenginemocks.MockDispatchNotificationsRepository,
+				emailProvider *billingenginemocks.MockNotificationProvider,
+				_ *billingenginemocks.MockNotificationProvider,
+				mockSnowflake *pkgmocks.MockSnowflake,
+			) {
+				mockRepo.On("MarkMissedInstallments", mock.Anything, asOf).Return(nil)
+				mockRepo.On("GetPendingInstallmentTargetsDueOn", mock.Anything, asOf.AddDate(0, 0, 2)).
+					Return([]entity.NotificationTarget{upcoming}, nil)
+				mockRepo.On("GetPendingInstallmentTargetsDueOn", mock.Anything, asOf).
+					Return([]entity.NotificationTarget{}, nil)
+				mockRepo.On("GetMissedInstallmentTargets", mock.Anything).Return([]entity.NotificationTarget{}, nil)
+				mockRepo.On("GetDelinquentLoans", mock.Anything).Return([]entity.DelinquentLoan{}, nil)
+
+				emailProvider.On("Channel").Return(entity.NOTIFICATION_CHANNEL_EMAIL)
+				emailProvider.On("Send", mock.Anything, mock.Anything).Return("email-1", nil)
+
+				mockRepo.On("IsCommunicationSent", mock.Anything, mock.Anything).Return(false, nil)
+				mockSnowflake.On("Generate").Return(uint64(1))
+				mockRepo.On("CreateCommunication", mock.Anything, mock.Anything).
+					Return(entity.Communication{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockDispatchNotificationsRepository(t)
+			emailProvider := billingenginemocks.NewMockNotificationProvider(t)
+			smsProvider := billingenginemocks.NewMockNotificationProvider(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+
+			tt.setupMocks(mockRepo, emailProvider, smsProvider, mockSnowflake)
+
+			providers := []NotificationProvider{emailProvider}
+			if tt.expectedSent+tt.expectedFailed > 0 {
+				providers = append(providers, smsProvider)
+			}
+
+			interactor := NewDispatchNotificationsInteractor(DispatchNotificationsInteractorDependencies{
+				DispatchNotificationsRepository: mockRepo,
+				NotificationProviders:           providers,
+				Logger:                          zap.NewNop().Sugar(),
+				Validator:                       validator.New(),
+				SnowflakeGen:                    mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedSent, output.Sent)
+				assert.Equal(t, tt.expectedFailed, output.Failed)
+
+				events := make([]string, len(output.Communications))
+				for i, communication := range output.Communications {
+					events[i] = communication.Event
+				}
+				assert.Equal(t, tt.expectedEvents, events)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.DrawCreditLineUsecase = (*DrawCreditLineInteractor)(nil)
+
+type (
+	DrawCreditLineRepository interface {
+		ProductFeeRepository
+		MerchantOrderRepository
+		EligibilityRepository
+		IsCustomerExist(ctx context.Context, customerID uint64) (bool, error)
+		GetCreditLine(ctx context.Context, creditLineID uint64) (entity.CreditLine, error)
+		IsCreditLineOverdue(ctx context.Context, creditLineID uint64) (bool, error)
+		GetCreditLineUsed(ctx context.Context, creditLineID uint64) (decimal.Decimal, error)
+		CreateLoanApplication(ctx context.Context, loan entity.Loan) (entity.Loan, error)
+		CreateLoanDisclosure(ctx context.Context, disclosure entity.LoanDisclosure) error
+		TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error
+	}
+
+	DrawCreditLineInteractorDependencies struct {
+		DrawCreditLineRepository DrawCreditLineRepository
+		Logger                   *zap.SugaredLogger
+		Validator                *validator.Validate
+		SnowflakeGen             pkguid.Snowflake
+	}
+
+	DrawCreditLineInteractor struct {
+		repository   DrawCreditLineRepository `validate:"required"`
+		logger       *zap.SugaredLogger       `validate:"required"`
+		validator    *validator.Validate      `validate:"required"`
+		snowflakeGen pkguid.Snowflake         `validate:"required"`
+	}
+)
+
+func NewDrawCreditLineInteractor(
+	deps DrawCreditLineInteractorDependencies,
+) *DrawCreditLineInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &DrawCreditLineInteractor{
+		repository:   deps.DrawCreditLineRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.DrawCreditLineUsecase.
+//
+// A drawdown is a loan of the line's product, applied for and approved at
+// once as the line's limit was granted already. The customer still has to
+// pass the checks of a loan application for the amount drawn, and a deleted
+// customer cannot draw. It is paid out like any approved loan, which starts
+// its own schedule.
+func (d *DrawCreditLineInteractor) Execute(ctx context.Context, input usecases.DrawCreditLineInput) (usecases.LoanOutput, error) {
+	if err := d.validator.Struct(input); err != nil {
+		d.logger.Errorw("invalid input", "error", err)
+		return usecases.LoanOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	line, err := d.repository.GetCreditLine(ctx, input.CreditLineID)
+	if err != nil {
+		d.logger.Errorw("failed to get credit line", "error", err, "credit_line_id", input.CreditLineID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	isCustomerExist, err := d.repository.IsCustomerExist(ctx, line.CustomerID)
+	if err != nil {
+		d.logger.Errorw("failed to check if customer exists", "error", err, "customer_id", line.CustomerID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !isCustomerExist {
+		return usecases.LoanOutput{}, pkgerror.NewBusinessError("customer not found")
+	}
+
+	loan := entity.NewLoanApplication(line.CustomerID, input.RequestedBy)
+	if err := applyRequestedTerms(loan, line.ProductCode, input.Amount, input.TermWeeks); err != nil {
+		return usecases.LoanOutput{}, err
+	}
+	loan.CreditLineID = line.ID
+
+	if err := tagMerchantOrder(ctx, d.repository, loan, input.MerchantID, input.OrderRef); err != nil {
+		d.logger.Errorw("failed to tag merchant order", "error", err, "merchant_id", input.MerchantID)
+		return usecases.LoanOutput{}, err
+	}
+
+	isOverdue, err := d.repository.IsCreditLineOverdue(ctx, line.ID)
+	if err != nil {
+		d.logger.Errorw("failed to check overdue bills", "error", err, "credit_line_id", line.ID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if isOverdue {
+		return usecases.LoanOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("credit line %d has an overdue bill, pay it before drawing again", line.ID),
+		)
+	}
+
+	used, err := d.repository.GetCreditLineUsed(ctx, line.ID)
+	if err != nil {
+		d.logger.Errorw("failed to get credit line usage", "error", err, "credit_line_id", line.ID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !line.Allows(used, loan.PrincipalAmount) {
+		return usecases.LoanOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf(
+				"drawdown of %s exceeds the %s available on credit line %d",
+				loan.PrincipalAmount.StringFixed(2), line.Available(used).StringFixed(2), line.ID,
+			),
+		)
+	}
+
+	checks, err := runEligibilityChecks(ctx, d.repository, line.CustomerID, loan.PrincipalAmount)
+	if err != nil {
+		d.logger.Errorw("failed to run eligibility checks", "error", err, "customer_id", line.CustomerID)
+		return usecases.LoanOutput{}, err
+	}
+
+	if failed := failedEligibilityChecks(checks); len(failed) > 0 {
+		return usecases.LoanOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("customer %d is not eligible for a drawdown: %s", line.CustomerID, strings.Join(failed, ", ")),
+		)
+	}
+
+	now := time.Now()
+	quote, err := quoteLoan(ctx, d.repository, *loan, startOfDay(now))
+	if err != nil {
+		d.logger.Errorw("failed to quote drawdown", "error", err, "credit_line_id", line.ID)
+		return usecases.LoanOutput{}, err
+	}
+
+	loan.ID = d.snowflakeGen.Generate()
+
+	createdLoan, err := d.repository.CreateLoanApplication(ctx, *loan)
+	if err != nil {
+		d.logger.Errorw("failed to create drawdown", "error", err, "credit_line_id", line.ID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	disclosure := entity.NewLoanDisclosure(createdLoan.ID, quote, now)
+	if err := d.repository.CreateLoanDisclosure(ctx, disclosure); err != nil {
+		d.logger.Errorw("failed to create loan disclosure", "error", err, "loan_id", createdLoan.ID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	transition, err := newLoanTransition(createdLoan, entity.LOAN_APPROVED, entity.SYSTEM_ACTOR, fmt.Sprintf("drawdown within the limit of credit line %d", line.ID))
+	if err != nil {
+		return usecases.LoanOutput{}, err
+	}
+
+	if err := d.repository.TransitionLoanStatus(ctx, transition); err != nil {
+		d.logger.Errorw("failed to approve drawdown", "error", err, "loan_id", createdLoan.ID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	createdLoan.Status = entity.LOAN_APPROVED
+
+	output := toLoanOutput(createdLoan)
+	disclosureOutput := toLoanDisclosureOutput(disclosure)
+	output.Disclosure = &disclosureOutput
+
+	return output, nil
+}
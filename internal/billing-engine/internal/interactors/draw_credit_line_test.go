@@ -0,0 +1,186 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestDrawCreditLineInteractor_Execute(t *testing.T) {
+	line := entity.CreditLine{ID: 700, CustomerID: 10, Limit: decimal.NewFromInt(2000000), ProductCode: "PAYLATER"}
+	input := usecases.DrawCreditLineInput{
+		CreditLineID: 700,
+		Amount:       "500000",
+		TermWeeks:    4,
+		RequestedBy:  "customer-app",
+	}
+	limit := entity.CreditLimit{CustomerID: 10, Limit: decimal.NewFromInt(5000000), MaxActiveLoans: 3}
+	exposure := entity.CustomerExposure{CustomerID: 10, ActiveLoans: 2, Outstanding: decimal.NewFromInt(1500000)}
+
+	tests := []struct {
+		name          string
+		input         usecases.DrawCreditLineInput
+		setupMocks    func(*billingenginemocks.MockDrawCreditLineRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.LoanOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - drawdown approved within the available limit",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDrawCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(true, nil)
+				mockRepo.On("IsCreditLineOverdue", mock.Anything, uint64(700)).Return(false, nil)
+				mockRepo.On("GetCreditLineUsed", mock.Anything, uint64(700)).Return(decimal.NewFromInt(1500000), nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(10)).Return(limit, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(10)).Return(exposure, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(10)).Return(false, nil)
+				mockRepo.On("GetProductFees", mock.Anything, "PAYLATER").Return([]entity.ProductFee(nil), nil)
+				mockSnowflake.On("Generate").Return(uint64(100))
+				mockRepo.EXPECT().CreateLoanApplication(mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
+					return loan.ID == 100 && loan.CustomerID == 10 && loan.CreditLineID == 700 && loan.ProductCode == "PAYLATER" &&
+						loan.PrincipalAmount.Equal(decimal.NewFromInt(500000)) && loan.TermWeeks == 4 && loan.Status == entity.LOAN_APPLIED
+				})).RunAndReturn(func(_ context.Context, loan entity.Loan) (entity.Loan, error) {
+					return loan, nil
+				})
+				mockRepo.On("CreateLoanDisclosure", mock.Anything, mock.MatchedBy(func(disclosure entity.LoanDisclosure) bool {
+					return disclosure.LoanID == 100
+				})).Return(nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.MatchedBy(func(transition entity.LoanStatusTransition) bool {
+					return transition.LoanID == 100 && transition.FromStatus == entity.LOAN_APPLIED &&
+						transition.ToStatus == entity.LOAN_APPROVED && transition.Actor == entity.SYSTEM_ACTOR
+				})).Return(nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.LoanOutput) {
+				assert.Equal(t, uint64(100), output.ID)
+				assert.Equal(t, uint64(700), output.CreditLineID)
+				assert.Equal(t, "APPROVED", output.Status)
+				assert.Equal(t, "PAYLATER", output.ProductCode)
+				assert.NotNil(t, output.Disclosure)
+			},
+		},
+		{
+			name:  "error - drawdown over the available limit",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDrawCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(true, nil)
+				mockRepo.On("IsCreditLineOverdue", mock.Anything, uint64(700)).Return(false, nil)
+				mockRepo.On("GetCreditLineUsed", mock.Anything, uint64(700)).Return(decimal.NewFromInt(1600000), nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - drawdown over what is left of the customer's credit limit",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDrawCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(true, nil)
+				mockRepo.On("IsCreditLineOverdue", mock.Anything, uint64(700)).Return(false, nil)
+				mockRepo.On("GetCreditLineUsed", mock.Anything, uint64(700)).Return(decimal.Zero, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(10)).Return(limit, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(10)).Return(entity.CustomerExposure{CustomerID: 10, ActiveLoans: 1, Outstanding: decimal.NewFromInt(4600000)}, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(10)).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer has a written off loan",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDrawCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(true, nil)
+				mockRepo.On("IsCreditLineOverdue", mock.Anything, uint64(700)).Return(false, nil)
+				mockRepo.On("GetCreditLineUsed", mock.Anything, uint64(700)).Return(decimal.Zero, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(10)).Return(limit, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(10)).Return(exposure, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(10)).Return(true, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer of the line deleted",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDrawCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - line has an overdue bill",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDrawCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(true, nil)
+				mockRepo.On("IsCreditLineOverdue", mock.Anything, uint64(700)).Return(true, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - amount with more than 2 decimals",
+			input: usecases.DrawCreditLineInput{
+				CreditLineID: 700, Amount: "100.001", TermWeeks: 4, RequestedBy: "customer-app",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockDrawCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(true, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - validation error (no term)",
+			input: usecases.DrawCreditLineInput{
+				CreditLineID: 700, Amount: "500000", RequestedBy: "customer-app",
+			},
+			setupMocks:    func(*billingenginemocks.MockDrawCreditLineRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - credit line not found",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockDrawCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).
+					Return(entity.CreditLine{}, errors.New("credit line 700 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockDrawCreditLineRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewDrawCreditLineInteractor(DrawCreditLineInteractorDependencies{
+				DrawCreditLineRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+				Validator:                validator.New(),
+				SnowflakeGen:             mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
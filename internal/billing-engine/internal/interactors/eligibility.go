@@ -0,0 +1,68 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+)
+
+// EligibilityRepository is embedded by the repositories of the write paths
+// that extend credit to a customer, a loan application, a credit line or a
+// drawdown on it.
+type EligibilityRepository interface {
+	CustomerExposureRepository
+	IsCustomerHasWrittenOffLoan(ctx context.Context, customerID uint64) (bool, error)
+}
+
+// runEligibilityChecks runs the checks a customer has to pass to be extended
+// principal more credit.
+func runEligibilityChecks(ctx context.Context, repository EligibilityRepository, customerID uint64, principal decimal.Decimal) ([]entity.EligibilityCheck, error) {
+	limit, err := repository.GetCreditLimit(ctx, customerID)
+	if err != nil {
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	exposure, err := repository.GetCustomerExposure(ctx, customerID)
+	if err != nil {
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	isCustomerHasWrittenOffLoan, err := repository.IsCustomerHasWrittenOffLoan(ctx, customerID)
+	if err != nil {
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	activeLoanLimit := entity.EligibilityCheck{Name: entity.ELIGIBILITY_ACTIVE_LOAN_LIMIT, Passed: exposure.ActiveLoans < limit.MaxActiveLoans}
+	if !activeLoanLimit.Passed {
+		activeLoanLimit.Detail = fmt.Sprintf("customer has %d active loans of %d allowed", exposure.ActiveLoans, limit.MaxActiveLoans)
+	}
+
+	creditLimit := entity.EligibilityCheck{Name: entity.ELIGIBILITY_CREDIT_LIMIT, Passed: limit.Allows(exposure, principal)}
+	if !creditLimit.Passed {
+		creditLimit.Detail = fmt.Sprintf(
+			"principal %s exceeds the %s left of the %s credit limit",
+			principal.StringFixed(2), limit.Available(exposure).StringFixed(2), limit.Limit.StringFixed(2),
+		)
+	}
+
+	noWrittenOffLoan := entity.EligibilityCheck{Name: entity.ELIGIBILITY_NO_WRITTEN_OFF_LOAN, Passed: !isCustomerHasWrittenOffLoan}
+	if isCustomerHasWrittenOffLoan {
+		noWrittenOffLoan.Detail = "customer has a written off loan"
+	}
+
+	return []entity.EligibilityCheck{activeLoanLimit, creditLimit, noWrittenOffLoan}, nil
+}
+
+func failedEligibilityChecks(checks []entity.EligibilityCheck) []string {
+	var failed []string
+	for _, check := range checks {
+		if !check.Passed {
+			failed = append(failed, fmt.Sprintf("%s (%s)", check.Name, check.Detail))
+		}
+	}
+
+	return failed
+}
@@ -0,0 +1,108 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.EvaluatePromisesToPayUsecase = (*EvaluatePromisesToPayInteractor)(nil)
+
+type (
+	EvaluatePromisesToPayRepository interface {
+		GetPendingPromisesToPayDueBefore(ctx context.Context, asOf time.Time) ([]entity.PromiseToPay, error)
+		GetTotalPaidForLoanBetween(ctx context.Context, loanID uint64, from time.Time, to time.Time) (decimal.Decimal, error)
+		UpdatePromiseToPayStatus(ctx context.Context, promiseID uint64, status entity.PromiseToPayStatus) error
+	}
+
+	EvaluatePromisesToPayInteractorDependencies struct {
+		EvaluatePromisesToPayRepository EvaluatePromisesToPayRepository
+		Logger                          *zap.SugaredLogger
+		Validator                       *validator.Validate
+	}
+
+	EvaluatePromisesToPayInteractor struct {
+		repository EvaluatePromisesToPayRepository `validate:"required"`
+		logger     *zap.SugaredLogger              `validate:"required"`
+		validator  *validator.Validate             `validate:"required"`
+	}
+)
+
+func NewEvaluatePromisesToPayInteractor(
+	deps EvaluatePromisesToPayInteractorDependencies,
+) *EvaluatePromisesToPayInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &EvaluatePromisesToPayInteractor{
+		repository: deps.EvaluatePromisesToPayRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.EvaluatePromisesToPayUsecase.
+//
+// A promise is KEPT when the payments booked between the moment it was made
+// and the end of the promised date cover the promised amount, otherwise it is
+// flagged as BROKEN. Only promises whose date has fully passed are evaluated.
+func (e *EvaluatePromisesToPayInteractor) Execute(ctx context.Context, input usecases.EvaluatePromisesToPayInput) (usecases.EvaluatePromisesToPayOutput, error) {
+	if err := e.validator.Struct(input); err != nil {
+		e.logger.Errorw("invalid input", "error", err)
+		return usecases.EvaluatePromisesToPayOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	asOf, err := parseAsOfDate(input.AsOf)
+	if err != nil {
+		return usecases.EvaluatePromisesToPayOutput{}, err
+	}
+
+	promises, err := e.repository.GetPendingPromisesToPayDueBefore(ctx, asOf)
+	if err != nil {
+		e.logger.Errorw("failed to get pending promises to pay", "error", err)
+		return usecases.EvaluatePromisesToPayOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.EvaluatePromisesToPayOutput{
+		AsOf:   asOf.Format(dateLayout),
+		Kept:   []usecases.PromiseToPayOutput{},
+		Broken: []usecases.PromiseToPayOutput{},
+	}
+
+	for _, promise := range promises {
+		endOfPromisedDate := promise.PromisedDate.AddDate(0, 0, 1)
+
+		totalPaid, err := e.repository.GetTotalPaidForLoanBetween(ctx, promise.LoanID, promise.CreatedAt, endOfPromisedDate)
+		if err != nil {
+			e.logger.Errorw("failed to get total paid for loan", "error", err, "loan_id", promise.LoanID)
+			return usecases.EvaluatePromisesToPayOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		promise.Status = entity.PROMISE_TO_PAY_BROKEN
+		if totalPaid.GreaterThanOrEqual(promise.PromisedAmount) {
+			promise.Status = entity.PROMISE_TO_PAY_KEPT
+		}
+
+		if err := e.repository.UpdatePromiseToPayStatus(ctx, promise.ID, promise.Status); err != nil {
+			e.logger.Errorw("failed to update promise to pay status", "error", err, "promise_id", promise.ID)
+			return usecases.EvaluatePromisesToPayOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		if promise.Status == entity.PROMISE_TO_PAY_KEPT {
+			output.Kept = append(output.Kept, toPromiseToPayOutput(promise))
+			continue
+		}
+
+		output.Broken = append(output.Broken, toPromiseToPayOutput(promise))
+	}
+
+	return output, nil
+}
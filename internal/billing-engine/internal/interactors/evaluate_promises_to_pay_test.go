@@ -0,0 +1,107 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestEvaluatePromisesToPayInteractor_Execute(t *testing.T) {
+	asOf := time.Date(2024, 3, 10, 0, 0, 0, 0, time.Local)
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.Local)
+	promisedDate := time.Date(2024, 3, 5, 0, 0, 0, 0, time.Local)
+	keptPromise := entity.PromiseToPay{
+		ID: 1, CaseID: 11, LoanID: 100, PromisedDate: promisedDate,
+		PromisedAmount: decimal.NewFromInt(220000), Status: entity.PROMISE_TO_PAY_PENDING, CreatedAt: createdAt,
+	}
+	brokenPromise := entity.PromiseToPay{
+		ID: 2, CaseID: 12, LoanID: 200, PromisedDate: promisedDate,
+		PromisedAmount: decimal.NewFromInt(110000), Status: entity.PROMISE_TO_PAY_PENDING, CreatedAt: createdAt,
+	}
+
+	tests := []struct {
+		name           string
+		input          usecases.EvaluatePromisesToPayInput
+		setupMocks     func(*billingenginemocks.MockEvaluatePromisesToPayRepository)
+		expectedOutput usecases.EvaluatePromisesToPayOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - promises are flagged as kept or broken",
+			input: usecases.EvaluatePromisesToPayInput{AsOf: "2024-03-10"},
+			setupMocks: func(mockRepo *billingenginemocks.MockEvaluatePromisesToPayRepository) {
+				mockRepo.On("GetPendingPromisesToPayDueBefore", mock.Anything, asOf).
+					Return([]entity.PromiseToPay{keptPromise, brokenPromise}, nil)
+				mockRepo.On("GetTotalPaidForLoanBetween", mock.Anything, uint64(100), createdAt, promisedDate.AddDate(0, 0, 1)).
+					Return(decimal.NewFromInt(220000), nil)
+				mockRepo.On("GetTotalPaidForLoanBetween", mock.Anything, uint64(200), createdAt, promisedDate.AddDate(0, 0, 1)).
+					Return(decimal.Zero, nil)
+				mockRepo.On("UpdatePromiseToPayStatus", mock.Anything, uint64(1), entity.PROMISE_TO_PAY_KEPT).Return(nil)
+				mockRepo.On("UpdatePromiseToPayStatus", mock.Anything, uint64(2), entity.PROMISE_TO_PAY_BROKEN).Return(nil)
+			},
+			expectedOutput: usecases.EvaluatePromisesToPayOutput{
+				AsOf: "2024-03-10",
+				Kept: []usecases.PromiseToPayOutput{
+					{ID: 1, CaseID: 11, LoanID: 100, PromisedDate: "2024-03-05", PromisedAmount: "220000", Status: "KEPT"},
+				},
+				Broken: []usecases.PromiseToPayOutput{
+					{ID: 2, CaseID: 12, LoanID: 200, PromisedDate: "2024-03-05", PromisedAmount: "110000", Status: "BROKEN"},
+				},
+			},
+		},
+		{
+			name:          "error - invalid as of date",
+			input:         usecases.EvaluatePromisesToPayInput{AsOf: "10-03-2024"},
+			setupMocks:    func(*billingenginemocks.MockEvaluatePromisesToPayRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on update",
+			input: usecases.EvaluatePromisesToPayInput{AsOf: "2024-03-10"},
+			setupMocks: func(mockRepo *billingenginemocks.MockEvaluatePromisesToPayRepository) {
+				mockRepo.On("GetPendingPromisesToPayDueBefore", mock.Anything, asOf).
+					Return([]entity.PromiseToPay{brokenPromise}, nil)
+				mockRepo.On("GetTotalPaidForLoanBetween", mock.Anything, uint64(200), mock.Anything, mock.Anything).
+					Return(decimal.Zero, nil)
+				mockRepo.On("UpdatePromiseToPayStatus", mock.Anything, uint64(2), entity.PROMISE_TO_PAY_BROKEN).
+					Return(errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockEvaluatePromisesToPayRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewEvaluatePromisesToPayInteractor(EvaluatePromisesToPayInteractorDependencies{
+				EvaluatePromisesToPayRepository: mockRepo,
+				Logger:                          zap.NewNop().Sugar(),
+				Validator:                       validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
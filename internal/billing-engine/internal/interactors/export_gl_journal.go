@@ -0,0 +1,229 @@
+package interactors
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.ExportGLJournalUsecase = (*ExportGLJournalInteractor)(nil)
+
+const (
+	glExportFormatCSV  = "csv"
+	glExportFormatJSON = "json"
+)
+
+type (
+	ExportGLJournalRepository interface {
+		GetGLMappings(ctx context.Context) ([]entity.GLMapping, error)
+		GetJournalEntriesByDate(ctx context.Context, date time.Time) ([]entity.JournalEntry, error)
+	}
+
+	ExportGLJournalInteractorDependencies struct {
+		ExportGLJournalRepository ExportGLJournalRepository
+		Logger                    *zap.SugaredLogger
+		Validator                 *validator.Validate
+	}
+
+	ExportGLJournalInteractor struct {
+		repository ExportGLJournalRepository `validate:"required"`
+		logger     *zap.SugaredLogger        `validate:"required"`
+		validator  *validator.Validate       `validate:"required"`
+	}
+
+	glJournalDocument struct {
+		Date         string            `json:"date"`
+		Batches      []glBatchDocument `json:"batches"`
+		LineCount    int               `json:"line_count"`
+		ControlTotal string            `json:"control_total"`
+	}
+
+	glBatchDocument struct {
+		BatchID     string           `json:"batch_id"`
+		Event       string           `json:"event"`
+		TotalDebit  string           `json:"total_debit"`
+		TotalCredit string           `json:"total_credit"`
+		Lines       []glLineDocument `json:"lines"`
+	}
+
+	glLineDocument struct {
+		EntryID       uint64 `json:"entry_id"`
+		LineNumber    int    `json:"line_number"`
+		LoanID        uint64 `json:"loan_id"`
+		Reference     string `json:"reference"`
+		EffectiveDate string `json:"effective_date"`
+		AccountCode   string `json:"account_code"`
+		GLCode        string `json:"gl_code"`
+		Debit         string `json:"debit"`
+		Credit        string `json:"credit"`
+		Description   string `json:"description"`
+	}
+)
+
+func NewExportGLJournalInteractor(
+	deps ExportGLJournalInteractorDependencies,
+) *ExportGLJournalInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &ExportGLJournalInteractor{
+		repository: deps.ExportGLJournalRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.ExportGLJournalUsecase.
+//
+// The export is refused as a whole when a posting has no GL mapping or a batch
+// does not balance, so the accounting system never receives a partial day.
+func (e *ExportGLJournalInteractor) Execute(ctx context.Context, input usecases.ExportGLJournalInput) (usecases.ExportGLJournalOutput, error) {
+	if err := e.validator.Struct(input); err != nil {
+		e.logger.Errorw("invalid input", "error", err)
+		return usecases.ExportGLJournalOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	date, err := parseAsOfDate(input.Date)
+	if err != nil {
+		return usecases.ExportGLJournalOutput{}, err
+	}
+
+	format := input.Format
+	if format == "" {
+		format = glExportFormatJSON
+	}
+
+	mappings, err := e.repository.GetGLMappings(ctx)
+	if err != nil {
+		e.logger.Errorw("failed to get GL mappings", "error", err)
+		return usecases.ExportGLJournalOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	entries, err := e.repository.GetJournalEntriesByDate(ctx, date)
+	if err != nil {
+		e.logger.Errorw("failed to get journal entries", "error", err, "date", input.Date)
+		return usecases.ExportGLJournalOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	journal, err := entity.NewGLJournal(date, entries, entity.NewGLChart(mappings))
+	if err != nil {
+		e.logger.Errorw("failed to build GL journal", "error", err, "date", input.Date)
+		return usecases.ExportGLJournalOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.ExportGLJournalOutput{
+		Date:         date.Format(dateLayout),
+		Format:       format,
+		FileName:     fmt.Sprintf("gl-journal-%s.%s", date.Format(dateLayout), format),
+		Batches:      len(journal.Batches),
+		LineCount:    journal.LineCount,
+		ControlTotal: journal.ControlTotal.StringFixed(2),
+	}
+
+	if format == glExportFormatCSV {
+		output.ContentType = "text/csv; charset=utf-8"
+		output.Content, err = renderGLJournalCSV(journal)
+	} else {
+		output.ContentType = "application/json; charset=utf-8"
+		output.Content, err = renderGLJournalJSON(journal)
+	}
+	if err != nil {
+		e.logger.Errorw("failed to render GL journal", "error", err, "date", input.Date, "format", format)
+		return usecases.ExportGLJournalOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return output, nil
+}
+
+func renderGLJournalJSON(journal entity.GLJournal) ([]byte, error) {
+	document := glJournalDocument{
+		Date:         journal.Date.Format(dateLayout),
+		Batches:      make([]glBatchDocument, len(journal.Batches)),
+		LineCount:    journal.LineCount,
+		ControlTotal: journal.ControlTotal.StringFixed(2),
+	}
+
+	for i, batch := range journal.Batches {
+		document.Batches[i] = glBatchDocument{
+			BatchID:     batch.ID,
+			Event:       string(batch.Event),
+			TotalDebit:  batch.TotalDebit.StringFixed(2),
+			TotalCredit: batch.TotalCredit.StringFixed(2),
+			Lines:       make([]glLineDocument, len(batch.Lines)),
+		}
+
+		for j, line := range batch.Lines {
+			document.Batches[i].Lines[j] = glLineDocument{
+				EntryID:       line.EntryID,
+				LineNumber:    line.LineNumber,
+				LoanID:        line.LoanID,
+				Reference:     line.Reference,
+				EffectiveDate: line.EffectiveDate.Format(dateLayout),
+				AccountCode:   line.AccountCode,
+				GLCode:        line.GLCode,
+				Debit:         line.Debit.StringFixed(2),
+				Credit:        line.Credit.StringFixed(2),
+				Description:   line.Description,
+			}
+		}
+	}
+
+	return json.MarshalIndent(document, "", "  ")
+}
+
+// renderGLJournalCSV writes one LINE record per posting followed by a TRAILER
+// record carrying the line count and the control total on both sides.
+func renderGLJournalCSV(journal entity.GLJournal) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	records := [][]string{{
+		"record_type", "batch_id", "event", "entry_id", "line_number", "loan_id", "reference",
+		"effective_date", "account_code", "gl_code", "debit", "credit", "description",
+	}}
+
+	for _, batch := range journal.Batches {
+		for _, line := range batch.Lines {
+			records = append(records, []string{
+				"LINE",
+				line.BatchID,
+				string(line.Event),
+				strconv.FormatUint(line.EntryID, 10),
+				strconv.Itoa(line.LineNumber),
+				strconv.FormatUint(line.LoanID, 10),
+				line.Reference,
+				line.EffectiveDate.Format(dateLayout),
+				line.AccountCode,
+				line.GLCode,
+				line.Debit.StringFixed(2),
+				line.Credit.StringFixed(2),
+				line.Description,
+			})
+		}
+	}
+
+	controlTotal := journal.ControlTotal.StringFixed(2)
+	records = append(records, []string{
+		"TRAILER", "", "", "", strconv.Itoa(journal.LineCount), "", "",
+		journal.Date.Format(dateLayout), "", "", controlTotal, controlTotal,
+		fmt.Sprintf("%d lines in %d batches", journal.LineCount, len(journal.Batches)),
+	})
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,126 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestExportGLJournalInteractor_Execute(t *testing.T) {
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	mappings := []entity.GLMapping{
+		{Event: entity.GL_MAPPING_DEFAULT, AccountCode: entity.ACCOUNT_CASH, GLCode: "1101"},
+		{Event: entity.GL_MAPPING_DEFAULT, AccountCode: entity.ACCOUNT_PRINCIPAL_RECEIVABLE, GLCode: "1301"},
+		{Event: entity.GL_MAPPING_DEFAULT, AccountCode: entity.ACCOUNT_INTEREST_RECEIVABLE, GLCode: "1302"},
+		{Event: entity.JOURNAL_DISBURSEMENT, AccountCode: entity.ACCOUNT_CASH, GLCode: "2101"},
+	}
+	entries := []entity.JournalEntry{
+		entity.NewDisbursementEntry(10, entity.Loan{ID: 1, PrincipalAmount: decimal.NewFromInt(5000000), StartDate: date}, decimal.Zero),
+		entity.NewPaymentEntry(11, 2, 1, decimal.NewFromInt(100000), decimal.NewFromInt(10000), decimal.Zero, date),
+	}
+
+	tests := []struct {
+		name           string
+		input          usecases.ExportGLJournalInput
+		setupMocks     func(*billingenginemocks.MockExportGLJournalRepository)
+		expectedOutput usecases.ExportGLJournalOutput
+		expectedBody   string
+		expectedError  error
+	}{
+		{
+			name:  "success - csv with one batch per event and a control total",
+			input: usecases.ExportGLJournalInput{Date: "2024-03-01", Format: "csv"},
+			setupMocks: func(mockRepo *billingenginemocks.MockExportGLJournalRepository) {
+				mockRepo.On("GetGLMappings", mock.Anything).Return(mappings, nil)
+				mockRepo.On("GetJournalEntriesByDate", mock.Anything, date).Return(entries, nil)
+			},
+			expectedOutput: usecases.ExportGLJournalOutput{
+				Date: "2024-03-01", Format: "csv", FileName: "gl-journal-2024-03-01.csv", ContentType: "text/csv; charset=utf-8",
+				Batches: 2, LineCount: 5, ControlTotal: "5110000.00",
+			},
+			expectedBody: "record_type,batch_id,event,entry_id,line_number,loan_id,reference,effective_date,account_code,gl_code,debit,credit,description\n" +
+				"LINE,20240301-01,DISBURSEMENT,10,1,1,loan-1,2024-03-01,PRINCIPAL_RECEIVABLE,1301,5000000.00,0.00,Disbursement\n" +
+				"LINE,20240301-01,DISBURSEMENT,10,2,1,loan-1,2024-03-01,CASH,2101,0.00,5000000.00,Disbursement\n" +
+				"LINE,20240301-02,PAYMENT,11,1,2,week-1,2024-03-01,CASH,1101,110000.00,0.00,Installment payment\n" +
+				"LINE,20240301-02,PAYMENT,11,2,2,week-1,2024-03-01,PRINCIPAL_RECEIVABLE,1301,0.00,100000.00,Installment payment\n" +
+				"LINE,20240301-02,PAYMENT,11,3,2,week-1,2024-03-01,INTEREST_RECEIVABLE,1302,0.00,10000.00,Installment payment\n" +
+				"TRAILER,,,,5,,,2024-03-01,,,5110000.00,5110000.00,5 lines in 2 batches\n",
+		},
+		{
+			name:  "success - empty day exports an empty json journal",
+			input: usecases.ExportGLJournalInput{Date: "2024-03-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockExportGLJournalRepository) {
+				mockRepo.On("GetGLMappings", mock.Anything).Return(mappings, nil)
+				mockRepo.On("GetJournalEntriesByDate", mock.Anything, date).Return(nil, nil)
+			},
+			expectedOutput: usecases.ExportGLJournalOutput{
+				Date: "2024-03-01", Format: "json", FileName: "gl-journal-2024-03-01.json", ContentType: "application/json; charset=utf-8",
+				ControlTotal: "0.00",
+			},
+			expectedBody: "{\n  \"date\": \"2024-03-01\",\n  \"batches\": [],\n  \"line_count\": 0,\n  \"control_total\": \"0.00\"\n}",
+		},
+		{
+			name:  "error - posting without GL mapping",
+			input: usecases.ExportGLJournalInput{Date: "2024-03-01", Format: "json"},
+			setupMocks: func(mockRepo *billingenginemocks.MockExportGLJournalRepository) {
+				mockRepo.On("GetGLMappings", mock.Anything).Return(mappings[:2], nil)
+				mockRepo.On("GetJournalEntriesByDate", mock.Anything, date).Return(entries, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (unknown format)",
+			input:         usecases.ExportGLJournalInput{Date: "2024-03-01", Format: "xml"},
+			setupMocks:    func(*billingenginemocks.MockExportGLJournalRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on GetJournalEntriesByDate",
+			input: usecases.ExportGLJournalInput{Date: "2024-03-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockExportGLJournalRepository) {
+				mockRepo.On("GetGLMappings", mock.Anything).Return(mappings, nil)
+				mockRepo.On("GetJournalEntriesByDate", mock.Anything, date).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockExportGLJournalRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewExportGLJournalInteractor(ExportGLJournalInteractorDependencies{
+				ExportGLJournalRepository: mockRepo,
+				Logger:                    zap.NewNop().Sugar(),
+				Validator:                 validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedBody, string(output.Content))
+
+			output.Content = nil
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package interactors
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.ExportMerchantSettlementsUsecase = (*ExportMerchantSettlementsInteractor)(nil)
+
+type (
+	ExportMerchantSettlementsRepository interface {
+		GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error)
+		GetMerchantSettlementsByDate(ctx context.Context, settlementDate time.Time) ([]entity.MerchantSettlement, error)
+	}
+
+	ExportMerchantSettlementsInteractorDependencies struct {
+		ExportMerchantSettlementsRepository ExportMerchantSettlementsRepository
+		Logger                              *zap.SugaredLogger
+		Validator                           *validator.Validate
+	}
+
+	ExportMerchantSettlementsInteractor struct {
+		repository ExportMerchantSettlementsRepository `validate:"required"`
+		logger     *zap.SugaredLogger                  `validate:"required"`
+		validator  *validator.Validate                 `validate:"required"`
+	}
+)
+
+func NewExportMerchantSettlementsInteractor(
+	deps ExportMerchantSettlementsInteractorDependencies,
+) *ExportMerchantSettlementsInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &ExportMerchantSettlementsInteractor{
+		repository: deps.ExportMerchantSettlementsRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.ExportMerchantSettlementsUsecase.
+//
+// The file instructs the transfer of every settlement of the day to the
+// merchant's settlement account, whatever its status, so the bank can match
+// it against what it already received.
+func (e *ExportMerchantSettlementsInteractor) Execute(ctx context.Context, input usecases.ExportMerchantSettlementsInput) (usecases.ExportMerchantSettlementsOutput, error) {
+	if err := e.validator.Struct(input); err != nil {
+		e.logger.Errorw("invalid input", "error", err)
+		return usecases.ExportMerchantSettlementsOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	date, err := parseAsOfDate(input.Date)
+	if err != nil {
+		return usecases.ExportMerchantSettlementsOutput{}, err
+	}
+
+	settlements, err := e.repository.GetMerchantSettlementsByDate(ctx, date)
+	if err != nil {
+		e.logger.Errorw("failed to get merchant settlements", "error", err, "date", input.Date)
+		return usecases.ExportMerchantSettlementsOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	merchants := make(map[uint64]entity.Merchant)
+	for _, settlement := range settlements {
+		if _, ok := merchants[settlement.MerchantID]; ok {
+			continue
+		}
+
+		merchant, err := e.repository.GetMerchant(ctx, settlement.MerchantID)
+		if err != nil {
+			e.logger.Errorw("failed to get merchant", "error", err, "merchant_id", settlement.MerchantID)
+			return usecases.ExportMerchantSettlementsOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+		merchants[merchant.ID] = merchant
+	}
+
+	controlTotal := decimal.Zero
+	for _, settlement := range settlements {
+		controlTotal = controlTotal.Add(settlement.NetAmount)
+	}
+
+	content, err := renderMerchantSettlementsCSV(settlements, merchants, controlTotal)
+	if err != nil {
+		e.logger.Errorw("failed to render merchant settlements", "error", err, "date", input.Date)
+		return usecases.ExportMerchantSettlementsOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return usecases.ExportMerchantSettlementsOutput{
+		Date:            date.Format(dateLayout),
+		FileName:        fmt.Sprintf("merchant-settlements-%s.csv", date.Format(dateLayout)),
+		ContentType:     "text/csv; charset=utf-8",
+		Content:         content,
+		SettlementCount: len(settlements),
+		ControlTotal:    controlTotal.StringFixed(2),
+	}, nil
+}
+
+// renderMerchantSettlementsCSV writes one SETTLEMENT record per settlement
+// followed by a TRAILER record carrying the settlement count and the total
+// net amount.
+func renderMerchantSettlementsCSV(settlements []entity.MerchantSettlement, merchants map[uint64]entity.Merchant, controlTotal decimal.Decimal) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	records := [][]string{{
+		"record_type", "settlement_id", "merchant_code", "merchant_name", "bank_code", "account_number",
+		"account_name", "item_count", "gross_amount", "mdr_amount", "net_amount", "status",
+	}}
+
+	for _, settlement := range settlements {
+		merchant := merchants[settlement.MerchantID]
+		records = append(records, []string{
+			"SETTLEMENT",
+			strconv.FormatUint(settlement.ID, 10),
+			merchant.Code,
+			merchant.Name,
+			merchant.SettlementAccount.BankCode,
+			merchant.SettlementAccount.AccountNumber,
+			merchant.SettlementAccount.AccountName,
+			strconv.FormatInt(settlement.ItemCount, 10),
+			settlement.GrossAmount.StringFixed(2),
+			settlement.MDRAmount.StringFixed(2),
+			settlement.NetAmount.StringFixed(2),
+			string(settlement.Status),
+		})
+	}
+
+	records = append(records, []string{
+		"TRAILER", strconv.Itoa(len(settlements)), "", "", "", "", "", "", "", "", controlTotal.StringFixed(2), "",
+	})
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
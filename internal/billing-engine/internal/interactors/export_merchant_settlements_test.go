@@ -0,0 +1,108 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestExportMerchantSettlementsInteractor_Execute(t *testing.T) {
+	date := time.Date(2024, 3, 5, 0, 0, 0, 0, time.Local)
+	merchant := entity.Merchant{
+		ID: 900, Code: "TOKO", Name: "Toko Maju",
+		SettlementAccount: entity.BankAccount{BankCode: "BNI", AccountNumber: "555000111", AccountName: "Toko Maju"},
+	}
+	settlements := []entity.MerchantSettlement{
+		{ID: 1000, MerchantID: 900, SettlementDate: date, ItemCount: 2, Status: entity.SETTLEMENT_PENDING,
+			GrossAmount: decimal.NewFromInt(3000000), MDRAmount: decimal.NewFromInt(75000), NetAmount: decimal.NewFromInt(2925000)},
+		{ID: 1001, MerchantID: 900, SettlementDate: date, ItemCount: 1, Status: entity.SETTLEMENT_SENT,
+			GrossAmount: decimal.NewFromInt(500000), MDRAmount: decimal.NewFromInt(12500), NetAmount: decimal.NewFromInt(487500)},
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.ExportMerchantSettlementsInput
+		setupMocks    func(*billingenginemocks.MockExportMerchantSettlementsRepository)
+		expectedCheck func(*testing.T, usecases.ExportMerchantSettlementsOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - settlement file with its control total",
+			input: usecases.ExportMerchantSettlementsInput{Date: "2024-03-05"},
+			setupMocks: func(mockRepo *billingenginemocks.MockExportMerchantSettlementsRepository) {
+				mockRepo.On("GetMerchantSettlementsByDate", mock.Anything, date).Return(settlements, nil)
+				mockRepo.On("GetMerchant", mock.Anything, uint64(900)).Return(merchant, nil).Once()
+			},
+			expectedCheck: func(t *testing.T, output usecases.ExportMerchantSettlementsOutput) {
+				assert.Equal(t, "merchant-settlements-2024-03-05.csv", output.FileName)
+				assert.Equal(t, "text/csv; charset=utf-8", output.ContentType)
+				assert.Equal(t, 2, output.SettlementCount)
+				assert.Equal(t, "3412500.00", output.ControlTotal)
+				assert.Equal(t, "record_type,settlement_id,merchant_code,merchant_name,bank_code,account_number,account_name,item_count,gross_amount,mdr_amount,net_amount,status\n"+
+					"SETTLEMENT,1000,TOKO,Toko Maju,BNI,555000111,Toko Maju,2,3000000.00,75000.00,2925000.00,PENDING\n"+
+					"SETTLEMENT,1001,TOKO,Toko Maju,BNI,555000111,Toko Maju,1,500000.00,12500.00,487500.00,SENT\n"+
+					"TRAILER,2,,,,,,,,,3412500.00,\n", string(output.Content))
+			},
+		},
+		{
+			name:  "success - no settlements that day",
+			input: usecases.ExportMerchantSettlementsInput{Date: "2024-03-05"},
+			setupMocks: func(mockRepo *billingenginemocks.MockExportMerchantSettlementsRepository) {
+				mockRepo.On("GetMerchantSettlementsByDate", mock.Anything, date).Return([]entity.MerchantSettlement(nil), nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.ExportMerchantSettlementsOutput) {
+				assert.Equal(t, 0, output.SettlementCount)
+				assert.Equal(t, "0.00", output.ControlTotal)
+			},
+		},
+		{
+			name:          "error - validation error (missing date)",
+			input:         usecases.ExportMerchantSettlementsInput{},
+			setupMocks:    func(*billingenginemocks.MockExportMerchantSettlementsRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error",
+			input: usecases.ExportMerchantSettlementsInput{Date: "2024-03-05"},
+			setupMocks: func(mockRepo *billingenginemocks.MockExportMerchantSettlementsRepository) {
+				mockRepo.On("GetMerchantSettlementsByDate", mock.Anything, date).Return(nil, errors.New("database error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockExportMerchantSettlementsRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewExportMerchantSettlementsInteractor(ExportMerchantSettlementsInteractorDependencies{
+				ExportMerchantSettlementsRepository: mockRepo,
+				Logger:                              zap.NewNop().Sugar(),
+				Validator:                           validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
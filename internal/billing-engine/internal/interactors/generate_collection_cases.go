@@ -0,0 +1,228 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GenerateCollectionCasesUsecase = (*GenerateCollectionCasesInteractor)(nil)
+
+type (
+	GenerateCollectionCasesRepository interface {
+		MarkMissedInstallments(ctx context.Context, asOf time.Time) error
+		GetDelinquentLoans(ctx context.Context) ([]entity.DelinquentLoan, error)
+		GetOpenCollectionCaseLoanIDs(ctx context.Context) ([]uint64, error)
+		GetActiveCollectionAgents(ctx context.Context) ([]entity.CollectionAgent, error)
+		GetLastAssignedCollectionAgentID(ctx context.Context) (uint64, error)
+		CreateCollectionCase(ctx context.Context, collectionCase entity.CollectionCase) (entity.CollectionCase, error)
+	}
+
+	GenerateCollectionCasesInteractorDependencies struct {
+		GenerateCollectionCasesRepository GenerateCollectionCasesRepository
+		Logger                            *zap.SugaredLogger
+		Validator                         *validator.Validate
+		SnowflakeGen                      pkguid.Snowflake
+	}
+
+	GenerateCollectionCasesInteractor struct {
+		repository   GenerateCollectionCasesRepository `validate:"required"`
+		logger       *zap.SugaredLogger                `validate:"required"`
+		validator    *validator.Validate               `validate:"required"`
+		snowflakeGen pkguid.Snowflake                  `validate:"required"`
+	}
+)
+
+func NewGenerateCollectionCasesInteractor(
+	deps GenerateCollectionCasesInteractorDependencies,
+) *GenerateCollectionCasesInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GenerateCollectionCasesInteractor{
+		repository:   deps.GenerateCollectionCasesRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.GenerateCollectionCasesUsecase.
+func (g *GenerateCollectionCasesInteractor) Execute(ctx context.Context, input usecases.GenerateCollectionCasesInput) (usecases.GenerateCollectionCasesOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GenerateCollectionCasesOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	asOf, err := parseMarkingDate(input.AsOf)
+	if err != nil {
+		return usecases.GenerateCollectionCasesOutput{}, err
+	}
+
+	// installments are only flagged as MISSED lazily, so bring them up to
+	// date before looking for delinquent loans
+	if err := g.repository.MarkMissedInstallments(ctx, asOf); err != nil {
+		g.logger.Errorw("failed to mark missed installments", "error", err)
+		return usecases.GenerateCollectionCasesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	delinquentLoans, err := g.repository.GetDelinquentLoans(ctx)
+	if err != nil {
+		g.logger.Errorw("failed to get delinquent loans", "error", err)
+		return usecases.GenerateCollectionCasesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	openLoanIDs, err := g.repository.GetOpenCollectionCaseLoanIDs(ctx)
+	if err != nil {
+		g.logger.Errorw("failed to get open collection cases", "error", err)
+		return usecases.GenerateCollectionCasesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	agents, err := g.repository.GetActiveCollectionAgents(ctx)
+	if err != nil {
+		g.logger.Errorw("failed to get active collection agents", "error", err)
+		return usecases.GenerateCollectionCasesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	lastAgentID, err := g.repository.GetLastAssignedCollectionAgentID(ctx)
+	if err != nil {
+		g.logger.Errorw("failed to get last assigned collection agent", "error", err)
+		return usecases.GenerateCollectionCasesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	hasOpenCase := make(map[uint64]bool, len(openLoanIDs))
+	for _, loanID := range openLoanIDs {
+		hasOpenCase[loanID] = true
+	}
+
+	assigner := newCollectionAssigner(entity.CollectionAssignmentStrategy(input.Strategy), agents, lastAgentID)
+
+	output := usecases.GenerateCollectionCasesOutput{
+		AsOf:     asOf.Format(dateLayout),
+		Strategy: input.Strategy,
+		Created:  []usecases.CollectionCaseOutput{},
+	}
+
+	for _, loan := range delinquentLoans {
+		if hasOpenCase[loan.LoanID] {
+			continue
+		}
+
+		dpd := loan.DaysPastDue(asOf)
+		bucket := entity.BucketFromDaysPastDue(dpd)
+
+		agentID, ok := assigner.next(bucket)
+		if !ok {
+			output.Unassigned = append(output.Unassigned, loan.LoanID)
+			continue
+		}
+
+		createdCase, err := g.repository.CreateCollectionCase(ctx, entity.CollectionCase{
+			ID:                 g.snowflakeGen.Generate(),
+			LoanID:             loan.LoanID,
+			CustomerID:         loan.CustomerID,
+			AgentID:            agentID,
+			Bucket:             bucket,
+			DaysPastDue:        dpd,
+			MissedInstallments: loan.MissedInstallments,
+			Status:             entity.COLLECTION_CASE_OPEN,
+			OpenedAt:           time.Now(),
+		})
+		if err != nil {
+			g.logger.Errorw("failed to create collection case", "error", err, "loan_id", loan.LoanID)
+			return usecases.GenerateCollectionCasesOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		output.Created = append(output.Created, toCollectionCaseOutput(createdCase))
+	}
+
+	return output, nil
+}
+
+// collectionAssigner hands out agents for new collection cases, either in a
+// single rotation over all agents or in one rotation per bucket.
+type collectionAssigner struct {
+	strategy entity.CollectionAssignmentStrategy
+	agents   []entity.CollectionAgent
+	cursor   map[entity.CollectionBucket]int
+}
+
+func newCollectionAssigner(
+	strategy entity.CollectionAssignmentStrategy,
+	agents []entity.CollectionAgent,
+	lastAgentID uint64,
+) *collectionAssigner {
+	assigner := &collectionAssigner{
+		strategy: strategy,
+		agents:   agents,
+		cursor:   map[entity.CollectionBucket]int{},
+	}
+
+	// continue the global rotation right after the agent who got the
+	// previous case
+	for i, agent := range agents {
+		if agent.ID == lastAgentID {
+			assigner.cursor[entity.COLLECTION_BUCKET_ANY] = i + 1
+			break
+		}
+	}
+
+	return assigner
+}
+
+func (a *collectionAssigner) next(bucket entity.CollectionBucket) (uint64, bool) {
+	if a.strategy == entity.COLLECTION_ASSIGN_ROUND_ROBIN {
+		return a.pick(entity.COLLECTION_BUCKET_ANY, a.agents)
+	}
+
+	var candidates []entity.CollectionAgent
+	for _, agent := range a.agents {
+		if agent.Bucket == bucket {
+			candidates = append(candidates, agent)
+		}
+	}
+
+	// fall back to generalist agents when nobody specialises in the bucket
+	if len(candidates) == 0 {
+		for _, agent := range a.agents {
+			if agent.Bucket == entity.COLLECTION_BUCKET_ANY {
+				candidates = append(candidates, agent)
+			}
+		}
+	}
+
+	return a.pick(bucket, candidates)
+}
+
+func (a *collectionAssigner) pick(key entity.CollectionBucket, candidates []entity.CollectionAgent) (uint64, bool) {
+	if len(candidates) == 0 {
+		return 0, false
+	}
+
+	idx := a.cursor[key] % len(candidates)
+	a.cursor[key] = idx + 1
+
+	return candidates[idx].ID, true
+}
+
+func toCollectionCaseOutput(collectionCase entity.CollectionCase) usecases.CollectionCaseOutput {
+	return usecases.CollectionCaseOutput{
+		ID:                 collectionCase.ID,
+		LoanID:             collectionCase.LoanID,
+		CustomerID:         collectionCase.CustomerID,
+		AgentID:            collectionCase.AgentID,
+		Bucket:             string(collectionCase.Bucket),
+		DaysPastDue:        collectionCase.DaysPastDue,
+		MissedInstallments: collectionCase.MissedInstallments,
+		Status:             string(collectionCase.Status),
+		OpenedAt:           collectionCase.OpenedAt.Format(time.RFC3339),
+	}
+}
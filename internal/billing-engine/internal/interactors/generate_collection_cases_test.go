@@ -0,0 +1,138 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGenerateCollectionCasesInteractor_Execute(t *testing.T) {
+	asOf := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	agents := []entity.CollectionAgent{
+		{ID: 1, Name: "Early", Bucket: entity.COLLECTION_BUCKET_1_30, Active: true},
+		{ID: 2, Name: "Late", Bucket: entity.COLLECTION_BUCKET_61_90, Active: true},
+		{ID: 3, Name: "Generalist", Bucket: entity.COLLECTION_BUCKET_ANY, Active: true},
+	}
+	delinquentLoans := []entity.DelinquentLoan{
+		{LoanID: 100, CustomerID: 10, OldestMissedDue: asOf.AddDate(0, 0, -14), MissedInstallments: 2},
+		{LoanID: 200, CustomerID: 20, OldestMissedDue: asOf.AddDate(0, 0, -70), MissedInstallments: 10},
+		{LoanID: 300, CustomerID: 30, OldestMissedDue: asOf.AddDate(0, 0, -40), MissedInstallments: 6},
+		{LoanID: 400, CustomerID: 40, OldestMissedDue: asOf.AddDate(0, 0, -21), MissedInstallments: 3},
+	}
+
+	setupCommon := func(mockRepo *billingenginemocks.MockGenerateCollectionCasesRepository, lastAgentID uint64) {
+		mockRepo.On("MarkMissedInstallments", mock.Anything, asOf).Return(nil)
+		mockRepo.On("GetDelinquentLoans", mock.Anything).Return(delinquentLoans, nil)
+		mockRepo.On("GetOpenCollectionCaseLoanIDs", mock.Anything).Return([]uint64{400}, nil)
+		mockRepo.On("GetActiveCollectionAgents", mock.Anything).Return(agents, nil)
+		mockRepo.On("GetLastAssignedCollectionAgentID", mock.Anything).Return(lastAgentID, nil)
+		mockRepo.On("CreateCollectionCase", mock.Anything, mock.Anything).Return(
+			func(_ context.Context, c entity.CollectionCase) (entity.CollectionCase, error) {
+				return c, nil
+			},
+		)
+	}
+
+	tests := []struct {
+		name            string
+		input           usecases.GenerateCollectionCasesInput
+		setupMocks      func(*billingenginemocks.MockGenerateCollectionCasesRepository, *pkgmocks.MockSnowflake)
+		expectedAgents  map[uint64]uint64
+		expectedBuckets map[uint64]string
+		expectedError   error
+	}{
+		{
+			name:  "success - round robin continues after last assigned agent",
+			input: usecases.GenerateCollectionCasesInput{Strategy: "ROUND_ROBIN", AsOf: "2024-03-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateCollectionCasesRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				setupCommon(mockRepo, 1)
+				mockSnowflake.On("Generate").Return(uint64(999))
+			},
+			expectedAgents: map[uint64]uint64{100: 2, 200: 3, 300: 1},
+			expectedBuckets: map[uint64]string{
+				100: "DPD_1_30",
+				200: "DPD_61_90",
+				300: "DPD_31_60",
+			},
+		},
+		{
+			name:  "success - by bucket falls back to generalist agents",
+			input: usecases.GenerateCollectionCasesInput{Strategy: "BY_BUCKET", AsOf: "2024-03-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateCollectionCasesRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				setupCommon(mockRepo, 0)
+				mockSnowflake.On("Generate").Return(uint64(999))
+			},
+			expectedAgents: map[uint64]uint64{100: 1, 200: 2, 300: 3},
+			expectedBuckets: map[uint64]string{
+				100: "DPD_1_30",
+				200: "DPD_61_90",
+				300: "DPD_31_60",
+			},
+		},
+		{
+			name:          "error - invalid strategy",
+			input:         usecases.GenerateCollectionCasesInput{Strategy: "RANDOM"},
+			setupMocks:    func(*billingenginemocks.MockGenerateCollectionCasesRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - as_of in the future would mark installments not yet due",
+			input: usecases.GenerateCollectionCasesInput{Strategy: "ROUND_ROBIN", AsOf: time.Now().AddDate(0, 0, 7).Format("2006-01-02")},
+			setupMocks: func(*billingenginemocks.MockGenerateCollectionCasesRepository, *pkgmocks.MockSnowflake) {
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on GetDelinquentLoans",
+			input: usecases.GenerateCollectionCasesInput{Strategy: "ROUND_ROBIN", AsOf: "2024-03-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateCollectionCasesRepository, _ *pkgmocks.MockSnowflake) {
+				mockRepo.On("MarkMissedInstallments", mock.Anything, asOf).Return(nil)
+				mockRepo.On("GetDelinquentLoans", mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGenerateCollectionCasesRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewGenerateCollectionCasesInteractor(GenerateCollectionCasesInteractorDependencies{
+				GenerateCollectionCasesRepository: mockRepo,
+				Logger:                            zap.NewNop().Sugar(),
+				Validator:                         validator.New(),
+				SnowflakeGen:                      mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, output.Created, len(tt.expectedAgents))
+			for _, created := range output.Created {
+				assert.Equal(t, tt.expectedAgents[created.LoanID], created.AgentID, "agent for loan %d", created.LoanID)
+				assert.Equal(t, tt.expectedBuckets[created.LoanID], created.Bucket, "bucket for loan %d", created.LoanID)
+				assert.Equal(t, "OPEN", created.Status)
+			}
+		})
+	}
+}
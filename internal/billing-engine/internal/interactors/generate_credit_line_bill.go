@@ -0,0 +1,147 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GenerateCreditLineBillUsecase = (*GenerateCreditLineBillInteractor)(nil)
+
+type (
+	GenerateCreditLineBillRepository interface {
+		GetCreditLine(ctx context.Context, creditLineID uint64) (entity.CreditLine, error)
+		IsCreditLineBilled(ctx context.Context, creditLineID uint64, cycle string) (bool, error)
+		GetCreditLineUnbilledInstallments(ctx context.Context, creditLineID uint64, dueBy time.Time) ([]entity.Installment, error)
+		CreateCreditLineBill(ctx context.Context, bill entity.CreditLineBill) (entity.CreditLineBill, error)
+	}
+
+	GenerateCreditLineBillInteractorDependencies struct {
+		GenerateCreditLineBillRepository GenerateCreditLineBillRepository
+		Logger                           *zap.SugaredLogger
+		Validator                        *validator.Validate
+		SnowflakeGen                     pkguid.Snowflake
+	}
+
+	GenerateCreditLineBillInteractor struct {
+		repository   GenerateCreditLineBillRepository `validate:"required"`
+		logger       *zap.SugaredLogger               `validate:"required"`
+		validator    *validator.Validate              `validate:"required"`
+		snowflakeGen pkguid.Snowflake                 `validate:"required"`
+	}
+)
+
+func NewGenerateCreditLineBillInteractor(
+	deps GenerateCreditLineBillInteractorDependencies,
+) *GenerateCreditLineBillInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GenerateCreditLineBillInteractor{
+		repository:   deps.GenerateCreditLineBillRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.GenerateCreditLineBillUsecase.
+//
+// The bill of a cycle gathers the unpaid installments of every drawdown due
+// by the end of the cycle that no earlier bill carried, so installments due
+// after an early bill move to the next one. It is due at the end of the
+// cycle.
+func (g *GenerateCreditLineBillInteractor) Execute(ctx context.Context, input usecases.GenerateCreditLineBillInput) (usecases.CreditLineBillOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.CreditLineBillOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	cycleStart, err := parseMonth(input.Cycle)
+	if err != nil {
+		return usecases.CreditLineBillOutput{}, err
+	}
+
+	line, err := g.repository.GetCreditLine(ctx, input.CreditLineID)
+	if err != nil {
+		g.logger.Errorw("failed to get credit line", "error", err, "credit_line_id", input.CreditLineID)
+		return usecases.CreditLineBillOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	isBilled, err := g.repository.IsCreditLineBilled(ctx, line.ID, input.Cycle)
+	if err != nil {
+		g.logger.Errorw("failed to check credit line bill", "error", err, "credit_line_id", line.ID, "cycle", input.Cycle)
+		return usecases.CreditLineBillOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if isBilled {
+		return usecases.CreditLineBillOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("credit line %d already has a bill for %s", line.ID, input.Cycle),
+		)
+	}
+
+	dueDate := endOfMonth(cycleStart)
+
+	installments, err := g.repository.GetCreditLineUnbilledInstallments(ctx, line.ID, dueDate)
+	if err != nil {
+		g.logger.Errorw("failed to get unbilled installments", "error", err, "credit_line_id", line.ID)
+		return usecases.CreditLineBillOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if len(installments) == 0 {
+		return usecases.CreditLineBillOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("nothing is due on credit line %d by the end of %s", line.ID, input.Cycle),
+		)
+	}
+
+	bill, err := entity.NewCreditLineBill(g.snowflakeGen.Generate(), line.ID, input.Cycle, dueDate, installments, time.Now())
+	if err != nil {
+		return usecases.CreditLineBillOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	bill, err = g.repository.CreateCreditLineBill(ctx, bill)
+	if err != nil {
+		g.logger.Errorw("failed to create credit line bill", "error", err, "credit_line_id", line.ID, "cycle", input.Cycle)
+		return usecases.CreditLineBillOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toCreditLineBillOutput(bill), nil
+}
+
+func toCreditLineBillOutput(bill entity.CreditLineBill) usecases.CreditLineBillOutput {
+	output := usecases.CreditLineBillOutput{
+		ID:           bill.ID,
+		CreditLineID: bill.CreditLineID,
+		Cycle:        bill.Cycle,
+		DueDate:      bill.DueDate.Format(dateLayout),
+		Amount:       bill.Amount.StringFixed(2),
+		Outstanding:  bill.Outstanding().StringFixed(2),
+		Status:       string(bill.Status),
+		CreatedAt:    bill.CreatedAt.Format(time.RFC3339),
+		Items:        make([]usecases.CreditLineBillItemOutput, len(bill.Items)),
+	}
+	if !bill.PaidAt.IsZero() {
+		output.PaidAt = bill.PaidAt.Format(time.RFC3339)
+	}
+
+	for i, item := range bill.Items {
+		output.Items[i] = usecases.CreditLineBillItemOutput{
+			InstallmentID: item.InstallmentID,
+			LoanID:        item.LoanID,
+			WeekNumber:    item.WeekNumber,
+			Amount:        item.Amount.StringFixed(2),
+			Status:        string(item.Status),
+		}
+	}
+
+	return output
+}
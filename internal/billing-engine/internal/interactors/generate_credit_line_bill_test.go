@@ -0,0 +1,128 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGenerateCreditLineBillInteractor_Execute(t *testing.T) {
+	line := entity.CreditLine{ID: 700, CustomerID: 10, Limit: decimal.NewFromInt(2000000), ProductCode: "PAYLATER"}
+	input := usecases.GenerateCreditLineBillInput{CreditLineID: 700, Cycle: "2024-03"}
+	endOfMarch := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		input         usecases.GenerateCreditLineBillInput
+		setupMocks    func(*billingenginemocks.MockGenerateCreditLineBillRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.CreditLineBillOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - installments of every drawdown on one bill",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateCreditLineBillRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("IsCreditLineBilled", mock.Anything, uint64(700), "2024-03").Return(false, nil)
+				mockRepo.On("GetCreditLineUnbilledInstallments", mock.Anything, uint64(700), mock.MatchedBy(func(dueBy time.Time) bool {
+					return dueBy.Format("2006-01-02") == endOfMarch.Format("2006-01-02")
+				})).Return([]entity.Installment{
+					{ID: 1, LoanID: 100, WeekNumber: 3, DueDate: "2024-03-18", AmountDue: "110000", FeeAmount: "5000", Status: entity.INSTALLMENT_PENDING},
+					{ID: 2, LoanID: 101, WeekNumber: 1, DueDate: "2024-03-25", AmountDue: "55000", Status: entity.INSTALLMENT_MISSED},
+				}, nil)
+				mockSnowflake.On("Generate").Return(uint64(800))
+				mockRepo.EXPECT().CreateCreditLineBill(mock.Anything, mock.MatchedBy(func(bill entity.CreditLineBill) bool {
+					return bill.ID == 800 && bill.CreditLineID == 700 && bill.Cycle == "2024-03" &&
+						bill.Status == entity.CREDIT_LINE_BILL_OPEN && bill.Amount.Equal(decimal.NewFromInt(170000)) && len(bill.Items) == 2
+				})).RunAndReturn(func(_ context.Context, bill entity.CreditLineBill) (entity.CreditLineBill, error) {
+					return bill, nil
+				})
+			},
+			expectedCheck: func(t *testing.T, output usecases.CreditLineBillOutput) {
+				assert.Equal(t, uint64(800), output.ID)
+				assert.Equal(t, "2024-03-31", output.DueDate)
+				assert.Equal(t, "170000.00", output.Amount)
+				assert.Equal(t, "170000.00", output.Outstanding)
+				assert.Equal(t, "OPEN", output.Status)
+				assert.Empty(t, output.PaidAt)
+				assert.Equal(t, []usecases.CreditLineBillItemOutput{
+					{InstallmentID: 1, LoanID: 100, WeekNumber: 3, Amount: "115000.00", Status: "PENDING"},
+					{InstallmentID: 2, LoanID: 101, WeekNumber: 1, Amount: "55000.00", Status: "MISSED"},
+				}, output.Items)
+			},
+		},
+		{
+			name:          "error - validation error (cycle not a month)",
+			input:         usecases.GenerateCreditLineBillInput{CreditLineID: 700, Cycle: "2024-03-01"},
+			setupMocks:    func(*billingenginemocks.MockGenerateCreditLineBillRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - cycle already billed",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateCreditLineBillRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("IsCreditLineBilled", mock.Anything, uint64(700), "2024-03").Return(true, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - nothing due in the cycle",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateCreditLineBillRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("IsCreditLineBilled", mock.Anything, uint64(700), "2024-03").Return(false, nil)
+				mockRepo.On("GetCreditLineUnbilledInstallments", mock.Anything, uint64(700), mock.Anything).
+					Return([]entity.Installment{}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - credit line not found",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateCreditLineBillRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).
+					Return(entity.CreditLine{}, errors.New("credit line 700 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGenerateCreditLineBillRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewGenerateCreditLineBillInteractor(GenerateCreditLineBillInteractorDependencies{
+				GenerateCreditLineBillRepository: mockRepo,
+				Logger:                           zap.NewNop().Sugar(),
+				Validator:                        validator.New(),
+				SnowflakeGen:                     mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
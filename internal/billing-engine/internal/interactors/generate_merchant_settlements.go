@@ -0,0 +1,150 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GenerateMerchantSettlementsUsecase = (*GenerateMerchantSettlementsInteractor)(nil)
+
+type (
+	GenerateMerchantSettlementsRepository interface {
+		GetUnsettledMerchantItems(ctx context.Context, saleDate time.Time) ([]entity.MerchantSettlementItem, error)
+		IsMerchantSettled(ctx context.Context, merchantID uint64, settlementDate time.Time) (bool, error)
+		CreateMerchantSettlement(ctx context.Context, settlement entity.MerchantSettlement) (entity.MerchantSettlement, error)
+	}
+
+	GenerateMerchantSettlementsInteractorDependencies struct {
+		GenerateMerchantSettlementsRepository GenerateMerchantSettlementsRepository
+		Logger                                *zap.SugaredLogger
+		Validator                             *validator.Validate
+		SnowflakeGen                          pkguid.Snowflake
+	}
+
+	GenerateMerchantSettlementsInteractor struct {
+		repository   GenerateMerchantSettlementsRepository `validate:"required"`
+		logger       *zap.SugaredLogger                    `validate:"required"`
+		validator    *validator.Validate                   `validate:"required"`
+		snowflakeGen pkguid.Snowflake                      `validate:"required"`
+	}
+)
+
+func NewGenerateMerchantSettlementsInteractor(
+	deps GenerateMerchantSettlementsInteractorDependencies,
+) *GenerateMerchantSettlementsInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GenerateMerchantSettlementsInteractor{
+		repository:   deps.GenerateMerchantSettlementsRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.GenerateMerchantSettlementsUsecase.
+//
+// Each merchant gets one batch a day with what it is owed for the loans it
+// sold up to the settlement date and that no earlier batch paid. A merchant
+// already batched that day is skipped, so the run can be repeated; what it
+// sold since is picked up by the next day's batch.
+func (g *GenerateMerchantSettlementsInteractor) Execute(ctx context.Context, input usecases.GenerateMerchantSettlementsInput) (usecases.GenerateMerchantSettlementsOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GenerateMerchantSettlementsOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	settlementDate, err := parseAsOfDate(input.SettlementDate)
+	if err != nil {
+		return usecases.GenerateMerchantSettlementsOutput{}, err
+	}
+
+	items, err := g.repository.GetUnsettledMerchantItems(ctx, settlementDate)
+	if err != nil {
+		g.logger.Errorw("failed to get unsettled merchant items", "error", err, "settlement_date", input.SettlementDate)
+		return usecases.GenerateMerchantSettlementsOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	var merchantIDs []uint64
+	itemsByMerchant := make(map[uint64][]entity.MerchantSettlementItem)
+	for _, item := range items {
+		if _, ok := itemsByMerchant[item.MerchantID]; !ok {
+			merchantIDs = append(merchantIDs, item.MerchantID)
+		}
+		itemsByMerchant[item.MerchantID] = append(itemsByMerchant[item.MerchantID], item)
+	}
+
+	output := usecases.GenerateMerchantSettlementsOutput{
+		SettlementDate: settlementDate.Format(dateLayout),
+		Settlements:    make([]usecases.MerchantSettlementOutput, 0, len(merchantIDs)),
+	}
+
+	now := time.Now()
+	for _, merchantID := range merchantIDs {
+		isSettled, err := g.repository.IsMerchantSettled(ctx, merchantID, settlementDate)
+		if err != nil {
+			g.logger.Errorw("failed to check merchant settlement", "error", err, "merchant_id", merchantID)
+			return usecases.GenerateMerchantSettlementsOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		if isSettled {
+			continue
+		}
+
+		settlement := entity.NewMerchantSettlement(g.snowflakeGen.Generate(), merchantID, settlementDate, itemsByMerchant[merchantID], input.GeneratedBy, now)
+		created, err := g.repository.CreateMerchantSettlement(ctx, settlement)
+		if err != nil {
+			g.logger.Errorw("failed to create merchant settlement", "error", err, "merchant_id", merchantID)
+			return usecases.GenerateMerchantSettlementsOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		output.Settlements = append(output.Settlements, toMerchantSettlementOutput(created))
+	}
+
+	return output, nil
+}
+
+func toMerchantSettlementOutput(settlement entity.MerchantSettlement) usecases.MerchantSettlementOutput {
+	output := usecases.MerchantSettlementOutput{
+		ID:             settlement.ID,
+		MerchantID:     settlement.MerchantID,
+		SettlementDate: settlement.SettlementDate.Format(dateLayout),
+		GrossAmount:    settlement.GrossAmount.StringFixed(2),
+		MDRAmount:      settlement.MDRAmount.StringFixed(2),
+		NetAmount:      settlement.NetAmount.StringFixed(2),
+		ItemCount:      settlement.ItemCount,
+		Status:         string(settlement.Status),
+		Reference:      settlement.Reference,
+		FailureReason:  settlement.FailureReason,
+		UpdatedBy:      settlement.UpdatedBy,
+		CreatedAt:      settlement.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      settlement.UpdatedAt.Format(time.RFC3339),
+		Items:          make([]usecases.MerchantSettlementItemOutput, len(settlement.Items)),
+	}
+	if !settlement.SettledAt.IsZero() {
+		output.SettledAt = settlement.SettledAt.Format(time.RFC3339)
+	}
+
+	for i, item := range settlement.Items {
+		output.Items[i] = usecases.MerchantSettlementItemOutput{
+			LoanID:      item.LoanID,
+			OrderRef:    item.OrderRef,
+			SaleDate:    item.SaleDate.Format(dateLayout),
+			GrossAmount: item.GrossAmount.StringFixed(2),
+			MDRAmount:   item.MDRAmount.StringFixed(2),
+			NetAmount:   item.NetAmount.StringFixed(2),
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,147 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGenerateMerchantSettlementsInteractor_Execute(t *testing.T) {
+	settlementDate := time.Date(2024, 3, 5, 0, 0, 0, 0, time.Local)
+	input := usecases.GenerateMerchantSettlementsInput{SettlementDate: "2024-03-05", GeneratedBy: "finance"}
+	items := []entity.MerchantSettlementItem{
+		{ID: 1, MerchantID: 900, LoanID: 100, OrderRef: "ORD-1", SaleDate: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+			GrossAmount: decimal.NewFromInt(1000000), MDRAmount: decimal.NewFromInt(25000), NetAmount: decimal.NewFromInt(975000)},
+		{ID: 2, MerchantID: 900, LoanID: 101, OrderRef: "ORD-2", SaleDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+			GrossAmount: decimal.NewFromInt(2000000), MDRAmount: decimal.NewFromInt(50000), NetAmount: decimal.NewFromInt(1950000)},
+		{ID: 3, MerchantID: 901, LoanID: 102, OrderRef: "A-9", SaleDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+			GrossAmount: decimal.NewFromInt(500000), MDRAmount: decimal.NewFromInt(10000), NetAmount: decimal.NewFromInt(490000)},
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.GenerateMerchantSettlementsInput
+		setupMocks    func(*billingenginemocks.MockGenerateMerchantSettlementsRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.GenerateMerchantSettlementsOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - one batch per merchant with what it is owed",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateMerchantSettlementsRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetUnsettledMerchantItems", mock.Anything, settlementDate).Return(items, nil)
+				mockRepo.On("IsMerchantSettled", mock.Anything, uint64(900), settlementDate).Return(false, nil)
+				mockRepo.On("IsMerchantSettled", mock.Anything, uint64(901), settlementDate).Return(false, nil)
+				mockSnowflake.On("Generate").Return(uint64(1000)).Once()
+				mockSnowflake.On("Generate").Return(uint64(1001)).Once()
+				mockRepo.EXPECT().CreateMerchantSettlement(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, settlement entity.MerchantSettlement) (entity.MerchantSettlement, error) {
+						return settlement, nil
+					})
+			},
+			expectedCheck: func(t *testing.T, output usecases.GenerateMerchantSettlementsOutput) {
+				assert.Equal(t, "2024-03-05", output.SettlementDate)
+				assert.Len(t, output.Settlements, 2)
+
+				first := output.Settlements[0]
+				assert.Equal(t, uint64(1000), first.ID)
+				assert.Equal(t, uint64(900), first.MerchantID)
+				assert.Equal(t, "3000000.00", first.GrossAmount)
+				assert.Equal(t, "75000.00", first.MDRAmount)
+				assert.Equal(t, "2925000.00", first.NetAmount)
+				assert.Equal(t, int64(2), first.ItemCount)
+				assert.Equal(t, "PENDING", first.Status)
+				assert.Equal(t, "ORD-1", first.Items[0].OrderRef)
+				assert.Equal(t, "2024-03-04", first.Items[0].SaleDate)
+
+				assert.Equal(t, uint64(901), output.Settlements[1].MerchantID)
+				assert.Equal(t, "490000.00", output.Settlements[1].NetAmount)
+			},
+		},
+		{
+			name:  "success - merchant already batched that day is skipped",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateMerchantSettlementsRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetUnsettledMerchantItems", mock.Anything, settlementDate).Return(items, nil)
+				mockRepo.On("IsMerchantSettled", mock.Anything, uint64(900), settlementDate).Return(true, nil)
+				mockRepo.On("IsMerchantSettled", mock.Anything, uint64(901), settlementDate).Return(false, nil)
+				mockSnowflake.On("Generate").Return(uint64(1001))
+				mockRepo.EXPECT().CreateMerchantSettlement(mock.Anything, mock.MatchedBy(func(settlement entity.MerchantSettlement) bool {
+					return settlement.MerchantID == 901 && len(settlement.Items) == 1 && settlement.Items[0].SettlementID == 1001
+				})).RunAndReturn(func(_ context.Context, settlement entity.MerchantSettlement) (entity.MerchantSettlement, error) {
+					return settlement, nil
+				})
+			},
+			expectedCheck: func(t *testing.T, output usecases.GenerateMerchantSettlementsOutput) {
+				assert.Len(t, output.Settlements, 1)
+				assert.Equal(t, uint64(901), output.Settlements[0].MerchantID)
+			},
+		},
+		{
+			name:  "success - nothing owed",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateMerchantSettlementsRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetUnsettledMerchantItems", mock.Anything, settlementDate).Return([]entity.MerchantSettlementItem(nil), nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.GenerateMerchantSettlementsOutput) {
+				assert.Equal(t, []usecases.MerchantSettlementOutput{}, output.Settlements)
+			},
+		},
+		{
+			name:          "error - validation error (missing generated_by)",
+			input:         usecases.GenerateMerchantSettlementsInput{SettlementDate: "2024-03-05"},
+			setupMocks:    func(*billingenginemocks.MockGenerateMerchantSettlementsRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - item batched in the meantime",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockGenerateMerchantSettlementsRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetUnsettledMerchantItems", mock.Anything, settlementDate).Return(items[2:], nil)
+				mockRepo.On("IsMerchantSettled", mock.Anything, uint64(901), settlementDate).Return(false, nil)
+				mockSnowflake.On("Generate").Return(uint64(1001))
+				mockRepo.On("CreateMerchantSettlement", mock.Anything, mock.Anything).
+					Return(entity.MerchantSettlement{}, errors.New("1 of the 1 items of merchant settlement 1001 were already batched"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGenerateMerchantSettlementsRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewGenerateMerchantSettlementsInteractor(GenerateMerchantSettlementsInteractorDependencies{
+				GenerateMerchantSettlementsRepository: mockRepo,
+				Logger:                                zap.NewNop().Sugar(),
+				Validator:                             validator.New(),
+				SnowflakeGen:                          mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
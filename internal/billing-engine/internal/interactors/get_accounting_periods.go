@@ -0,0 +1,74 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetAccountingPeriodsUsecase = (*GetAccountingPeriodsInteractor)(nil)
+
+type (
+	GetAccountingPeriodsRepository interface {
+		GetAccountingPeriods(ctx context.Context) ([]entity.AccountingPeriod, error)
+	}
+
+	GetAccountingPeriodsInteractorDependencies struct {
+		GetAccountingPeriodsRepository GetAccountingPeriodsRepository
+		Logger                         *zap.SugaredLogger
+	}
+
+	GetAccountingPeriodsInteractor struct {
+		repository GetAccountingPeriodsRepository `validate:"required"`
+		logger     *zap.SugaredLogger             `validate:"required"`
+	}
+)
+
+func NewGetAccountingPeriodsInteractor(
+	deps GetAccountingPeriodsInteractorDependencies,
+) *GetAccountingPeriodsInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetAccountingPeriodsInteractor{
+		repository: deps.GetAccountingPeriodsRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetAccountingPeriodsUsecase.
+func (g *GetAccountingPeriodsInteractor) Execute(ctx context.Context) (usecases.GetAccountingPeriodsOutput, error) {
+	periods, err := g.repository.GetAccountingPeriods(ctx)
+	if err != nil {
+		g.logger.Errorw("failed to get accounting periods", "error", err)
+		return usecases.GetAccountingPeriodsOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetAccountingPeriodsOutput{
+		Periods: make([]usecases.AccountingPeriodOutput, len(periods)),
+	}
+	for i, period := range periods {
+		if output.LockedThrough == "" && period.Status == entity.PERIOD_CLOSED {
+			output.LockedThrough = period.Period
+		}
+		output.Periods[i] = toAccountingPeriodOutput(period)
+	}
+
+	return output, nil
+}
+
+func toAccountingPeriodOutput(period entity.AccountingPeriod) usecases.AccountingPeriodOutput {
+	return usecases.AccountingPeriodOutput{
+		Period:   period.Period,
+		Status:   string(period.Status),
+		ClosedBy: period.ClosedBy,
+		ClosedAt: period.ClosedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,82 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetAccountingPeriodsInteractor_Execute(t *testing.T) {
+	closedAt := time.Date(2024, 4, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*billingenginemocks.MockGetAccountingPeriodsRepository)
+		expectedOutput usecases.GetAccountingPeriodsOutput
+		expectedError  error
+	}{
+		{
+			name: "success - periods listed with the lock",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetAccountingPeriodsRepository) {
+				mockRepo.On("GetAccountingPeriods", mock.Anything).Return([]entity.AccountingPeriod{
+					{Period: "2024-03", Status: entity.PERIOD_CLOSED, ClosedBy: "finance-1", ClosedAt: closedAt},
+					{Period: "2024-02", Status: entity.PERIOD_CLOSED, ClosedBy: "finance-1", ClosedAt: closedAt},
+				}, nil)
+			},
+			expectedOutput: usecases.GetAccountingPeriodsOutput{
+				LockedThrough: "2024-03",
+				Periods: []usecases.AccountingPeriodOutput{
+					{Period: "2024-03", Status: "CLOSED", ClosedBy: "finance-1", ClosedAt: "2024-04-02T09:00:00Z"},
+					{Period: "2024-02", Status: "CLOSED", ClosedBy: "finance-1", ClosedAt: "2024-04-02T09:00:00Z"},
+				},
+			},
+		},
+		{
+			name: "success - no period closed yet",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetAccountingPeriodsRepository) {
+				mockRepo.On("GetAccountingPeriods", mock.Anything).Return(nil, nil)
+			},
+			expectedOutput: usecases.GetAccountingPeriodsOutput{Periods: []usecases.AccountingPeriodOutput{}},
+		},
+		{
+			name: "error - repository error",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetAccountingPeriodsRepository) {
+				mockRepo.On("GetAccountingPeriods", mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetAccountingPeriodsRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetAccountingPeriodsInteractor(GetAccountingPeriodsInteractorDependencies{
+				GetAccountingPeriodsRepository: mockRepo,
+				Logger:                         zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background())
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -14,7 +14,7 @@ var _ usecases.GetAllCustomerUsecase = (*GetAllCustomerInteractor)(nil)
 
 type (
 	GetAllCustomerRepository interface {
-		GetAllCustomer(ctx context.Context) ([]entity.Customer, error)
+		SearchCustomers(ctx context.Context, search entity.CustomerSearch) ([]entity.Customer, error)
 	}
 
 	GetAllCustomerInteractorDependencies struct {
@@ -47,25 +47,71 @@ func NewGetAllCustomerInteractor(
 }
 
 // Execute implements usecases.GetAllCustomerUsecase.
-func (g *GetAllCustomerInteractor) Execute(ctx context.Context) (usecases.GetAllCustomerOutput, error) {
-	customers, err := g.repository.GetAllCustomer(ctx)
+//
+// Pages are cut after the snowflake ID, or the name then the ID, of their
+// last customer, so paging stays consistent while customers are added.
+func (g *GetAllCustomerInteractor) Execute(ctx context.Context, input usecases.GetAllCustomerInput) (usecases.GetAllCustomerOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GetAllCustomerOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	search := entity.CustomerSearch{
+		NamePrefix: input.Name,
+		Email:      input.Email,
+		KYCStatus:  entity.KYCStatus(input.KYCStatus),
+		Sort:       entity.CustomerSort(input.Sort),
+		Limit:      input.Limit,
+	}
+	if search.Sort == "" {
+		search.Sort = entity.CUSTOMER_SORT_OLDEST
+	}
+	if search.Limit == 0 {
+		search.Limit = entity.DEFAULT_PAGE_SIZE
+	}
+	if input.HasActiveLoan != "" {
+		hasActiveLoan := input.HasActiveLoan == "true"
+		search.HasActiveLoan = &hasActiveLoan
+	}
+
+	if input.Cursor != "" {
+		cursor, err := entity.DecodeCursor(input.Cursor, string(search.Sort))
+		if err != nil {
+			return usecases.GetAllCustomerOutput{}, pkgerror.ValidationErrorFrom(err)
+		}
+		search.After = &cursor
+	}
+
+	// One more customer than the page holds tells whether another page follows
+	pageSize := search.Limit
+	search.Limit++
+
+	customers, err := g.repository.SearchCustomers(ctx, search)
 	if err != nil {
-		g.logger.Errorw("failed to get all customer", "error", err)
+		g.logger.Errorw("failed to search customers", "error", err)
 		return usecases.GetAllCustomerOutput{}, pkgerror.BusinessErrorFrom(
 			err,
 		)
 	}
 
+	var nextCursor string
+	if int64(len(customers)) > pageSize {
+		customers = customers[:pageSize]
+		nextCursor = search.CursorOf(customers[pageSize-1]).Encode()
+	}
+
 	customersOutput := make([]usecases.CustomerOutput, len(customers))
 	for i, customer := range customers {
 		customersOutput[i] = usecases.CustomerOutput{
-			ID:    customer.ID,
-			Name:  customer.Name,
-			Email: customer.Email,
+			ID:        customer.ID,
+			Name:      customer.Name,
+			Email:     customer.Email,
+			KYCStatus: string(customer.KYCStatus),
 		}
 	}
 
 	return usecases.GetAllCustomerOutput{
-		Customers: customersOutput,
+		Customers:  customersOutput,
+		NextCursor: nextCursor,
 	}, nil
 }
@@ -18,18 +18,22 @@ import (
 func TestGetAllCustomerInteractor_Execute(t *testing.T) {
 	tests := []struct {
 		name           string
+		input          usecases.GetAllCustomerInput
 		setupMocks     func(*billingenginemocks.MockGetAllCustomerRepository)
 		expectedOutput usecases.GetAllCustomerOutput
 		expectedError  error
 	}{
 		{
-			name: "success - get all customers",
+			name:  "success - get all customers",
+			input: usecases.GetAllCustomerInput{},
 			setupMocks: func(mockRepo *billingenginemocks.MockGetAllCustomerRepository) {
 				customers := []entity.Customer{
 					{ID: 1, Name: "John Doe", Email: "john@example.com"},
 					{ID: 2, Name: "Jane Smith", Email: "jane@example.com"},
 				}
-				mockRepo.On("GetAllCustomer", mock.Anything).Return(customers, nil)
+				mockRepo.On("SearchCustomers", mock.Anything, entity.CustomerSearch{
+					Sort: entity.CUSTOMER_SORT_OLDEST, Limit: entity.DEFAULT_PAGE_SIZE + 1,
+				}).Return(customers, nil)
 			},
 			expectedOutput: usecases.GetAllCustomerOutput{
 				Customers: []usecases.CustomerOutput{
@@ -40,9 +44,10 @@ func TestGetAllCustomerInteractor_Execute(t *testing.T) {
 			expectedError: nil,
 		},
 		{
-			name: "success - empty customer list",
+			name:  "success - empty customer list",
+			input: usecases.GetAllCustomerInput{},
 			setupMocks: func(mockRepo *billingenginemocks.MockGetAllCustomerRepository) {
-				mockRepo.On("GetAllCustomer", mock.Anything).Return([]entity.Customer{}, nil)
+				mockRepo.On("SearchCustomers", mock.Anything, mock.Anything).Return([]entity.Customer{}, nil)
 			},
 			expectedOutput: usecases.GetAllCustomerOutput{
 				Customers: []usecases.CustomerOutput{},
@@ -50,10 +55,62 @@ func TestGetAllCustomerInteractor_Execute(t *testing.T) {
 			expectedError: nil,
 		},
 		{
-			name: "error - repository error",
+			name:  "success - full page returns a cursor for the next one",
+			input: usecases.GetAllCustomerInput{Limit: 2, Name: "ja", KYCStatus: "VERIFIED", HasActiveLoan: "false", Sort: "name"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetAllCustomerRepository) {
+				mockRepo.On("SearchCustomers", mock.Anything, mock.MatchedBy(func(search entity.CustomerSearch) bool {
+					return search.NamePrefix == "ja" && search.KYCStatus == entity.KYC_VERIFIED && search.HasActiveLoan != nil &&
+						!*search.HasActiveLoan && search.Sort == entity.CUSTOMER_SORT_NAME && search.Limit == 3 && search.After == nil
+				})).Return([]entity.Customer{
+					{ID: 7, Name: "Jane", Email: "jane@example.com", KYCStatus: entity.KYC_VERIFIED},
+					{ID: 3, Name: "Januar", Email: "januar@example.com", KYCStatus: entity.KYC_VERIFIED},
+					{ID: 5, Name: "Jaya", Email: "jaya@example.com", KYCStatus: entity.KYC_VERIFIED},
+				}, nil)
+			},
+			expectedOutput: usecases.GetAllCustomerOutput{
+				Customers: []usecases.CustomerOutput{
+					{ID: 7, Name: "Jane", Email: "jane@example.com", KYCStatus: "VERIFIED"},
+					{ID: 3, Name: "Januar", Email: "januar@example.com", KYCStatus: "VERIFIED"},
+				},
+				NextCursor: entity.Cursor{Sort: "name", Key: "Januar", ID: 3}.Encode(),
+			},
+		},
+		{
+			name:  "success - next page starts after the cursor",
+			input: usecases.GetAllCustomerInput{Cursor: entity.Cursor{Sort: "-id", ID: 100}.Encode(), Sort: "-id"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetAllCustomerRepository) {
+				mockRepo.On("SearchCustomers", mock.Anything, mock.MatchedBy(func(search entity.CustomerSearch) bool {
+					return search.After != nil && search.After.ID == 100 && search.Sort == entity.CUSTOMER_SORT_NEWEST
+				})).Return([]entity.Customer{{ID: 99, Name: "John Doe", Email: "john@example.com"}}, nil)
+			},
+			expectedOutput: usecases.GetAllCustomerOutput{
+				Customers: []usecases.CustomerOutput{{ID: 99, Name: "John Doe", Email: "john@example.com"}},
+			},
+		},
+		{
+			name:          "error - cursor of another sort",
+			input:         usecases.GetAllCustomerInput{Cursor: entity.Cursor{Sort: "name", Key: "Jane", ID: 7}.Encode()},
+			setupMocks:    func(*billingenginemocks.MockGetAllCustomerRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - malformed cursor",
+			input:         usecases.GetAllCustomerInput{Cursor: "not-a-cursor"},
+			setupMocks:    func(*billingenginemocks.MockGetAllCustomerRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (limit above the maximum)",
+			input:         usecases.GetAllCustomerInput{Limit: 1000},
+			setupMocks:    func(*billingenginemocks.MockGetAllCustomerRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error",
+			input: usecases.GetAllCustomerInput{},
 			setupMocks: func(mockRepo *billingenginemocks.MockGetAllCustomerRepository) {
 				repoErr := errors.New("db error")
-				mockRepo.On("GetAllCustomer", mock.Anything).Return(nil, repoErr)
+				mockRepo.On("SearchCustomers", mock.Anything, mock.Anything).Return(nil, repoErr)
 			},
 			expectedOutput: usecases.GetAllCustomerOutput{},
 			expectedError:  &pkgerror.Error{},
@@ -74,7 +131,7 @@ func TestGetAllCustomerInteractor_Execute(t *testing.T) {
 				Validator:                validator,
 			})
 
-			output, err := interactor.Execute(context.Background())
+			output, err := interactor.Execute(context.Background(), tt.input)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -0,0 +1,87 @@
+package interactors
+
+import (
+	"context"
+	"sort"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetCollateralReportUsecase = (*GetCollateralReportInteractor)(nil)
+
+type (
+	GetCollateralReportRepository interface {
+		GetCollateralisedLoans(ctx context.Context) ([]entity.CollateralisedLoan, error)
+	}
+
+	GetCollateralReportInteractorDependencies struct {
+		GetCollateralReportRepository GetCollateralReportRepository
+		Logger                        *zap.SugaredLogger
+	}
+
+	GetCollateralReportInteractor struct {
+		repository GetCollateralReportRepository `validate:"required"`
+		logger     *zap.SugaredLogger            `validate:"required"`
+	}
+)
+
+func NewGetCollateralReportInteractor(
+	deps GetCollateralReportInteractorDependencies,
+) *GetCollateralReportInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetCollateralReportInteractor{
+		repository: deps.GetCollateralReportRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetCollateralReportUsecase.
+//
+// The report covers the active loans with collateral still held, the least
+// covered first.
+func (g *GetCollateralReportInteractor) Execute(ctx context.Context) (usecases.CollateralReportOutput, error) {
+	loans, err := g.repository.GetCollateralisedLoans(ctx)
+	if err != nil {
+		g.logger.Errorw("failed to get collateralised loans", "error", err)
+		return usecases.CollateralReportOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	sort.SliceStable(loans, func(i, j int) bool {
+		return entity.LoanToValue(loans[i].Exposure, loans[i].Valuation).
+			GreaterThan(entity.LoanToValue(loans[j].Exposure, loans[j].Valuation))
+	})
+
+	exposure, valuation := decimal.Zero, decimal.Zero
+	output := usecases.CollateralReportOutput{
+		Loans: make([]usecases.CollateralisedLoanOutput, len(loans)),
+	}
+
+	for i, loan := range loans {
+		exposure = exposure.Add(loan.Exposure)
+		valuation = valuation.Add(loan.Valuation)
+
+		output.Loans[i] = usecases.CollateralisedLoanOutput{
+			LoanID:      loan.LoanID,
+			CustomerID:  loan.CustomerID,
+			LoanStatus:  string(loan.LoanStatus),
+			Exposure:    loan.Exposure.StringFixed(2),
+			Valuation:   loan.Valuation.StringFixed(2),
+			LoanToValue: entity.LoanToValue(loan.Exposure, loan.Valuation).StringFixed(4),
+		}
+	}
+
+	output.Exposure = exposure.StringFixed(2)
+	output.Valuation = valuation.StringFixed(2)
+	output.LoanToValue = entity.LoanToValue(exposure, valuation).StringFixed(4)
+
+	return output, nil
+}
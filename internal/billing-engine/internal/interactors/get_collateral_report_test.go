@@ -0,0 +1,81 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetCollateralReportInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMocks     func(*billingenginemocks.MockGetCollateralReportRepository)
+		expectedOutput usecases.CollateralReportOutput
+		expectedError  error
+	}{
+		{
+			name: "success - least covered loans first",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCollateralReportRepository) {
+				mockRepo.On("GetCollateralisedLoans", mock.Anything).Return([]entity.CollateralisedLoan{
+					{LoanID: 100, CustomerID: 1, LoanStatus: entity.LOAN_DISBURSED, Exposure: decimal.NewFromInt(3000000), Valuation: decimal.NewFromInt(6000000)},
+					{LoanID: 101, CustomerID: 2, LoanStatus: entity.LOAN_APPROVED, Exposure: decimal.NewFromInt(9000000), Valuation: decimal.NewFromInt(10000000)},
+				}, nil)
+			},
+			expectedOutput: usecases.CollateralReportOutput{
+				Exposure: "12000000.00", Valuation: "16000000.00", LoanToValue: "0.7500",
+				Loans: []usecases.CollateralisedLoanOutput{
+					{LoanID: 101, CustomerID: 2, LoanStatus: "APPROVED", Exposure: "9000000.00", Valuation: "10000000.00", LoanToValue: "0.9000"},
+					{LoanID: 100, CustomerID: 1, LoanStatus: "DISBURSED", Exposure: "3000000.00", Valuation: "6000000.00", LoanToValue: "0.5000"},
+				},
+			},
+		},
+		{
+			name: "success - no secured loans",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCollateralReportRepository) {
+				mockRepo.On("GetCollateralisedLoans", mock.Anything).Return(nil, nil)
+			},
+			expectedOutput: usecases.CollateralReportOutput{
+				Exposure: "0.00", Valuation: "0.00", LoanToValue: "0.0000", Loans: []usecases.CollateralisedLoanOutput{},
+			},
+		},
+		{
+			name: "error - repository error",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCollateralReportRepository) {
+				mockRepo.On("GetCollateralisedLoans", mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetCollateralReportRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetCollateralReportInteractor(GetCollateralReportInteractorDependencies{
+				GetCollateralReportRepository: mockRepo,
+				Logger:                        zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background())
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
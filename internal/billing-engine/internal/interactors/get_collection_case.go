@@ -0,0 +1,82 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetCollectionCaseUsecase = (*GetCollectionCaseInteractor)(nil)
+
+type (
+	GetCollectionCaseRepository interface {
+		GetCollectionCase(ctx context.Context, caseID uint64) (entity.CollectionCase, error)
+		GetContactAttemptsByCase(ctx context.Context, caseID uint64) ([]entity.ContactAttempt, error)
+		GetPromisesToPayByCase(ctx context.Context, caseID uint64) ([]entity.PromiseToPay, error)
+	}
+
+	GetCollectionCaseInteractorDependencies struct {
+		GetCollectionCaseRepository GetCollectionCaseRepository
+		Logger                      *zap.SugaredLogger
+	}
+
+	GetCollectionCaseInteractor struct {
+		repository GetCollectionCaseRepository `validate:"required"`
+		logger     *zap.SugaredLogger          `validate:"required"`
+	}
+)
+
+func NewGetCollectionCaseInteractor(
+	deps GetCollectionCaseInteractorDependencies,
+) *GetCollectionCaseInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetCollectionCaseInteractor{
+		repository: deps.GetCollectionCaseRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetCollectionCaseUsecase.
+func (g *GetCollectionCaseInteractor) Execute(ctx context.Context, caseID uint64) (usecases.GetCollectionCaseOutput, error) {
+	collectionCase, err := g.repository.GetCollectionCase(ctx, caseID)
+	if err != nil {
+		g.logger.Errorw("failed to get collection case", "error", err, "case_id", caseID)
+		return usecases.GetCollectionCaseOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	attempts, err := g.repository.GetContactAttemptsByCase(ctx, caseID)
+	if err != nil {
+		g.logger.Errorw("failed to get contact attempts", "error", err, "case_id", caseID)
+		return usecases.GetCollectionCaseOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	promises, err := g.repository.GetPromisesToPayByCase(ctx, caseID)
+	if err != nil {
+		g.logger.Errorw("failed to get promises to pay", "error", err, "case_id", caseID)
+		return usecases.GetCollectionCaseOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetCollectionCaseOutput{
+		Case:     toCollectionCaseOutput(collectionCase),
+		Attempts: make([]usecases.ContactAttemptOutput, len(attempts)),
+		Promises: make([]usecases.PromiseToPayOutput, len(promises)),
+	}
+
+	for i, attempt := range attempts {
+		output.Attempts[i] = toContactAttemptOutput(attempt)
+	}
+
+	for i, promise := range promises {
+		output.Promises[i] = toPromiseToPayOutput(promise)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,90 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetCollectionCaseInteractor_Execute(t *testing.T) {
+	openedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	collectionCase := entity.CollectionCase{
+		ID: 1, LoanID: 100, CustomerID: 10, AgentID: 7, Bucket: entity.COLLECTION_BUCKET_1_30,
+		DaysPastDue: 14, MissedInstallments: 2, Status: entity.COLLECTION_CASE_OPEN, OpenedAt: openedAt,
+	}
+
+	tests := []struct {
+		name           string
+		caseID         uint64
+		setupMocks     func(*billingenginemocks.MockGetCollectionCaseRepository)
+		expectedOutput usecases.GetCollectionCaseOutput
+		expectedError  error
+	}{
+		{
+			name:   "success - case with attempts and promises",
+			caseID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCollectionCaseRepository) {
+				mockRepo.On("GetCollectionCase", mock.Anything, uint64(1)).Return(collectionCase, nil)
+				mockRepo.On("GetContactAttemptsByCase", mock.Anything, uint64(1)).Return([]entity.ContactAttempt{
+					{ID: 2, CaseID: 1, AgentID: 7, Channel: entity.CONTACT_CHANNEL_CALL, Outcome: entity.CONTACT_OUTCOME_PROMISE_TO_PAY, AttemptedAt: openedAt},
+				}, nil)
+				mockRepo.On("GetPromisesToPayByCase", mock.Anything, uint64(1)).Return([]entity.PromiseToPay{
+					{ID: 3, CaseID: 1, LoanID: 100, PromisedDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), PromisedAmount: decimal.NewFromInt(220000), Status: entity.PROMISE_TO_PAY_PENDING},
+				}, nil)
+			},
+			expectedOutput: usecases.GetCollectionCaseOutput{
+				Case: usecases.CollectionCaseOutput{
+					ID: 1, LoanID: 100, CustomerID: 10, AgentID: 7, Bucket: "DPD_1_30",
+					DaysPastDue: 14, MissedInstallments: 2, Status: "OPEN", OpenedAt: "2024-03-01T09:00:00Z",
+				},
+				Attempts: []usecases.ContactAttemptOutput{
+					{ID: 2, CaseID: 1, AgentID: 7, Channel: "CALL", Outcome: "PROMISE_TO_PAY", AttemptedAt: "2024-03-01T09:00:00Z"},
+				},
+				Promises: []usecases.PromiseToPayOutput{
+					{ID: 3, CaseID: 1, LoanID: 100, PromisedDate: "2024-03-05", PromisedAmount: "220000", Status: "PENDING"},
+				},
+			},
+		},
+		{
+			name:   "error - case not found",
+			caseID: 2,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCollectionCaseRepository) {
+				mockRepo.On("GetCollectionCase", mock.Anything, uint64(2)).Return(entity.CollectionCase{}, errors.New("collection case 2 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetCollectionCaseRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetCollectionCaseInteractor(GetCollectionCaseInteractorDependencies{
+				GetCollectionCaseRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.caseID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
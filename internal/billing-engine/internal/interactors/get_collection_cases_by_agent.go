@@ -0,0 +1,59 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetCollectionCasesByAgentUsecase = (*GetCollectionCasesByAgentInteractor)(nil)
+
+type (
+	GetCollectionCasesByAgentRepository interface {
+		GetCollectionCasesByAgent(ctx context.Context, agentID uint64) ([]entity.CollectionCase, error)
+	}
+
+	GetCollectionCasesByAgentInteractorDependencies struct {
+		GetCollectionCasesByAgentRepository GetCollectionCasesByAgentRepository
+		Logger                              *zap.SugaredLogger
+	}
+
+	GetCollectionCasesByAgentInteractor struct {
+		repository GetCollectionCasesByAgentRepository `validate:"required"`
+		logger     *zap.SugaredLogger                  `validate:"required"`
+	}
+)
+
+func NewGetCollectionCasesByAgentInteractor(
+	deps GetCollectionCasesByAgentInteractorDependencies,
+) *GetCollectionCasesByAgentInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetCollectionCasesByAgentInteractor{
+		repository: deps.GetCollectionCasesByAgentRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetCollectionCasesByAgentUsecase.
+func (g *GetCollectionCasesByAgentInteractor) Execute(ctx context.Context, agentID uint64) ([]usecases.CollectionCaseOutput, error) {
+	cases, err := g.repository.GetCollectionCasesByAgent(ctx, agentID)
+	if err != nil {
+		g.logger.Errorw("failed to get collection cases by agent", "error", err, "agent_id", agentID)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	outputs := make([]usecases.CollectionCaseOutput, len(cases))
+	for i, collectionCase := range cases {
+		outputs[i] = toCollectionCaseOutput(collectionCase)
+	}
+
+	return outputs, nil
+}
@@ -0,0 +1,80 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetCollectionCasesByAgentInteractor_Execute(t *testing.T) {
+	openedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		agentID        uint64
+		setupMocks     func(*billingenginemocks.MockGetCollectionCasesByAgentRepository)
+		expectedOutput []usecases.CollectionCaseOutput
+		expectedError  error
+	}{
+		{
+			name:    "success - cases for agent",
+			agentID: 7,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCollectionCasesByAgentRepository) {
+				mockRepo.On("GetCollectionCasesByAgent", mock.Anything, uint64(7)).Return([]entity.CollectionCase{
+					{ID: 1, LoanID: 100, CustomerID: 10, AgentID: 7, Bucket: entity.COLLECTION_BUCKET_1_30, DaysPastDue: 14, MissedInstallments: 2, Status: entity.COLLECTION_CASE_OPEN, OpenedAt: openedAt},
+				}, nil)
+			},
+			expectedOutput: []usecases.CollectionCaseOutput{
+				{ID: 1, LoanID: 100, CustomerID: 10, AgentID: 7, Bucket: "DPD_1_30", DaysPastDue: 14, MissedInstallments: 2, Status: "OPEN", OpenedAt: "2024-03-01T09:00:00Z"},
+			},
+		},
+		{
+			name:    "success - no cases",
+			agentID: 8,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCollectionCasesByAgentRepository) {
+				mockRepo.On("GetCollectionCasesByAgent", mock.Anything, uint64(8)).Return(nil, nil)
+			},
+			expectedOutput: []usecases.CollectionCaseOutput{},
+		},
+		{
+			name:    "error - repository error",
+			agentID: 9,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCollectionCasesByAgentRepository) {
+				mockRepo.On("GetCollectionCasesByAgent", mock.Anything, uint64(9)).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetCollectionCasesByAgentRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetCollectionCasesByAgentInteractor(GetCollectionCasesByAgentInteractorDependencies{
+				GetCollectionCasesByAgentRepository: mockRepo,
+				Logger:                              zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.agentID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
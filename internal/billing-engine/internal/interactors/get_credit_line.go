@@ -0,0 +1,76 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetCreditLineUsecase = (*GetCreditLineInteractor)(nil)
+
+type (
+	GetCreditLineRepository interface {
+		GetCreditLine(ctx context.Context, creditLineID uint64) (entity.CreditLine, error)
+		GetCreditLineUsed(ctx context.Context, creditLineID uint64) (decimal.Decimal, error)
+		IsCreditLineOverdue(ctx context.Context, creditLineID uint64) (bool, error)
+		GetCreditLineDrawdowns(ctx context.Context, creditLineID uint64) ([]entity.Loan, error)
+	}
+
+	GetCreditLineInteractorDependencies struct {
+		GetCreditLineRepository GetCreditLineRepository
+		Logger                  *zap.SugaredLogger
+	}
+
+	GetCreditLineInteractor struct {
+		repository GetCreditLineRepository `validate:"required"`
+		logger     *zap.SugaredLogger      `validate:"required"`
+	}
+)
+
+func NewGetCreditLineInteractor(
+	deps GetCreditLineInteractorDependencies,
+) *GetCreditLineInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetCreditLineInteractor{
+		repository: deps.GetCreditLineRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetCreditLineUsecase.
+func (g *GetCreditLineInteractor) Execute(ctx context.Context, creditLineID uint64) (usecases.CreditLineOutput, error) {
+	line, err := g.repository.GetCreditLine(ctx, creditLineID)
+	if err != nil {
+		g.logger.Errorw("failed to get credit line", "error", err, "credit_line_id", creditLineID)
+		return usecases.CreditLineOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	used, err := g.repository.GetCreditLineUsed(ctx, line.ID)
+	if err != nil {
+		g.logger.Errorw("failed to get credit line usage", "error", err, "credit_line_id", line.ID)
+		return usecases.CreditLineOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	isOverdue, err := g.repository.IsCreditLineOverdue(ctx, line.ID)
+	if err != nil {
+		g.logger.Errorw("failed to check overdue bills", "error", err, "credit_line_id", line.ID)
+		return usecases.CreditLineOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	drawdowns, err := g.repository.GetCreditLineDrawdowns(ctx, line.ID)
+	if err != nil {
+		g.logger.Errorw("failed to get drawdowns", "error", err, "credit_line_id", line.ID)
+		return usecases.CreditLineOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toCreditLineOutput(line, used, isOverdue, drawdowns), nil
+}
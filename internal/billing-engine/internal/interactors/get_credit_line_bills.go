@@ -0,0 +1,65 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetCreditLineBillsUsecase = (*GetCreditLineBillsInteractor)(nil)
+
+type (
+	GetCreditLineBillsRepository interface {
+		GetCreditLine(ctx context.Context, creditLineID uint64) (entity.CreditLine, error)
+		GetCreditLineBills(ctx context.Context, creditLineID uint64) ([]entity.CreditLineBill, error)
+	}
+
+	GetCreditLineBillsInteractorDependencies struct {
+		GetCreditLineBillsRepository GetCreditLineBillsRepository
+		Logger                       *zap.SugaredLogger
+	}
+
+	GetCreditLineBillsInteractor struct {
+		repository GetCreditLineBillsRepository `validate:"required"`
+		logger     *zap.SugaredLogger           `validate:"required"`
+	}
+)
+
+func NewGetCreditLineBillsInteractor(
+	deps GetCreditLineBillsInteractorDependencies,
+) *GetCreditLineBillsInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetCreditLineBillsInteractor{
+		repository: deps.GetCreditLineBillsRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetCreditLineBillsUsecase.
+func (g *GetCreditLineBillsInteractor) Execute(ctx context.Context, creditLineID uint64) ([]usecases.CreditLineBillOutput, error) {
+	if _, err := g.repository.GetCreditLine(ctx, creditLineID); err != nil {
+		g.logger.Errorw("failed to get credit line", "error", err, "credit_line_id", creditLineID)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	bills, err := g.repository.GetCreditLineBills(ctx, creditLineID)
+	if err != nil {
+		g.logger.Errorw("failed to get credit line bills", "error", err, "credit_line_id", creditLineID)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := make([]usecases.CreditLineBillOutput, len(bills))
+	for i, bill := range bills {
+		output[i] = toCreditLineBillOutput(bill)
+	}
+
+	return output, nil
+}
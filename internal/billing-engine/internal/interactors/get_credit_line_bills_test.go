@@ -0,0 +1,93 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetCreditLineBillsInteractor_Execute(t *testing.T) {
+	bill := entity.CreditLineBill{
+		ID: 800, CreditLineID: 700, Cycle: "2024-03", DueDate: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		Amount: decimal.NewFromInt(170000), Status: entity.CREDIT_LINE_BILL_PAID,
+		CreatedAt: time.Date(2024, 3, 31, 1, 0, 0, 0, time.UTC), PaidAt: time.Date(2024, 3, 30, 10, 0, 0, 0, time.UTC),
+		Items: []entity.CreditLineBillItem{
+			{ID: 1, BillID: 800, InstallmentID: 1, LoanID: 100, WeekNumber: 3, Amount: decimal.NewFromInt(115000), Status: entity.INSTALLMENT_PAID},
+			{ID: 2, BillID: 800, InstallmentID: 2, LoanID: 101, WeekNumber: 1, Amount: decimal.NewFromInt(55000), Status: entity.INSTALLMENT_PAID},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*billingenginemocks.MockGetCreditLineBillsRepository)
+		expectedOutput []usecases.CreditLineBillOutput
+		expectedError  error
+	}{
+		{
+			name: "success - bills of the line",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCreditLineBillsRepository) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(entity.CreditLine{ID: 700}, nil)
+				mockRepo.On("GetCreditLineBills", mock.Anything, uint64(700)).Return([]entity.CreditLineBill{bill}, nil)
+			},
+			expectedOutput: []usecases.CreditLineBillOutput{
+				{
+					ID: 800, CreditLineID: 700, Cycle: "2024-03", DueDate: "2024-03-31", Amount: "170000.00", Outstanding: "0.00",
+					Status: "PAID", CreatedAt: "2024-03-31T01:00:00Z", PaidAt: "2024-03-30T10:00:00Z",
+					Items: []usecases.CreditLineBillItemOutput{
+						{InstallmentID: 1, LoanID: 100, WeekNumber: 3, Amount: "115000.00", Status: "PAID"},
+						{InstallmentID: 2, LoanID: 101, WeekNumber: 1, Amount: "55000.00", Status: "PAID"},
+					},
+				},
+			},
+		},
+		{
+			name: "success - no bills yet",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCreditLineBillsRepository) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(entity.CreditLine{ID: 700}, nil)
+				mockRepo.On("GetCreditLineBills", mock.Anything, uint64(700)).Return([]entity.CreditLineBill{}, nil)
+			},
+			expectedOutput: []usecases.CreditLineBillOutput{},
+		},
+		{
+			name: "error - credit line not found",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCreditLineBillsRepository) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).
+					Return(entity.CreditLine{}, errors.New("credit line 700 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetCreditLineBillsRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetCreditLineBillsInteractor(GetCreditLineBillsInteractorDependencies{
+				GetCreditLineBillsRepository: mockRepo,
+				Logger:                       zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), 700)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetCreditLineInteractor_Execute(t *testing.T) {
+	line := entity.CreditLine{
+		ID: 700, CustomerID: 10, Limit: decimal.NewFromInt(2000000), ProductCode: "PAYLATER",
+		OpenedBy: "credit-officer", OpenedAt: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*billingenginemocks.MockGetCreditLineRepository)
+		expectedCheck func(*testing.T, usecases.CreditLineOutput)
+		expectedError error
+	}{
+		{
+			name: "success - usage and drawdowns of the line",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCreditLineRepository) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("GetCreditLineUsed", mock.Anything, uint64(700)).Return(decimal.NewFromInt(550000), nil)
+				mockRepo.On("IsCreditLineOverdue", mock.Anything, uint64(700)).Return(true, nil)
+				mockRepo.On("GetCreditLineDrawdowns", mock.Anything, uint64(700)).Return([]entity.Loan{
+					{ID: 100, CustomerID: 10, CreditLineID: 700, PrincipalAmount: decimal.NewFromInt(500000), Status: entity.LOAN_DISBURSED},
+				}, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CreditLineOutput) {
+				assert.Equal(t, "2000000.00", output.CreditLimit)
+				assert.Equal(t, "550000.00", output.Used)
+				assert.Equal(t, "1450000.00", output.Available)
+				assert.True(t, output.Overdue)
+				assert.Equal(t, "2024-03-01T09:00:00Z", output.OpenedAt)
+				assert.Len(t, output.Drawdowns, 1)
+				assert.Equal(t, uint64(700), output.Drawdowns[0].CreditLineID)
+			},
+		},
+		{
+			name: "success - available never below zero",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCreditLineRepository) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).Return(line, nil)
+				mockRepo.On("GetCreditLineUsed", mock.Anything, uint64(700)).Return(decimal.NewFromInt(2100000), nil)
+				mockRepo.On("IsCreditLineOverdue", mock.Anything, uint64(700)).Return(false, nil)
+				mockRepo.On("GetCreditLineDrawdowns", mock.Anything, uint64(700)).Return([]entity.Loan{}, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CreditLineOutput) {
+				assert.Equal(t, "0.00", output.Available)
+				assert.Equal(t, []usecases.LoanOutput{}, output.Drawdowns)
+			},
+		},
+		{
+			name: "error - credit line not found",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCreditLineRepository) {
+				mockRepo.On("GetCreditLine", mock.Anything, uint64(700)).
+					Return(entity.CreditLine{}, errors.New("credit line 700 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetCreditLineRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetCreditLineInteractor(GetCreditLineInteractorDependencies{
+				GetCreditLineRepository: mockRepo,
+				Logger:                  zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), 700)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetCustomerUsecase = (*GetCustomerInteractor)(nil)
+
+type (
+	GetCustomerRepository interface {
+		GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error)
+	}
+
+	GetCustomerInteractorDependencies struct {
+		GetCustomerRepository GetCustomerRepository
+		Logger                *zap.SugaredLogger
+	}
+
+	GetCustomerInteractor struct {
+		repository GetCustomerRepository `validate:"required"`
+		logger     *zap.SugaredLogger    `validate:"required"`
+	}
+)
+
+func NewGetCustomerInteractor(
+	deps GetCustomerInteractorDependencies,
+) *GetCustomerInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetCustomerInteractor{
+		repository: deps.GetCustomerRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetCustomerUsecase.
+func (g *GetCustomerInteractor) Execute(ctx context.Context, customerID uint64) (usecases.CustomerProfileOutput, error) {
+	customer, err := g.repository.GetCustomer(ctx, customerID)
+	if err != nil {
+		g.logger.Errorw("failed to get customer", "error", err, "customer_id", customerID)
+		return usecases.CustomerProfileOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toCustomerProfileOutput(customer), nil
+}
+
+func toCustomerProfileOutput(customer entity.Customer) usecases.CustomerProfileOutput {
+	output := usecases.CustomerProfileOutput{
+		ID:        customer.ID,
+		Name:      customer.Name,
+		Email:     customer.Email,
+		Phone:     customer.Phone,
+		Language:  string(customer.Language),
+		NIK:       customer.NIK,
+		Address:   customer.Address,
+		KYCStatus: string(customer.KYCStatus),
+	}
+	if !customer.DateOfBirth.IsZero() {
+		output.DateOfBirth = customer.DateOfBirth.Format(dateLayout)
+	}
+
+	return output
+}
@@ -0,0 +1,97 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetCustomerDuplicatesUsecase = (*GetCustomerDuplicatesInteractor)(nil)
+
+type (
+	GetCustomerDuplicatesRepository interface {
+		GetCustomerDuplicates(ctx context.Context, status entity.DuplicateStatus, customerID uint64) ([]entity.CustomerDuplicate, error)
+	}
+
+	GetCustomerDuplicatesInteractorDependencies struct {
+		GetCustomerDuplicatesRepository GetCustomerDuplicatesRepository
+		Logger                          *zap.SugaredLogger
+		Validator                       *validator.Validate
+	}
+
+	GetCustomerDuplicatesInteractor struct {
+		repository GetCustomerDuplicatesRepository `validate:"required"`
+		logger     *zap.SugaredLogger              `validate:"required"`
+		validator  *validator.Validate             `validate:"required"`
+	}
+)
+
+func NewGetCustomerDuplicatesInteractor(
+	deps GetCustomerDuplicatesInteractorDependencies,
+) *GetCustomerDuplicatesInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetCustomerDuplicatesInteractor{
+		repository: deps.GetCustomerDuplicatesRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.GetCustomerDuplicatesUsecase.
+func (g *GetCustomerDuplicatesInteractor) Execute(ctx context.Context, input usecases.GetCustomerDuplicatesInput) (usecases.GetCustomerDuplicatesOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GetCustomerDuplicatesOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	status := entity.DuplicateStatus(input.Status)
+	if status == "" {
+		status = entity.DUPLICATE_OPEN
+	}
+
+	duplicates, err := g.repository.GetCustomerDuplicates(ctx, status, input.CustomerID)
+	if err != nil {
+		g.logger.Errorw("failed to get customer duplicates", "error", err, "customer_id", input.CustomerID)
+		return usecases.GetCustomerDuplicatesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := toCustomerDuplicateOutputs(duplicates)
+	if output == nil {
+		output = []usecases.CustomerDuplicateOutput{}
+	}
+
+	return usecases.GetCustomerDuplicatesOutput{Duplicates: output}, nil
+}
+
+func toCustomerDuplicateOutputs(duplicates []entity.CustomerDuplicate) []usecases.CustomerDuplicateOutput {
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	output := make([]usecases.CustomerDuplicateOutput, len(duplicates))
+	for i, duplicate := range duplicates {
+		output[i] = usecases.CustomerDuplicateOutput{
+			ID:                duplicate.ID,
+			CustomerID:        duplicate.CustomerID,
+			MatchedCustomerID: duplicate.MatchedCustomerID,
+			Match:             string(duplicate.Match),
+			Status:            string(duplicate.Status),
+			CreatedAt:         duplicate.CreatedAt.Format(time.RFC3339),
+			ResolvedBy:        duplicate.ResolvedBy,
+		}
+		if !duplicate.ResolvedAt.IsZero() {
+			output[i].ResolvedAt = duplicate.ResolvedAt.Format(time.RFC3339)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,108 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetCustomerDuplicatesInteractor_Execute(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	resolvedAt := time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		input          usecases.GetCustomerDuplicatesInput
+		setupMocks     func(*billingenginemocks.MockGetCustomerDuplicatesRepository)
+		expectedOutput usecases.GetCustomerDuplicatesOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - open flags by default",
+			input: usecases.GetCustomerDuplicatesInput{},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerDuplicatesRepository) {
+				mockRepo.On("GetCustomerDuplicates", mock.Anything, entity.DUPLICATE_OPEN, uint64(0)).Return([]entity.CustomerDuplicate{
+					{ID: 5, CustomerID: 2, MatchedCustomerID: 1, Match: entity.DUPLICATE_NIK, Status: entity.DUPLICATE_OPEN, CreatedAt: createdAt},
+				}, nil)
+			},
+			expectedOutput: usecases.GetCustomerDuplicatesOutput{
+				Duplicates: []usecases.CustomerDuplicateOutput{
+					{ID: 5, CustomerID: 2, MatchedCustomerID: 1, Match: "NIK", Status: "OPEN", CreatedAt: "2024-03-01T09:00:00Z"},
+				},
+			},
+		},
+		{
+			name:  "success - dismissed flags of a customer",
+			input: usecases.GetCustomerDuplicatesInput{Status: "DISMISSED", CustomerID: 1},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerDuplicatesRepository) {
+				mockRepo.On("GetCustomerDuplicates", mock.Anything, entity.DUPLICATE_DISMISSED, uint64(1)).Return([]entity.CustomerDuplicate{
+					{ID: 5, CustomerID: 2, MatchedCustomerID: 1, Match: entity.DUPLICATE_PHONE, Status: entity.DUPLICATE_DISMISSED,
+						CreatedAt: createdAt, ResolvedBy: "ops", ResolvedAt: resolvedAt},
+				}, nil)
+			},
+			expectedOutput: usecases.GetCustomerDuplicatesOutput{
+				Duplicates: []usecases.CustomerDuplicateOutput{
+					{ID: 5, CustomerID: 2, MatchedCustomerID: 1, Match: "PHONE", Status: "DISMISSED", CreatedAt: "2024-03-01T09:00:00Z",
+						ResolvedBy: "ops", ResolvedAt: "2024-03-02T09:00:00Z"},
+				},
+			},
+		},
+		{
+			name:  "success - no flags",
+			input: usecases.GetCustomerDuplicatesInput{},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerDuplicatesRepository) {
+				mockRepo.On("GetCustomerDuplicates", mock.Anything, entity.DUPLICATE_OPEN, uint64(0)).Return(nil, nil)
+			},
+			expectedOutput: usecases.GetCustomerDuplicatesOutput{Duplicates: []usecases.CustomerDuplicateOutput{}},
+		},
+		{
+			name:          "validation error - unknown status",
+			input:         usecases.GetCustomerDuplicatesInput{Status: "CLOSED"},
+			setupMocks:    func(*billingenginemocks.MockGetCustomerDuplicatesRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "repository error",
+			input: usecases.GetCustomerDuplicatesInput{},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerDuplicatesRepository) {
+				mockRepo.On("GetCustomerDuplicates", mock.Anything, entity.DUPLICATE_OPEN, uint64(0)).Return(nil, errors.New("database error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetCustomerDuplicatesRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetCustomerDuplicatesInteractor(GetCustomerDuplicatesInteractorDependencies{
+				GetCustomerDuplicatesRepository: mockRepo,
+				Logger:                          zap.NewNop().Sugar(),
+				Validator:                       validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetCustomerMergesUsecase = (*GetCustomerMergesInteractor)(nil)
+
+type (
+	GetCustomerMergesRepository interface {
+		GetCustomerMerges(ctx context.Context, customerID uint64) ([]entity.CustomerMerge, error)
+	}
+
+	GetCustomerMergesInteractorDependencies struct {
+		GetCustomerMergesRepository GetCustomerMergesRepository
+		Logger                      *zap.SugaredLogger
+	}
+
+	GetCustomerMergesInteractor struct {
+		repository GetCustomerMergesRepository `validate:"required"`
+		logger     *zap.SugaredLogger          `validate:"required"`
+	}
+)
+
+func NewGetCustomerMergesInteractor(
+	deps GetCustomerMergesInteractorDependencies,
+) *GetCustomerMergesInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetCustomerMergesInteractor{
+		repository: deps.GetCustomerMergesRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetCustomerMergesUsecase.
+//
+// The merges are read for merged customers too, they are the audit of where
+// their loans went.
+func (g *GetCustomerMergesInteractor) Execute(ctx context.Context, customerID uint64) ([]usecases.CustomerMergeOutput, error) {
+	merges, err := g.repository.GetCustomerMerges(ctx, customerID)
+	if err != nil {
+		g.logger.Errorw("failed to get customer merges", "error", err, "customer_id", customerID)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := make([]usecases.CustomerMergeOutput, len(merges))
+	for i, merge := range merges {
+		output[i] = toCustomerMergeOutput(merge)
+	}
+
+	return output, nil
+}
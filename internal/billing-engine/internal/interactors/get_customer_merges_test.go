@@ -0,0 +1,87 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetCustomerMergesInteractor_Execute(t *testing.T) {
+	mergedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		customerID     uint64
+		setupMocks     func(*billingenginemocks.MockGetCustomerMergesRepository)
+		expectedOutput []usecases.CustomerMergeOutput
+		expectedError  error
+	}{
+		{
+			name:       "success - merges with the rows moved",
+			customerID: 2,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerMergesRepository) {
+				mockRepo.On("GetCustomerMerges", mock.Anything, uint64(2)).Return([]entity.CustomerMerge{
+					{
+						ID: 99, SourceCustomerID: 2, TargetCustomerID: 1, Reason: "signed up twice", MergedBy: "ops", MergedAt: mergedAt,
+						Records: []entity.CustomerMergeRecord{{ID: 100, MergeID: 99, TableName: "loans", RecordID: 10}},
+					},
+				}, nil)
+			},
+			expectedOutput: []usecases.CustomerMergeOutput{
+				{
+					ID: 99, SourceCustomerID: 2, TargetCustomerID: 1, Reason: "signed up twice", MergedBy: "ops", MergedAt: "2024-03-01T09:00:00Z",
+					Records: []usecases.CustomerMergeRecordOutput{{TableName: "loans", RecordID: 10}},
+				},
+			},
+		},
+		{
+			name:       "success - never merged",
+			customerID: 3,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerMergesRepository) {
+				mockRepo.On("GetCustomerMerges", mock.Anything, uint64(3)).Return(nil, nil)
+			},
+			expectedOutput: []usecases.CustomerMergeOutput{},
+		},
+		{
+			name:       "repository error",
+			customerID: 2,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerMergesRepository) {
+				mockRepo.On("GetCustomerMerges", mock.Anything, uint64(2)).Return(nil, errors.New("database error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetCustomerMergesRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetCustomerMergesInteractor(GetCustomerMergesInteractorDependencies{
+				GetCustomerMergesRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.customerID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
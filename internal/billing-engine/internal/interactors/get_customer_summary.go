@@ -0,0 +1,107 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetCustomerSummaryUsecase = (*GetCustomerSummaryInteractor)(nil)
+
+type (
+	// CustomerExposureRepository reads what a customer may borrow and what
+	// they already owe.
+	CustomerExposureRepository interface {
+		GetCreditLimit(ctx context.Context, customerID uint64) (entity.CreditLimit, error)
+		GetCustomerExposure(ctx context.Context, customerID uint64) (entity.CustomerExposure, error)
+	}
+
+	GetCustomerSummaryRepository interface {
+		GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error)
+		GetLoanObligations(ctx context.Context, customerID uint64) ([]entity.LoanObligation, error)
+		CustomerExposureRepository
+	}
+
+	GetCustomerSummaryInteractorDependencies struct {
+		GetCustomerSummaryRepository GetCustomerSummaryRepository
+		Logger                       *zap.SugaredLogger
+	}
+
+	GetCustomerSummaryInteractor struct {
+		repository GetCustomerSummaryRepository `validate:"required"`
+		logger     *zap.SugaredLogger           `validate:"required"`
+	}
+)
+
+func NewGetCustomerSummaryInteractor(
+	deps GetCustomerSummaryInteractorDependencies,
+) *GetCustomerSummaryInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetCustomerSummaryInteractor{
+		repository: deps.GetCustomerSummaryRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetCustomerSummaryUsecase.
+func (g *GetCustomerSummaryInteractor) Execute(ctx context.Context, customerID uint64) (usecases.CustomerSummaryOutput, error) {
+	customer, err := g.repository.GetCustomer(ctx, customerID)
+	if err != nil {
+		g.logger.Errorw("failed to get customer", "error", err, "customer_id", customerID)
+		return usecases.CustomerSummaryOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	limit, err := g.repository.GetCreditLimit(ctx, customerID)
+	if err != nil {
+		g.logger.Errorw("failed to get credit limit", "error", err, "customer_id", customerID)
+		return usecases.CustomerSummaryOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	exposure, err := g.repository.GetCustomerExposure(ctx, customerID)
+	if err != nil {
+		g.logger.Errorw("failed to get customer exposure", "error", err, "customer_id", customerID)
+		return usecases.CustomerSummaryOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	obligations, err := g.repository.GetLoanObligations(ctx, customerID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan obligations", "error", err, "customer_id", customerID)
+		return usecases.CustomerSummaryOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.CustomerSummaryOutput{
+		CustomerID:  customer.ID,
+		Name:        customer.Name,
+		Email:       customer.Email,
+		CreditLimit: toCreditLimitOutput(limit),
+		ActiveLoans: exposure.ActiveLoans,
+		Outstanding: exposure.Outstanding.StringFixed(2),
+		Committed:   exposure.Committed.StringFixed(2),
+		CoBorrowed:  exposure.CoBorrowed.StringFixed(2),
+		Guaranteed:  exposure.Guaranteed.StringFixed(2),
+		Utilised:    exposure.Total().StringFixed(2),
+		Available:   limit.Available(exposure).StringFixed(2),
+		Utilisation: limit.Utilisation(exposure).StringFixed(4),
+		Obligations: make([]usecases.LoanObligationOutput, len(obligations)),
+	}
+
+	for i, obligation := range obligations {
+		output.Obligations[i] = usecases.LoanObligationOutput{
+			LoanID:     obligation.LoanID,
+			BorrowerID: obligation.BorrowerID,
+			Role:       string(obligation.Role),
+			LoanStatus: string(obligation.LoanStatus),
+			Amount:     obligation.Amount.StringFixed(2),
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,163 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetCustomerSummaryInteractor_Execute(t *testing.T) {
+	customer := entity.Customer{ID: 1, Name: "Budi", Email: "budi@example.com"}
+
+	tests := []struct {
+		name           string
+		customerID     uint64
+		setupMocks     func(*billingenginemocks.MockGetCustomerSummaryRepository)
+		expectedOutput usecases.CustomerSummaryOutput
+		expectedError  error
+	}{
+		{
+			name:       "success - default limit partly used",
+			customerID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerSummaryRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(customer, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(1)).Return(entity.DefaultCreditLimit(1), nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(1)).Return(entity.CustomerExposure{
+					CustomerID: 1, ActiveLoans: 2, Outstanding: decimal.NewFromInt(1500000), Committed: decimal.NewFromInt(1000000),
+				}, nil)
+				mockRepo.On("GetLoanObligations", mock.Anything, uint64(1)).Return(nil, nil)
+			},
+			expectedOutput: usecases.CustomerSummaryOutput{
+				CustomerID:  1,
+				Name:        "Budi",
+				Email:       "budi@example.com",
+				CreditLimit: usecases.CreditLimitOutput{CustomerID: 1, CreditLimit: "10000000.00", MaxActiveLoans: 2},
+				ActiveLoans: 2,
+				Outstanding: "1500000.00",
+				Committed:   "1000000.00",
+				CoBorrowed:  "0.00",
+				Guaranteed:  "0.00",
+				Utilised:    "2500000.00",
+				Available:   "7500000.00",
+				Utilisation: "0.2500",
+				Obligations: []usecases.LoanObligationOutput{},
+			},
+		},
+		{
+			name:       "success - guarantor obligations count against the limit",
+			customerID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerSummaryRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(customer, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(1)).Return(entity.DefaultCreditLimit(1), nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(1)).Return(entity.CustomerExposure{
+					CustomerID: 1, ActiveLoans: 1, Outstanding: decimal.NewFromInt(1000000), Committed: decimal.Zero,
+					CoBorrowed: decimal.NewFromInt(500000), Guaranteed: decimal.NewFromInt(3500000),
+				}, nil)
+				mockRepo.On("GetLoanObligations", mock.Anything, uint64(1)).Return([]entity.LoanObligation{
+					{LoanID: 200, BorrowerID: 2, Role: entity.LOAN_PARTY_GUARANTOR, LoanStatus: entity.LOAN_DISBURSED, Amount: decimal.NewFromInt(2000000)},
+					{LoanID: 201, BorrowerID: 3, Role: entity.LOAN_PARTY_CO_BORROWER, LoanStatus: entity.LOAN_APPLIED, Amount: decimal.NewFromInt(500000)},
+					{LoanID: 202, BorrowerID: 2, Role: entity.LOAN_PARTY_GUARANTOR, LoanStatus: entity.LOAN_APPROVED, Amount: decimal.NewFromInt(1500000)},
+				}, nil)
+			},
+			expectedOutput: usecases.CustomerSummaryOutput{
+				CustomerID:  1,
+				Name:        "Budi",
+				Email:       "budi@example.com",
+				CreditLimit: usecases.CreditLimitOutput{CustomerID: 1, CreditLimit: "10000000.00", MaxActiveLoans: 2},
+				ActiveLoans: 1,
+				Outstanding: "1000000.00",
+				Committed:   "0.00",
+				CoBorrowed:  "500000.00",
+				Guaranteed:  "3500000.00",
+				Utilised:    "5000000.00",
+				Available:   "5000000.00",
+				Utilisation: "0.5000",
+				Obligations: []usecases.LoanObligationOutput{
+					{LoanID: 200, BorrowerID: 2, Role: "GUARANTOR", LoanStatus: "DISBURSED", Amount: "2000000.00"},
+					{LoanID: 201, BorrowerID: 3, Role: "CO_BORROWER", LoanStatus: "APPLIED", Amount: "500000.00"},
+					{LoanID: 202, BorrowerID: 2, Role: "GUARANTOR", LoanStatus: "APPROVED", Amount: "1500000.00"},
+				},
+			},
+		},
+		{
+			name:       "success - limit lowered below the exposure",
+			customerID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerSummaryRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(customer, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(1)).Return(entity.CreditLimit{
+					CustomerID: 1, Limit: decimal.NewFromInt(2000000), MaxActiveLoans: 1, UpdatedBy: "risk-officer",
+				}, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(1)).Return(entity.CustomerExposure{
+					CustomerID: 1, ActiveLoans: 1, Outstanding: decimal.NewFromInt(3000000), Committed: decimal.Zero,
+				}, nil)
+				mockRepo.On("GetLoanObligations", mock.Anything, uint64(1)).Return(nil, nil)
+			},
+			expectedOutput: usecases.CustomerSummaryOutput{
+				CustomerID:  1,
+				Name:        "Budi",
+				Email:       "budi@example.com",
+				CreditLimit: usecases.CreditLimitOutput{CustomerID: 1, CreditLimit: "2000000.00", MaxActiveLoans: 1, UpdatedBy: "risk-officer"},
+				ActiveLoans: 1,
+				Outstanding: "3000000.00",
+				Committed:   "0.00",
+				CoBorrowed:  "0.00",
+				Guaranteed:  "0.00",
+				Utilised:    "3000000.00",
+				Available:   "0.00",
+				Utilisation: "1.5000",
+				Obligations: []usecases.LoanObligationOutput{},
+			},
+		},
+		{
+			name:       "error - customer not found",
+			customerID: 404,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerSummaryRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(404)).Return(entity.Customer{}, errors.New("customer 404 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:       "error - repository error on GetCustomerExposure",
+			customerID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerSummaryRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(customer, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(1)).Return(entity.DefaultCreditLimit(1), nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(1)).Return(entity.CustomerExposure{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetCustomerSummaryRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetCustomerSummaryInteractor(GetCustomerSummaryInteractorDependencies{
+				GetCustomerSummaryRepository: mockRepo,
+				Logger:                       zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.customerID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetCustomerInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		customerID     uint64
+		setupMocks     func(*billingenginemocks.MockGetCustomerRepository)
+		expectedOutput usecases.CustomerProfileOutput
+		expectedError  error
+	}{
+		{
+			name:       "success - full profile",
+			customerID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(entity.Customer{
+					ID: 1, Name: "Budi", Email: "budi@example.com", Phone: "+6281234567890", Language: entity.LANGUAGE_INDONESIAN,
+					NIK: "3171011705900001", DateOfBirth: time.Date(1990, 5, 17, 0, 0, 0, 0, time.Local),
+					Address: "Jl. Merdeka No. 1, Jakarta", KYCStatus: entity.KYC_VERIFIED,
+				}, nil)
+			},
+			expectedOutput: usecases.CustomerProfileOutput{
+				ID: 1, Name: "Budi", Email: "budi@example.com", Phone: "+6281234567890", Language: "id",
+				NIK: "3171011705900001", DateOfBirth: "1990-05-17", Address: "Jl. Merdeka No. 1, Jakarta", KYCStatus: "VERIFIED",
+			},
+		},
+		{
+			name:       "success - profile without identity",
+			customerID: 2,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(2)).Return(entity.Customer{
+					ID: 2, Name: "Ani", Email: "ani@example.com", Language: entity.LANGUAGE_ENGLISH, KYCStatus: entity.KYC_UNVERIFIED,
+				}, nil)
+			},
+			expectedOutput: usecases.CustomerProfileOutput{
+				ID: 2, Name: "Ani", Email: "ani@example.com", Language: "en", KYCStatus: "UNVERIFIED",
+			},
+		},
+		{
+			name:       "error - customer not found or deleted",
+			customerID: 404,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetCustomerRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(404)).Return(entity.Customer{}, errors.New("customer 404 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetCustomerRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetCustomerInteractor(GetCustomerInteractorDependencies{
+				GetCustomerRepository: mockRepo,
+				Logger:                zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.customerID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetGLMappingsUsecase = (*GetGLMappingsInteractor)(nil)
+
+type (
+	GetGLMappingsRepository interface {
+		GetGLMappings(ctx context.Context) ([]entity.GLMapping, error)
+	}
+
+	GetGLMappingsInteractorDependencies struct {
+		GetGLMappingsRepository GetGLMappingsRepository
+		Logger                  *zap.SugaredLogger
+	}
+
+	GetGLMappingsInteractor struct {
+		repository GetGLMappingsRepository `validate:"required"`
+		logger     *zap.SugaredLogger      `validate:"required"`
+	}
+)
+
+func NewGetGLMappingsInteractor(
+	deps GetGLMappingsInteractorDependencies,
+) *GetGLMappingsInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetGLMappingsInteractor{
+		repository: deps.GetGLMappingsRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetGLMappingsUsecase.
+func (g *GetGLMappingsInteractor) Execute(ctx context.Context) (usecases.GetGLMappingsOutput, error) {
+	mappings, err := g.repository.GetGLMappings(ctx)
+	if err != nil {
+		g.logger.Errorw("failed to get GL mappings", "error", err)
+		return usecases.GetGLMappingsOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetGLMappingsOutput{
+		Mappings: make([]usecases.GLMappingOutput, len(mappings)),
+	}
+	for i, mapping := range mappings {
+		output.Mappings[i] = toGLMappingOutput(mapping)
+	}
+
+	return output, nil
+}
+
+func toGLMappingOutput(mapping entity.GLMapping) usecases.GLMappingOutput {
+	return usecases.GLMappingOutput{
+		Event:       string(mapping.Event),
+		AccountCode: mapping.AccountCode,
+		GLCode:      mapping.GLCode,
+		UpdatedAt:   mapping.UpdatedAt.Format(time.RFC3339),
+	}
+}
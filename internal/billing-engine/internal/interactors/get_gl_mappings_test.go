@@ -0,0 +1,72 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetGLMappingsInteractor_Execute(t *testing.T) {
+	updatedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*billingenginemocks.MockGetGLMappingsRepository)
+		expectedOutput usecases.GetGLMappingsOutput
+		expectedError  error
+	}{
+		{
+			name: "success - mappings listed",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetGLMappingsRepository) {
+				mockRepo.On("GetGLMappings", mock.Anything).Return([]entity.GLMapping{
+					{Event: entity.GL_MAPPING_DEFAULT, AccountCode: entity.ACCOUNT_CASH, GLCode: "1101", UpdatedAt: updatedAt},
+				}, nil)
+			},
+			expectedOutput: usecases.GetGLMappingsOutput{
+				Mappings: []usecases.GLMappingOutput{
+					{Event: "DEFAULT", AccountCode: "CASH", GLCode: "1101", UpdatedAt: "2024-03-01T09:00:00Z"},
+				},
+			},
+		},
+		{
+			name: "error - repository error on GetGLMappings",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetGLMappingsRepository) {
+				mockRepo.On("GetGLMappings", mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetGLMappingsRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetGLMappingsInteractor(GetGLMappingsInteractorDependencies{
+				GetGLMappingsRepository: mockRepo,
+				Logger:                  zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background())
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -15,6 +15,7 @@ var _ usecases.GetInstallmentsByLoanUsecase = (*GetInstallmentsByLoanInteractor)
 type (
 	GetInstallmentsRepository interface {
 		GetInstallments(ctx context.Context, loanID uint64) ([]entity.Installment, error)
+		GetLoanFees(ctx context.Context, loanID uint64) ([]entity.LoanFee, error)
 	}
 
 	GetInstallmentsByLoanInteractorDependencies struct {
@@ -50,6 +51,16 @@ func (g *GetInstallmentsByLoanInteractor) Execute(ctx context.Context, loanID ui
 		return nil, pkgerror.BusinessErrorFrom(err)
 	}
 
+	fees, err := g.repository.GetLoanFees(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan fees", "error", err, "loan_id", loanID)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	// The fee lines are derived from the loan's fees the way the schedule
+	// was built
+	feeSchedule := entity.FeeSchedule(fees, int64(len(installments)))
+
 	outputs := make([]usecases.GetInstallmentsOutput, len(installments))
 	for i, installment := range installments {
 		outputs[i] = usecases.GetInstallmentsOutput{
@@ -58,9 +69,30 @@ func (g *GetInstallmentsByLoanInteractor) Execute(ctx context.Context, loanID ui
 			WeekNumber: installment.WeekNumber,
 			DueDate:    installment.DueDate,
 			AmountDue:  installment.AmountDue,
+			FeeAmount:  installment.FeeAmount,
 			Status:     string(installment.Status),
 		}
+
+		if week := installment.WeekNumber; week >= 1 && week <= int64(len(feeSchedule)) {
+			outputs[i].Fees = toInstallmentFeeOutputs(feeSchedule[week-1])
+		}
 	}
 
 	return outputs, nil
 }
+
+func toInstallmentFeeOutputs(lines []entity.InstallmentFee) []usecases.InstallmentFeeOutput {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	outputs := make([]usecases.InstallmentFeeOutput, len(lines))
+	for i, line := range lines {
+		outputs[i] = usecases.InstallmentFeeOutput{
+			FeeType: string(line.FeeType),
+			Amount:  line.Amount.StringFixed(2),
+		}
+	}
+
+	return outputs
+}
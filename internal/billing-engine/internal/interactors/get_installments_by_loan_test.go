@@ -9,6 +9,7 @@ import (
 	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
@@ -27,14 +28,26 @@ func TestGetInstallmentsByLoanInteractor_Execute(t *testing.T) {
 			loanID: 1,
 			setupMocks: func(mockRepo *billingenginemocks.MockGetInstallmentsRepository) {
 				installments := []entity.Installment{
-					{ID: 1, LoanID: 1, WeekNumber: 1, DueDate: "2024-06-01", AmountDue: "100000", Status: entity.INSTALLMENT_PENDING},
-					{ID: 2, LoanID: 1, WeekNumber: 2, DueDate: "2024-06-08", AmountDue: "100000", Status: entity.INSTALLMENT_PAID},
+					{ID: 1, LoanID: 1, WeekNumber: 1, DueDate: "2024-06-01", AmountDue: "100000", FeeAmount: "1500.00", Status: entity.INSTALLMENT_PENDING},
+					{ID: 2, LoanID: 1, WeekNumber: 2, DueDate: "2024-06-08", AmountDue: "100000", FeeAmount: "1500.00", Status: entity.INSTALLMENT_PAID},
+				}
+				fees := []entity.LoanFee{
+					{ID: 10, LoanID: 1, FeeType: entity.FEE_ORIGINATION, Collection: entity.FEE_DEDUCTED, Amount: decimal.NewFromInt(50000)},
+					{ID: 11, LoanID: 1, FeeType: entity.FEE_ADMIN, Collection: entity.FEE_AMORTISED, Amount: decimal.NewFromInt(1000)},
+					{ID: 12, LoanID: 1, FeeType: entity.FEE_INSURANCE, Collection: entity.FEE_AMORTISED, Amount: decimal.NewFromInt(2000)},
 				}
 				mockRepo.On("GetInstallments", mock.Anything, uint64(1)).Return(installments, nil)
+				mockRepo.On("GetLoanFees", mock.Anything, uint64(1)).Return(fees, nil)
 			},
 			expectedOutput: []usecases.GetInstallmentsOutput{
-				{ID: 1, LoanID: 1, WeekNumber: 1, DueDate: "2024-06-01", AmountDue: "100000", Status: string(entity.INSTALLMENT_PENDING)},
-				{ID: 2, LoanID: 1, WeekNumber: 2, DueDate: "2024-06-08", AmountDue: "100000", Status: string(entity.INSTALLMENT_PAID)},
+				{
+					ID: 1, LoanID: 1, WeekNumber: 1, DueDate: "2024-06-01", AmountDue: "100000", FeeAmount: "1500.00", Status: string(entity.INSTALLMENT_PENDING),
+					Fees: []usecases.InstallmentFeeOutput{{FeeType: "ADMIN", Amount: "500.00"}, {FeeType: "INSURANCE", Amount: "1000.00"}},
+				},
+				{
+					ID: 2, LoanID: 1, WeekNumber: 2, DueDate: "2024-06-08", AmountDue: "100000", FeeAmount: "1500.00", Status: string(entity.INSTALLMENT_PAID),
+					Fees: []usecases.InstallmentFeeOutput{{FeeType: "ADMIN", Amount: "500.00"}, {FeeType: "INSURANCE", Amount: "1000.00"}},
+				},
 			},
 			expectedError: nil,
 		},
@@ -43,6 +56,7 @@ func TestGetInstallmentsByLoanInteractor_Execute(t *testing.T) {
 			loanID: 2,
 			setupMocks: func(mockRepo *billingenginemocks.MockGetInstallmentsRepository) {
 				mockRepo.On("GetInstallments", mock.Anything, uint64(2)).Return([]entity.Installment{}, nil)
+				mockRepo.On("GetLoanFees", mock.Anything, uint64(2)).Return([]entity.LoanFee(nil), nil)
 			},
 			expectedOutput: []usecases.GetInstallmentsOutput{},
 			expectedError:  nil,
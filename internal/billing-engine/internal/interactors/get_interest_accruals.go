@@ -0,0 +1,105 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetInterestAccrualsUsecase = (*GetInterestAccrualsInteractor)(nil)
+
+type (
+	GetInterestAccrualsRepository interface {
+		GetInterestAccruals(ctx context.Context, loanID uint64, from time.Time, to time.Time) ([]entity.InterestAccrual, error)
+	}
+
+	GetInterestAccrualsInteractorDependencies struct {
+		GetInterestAccrualsRepository GetInterestAccrualsRepository
+		Logger                        *zap.SugaredLogger
+		Validator                     *validator.Validate
+	}
+
+	GetInterestAccrualsInteractor struct {
+		repository GetInterestAccrualsRepository `validate:"required"`
+		logger     *zap.SugaredLogger            `validate:"required"`
+		validator  *validator.Validate           `validate:"required"`
+	}
+)
+
+func NewGetInterestAccrualsInteractor(
+	deps GetInterestAccrualsInteractorDependencies,
+) *GetInterestAccrualsInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetInterestAccrualsInteractor{
+		repository: deps.GetInterestAccrualsRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.GetInterestAccrualsUsecase.
+func (g *GetInterestAccrualsInteractor) Execute(ctx context.Context, input usecases.GetInterestAccrualsInput) (usecases.GetInterestAccrualsOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GetInterestAccrualsOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	from, err := parseAsOfDate(input.From)
+	if err != nil {
+		return usecases.GetInterestAccrualsOutput{}, err
+	}
+
+	to, err := parseAsOfDate(input.To)
+	if err != nil {
+		return usecases.GetInterestAccrualsOutput{}, err
+	}
+
+	if to.Before(from) {
+		return usecases.GetInterestAccrualsOutput{}, pkgerror.NewValidationError("to must not be before from")
+	}
+
+	accruals, err := g.repository.GetInterestAccruals(ctx, input.LoanID, from, to)
+	if err != nil {
+		g.logger.Errorw("failed to get interest accruals", "error", err, "loan_id", input.LoanID)
+		return usecases.GetInterestAccrualsOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetInterestAccrualsOutput{
+		From:     from.Format(dateLayout),
+		To:       to.Format(dateLayout),
+		Accruals: make([]usecases.InterestAccrualOutput, len(accruals)),
+	}
+
+	accrued, reversed := decimal.Zero, decimal.Zero
+	for i, accrual := range accruals {
+		if accrual.Type == entity.ACCRUAL_REVERSAL {
+			reversed = reversed.Add(accrual.Amount)
+		} else {
+			accrued = accrued.Add(accrual.Amount)
+		}
+
+		output.Accruals[i] = usecases.InterestAccrualOutput{
+			ID:             accrual.ID,
+			LoanID:         accrual.LoanID,
+			AccrualDate:    accrual.AccrualDate.Format(dateLayout),
+			Type:           string(accrual.Type),
+			Amount:         accrual.Amount.StringFixed(2),
+			JournalEntryID: accrual.JournalEntryID,
+		}
+	}
+
+	output.TotalAccrued = accrued.StringFixed(2)
+	output.TotalReversed = reversed.StringFixed(2)
+
+	return output, nil
+}
@@ -0,0 +1,99 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetInterestAccrualsInteractor_Execute(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name           string
+		input          usecases.GetInterestAccrualsInput
+		setupMocks     func(*billingenginemocks.MockGetInterestAccrualsRepository)
+		expectedOutput usecases.GetInterestAccrualsOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - accruals and reversals are totalled separately",
+			input: usecases.GetInterestAccrualsInput{LoanID: 1, From: "2024-03-01", To: "2024-03-31"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetInterestAccrualsRepository) {
+				mockRepo.On("GetInterestAccruals", mock.Anything, uint64(1), from, to).Return([]entity.InterestAccrual{
+					{ID: 1, LoanID: 1, AccrualDate: time.Date(2024, 3, 2, 0, 0, 0, 0, time.Local), Type: entity.ACCRUAL_DAILY, Amount: decimal.RequireFromString("1428.57"), JournalEntryID: 10},
+					{ID: 2, LoanID: 1, AccrualDate: time.Date(2024, 3, 3, 0, 0, 0, 0, time.Local), Type: entity.ACCRUAL_DAILY, Amount: decimal.RequireFromString("1428.57"), JournalEntryID: 11},
+					{ID: 3, LoanID: 1, AccrualDate: time.Date(2024, 3, 4, 0, 0, 0, 0, time.Local), Type: entity.ACCRUAL_REVERSAL, Amount: decimal.RequireFromString("2857.14"), JournalEntryID: 12},
+				}, nil)
+			},
+			expectedOutput: usecases.GetInterestAccrualsOutput{
+				From: "2024-03-01",
+				To:   "2024-03-31",
+				Accruals: []usecases.InterestAccrualOutput{
+					{ID: 1, LoanID: 1, AccrualDate: "2024-03-02", Type: "ACCRUAL", Amount: "1428.57", JournalEntryID: 10},
+					{ID: 2, LoanID: 1, AccrualDate: "2024-03-03", Type: "ACCRUAL", Amount: "1428.57", JournalEntryID: 11},
+					{ID: 3, LoanID: 1, AccrualDate: "2024-03-04", Type: "REVERSAL", Amount: "2857.14", JournalEntryID: 12},
+				},
+				TotalAccrued:  "2857.14",
+				TotalReversed: "2857.14",
+			},
+		},
+		{
+			name:          "error - validation error (missing from)",
+			input:         usecases.GetInterestAccrualsInput{To: "2024-03-31"},
+			setupMocks:    func(*billingenginemocks.MockGetInterestAccrualsRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - to before from",
+			input:         usecases.GetInterestAccrualsInput{From: "2024-03-31", To: "2024-03-01"},
+			setupMocks:    func(*billingenginemocks.MockGetInterestAccrualsRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on GetInterestAccruals",
+			input: usecases.GetInterestAccrualsInput{From: "2024-03-01", To: "2024-03-31"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetInterestAccrualsRepository) {
+				mockRepo.On("GetInterestAccruals", mock.Anything, uint64(0), from, to).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetInterestAccrualsRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetInterestAccrualsInteractor(GetInterestAccrualsInteractorDependencies{
+				GetInterestAccrualsRepository: mockRepo,
+				Logger:                        zap.NewNop().Sugar(),
+				Validator:                     validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
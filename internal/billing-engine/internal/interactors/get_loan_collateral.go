@@ -0,0 +1,88 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoanCollateralUsecase = (*GetLoanCollateralInteractor)(nil)
+
+type (
+	GetLoanCollateralRepository interface {
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		GetLoanCollaterals(ctx context.Context, loanID uint64) ([]entity.Collateral, error)
+		GetOutstanding(ctx context.Context, loanID uint64) (decimal.Decimal, error)
+	}
+
+	GetLoanCollateralInteractorDependencies struct {
+		GetLoanCollateralRepository GetLoanCollateralRepository
+		Logger                      *zap.SugaredLogger
+	}
+
+	GetLoanCollateralInteractor struct {
+		repository GetLoanCollateralRepository `validate:"required"`
+		logger     *zap.SugaredLogger          `validate:"required"`
+	}
+)
+
+func NewGetLoanCollateralInteractor(
+	deps GetLoanCollateralInteractorDependencies,
+) *GetLoanCollateralInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoanCollateralInteractor{
+		repository: deps.GetLoanCollateralRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetLoanCollateralUsecase.
+func (g *GetLoanCollateralInteractor) Execute(ctx context.Context, loanID uint64) (usecases.LoanCollateralOutput, error) {
+	loan, err := g.repository.GetLoan(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan", "error", err, "loan_id", loanID)
+		return usecases.LoanCollateralOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	collaterals, err := g.repository.GetLoanCollaterals(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan collaterals", "error", err, "loan_id", loanID)
+		return usecases.LoanCollateralOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	outstanding := decimal.Zero
+	if loan.Status == entity.LOAN_DISBURSED {
+		outstanding, err = g.repository.GetOutstanding(ctx, loanID)
+		if err != nil {
+			g.logger.Errorw("failed to get outstanding", "error", err, "loan_id", loanID)
+			return usecases.LoanCollateralOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+	}
+
+	exposure := loan.Exposure(outstanding)
+	valuation := entity.HeldValuation(collaterals)
+
+	output := usecases.LoanCollateralOutput{
+		LoanID:      loan.ID,
+		LoanStatus:  string(loan.Status),
+		Exposure:    exposure.StringFixed(2),
+		Valuation:   valuation.StringFixed(2),
+		LoanToValue: entity.LoanToValue(exposure, valuation).StringFixed(4),
+		Collaterals: make([]usecases.CollateralOutput, len(collaterals)),
+	}
+
+	for i, collateral := range collaterals {
+		output.Collaterals[i] = toCollateralOutput(collateral)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,131 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoanCollateralInteractor_Execute(t *testing.T) {
+	valuedOn := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	registeredAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	collaterals := []entity.Collateral{
+		{
+			ID: 500, LoanID: 100, Type: entity.COLLATERAL_VEHICLE_BPKB, Description: "B 1234 XYZ", Valuation: decimal.NewFromInt(6000000),
+			ValuationDate: valuedOn, LienStatus: entity.LIEN_REGISTERED, RegisteredBy: "credit-officer", RegisteredAt: registeredAt,
+		},
+		{
+			ID: 501, LoanID: 100, Type: entity.COLLATERAL_GOLD, Description: "Antam 5g", Valuation: decimal.NewFromInt(4000000),
+			ValuationDate: valuedOn, LienStatus: entity.LIEN_RELEASED, RegisteredBy: "credit-officer", RegisteredAt: registeredAt,
+			LienUpdatedBy: "legal", LienUpdatedAt: registeredAt.Add(time.Hour),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		loanID         uint64
+		setupMocks     func(*billingenginemocks.MockGetLoanCollateralRepository)
+		expectedOutput usecases.LoanCollateralOutput
+		expectedError  error
+	}{
+		{
+			name:   "success - disbursed loan against the collateral still held",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanCollateralRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{
+					ID: 100, PrincipalAmount: decimal.NewFromInt(5000000), Status: entity.LOAN_DISBURSED,
+				}, nil)
+				mockRepo.On("GetLoanCollaterals", mock.Anything, uint64(100)).Return(collaterals, nil)
+				mockRepo.On("GetOutstanding", mock.Anything, uint64(100)).Return(decimal.NewFromInt(4500000), nil)
+			},
+			expectedOutput: usecases.LoanCollateralOutput{
+				LoanID: 100, LoanStatus: "DISBURSED", Exposure: "4500000.00", Valuation: "6000000.00", LoanToValue: "0.7500",
+				Collaterals: []usecases.CollateralOutput{
+					{
+						ID: 500, LoanID: 100, Type: "VEHICLE_BPKB", Description: "B 1234 XYZ", Valuation: "6000000.00",
+						ValuationDate: "2024-03-01", LienStatus: "REGISTERED", RegisteredBy: "credit-officer", RegisteredAt: "2024-03-01T10:00:00Z",
+					},
+					{
+						ID: 501, LoanID: 100, Type: "GOLD", Description: "Antam 5g", Valuation: "4000000.00",
+						ValuationDate: "2024-03-01", LienStatus: "RELEASED", RegisteredBy: "credit-officer", RegisteredAt: "2024-03-01T10:00:00Z",
+						LienUpdatedBy: "legal", LienUpdatedAt: "2024-03-01T11:00:00Z",
+					},
+				},
+			},
+		},
+		{
+			name:   "success - application counts with its principal",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanCollateralRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{
+					ID: 100, PrincipalAmount: decimal.NewFromInt(5000000), Status: entity.LOAN_APPLIED,
+				}, nil)
+				mockRepo.On("GetLoanCollaterals", mock.Anything, uint64(100)).Return(collaterals[:1], nil)
+			},
+			expectedOutput: usecases.LoanCollateralOutput{
+				LoanID: 100, LoanStatus: "APPLIED", Exposure: "5000000.00", Valuation: "6000000.00", LoanToValue: "0.8333",
+				Collaterals: []usecases.CollateralOutput{
+					{
+						ID: 500, LoanID: 100, Type: "VEHICLE_BPKB", Description: "B 1234 XYZ", Valuation: "6000000.00",
+						ValuationDate: "2024-03-01", LienStatus: "REGISTERED", RegisteredBy: "credit-officer", RegisteredAt: "2024-03-01T10:00:00Z",
+					},
+				},
+			},
+		},
+		{
+			name:   "success - unsecured loan",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanCollateralRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{
+					ID: 100, PrincipalAmount: decimal.NewFromInt(5000000), Status: entity.LOAN_PAID,
+				}, nil)
+				mockRepo.On("GetLoanCollaterals", mock.Anything, uint64(100)).Return(nil, nil)
+			},
+			expectedOutput: usecases.LoanCollateralOutput{
+				LoanID: 100, LoanStatus: "PAID", Exposure: "0.00", Valuation: "0.00", LoanToValue: "0.0000",
+				Collaterals: []usecases.CollateralOutput{},
+			},
+		},
+		{
+			name:   "error - loan not found",
+			loanID: 404,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanCollateralRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(404)).Return(entity.Loan{}, errors.New("loan 404 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoanCollateralRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoanCollateralInteractor(GetLoanCollateralInteractorDependencies{
+				GetLoanCollateralRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.loanID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoanCommunicationsUsecase = (*GetLoanCommunicationsInteractor)(nil)
+
+type (
+	GetLoanCommunicationsRepository interface {
+		GetCommunicationsByLoan(ctx context.Context, loanID uint64) ([]entity.Communication, error)
+	}
+
+	GetLoanCommunicationsInteractorDependencies struct {
+		GetLoanCommunicationsRepository GetLoanCommunicationsRepository
+		Logger                          *zap.SugaredLogger
+	}
+
+	GetLoanCommunicationsInteractor struct {
+		repository GetLoanCommunicationsRepository `validate:"required"`
+		logger     *zap.SugaredLogger              `validate:"required"`
+	}
+)
+
+func NewGetLoanCommunicationsInteractor(
+	deps GetLoanCommunicationsInteractorDependencies,
+) *GetLoanCommunicationsInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoanCommunicationsInteractor{
+		repository: deps.GetLoanCommunicationsRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetLoanCommunicationsUsecase.
+func (g *GetLoanCommunicationsInteractor) Execute(ctx context.Context, loanID uint64) (usecases.GetLoanCommunicationsOutput, error) {
+	communications, err := g.repository.GetCommunicationsByLoan(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get communications", "error", err, "loan_id", loanID)
+		return usecases.GetLoanCommunicationsOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetLoanCommunicationsOutput{
+		LoanID:         loanID,
+		Communications: make([]usecases.CommunicationOutput, len(communications)),
+	}
+
+	for i, communication := range communications {
+		output.Communications[i] = toCommunicationOutput(communication)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,86 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoanCommunicationsInteractor_Execute(t *testing.T) {
+	sentAt := time.Date(2024, 3, 8, 9, 0, 0, 0, time.Local)
+	communication := entity.Communication{
+		ID: 1, LoanID: 100, CustomerID: 1, InstallmentID: 7,
+		Event: entity.NOTIFICATION_EVENT_REMINDER_T_MINUS_2, Channel: entity.NOTIFICATION_CHANNEL_EMAIL,
+		Language: entity.LANGUAGE_INDONESIAN, Recipient: "budi@example.com", Subject: "subject", Body: "body",
+		Status: entity.COMMUNICATION_SENT, ProviderMessageID: "email-1", SentAt: sentAt,
+	}
+
+	tests := []struct {
+		name           string
+		loanID         uint64
+		setupMocks     func(*billingenginemocks.MockGetLoanCommunicationsRepository)
+		expectedOutput usecases.GetLoanCommunicationsOutput
+		expectedError  error
+	}{
+		{
+			name:   "success - communications log is returned",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanCommunicationsRepository) {
+				mockRepo.On("GetCommunicationsByLoan", mock.Anything, uint64(100)).
+					Return([]entity.Communication{communication}, nil)
+			},
+			expectedOutput: usecases.GetLoanCommunicationsOutput{
+				LoanID: 100,
+				Communications: []usecases.CommunicationOutput{
+					{
+						ID: 1, LoanID: 100, CustomerID: 1, InstallmentID: 7,
+						Event: "REMINDER_T_MINUS_2", Channel: "EMAIL", Language: "id",
+						Recipient: "budi@example.com", Subject: "subject", Body: "body",
+						Status: "SENT", ProviderMessageID: "email-1", SentAt: sentAt,
+					},
+				},
+			},
+		},
+		{
+			name:   "error - repository error",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanCommunicationsRepository) {
+				mockRepo.On("GetCommunicationsByLoan", mock.Anything, uint64(100)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoanCommunicationsRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoanCommunicationsInteractor(GetLoanCommunicationsInteractorDependencies{
+				GetLoanCommunicationsRepository: mockRepo,
+				Logger:                          zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.loanID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
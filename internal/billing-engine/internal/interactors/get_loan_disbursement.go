@@ -0,0 +1,79 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoanDisbursementUsecase = (*GetLoanDisbursementInteractor)(nil)
+
+type (
+	GetLoanDisbursementRepository interface {
+		GetDisbursementByLoan(ctx context.Context, loanID uint64) (entity.Disbursement, error)
+	}
+
+	GetLoanDisbursementInteractorDependencies struct {
+		GetLoanDisbursementRepository GetLoanDisbursementRepository
+		Logger                        *zap.SugaredLogger
+	}
+
+	GetLoanDisbursementInteractor struct {
+		repository GetLoanDisbursementRepository `validate:"required"`
+		logger     *zap.SugaredLogger            `validate:"required"`
+	}
+)
+
+func NewGetLoanDisbursementInteractor(
+	deps GetLoanDisbursementInteractorDependencies,
+) *GetLoanDisbursementInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoanDisbursementInteractor{
+		repository: deps.GetLoanDisbursementRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetLoanDisbursementUsecase.
+func (g *GetLoanDisbursementInteractor) Execute(ctx context.Context, loanID uint64) (usecases.DisbursementOutput, error) {
+	disbursement, err := g.repository.GetDisbursementByLoan(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get disbursement", "error", err, "loan_id", loanID)
+		return usecases.DisbursementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toDisbursementOutput(disbursement), nil
+}
+
+func toDisbursementOutput(disbursement entity.Disbursement) usecases.DisbursementOutput {
+	output := usecases.DisbursementOutput{
+		ID:                disbursement.ID,
+		LoanID:            disbursement.LoanID,
+		CustomerID:        disbursement.CustomerID,
+		BankCode:          disbursement.BankAccount.BankCode,
+		AccountNumber:     disbursement.BankAccount.AccountNumber,
+		AccountName:       disbursement.BankAccount.AccountName,
+		Amount:            disbursement.Amount.StringFixed(2),
+		FeeAmount:         disbursement.FeeAmount.StringFixed(2),
+		Status:            string(disbursement.Status),
+		ProviderReference: disbursement.ProviderReference,
+		FailureReason:     disbursement.FailureReason,
+		Attempts:          disbursement.Attempts,
+		RequestedBy:       disbursement.RequestedBy,
+		CreatedAt:         disbursement.CreatedAt.Format(time.RFC3339),
+	}
+	if !disbursement.CompletedAt.IsZero() {
+		output.CompletedAt = disbursement.CompletedAt.Format(time.RFC3339)
+	}
+
+	return output
+}
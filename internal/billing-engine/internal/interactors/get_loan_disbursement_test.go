@@ -0,0 +1,79 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoanDisbursementInteractor_Execute(t *testing.T) {
+	createdAt := time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		loanID         uint64
+		setupMocks     func(*billingenginemocks.MockGetLoanDisbursementRepository)
+		expectedOutput usecases.DisbursementOutput
+		expectedError  error
+	}{
+		{
+			name:   "success - sent disbursement has no completion date",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanDisbursementRepository) {
+				mockRepo.On("GetDisbursementByLoan", mock.Anything, uint64(100)).Return(entity.Disbursement{
+					ID: 300, LoanID: 100, CustomerID: 1,
+					BankAccount: entity.BankAccount{BankCode: "BCA", AccountNumber: "1234567890", AccountName: "Budi"},
+					Amount:      decimal.NewFromInt(5000000), FeeAmount: decimal.Zero, Status: entity.DISBURSEMENT_SENT,
+					ProviderReference: "ref-1", Attempts: 1, RequestedBy: "finance", CreatedAt: createdAt,
+				}, nil)
+			},
+			expectedOutput: usecases.DisbursementOutput{
+				ID: 300, LoanID: 100, CustomerID: 1, BankCode: "BCA", AccountNumber: "1234567890", AccountName: "Budi",
+				Amount: "5000000.00", FeeAmount: "0.00", Status: "SENT", ProviderReference: "ref-1", Attempts: 1,
+				RequestedBy: "finance", CreatedAt: "2025-07-01T09:00:00Z",
+			},
+		},
+		{
+			name:   "error - loan has no disbursement",
+			loanID: 101,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanDisbursementRepository) {
+				mockRepo.On("GetDisbursementByLoan", mock.Anything, uint64(101)).Return(entity.Disbursement{}, errors.New("loan 101 has no disbursement"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoanDisbursementRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoanDisbursementInteractor(GetLoanDisbursementInteractorDependencies{
+				GetLoanDisbursementRepository: mockRepo,
+				Logger:                        zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.loanID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoanDisclosureUsecase = (*GetLoanDisclosureInteractor)(nil)
+
+type (
+	GetLoanDisclosureRepository interface {
+		GetLoanDisclosure(ctx context.Context, loanID uint64) (entity.LoanDisclosure, error)
+	}
+
+	GetLoanDisclosureInteractorDependencies struct {
+		GetLoanDisclosureRepository GetLoanDisclosureRepository
+		Logger                      *zap.SugaredLogger
+	}
+
+	GetLoanDisclosureInteractor struct {
+		repository GetLoanDisclosureRepository `validate:"required"`
+		logger     *zap.SugaredLogger          `validate:"required"`
+	}
+)
+
+func NewGetLoanDisclosureInteractor(
+	deps GetLoanDisclosureInteractorDependencies,
+) *GetLoanDisclosureInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoanDisclosureInteractor{
+		repository: deps.GetLoanDisclosureRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetLoanDisclosureUsecase.
+func (g *GetLoanDisclosureInteractor) Execute(ctx context.Context, loanID uint64) (usecases.LoanDisclosureOutput, error) {
+	disclosure, err := g.repository.GetLoanDisclosure(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan disclosure", "error", err, "loan_id", loanID)
+		return usecases.LoanDisclosureOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toLoanDisclosureOutput(disclosure), nil
+}
+
+func toLoanDisclosureOutput(disclosure entity.LoanDisclosure) usecases.LoanDisclosureOutput {
+	return usecases.LoanDisclosureOutput{
+		LoanID:              disclosure.LoanID,
+		ProductCode:         disclosure.ProductCode,
+		PrincipalAmount:     disclosure.PrincipalAmount.StringFixed(2),
+		InterestRate:        disclosure.InterestRate.String(),
+		TermWeeks:           disclosure.TermWeeks,
+		AmountReceived:      disclosure.AmountReceived.StringFixed(2),
+		TotalInterest:       disclosure.TotalInterest.StringFixed(2),
+		TotalFees:           disclosure.TotalFees.StringFixed(2),
+		TotalRepayment:      disclosure.TotalRepayment.StringFixed(2),
+		EffectiveAnnualRate: disclosure.EffectiveAnnualRate.StringFixed(4),
+		DisclosedAt:         disclosure.DisclosedAt.Format(time.RFC3339),
+	}
+}
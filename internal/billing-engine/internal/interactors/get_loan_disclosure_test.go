@@ -0,0 +1,79 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoanDisclosureInteractor_Execute(t *testing.T) {
+	disclosedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		loanID         uint64
+		setupMocks     func(*billingenginemocks.MockGetLoanDisclosureRepository)
+		expectedOutput usecases.LoanDisclosureOutput
+		expectedError  error
+	}{
+		{
+			name:   "success - disclosed terms of a loan",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanDisclosureRepository) {
+				mockRepo.On("GetLoanDisclosure", mock.Anything, uint64(100)).Return(entity.LoanDisclosure{
+					LoanID: 100, ProductCode: entity.DEFAULT_LOAN_PRODUCT, PrincipalAmount: decimal.NewFromInt(5000000),
+					InterestRate: decimal.NewFromFloat(0.1), TermWeeks: 50, AmountReceived: decimal.NewFromInt(4900000),
+					TotalInterest: decimal.NewFromInt(500000), TotalFees: decimal.NewFromInt(100000), TotalRepayment: decimal.NewFromInt(5500000),
+					EffectiveAnnualRate: decimal.NewFromFloat(0.264312), DisclosedAt: disclosedAt,
+				}, nil)
+			},
+			expectedOutput: usecases.LoanDisclosureOutput{
+				LoanID: 100, ProductCode: entity.DEFAULT_LOAN_PRODUCT, PrincipalAmount: "5000000.00", InterestRate: "0.1",
+				TermWeeks: 50, AmountReceived: "4900000.00", TotalInterest: "500000.00", TotalFees: "100000.00",
+				TotalRepayment: "5500000.00", EffectiveAnnualRate: "0.2643", DisclosedAt: disclosedAt.Format(time.RFC3339),
+			},
+		},
+		{
+			name:   "error - loan without disclosure",
+			loanID: 404,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanDisclosureRepository) {
+				mockRepo.On("GetLoanDisclosure", mock.Anything, uint64(404)).Return(entity.LoanDisclosure{}, errors.New("disclosure of loan 404 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoanDisclosureRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoanDisclosureInteractor(GetLoanDisclosureInteractorDependencies{
+				GetLoanDisclosureRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.loanID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoanFeesUsecase = (*GetLoanFeesInteractor)(nil)
+
+type (
+	GetLoanFeesRepository interface {
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		GetLoanFees(ctx context.Context, loanID uint64) ([]entity.LoanFee, error)
+	}
+
+	GetLoanFeesInteractorDependencies struct {
+		GetLoanFeesRepository GetLoanFeesRepository
+		Logger                *zap.SugaredLogger
+	}
+
+	GetLoanFeesInteractor struct {
+		repository GetLoanFeesRepository `validate:"required"`
+		logger     *zap.SugaredLogger    `validate:"required"`
+	}
+)
+
+func NewGetLoanFeesInteractor(
+	deps GetLoanFeesInteractorDependencies,
+) *GetLoanFeesInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoanFeesInteractor{
+		repository: deps.GetLoanFeesRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetLoanFeesUsecase.
+//
+// The effective annual rate is the rate of return of what the borrower
+// received against the installments they repay, fees included.
+func (g *GetLoanFeesInteractor) Execute(ctx context.Context, loanID uint64) (usecases.GetLoanFeesOutput, error) {
+	loan, err := g.repository.GetLoan(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan", "error", err, "loan_id", loanID)
+		return usecases.GetLoanFeesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	fees, err := g.repository.GetLoanFees(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan fees", "error", err, "loan_id", loanID)
+		return usecases.GetLoanFeesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	effectiveRate, err := entity.EffectiveAnnualRate(entity.LoanCashFlows(loan, fees))
+	if err != nil {
+		g.logger.Errorw("failed to compute effective rate", "error", err, "loan_id", loanID)
+		return usecases.GetLoanFeesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetLoanFeesOutput{
+		LoanID:              loan.ID,
+		PrincipalAmount:     loan.PrincipalAmount.StringFixed(2),
+		InterestRate:        loan.InterestRate.String(),
+		TotalDeducted:       entity.TotalFees(fees, entity.FEE_DEDUCTED).StringFixed(2),
+		TotalAmortised:      entity.TotalFees(fees, entity.FEE_AMORTISED).StringFixed(2),
+		EffectiveAnnualRate: effectiveRate.StringFixed(4),
+		Fees:                make([]usecases.LoanFeeOutput, len(fees)),
+	}
+	for i, fee := range fees {
+		output.Fees[i] = usecases.LoanFeeOutput{
+			ID:          fee.ID,
+			FeeType:     string(fee.FeeType),
+			Calculation: string(fee.Calculation),
+			Value:       fee.Value.String(),
+			Collection:  string(fee.Collection),
+			Amount:      fee.Amount.StringFixed(2),
+			CreatedAt:   fee.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,109 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoanFeesInteractor_Execute(t *testing.T) {
+	loan := entity.Loan{
+		ID: 100, CustomerID: 1, PrincipalAmount: decimal.NewFromInt(5000000), InterestRate: decimal.NewFromFloat(0.1),
+		TermWeeks: 50, Status: entity.LOAN_DISBURSED,
+	}
+	fees := []entity.LoanFee{
+		{ID: 1, LoanID: 100, FeeType: entity.FEE_ORIGINATION, Calculation: entity.FEE_PERCENTAGE, Value: decimal.NewFromFloat(0.02), Collection: entity.FEE_DEDUCTED, Amount: decimal.NewFromInt(100000)},
+		{ID: 2, LoanID: 100, FeeType: entity.FEE_INSURANCE, Calculation: entity.FEE_FLAT, Value: decimal.NewFromInt(50000), Collection: entity.FEE_AMORTISED, Amount: decimal.NewFromInt(50000)},
+	}
+
+	tests := []struct {
+		name          string
+		loanID        uint64
+		setupMocks    func(*billingenginemocks.MockGetLoanFeesRepository)
+		expectedCheck func(*testing.T, usecases.GetLoanFeesOutput)
+		expectedError error
+	}{
+		{
+			name:   "success - fees raise the effective rate above the flat interest",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanFeesRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(loan, nil)
+				mockRepo.On("GetLoanFees", mock.Anything, uint64(100)).Return(fees, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.GetLoanFeesOutput) {
+				assert.Equal(t, "100000.00", output.TotalDeducted)
+				assert.Equal(t, "50000.00", output.TotalAmortised)
+				assert.Len(t, output.Fees, 2)
+				assert.Equal(t, "0.02", output.Fees[0].Value)
+
+				withoutFees, err := entity.EffectiveAnnualRate(entity.LoanCashFlows(loan, nil))
+				assert.NoError(t, err)
+				effectiveRate, err := decimal.NewFromString(output.EffectiveAnnualRate)
+				assert.NoError(t, err)
+				assert.True(t, effectiveRate.GreaterThan(withoutFees))
+			},
+		},
+		{
+			name:   "success - loan without fees",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanFeesRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(loan, nil)
+				mockRepo.On("GetLoanFees", mock.Anything, uint64(100)).Return(nil, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.GetLoanFeesOutput) {
+				assert.Equal(t, "0.00", output.TotalDeducted)
+				assert.Empty(t, output.Fees)
+			},
+		},
+		{
+			name:   "error - repository error on GetLoanFees",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanFeesRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(loan, nil)
+				mockRepo.On("GetLoanFees", mock.Anything, uint64(100)).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:   "error - loan not found",
+			loanID: 404,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanFeesRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(404)).Return(entity.Loan{}, errors.New("loan not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoanFeesRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoanFeesInteractor(GetLoanFeesInteractorDependencies{
+				GetLoanFeesRepository: mockRepo,
+				Logger:                zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.loanID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
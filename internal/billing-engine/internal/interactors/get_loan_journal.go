@@ -0,0 +1,84 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoanJournalUsecase = (*GetLoanJournalInteractor)(nil)
+
+type (
+	GetLoanJournalRepository interface {
+		GetJournalEntriesByLoan(ctx context.Context, loanID uint64) ([]entity.JournalEntry, error)
+	}
+
+	GetLoanJournalInteractorDependencies struct {
+		GetLoanJournalRepository GetLoanJournalRepository
+		Logger                   *zap.SugaredLogger
+	}
+
+	GetLoanJournalInteractor struct {
+		repository GetLoanJournalRepository `validate:"required"`
+		logger     *zap.SugaredLogger       `validate:"required"`
+	}
+)
+
+func NewGetLoanJournalInteractor(
+	deps GetLoanJournalInteractorDependencies,
+) *GetLoanJournalInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoanJournalInteractor{
+		repository: deps.GetLoanJournalRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetLoanJournalUsecase.
+func (g *GetLoanJournalInteractor) Execute(ctx context.Context, loanID uint64) ([]usecases.JournalEntryOutput, error) {
+	entries, err := g.repository.GetJournalEntriesByLoan(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get journal entries", "error", err, "loan_id", loanID)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := make([]usecases.JournalEntryOutput, len(entries))
+	for i, entry := range entries {
+		output[i] = toJournalEntryOutput(entry)
+	}
+
+	return output, nil
+}
+
+func toJournalEntryOutput(entry entity.JournalEntry) usecases.JournalEntryOutput {
+	output := usecases.JournalEntryOutput{
+		ID:              entry.ID,
+		LoanID:          entry.LoanID,
+		Event:           string(entry.Event),
+		Reference:       entry.Reference,
+		Description:     entry.Description,
+		EffectiveDate:   entry.EffectiveDate.Format(dateLayout),
+		ReversesEntryID: entry.ReversesEntryID,
+		CreatedAt:       entry.CreatedAt.Format(time.RFC3339),
+		Postings:        make([]usecases.PostingOutput, len(entry.Postings)),
+	}
+
+	for i, posting := range entry.Postings {
+		output.Postings[i] = usecases.PostingOutput{
+			AccountCode: posting.AccountCode,
+			Direction:   string(posting.Direction),
+			Amount:      posting.Amount.StringFixed(2),
+		}
+	}
+
+	return output
+}
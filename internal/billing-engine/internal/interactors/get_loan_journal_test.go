@@ -0,0 +1,80 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoanJournalInteractor_Execute(t *testing.T) {
+	startDate := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	disbursement := entity.NewDisbursementEntry(400, entity.Loan{ID: 100, PrincipalAmount: decimal.NewFromInt(5000000), StartDate: startDate}, decimal.Zero)
+	disbursement.CreatedAt = startDate
+
+	tests := []struct {
+		name           string
+		loanID         uint64
+		setupMocks     func(*billingenginemocks.MockGetLoanJournalRepository)
+		expectedOutput []usecases.JournalEntryOutput
+		expectedError  error
+	}{
+		{
+			name:   "success - journal entries of the loan are returned",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanJournalRepository) {
+				mockRepo.On("GetJournalEntriesByLoan", mock.Anything, uint64(100)).Return([]entity.JournalEntry{disbursement}, nil)
+			},
+			expectedOutput: []usecases.JournalEntryOutput{
+				{
+					ID: 400, LoanID: 100, Event: "DISBURSEMENT", Reference: "loan-100", Description: "Disbursement",
+					EffectiveDate: "2024-03-01", CreatedAt: "2024-03-01T10:00:00Z",
+					Postings: []usecases.PostingOutput{
+						{AccountCode: "PRINCIPAL_RECEIVABLE", Direction: "DEBIT", Amount: "5000000.00"},
+						{AccountCode: "CASH", Direction: "CREDIT", Amount: "5000000.00"},
+					},
+				},
+			},
+		},
+		{
+			name:   "error - repository error",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanJournalRepository) {
+				mockRepo.On("GetJournalEntriesByLoan", mock.Anything, uint64(100)).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoanJournalRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoanJournalInteractor(GetLoanJournalInteractorDependencies{
+				GetLoanJournalRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.loanID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoanPartiesUsecase = (*GetLoanPartiesInteractor)(nil)
+
+type (
+	GetLoanPartiesRepository interface {
+		GetLoanParties(ctx context.Context, loanID uint64) ([]entity.LoanParty, error)
+	}
+
+	GetLoanPartiesInteractorDependencies struct {
+		GetLoanPartiesRepository GetLoanPartiesRepository
+		Logger                   *zap.SugaredLogger
+	}
+
+	GetLoanPartiesInteractor struct {
+		repository GetLoanPartiesRepository `validate:"required"`
+		logger     *zap.SugaredLogger       `validate:"required"`
+	}
+)
+
+func NewGetLoanPartiesInteractor(
+	deps GetLoanPartiesInteractorDependencies,
+) *GetLoanPartiesInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoanPartiesInteractor{
+		repository: deps.GetLoanPartiesRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetLoanPartiesUsecase.
+func (g *GetLoanPartiesInteractor) Execute(ctx context.Context, loanID uint64) ([]usecases.LoanPartyOutput, error) {
+	parties, err := g.repository.GetLoanParties(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan parties", "error", err, "loan_id", loanID)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := make([]usecases.LoanPartyOutput, len(parties))
+	for i, party := range parties {
+		output[i] = toLoanPartyOutput(party)
+	}
+
+	return output, nil
+}
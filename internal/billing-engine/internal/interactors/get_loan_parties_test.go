@@ -0,0 +1,82 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoanPartiesInteractor_Execute(t *testing.T) {
+	addedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		loanID         uint64
+		setupMocks     func(*billingenginemocks.MockGetLoanPartiesRepository)
+		expectedOutput []usecases.LoanPartyOutput
+		expectedError  error
+	}{
+		{
+			name:   "success - parties of the loan are returned",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanPartiesRepository) {
+				mockRepo.On("GetLoanParties", mock.Anything, uint64(100)).Return([]entity.LoanParty{
+					{ID: 10, LoanID: 100, CustomerID: 2, Role: entity.LOAN_PARTY_GUARANTOR, AddedBy: "sales-agent", AddedAt: addedAt},
+					{ID: 11, LoanID: 100, CustomerID: 3, Role: entity.LOAN_PARTY_CO_BORROWER, AddedBy: "sales-agent", AddedAt: addedAt},
+				}, nil)
+			},
+			expectedOutput: []usecases.LoanPartyOutput{
+				{ID: 10, LoanID: 100, CustomerID: 2, Role: "GUARANTOR", AddedBy: "sales-agent", AddedAt: "2024-03-01T10:00:00Z"},
+				{ID: 11, LoanID: 100, CustomerID: 3, Role: "CO_BORROWER", AddedBy: "sales-agent", AddedAt: "2024-03-01T10:00:00Z"},
+			},
+		},
+		{
+			name:   "success - loan without parties",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanPartiesRepository) {
+				mockRepo.On("GetLoanParties", mock.Anything, uint64(100)).Return(nil, nil)
+			},
+			expectedOutput: []usecases.LoanPartyOutput{},
+		},
+		{
+			name:   "error - repository error",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanPartiesRepository) {
+				mockRepo.On("GetLoanParties", mock.Anything, uint64(100)).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoanPartiesRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoanPartiesInteractor(GetLoanPartiesInteractorDependencies{
+				GetLoanPartiesRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.loanID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
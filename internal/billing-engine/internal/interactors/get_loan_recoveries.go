@@ -0,0 +1,83 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoanRecoveriesUsecase = (*GetLoanRecoveriesInteractor)(nil)
+
+type (
+	GetLoanRecoveriesRepository interface {
+		GetWriteOffByLoan(ctx context.Context, loanID uint64) (entity.WriteOff, error)
+		GetRecoveriesByLoan(ctx context.Context, loanID uint64) ([]entity.Recovery, error)
+	}
+
+	GetLoanRecoveriesInteractorDependencies struct {
+		GetLoanRecoveriesRepository GetLoanRecoveriesRepository
+		Logger                      *zap.SugaredLogger
+	}
+
+	GetLoanRecoveriesInteractor struct {
+		repository GetLoanRecoveriesRepository `validate:"required"`
+		logger     *zap.SugaredLogger          `validate:"required"`
+	}
+)
+
+func NewGetLoanRecoveriesInteractor(
+	deps GetLoanRecoveriesInteractorDependencies,
+) *GetLoanRecoveriesInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoanRecoveriesInteractor{
+		repository: deps.GetLoanRecoveriesRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetLoanRecoveriesUsecase.
+func (g *GetLoanRecoveriesInteractor) Execute(ctx context.Context, loanID uint64) (usecases.GetLoanRecoveriesOutput, error) {
+	writeOff, err := g.repository.GetWriteOffByLoan(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get write-off", "error", err, "loan_id", loanID)
+		return usecases.GetLoanRecoveriesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	recoveries, err := g.repository.GetRecoveriesByLoan(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get recoveries", "error", err, "loan_id", loanID)
+		return usecases.GetLoanRecoveriesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetLoanRecoveriesOutput{
+		WriteOff:   toWriteOffOutput(writeOff),
+		Recoveries: make([]usecases.RecoveryOutput, len(recoveries)),
+	}
+
+	recovered := decimal.Zero
+	for i, recovery := range recoveries {
+		recovered = recovered.Add(recovery.Amount)
+		output.Recoveries[i] = usecases.RecoveryOutput{
+			ID:         recovery.ID,
+			LoanID:     recovery.LoanID,
+			WriteOffID: recovery.WriteOffID,
+			Amount:     recovery.Amount.StringFixed(2),
+			ReceivedAt: recovery.ReceivedAt.Format(time.RFC3339),
+		}
+	}
+
+	output.TotalRecovered = recovered.StringFixed(2)
+	output.RemainingAmount = writeOff.Total().Sub(recovered).StringFixed(2)
+
+	return output, nil
+}
@@ -0,0 +1,90 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoanRecoveriesInteractor_Execute(t *testing.T) {
+	writtenOffAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	writeOff := entity.WriteOff{
+		ID: 500, LoanID: 100, CustomerID: 1,
+		PrincipalAmount: decimal.NewFromInt(200000), InterestAmount: decimal.NewFromInt(20000), FeeAmount: decimal.Zero,
+		Reason: "deceased", WrittenOffBy: "risk-officer", WrittenOffAt: writtenOffAt,
+	}
+
+	tests := []struct {
+		name           string
+		loanID         uint64
+		setupMocks     func(*billingenginemocks.MockGetLoanRecoveriesRepository)
+		expectedOutput usecases.GetLoanRecoveriesOutput
+		expectedError  error
+	}{
+		{
+			name:   "success - recoveries are totalled against the write-off",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanRecoveriesRepository) {
+				mockRepo.On("GetWriteOffByLoan", mock.Anything, uint64(100)).Return(writeOff, nil)
+				mockRepo.On("GetRecoveriesByLoan", mock.Anything, uint64(100)).Return([]entity.Recovery{
+					{ID: 600, LoanID: 100, WriteOffID: 500, Amount: decimal.NewFromInt(50000), ReceivedAt: writtenOffAt.AddDate(0, 0, 7)},
+					{ID: 601, LoanID: 100, WriteOffID: 500, Amount: decimal.NewFromInt(20000), ReceivedAt: writtenOffAt.AddDate(0, 0, 14)},
+				}, nil)
+			},
+			expectedOutput: usecases.GetLoanRecoveriesOutput{
+				WriteOff: usecases.WriteOffOutput{
+					ID: 500, LoanID: 100, CustomerID: 1,
+					PrincipalAmount: "200000.00", InterestAmount: "20000.00", FeeAmount: "0.00", TotalAmount: "220000.00",
+					Reason: "deceased", WrittenOffBy: "risk-officer", WrittenOffAt: "2024-03-01T10:00:00Z",
+				},
+				Recoveries: []usecases.RecoveryOutput{
+					{ID: 600, LoanID: 100, WriteOffID: 500, Amount: "50000.00", ReceivedAt: "2024-03-08T10:00:00Z"},
+					{ID: 601, LoanID: 100, WriteOffID: 500, Amount: "20000.00", ReceivedAt: "2024-03-15T10:00:00Z"},
+				},
+				TotalRecovered:  "70000.00",
+				RemainingAmount: "150000.00",
+			},
+		},
+		{
+			name:   "error - loan was not written off",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanRecoveriesRepository) {
+				mockRepo.On("GetWriteOffByLoan", mock.Anything, uint64(100)).Return(entity.WriteOff{}, errors.New("write-off of loan 100 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoanRecoveriesRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoanRecoveriesInteractor(GetLoanRecoveriesInteractorDependencies{
+				GetLoanRecoveriesRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.loanID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
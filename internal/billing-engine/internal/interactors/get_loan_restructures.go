@@ -0,0 +1,59 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoanRestructuresUsecase = (*GetLoanRestructuresInteractor)(nil)
+
+type (
+	GetLoanRestructuresRepository interface {
+		GetLoanRestructures(ctx context.Context, loanID uint64) ([]entity.LoanRestructure, error)
+	}
+
+	GetLoanRestructuresInteractorDependencies struct {
+		GetLoanRestructuresRepository GetLoanRestructuresRepository
+		Logger                        *zap.SugaredLogger
+	}
+
+	GetLoanRestructuresInteractor struct {
+		repository GetLoanRestructuresRepository `validate:"required"`
+		logger     *zap.SugaredLogger            `validate:"required"`
+	}
+)
+
+func NewGetLoanRestructuresInteractor(
+	deps GetLoanRestructuresInteractorDependencies,
+) *GetLoanRestructuresInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoanRestructuresInteractor{
+		repository: deps.GetLoanRestructuresRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetLoanRestructuresUsecase.
+func (g *GetLoanRestructuresInteractor) Execute(ctx context.Context, loanID uint64) ([]usecases.LoanRestructureOutput, error) {
+	restructures, err := g.repository.GetLoanRestructures(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan restructures", "error", err, "loan_id", loanID)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := make([]usecases.LoanRestructureOutput, len(restructures))
+	for i, restructure := range restructures {
+		output[i] = toLoanRestructureOutput(restructure)
+	}
+
+	return output, nil
+}
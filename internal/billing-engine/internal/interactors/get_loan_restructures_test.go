@@ -0,0 +1,81 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoanRestructuresInteractor_Execute(t *testing.T) {
+	restructuredAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		loanID         uint64
+		setupMocks     func(*billingenginemocks.MockGetLoanRestructuresRepository)
+		expectedOutput []usecases.LoanRestructureOutput
+		expectedError  error
+	}{
+		{
+			name:   "success - restructures of the loan are returned",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanRestructuresRepository) {
+				mockRepo.On("GetLoanRestructures", mock.Anything, uint64(100)).Return([]entity.LoanRestructure{
+					{
+						ID: 300, LoanID: 100, NewLoanID: 200, Type: entity.RESTRUCTURE_EXTEND_TERM,
+						OutstandingAmount: decimal.NewFromInt(300000), ArrearsAmount: decimal.Zero, ClosedInstallments: 3,
+						NewTermWeeks: 6, NewInstallmentAmount: decimal.NewFromInt(50000), Reason: "hardship", RestructuredAt: restructuredAt,
+					},
+				}, nil)
+			},
+			expectedOutput: []usecases.LoanRestructureOutput{
+				{
+					ID: 300, LoanID: 100, NewLoanID: 200, Type: "EXTEND_TERM",
+					OutstandingAmount: "300000.00", ArrearsAmount: "0.00", ClosedInstallments: 3,
+					NewTermWeeks: 6, NewInstallmentAmount: "50000.00", Reason: "hardship", RestructuredAt: "2024-03-01T10:00:00Z",
+				},
+			},
+		},
+		{
+			name:   "error - repository error",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanRestructuresRepository) {
+				mockRepo.On("GetLoanRestructures", mock.Anything, uint64(100)).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoanRestructuresRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoanRestructuresInteractor(GetLoanRestructuresInteractorDependencies{
+				GetLoanRestructuresRepository: mockRepo,
+				Logger:                        zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.loanID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoanStatusHistoryUsecase = (*GetLoanStatusHistoryInteractor)(nil)
+
+type (
+	GetLoanStatusHistoryRepository interface {
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		GetLoanStatusTransitions(ctx context.Context, loanID uint64) ([]entity.LoanStatusTransition, error)
+	}
+
+	GetLoanStatusHistoryInteractorDependencies struct {
+		GetLoanStatusHistoryRepository GetLoanStatusHistoryRepository
+		Logger                         *zap.SugaredLogger
+	}
+
+	GetLoanStatusHistoryInteractor struct {
+		repository GetLoanStatusHistoryRepository `validate:"required"`
+		logger     *zap.SugaredLogger             `validate:"required"`
+	}
+)
+
+func NewGetLoanStatusHistoryInteractor(
+	deps GetLoanStatusHistoryInteractorDependencies,
+) *GetLoanStatusHistoryInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoanStatusHistoryInteractor{
+		repository: deps.GetLoanStatusHistoryRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetLoanStatusHistoryUsecase.
+func (g *GetLoanStatusHistoryInteractor) Execute(ctx context.Context, loanID uint64) (usecases.GetLoanStatusHistoryOutput, error) {
+	loan, err := g.repository.GetLoan(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan", "error", err, "loan_id", loanID)
+		return usecases.GetLoanStatusHistoryOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	transitions, err := g.repository.GetLoanStatusTransitions(ctx, loanID)
+	if err != nil {
+		g.logger.Errorw("failed to get loan status transitions", "error", err, "loan_id", loanID)
+		return usecases.GetLoanStatusHistoryOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetLoanStatusHistoryOutput{
+		LoanID:      loan.ID,
+		Status:      string(loan.Status),
+		Transitions: make([]usecases.LoanStatusTransitionOutput, len(transitions)),
+	}
+	for i, transition := range transitions {
+		output.Transitions[i] = toLoanStatusTransitionOutput(transition)
+	}
+
+	return output, nil
+}
+
+func toLoanStatusTransitionOutput(transition entity.LoanStatusTransition) usecases.LoanStatusTransitionOutput {
+	return usecases.LoanStatusTransitionOutput{
+		ID:             transition.ID,
+		FromStatus:     string(transition.FromStatus),
+		ToStatus:       string(transition.ToStatus),
+		Actor:          transition.Actor,
+		Reason:         transition.Reason,
+		TransitionedAt: transition.TransitionedAt.Format(time.RFC3339),
+	}
+}
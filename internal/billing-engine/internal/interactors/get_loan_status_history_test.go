@@ -0,0 +1,90 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoanStatusHistoryInteractor_Execute(t *testing.T) {
+	paidAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	reversedAt := time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		loanID         uint64
+		setupMocks     func(*billingenginemocks.MockGetLoanStatusHistoryRepository)
+		expectedOutput usecases.GetLoanStatusHistoryOutput
+		expectedError  error
+	}{
+		{
+			name:   "success - transitions returned in order",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanStatusHistoryRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("GetLoanStatusTransitions", mock.Anything, uint64(100)).Return([]entity.LoanStatusTransition{
+					{ID: 1, LoanID: 100, FromStatus: entity.LOAN_DISBURSED, ToStatus: entity.LOAN_PAID, Actor: entity.SYSTEM_ACTOR, Reason: "all installments paid", TransitionedAt: paidAt},
+					{ID: 2, LoanID: 100, FromStatus: entity.LOAN_PAID, ToStatus: entity.LOAN_DISBURSED, Actor: entity.SYSTEM_ACTOR, Reason: "payment reversed", TransitionedAt: reversedAt},
+				}, nil)
+			},
+			expectedOutput: usecases.GetLoanStatusHistoryOutput{
+				LoanID: 100,
+				Status: "DISBURSED",
+				Transitions: []usecases.LoanStatusTransitionOutput{
+					{ID: 1, FromStatus: "DISBURSED", ToStatus: "PAID", Actor: "system", Reason: "all installments paid", TransitionedAt: "2024-03-01T10:00:00Z"},
+					{ID: 2, FromStatus: "PAID", ToStatus: "DISBURSED", Actor: "system", Reason: "payment reversed", TransitionedAt: "2024-03-02T09:00:00Z"},
+				},
+			},
+		},
+		{
+			name:   "error - loan not found",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanStatusHistoryRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{}, errors.New("loan 100 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:   "error - repository error on GetLoanStatusTransitions",
+			loanID: 100,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoanStatusHistoryRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("GetLoanStatusTransitions", mock.Anything, uint64(100)).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoanStatusHistoryRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoanStatusHistoryInteractor(GetLoanStatusHistoryInteractorDependencies{
+				GetLoanStatusHistoryRepository: mockRepo,
+				Logger:                         zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.loanID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetLoansUsecase = (*GetLoansInteractor)(nil)
+
+type (
+	GetLoansRepository interface {
+		IsCustomerExist(ctx context.Context, customerID uint64) (bool, error)
+		SearchLoans(ctx context.Context, search entity.LoanSearch) ([]entity.Loan, error)
+		GetUnpaidInstallmentsOfLoans(ctx context.Context, loanIDs []uint64) ([]entity.Installment, error)
+	}
+
+	GetLoansInteractorDependencies struct {
+		GetLoansRepository GetLoansRepository
+		Logger             *zap.SugaredLogger
+		Validator          *validator.Validate
+	}
+
+	GetLoansInteractor struct {
+		repository GetLoansRepository  `validate:"required"`
+		logger     *zap.SugaredLogger  `validate:"required"`
+		validator  *validator.Validate `validate:"required"`
+	}
+)
+
+func NewGetLoansInteractor(
+	deps GetLoansInteractorDependencies,
+) *GetLoansInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetLoansInteractor{
+		repository: deps.GetLoansRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.GetLoansUsecase.
+//
+// The balances of a whole page are read with a single query on the unpaid
+// installments of its loans.
+func (g *GetLoansInteractor) Execute(ctx context.Context, input usecases.GetLoansInput) (usecases.GetLoansOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GetLoansOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	search, err := g.toLoanSearch(input)
+	if err != nil {
+		return usecases.GetLoansOutput{}, err
+	}
+
+	if search.CustomerID != 0 {
+		isCustomerExist, err := g.repository.IsCustomerExist(ctx, search.CustomerID)
+		if err != nil {
+			g.logger.Errorw("failed to check if customer exists", "error", err, "customer_id", search.CustomerID)
+			return usecases.GetLoansOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		if !isCustomerExist {
+			return usecases.GetLoansOutput{}, pkgerror.NewBusinessError("customer not found")
+		}
+	}
+
+	// One more loan than the page holds tells whether another page follows
+	pageSize := search.Limit
+	search.Limit++
+
+	loans, err := g.repository.SearchLoans(ctx, search)
+	if err != nil {
+		g.logger.Errorw("failed to search loans", "error", err)
+		return usecases.GetLoansOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	var nextCursor string
+	if int64(len(loans)) > pageSize {
+		loans = loans[:pageSize]
+		nextCursor = search.CursorOf(loans[pageSize-1]).Encode()
+	}
+
+	loanIDs := make([]uint64, len(loans))
+	for i, loan := range loans {
+		loanIDs[i] = loan.ID
+	}
+
+	installments, err := g.repository.GetUnpaidInstallmentsOfLoans(ctx, loanIDs)
+	if err != nil {
+		g.logger.Errorw("failed to get unpaid installments", "error", err)
+		return usecases.GetLoansOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	installmentsByLoan := make(map[uint64][]entity.Installment, len(loans))
+	for _, installment := range installments {
+		installmentsByLoan[installment.LoanID] = append(installmentsByLoan[installment.LoanID], installment)
+	}
+
+	loansOutput := make([]usecases.LoanSummaryOutput, len(loans))
+	for i, loan := range loans {
+		balance, err := entity.NewLoanBalance(installmentsByLoan[loan.ID], search.AsOf)
+		if err != nil {
+			g.logger.Errorw("failed to compute loan balance", "error", err, "loan_id", loan.ID)
+			return usecases.GetLoansOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		loansOutput[i] = usecases.LoanSummaryOutput{
+			LoanOutput:    toLoanOutput(loan),
+			Outstanding:   balance.Outstanding.StringFixed(2),
+			OverdueAmount: balance.Overdue.StringFixed(2),
+			DaysPastDue:   balance.DaysPastDue(search.AsOf),
+		}
+		if !balance.NextDueDate.IsZero() {
+			loansOutput[i].NextDueDate = balance.NextDueDate.Format(dateLayout)
+			loansOutput[i].NextDueAmount = balance.NextDueAmount.StringFixed(2)
+		}
+	}
+
+	return usecases.GetLoansOutput{
+		Loans:      loansOutput,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (g *GetLoansInteractor) toLoanSearch(input usecases.GetLoansInput) (entity.LoanSearch, error) {
+	search := entity.LoanSearch{
+		CustomerID: input.CustomerID,
+		Status:     entity.LoanStatus(input.Status),
+		Sort:       entity.LoanSort(input.Sort),
+		Limit:      input.Limit,
+	}
+	if search.Sort == "" {
+		search.Sort = entity.LOAN_SORT_OLDEST
+	}
+	if search.Limit == 0 {
+		search.Limit = entity.DEFAULT_PAGE_SIZE
+	}
+	if input.Overdue != "" {
+		overdue := input.Overdue == "true"
+		search.Overdue = &overdue
+	}
+
+	var err error
+	if search.AsOf, err = parseAsOfDate(input.AsOf); err != nil {
+		return entity.LoanSearch{}, err
+	}
+	if search.StartFrom, err = parseOptionalDate(input.StartFrom); err != nil {
+		return entity.LoanSearch{}, err
+	}
+	if search.StartTo, err = parseOptionalDate(input.StartTo); err != nil {
+		return entity.LoanSearch{}, err
+	}
+
+	if !search.StartFrom.IsZero() && !search.StartTo.IsZero() && search.StartTo.Before(search.StartFrom) {
+		return entity.LoanSearch{}, pkgerror.NewValidationError("start_to must not be before start_from")
+	}
+
+	if input.Cursor != "" {
+		cursor, err := entity.DecodeCursor(input.Cursor, string(search.Sort))
+		if err != nil {
+			return entity.LoanSearch{}, pkgerror.ValidationErrorFrom(err)
+		}
+		search.After = &cursor
+	}
+
+	return search, nil
+}
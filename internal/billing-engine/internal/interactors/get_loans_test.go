@@ -0,0 +1,201 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetLoansInteractor_Execute(t *testing.T) {
+	startDate := time.Date(2025, 6, 2, 0, 0, 0, 0, time.Local)
+	disbursedLoan := entity.Loan{
+		ID:              11,
+		CustomerID:      1,
+		PrincipalAmount: decimal.NewFromInt(5000000),
+		InterestRate:    decimal.NewFromFloat(0.1),
+		TermWeeks:       50,
+		StartDate:       startDate,
+		Status:          entity.LOAN_DISBURSED,
+		ProductCode:     entity.DEFAULT_LOAN_PRODUCT,
+	}
+	appliedLoan := entity.Loan{
+		ID:              12,
+		CustomerID:      1,
+		PrincipalAmount: decimal.NewFromInt(5000000),
+		InterestRate:    decimal.NewFromFloat(0.1),
+		TermWeeks:       50,
+		Status:          entity.LOAN_APPLIED,
+		ProductCode:     entity.DEFAULT_LOAN_PRODUCT,
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.GetLoansInput
+		setupMocks    func(*billingenginemocks.MockGetLoansRepository)
+		expectedCheck func(*testing.T, usecases.GetLoansOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - loans with their balance as of a date",
+			input: usecases.GetLoansInput{AsOf: "2025-06-20"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoansRepository) {
+				mockRepo.On("SearchLoans", mock.Anything, mock.MatchedBy(func(search entity.LoanSearch) bool {
+					return search.Sort == entity.LOAN_SORT_OLDEST && search.Limit == entity.DEFAULT_PAGE_SIZE+1 &&
+						search.AsOf.Equal(time.Date(2025, 6, 20, 0, 0, 0, 0, time.Local)) && search.Overdue == nil
+				})).Return([]entity.Loan{disbursedLoan, appliedLoan}, nil)
+				mockRepo.On("GetUnpaidInstallmentsOfLoans", mock.Anything, []uint64{11, 12}).Return([]entity.Installment{
+					{LoanID: 11, WeekNumber: 2, DueDate: "2025-06-16", AmountDue: "110000.00", FeeAmount: "1000.00", Status: entity.INSTALLMENT_MISSED},
+					{LoanID: 11, WeekNumber: 3, DueDate: "2025-06-23", AmountDue: "110000.00", FeeAmount: "1000.00", Status: entity.INSTALLMENT_PENDING},
+					{LoanID: 11, WeekNumber: 4, DueDate: "2025-06-30", AmountDue: "110000.00", FeeAmount: "1000.00", Status: entity.INSTALLMENT_PENDING},
+				}, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.GetLoansOutput) {
+				assert.Len(t, output.Loans, 2)
+				assert.Empty(t, output.NextCursor)
+
+				disbursed := output.Loans[0]
+				assert.Equal(t, uint64(11), disbursed.ID)
+				assert.Equal(t, "DISBURSED", disbursed.Status)
+				assert.Equal(t, "333000.00", disbursed.Outstanding)
+				assert.Equal(t, "111000.00", disbursed.OverdueAmount)
+				assert.Equal(t, int64(4), disbursed.DaysPastDue)
+				assert.Equal(t, "2025-06-23", disbursed.NextDueDate)
+				assert.Equal(t, "111000.00", disbursed.NextDueAmount)
+
+				applied := output.Loans[1]
+				assert.Equal(t, uint64(12), applied.ID)
+				assert.Equal(t, "0.00", applied.Outstanding)
+				assert.Equal(t, "0.00", applied.OverdueAmount)
+				assert.Equal(t, int64(0), applied.DaysPastDue)
+				assert.Empty(t, applied.NextDueDate)
+				assert.Empty(t, applied.StartDate)
+			},
+		},
+		{
+			name:  "success - full page returns a cursor for the next one",
+			input: usecases.GetLoansInput{Limit: 1, Sort: "-id"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoansRepository) {
+				mockRepo.On("SearchLoans", mock.Anything, mock.MatchedBy(func(search entity.LoanSearch) bool {
+					return search.Sort == entity.LOAN_SORT_NEWEST && search.Limit == 2 && search.After == nil
+				})).Return([]entity.Loan{appliedLoan, disbursedLoan}, nil)
+				mockRepo.On("GetUnpaidInstallmentsOfLoans", mock.Anything, []uint64{12}).Return(nil, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.GetLoansOutput) {
+				assert.Len(t, output.Loans, 1)
+				assert.Equal(t, uint64(12), output.Loans[0].ID)
+				assert.Equal(t, entity.Cursor{Sort: "-id", ID: 12}.Encode(), output.NextCursor)
+			},
+		},
+		{
+			name: "success - filters of a customer's loans are passed on",
+			input: usecases.GetLoansInput{
+				CustomerID: 1,
+				Status:     "DISBURSED",
+				Overdue:    "true",
+				StartFrom:  "2025-06-01",
+				StartTo:    "2025-06-30",
+				Cursor:     entity.Cursor{Sort: "id", ID: 10}.Encode(),
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoansRepository) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(1)).Return(true, nil)
+				mockRepo.On("SearchLoans", mock.Anything, mock.MatchedBy(func(search entity.LoanSearch) bool {
+					return search.CustomerID == 1 && search.Status == entity.LOAN_DISBURSED &&
+						search.Overdue != nil && *search.Overdue &&
+						search.StartFrom.Equal(time.Date(2025, 6, 1, 0, 0, 0, 0, time.Local)) &&
+						search.StartTo.Equal(time.Date(2025, 6, 30, 0, 0, 0, 0, time.Local)) &&
+						search.After != nil && search.After.ID == 10
+				})).Return([]entity.Loan{disbursedLoan}, nil)
+				mockRepo.On("GetUnpaidInstallmentsOfLoans", mock.Anything, []uint64{11}).Return(nil, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.GetLoansOutput) {
+				assert.Len(t, output.Loans, 1)
+				assert.Equal(t, uint64(1), output.Loans[0].CustomerID)
+			},
+		},
+		{
+			name:  "error - customer not found",
+			input: usecases.GetLoansInput{CustomerID: 404},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoansRepository) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(404)).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - start range ends before it starts",
+			input:         usecases.GetLoansInput{StartFrom: "2025-06-30", StartTo: "2025-06-01"},
+			setupMocks:    func(*billingenginemocks.MockGetLoansRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - invalid start date",
+			input:         usecases.GetLoansInput{StartFrom: "01-06-2025"},
+			setupMocks:    func(*billingenginemocks.MockGetLoansRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - unknown status",
+			input:         usecases.GetLoansInput{Status: "OVERDUE"},
+			setupMocks:    func(*billingenginemocks.MockGetLoansRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - cursor of another sort",
+			input:         usecases.GetLoansInput{Cursor: entity.Cursor{Sort: "-id", ID: 12}.Encode()},
+			setupMocks:    func(*billingenginemocks.MockGetLoansRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error",
+			input: usecases.GetLoansInput{},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoansRepository) {
+				mockRepo.On("SearchLoans", mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - installments repository error",
+			input: usecases.GetLoansInput{},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetLoansRepository) {
+				mockRepo.On("SearchLoans", mock.Anything, mock.Anything).Return([]entity.Loan{disbursedLoan}, nil)
+				mockRepo.On("GetUnpaidInstallmentsOfLoans", mock.Anything, []uint64{11}).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetLoansRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetLoansInteractor(GetLoansInteractorDependencies{
+				GetLoansRepository: mockRepo,
+				Logger:             zap.NewNop().Sugar(),
+				Validator:          validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				tt.expectedCheck(t, output)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
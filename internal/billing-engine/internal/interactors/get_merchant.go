@@ -0,0 +1,54 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetMerchantUsecase = (*GetMerchantInteractor)(nil)
+
+type (
+	GetMerchantRepository interface {
+		GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error)
+	}
+
+	GetMerchantInteractorDependencies struct {
+		GetMerchantRepository GetMerchantRepository
+		Logger                *zap.SugaredLogger
+	}
+
+	GetMerchantInteractor struct {
+		repository GetMerchantRepository `validate:"required"`
+		logger     *zap.SugaredLogger    `validate:"required"`
+	}
+)
+
+func NewGetMerchantInteractor(
+	deps GetMerchantInteractorDependencies,
+) *GetMerchantInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetMerchantInteractor{
+		repository: deps.GetMerchantRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetMerchantUsecase.
+func (g *GetMerchantInteractor) Execute(ctx context.Context, merchantID uint64) (usecases.MerchantOutput, error) {
+	merchant, err := g.repository.GetMerchant(ctx, merchantID)
+	if err != nil {
+		g.logger.Errorw("failed to get merchant", "error", err, "merchant_id", merchantID)
+		return usecases.MerchantOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toMerchantOutput(merchant), nil
+}
@@ -0,0 +1,54 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetMerchantSettlementUsecase = (*GetMerchantSettlementInteractor)(nil)
+
+type (
+	GetMerchantSettlementRepository interface {
+		GetMerchantSettlement(ctx context.Context, settlementID uint64) (entity.MerchantSettlement, error)
+	}
+
+	GetMerchantSettlementInteractorDependencies struct {
+		GetMerchantSettlementRepository GetMerchantSettlementRepository
+		Logger                          *zap.SugaredLogger
+	}
+
+	GetMerchantSettlementInteractor struct {
+		repository GetMerchantSettlementRepository `validate:"required"`
+		logger     *zap.SugaredLogger              `validate:"required"`
+	}
+)
+
+func NewGetMerchantSettlementInteractor(
+	deps GetMerchantSettlementInteractorDependencies,
+) *GetMerchantSettlementInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetMerchantSettlementInteractor{
+		repository: deps.GetMerchantSettlementRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetMerchantSettlementUsecase.
+func (g *GetMerchantSettlementInteractor) Execute(ctx context.Context, settlementID uint64) (usecases.MerchantSettlementOutput, error) {
+	settlement, err := g.repository.GetMerchantSettlement(ctx, settlementID)
+	if err != nil {
+		g.logger.Errorw("failed to get merchant settlement", "error", err, "settlement_id", settlementID)
+		return usecases.MerchantSettlementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toMerchantSettlementOutput(settlement), nil
+}
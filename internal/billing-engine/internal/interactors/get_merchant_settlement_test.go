@@ -0,0 +1,75 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetMerchantSettlementInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMocks     func(*billingenginemocks.MockGetMerchantSettlementRepository)
+		expectedOutput usecases.MerchantSettlementOutput
+		expectedError  error
+	}{
+		{
+			name: "success - failed settlement with its failure reason",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetMerchantSettlementRepository) {
+				mockRepo.On("GetMerchantSettlement", mock.Anything, uint64(1000)).Return(entity.MerchantSettlement{
+					ID: 1000, MerchantID: 900, SettlementDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+					GrossAmount: decimal.NewFromInt(1000000), MDRAmount: decimal.NewFromInt(25000), NetAmount: decimal.NewFromInt(975000),
+					ItemCount: 1, Status: entity.SETTLEMENT_FAILED, FailureReason: "account closed", UpdatedBy: "finance",
+					CreatedAt: time.Date(2024, 3, 5, 1, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2024, 3, 5, 1, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+			expectedOutput: usecases.MerchantSettlementOutput{
+				ID: 1000, MerchantID: 900, SettlementDate: "2024-03-05", GrossAmount: "1000000.00", MDRAmount: "25000.00",
+				NetAmount: "975000.00", ItemCount: 1, Status: "FAILED", FailureReason: "account closed", UpdatedBy: "finance",
+				CreatedAt: "2024-03-05T01:00:00Z", UpdatedAt: "2024-03-05T01:00:00Z",
+				Items: []usecases.MerchantSettlementItemOutput{},
+			},
+		},
+		{
+			name: "error - settlement not found",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetMerchantSettlementRepository) {
+				mockRepo.On("GetMerchantSettlement", mock.Anything, uint64(1000)).
+					Return(entity.MerchantSettlement{}, errors.New("merchant settlement 1000 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetMerchantSettlementRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetMerchantSettlementInteractor(GetMerchantSettlementInteractorDependencies{
+				GetMerchantSettlementRepository: mockRepo,
+				Logger:                          zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), 1000)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
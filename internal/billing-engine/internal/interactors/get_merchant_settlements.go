@@ -0,0 +1,65 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetMerchantSettlementsUsecase = (*GetMerchantSettlementsInteractor)(nil)
+
+type (
+	GetMerchantSettlementsRepository interface {
+		GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error)
+		GetMerchantSettlements(ctx context.Context, merchantID uint64) ([]entity.MerchantSettlement, error)
+	}
+
+	GetMerchantSettlementsInteractorDependencies struct {
+		GetMerchantSettlementsRepository GetMerchantSettlementsRepository
+		Logger                           *zap.SugaredLogger
+	}
+
+	GetMerchantSettlementsInteractor struct {
+		repository GetMerchantSettlementsRepository `validate:"required"`
+		logger     *zap.SugaredLogger               `validate:"required"`
+	}
+)
+
+func NewGetMerchantSettlementsInteractor(
+	deps GetMerchantSettlementsInteractorDependencies,
+) *GetMerchantSettlementsInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetMerchantSettlementsInteractor{
+		repository: deps.GetMerchantSettlementsRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetMerchantSettlementsUsecase.
+func (g *GetMerchantSettlementsInteractor) Execute(ctx context.Context, merchantID uint64) ([]usecases.MerchantSettlementOutput, error) {
+	if _, err := g.repository.GetMerchant(ctx, merchantID); err != nil {
+		g.logger.Errorw("failed to get merchant", "error", err, "merchant_id", merchantID)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	settlements, err := g.repository.GetMerchantSettlements(ctx, merchantID)
+	if err != nil {
+		g.logger.Errorw("failed to get merchant settlements", "error", err, "merchant_id", merchantID)
+		return nil, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := make([]usecases.MerchantSettlementOutput, len(settlements))
+	for i, settlement := range settlements {
+		output[i] = toMerchantSettlementOutput(settlement)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,94 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetMerchantSettlementsInteractor_Execute(t *testing.T) {
+	settlement := entity.MerchantSettlement{
+		ID: 1000, MerchantID: 900, SettlementDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+		GrossAmount: decimal.NewFromInt(1000000), MDRAmount: decimal.NewFromInt(25000), NetAmount: decimal.NewFromInt(975000),
+		ItemCount: 1, Status: entity.SETTLEMENT_SETTLED, Reference: "TRF-1", UpdatedBy: "finance",
+		CreatedAt: time.Date(2024, 3, 5, 1, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2024, 3, 6, 9, 0, 0, 0, time.UTC),
+		SettledAt: time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC),
+		Items: []entity.MerchantSettlementItem{
+			{ID: 1, SettlementID: 1000, MerchantID: 900, LoanID: 100, OrderRef: "ORD-1", SaleDate: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+				GrossAmount: decimal.NewFromInt(1000000), MDRAmount: decimal.NewFromInt(25000), NetAmount: decimal.NewFromInt(975000)},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*billingenginemocks.MockGetMerchantSettlementsRepository)
+		expectedOutput []usecases.MerchantSettlementOutput
+		expectedError  error
+	}{
+		{
+			name: "success - settlements of the merchant",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetMerchantSettlementsRepository) {
+				mockRepo.On("GetMerchant", mock.Anything, uint64(900)).Return(entity.Merchant{ID: 900}, nil)
+				mockRepo.On("GetMerchantSettlements", mock.Anything, uint64(900)).Return([]entity.MerchantSettlement{settlement}, nil)
+			},
+			expectedOutput: []usecases.MerchantSettlementOutput{
+				{
+					ID: 1000, MerchantID: 900, SettlementDate: "2024-03-05", GrossAmount: "1000000.00", MDRAmount: "25000.00",
+					NetAmount: "975000.00", ItemCount: 1, Status: "SETTLED", Reference: "TRF-1", UpdatedBy: "finance",
+					CreatedAt: "2024-03-05T01:00:00Z", UpdatedAt: "2024-03-06T09:00:00Z", SettledAt: "2024-03-06T00:00:00Z",
+					Items: []usecases.MerchantSettlementItemOutput{
+						{LoanID: 100, OrderRef: "ORD-1", SaleDate: "2024-03-04", GrossAmount: "1000000.00", MDRAmount: "25000.00", NetAmount: "975000.00"},
+					},
+				},
+			},
+		},
+		{
+			name: "success - no settlements yet",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetMerchantSettlementsRepository) {
+				mockRepo.On("GetMerchant", mock.Anything, uint64(900)).Return(entity.Merchant{ID: 900}, nil)
+				mockRepo.On("GetMerchantSettlements", mock.Anything, uint64(900)).Return([]entity.MerchantSettlement(nil), nil)
+			},
+			expectedOutput: []usecases.MerchantSettlementOutput{},
+		},
+		{
+			name: "error - merchant not found",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetMerchantSettlementsRepository) {
+				mockRepo.On("GetMerchant", mock.Anything, uint64(900)).Return(entity.Merchant{}, errors.New("merchant 900 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetMerchantSettlementsRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetMerchantSettlementsInteractor(GetMerchantSettlementsInteractorDependencies{
+				GetMerchantSettlementsRepository: mockRepo,
+				Logger:                           zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), 900)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
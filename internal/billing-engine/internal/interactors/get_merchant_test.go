@@ -0,0 +1,71 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetMerchantInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMocks     func(*billingenginemocks.MockGetMerchantRepository)
+		expectedOutput usecases.MerchantOutput
+		expectedError  error
+	}{
+		{
+			name: "success - merchant found",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetMerchantRepository) {
+				mockRepo.On("GetMerchant", mock.Anything, uint64(900)).Return(entity.Merchant{
+					ID: 900, Code: "TOKO", Name: "Toko Maju", MDRRate: decimal.NewFromFloat(0.025),
+					SettlementAccount: entity.BankAccount{BankCode: "BNI", AccountNumber: "555000111", AccountName: "Toko Maju"},
+					CreatedBy:         "partnerships", CreatedAt: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+			expectedOutput: usecases.MerchantOutput{
+				ID: 900, Code: "TOKO", Name: "Toko Maju", MDRRate: "0.025", BankCode: "BNI", AccountNumber: "555000111",
+				AccountName: "Toko Maju", CreatedBy: "partnerships", CreatedAt: "2024-03-01T09:00:00Z",
+			},
+		},
+		{
+			name: "error - merchant not found",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetMerchantRepository) {
+				mockRepo.On("GetMerchant", mock.Anything, uint64(900)).Return(entity.Merchant{}, errors.New("merchant 900 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetMerchantRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetMerchantInteractor(GetMerchantInteractorDependencies{
+				GetMerchantRepository: mockRepo,
+				Logger:                zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), 900)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
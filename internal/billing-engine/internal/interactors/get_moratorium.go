@@ -0,0 +1,61 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetMoratoriumUsecase = (*GetMoratoriumInteractor)(nil)
+
+type (
+	GetMoratoriumRepository interface {
+		GetMoratorium(ctx context.Context, moratoriumID uint64) (entity.Moratorium, error)
+		GetMoratoriumInstallments(ctx context.Context, moratoriumID uint64) ([]entity.MoratoriumInstallment, error)
+	}
+
+	GetMoratoriumInteractorDependencies struct {
+		GetMoratoriumRepository GetMoratoriumRepository
+		Logger                  *zap.SugaredLogger
+	}
+
+	GetMoratoriumInteractor struct {
+		repository GetMoratoriumRepository `validate:"required"`
+		logger     *zap.SugaredLogger      `validate:"required"`
+	}
+)
+
+func NewGetMoratoriumInteractor(
+	deps GetMoratoriumInteractorDependencies,
+) *GetMoratoriumInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetMoratoriumInteractor{
+		repository: deps.GetMoratoriumRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetMoratoriumUsecase.
+func (g *GetMoratoriumInteractor) Execute(ctx context.Context, moratoriumID uint64) (usecases.MoratoriumOutput, error) {
+	moratorium, err := g.repository.GetMoratorium(ctx, moratoriumID)
+	if err != nil {
+		g.logger.Errorw("failed to get moratorium", "error", err, "moratorium_id", moratoriumID)
+		return usecases.MoratoriumOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	changes, err := g.repository.GetMoratoriumInstallments(ctx, moratoriumID)
+	if err != nil {
+		g.logger.Errorw("failed to get moratorium installments", "error", err, "moratorium_id", moratoriumID)
+		return usecases.MoratoriumOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toMoratoriumOutput(moratorium, changes), nil
+}
@@ -0,0 +1,88 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetMoratoriumInteractor_Execute(t *testing.T) {
+	moratorium := entity.Moratorium{
+		ID: 1, Scope: entity.MORATORIUM_SCOPE_LOAN, LoanID: 100,
+		StartDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+		Reason: "flood", ShiftWeeks: 2, AffectedLoans: 1, AffectedInstallments: 1,
+		CreatedAt: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC),
+	}
+	change := entity.MoratoriumInstallment{
+		ID: 2, MoratoriumID: 1, InstallmentID: 6, LoanID: 100, WeekNumber: 6, PreviousStatus: entity.INSTALLMENT_PENDING,
+		OriginalDueDate: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), NewDueDate: time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC),
+		OriginalAmount: decimal.NewFromInt(110000), NewAmount: decimal.NewFromInt(110000), AccruedInterest: decimal.Zero,
+	}
+
+	tests := []struct {
+		name           string
+		moratoriumID   uint64
+		setupMocks     func(*billingenginemocks.MockGetMoratoriumRepository)
+		expectedOutput usecases.MoratoriumOutput
+		expectedError  error
+	}{
+		{
+			name:         "success - moratorium with its shifted installments",
+			moratoriumID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetMoratoriumRepository) {
+				mockRepo.On("GetMoratorium", mock.Anything, uint64(1)).Return(moratorium, nil)
+				mockRepo.On("GetMoratoriumInstallments", mock.Anything, uint64(1)).
+					Return([]entity.MoratoriumInstallment{change}, nil)
+			},
+			expectedOutput: usecases.MoratoriumOutput{
+				ID: 1, Scope: "LOAN", LoanID: 100, StartDate: "2024-03-01", EndDate: "2024-03-10",
+				Reason: "flood", ShiftWeeks: 2, AffectedLoans: 1, AffectedInstallments: 1, CreatedAt: "2024-03-01T09:00:00Z",
+				Installments: []usecases.MoratoriumInstallmentOutput{
+					{InstallmentID: 6, LoanID: 100, WeekNumber: 6, PreviousStatus: "PENDING", OriginalDueDate: "2024-03-11", NewDueDate: "2024-03-25", OriginalAmount: "110000.00", NewAmount: "110000.00", AccruedInterest: "0.00"},
+				},
+			},
+		},
+		{
+			name:         "error - moratorium not found",
+			moratoriumID: 1,
+			setupMocks: func(mockRepo *billingenginemocks.MockGetMoratoriumRepository) {
+				mockRepo.On("GetMoratorium", mock.Anything, uint64(1)).
+					Return(entity.Moratorium{}, errors.New("moratorium 1 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetMoratoriumRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetMoratoriumInteractor(GetMoratoriumInteractorDependencies{
+				GetMoratoriumRepository: mockRepo,
+				Logger:                  zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.moratoriumID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
@@ -85,11 +85,12 @@ func (g *GetOutstandingInteractor) Execute(ctx context.Context, customerID uint6
 		WeekNumber int64  `json:"week_number"`
 		DueDate    string `json:"due_date"`
 		AmountDue  string `json:"amount_due"`
+		FeeAmount  string `json:"fee_amount"`
 		Status     string `json:"status"`
 	}
 
-	// Calculate total paid and total missed from installments
-	var totalPaidAmount, totalMissedAmount float64
+	// Calculate total paid, total missed and unpaid fees from installments
+	var totalPaidAmount, totalMissedAmount, totalFeesAmount float64
 	for _, inst := range installments {
 		// Parse amount due to float for calculation
 		amountDue, err := strconv.ParseFloat(inst.AmountDue, 64)
@@ -98,6 +99,15 @@ func (g *GetOutstandingInteractor) Execute(ctx context.Context, customerID uint6
 			continue
 		}
 
+		feeAmount, err := inst.Fee()
+		if err != nil {
+			g.logger.Warnw("failed to parse fee amount", "error", err, "installment_id", inst.ID, "fee_amount", inst.FeeAmount)
+			continue
+		}
+
+		// Fees are due with the installment, so they count where it counts
+		amountDue += feeAmount.InexactFloat64()
+
 		// Add to appropriate total based on status
 		switch inst.Status {
 		case entity.INSTALLMENT_PAID:
@@ -106,17 +116,23 @@ func (g *GetOutstandingInteractor) Execute(ctx context.Context, customerID uint6
 			totalMissedAmount += amountDue
 		}
 
+		if inst.Status == entity.INSTALLMENT_PENDING || inst.Status == entity.INSTALLMENT_MISSED {
+			totalFeesAmount += feeAmount.InexactFloat64()
+		}
+
 		installmentDetails = append(installmentDetails, struct {
 			ID         uint64 `json:"id"`
 			WeekNumber int64  `json:"week_number"`
 			DueDate    string `json:"due_date"`
 			AmountDue  string `json:"amount_due"`
+			FeeAmount  string `json:"fee_amount"`
 			Status     string `json:"status"`
 		}{
 			ID:         inst.ID,
 			WeekNumber: inst.WeekNumber,
 			DueDate:    inst.DueDate,
 			AmountDue:  inst.AmountDue,
+			FeeAmount:  inst.FeeAmount,
 			Status:     string(inst.Status),
 		})
 	}
@@ -124,6 +140,7 @@ func (g *GetOutstandingInteractor) Execute(ctx context.Context, customerID uint6
 	// Convert calculated amounts back to string format
 	totalPaid = strconv.FormatFloat(totalPaidAmount, 'f', 2, 64)
 	totalMissed = strconv.FormatFloat(totalMissedAmount, 'f', 2, 64)
+	totalFees := strconv.FormatFloat(totalFeesAmount, 'f', 2, 64)
 	totalAmount = outstandingAmount // This should be the total loan amount
 
 	output := usecases.GetOutstandingOutput{
@@ -133,10 +150,12 @@ func (g *GetOutstandingInteractor) Execute(ctx context.Context, customerID uint6
 			TotalAmount string `json:"total_amount"`
 			TotalPaid   string `json:"total_paid"`
 			TotalMissed string `json:"total_missed"`
+			TotalFees   string `json:"total_fees"`
 		}{
 			TotalAmount: totalAmount,
 			TotalPaid:   totalPaid,
 			TotalMissed: totalMissed,
+			TotalFees:   totalFees,
 		},
 		Installments: installmentDetails,
 	}
@@ -33,8 +33,8 @@ func TestGetOutstandingInteractor_Execute(t *testing.T) {
 				mockRepo.On("GetOutstandingString", mock.Anything, uint64(10)).Return("5000000", nil)
 				installments := []entity.Installment{
 					{ID: 1, WeekNumber: 1, DueDate: "2024-06-01", AmountDue: "100000", Status: entity.INSTALLMENT_PAID},
-					{ID: 2, WeekNumber: 2, DueDate: "2024-06-08", AmountDue: "100000", Status: entity.INSTALLMENT_MISSED},
-					{ID: 3, WeekNumber: 3, DueDate: "2024-06-15", AmountDue: "100000", Status: entity.INSTALLMENT_PENDING},
+					{ID: 2, WeekNumber: 2, DueDate: "2024-06-08", AmountDue: "100000", FeeAmount: "5000.00", Status: entity.INSTALLMENT_MISSED},
+					{ID: 3, WeekNumber: 3, DueDate: "2024-06-15", AmountDue: "100000", FeeAmount: "5000.00", Status: entity.INSTALLMENT_PENDING},
 				}
 				mockRepo.On("GetAllInstallments", mock.Anything, uint64(10)).Return(installments, nil)
 			},
@@ -46,21 +46,24 @@ func TestGetOutstandingInteractor_Execute(t *testing.T) {
 						TotalAmount string `json:"total_amount"`
 						TotalPaid   string `json:"total_paid"`
 						TotalMissed string `json:"total_missed"`
+						TotalFees   string `json:"total_fees"`
 					}{
 						TotalAmount: "5000000",
 						TotalPaid:   "100000.00",
-						TotalMissed: "100000.00",
+						TotalMissed: "105000.00",
+						TotalFees:   "10000.00",
 					},
 					Installments: []struct {
 						ID         uint64 `json:"id"`
 						WeekNumber int64  `json:"week_number"`
 						DueDate    string `json:"due_date"`
 						AmountDue  string `json:"amount_due"`
+						FeeAmount  string `json:"fee_amount"`
 						Status     string `json:"status"`
 					}{
 						{ID: 1, WeekNumber: 1, DueDate: "2024-06-01", AmountDue: "100000", Status: string(entity.INSTALLMENT_PAID)},
-						{ID: 2, WeekNumber: 2, DueDate: "2024-06-08", AmountDue: "100000", Status: string(entity.INSTALLMENT_MISSED)},
-						{ID: 3, WeekNumber: 3, DueDate: "2024-06-15", AmountDue: "100000", Status: string(entity.INSTALLMENT_PENDING)},
+						{ID: 2, WeekNumber: 2, DueDate: "2024-06-08", AmountDue: "100000", FeeAmount: "5000.00", Status: string(entity.INSTALLMENT_MISSED)},
+						{ID: 3, WeekNumber: 3, DueDate: "2024-06-15", AmountDue: "100000", FeeAmount: "5000.00", Status: string(entity.INSTALLMENT_PENDING)},
 					},
 				}
 			}(),
@@ -84,10 +87,12 @@ func TestGetOutstandingInteractor_Execute(t *testing.T) {
 						TotalAmount string `json:"total_amount"`
 						TotalPaid   string `json:"total_paid"`
 						TotalMissed string `json:"total_missed"`
+						TotalFees   string `json:"total_fees"`
 					}{
 						TotalAmount: "0",
 						TotalPaid:   "0.00",
 						TotalMissed: "0.00",
+						TotalFees:   "0.00",
 					},
 					Installments: nil,
 				}
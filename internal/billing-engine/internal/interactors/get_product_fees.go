@@ -0,0 +1,81 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetProductFeesUsecase = (*GetProductFeesInteractor)(nil)
+
+type (
+	GetProductFeesRepository interface {
+		GetProductFees(ctx context.Context, productCode string) ([]entity.ProductFee, error)
+	}
+
+	GetProductFeesInteractorDependencies struct {
+		GetProductFeesRepository GetProductFeesRepository
+		Logger                   *zap.SugaredLogger
+		Validator                *validator.Validate
+	}
+
+	GetProductFeesInteractor struct {
+		repository GetProductFeesRepository `validate:"required"`
+		logger     *zap.SugaredLogger       `validate:"required"`
+		validator  *validator.Validate      `validate:"required"`
+	}
+)
+
+func NewGetProductFeesInteractor(
+	deps GetProductFeesInteractorDependencies,
+) *GetProductFeesInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetProductFeesInteractor{
+		repository: deps.GetProductFeesRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.GetProductFeesUsecase.
+func (g *GetProductFeesInteractor) Execute(ctx context.Context, input usecases.GetProductFeesInput) (usecases.GetProductFeesOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GetProductFeesOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	fees, err := g.repository.GetProductFees(ctx, input.ProductCode)
+	if err != nil {
+		g.logger.Errorw("failed to get product fees", "error", err, "product_code", input.ProductCode)
+		return usecases.GetProductFeesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetProductFeesOutput{
+		Fees: make([]usecases.ProductFeeOutput, len(fees)),
+	}
+	for i, fee := range fees {
+		output.Fees[i] = toProductFeeOutput(fee)
+	}
+
+	return output, nil
+}
+
+func toProductFeeOutput(fee entity.ProductFee) usecases.ProductFeeOutput {
+	return usecases.ProductFeeOutput{
+		ProductCode: fee.ProductCode,
+		FeeType:     string(fee.FeeType),
+		Calculation: string(fee.Calculation),
+		Value:       fee.Value.String(),
+		Collection:  string(fee.Collection),
+		UpdatedAt:   fee.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,84 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetProductFeesInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         usecases.GetProductFeesInput
+		setupMocks    func(*billingenginemocks.MockGetProductFeesRepository)
+		expectedFees  int
+		expectedError error
+	}{
+		{
+			name:  "success - fees of a product",
+			input: usecases.GetProductFeesInput{ProductCode: "DEFAULT"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetProductFeesRepository) {
+				mockRepo.On("GetProductFees", mock.Anything, "DEFAULT").Return([]entity.ProductFee{
+					{ProductCode: "DEFAULT", FeeType: entity.FEE_ADMIN, Calculation: entity.FEE_FLAT, Value: decimal.NewFromInt(50000), Collection: entity.FEE_DEDUCTED},
+					{ProductCode: "DEFAULT", FeeType: entity.FEE_ORIGINATION, Calculation: entity.FEE_PERCENTAGE, Value: decimal.NewFromFloat(0.02), Collection: entity.FEE_DEDUCTED},
+				}, nil)
+			},
+			expectedFees: 2,
+		},
+		{
+			name:  "success - no fees defined",
+			input: usecases.GetProductFeesInput{},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetProductFeesRepository) {
+				mockRepo.On("GetProductFees", mock.Anything, "").Return(nil, nil)
+			},
+			expectedFees: 0,
+		},
+		{
+			name:  "error - repository error on GetProductFees",
+			input: usecases.GetProductFeesInput{ProductCode: "DEFAULT"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetProductFeesRepository) {
+				mockRepo.On("GetProductFees", mock.Anything, "DEFAULT").Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetProductFeesRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetProductFeesInteractor(GetProductFeesInteractorDependencies{
+				GetProductFeesRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+				Validator:                validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, output.Fees, tt.expectedFees)
+			if tt.expectedFees > 0 {
+				assert.Equal(t, "ADMIN", output.Fees[0].FeeType)
+				assert.Equal(t, "0.02", output.Fees[1].Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetProvisionRatesUsecase = (*GetProvisionRatesInteractor)(nil)
+
+type (
+	GetProvisionRatesRepository interface {
+		GetProvisionRates(ctx context.Context) ([]entity.ProvisionRate, error)
+	}
+
+	GetProvisionRatesInteractorDependencies struct {
+		GetProvisionRatesRepository GetProvisionRatesRepository
+		Logger                      *zap.SugaredLogger
+	}
+
+	GetProvisionRatesInteractor struct {
+		repository GetProvisionRatesRepository `validate:"required"`
+		logger     *zap.SugaredLogger          `validate:"required"`
+	}
+)
+
+func NewGetProvisionRatesInteractor(
+	deps GetProvisionRatesInteractorDependencies,
+) *GetProvisionRatesInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetProvisionRatesInteractor{
+		repository: deps.GetProvisionRatesRepository,
+		logger:     deps.Logger,
+	}
+}
+
+// Execute implements usecases.GetProvisionRatesUsecase.
+func (g *GetProvisionRatesInteractor) Execute(ctx context.Context) (usecases.GetProvisionRatesOutput, error) {
+	rates, err := g.repository.GetProvisionRates(ctx)
+	if err != nil {
+		g.logger.Errorw("failed to get provision rates", "error", err)
+		return usecases.GetProvisionRatesOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetProvisionRatesOutput{
+		Rates: make([]usecases.ProvisionRateOutput, len(rates)),
+	}
+	for i, rate := range rates {
+		output.Rates[i] = toProvisionRateOutput(rate)
+	}
+
+	return output, nil
+}
+
+func toProvisionRateOutput(rate entity.ProvisionRate) usecases.ProvisionRateOutput {
+	return usecases.ProvisionRateOutput{
+		ProductCode: rate.ProductCode,
+		Stage:       int64(rate.Stage),
+		PD:          rate.PD.StringFixed(4),
+		LGD:         rate.LGD.StringFixed(4),
+		UpdatedAt:   rate.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,73 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetProvisionRatesInteractor_Execute(t *testing.T) {
+	updatedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*billingenginemocks.MockGetProvisionRatesRepository)
+		expectedOutput usecases.GetProvisionRatesOutput
+		expectedError  error
+	}{
+		{
+			name: "success - rates listed",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetProvisionRatesRepository) {
+				mockRepo.On("GetProvisionRates", mock.Anything).Return([]entity.ProvisionRate{
+					{ProductCode: "DEFAULT", Stage: entity.ECL_STAGE_3, PD: decimal.NewFromInt(1), LGD: decimal.RequireFromString("0.6"), UpdatedAt: updatedAt},
+				}, nil)
+			},
+			expectedOutput: usecases.GetProvisionRatesOutput{
+				Rates: []usecases.ProvisionRateOutput{
+					{ProductCode: "DEFAULT", Stage: 3, PD: "1.0000", LGD: "0.6000", UpdatedAt: "2024-03-01T09:00:00Z"},
+				},
+			},
+		},
+		{
+			name: "error - repository error on GetProvisionRates",
+			setupMocks: func(mockRepo *billingenginemocks.MockGetProvisionRatesRepository) {
+				mockRepo.On("GetProvisionRates", mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetProvisionRatesRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetProvisionRatesInteractor(GetProvisionRatesInteractorDependencies{
+				GetProvisionRatesRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+			})
+
+			output, err := interactor.Execute(context.Background())
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
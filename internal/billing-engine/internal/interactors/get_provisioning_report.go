@@ -0,0 +1,170 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetProvisioningReportUsecase = (*GetProvisioningReportInteractor)(nil)
+
+type (
+	GetProvisioningReportRepository interface {
+		GetLoanProvisions(ctx context.Context, period string) ([]entity.LoanProvision, error)
+	}
+
+	GetProvisioningReportInteractorDependencies struct {
+		GetProvisioningReportRepository GetProvisioningReportRepository
+		Logger                          *zap.SugaredLogger
+		Validator                       *validator.Validate
+	}
+
+	GetProvisioningReportInteractor struct {
+		repository GetProvisioningReportRepository `validate:"required"`
+		logger     *zap.SugaredLogger              `validate:"required"`
+		validator  *validator.Validate             `validate:"required"`
+	}
+
+	provisioningLineKey struct {
+		productCode string
+		stage       entity.ECLStage
+	}
+)
+
+func NewGetProvisioningReportInteractor(
+	deps GetProvisioningReportInteractorDependencies,
+) *GetProvisioningReportInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetProvisioningReportInteractor{
+		repository: deps.GetProvisioningReportRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.GetProvisioningReportUsecase.
+//
+// The report sums the month's provisions per product and stage and explains
+// the change from the previous month's closing provision. A previous month
+// that was never run opens at zero.
+func (g *GetProvisioningReportInteractor) Execute(ctx context.Context, input usecases.GetProvisioningReportInput) (usecases.GetProvisioningReportOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GetProvisioningReportOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	month, err := parseMonth(input.Month)
+	if err != nil {
+		return usecases.GetProvisioningReportOutput{}, err
+	}
+
+	period := month.Format(monthLayout)
+	previousPeriod := month.AddDate(0, -1, 0).Format(monthLayout)
+
+	current, err := g.repository.GetLoanProvisions(ctx, period)
+	if err != nil {
+		g.logger.Errorw("failed to get loan provisions", "error", err, "period", period)
+		return usecases.GetProvisioningReportOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if len(current) == 0 {
+		return usecases.GetProvisioningReportOutput{}, pkgerror.NewBusinessError(fmt.Sprintf("provisioning for %s has not been run", period))
+	}
+
+	previous, err := g.repository.GetLoanProvisions(ctx, previousPeriod)
+	if err != nil {
+		g.logger.Errorw("failed to get loan provisions", "error", err, "period", previousPeriod)
+		return usecases.GetProvisioningReportOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetProvisioningReportOutput{
+		Month:         period,
+		PreviousMonth: previousPeriod,
+		OpeningECL:    sumECL(previous).StringFixed(2),
+		ClosingECL:    sumECL(current).StringFixed(2),
+	}
+
+	output.Lines, output.TotalExposure, output.TotalECL = toProvisioningLines(current)
+
+	for _, movement := range entity.ProvisionMovements(previous, current) {
+		output.Movements = append(output.Movements, usecases.ProvisionMovementOutput{
+			Type:   string(movement.Type),
+			Loans:  movement.Loans,
+			Amount: movement.Amount.StringFixed(2),
+		})
+	}
+
+	return output, nil
+}
+
+func toProvisioningLines(provisions []entity.LoanProvision) ([]usecases.ProvisioningLineOutput, string, string) {
+	type line struct {
+		loans    int
+		exposure decimal.Decimal
+		ecl      decimal.Decimal
+	}
+
+	lines := make(map[provisioningLineKey]*line)
+	var keys []provisioningLineKey
+	totalExposure, totalECL := decimal.Zero, decimal.Zero
+
+	for _, provision := range provisions {
+		key := provisioningLineKey{productCode: provision.ProductCode, stage: provision.Stage}
+		if lines[key] == nil {
+			lines[key] = &line{exposure: decimal.Zero, ecl: decimal.Zero}
+			keys = append(keys, key)
+		}
+
+		lines[key].loans++
+		lines[key].exposure = lines[key].exposure.Add(provision.ExposureAtDefault)
+		lines[key].ecl = lines[key].ecl.Add(provision.ECL)
+		totalExposure = totalExposure.Add(provision.ExposureAtDefault)
+		totalECL = totalECL.Add(provision.ECL)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].productCode != keys[j].productCode {
+			return keys[i].productCode < keys[j].productCode
+		}
+		return keys[i].stage < keys[j].stage
+	})
+
+	output := make([]usecases.ProvisioningLineOutput, len(keys))
+	for i, key := range keys {
+		coverage := decimal.Zero
+		if lines[key].exposure.IsPositive() {
+			coverage = lines[key].ecl.Div(lines[key].exposure)
+		}
+
+		output[i] = usecases.ProvisioningLineOutput{
+			ProductCode:       key.productCode,
+			Stage:             int64(key.stage),
+			Loans:             lines[key].loans,
+			ExposureAtDefault: lines[key].exposure.StringFixed(2),
+			ECL:               lines[key].ecl.StringFixed(2),
+			CoverageRatio:     coverage.StringFixed(4),
+		}
+	}
+
+	return output, totalExposure.StringFixed(2), totalECL.StringFixed(2)
+}
+
+func sumECL(provisions []entity.LoanProvision) decimal.Decimal {
+	total := decimal.Zero
+	for _, provision := range provisions {
+		total = total.Add(provision.ECL)
+	}
+
+	return total
+}
@@ -0,0 +1,120 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetProvisioningReportInteractor_Execute(t *testing.T) {
+	provision := func(loanID uint64, stage entity.ECLStage, ead int64, ecl int64) entity.LoanProvision {
+		return entity.LoanProvision{
+			LoanID: loanID, ProductCode: entity.DEFAULT_LOAN_PRODUCT, Stage: stage,
+			ExposureAtDefault: decimal.NewFromInt(ead), ECL: decimal.NewFromInt(ecl),
+		}
+	}
+
+	february := []entity.LoanProvision{
+		provision(1, entity.ECL_STAGE_1, 1000000, 9000),
+		provision(2, entity.ECL_STAGE_1, 500000, 4500),
+		provision(3, entity.ECL_STAGE_2, 300000, 20250),
+	}
+	march := []entity.LoanProvision{
+		provision(1, entity.ECL_STAGE_1, 800000, 7200),
+		provision(2, entity.ECL_STAGE_2, 400000, 27000),
+		provision(4, entity.ECL_STAGE_1, 1000000, 9000),
+	}
+
+	tests := []struct {
+		name           string
+		input          usecases.GetProvisioningReportInput
+		setupMocks     func(*billingenginemocks.MockGetProvisioningReportRepository)
+		expectedOutput usecases.GetProvisioningReportOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - stages summed and movements reconcile opening to closing",
+			input: usecases.GetProvisioningReportInput{Month: "2024-03"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetProvisioningReportRepository) {
+				mockRepo.On("GetLoanProvisions", mock.Anything, "2024-03").Return(march, nil)
+				mockRepo.On("GetLoanProvisions", mock.Anything, "2024-02").Return(february, nil)
+			},
+			expectedOutput: usecases.GetProvisioningReportOutput{
+				Month:         "2024-03",
+				PreviousMonth: "2024-02",
+				Lines: []usecases.ProvisioningLineOutput{
+					{ProductCode: "STANDARD", Stage: 1, Loans: 2, ExposureAtDefault: "1800000.00", ECL: "16200.00", CoverageRatio: "0.0090"},
+					{ProductCode: "STANDARD", Stage: 2, Loans: 1, ExposureAtDefault: "400000.00", ECL: "27000.00", CoverageRatio: "0.0675"},
+				},
+				TotalExposure: "2200000.00",
+				TotalECL:      "43200.00",
+				OpeningECL:    "33750.00",
+				Movements: []usecases.ProvisionMovementOutput{
+					{Type: "NEW", Loans: 1, Amount: "9000.00"},
+					{Type: "DETERIORATION", Loans: 1, Amount: "22500.00"},
+					{Type: "IMPROVEMENT", Loans: 0, Amount: "0.00"},
+					{Type: "REMEASUREMENT", Loans: 1, Amount: "-1800.00"},
+					{Type: "DERECOGNISED", Loans: 1, Amount: "-20250.00"},
+				},
+				ClosingECL: "43200.00",
+			},
+		},
+		{
+			name:  "error - month not provisioned",
+			input: usecases.GetProvisioningReportInput{Month: "2024-03"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetProvisioningReportRepository) {
+				mockRepo.On("GetLoanProvisions", mock.Anything, "2024-03").Return(nil, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (missing month)",
+			input:         usecases.GetProvisioningReportInput{},
+			setupMocks:    func(*billingenginemocks.MockGetProvisioningReportRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on GetLoanProvisions",
+			input: usecases.GetProvisioningReportInput{Month: "2024-03"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetProvisioningReportRepository) {
+				mockRepo.On("GetLoanProvisions", mock.Anything, "2024-03").Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetProvisioningReportRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetProvisioningReportInteractor(GetProvisioningReportInteractorDependencies{
+				GetProvisioningReportRepository: mockRepo,
+				Logger:                          zap.NewNop().Sugar(),
+				Validator:                       validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetRecoveryReportUsecase = (*GetRecoveryReportInteractor)(nil)
+
+type (
+	GetRecoveryReportRepository interface {
+		GetRecoverySummaries(ctx context.Context, from time.Time, to time.Time) ([]entity.LoanRecoverySummary, error)
+	}
+
+	GetRecoveryReportInteractorDependencies struct {
+		GetRecoveryReportRepository GetRecoveryReportRepository
+		Logger                      *zap.SugaredLogger
+		Validator                   *validator.Validate
+	}
+
+	GetRecoveryReportInteractor struct {
+		repository GetRecoveryReportRepository `validate:"required"`
+		logger     *zap.SugaredLogger          `validate:"required"`
+		validator  *validator.Validate         `validate:"required"`
+	}
+)
+
+func NewGetRecoveryReportInteractor(
+	deps GetRecoveryReportInteractorDependencies,
+) *GetRecoveryReportInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetRecoveryReportInteractor{
+		repository: deps.GetRecoveryReportRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.GetRecoveryReportUsecase.
+//
+// Both ends of the period are inclusive; recoveries are summarised per loan.
+func (g *GetRecoveryReportInteractor) Execute(ctx context.Context, input usecases.GetRecoveryReportInput) (usecases.GetRecoveryReportOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GetRecoveryReportOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	from, err := parseAsOfDate(input.From)
+	if err != nil {
+		return usecases.GetRecoveryReportOutput{}, err
+	}
+
+	to, err := parseAsOfDate(input.To)
+	if err != nil {
+		return usecases.GetRecoveryReportOutput{}, err
+	}
+
+	if to.Before(from) {
+		return usecases.GetRecoveryReportOutput{}, pkgerror.NewValidationError("to must not be before from")
+	}
+
+	summaries, err := g.repository.GetRecoverySummaries(ctx, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		g.logger.Errorw("failed to get recovery summaries", "error", err, "from", input.From, "to", input.To)
+		return usecases.GetRecoveryReportOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.GetRecoveryReportOutput{
+		From:  from.Format(dateLayout),
+		To:    to.Format(dateLayout),
+		Loans: make([]usecases.LoanRecoverySummaryOutput, len(summaries)),
+	}
+
+	recovered := decimal.Zero
+	for i, summary := range summaries {
+		recovered = recovered.Add(summary.Recovered)
+		output.TotalRecoveries += summary.RecoveriesCount
+		output.Loans[i] = usecases.LoanRecoverySummaryOutput{
+			LoanID:           summary.LoanID,
+			CustomerID:       summary.CustomerID,
+			WrittenOffAmount: summary.WrittenOff.StringFixed(2),
+			RecoveredAmount:  summary.Recovered.StringFixed(2),
+			RecoveriesCount:  summary.RecoveriesCount,
+		}
+	}
+
+	output.TotalRecovered = recovered.StringFixed(2)
+
+	return output, nil
+}
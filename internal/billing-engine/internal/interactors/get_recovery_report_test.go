@@ -0,0 +1,96 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetRecoveryReportInteractor_Execute(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name           string
+		input          usecases.GetRecoveryReportInput
+		setupMocks     func(*billingenginemocks.MockGetRecoveryReportRepository)
+		expectedOutput usecases.GetRecoveryReportOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - recoveries are summarised per loan over an inclusive period",
+			input: usecases.GetRecoveryReportInput{From: "2024-03-01", To: "2024-03-31"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetRecoveryReportRepository) {
+				mockRepo.On("GetRecoverySummaries", mock.Anything, from, to).Return([]entity.LoanRecoverySummary{
+					{LoanID: 100, CustomerID: 1, WrittenOff: decimal.NewFromInt(220000), Recovered: decimal.NewFromInt(70000), RecoveriesCount: 2},
+					{LoanID: 101, CustomerID: 2, WrittenOff: decimal.NewFromInt(110000), Recovered: decimal.NewFromInt(10000), RecoveriesCount: 1},
+				}, nil)
+			},
+			expectedOutput: usecases.GetRecoveryReportOutput{
+				From: "2024-03-01",
+				To:   "2024-03-31",
+				Loans: []usecases.LoanRecoverySummaryOutput{
+					{LoanID: 100, CustomerID: 1, WrittenOffAmount: "220000.00", RecoveredAmount: "70000.00", RecoveriesCount: 2},
+					{LoanID: 101, CustomerID: 2, WrittenOffAmount: "110000.00", RecoveredAmount: "10000.00", RecoveriesCount: 1},
+				},
+				TotalRecovered:  "80000.00",
+				TotalRecoveries: 3,
+			},
+		},
+		{
+			name:          "error - validation error (missing from)",
+			input:         usecases.GetRecoveryReportInput{To: "2024-03-31"},
+			setupMocks:    func(*billingenginemocks.MockGetRecoveryReportRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - to before from",
+			input:         usecases.GetRecoveryReportInput{From: "2024-03-31", To: "2024-03-01"},
+			setupMocks:    func(*billingenginemocks.MockGetRecoveryReportRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error",
+			input: usecases.GetRecoveryReportInput{From: "2024-03-01", To: "2024-03-31"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetRecoveryReportRepository) {
+				mockRepo.On("GetRecoverySummaries", mock.Anything, from, to).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetRecoveryReportRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetRecoveryReportInteractor(GetRecoveryReportInteractorDependencies{
+				GetRecoveryReportRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+				Validator:                   validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package interactors
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgpdf"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetStatementUsecase = (*GetStatementInteractor)(nil)
+
+const (
+	statementFormatJSON = "json"
+	statementFormatCSV  = "csv"
+	statementFormatPDF  = "pdf"
+)
+
+type (
+	GetStatementRepository interface {
+		GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error)
+		IsLoanBelongsToCustomer(ctx context.Context, customerID uint64, loanID uint64) (bool, error)
+		GetStatementOpeningBalance(ctx context.Context, customerID uint64, loanID uint64, before time.Time) (decimal.Decimal, error)
+		GetStatementEntries(ctx context.Context, customerID uint64, loanID uint64, from time.Time, to time.Time) ([]entity.JournalEntry, error)
+	}
+
+	GetStatementInteractorDependencies struct {
+		GetStatementRepository GetStatementRepository
+		Logger                 *zap.SugaredLogger
+		Validator              *validator.Validate
+	}
+
+	GetStatementInteractor struct {
+		repository GetStatementRepository `validate:"required"`
+		logger     *zap.SugaredLogger     `validate:"required"`
+		validator  *validator.Validate    `validate:"required"`
+	}
+
+	statementDocument struct {
+		CustomerID     uint64                  `json:"customer_id"`
+		CustomerName   string                  `json:"customer_name"`
+		LoanID         uint64                  `json:"loan_id,omitempty"`
+		From           string                  `json:"from"`
+		To             string                  `json:"to"`
+		OpeningBalance string                  `json:"opening_balance"`
+		TotalCharges   string                  `json:"total_charges"`
+		TotalPayments  string                  `json:"total_payments"`
+		TotalReversals string                  `json:"total_reversals"`
+		TotalWriteOffs string                  `json:"total_write_offs"`
+		ClosingBalance string                  `json:"closing_balance"`
+		Lines          []statementLineDocument `json:"lines"`
+	}
+
+	statementLineDocument struct {
+		Date        string `json:"date"`
+		LoanID      uint64 `json:"loan_id"`
+		Type        string `json:"type"`
+		Reference   string `json:"reference"`
+		Description string `json:"description"`
+		Debit       string `json:"debit"`
+		Credit      string `json:"credit"`
+		Balance     string `json:"balance"`
+	}
+)
+
+func NewGetStatementInteractor(
+	deps GetStatementInteractorDependencies,
+) *GetStatementInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetStatementInteractor{
+		repository: deps.GetStatementRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.GetStatementUsecase.
+//
+// The statement is read from the ledger: the opening balance is what the
+// customer owed before from, and every entry up to to is a line.
+func (g *GetStatementInteractor) Execute(ctx context.Context, input usecases.GetStatementInput) (usecases.GetStatementOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GetStatementOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	to, err := parseAsOfDate(input.To)
+	if err != nil {
+		return usecases.GetStatementOutput{}, err
+	}
+
+	from, err := parseOptionalDate(input.From)
+	if err != nil {
+		return usecases.GetStatementOutput{}, err
+	}
+	if from.IsZero() {
+		from = time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, to.Location())
+	}
+
+	if to.Before(from) {
+		return usecases.GetStatementOutput{}, pkgerror.NewValidationError("to must not be before from")
+	}
+
+	format := input.Format
+	if format == "" {
+		format = statementFormatJSON
+	}
+
+	customer, err := g.repository.GetCustomer(ctx, input.CustomerID)
+	if err != nil {
+		g.logger.Errorw("failed to get customer", "error", err, "customer_id", input.CustomerID)
+		return usecases.GetStatementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if input.LoanID != 0 {
+		isLoanBelongsToCustomer, err := g.repository.IsLoanBelongsToCustomer(ctx, input.CustomerID, input.LoanID)
+		if err != nil {
+			g.logger.Errorw("failed to check if loan belongs to customer", "error", err, "customer_id", input.CustomerID, "loan_id", input.LoanID)
+			return usecases.GetStatementOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		if !isLoanBelongsToCustomer {
+			return usecases.GetStatementOutput{}, pkgerror.NewBusinessError("loan not found or does not belong to customer")
+		}
+	}
+
+	openingBalance, err := g.repository.GetStatementOpeningBalance(ctx, input.CustomerID, input.LoanID, from)
+	if err != nil {
+		g.logger.Errorw("failed to get statement opening balance", "error", err, "customer_id", input.CustomerID)
+		return usecases.GetStatementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	entries, err := g.repository.GetStatementEntries(ctx, input.CustomerID, input.LoanID, from, to)
+	if err != nil {
+		g.logger.Errorw("failed to get statement entries", "error", err, "customer_id", input.CustomerID)
+		return usecases.GetStatementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	statement := entity.NewStatement(input.CustomerID, input.LoanID, from, to, openingBalance, entries)
+
+	output := usecases.GetStatementOutput{
+		CustomerID:     input.CustomerID,
+		LoanID:         input.LoanID,
+		From:           from.Format(dateLayout),
+		To:             to.Format(dateLayout),
+		Format:         format,
+		FileName:       fmt.Sprintf("statement-%d-%s-%s.%s", input.CustomerID, from.Format(dateLayout), to.Format(dateLayout), format),
+		OpeningBalance: statement.OpeningBalance.StringFixed(2),
+		ClosingBalance: statement.ClosingBalance.StringFixed(2),
+	}
+
+	switch format {
+	case statementFormatCSV:
+		output.ContentType = "text/csv; charset=utf-8"
+		output.Content, err = renderStatementCSV(statement)
+	case statementFormatPDF:
+		output.ContentType = "application/pdf"
+		output.Content = renderStatementPDF(customer, statement)
+	default:
+		output.ContentType = "application/json; charset=utf-8"
+		output.Content, err = renderStatementJSON(customer, statement)
+	}
+	if err != nil {
+		g.logger.Errorw("failed to render statement", "error", err, "customer_id", input.CustomerID, "format", format)
+		return usecases.GetStatementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return output, nil
+}
+
+func renderStatementJSON(customer entity.Customer, statement entity.Statement) ([]byte, error) {
+	document := statementDocument{
+		CustomerID:     statement.CustomerID,
+		CustomerName:   customer.Name,
+		LoanID:         statement.LoanID,
+		From:           statement.From.Format(dateLayout),
+		To:             statement.To.Format(dateLayout),
+		OpeningBalance: statement.OpeningBalance.StringFixed(2),
+		TotalCharges:   statement.TotalCharges.StringFixed(2),
+		TotalPayments:  statement.TotalPayments.StringFixed(2),
+		TotalReversals: statement.TotalReversals.StringFixed(2),
+		TotalWriteOffs: statement.TotalWriteOffs.StringFixed(2),
+		ClosingBalance: statement.ClosingBalance.StringFixed(2),
+		Lines:          make([]statementLineDocument, len(statement.Lines)),
+	}
+
+	for i, line := range statement.Lines {
+		document.Lines[i] = statementLineDocument{
+			Date:        line.Date.Format(dateLayout),
+			LoanID:      line.LoanID,
+			Type:        string(line.Type),
+			Reference:   line.Reference,
+			Description: line.Description,
+			Debit:       line.Debit.StringFixed(2),
+			Credit:      line.Credit.StringFixed(2),
+			Balance:     line.Balance.StringFixed(2),
+		}
+	}
+
+	return json.MarshalIndent(document, "", "  ")
+}
+
+// renderStatementCSV writes an OPENING record, one LINE record per line and
+// a CLOSING record, each carrying the balance after it.
+func renderStatementCSV(statement entity.Statement) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	records := [][]string{
+		{"record_type", "date", "loan_id", "type", "reference", "description", "debit", "credit", "balance"},
+		{"OPENING", statement.From.Format(dateLayout), "", "", "", "Opening balance", "", "", statement.OpeningBalance.StringFixed(2)},
+	}
+
+	for _, line := range statement.Lines {
+		records = append(records, []string{
+			"LINE",
+			line.Date.Format(dateLayout),
+			strconv.FormatUint(line.LoanID, 10),
+			string(line.Type),
+			line.Reference,
+			line.Description,
+			line.Debit.StringFixed(2),
+			line.Credit.StringFixed(2),
+			line.Balance.StringFixed(2),
+		})
+	}
+
+	records = append(records, []string{
+		"CLOSING", statement.To.Format(dateLayout), "", "", "", "Closing balance", "", "", statement.ClosingBalance.StringFixed(2),
+	})
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// statementPDFRow lays a line out in the fixed width columns of the PDF.
+const statementPDFRow = "%-10s %-19s %-9s %-18.18s %14s %14s %15s"
+
+func renderStatementPDF(customer entity.Customer, statement entity.Statement) []byte {
+	document := pkgpdf.NewDocument(fmt.Sprintf("Statement %d %s to %s", statement.CustomerID, statement.From.Format(dateLayout), statement.To.Format(dateLayout)))
+
+	loan := "All loans"
+	if statement.LoanID != 0 {
+		loan = strconv.FormatUint(statement.LoanID, 10)
+	}
+
+	for _, line := range []string{
+		"STATEMENT OF ACCOUNT",
+		"",
+		fmt.Sprintf("Customer : %s (%d)", customer.Name, statement.CustomerID),
+		fmt.Sprintf("Loan     : %s", loan),
+		fmt.Sprintf("Period   : %s to %s", statement.From.Format(dateLayout), statement.To.Format(dateLayout)),
+		"",
+		fmt.Sprintf("Opening balance %20s", statement.OpeningBalance.StringFixed(2)),
+		fmt.Sprintf("Charges         %20s", statement.TotalCharges.StringFixed(2)),
+		fmt.Sprintf("Payments        %20s", statement.TotalPayments.StringFixed(2)),
+		fmt.Sprintf("Reversals       %20s", statement.TotalReversals.StringFixed(2)),
+		fmt.Sprintf("Write-offs      %20s", statement.TotalWriteOffs.StringFixed(2)),
+		fmt.Sprintf("Closing balance %20s", statement.ClosingBalance.StringFixed(2)),
+		"",
+	} {
+		document.AddLine(line)
+	}
+
+	header := fmt.Sprintf(statementPDFRow, "Date", "Loan", "Type", "Description", "Debit", "Credit", "Balance")
+	document.AddLine(header)
+	document.AddLine(strings.Repeat("-", len(header)))
+	document.AddLine(fmt.Sprintf(statementPDFRow, statement.From.Format(dateLayout), "", "", "Opening balance", "", "", statement.OpeningBalance.StringFixed(2)))
+
+	for _, line := range statement.Lines {
+		document.AddLine(fmt.Sprintf(statementPDFRow,
+			line.Date.Format(dateLayout),
+			strconv.FormatUint(line.LoanID, 10),
+			string(line.Type),
+			line.Description,
+			line.Debit.StringFixed(2),
+			line.Credit.StringFixed(2),
+			line.Balance.StringFixed(2),
+		))
+	}
+
+	document.AddLine(fmt.Sprintf(statementPDFRow, statement.To.Format(dateLayout), "", "", "Closing balance", "", "", statement.ClosingBalance.StringFixed(2)))
+
+	return document.Bytes()
+}
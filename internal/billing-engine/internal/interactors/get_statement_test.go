@@ -0,0 +1,175 @@
+package interactors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetStatementInteractor_Execute(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.Local)
+	customer := entity.Customer{ID: 1, Name: "Budi Santoso", Email: "budi@example.com"}
+
+	payment := entity.NewPaymentEntry(14, 7, 1, decimal.NewFromInt(100000), decimal.NewFromInt(10000), decimal.Zero, from.AddDate(0, 0, 7))
+	entries := []entity.JournalEntry{
+		entity.NewFeeEntry(10, 7, "fee-ADMIN", decimal.NewFromInt(500000), from),
+		entity.NewDisbursementEntry(11, entity.Loan{ID: 7, PrincipalAmount: decimal.NewFromInt(5000000), StartDate: from}, decimal.NewFromInt(500000)),
+		entity.NewAccrualEntry(12, 7, from.AddDate(0, 0, 1), decimal.NewFromInt(1000)),
+		entity.NewAccrualEntry(13, 7, from.AddDate(0, 0, 2), decimal.NewFromInt(1000)),
+		payment,
+		payment.Reversal(15, from.AddDate(0, 0, 8), "Payment reversal: bounced"),
+		entity.NewRecoveryEntry(16, entity.Recovery{ID: 3, LoanID: 7, Amount: decimal.NewFromInt(50000), ReceivedAt: from.AddDate(0, 0, 9)}),
+	}
+
+	tests := []struct {
+		name           string
+		input          usecases.GetStatementInput
+		setupMocks     func(*billingenginemocks.MockGetStatementRepository)
+		expectedOutput usecases.GetStatementOutput
+		expectedBody   string
+		expectedCheck  func(*testing.T, []byte)
+		expectedError  error
+	}{
+		{
+			name:  "success - csv with charges, payments and reversals",
+			input: usecases.GetStatementInput{CustomerID: 1, From: "2024-03-01", To: "2024-03-31", Format: "csv"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetStatementRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(customer, nil)
+				mockRepo.On("GetStatementOpeningBalance", mock.Anything, uint64(1), uint64(0), from).Return(decimal.Zero, nil)
+				mockRepo.On("GetStatementEntries", mock.Anything, uint64(1), uint64(0), from, to).Return(entries, nil)
+			},
+			expectedOutput: usecases.GetStatementOutput{
+				CustomerID: 1, From: "2024-03-01", To: "2024-03-31", Format: "csv",
+				FileName: "statement-1-2024-03-01-2024-03-31.csv", ContentType: "text/csv; charset=utf-8",
+				OpeningBalance: "0.00", ClosingBalance: "5002000.00",
+			},
+			expectedBody: "record_type,date,loan_id,type,reference,description,debit,credit,balance\n" +
+				"OPENING,2024-03-01,,,,Opening balance,,,0.00\n" +
+				"LINE,2024-03-01,7,CHARGE,fee-ADMIN,Fee charged,500000.00,0.00,500000.00\n" +
+				"LINE,2024-03-01,7,CHARGE,loan-7,Disbursement,5000000.00,500000.00,5000000.00\n" +
+				"LINE,2024-03-03,7,CHARGE,accrual-2024-03,Interest accrued 2024-03,2000.00,0.00,5002000.00\n" +
+				"LINE,2024-03-08,7,PAYMENT,week-1,Installment payment,0.00,110000.00,4892000.00\n" +
+				"LINE,2024-03-09,7,REVERSAL,week-1,Payment reversal: bounced,110000.00,0.00,5002000.00\n" +
+				"CLOSING,2024-03-31,,,,Closing balance,,,5002000.00\n",
+		},
+		{
+			name:  "success - json of a single loan with its totals",
+			input: usecases.GetStatementInput{CustomerID: 1, LoanID: 7, From: "2024-03-08", To: "2024-03-31"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetStatementRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(customer, nil)
+				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(1), uint64(7)).Return(true, nil)
+				mockRepo.On("GetStatementOpeningBalance", mock.Anything, uint64(1), uint64(7), from.AddDate(0, 0, 7)).
+					Return(decimal.NewFromInt(5002000), nil)
+				mockRepo.On("GetStatementEntries", mock.Anything, uint64(1), uint64(7), from.AddDate(0, 0, 7), to).
+					Return(entries[4:], nil)
+			},
+			expectedOutput: usecases.GetStatementOutput{
+				CustomerID: 1, LoanID: 7, From: "2024-03-08", To: "2024-03-31", Format: "json",
+				FileName: "statement-1-2024-03-08-2024-03-31.json", ContentType: "application/json; charset=utf-8",
+				OpeningBalance: "5002000.00", ClosingBalance: "5002000.00",
+			},
+			expectedCheck: func(t *testing.T, content []byte) {
+				assert.Contains(t, string(content), `"customer_name": "Budi Santoso"`)
+				assert.Contains(t, string(content), `"total_payments": "110000.00"`)
+				assert.Contains(t, string(content), `"total_reversals": "110000.00"`)
+				assert.Contains(t, string(content), `"total_charges": "0.00"`)
+			},
+		},
+		{
+			name:  "success - pdf",
+			input: usecases.GetStatementInput{CustomerID: 1, From: "2024-03-01", To: "2024-03-31", Format: "pdf"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetStatementRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(customer, nil)
+				mockRepo.On("GetStatementOpeningBalance", mock.Anything, uint64(1), uint64(0), from).Return(decimal.Zero, nil)
+				mockRepo.On("GetStatementEntries", mock.Anything, uint64(1), uint64(0), from, to).Return(entries, nil)
+			},
+			expectedOutput: usecases.GetStatementOutput{
+				CustomerID: 1, From: "2024-03-01", To: "2024-03-31", Format: "pdf",
+				FileName: "statement-1-2024-03-01-2024-03-31.pdf", ContentType: "application/pdf",
+				OpeningBalance: "0.00", ClosingBalance: "5002000.00",
+			},
+			expectedCheck: func(t *testing.T, content []byte) {
+				assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+				assert.Contains(t, string(content), "(Customer : Budi Santoso \\(1\\))")
+				assert.Contains(t, string(content), "Payment reversa")
+			},
+		},
+		{
+			name:          "error - to before from",
+			input:         usecases.GetStatementInput{CustomerID: 1, From: "2024-03-31", To: "2024-03-01"},
+			setupMocks:    func(*billingenginemocks.MockGetStatementRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - unsupported format",
+			input:         usecases.GetStatementInput{CustomerID: 1, Format: "xlsx"},
+			setupMocks:    func(*billingenginemocks.MockGetStatementRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer not found",
+			input: usecases.GetStatementInput{CustomerID: 404, From: "2024-03-01", To: "2024-03-31"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetStatementRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(404)).Return(entity.Customer{}, errors.New("customer 404 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan of another customer",
+			input: usecases.GetStatementInput{CustomerID: 1, LoanID: 8, From: "2024-03-01", To: "2024-03-31"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetStatementRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(customer, nil)
+				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(1), uint64(8)).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetStatementRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetStatementInteractor(GetStatementInteractorDependencies{
+				GetStatementRepository: mockRepo,
+				Logger:                 zap.NewNop().Sugar(),
+				Validator:              validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+
+				content := output.Content
+				output.Content = nil
+				assert.Equal(t, tt.expectedOutput, output)
+
+				if tt.expectedBody != "" {
+					assert.Equal(t, tt.expectedBody, string(content))
+				}
+				if tt.expectedCheck != nil {
+					tt.expectedCheck(t, content)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
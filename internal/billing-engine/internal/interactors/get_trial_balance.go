@@ -0,0 +1,118 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.GetTrialBalanceUsecase = (*GetTrialBalanceInteractor)(nil)
+
+type (
+	GetTrialBalanceRepository interface {
+		GetLedgerAccounts(ctx context.Context) ([]entity.LedgerAccount, error)
+		GetAccountTotals(ctx context.Context, asOf time.Time) ([]entity.AccountTotal, error)
+	}
+
+	GetTrialBalanceInteractorDependencies struct {
+		GetTrialBalanceRepository GetTrialBalanceRepository
+		Logger                    *zap.SugaredLogger
+		Validator                 *validator.Validate
+	}
+
+	GetTrialBalanceInteractor struct {
+		repository GetTrialBalanceRepository `validate:"required"`
+		logger     *zap.SugaredLogger        `validate:"required"`
+		validator  *validator.Validate       `validate:"required"`
+	}
+)
+
+func NewGetTrialBalanceInteractor(
+	deps GetTrialBalanceInteractorDependencies,
+) *GetTrialBalanceInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &GetTrialBalanceInteractor{
+		repository: deps.GetTrialBalanceRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.GetTrialBalanceUsecase.
+//
+// Every account of the chart is listed with the postings of the entries
+// effective on or before as_of; the ledger is balanced when the total debits
+// equal the total credits.
+func (g *GetTrialBalanceInteractor) Execute(ctx context.Context, input usecases.GetTrialBalanceInput) (usecases.GetTrialBalanceOutput, error) {
+	if err := g.validator.Struct(input); err != nil {
+		g.logger.Errorw("invalid input", "error", err)
+		return usecases.GetTrialBalanceOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	asOf, err := parseAsOfDate(input.AsOf)
+	if err != nil {
+		return usecases.GetTrialBalanceOutput{}, err
+	}
+
+	accounts, err := g.repository.GetLedgerAccounts(ctx)
+	if err != nil {
+		g.logger.Errorw("failed to get ledger accounts", "error", err)
+		return usecases.GetTrialBalanceOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	totals, err := g.repository.GetAccountTotals(ctx, asOf)
+	if err != nil {
+		g.logger.Errorw("failed to get account totals", "error", err, "as_of", asOf.Format(dateLayout))
+		return usecases.GetTrialBalanceOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	totalsByAccount := make(map[string]entity.AccountTotal, len(totals))
+	for _, total := range totals {
+		totalsByAccount[total.AccountCode] = total
+	}
+
+	output := usecases.GetTrialBalanceOutput{
+		AsOf:     asOf.Format(dateLayout),
+		Accounts: make([]usecases.TrialBalanceLineOutput, len(accounts)),
+	}
+
+	totalDebit, totalCredit := decimal.Zero, decimal.Zero
+	for i, account := range accounts {
+		total, ok := totalsByAccount[account.Code]
+		if !ok {
+			total = entity.AccountTotal{AccountCode: account.Code, Debit: decimal.Zero, Credit: decimal.Zero}
+		}
+
+		balance := total.Debit.Sub(total.Credit)
+		if !account.IsDebitNormal() {
+			balance = balance.Neg()
+		}
+
+		totalDebit = totalDebit.Add(total.Debit)
+		totalCredit = totalCredit.Add(total.Credit)
+		output.Accounts[i] = usecases.TrialBalanceLineOutput{
+			Code:    account.Code,
+			Name:    account.Name,
+			Type:    string(account.Type),
+			Debit:   total.Debit.StringFixed(2),
+			Credit:  total.Credit.StringFixed(2),
+			Balance: balance.StringFixed(2),
+		}
+	}
+
+	output.TotalDebit = totalDebit.StringFixed(2)
+	output.TotalCredit = totalCredit.StringFixed(2)
+	output.Balanced = totalDebit.Equal(totalCredit)
+
+	return output, nil
+}
@@ -0,0 +1,100 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestGetTrialBalanceInteractor_Execute(t *testing.T) {
+	asOf := time.Date(2024, 3, 31, 0, 0, 0, 0, time.Local)
+	accounts := []entity.LedgerAccount{
+		{Code: entity.ACCOUNT_CASH, Name: "Cash", Type: entity.ACCOUNT_ASSET},
+		{Code: entity.ACCOUNT_INTEREST_INCOME, Name: "Interest income", Type: entity.ACCOUNT_INCOME},
+		{Code: entity.ACCOUNT_PRINCIPAL_RECEIVABLE, Name: "Principal receivable", Type: entity.ACCOUNT_ASSET},
+		{Code: entity.ACCOUNT_WRITE_OFF_EXPENSE, Name: "Write-off expense", Type: entity.ACCOUNT_EXPENSE},
+	}
+
+	tests := []struct {
+		name           string
+		input          usecases.GetTrialBalanceInput
+		setupMocks     func(*billingenginemocks.MockGetTrialBalanceRepository)
+		expectedOutput usecases.GetTrialBalanceOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - debits equal credits",
+			input: usecases.GetTrialBalanceInput{AsOf: "2024-03-31"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetTrialBalanceRepository) {
+				mockRepo.On("GetLedgerAccounts", mock.Anything).Return(accounts, nil)
+				mockRepo.On("GetAccountTotals", mock.Anything, asOf).Return([]entity.AccountTotal{
+					{AccountCode: entity.ACCOUNT_CASH, Debit: decimal.NewFromInt(110000), Credit: decimal.NewFromInt(5000000)},
+					{AccountCode: entity.ACCOUNT_INTEREST_INCOME, Debit: decimal.Zero, Credit: decimal.NewFromInt(10000)},
+					{AccountCode: entity.ACCOUNT_PRINCIPAL_RECEIVABLE, Debit: decimal.NewFromInt(5000000), Credit: decimal.NewFromInt(100000)},
+				}, nil)
+			},
+			expectedOutput: usecases.GetTrialBalanceOutput{
+				AsOf: "2024-03-31",
+				Accounts: []usecases.TrialBalanceLineOutput{
+					{Code: "CASH", Name: "Cash", Type: "ASSET", Debit: "110000.00", Credit: "5000000.00", Balance: "-4890000.00"},
+					{Code: "INTEREST_INCOME", Name: "Interest income", Type: "INCOME", Debit: "0.00", Credit: "10000.00", Balance: "10000.00"},
+					{Code: "PRINCIPAL_RECEIVABLE", Name: "Principal receivable", Type: "ASSET", Debit: "5000000.00", Credit: "100000.00", Balance: "4900000.00"},
+					{Code: "WRITE_OFF_EXPENSE", Name: "Write-off expense", Type: "EXPENSE", Debit: "0.00", Credit: "0.00", Balance: "0.00"},
+				},
+				TotalDebit:  "5110000.00",
+				TotalCredit: "5110000.00",
+				Balanced:    true,
+			},
+		},
+		{
+			name:          "error - validation error (invalid as_of)",
+			input:         usecases.GetTrialBalanceInput{AsOf: "31-03-2024"},
+			setupMocks:    func(*billingenginemocks.MockGetTrialBalanceRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on GetAccountTotals",
+			input: usecases.GetTrialBalanceInput{AsOf: "2024-03-31"},
+			setupMocks: func(mockRepo *billingenginemocks.MockGetTrialBalanceRepository) {
+				mockRepo.On("GetLedgerAccounts", mock.Anything).Return(accounts, nil)
+				mockRepo.On("GetAccountTotals", mock.Anything, asOf).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockGetTrialBalanceRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewGetTrialBalanceInteractor(GetTrialBalanceInteractorDependencies{
+				GetTrialBalanceRepository: mockRepo,
+				Logger:                    zap.NewNop().Sugar(),
+				Validator:                 validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, output)
+			}
+		})
+	}
+}
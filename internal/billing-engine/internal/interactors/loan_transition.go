@@ -0,0 +1,19 @@
+package interactors
+
+import (
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+)
+
+// newLoanTransition builds the transition of the loan to status to, failing
+// with the IllegalLoanTransition code when the lifecycle does not allow it.
+func newLoanTransition(loan entity.Loan, to entity.LoanStatus, actor string, reason string) (entity.LoanStatusTransition, error) {
+	transition, err := entity.NewLoanStatusTransition(loan, to, actor, reason, time.Now())
+	if err != nil {
+		return entity.LoanStatusTransition{}, pkgerror.NewBusinessErrorCodeWithCustomMessage(pkgerror.IllegalLoanTransition, err.Error())
+	}
+
+	return transition, nil
+}
@@ -0,0 +1,167 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.LogContactAttemptUsecase = (*LogContactAttemptInteractor)(nil)
+
+type (
+	LogContactAttemptRepository interface {
+		GetCollectionCase(ctx context.Context, caseID uint64) (entity.CollectionCase, error)
+		CreateContactAttempt(ctx context.Context, attempt entity.ContactAttempt) (entity.ContactAttempt, error)
+		CreatePromiseToPay(ctx context.Context, promise entity.PromiseToPay) (entity.PromiseToPay, error)
+	}
+
+	LogContactAttemptInteractorDependencies struct {
+		LogContactAttemptRepository LogContactAttemptRepository
+		Logger                      *zap.SugaredLogger
+		Validator                   *validator.Validate
+		SnowflakeGen                pkguid.Snowflake
+	}
+
+	LogContactAttemptInteractor struct {
+		repository   LogContactAttemptRepository `validate:"required"`
+		logger       *zap.SugaredLogger          `validate:"required"`
+		validator    *validator.Validate         `validate:"required"`
+		snowflakeGen pkguid.Snowflake            `validate:"required"`
+	}
+)
+
+func NewLogContactAttemptInteractor(
+	deps LogContactAttemptInteractorDependencies,
+) *LogContactAttemptInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &LogContactAttemptInteractor{
+		repository:   deps.LogContactAttemptRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.LogContactAttemptUsecase.
+func (l *LogContactAttemptInteractor) Execute(ctx context.Context, input usecases.LogContactAttemptInput) (usecases.LogContactAttemptOutput, error) {
+	if err := l.validator.Struct(input); err != nil {
+		l.logger.Errorw("invalid input", "error", err)
+		return usecases.LogContactAttemptOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	now := time.Now()
+
+	var (
+		promisedDate   time.Time
+		promisedAmount decimal.Decimal
+		err            error
+	)
+	if entity.ContactOutcome(input.Outcome) == entity.CONTACT_OUTCOME_PROMISE_TO_PAY {
+		promisedDate, err = time.ParseInLocation(dateLayout, input.PromisedDate, time.Local)
+		if err != nil {
+			return usecases.LogContactAttemptOutput{}, pkgerror.ValidationErrorFrom(err)
+		}
+
+		if promisedDate.Before(startOfDay(now)) {
+			return usecases.LogContactAttemptOutput{}, pkgerror.NewValidationError("promised date must not be in the past")
+		}
+
+		promisedAmount, err = decimal.NewFromString(input.PromisedAmount)
+		if err != nil {
+			return usecases.LogContactAttemptOutput{}, pkgerror.ValidationErrorFrom(err)
+		}
+
+		if !promisedAmount.IsPositive() {
+			return usecases.LogContactAttemptOutput{}, pkgerror.NewValidationError("promised amount must be positive")
+		}
+	}
+
+	collectionCase, err := l.repository.GetCollectionCase(ctx, input.CaseID)
+	if err != nil {
+		l.logger.Errorw("failed to get collection case", "error", err, "case_id", input.CaseID)
+		return usecases.LogContactAttemptOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if collectionCase.Status != entity.COLLECTION_CASE_OPEN {
+		return usecases.LogContactAttemptOutput{}, pkgerror.NewBusinessError("collection case is not open")
+	}
+
+	if collectionCase.AgentID != input.AgentID {
+		return usecases.LogContactAttemptOutput{}, pkgerror.NewBusinessError("collection case is not assigned to agent")
+	}
+
+	attempt, err := l.repository.CreateContactAttempt(ctx, entity.ContactAttempt{
+		ID:          l.snowflakeGen.Generate(),
+		CaseID:      collectionCase.ID,
+		AgentID:     input.AgentID,
+		Channel:     entity.ContactChannel(input.Channel),
+		Outcome:     entity.ContactOutcome(input.Outcome),
+		Notes:       input.Notes,
+		AttemptedAt: now,
+	})
+	if err != nil {
+		l.logger.Errorw("failed to create contact attempt", "error", err, "case_id", input.CaseID)
+		return usecases.LogContactAttemptOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.LogContactAttemptOutput{
+		Attempt: toContactAttemptOutput(attempt),
+	}
+
+	if attempt.Outcome != entity.CONTACT_OUTCOME_PROMISE_TO_PAY {
+		return output, nil
+	}
+
+	promise, err := l.repository.CreatePromiseToPay(ctx, entity.PromiseToPay{
+		ID:             l.snowflakeGen.Generate(),
+		CaseID:         collectionCase.ID,
+		LoanID:         collectionCase.LoanID,
+		PromisedDate:   promisedDate,
+		PromisedAmount: promisedAmount,
+		Status:         entity.PROMISE_TO_PAY_PENDING,
+		CreatedAt:      now,
+	})
+	if err != nil {
+		l.logger.Errorw("failed to create promise to pay", "error", err, "case_id", input.CaseID)
+		return usecases.LogContactAttemptOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	promiseOutput := toPromiseToPayOutput(promise)
+	output.Promise = &promiseOutput
+
+	return output, nil
+}
+
+func toContactAttemptOutput(attempt entity.ContactAttempt) usecases.ContactAttemptOutput {
+	return usecases.ContactAttemptOutput{
+		ID:          attempt.ID,
+		CaseID:      attempt.CaseID,
+		AgentID:     attempt.AgentID,
+		Channel:     string(attempt.Channel),
+		Outcome:     string(attempt.Outcome),
+		Notes:       attempt.Notes,
+		AttemptedAt: attempt.AttemptedAt.Format(time.RFC3339),
+	}
+}
+
+func toPromiseToPayOutput(promise entity.PromiseToPay) usecases.PromiseToPayOutput {
+	return usecases.PromiseToPayOutput{
+		ID:             promise.ID,
+		CaseID:         promise.CaseID,
+		LoanID:         promise.LoanID,
+		PromisedDate:   promise.PromisedDate.Format(dateLayout),
+		PromisedAmount: promise.PromisedAmount.String(),
+		Status:         string(promise.Status),
+	}
+}
@@ -0,0 +1,134 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestLogContactAttemptInteractor_Execute(t *testing.T) {
+	openCase := entity.CollectionCase{ID: 1, LoanID: 100, AgentID: 7, Status: entity.COLLECTION_CASE_OPEN}
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(dateLayout)
+
+	tests := []struct {
+		name          string
+		input         usecases.LogContactAttemptInput
+		setupMocks    func(*billingenginemocks.MockLogContactAttemptRepository, *pkgmocks.MockSnowflake)
+		expectPromise bool
+		expectedError error
+	}{
+		{
+			name:  "success - contact attempt without promise",
+			input: usecases.LogContactAttemptInput{CaseID: 1, AgentID: 7, Channel: "CALL", Outcome: "NO_ANSWER"},
+			setupMocks: func(mockRepo *billingenginemocks.MockLogContactAttemptRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCollectionCase", mock.Anything, uint64(1)).Return(openCase, nil)
+				mockSnowflake.On("Generate").Return(uint64(50)).Once()
+				mockRepo.On("CreateContactAttempt", mock.Anything, mock.MatchedBy(func(a entity.ContactAttempt) bool {
+					return a.ID == 50 && a.CaseID == 1 && a.Outcome == entity.CONTACT_OUTCOME_NO_ANSWER
+				})).Return(func(_ context.Context, a entity.ContactAttempt) (entity.ContactAttempt, error) {
+					return a, nil
+				})
+			},
+		},
+		{
+			name: "success - promise to pay is recorded",
+			input: usecases.LogContactAttemptInput{
+				CaseID: 1, AgentID: 7, Channel: "WHATSAPP", Outcome: "PROMISE_TO_PAY",
+				PromisedDate: tomorrow, PromisedAmount: "220000",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockLogContactAttemptRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCollectionCase", mock.Anything, uint64(1)).Return(openCase, nil)
+				mockSnowflake.On("Generate").Return(uint64(51))
+				mockRepo.On("CreateContactAttempt", mock.Anything, mock.Anything).Return(
+					func(_ context.Context, a entity.ContactAttempt) (entity.ContactAttempt, error) {
+						return a, nil
+					},
+				)
+				mockRepo.On("CreatePromiseToPay", mock.Anything, mock.MatchedBy(func(p entity.PromiseToPay) bool {
+					return p.LoanID == 100 && p.PromisedAmount.Equal(decimal.NewFromInt(220000)) &&
+						p.Status == entity.PROMISE_TO_PAY_PENDING
+				})).Return(func(_ context.Context, p entity.PromiseToPay) (entity.PromiseToPay, error) {
+					return p, nil
+				})
+			},
+			expectPromise: true,
+		},
+		{
+			name:          "error - promise outcome without promised date",
+			input:         usecases.LogContactAttemptInput{CaseID: 1, AgentID: 7, Channel: "CALL", Outcome: "PROMISE_TO_PAY"},
+			setupMocks:    func(*billingenginemocks.MockLogContactAttemptRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - promised date in the past",
+			input: usecases.LogContactAttemptInput{
+				CaseID: 1, AgentID: 7, Channel: "CALL", Outcome: "PROMISE_TO_PAY",
+				PromisedDate: "2020-01-01", PromisedAmount: "110000",
+			},
+			setupMocks:    func(*billingenginemocks.MockLogContactAttemptRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - case assigned to another agent",
+			input: usecases.LogContactAttemptInput{CaseID: 1, AgentID: 8, Channel: "CALL", Outcome: "REACHED"},
+			setupMocks: func(mockRepo *billingenginemocks.MockLogContactAttemptRepository, _ *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCollectionCase", mock.Anything, uint64(1)).Return(openCase, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - case not found",
+			input: usecases.LogContactAttemptInput{CaseID: 2, AgentID: 7, Channel: "CALL", Outcome: "REACHED"},
+			setupMocks: func(mockRepo *billingenginemocks.MockLogContactAttemptRepository, _ *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCollectionCase", mock.Anything, uint64(2)).Return(entity.CollectionCase{}, errors.New("collection case 2 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockLogContactAttemptRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewLogContactAttemptInteractor(LogContactAttemptInteractorDependencies{
+				LogContactAttemptRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+				Validator:                   validator.New(),
+				SnowflakeGen:                mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.input.Outcome, output.Attempt.Outcome)
+			if tt.expectPromise {
+				assert.NotNil(t, output.Promise)
+				assert.Equal(t, "PENDING", output.Promise.Status)
+				assert.Equal(t, tt.input.PromisedDate, output.Promise.PromisedDate)
+			} else {
+				assert.Nil(t, output.Promise)
+			}
+		})
+	}
+}
@@ -2,33 +2,55 @@ package interactors
 
 import (
 	"context"
+	"time"
 
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
 	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
 
 var _ usecases.MakePaymentUsecase = (*MakePaymentInteractor)(nil)
 
 type (
+	// InstallmentPaymentRepository is what paying an installment in full and
+	// posting the payment needs.
+	InstallmentPaymentRepository interface {
+		TransactionRepository
+		MakePayment(ctx context.Context, loanID uint64, weekNumber int64, amount string, paidAt time.Time) error
+		GetInstallment(ctx context.Context, loanID uint64, weekNumber int64) (entity.Installment, error)
+		CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error)
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		ReleaseLoanCollaterals(ctx context.Context, loanID uint64, releasedAt time.Time) (int64, error)
+	}
+
 	MakePaymentRepository interface {
-		MakePayment(ctx context.Context, loanID uint64, weekNumber int64, amount string) error
+		PeriodLockRepository
+		InstallmentPaymentRepository
 		GetOutstandingString(ctx context.Context, loanID uint64) (string, error)
 		IsCustomerExist(ctx context.Context, customerID uint64) (bool, error)
 		IsLoanBelongsToCustomer(ctx context.Context, customerID uint64, loanID uint64) (bool, error)
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		GetWriteOffByLoan(ctx context.Context, loanID uint64) (entity.WriteOff, error)
+		GetTotalRecovered(ctx context.Context, loanID uint64) (decimal.Decimal, error)
+		CreateRecovery(ctx context.Context, recovery entity.Recovery) (entity.Recovery, error)
 	}
 
 	MakePaymentInteractorDependencies struct {
 		MakePaymentRepository MakePaymentRepository
 		Logger                *zap.SugaredLogger
 		Validator             *validator.Validate
+		SnowflakeGen          pkguid.Snowflake
 	}
 
 	MakePaymentInteractor struct {
-		repository MakePaymentRepository `validate:"required"`
-		logger     *zap.SugaredLogger    `validate:"required"`
-		validator  *validator.Validate   `validate:"required"`
+		repository   MakePaymentRepository `validate:"required"`
+		logger       *zap.SugaredLogger    `validate:"required"`
+		validator    *validator.Validate   `validate:"required"`
+		snowflakeGen pkguid.Snowflake      `validate:"required"`
 	}
 )
 
@@ -40,9 +62,10 @@ func NewMakePaymentInteractor(
 	}
 
 	return &MakePaymentInteractor{
-		repository: deps.MakePaymentRepository,
-		logger:     deps.Logger,
-		validator:  deps.Validator,
+		repository:   deps.MakePaymentRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
 	}
 }
 
@@ -54,6 +77,23 @@ func (m *MakePaymentInteractor) Execute(ctx context.Context, input usecases.Make
 		return usecases.MakePaymentOutput{}, pkgerror.NewBusinessError("invalid input: " + err.Error())
 	}
 
+	// Backdated payments are booked on their effective date, which must fall
+	// in an open accounting period
+	effectiveDate, err := parseEffectiveDate(input.EffectiveDate)
+	if err != nil {
+		return usecases.MakePaymentOutput{}, err
+	}
+
+	if err := ensurePeriodOpen(ctx, m.repository, effectiveDate); err != nil {
+		m.logger.Errorw("failed to check period lock", "error", err, "loan_id", input.LoanID, "effective_date", effectiveDate.Format(dateLayout))
+		return usecases.MakePaymentOutput{}, err
+	}
+
+	paidAt := time.Now()
+	if input.EffectiveDate != "" {
+		paidAt = effectiveDate
+	}
+
 	// Check if customer exists
 	isCustomerExist, err := m.repository.IsCustomerExist(ctx, input.CustomerID)
 	if err != nil {
@@ -76,13 +116,22 @@ func (m *MakePaymentInteractor) Execute(ctx context.Context, input usecases.Make
 		return usecases.MakePaymentOutput{}, pkgerror.NewBusinessError("loan not found or does not belong to customer")
 	}
 
-	// Make the payment
-	err = m.repository.MakePayment(ctx, input.LoanID, input.WeekNumber, input.Amount)
+	loan, err := m.repository.GetLoan(ctx, input.LoanID)
 	if err != nil {
-		m.logger.Errorw("failed to make payment", "error", err, "loan_id", input.LoanID, "week_number", input.WeekNumber)
+		m.logger.Errorw("failed to get loan", "error", err, "loan_id", input.LoanID)
 		return usecases.MakePaymentOutput{}, pkgerror.BusinessErrorFrom(err)
 	}
 
+	// Payments on a written-off loan no longer settle installments
+	if loan.Status == entity.LOAN_WRITTEN_OFF {
+		return m.bookRecovery(ctx, input, paidAt)
+	}
+
+	if err := payInstallment(ctx, m.repository, m.snowflakeGen, loan, input.WeekNumber, input.Amount, paidAt); err != nil {
+		m.logger.Errorw("failed to make payment", "error", err, "loan_id", input.LoanID, "week_number", input.WeekNumber)
+		return usecases.MakePaymentOutput{}, err
+	}
+
 	// Get updated outstanding amount
 	outstanding, err := m.repository.GetOutstandingString(ctx, input.LoanID)
 	if err != nil {
@@ -105,3 +154,124 @@ func (m *MakePaymentInteractor) Execute(ctx context.Context, input usecases.Make
 		Message:    message,
 	}, nil
 }
+
+// payInstallment pays the installment of the loan in full and posts the
+// payment to the ledger, splitting off the fees and the flat interest; the
+// holiday interest a moratorium added to the installment is all interest. The
+// payment, its journal entry and the release of the liens of a loan it pays
+// off are written in one transaction.
+func payInstallment(ctx context.Context, repository InstallmentPaymentRepository, snowflakeGen pkguid.Snowflake, loan entity.Loan, weekNumber int64, amount string, paidAt time.Time) error {
+	paid, err := decimal.NewFromString(amount)
+	if err != nil {
+		return pkgerror.ValidationErrorFrom(err)
+	}
+
+	return repository.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := repository.MakePayment(ctx, loan.ID, weekNumber, amount, paidAt); err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		installment, err := repository.GetInstallment(ctx, loan.ID, weekNumber)
+		if err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		fee, err := installment.Fee()
+		if err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		holiday, err := installment.Holiday()
+		if err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		principal, interest := entity.SplitPrincipalInterest(paid.Sub(fee).Sub(holiday), loan.InterestRate)
+		interest = interest.Add(holiday)
+		entry := entity.NewPaymentEntry(snowflakeGen.Generate(), loan.ID, weekNumber, principal, interest, fee, paidAt)
+		if _, err := repository.CreateJournalEntry(ctx, entry); err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		// Nothing is owed once the last installment is paid, the collateral
+		// of the loan is given back
+		paidLoan, err := repository.GetLoan(ctx, loan.ID)
+		if err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		if paidLoan.Status == entity.LOAN_PAID {
+			if _, err := repository.ReleaseLoanCollaterals(ctx, loan.ID, paidAt); err != nil {
+				return pkgerror.BusinessErrorFrom(err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// bookRecovery records a payment received after the loan was written off as
+// a recovery, up to the balance that was written off.
+func (m *MakePaymentInteractor) bookRecovery(ctx context.Context, input usecases.MakePaymentInput, receivedAt time.Time) (usecases.MakePaymentOutput, error) {
+	amount, err := decimal.NewFromString(input.Amount)
+	if err != nil {
+		return usecases.MakePaymentOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if !amount.IsPositive() || amount.Exponent() < -2 {
+		return usecases.MakePaymentOutput{}, pkgerror.NewValidationError("amount must be a positive amount with at most 2 decimals")
+	}
+
+	// The remaining balance is read in the transaction recording the
+	// recovery, so it is checked against the recoveries committed before it
+	var remaining decimal.Decimal
+	err = m.repository.WithinTransaction(ctx, func(ctx context.Context) error {
+		writeOff, err := m.repository.GetWriteOffByLoan(ctx, input.LoanID)
+		if err != nil {
+			m.logger.Errorw("failed to get write-off", "error", err, "loan_id", input.LoanID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		recovered, err := m.repository.GetTotalRecovered(ctx, input.LoanID)
+		if err != nil {
+			m.logger.Errorw("failed to get total recovered", "error", err, "loan_id", input.LoanID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		remaining = writeOff.Total().Sub(recovered)
+		if amount.GreaterThan(remaining) {
+			return pkgerror.NewBusinessError("amount exceeds the written-off balance of " + remaining.StringFixed(2))
+		}
+
+		recovery, err := m.repository.CreateRecovery(ctx, entity.Recovery{
+			ID:         m.snowflakeGen.Generate(),
+			LoanID:     input.LoanID,
+			WriteOffID: writeOff.ID,
+			Amount:     amount,
+			ReceivedAt: receivedAt,
+		})
+		if err != nil {
+			m.logger.Errorw("failed to create recovery", "error", err, "loan_id", input.LoanID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		if _, err := m.repository.CreateJournalEntry(ctx, entity.NewRecoveryEntry(m.snowflakeGen.Generate(), recovery)); err != nil {
+			m.logger.Errorw("failed to post recovery journal entry", "error", err, "loan_id", input.LoanID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return usecases.MakePaymentOutput{}, err
+	}
+
+	return usecases.MakePaymentOutput{
+		CustomerID: input.CustomerID,
+		LoanID:     input.LoanID,
+		WeekNumber: input.WeekNumber,
+		Amount:     input.Amount,
+		Status:     "RECOVERY",
+		Message:    "Payment booked as recovery of a written-off loan. Remaining written-off amount: " + remaining.Sub(amount).StringFixed(2),
+	}, nil
+}
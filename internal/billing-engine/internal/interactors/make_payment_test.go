@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
 	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
 	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
@@ -18,7 +22,7 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 	tests := []struct {
 		name           string
 		input          usecases.MakePaymentInput
-		setupMocks     func(*billingenginemocks.MockMakePaymentRepository)
+		setupMocks     func(*billingenginemocks.MockMakePaymentRepository, *pkgmocks.MockSnowflake)
 		expectedOutput usecases.MakePaymentOutput
 		expectedError  error
 	}{
@@ -30,10 +34,15 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 5,
 				Amount:     "100000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
 				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(1)).Return(true, nil)
-				mockRepo.On("MakePayment", mock.Anything, uint64(1), int64(5), "100000").Return(nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(1)).Return(entity.Loan{ID: 1, Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("MakePayment", mock.Anything, uint64(1), int64(5), "100000", mock.Anything).Return(nil)
+				mockRepo.On("GetInstallment", mock.Anything, uint64(1), int64(5)).Return(entity.Installment{LoanID: 1, WeekNumber: 5, FeeAmount: "0.00"}, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(isBalancedEntry(entity.JOURNAL_PAYMENT))).Return(entity.JournalEntry{}, nil)
 				mockRepo.On("GetOutstandingString", mock.Anything, uint64(1)).Return("400000", nil)
 			},
 			expectedOutput: usecases.MakePaymentOutput{
@@ -54,10 +63,26 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 10,
 				Amount:     "50000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(200)).Return(true, nil)
 				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(200), uint64(2)).Return(true, nil)
-				mockRepo.On("MakePayment", mock.Anything, uint64(2), int64(10), "50000").Return(nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(2)).Return(entity.Loan{ID: 2, Status: entity.LOAN_DISBURSED}, nil).Once()
+				mockRepo.On("MakePayment", mock.Anything, uint64(2), int64(10), "50000", mock.Anything).Return(nil)
+				mockRepo.On("GetInstallment", mock.Anything, uint64(2), int64(10)).Return(entity.Installment{LoanID: 2, WeekNumber: 10, FeeAmount: "5000.00"}, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					for _, posting := range entry.Postings {
+						if posting.AccountCode == entity.ACCOUNT_FEE_RECEIVABLE {
+							return entry.IsBalanced() && posting.Amount.Equal(decimal.NewFromInt(5000))
+						}
+					}
+
+					return false
+				})).Return(entity.JournalEntry{}, nil)
+				// The last installment pays the loan off, its liens are released
+				mockRepo.On("GetLoan", mock.Anything, uint64(2)).Return(entity.Loan{ID: 2, Status: entity.LOAN_PAID}, nil).Once()
+				mockRepo.On("ReleaseLoanCollaterals", mock.Anything, uint64(2), mock.Anything).Return(int64(1), nil)
 				mockRepo.On("GetOutstandingString", mock.Anything, uint64(2)).Return("0", nil)
 			},
 			expectedOutput: usecases.MakePaymentOutput{
@@ -78,10 +103,15 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 3,
 				Amount:     "75000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(300)).Return(true, nil)
 				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(300), uint64(3)).Return(true, nil)
-				mockRepo.On("MakePayment", mock.Anything, uint64(3), int64(3), "75000").Return(nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(3)).Return(entity.Loan{ID: 3, Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("MakePayment", mock.Anything, uint64(3), int64(3), "75000", mock.Anything).Return(nil)
+				mockRepo.On("GetInstallment", mock.Anything, uint64(3), int64(3)).Return(entity.Installment{LoanID: 3, WeekNumber: 3, FeeAmount: ""}, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(isBalancedEntry(entity.JOURNAL_PAYMENT))).Return(entity.JournalEntry{}, nil)
 				repoErr := errors.New("db error")
 				mockRepo.On("GetOutstandingString", mock.Anything, uint64(3)).Return("", repoErr)
 			},
@@ -103,7 +133,7 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 1,
 				Amount:     "100000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
 				// No mocks needed for validation error
 			},
 			expectedOutput: usecases.MakePaymentOutput{},
@@ -117,7 +147,7 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 1,
 				Amount:     "100000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
 				// No mocks needed for validation error
 			},
 			expectedOutput: usecases.MakePaymentOutput{},
@@ -131,7 +161,7 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 0,
 				Amount:     "100000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
 				// No mocks needed for validation error
 			},
 			expectedOutput: usecases.MakePaymentOutput{},
@@ -145,7 +175,7 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 1,
 				Amount:     "",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
 				// No mocks needed for validation error
 			},
 			expectedOutput: usecases.MakePaymentOutput{},
@@ -159,7 +189,8 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 1,
 				Amount:     "100000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(999)).Return(false, nil)
 			},
 			expectedOutput: usecases.MakePaymentOutput{},
@@ -173,7 +204,8 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 1,
 				Amount:     "100000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
 				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(999)).Return(false, nil)
 			},
@@ -188,8 +220,9 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 1,
 				Amount:     "100000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
 				repoErr := errors.New("db error")
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(false, repoErr)
 			},
 			expectedOutput: usecases.MakePaymentOutput{},
@@ -203,7 +236,8 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 1,
 				Amount:     "100000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
 				repoErr := errors.New("db error")
 				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(1)).Return(false, repoErr)
@@ -219,11 +253,260 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 				WeekNumber: 2,
 				Amount:     "200000",
 			},
-			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository) {
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
 				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
 				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(4)).Return(true, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(4)).Return(entity.Loan{ID: 4, Status: entity.LOAN_DISBURSED}, nil)
 				repoErr := errors.New("payment failed")
-				mockRepo.On("MakePayment", mock.Anything, uint64(4), int64(2), "200000").Return(repoErr)
+				mockRepo.On("MakePayment", mock.Anything, uint64(4), int64(2), "200000", mock.Anything).Return(repoErr)
+			},
+			expectedOutput: usecases.MakePaymentOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name: "success - payment on a written-off loan booked as recovery",
+			input: usecases.MakePaymentInput{
+				CustomerID: 100,
+				LoanID:     5,
+				WeekNumber: 1,
+				Amount:     "100000",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
+				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(5)).Return(true, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(5)).Return(entity.Loan{ID: 5, Status: entity.LOAN_WRITTEN_OFF}, nil)
+				mockRepo.On("GetWriteOffByLoan", mock.Anything, uint64(5)).Return(entity.WriteOff{
+					ID:              50,
+					LoanID:          5,
+					PrincipalAmount: decimal.NewFromInt(1000000),
+					InterestAmount:  decimal.NewFromInt(100000),
+					FeeAmount:       decimal.Zero,
+				}, nil)
+				mockRepo.On("GetTotalRecovered", mock.Anything, uint64(5)).Return(decimal.NewFromInt(200000), nil)
+				mockSnowflake.On("Generate").Return(uint64(500))
+				mockRepo.On("CreateRecovery", mock.Anything, mock.MatchedBy(func(recovery entity.Recovery) bool {
+					return recovery.ID == 500 && recovery.WriteOffID == 50 && recovery.Amount.Equal(decimal.NewFromInt(100000))
+				})).Return(entity.Recovery{ID: 500, LoanID: 5, Amount: decimal.NewFromInt(100000)}, nil)
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(isBalancedEntry(entity.JOURNAL_RECOVERY))).Return(entity.JournalEntry{}, nil)
+			},
+			expectedOutput: usecases.MakePaymentOutput{
+				CustomerID: 100,
+				LoanID:     5,
+				WeekNumber: 1,
+				Amount:     "100000",
+				Status:     "RECOVERY",
+				Message:    "Payment booked as recovery of a written-off loan. Remaining written-off amount: 800000.00",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "error - recovery exceeds written-off balance",
+			input: usecases.MakePaymentInput{
+				CustomerID: 100,
+				LoanID:     5,
+				WeekNumber: 1,
+				Amount:     "100000",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
+				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(5)).Return(true, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(5)).Return(entity.Loan{ID: 5, Status: entity.LOAN_WRITTEN_OFF}, nil)
+				mockRepo.On("GetWriteOffByLoan", mock.Anything, uint64(5)).Return(entity.WriteOff{
+					ID:              50,
+					LoanID:          5,
+					PrincipalAmount: decimal.NewFromInt(100000),
+					InterestAmount:  decimal.NewFromInt(10000),
+					FeeAmount:       decimal.Zero,
+				}, nil)
+				mockRepo.On("GetTotalRecovered", mock.Anything, uint64(5)).Return(decimal.NewFromInt(50000), nil)
+			},
+			expectedOutput: usecases.MakePaymentOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name: "error - invalid recovery amount",
+			input: usecases.MakePaymentInput{
+				CustomerID: 100,
+				LoanID:     5,
+				WeekNumber: 1,
+				Amount:     "abc",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
+				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(5)).Return(true, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(5)).Return(entity.Loan{ID: 5, Status: entity.LOAN_WRITTEN_OFF}, nil)
+			},
+			expectedOutput: usecases.MakePaymentOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name: "error - repository error on GetLoan",
+			input: usecases.MakePaymentInput{
+				CustomerID: 100,
+				LoanID:     1,
+				WeekNumber: 1,
+				Amount:     "100000",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
+				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(1)).Return(true, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(1)).Return(entity.Loan{}, errors.New("loan 1 not found"))
+			},
+			expectedOutput: usecases.MakePaymentOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name: "success - payment posted to the ledger split into principal and interest",
+			input: usecases.MakePaymentInput{
+				CustomerID: 100,
+				LoanID:     6,
+				WeekNumber: 1,
+				Amount:     "110000.00",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
+				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(6)).Return(true, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(6)).Return(entity.Loan{ID: 6, InterestRate: decimal.NewFromFloat(0.1), Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("MakePayment", mock.Anything, uint64(6), int64(1), "110000.00", mock.Anything).Return(nil)
+				mockRepo.On("GetInstallment", mock.Anything, uint64(6), int64(1)).Return(entity.Installment{LoanID: 6, WeekNumber: 1}, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.IsBalanced() && entry.Reference == "week-1" && len(entry.Postings) == 3 &&
+						entry.Postings[0].AccountCode == entity.ACCOUNT_CASH && entry.Postings[0].Amount.Equal(decimal.NewFromInt(110000)) &&
+						entry.Postings[1].AccountCode == entity.ACCOUNT_PRINCIPAL_RECEIVABLE && entry.Postings[1].Amount.Equal(decimal.NewFromInt(100000)) &&
+						entry.Postings[2].AccountCode == entity.ACCOUNT_INTEREST_RECEIVABLE && entry.Postings[2].Amount.Equal(decimal.NewFromInt(10000))
+				})).Return(entity.JournalEntry{}, nil)
+				mockRepo.On("GetOutstandingString", mock.Anything, uint64(6)).Return("0", nil)
+			},
+			expectedOutput: usecases.MakePaymentOutput{
+				CustomerID: 100,
+				LoanID:     6,
+				WeekNumber: 1,
+				Amount:     "110000.00",
+				Status:     "SUCCESS",
+				Message:    "Payment processed successfully",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "success - holiday interest of a moratorium is settled as interest",
+			input: usecases.MakePaymentInput{
+				CustomerID: 100,
+				LoanID:     6,
+				WeekNumber: 1,
+				Amount:     "110365.00",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
+				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(6)).Return(true, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(6)).Return(entity.Loan{ID: 6, InterestRate: decimal.NewFromFloat(0.1), Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("MakePayment", mock.Anything, uint64(6), int64(1), "110365.00", mock.Anything).Return(nil)
+				mockRepo.On("GetInstallment", mock.Anything, uint64(6), int64(1)).Return(entity.Installment{LoanID: 6, WeekNumber: 1, AmountDue: "110365.00", HolidayInterest: "365.00"}, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.IsBalanced() && len(entry.Postings) == 3 &&
+						entry.Postings[1].AccountCode == entity.ACCOUNT_PRINCIPAL_RECEIVABLE && entry.Postings[1].Amount.Equal(decimal.NewFromInt(100000)) &&
+						entry.Postings[2].AccountCode == entity.ACCOUNT_INTEREST_RECEIVABLE && entry.Postings[2].Amount.Equal(decimal.NewFromInt(10365))
+				})).Return(entity.JournalEntry{}, nil)
+				mockRepo.On("GetOutstandingString", mock.Anything, uint64(6)).Return("0", nil)
+			},
+			expectedOutput: usecases.MakePaymentOutput{
+				CustomerID: 100,
+				LoanID:     6,
+				WeekNumber: 1,
+				Amount:     "110365.00",
+				Status:     "SUCCESS",
+				Message:    "Payment processed successfully",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "error - repository error on CreateJournalEntry",
+			input: usecases.MakePaymentInput{
+				CustomerID: 100,
+				LoanID:     1,
+				WeekNumber: 1,
+				Amount:     "100000",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
+				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(1)).Return(true, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(1)).Return(entity.Loan{ID: 1, Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("MakePayment", mock.Anything, uint64(1), int64(1), "100000", mock.Anything).Return(nil)
+				mockRepo.On("GetInstallment", mock.Anything, uint64(1), int64(1)).Return(entity.Installment{LoanID: 1, WeekNumber: 1}, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.Anything).Return(entity.JournalEntry{}, errors.New("db error"))
+			},
+			expectedOutput: usecases.MakePaymentOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name: "success - backdated payment booked on its effective date",
+			input: usecases.MakePaymentInput{
+				CustomerID:    100,
+				LoanID:        1,
+				WeekNumber:    1,
+				Amount:        "100000",
+				EffectiveDate: "2024-03-15",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				effectiveDate := time.Date(2024, time.March, 15, 0, 0, 0, 0, time.Local)
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(100)).Return(true, nil)
+				mockRepo.On("IsLoanBelongsToCustomer", mock.Anything, uint64(100), uint64(1)).Return(true, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(1)).Return(entity.Loan{ID: 1, Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("MakePayment", mock.Anything, uint64(1), int64(1), "100000", effectiveDate).Return(nil)
+				mockRepo.On("GetInstallment", mock.Anything, uint64(1), int64(1)).Return(entity.Installment{LoanID: 1, WeekNumber: 1}, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.IsBalanced() && entry.EffectiveDate.Equal(effectiveDate)
+				})).Return(entity.JournalEntry{}, nil)
+				mockRepo.On("GetOutstandingString", mock.Anything, uint64(1)).Return("0", nil)
+			},
+			expectedOutput: usecases.MakePaymentOutput{
+				CustomerID: 100,
+				LoanID:     1,
+				WeekNumber: 1,
+				Amount:     "100000",
+				Status:     "SUCCESS",
+				Message:    "Payment processed successfully",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "error - payment backdated into a closed period",
+			input: usecases.MakePaymentInput{
+				CustomerID:    100,
+				LoanID:        1,
+				WeekNumber:    1,
+				Amount:        "100000",
+				EffectiveDate: "2024-02-28",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+			},
+			expectedOutput: usecases.MakePaymentOutput{},
+			expectedError:  &pkgerror.Error{},
+		},
+		{
+			name: "error - effective date in the future",
+			input: usecases.MakePaymentInput{
+				CustomerID:    100,
+				LoanID:        1,
+				WeekNumber:    1,
+				Amount:        "100000",
+				EffectiveDate: time.Now().AddDate(0, 0, 2).Format("2006-01-02"),
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockMakePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				// Rejected before the period lock is read
 			},
 			expectedOutput: usecases.MakePaymentOutput{},
 			expectedError:  &pkgerror.Error{},
@@ -236,12 +519,16 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 			logger := zap.NewNop().Sugar()
 			validator := validator.New()
 
-			tt.setupMocks(mockRepo)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction).Maybe()
+
+			tt.setupMocks(mockRepo, mockSnowflake)
 
 			interactor := NewMakePaymentInteractor(MakePaymentInteractorDependencies{
 				MakePaymentRepository: mockRepo,
 				Logger:                logger,
 				Validator:             validator,
+				SnowflakeGen:          mockSnowflake,
 			})
 
 			output, err := interactor.Execute(context.Background(), tt.input)
@@ -258,3 +545,15 @@ func TestMakePaymentInteractor_Execute(t *testing.T) {
 		})
 	}
 }
+
+// runInTransaction stands in for WithinTransaction, running fn right away.
+func runInTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+// isBalancedEntry matches a balanced journal entry of the given event.
+func isBalancedEntry(event entity.JournalEvent) func(entity.JournalEntry) bool {
+	return func(entry entity.JournalEntry) bool {
+		return entry.Event == event && entry.IsBalanced()
+	}
+}
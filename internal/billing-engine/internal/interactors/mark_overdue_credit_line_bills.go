@@ -0,0 +1,116 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.MarkOverdueCreditLineBillsUsecase = (*MarkOverdueCreditLineBillsInteractor)(nil)
+
+type (
+	MarkOverdueCreditLineBillsRepository interface {
+		GetOverdueCreditLineBills(ctx context.Context, asOf time.Time) ([]entity.CreditLineBill, error)
+		UpdateCreditLineBillStatus(ctx context.Context, billID uint64, from entity.CreditLineBillStatus, to entity.CreditLineBillStatus, paidAt time.Time) (bool, error)
+		MissCreditLineBillInstallments(ctx context.Context, billID uint64) (int64, error)
+	}
+
+	MarkOverdueCreditLineBillsInteractorDependencies struct {
+		MarkOverdueCreditLineBillsRepository MarkOverdueCreditLineBillsRepository
+		Logger                               *zap.SugaredLogger
+		Validator                            *validator.Validate
+	}
+
+	MarkOverdueCreditLineBillsInteractor struct {
+		repository MarkOverdueCreditLineBillsRepository `validate:"required"`
+		logger     *zap.SugaredLogger                   `validate:"required"`
+		validator  *validator.Validate                  `validate:"required"`
+	}
+)
+
+func NewMarkOverdueCreditLineBillsInteractor(
+	deps MarkOverdueCreditLineBillsInteractorDependencies,
+) *MarkOverdueCreditLineBillsInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &MarkOverdueCreditLineBillsInteractor{
+		repository: deps.MarkOverdueCreditLineBillsRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.MarkOverdueCreditLineBillsUsecase.
+//
+// An OPEN bill past its due date on asOf is OVERDUE and its installments
+// still PENDING are MISSED, so the drawdowns fall into delinquency,
+// reminders and collections like any loan. A bill whose installments were
+// all paid one by one is PAID instead.
+func (m *MarkOverdueCreditLineBillsInteractor) Execute(ctx context.Context, input usecases.MarkOverdueCreditLineBillsInput) (usecases.MarkOverdueCreditLineBillsOutput, error) {
+	if err := m.validator.Struct(input); err != nil {
+		m.logger.Errorw("invalid input", "error", err)
+		return usecases.MarkOverdueCreditLineBillsOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	asOf, err := parseAsOfDate(input.AsOf)
+	if err != nil {
+		return usecases.MarkOverdueCreditLineBillsOutput{}, err
+	}
+
+	bills, err := m.repository.GetOverdueCreditLineBills(ctx, asOf)
+	if err != nil {
+		m.logger.Errorw("failed to get overdue credit line bills", "error", err, "as_of", asOf.Format(dateLayout))
+		return usecases.MarkOverdueCreditLineBillsOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.MarkOverdueCreditLineBillsOutput{
+		AsOf:           asOf.Format(dateLayout),
+		OverdueBillIDs: []uint64{},
+		PaidBillIDs:    []uint64{},
+	}
+
+	for _, bill := range bills {
+		if bill.Outstanding().IsZero() {
+			updated, err := m.repository.UpdateCreditLineBillStatus(ctx, bill.ID, entity.CREDIT_LINE_BILL_OPEN, entity.CREDIT_LINE_BILL_PAID, time.Now())
+			if err != nil {
+				m.logger.Errorw("failed to update credit line bill", "error", err, "bill_id", bill.ID)
+				return usecases.MarkOverdueCreditLineBillsOutput{}, pkgerror.BusinessErrorFrom(err)
+			}
+
+			if updated {
+				output.PaidBillIDs = append(output.PaidBillIDs, bill.ID)
+			}
+			continue
+		}
+
+		updated, err := m.repository.UpdateCreditLineBillStatus(ctx, bill.ID, entity.CREDIT_LINE_BILL_OPEN, entity.CREDIT_LINE_BILL_OVERDUE, time.Time{})
+		if err != nil {
+			m.logger.Errorw("failed to update credit line bill", "error", err, "bill_id", bill.ID)
+			return usecases.MarkOverdueCreditLineBillsOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		// Paid in the meantime
+		if !updated {
+			continue
+		}
+
+		missed, err := m.repository.MissCreditLineBillInstallments(ctx, bill.ID)
+		if err != nil {
+			m.logger.Errorw("failed to miss credit line bill installments", "error", err, "bill_id", bill.ID)
+			return usecases.MarkOverdueCreditLineBillsOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		output.OverdueBillIDs = append(output.OverdueBillIDs, bill.ID)
+		output.MissedInstallments += missed
+	}
+
+	return output, nil
+}
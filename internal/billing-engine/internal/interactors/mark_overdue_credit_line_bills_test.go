@@ -0,0 +1,109 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestMarkOverdueCreditLineBillsInteractor_Execute(t *testing.T) {
+	unpaid := entity.CreditLineBill{
+		ID: 800, CreditLineID: 700, Cycle: "2024-03", Status: entity.CREDIT_LINE_BILL_OPEN,
+		Items: []entity.CreditLineBillItem{
+			{InstallmentID: 1, LoanID: 100, WeekNumber: 3, Amount: decimal.NewFromInt(115000), Status: entity.INSTALLMENT_PENDING},
+			{InstallmentID: 2, LoanID: 100, WeekNumber: 4, Amount: decimal.NewFromInt(110000), Status: entity.INSTALLMENT_PAID},
+		},
+	}
+	settled := entity.CreditLineBill{
+		ID: 801, CreditLineID: 701, Cycle: "2024-03", Status: entity.CREDIT_LINE_BILL_OPEN,
+		Items: []entity.CreditLineBillItem{
+			{InstallmentID: 3, LoanID: 102, WeekNumber: 1, Amount: decimal.NewFromInt(55000), Status: entity.INSTALLMENT_PAID},
+		},
+	}
+	isAsOf := mock.MatchedBy(func(asOf time.Time) bool { return asOf.Format("2006-01-02") == "2024-04-01" })
+
+	tests := []struct {
+		name           string
+		input          usecases.MarkOverdueCreditLineBillsInput
+		setupMocks     func(*billingenginemocks.MockMarkOverdueCreditLineBillsRepository)
+		expectedOutput usecases.MarkOverdueCreditLineBillsOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - unpaid bill overdue, settled bill paid",
+			input: usecases.MarkOverdueCreditLineBillsInput{AsOf: "2024-04-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockMarkOverdueCreditLineBillsRepository) {
+				mockRepo.On("GetOverdueCreditLineBills", mock.Anything, isAsOf).Return([]entity.CreditLineBill{unpaid, settled}, nil)
+				mockRepo.On("UpdateCreditLineBillStatus", mock.Anything, uint64(800), entity.CREDIT_LINE_BILL_OPEN, entity.CREDIT_LINE_BILL_OVERDUE, time.Time{}).
+					Return(true, nil)
+				mockRepo.On("MissCreditLineBillInstallments", mock.Anything, uint64(800)).Return(int64(1), nil)
+				mockRepo.On("UpdateCreditLineBillStatus", mock.Anything, uint64(801), entity.CREDIT_LINE_BILL_OPEN, entity.CREDIT_LINE_BILL_PAID, mock.Anything).
+					Return(true, nil)
+			},
+			expectedOutput: usecases.MarkOverdueCreditLineBillsOutput{
+				AsOf: "2024-04-01", OverdueBillIDs: []uint64{800}, PaidBillIDs: []uint64{801}, MissedInstallments: 1,
+			},
+		},
+		{
+			name:  "success - bill paid in the meantime is skipped",
+			input: usecases.MarkOverdueCreditLineBillsInput{AsOf: "2024-04-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockMarkOverdueCreditLineBillsRepository) {
+				mockRepo.On("GetOverdueCreditLineBills", mock.Anything, isAsOf).Return([]entity.CreditLineBill{unpaid}, nil)
+				mockRepo.On("UpdateCreditLineBillStatus", mock.Anything, uint64(800), entity.CREDIT_LINE_BILL_OPEN, entity.CREDIT_LINE_BILL_OVERDUE, time.Time{}).
+					Return(false, nil)
+			},
+			expectedOutput: usecases.MarkOverdueCreditLineBillsOutput{
+				AsOf: "2024-04-01", OverdueBillIDs: []uint64{}, PaidBillIDs: []uint64{},
+			},
+		},
+		{
+			name:          "error - validation error (as_of format)",
+			input:         usecases.MarkOverdueCreditLineBillsInput{AsOf: "01-04-2024"},
+			setupMocks:    func(*billingenginemocks.MockMarkOverdueCreditLineBillsRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error",
+			input: usecases.MarkOverdueCreditLineBillsInput{AsOf: "2024-04-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockMarkOverdueCreditLineBillsRepository) {
+				mockRepo.On("GetOverdueCreditLineBills", mock.Anything, isAsOf).Return(nil, errors.New("database error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockMarkOverdueCreditLineBillsRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewMarkOverdueCreditLineBillsInteractor(MarkOverdueCreditLineBillsInteractorDependencies{
+				MarkOverdueCreditLineBillsRepository: mockRepo,
+				Logger:                               zap.NewNop().Sugar(),
+				Validator:                            validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
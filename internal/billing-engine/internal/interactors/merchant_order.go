@@ -0,0 +1,45 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+)
+
+// MerchantOrderRepository is embedded by the repositories of the write paths
+// that book a loan for a merchant's order.
+type MerchantOrderRepository interface {
+	GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error)
+	IsMerchantOrderExist(ctx context.Context, merchantID uint64, orderRef string) (bool, error)
+}
+
+// tagMerchantOrder tags the loan with the merchant and order it finances. An
+// order is financed by a single loan.
+func tagMerchantOrder(ctx context.Context, repository MerchantOrderRepository, loan *entity.Loan, merchantID uint64, orderRef string) error {
+	if merchantID == 0 {
+		if orderRef != "" {
+			return pkgerror.NewValidationError("order_ref requires a merchant_id")
+		}
+		return nil
+	}
+
+	if _, err := repository.GetMerchant(ctx, merchantID); err != nil {
+		return pkgerror.BusinessErrorFrom(err)
+	}
+
+	isOrderExist, err := repository.IsMerchantOrderExist(ctx, merchantID, orderRef)
+	if err != nil {
+		return pkgerror.BusinessErrorFrom(err)
+	}
+
+	if isOrderExist {
+		return pkgerror.NewBusinessError(fmt.Sprintf("order %s of merchant %d is already financed", orderRef, merchantID))
+	}
+
+	loan.MerchantID = merchantID
+	loan.OrderRef = orderRef
+
+	return nil
+}
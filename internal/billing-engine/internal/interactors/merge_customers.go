@@ -0,0 +1,144 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.MergeCustomersUsecase = (*MergeCustomersInteractor)(nil)
+
+type (
+	MergeCustomersRepository interface {
+		GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error)
+		MergeCustomer(ctx context.Context, merge entity.CustomerMerge) (entity.CustomerMerge, error)
+		ResolveCustomerDuplicatesOf(ctx context.Context, customerID uint64, status entity.DuplicateStatus, resolvedBy string, resolvedAt time.Time) (int64, error)
+		DeleteCustomer(ctx context.Context, customerID uint64, deletedAt time.Time) (bool, error)
+		TransactionRepository
+	}
+
+	MergeCustomersInteractorDependencies struct {
+		MergeCustomersRepository MergeCustomersRepository
+		Logger                   *zap.SugaredLogger
+		Validator                *validator.Validate
+		SnowflakeGen             pkguid.Snowflake
+	}
+
+	MergeCustomersInteractor struct {
+		repository   MergeCustomersRepository `validate:"required"`
+		logger       *zap.SugaredLogger       `validate:"required"`
+		validator    *validator.Validate      `validate:"required"`
+		snowflakeGen pkguid.Snowflake         `validate:"required"`
+	}
+)
+
+func NewMergeCustomersInteractor(
+	deps MergeCustomersInteractorDependencies,
+) *MergeCustomersInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &MergeCustomersInteractor{
+		repository:   deps.MergeCustomersRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.MergeCustomersUsecase.
+//
+// The loans of the source customer and their history move to the target
+// customer, every row moved is recorded with the merge. The open duplicate
+// flags of the source are closed as MERGED and the source is soft deleted, all
+// in one transaction.
+func (m *MergeCustomersInteractor) Execute(ctx context.Context, input usecases.MergeCustomersInput) (usecases.CustomerMergeOutput, error) {
+	if err := m.validator.Struct(input); err != nil {
+		m.logger.Errorw("invalid input", "error", err)
+		return usecases.CustomerMergeOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	for _, customerID := range []uint64{input.SourceCustomerID, input.TargetCustomerID} {
+		if _, err := m.repository.GetCustomer(ctx, customerID); err != nil {
+			m.logger.Errorw("failed to get customer", "error", err, "customer_id", customerID)
+			return usecases.CustomerMergeOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+	}
+
+	mergedAt := time.Now()
+
+	// The rows are moved, the flags closed and the source deleted together, a
+	// merge that cannot delete the source is rolled back as a whole
+	var merge entity.CustomerMerge
+	err := m.repository.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		merge, err = m.repository.MergeCustomer(ctx, entity.CustomerMerge{
+			ID:               m.snowflakeGen.Generate(),
+			SourceCustomerID: input.SourceCustomerID,
+			TargetCustomerID: input.TargetCustomerID,
+			Reason:           input.Reason,
+			MergedBy:         input.MergedBy,
+			MergedAt:         mergedAt,
+		})
+		if err != nil {
+			m.logger.Errorw("failed to merge customers", "error", err,
+				"source_customer_id", input.SourceCustomerID, "target_customer_id", input.TargetCustomerID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		if _, err := m.repository.ResolveCustomerDuplicatesOf(ctx, input.SourceCustomerID, entity.DUPLICATE_MERGED, input.MergedBy, mergedAt); err != nil {
+			m.logger.Errorw("failed to resolve customer duplicates", "error", err, "customer_id", input.SourceCustomerID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		deleted, err := m.repository.DeleteCustomer(ctx, input.SourceCustomerID, mergedAt)
+		if err != nil {
+			m.logger.Errorw("failed to delete customer", "error", err, "customer_id", input.SourceCustomerID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		// A loan applied for on the source while merging keeps it open
+		if !deleted {
+			return pkgerror.NewBusinessError(
+				fmt.Sprintf("customer %d still has open loans after the merge, merge again", input.SourceCustomerID),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return usecases.CustomerMergeOutput{}, err
+	}
+
+	return toCustomerMergeOutput(merge), nil
+}
+
+func toCustomerMergeOutput(merge entity.CustomerMerge) usecases.CustomerMergeOutput {
+	output := usecases.CustomerMergeOutput{
+		ID:               merge.ID,
+		SourceCustomerID: merge.SourceCustomerID,
+		TargetCustomerID: merge.TargetCustomerID,
+		Reason:           merge.Reason,
+		MergedBy:         merge.MergedBy,
+		MergedAt:         merge.MergedAt.Format(time.RFC3339),
+		Records:          make([]usecases.CustomerMergeRecordOutput, len(merge.Records)),
+	}
+
+	for i, record := range merge.Records {
+		output.Records[i] = usecases.CustomerMergeRecordOutput{
+			TableName: record.TableName,
+			RecordID:  record.RecordID,
+		}
+	}
+
+	return output
+}
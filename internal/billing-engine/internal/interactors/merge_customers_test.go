@@ -0,0 +1,146 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestMergeCustomersInteractor_Execute(t *testing.T) {
+	input := usecases.MergeCustomersInput{
+		SourceCustomerID: 2,
+		TargetCustomerID: 1,
+		Reason:           "same NIK, signed up twice",
+		MergedBy:         "ops@example.com",
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.MergeCustomersInput
+		setupMocks    func(*billingenginemocks.MockMergeCustomersRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.CustomerMergeOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - loans and history moved, flags merged and source deleted",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockMergeCustomersRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(2)).Return(entity.Customer{ID: 2}, nil)
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(entity.Customer{ID: 1}, nil)
+				mockSnowflake.On("Generate").Return(uint64(99))
+				mockRepo.EXPECT().MergeCustomer(mock.Anything, mock.MatchedBy(func(merge entity.CustomerMerge) bool {
+					return merge.ID == 99 && merge.SourceCustomerID == 2 && merge.TargetCustomerID == 1 &&
+						merge.Reason == "same NIK, signed up twice" && merge.MergedBy == "ops@example.com" && !merge.MergedAt.IsZero()
+				})).RunAndReturn(func(_ context.Context, merge entity.CustomerMerge) (entity.CustomerMerge, error) {
+					merge.Records = []entity.CustomerMergeRecord{
+						{ID: 100, MergeID: 99, TableName: "loans", RecordID: 10},
+						{ID: 101, MergeID: 99, TableName: "disbursements", RecordID: 20},
+					}
+					return merge, nil
+				})
+				mockRepo.On("ResolveCustomerDuplicatesOf", mock.Anything, uint64(2), entity.DUPLICATE_MERGED, "ops@example.com", mock.Anything).
+					Return(int64(1), nil)
+				mockRepo.On("DeleteCustomer", mock.Anything, uint64(2), mock.Anything).Return(true, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CustomerMergeOutput) {
+				assert.Equal(t, uint64(99), output.ID)
+				assert.Equal(t, uint64(2), output.SourceCustomerID)
+				assert.Equal(t, uint64(1), output.TargetCustomerID)
+				assert.NotEmpty(t, output.MergedAt)
+				assert.Equal(t, []usecases.CustomerMergeRecordOutput{
+					{TableName: "loans", RecordID: 10},
+					{TableName: "disbursements", RecordID: 20},
+				}, output.Records)
+			},
+		},
+		{
+			name:          "validation error - merge into itself",
+			input:         usecases.MergeCustomersInput{SourceCustomerID: 1, TargetCustomerID: 1, Reason: "x", MergedBy: "ops"},
+			setupMocks:    func(*billingenginemocks.MockMergeCustomersRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "validation error - missing reason",
+			input:         usecases.MergeCustomersInput{SourceCustomerID: 2, TargetCustomerID: 1, MergedBy: "ops"},
+			setupMocks:    func(*billingenginemocks.MockMergeCustomersRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "business error - target customer not found",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockMergeCustomersRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(2)).Return(entity.Customer{ID: 2}, nil)
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(entity.Customer{}, errors.New("customer 1 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "business error - moving the records fails",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockMergeCustomersRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCustomer", mock.Anything, mock.Anything).Return(entity.Customer{}, nil)
+				mockSnowflake.On("Generate").Return(uint64(99))
+				mockRepo.On("MergeCustomer", mock.Anything, mock.Anything).Return(entity.CustomerMerge{}, errors.New("database error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "business error - a loan was opened on the source while merging, the merge is rolled back",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockMergeCustomersRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetCustomer", mock.Anything, mock.Anything).Return(entity.Customer{}, nil)
+				mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						err := fn(ctx)
+						assert.Error(t, err, "the transaction must be rolled back")
+						return err
+					}).Once()
+				mockSnowflake.On("Generate").Return(uint64(99))
+				mockRepo.On("MergeCustomer", mock.Anything, mock.Anything).Return(entity.CustomerMerge{ID: 99}, nil)
+				mockRepo.On("ResolveCustomerDuplicatesOf", mock.Anything, uint64(2), entity.DUPLICATE_MERGED, "ops@example.com", mock.Anything).
+					Return(int64(0), nil)
+				mockRepo.On("DeleteCustomer", mock.Anything, uint64(2), mock.Anything).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockMergeCustomersRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			tt.setupMocks(mockRepo, mockSnowflake)
+			mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction).Maybe()
+
+			interactor := NewMergeCustomersInteractor(MergeCustomersInteractorDependencies{
+				MergeCustomersRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+				Validator:                validator.New(),
+				SnowflakeGen:             mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				tt.expectedCheck(t, output)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockSnowflake.AssertExpectations(t)
+		})
+	}
+}
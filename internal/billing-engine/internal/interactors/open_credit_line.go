@@ -0,0 +1,141 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.OpenCreditLineUsecase = (*OpenCreditLineInteractor)(nil)
+
+type (
+	OpenCreditLineRepository interface {
+		IsCustomerExist(ctx context.Context, customerID uint64) (bool, error)
+		EligibilityRepository
+		CreateCreditLine(ctx context.Context, line entity.CreditLine) (entity.CreditLine, error)
+	}
+
+	OpenCreditLineInteractorDependencies struct {
+		OpenCreditLineRepository OpenCreditLineRepository
+		Logger                   *zap.SugaredLogger
+		Validator                *validator.Validate
+		SnowflakeGen             pkguid.Snowflake
+	}
+
+	OpenCreditLineInteractor struct {
+		repository   OpenCreditLineRepository `validate:"required"`
+		logger       *zap.SugaredLogger       `validate:"required"`
+		validator    *validator.Validate      `validate:"required"`
+		snowflakeGen pkguid.Snowflake         `validate:"required"`
+	}
+)
+
+func NewOpenCreditLineInteractor(
+	deps OpenCreditLineInteractorDependencies,
+) *OpenCreditLineInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &OpenCreditLineInteractor{
+		repository:   deps.OpenCreditLineRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.OpenCreditLineUsecase.
+//
+// The limit of the line is granted here, its drawdowns are approved against
+// it without going through the loan application review. The whole limit is
+// counted against the customer's credit limit, as a loan of that principal
+// would be.
+func (o *OpenCreditLineInteractor) Execute(ctx context.Context, input usecases.OpenCreditLineInput) (usecases.CreditLineOutput, error) {
+	if err := o.validator.Struct(input); err != nil {
+		o.logger.Errorw("invalid input", "error", err)
+		return usecases.CreditLineOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	limit, err := decimal.NewFromString(input.CreditLimit)
+	if err != nil {
+		return usecases.CreditLineOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if !limit.IsPositive() || limit.Exponent() < -2 {
+		return usecases.CreditLineOutput{}, pkgerror.NewValidationError("credit limit must be a positive amount with at most 2 decimals")
+	}
+
+	isCustomerExist, err := o.repository.IsCustomerExist(ctx, input.CustomerID)
+	if err != nil {
+		o.logger.Errorw("failed to check if customer exists", "error", err, "customer_id", input.CustomerID)
+		return usecases.CreditLineOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !isCustomerExist {
+		return usecases.CreditLineOutput{}, pkgerror.NewBusinessError("customer not found")
+	}
+
+	checks, err := runEligibilityChecks(ctx, o.repository, input.CustomerID, limit)
+	if err != nil {
+		o.logger.Errorw("failed to run eligibility checks", "error", err, "customer_id", input.CustomerID)
+		return usecases.CreditLineOutput{}, err
+	}
+
+	if failed := failedEligibilityChecks(checks); len(failed) > 0 {
+		return usecases.CreditLineOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("customer %d is not eligible for a credit line: %s", input.CustomerID, strings.Join(failed, ", ")),
+		)
+	}
+
+	productCode := input.ProductCode
+	if productCode == "" {
+		productCode = entity.DEFAULT_CREDIT_LINE_PRODUCT
+	}
+
+	line, err := o.repository.CreateCreditLine(ctx, entity.CreditLine{
+		ID:          o.snowflakeGen.Generate(),
+		CustomerID:  input.CustomerID,
+		Limit:       limit,
+		ProductCode: productCode,
+		OpenedBy:    input.OpenedBy,
+		OpenedAt:    time.Now(),
+	})
+	if err != nil {
+		o.logger.Errorw("failed to create credit line", "error", err, "customer_id", input.CustomerID)
+		return usecases.CreditLineOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toCreditLineOutput(line, decimal.Zero, false, nil), nil
+}
+
+func toCreditLineOutput(line entity.CreditLine, used decimal.Decimal, overdue bool, drawdowns []entity.Loan) usecases.CreditLineOutput {
+	output := usecases.CreditLineOutput{
+		ID:          line.ID,
+		CustomerID:  line.CustomerID,
+		CreditLimit: line.Limit.StringFixed(2),
+		Used:        used.StringFixed(2),
+		Available:   line.Available(used).StringFixed(2),
+		Overdue:     overdue,
+		ProductCode: line.ProductCode,
+		OpenedBy:    line.OpenedBy,
+		OpenedAt:    line.OpenedAt.Format(time.RFC3339),
+		Drawdowns:   make([]usecases.LoanOutput, len(drawdowns)),
+	}
+
+	for i, loan := range drawdowns {
+		output.Drawdowns[i] = toLoanOutput(loan)
+	}
+
+	return output
+}
@@ -0,0 +1,156 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestOpenCreditLineInteractor_Execute(t *testing.T) {
+	input := usecases.OpenCreditLineInput{
+		CustomerID:  10,
+		CreditLimit: "2000000",
+		OpenedBy:    "credit-officer",
+	}
+	limit := entity.CreditLimit{CustomerID: 10, Limit: decimal.NewFromInt(5000000), MaxActiveLoans: 3}
+	exposure := entity.CustomerExposure{CustomerID: 10, ActiveLoans: 1, Outstanding: decimal.NewFromInt(2500000)}
+
+	tests := []struct {
+		name          string
+		input         usecases.OpenCreditLineInput
+		setupMocks    func(*billingenginemocks.MockOpenCreditLineRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.CreditLineOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - line opened on the default product",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockOpenCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(true, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(10)).Return(limit, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(10)).Return(exposure, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(10)).Return(false, nil)
+				mockSnowflake.On("Generate").Return(uint64(700))
+				mockRepo.EXPECT().CreateCreditLine(mock.Anything, mock.MatchedBy(func(line entity.CreditLine) bool {
+					return line.ID == 700 && line.CustomerID == 10 && line.Limit.Equal(decimal.NewFromInt(2000000)) &&
+						line.ProductCode == entity.DEFAULT_CREDIT_LINE_PRODUCT && line.OpenedBy == "credit-officer"
+				})).RunAndReturn(func(_ context.Context, line entity.CreditLine) (entity.CreditLine, error) {
+					return line, nil
+				})
+			},
+			expectedCheck: func(t *testing.T, output usecases.CreditLineOutput) {
+				assert.Equal(t, uint64(700), output.ID)
+				assert.Equal(t, "2000000.00", output.CreditLimit)
+				assert.Equal(t, "0.00", output.Used)
+				assert.Equal(t, "2000000.00", output.Available)
+				assert.Equal(t, "PAYLATER", output.ProductCode)
+				assert.False(t, output.Overdue)
+				assert.Equal(t, []usecases.LoanOutput{}, output.Drawdowns)
+			},
+		},
+		{
+			name: "error - limit with more than 2 decimals",
+			input: usecases.OpenCreditLineInput{
+				CustomerID: 10, CreditLimit: "1000.125", OpenedBy: "credit-officer",
+			},
+			setupMocks:    func(*billingenginemocks.MockOpenCreditLineRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - limit not positive",
+			input: usecases.OpenCreditLineInput{
+				CustomerID: 10, CreditLimit: "0", OpenedBy: "credit-officer",
+			},
+			setupMocks:    func(*billingenginemocks.MockOpenCreditLineRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer not found",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockOpenCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer has a written off loan",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockOpenCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(true, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(10)).Return(limit, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(10)).Return(exposure, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(10)).Return(true, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - limit exceeds what is left of the customer's credit limit",
+			input: usecases.OpenCreditLineInput{
+				CustomerID: 10, CreditLimit: "2500000.01", OpenedBy: "credit-officer",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockOpenCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(true, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(10)).Return(limit, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(10)).Return(exposure, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(10)).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer at the active loan limit",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockOpenCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(true, nil)
+				mockRepo.On("GetCreditLimit", mock.Anything, uint64(10)).Return(limit, nil)
+				mockRepo.On("GetCustomerExposure", mock.Anything, uint64(10)).Return(entity.CustomerExposure{CustomerID: 10, ActiveLoans: 3}, nil)
+				mockRepo.On("IsCustomerHasWrittenOffLoan", mock.Anything, uint64(10)).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockOpenCreditLineRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(10)).Return(false, errors.New("database error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockOpenCreditLineRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewOpenCreditLineInteractor(OpenCreditLineInteractorDependencies{
+				OpenCreditLineRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+				Validator:                validator.New(),
+				SnowflakeGen:             mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.PayCreditLineBillUsecase = (*PayCreditLineBillInteractor)(nil)
+
+type (
+	PayCreditLineBillRepository interface {
+		PeriodLockRepository
+		InstallmentPaymentRepository
+		GetCreditLineBill(ctx context.Context, billID uint64) (entity.CreditLineBill, error)
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		UpdateCreditLineBillStatus(ctx context.Context, billID uint64, from entity.CreditLineBillStatus, to entity.CreditLineBillStatus, paidAt time.Time) (bool, error)
+	}
+
+	PayCreditLineBillInteractorDependencies struct {
+		PayCreditLineBillRepository PayCreditLineBillRepository
+		Logger                      *zap.SugaredLogger
+		Validator                   *validator.Validate
+		SnowflakeGen                pkguid.Snowflake
+	}
+
+	PayCreditLineBillInteractor struct {
+		repository   PayCreditLineBillRepository `validate:"required"`
+		logger       *zap.SugaredLogger          `validate:"required"`
+		validator    *validator.Validate         `validate:"required"`
+		snowflakeGen pkguid.Snowflake            `validate:"required"`
+	}
+)
+
+func NewPayCreditLineBillInteractor(
+	deps PayCreditLineBillInteractorDependencies,
+) *PayCreditLineBillInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &PayCreditLineBillInteractor{
+		repository:   deps.PayCreditLineBillRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.PayCreditLineBillUsecase.
+//
+// The payment has to settle what is outstanding on the bill. Each unpaid
+// installment is paid as if it was paid on its own, so drawdowns become PAID
+// with their last installment and the limit they used is restored. A payment
+// that failed half way pays the rest when it is made again.
+func (p *PayCreditLineBillInteractor) Execute(ctx context.Context, input usecases.PayCreditLineBillInput) (usecases.CreditLineBillOutput, error) {
+	if err := p.validator.Struct(input); err != nil {
+		p.logger.Errorw("invalid input", "error", err)
+		return usecases.CreditLineBillOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	amount, err := decimal.NewFromString(input.Amount)
+	if err != nil {
+		return usecases.CreditLineBillOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	effectiveDate, err := parseEffectiveDate(input.EffectiveDate)
+	if err != nil {
+		return usecases.CreditLineBillOutput{}, err
+	}
+
+	if err := ensurePeriodOpen(ctx, p.repository, effectiveDate); err != nil {
+		p.logger.Errorw("failed to check period lock", "error", err, "bill_id", input.BillID, "effective_date", effectiveDate.Format(dateLayout))
+		return usecases.CreditLineBillOutput{}, err
+	}
+
+	paidAt := time.Now()
+	if input.EffectiveDate != "" {
+		paidAt = effectiveDate
+	}
+
+	bill, err := p.repository.GetCreditLineBill(ctx, input.BillID)
+	if err != nil {
+		p.logger.Errorw("failed to get credit line bill", "error", err, "bill_id", input.BillID)
+		return usecases.CreditLineBillOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if bill.Status == entity.CREDIT_LINE_BILL_PAID {
+		return usecases.CreditLineBillOutput{}, pkgerror.NewBusinessError(fmt.Sprintf("credit line bill %d is already paid", bill.ID))
+	}
+
+	outstanding := bill.Outstanding()
+	if !amount.Equal(outstanding) {
+		return usecases.CreditLineBillOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("payment amount %s does not match the %s outstanding on credit line bill %d", amount.StringFixed(2), outstanding.StringFixed(2), bill.ID),
+		)
+	}
+
+	// The installments, their journal entries and the bill are paid in one
+	// transaction, so a failure leaves the whole bill unpaid
+	err = p.repository.WithinTransaction(ctx, func(ctx context.Context) error {
+		loans := make(map[uint64]entity.Loan)
+		for i, item := range bill.Items {
+			if !item.IsUnpaid() {
+				continue
+			}
+
+			loan, ok := loans[item.LoanID]
+			if !ok {
+				if loan, err = p.repository.GetLoan(ctx, item.LoanID); err != nil {
+					p.logger.Errorw("failed to get loan", "error", err, "loan_id", item.LoanID)
+					return pkgerror.BusinessErrorFrom(err)
+				}
+				loans[item.LoanID] = loan
+			}
+
+			if err := payInstallment(ctx, p.repository, p.snowflakeGen, loan, item.WeekNumber, item.Amount.StringFixed(2), paidAt); err != nil {
+				p.logger.Errorw("failed to pay installment", "error", err, "bill_id", bill.ID, "loan_id", item.LoanID, "week_number", item.WeekNumber)
+				return err
+			}
+
+			bill.Items[i].Status = entity.INSTALLMENT_PAID
+		}
+
+		updated, err := p.repository.UpdateCreditLineBillStatus(ctx, bill.ID, bill.Status, entity.CREDIT_LINE_BILL_PAID, paidAt)
+		if err != nil {
+			p.logger.Errorw("failed to update credit line bill", "error", err, "bill_id", bill.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		if !updated {
+			return pkgerror.NewBusinessError(fmt.Sprintf("credit line bill %d is no longer %s", bill.ID, bill.Status))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return usecases.CreditLineBillOutput{}, err
+	}
+
+	bill.Status = entity.CREDIT_LINE_BILL_PAID
+	bill.PaidAt = paidAt
+
+	return toCreditLineBillOutput(bill), nil
+}
@@ -0,0 +1,150 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestPayCreditLineBillInteractor_Execute(t *testing.T) {
+	newBill := func(status entity.CreditLineBillStatus) entity.CreditLineBill {
+		return entity.CreditLineBill{
+			ID: 800, CreditLineID: 700, Cycle: "2024-03", DueDate: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+			Amount: decimal.NewFromInt(280000), Status: status, CreatedAt: time.Date(2024, 3, 31, 1, 0, 0, 0, time.UTC),
+			Items: []entity.CreditLineBillItem{
+				{ID: 1, BillID: 800, InstallmentID: 1, LoanID: 100, WeekNumber: 3, Amount: decimal.NewFromInt(115000), Status: entity.INSTALLMENT_MISSED},
+				{ID: 2, BillID: 800, InstallmentID: 2, LoanID: 100, WeekNumber: 4, Amount: decimal.NewFromInt(110000), Status: entity.INSTALLMENT_PENDING},
+				{ID: 3, BillID: 800, InstallmentID: 3, LoanID: 101, WeekNumber: 1, Amount: decimal.NewFromInt(55000), Status: entity.INSTALLMENT_PAID},
+			},
+		}
+	}
+	drawdown := entity.Loan{ID: 100, CreditLineID: 700, InterestRate: decimal.NewFromFloat(0.1), Status: entity.LOAN_DISBURSED}
+
+	tests := []struct {
+		name          string
+		input         usecases.PayCreditLineBillInput
+		setupMocks    func(*billingenginemocks.MockPayCreditLineBillRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.CreditLineBillOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - unpaid installments of the bill paid",
+			input: usecases.PayCreditLineBillInput{BillID: 800, Amount: "225000"},
+			setupMocks: func(mockRepo *billingenginemocks.MockPayCreditLineBillRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetCreditLineBill", mock.Anything, uint64(800)).Return(newBill(entity.CREDIT_LINE_BILL_OVERDUE), nil)
+				// The drawdown is read once for the bill, then after each installment paid
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(drawdown, nil).Times(3)
+				mockRepo.On("MakePayment", mock.Anything, uint64(100), int64(3), "115000.00", mock.Anything).Return(nil)
+				mockRepo.On("GetInstallment", mock.Anything, uint64(100), int64(3)).
+					Return(entity.Installment{LoanID: 100, WeekNumber: 3, FeeAmount: "5000.00"}, nil)
+				mockRepo.On("MakePayment", mock.Anything, uint64(100), int64(4), "110000.00", mock.Anything).Return(nil)
+				mockRepo.On("GetInstallment", mock.Anything, uint64(100), int64(4)).
+					Return(entity.Installment{LoanID: 100, WeekNumber: 4, FeeAmount: "0.00"}, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(isBalancedEntry(entity.JOURNAL_PAYMENT))).
+					Return(entity.JournalEntry{}, nil).Twice()
+				mockRepo.On("UpdateCreditLineBillStatus", mock.Anything, uint64(800), entity.CREDIT_LINE_BILL_OVERDUE, entity.CREDIT_LINE_BILL_PAID, mock.Anything).
+					Return(true, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CreditLineBillOutput) {
+				assert.Equal(t, "PAID", output.Status)
+				assert.Equal(t, "0.00", output.Outstanding)
+				assert.NotEmpty(t, output.PaidAt)
+				for _, item := range output.Items {
+					assert.Equal(t, "PAID", item.Status)
+				}
+			},
+		},
+		{
+			name:  "error - amount not the outstanding",
+			input: usecases.PayCreditLineBillInput{BillID: 800, Amount: "280000"},
+			setupMocks: func(mockRepo *billingenginemocks.MockPayCreditLineBillRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetCreditLineBill", mock.Anything, uint64(800)).Return(newBill(entity.CREDIT_LINE_BILL_OPEN), nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - bill already paid",
+			input: usecases.PayCreditLineBillInput{BillID: 800, Amount: "225000"},
+			setupMocks: func(mockRepo *billingenginemocks.MockPayCreditLineBillRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetCreditLineBill", mock.Anything, uint64(800)).Return(newBill(entity.CREDIT_LINE_BILL_PAID), nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - bill paid concurrently",
+			input: usecases.PayCreditLineBillInput{BillID: 800, Amount: "0"},
+			setupMocks: func(mockRepo *billingenginemocks.MockPayCreditLineBillRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				bill := newBill(entity.CREDIT_LINE_BILL_OPEN)
+				for i := range bill.Items {
+					bill.Items[i].Status = entity.INSTALLMENT_PAID
+				}
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetCreditLineBill", mock.Anything, uint64(800)).Return(bill, nil)
+				mockRepo.On("UpdateCreditLineBillStatus", mock.Anything, uint64(800), entity.CREDIT_LINE_BILL_OPEN, entity.CREDIT_LINE_BILL_PAID, mock.Anything).
+					Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - installment payment failed",
+			input: usecases.PayCreditLineBillInput{BillID: 800, Amount: "225000"},
+			setupMocks: func(mockRepo *billingenginemocks.MockPayCreditLineBillRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetCreditLineBill", mock.Anything, uint64(800)).Return(newBill(entity.CREDIT_LINE_BILL_OPEN), nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(drawdown, nil)
+				mockRepo.On("MakePayment", mock.Anything, uint64(100), int64(3), "115000.00", mock.Anything).
+					Return(errors.New("installment 3 of loan 100 is already paid"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (amount not numeric)",
+			input:         usecases.PayCreditLineBillInput{BillID: 800, Amount: "abc"},
+			setupMocks:    func(*billingenginemocks.MockPayCreditLineBillRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockPayCreditLineBillRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction).Maybe()
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewPayCreditLineBillInteractor(PayCreditLineBillInteractorDependencies{
+				PayCreditLineBillRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+				Validator:                   validator.New(),
+				SnowflakeGen:                mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+)
+
+// PeriodLockRepository is embedded by the repositories of every write path
+// that books on an effective date.
+type PeriodLockRepository interface {
+	GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error)
+}
+
+// ensurePeriodOpen rejects a write effective on date when date falls in a
+// closed accounting period.
+func ensurePeriodOpen(ctx context.Context, repository PeriodLockRepository, date time.Time) error {
+	latest, err := repository.GetLatestClosedPeriod(ctx)
+	if err != nil {
+		return pkgerror.BusinessErrorFrom(err)
+	}
+
+	if latest.Locks(date) {
+		return pkgerror.NewBusinessError(fmt.Sprintf("accounting period %s is closed", entity.PeriodOf(date)))
+	}
+
+	return nil
+}
+
+// parseEffectiveDate parses an optional YYYY-MM-DD effective date, falling
+// back to today's date. Effective dates cannot be in the future.
+func parseEffectiveDate(value string) (time.Time, error) {
+	date, err := parseAsOfDate(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if date.After(startOfDay(time.Now())) {
+		return time.Time{}, pkgerror.NewValidationError("effective_date cannot be in the future")
+	}
+
+	return date, nil
+}
@@ -0,0 +1,178 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.QuoteLoanUsecase = (*QuoteLoanInteractor)(nil)
+
+type (
+	// ProductFeeRepository reads the fees a loan of a product is charged.
+	ProductFeeRepository interface {
+		GetProductFees(ctx context.Context, productCode string) ([]entity.ProductFee, error)
+	}
+
+	QuoteLoanRepository interface {
+		ProductFeeRepository
+	}
+
+	QuoteLoanInteractorDependencies struct {
+		QuoteLoanRepository QuoteLoanRepository
+		Logger              *zap.SugaredLogger
+		Validator           *validator.Validate
+	}
+
+	QuoteLoanInteractor struct {
+		repository QuoteLoanRepository `validate:"required"`
+		logger     *zap.SugaredLogger  `validate:"required"`
+		validator  *validator.Validate `validate:"required"`
+	}
+)
+
+func NewQuoteLoanInteractor(
+	deps QuoteLoanInteractorDependencies,
+) *QuoteLoanInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &QuoteLoanInteractor{
+		repository: deps.QuoteLoanRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.QuoteLoanUsecase.
+//
+// Nothing is stored: the loan is priced as if it were disbursed today with the
+// current fees of its product.
+func (q *QuoteLoanInteractor) Execute(ctx context.Context, input usecases.QuoteLoanInput) (usecases.LoanQuoteOutput, error) {
+	if err := q.validator.Struct(input); err != nil {
+		q.logger.Errorw("invalid input", "error", err)
+		return usecases.LoanQuoteOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	loan := entity.NewLoanApplication(0, "")
+	if err := applyRequestedTerms(loan, input.ProductCode, input.PrincipalAmount, input.TermWeeks); err != nil {
+		return usecases.LoanQuoteOutput{}, err
+	}
+
+	quote, err := quoteLoan(ctx, q.repository, *loan, startOfDay(time.Now()))
+	if err != nil {
+		q.logger.Errorw("failed to quote loan", "error", err, "product_code", loan.ProductCode)
+		return usecases.LoanQuoteOutput{}, err
+	}
+
+	return toLoanQuoteOutput(quote), nil
+}
+
+// applyRequestedTerms replaces the default terms of loan with the ones the
+// borrower asked for, leaving the defaults of the empty ones.
+func applyRequestedTerms(loan *entity.Loan, productCode string, principalAmount string, termWeeks int64) error {
+	if productCode != "" {
+		loan.ProductCode = productCode
+	}
+
+	if principalAmount != "" {
+		principal, err := decimal.NewFromString(principalAmount)
+		if err != nil {
+			return pkgerror.ValidationErrorFrom(err)
+		}
+
+		if !principal.IsPositive() || principal.Exponent() < -2 {
+			return pkgerror.NewValidationError("principal amount must be a positive amount with at most 2 decimals")
+		}
+
+		loan.PrincipalAmount = principal
+	}
+
+	if termWeeks > 0 {
+		loan.TermWeeks = termWeeks
+	}
+
+	return nil
+}
+
+// quoteLoan prices loan with the current fees of its product, as if it were
+// disbursed on startDate.
+func quoteLoan(ctx context.Context, repository ProductFeeRepository, loan entity.Loan, startDate time.Time) (entity.LoanQuote, error) {
+	productFees, err := repository.GetProductFees(ctx, loan.ProductCode)
+	if err != nil {
+		return entity.LoanQuote{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	fees := entity.PreviewLoanFees(loan, productFees, time.Now())
+
+	deducted := entity.TotalFees(fees, entity.FEE_DEDUCTED)
+	if deducted.GreaterThanOrEqual(loan.PrincipalAmount) {
+		return entity.LoanQuote{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("fees of %s deducted at disbursement leave nothing to pay out of %s", deducted.StringFixed(2), loan.PrincipalAmount.StringFixed(2)),
+		)
+	}
+
+	// A sliver left to pay out against the whole repayment has no meaningful
+	// effective rate
+	quote, err := entity.QuoteLoan(loan, fees, startDate)
+	if errors.Is(err, entity.ErrUnboundedEffectiveRate) {
+		return entity.LoanQuote{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("fees of %s deducted at disbursement leave too little of %s to pay out", deducted.StringFixed(2), loan.PrincipalAmount.StringFixed(2)),
+		)
+	}
+	if err != nil {
+		return entity.LoanQuote{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return quote, nil
+}
+
+func toLoanQuoteOutput(quote entity.LoanQuote) usecases.LoanQuoteOutput {
+	output := usecases.LoanQuoteOutput{
+		ProductCode:         quote.ProductCode,
+		PrincipalAmount:     quote.PrincipalAmount.StringFixed(2),
+		InterestRate:        quote.InterestRate.String(),
+		TermWeeks:           quote.TermWeeks,
+		AmountReceived:      quote.AmountReceived.StringFixed(2),
+		TotalInterest:       quote.TotalInterest.StringFixed(2),
+		TotalFees:           quote.TotalFees.StringFixed(2),
+		TotalRepayment:      quote.TotalRepayment.StringFixed(2),
+		EffectiveAnnualRate: quote.EffectiveAnnualRate.StringFixed(4),
+		Fees:                make([]usecases.QuoteFeeOutput, len(quote.Fees)),
+		Schedule:            make([]usecases.QuoteInstallmentOutput, len(quote.Schedule)),
+	}
+
+	for i, fee := range quote.Fees {
+		output.Fees[i] = usecases.QuoteFeeOutput{
+			FeeType:     string(fee.FeeType),
+			Calculation: string(fee.Calculation),
+			Value:       fee.Value.String(),
+			Collection:  string(fee.Collection),
+			Amount:      fee.Amount.StringFixed(2),
+		}
+	}
+
+	for i, installment := range quote.Schedule {
+		output.Schedule[i] = usecases.QuoteInstallmentOutput{
+			WeekNumber: installment.WeekNumber,
+			DueDate:    installment.DueDate.Format(dateLayout),
+			Principal:  installment.Principal.StringFixed(2),
+			Interest:   installment.Interest.StringFixed(2),
+			FeeAmount:  installment.FeeAmount.StringFixed(2),
+			TotalDue:   installment.TotalDue().StringFixed(2),
+			Fees:       toInstallmentFeeOutputs(installment.Fees),
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,132 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestQuoteLoanInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         usecases.QuoteLoanInput
+		setupMocks    func(*billingenginemocks.MockQuoteLoanRepository)
+		expectedCheck func(*testing.T, usecases.LoanQuoteOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - schedule with fees deducted and amortised",
+			input: usecases.QuoteLoanInput{PrincipalAmount: "1000000", TermWeeks: 3},
+			setupMocks: func(mockRepo *billingenginemocks.MockQuoteLoanRepository) {
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee{
+					{ProductCode: entity.DEFAULT_LOAN_PRODUCT, FeeType: entity.FEE_ADMIN, Calculation: entity.FEE_FLAT, Value: decimal.NewFromInt(10000), Collection: entity.FEE_AMORTISED},
+					{ProductCode: entity.DEFAULT_LOAN_PRODUCT, FeeType: entity.FEE_ORIGINATION, Calculation: entity.FEE_PERCENTAGE, Value: decimal.NewFromFloat(0.02), Collection: entity.FEE_DEDUCTED},
+				}, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.LoanQuoteOutput) {
+				assert.Equal(t, entity.DEFAULT_LOAN_PRODUCT, output.ProductCode)
+				assert.Equal(t, "980000.00", output.AmountReceived)
+				assert.Equal(t, "100000.00", output.TotalInterest)
+				assert.Equal(t, "30000.00", output.TotalFees)
+				assert.Equal(t, "1110000.00", output.TotalRepayment)
+				assert.Equal(t, "25.3892", output.EffectiveAnnualRate)
+				assert.Len(t, output.Fees, 2)
+				assert.Len(t, output.Schedule, 3)
+				assert.Equal(t, "333333.34", output.Schedule[2].Principal)
+				assert.Equal(t, "33333.34", output.Schedule[2].Interest)
+				assert.Equal(t, "3333.34", output.Schedule[2].FeeAmount)
+				assert.Equal(t, "370000.02", output.Schedule[2].TotalDue)
+				assert.Equal(t, []usecases.InstallmentFeeOutput{{FeeType: "ADMIN", Amount: "3333.33"}}, output.Schedule[0].Fees)
+			},
+		},
+		{
+			name:  "success - product without fees",
+			input: usecases.QuoteLoanInput{ProductCode: "PAYDAY", PrincipalAmount: "5000000", TermWeeks: 50},
+			setupMocks: func(mockRepo *billingenginemocks.MockQuoteLoanRepository) {
+				mockRepo.On("GetProductFees", mock.Anything, "PAYDAY").Return(nil, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.LoanQuoteOutput) {
+				assert.Equal(t, "5000000.00", output.AmountReceived)
+				assert.Equal(t, "0.00", output.TotalFees)
+				assert.Equal(t, "5500000.00", output.TotalRepayment)
+				assert.Equal(t, "0.2183", output.EffectiveAnnualRate)
+				assert.Len(t, output.Schedule, 50)
+			},
+		},
+		{
+			name:          "error - validation error (missing term)",
+			input:         usecases.QuoteLoanInput{PrincipalAmount: "1000000"},
+			setupMocks:    func(*billingenginemocks.MockQuoteLoanRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - non positive principal",
+			input:         usecases.QuoteLoanInput{PrincipalAmount: "0", TermWeeks: 10},
+			setupMocks:    func(*billingenginemocks.MockQuoteLoanRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - deducted fees leave nothing to pay out",
+			input: usecases.QuoteLoanInput{PrincipalAmount: "40000", TermWeeks: 4},
+			setupMocks: func(mockRepo *billingenginemocks.MockQuoteLoanRepository) {
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee{
+					{ProductCode: entity.DEFAULT_LOAN_PRODUCT, FeeType: entity.FEE_ADMIN, Calculation: entity.FEE_FLAT, Value: decimal.NewFromInt(50000), Collection: entity.FEE_DEDUCTED},
+				}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - deducted fees leave too little to pay out for a finite rate",
+			input: usecases.QuoteLoanInput{PrincipalAmount: "40000", TermWeeks: 4},
+			setupMocks: func(mockRepo *billingenginemocks.MockQuoteLoanRepository) {
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return([]entity.ProductFee{
+					{ProductCode: entity.DEFAULT_LOAN_PRODUCT, FeeType: entity.FEE_ADMIN, Calculation: entity.FEE_FLAT, Value: decimal.RequireFromString("39999.99"), Collection: entity.FEE_DEDUCTED},
+				}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on GetProductFees",
+			input: usecases.QuoteLoanInput{PrincipalAmount: "1000000", TermWeeks: 10},
+			setupMocks: func(mockRepo *billingenginemocks.MockQuoteLoanRepository) {
+				mockRepo.On("GetProductFees", mock.Anything, entity.DEFAULT_LOAN_PRODUCT).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockQuoteLoanRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewQuoteLoanInteractor(QuoteLoanInteractorDependencies{
+				QuoteLoanRepository: mockRepo,
+				Logger:              zap.NewNop().Sugar(),
+				Validator:           validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
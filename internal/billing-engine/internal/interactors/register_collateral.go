@@ -0,0 +1,144 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.RegisterCollateralUsecase = (*RegisterCollateralInteractor)(nil)
+
+type (
+	RegisterCollateralRepository interface {
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		CreateCollateral(ctx context.Context, collateral entity.Collateral) (entity.Collateral, error)
+	}
+
+	RegisterCollateralInteractorDependencies struct {
+		RegisterCollateralRepository RegisterCollateralRepository
+		Logger                       *zap.SugaredLogger
+		Validator                    *validator.Validate
+		SnowflakeGen                 pkguid.Snowflake
+	}
+
+	RegisterCollateralInteractor struct {
+		repository   RegisterCollateralRepository `validate:"required"`
+		logger       *zap.SugaredLogger           `validate:"required"`
+		validator    *validator.Validate          `validate:"required"`
+		snowflakeGen pkguid.Snowflake             `validate:"required"`
+	}
+)
+
+func NewRegisterCollateralInteractor(
+	deps RegisterCollateralInteractorDependencies,
+) *RegisterCollateralInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &RegisterCollateralInteractor{
+		repository:   deps.RegisterCollateralRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.RegisterCollateralUsecase.
+//
+// Collateral is pledged against an active loan with its lien PENDING until
+// the lien is registered.
+func (r *RegisterCollateralInteractor) Execute(ctx context.Context, input usecases.RegisterCollateralInput) (usecases.CollateralOutput, error) {
+	if err := r.validator.Struct(input); err != nil {
+		r.logger.Errorw("invalid input", "error", err)
+		return usecases.CollateralOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	valuation, valuationDate, err := parseValuation(input.Valuation, input.ValuationDate)
+	if err != nil {
+		return usecases.CollateralOutput{}, err
+	}
+
+	loan, err := r.repository.GetLoan(ctx, input.LoanID)
+	if err != nil {
+		r.logger.Errorw("failed to get loan", "error", err, "loan_id", input.LoanID)
+		return usecases.CollateralOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !loan.Status.IsActive() {
+		return usecases.CollateralOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("loan %d is %s, collateral is pledged against active loans", loan.ID, loan.Status),
+		)
+	}
+
+	collateral, err := r.repository.CreateCollateral(ctx, entity.Collateral{
+		ID:            r.snowflakeGen.Generate(),
+		LoanID:        loan.ID,
+		Type:          entity.CollateralType(input.Type),
+		Description:   input.Description,
+		Valuation:     valuation,
+		ValuationDate: valuationDate,
+		LienStatus:    entity.LIEN_PENDING,
+		RegisteredBy:  input.RegisteredBy,
+		RegisteredAt:  time.Now(),
+	})
+	if err != nil {
+		r.logger.Errorw("failed to create collateral", "error", err, "loan_id", loan.ID)
+		return usecases.CollateralOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toCollateralOutput(collateral), nil
+}
+
+// parseValuation parses a positive valuation made on a YYYY-MM-DD date that
+// is not in the future.
+func parseValuation(value string, date string) (decimal.Decimal, time.Time, error) {
+	valuation, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, time.Time{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if !valuation.IsPositive() {
+		return decimal.Zero, time.Time{}, pkgerror.NewValidationError("valuation must be positive")
+	}
+
+	valuationDate, err := time.ParseInLocation(dateLayout, date, time.Local)
+	if err != nil {
+		return decimal.Zero, time.Time{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if valuationDate.After(time.Now()) {
+		return decimal.Zero, time.Time{}, pkgerror.NewValidationError("valuation date must not be in the future")
+	}
+
+	return valuation, valuationDate, nil
+}
+
+func toCollateralOutput(collateral entity.Collateral) usecases.CollateralOutput {
+	output := usecases.CollateralOutput{
+		ID:            collateral.ID,
+		LoanID:        collateral.LoanID,
+		Type:          string(collateral.Type),
+		Description:   collateral.Description,
+		Valuation:     collateral.Valuation.StringFixed(2),
+		ValuationDate: collateral.ValuationDate.Format(dateLayout),
+		LienStatus:    string(collateral.LienStatus),
+		RegisteredBy:  collateral.RegisteredBy,
+		RegisteredAt:  collateral.RegisteredAt.Format(time.RFC3339),
+		LienUpdatedBy: collateral.LienUpdatedBy,
+	}
+	if !collateral.LienUpdatedAt.IsZero() {
+		output.LienUpdatedAt = collateral.LienUpdatedAt.Format(time.RFC3339)
+	}
+
+	return output
+}
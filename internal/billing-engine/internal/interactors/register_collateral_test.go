@@ -0,0 +1,130 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRegisterCollateralInteractor_Execute(t *testing.T) {
+	input := usecases.RegisterCollateralInput{
+		LoanID:        100,
+		Type:          "VEHICLE_BPKB",
+		Description:   "Honda Vario 2021, B 1234 XYZ, BPKB N-01234567",
+		Valuation:     "15000000",
+		ValuationDate: "2024-03-01",
+		RegisteredBy:  "credit-officer",
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.RegisterCollateralInput
+		setupMocks    func(*billingenginemocks.MockRegisterCollateralRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.CollateralOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - collateral pledged with a pending lien",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockRegisterCollateralRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_APPROVED}, nil)
+				mockSnowflake.On("Generate").Return(uint64(500))
+				mockRepo.EXPECT().CreateCollateral(mock.Anything, mock.MatchedBy(func(collateral entity.Collateral) bool {
+					return collateral.ID == 500 && collateral.LoanID == 100 && collateral.Type == entity.COLLATERAL_VEHICLE_BPKB &&
+						collateral.Valuation.Equal(decimal.NewFromInt(15000000)) && collateral.ValuationDate.Format("2006-01-02") == "2024-03-01" &&
+						collateral.LienStatus == entity.LIEN_PENDING && collateral.RegisteredBy == "credit-officer"
+				})).RunAndReturn(func(_ context.Context, collateral entity.Collateral) (entity.Collateral, error) {
+					return collateral, nil
+				})
+			},
+			expectedCheck: func(t *testing.T, output usecases.CollateralOutput) {
+				assert.Equal(t, uint64(500), output.ID)
+				assert.Equal(t, "VEHICLE_BPKB", output.Type)
+				assert.Equal(t, "15000000.00", output.Valuation)
+				assert.Equal(t, "2024-03-01", output.ValuationDate)
+				assert.Equal(t, "PENDING", output.LienStatus)
+				assert.NotEmpty(t, output.RegisteredAt)
+				assert.Empty(t, output.LienUpdatedAt)
+			},
+		},
+		{
+			name: "error - validation error (unknown type)",
+			input: usecases.RegisterCollateralInput{
+				LoanID: 100, Type: "LAND", Description: "SHM 123", Valuation: "1000", ValuationDate: "2024-03-01", RegisteredBy: "credit-officer",
+			},
+			setupMocks:    func(*billingenginemocks.MockRegisterCollateralRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - valuation not positive",
+			input: usecases.RegisterCollateralInput{
+				LoanID: 100, Type: "GOLD", Description: "Antam 10g", Valuation: "0", ValuationDate: "2024-03-01", RegisteredBy: "credit-officer",
+			},
+			setupMocks:    func(*billingenginemocks.MockRegisterCollateralRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - valuation date in the future",
+			input: usecases.RegisterCollateralInput{
+				LoanID: 100, Type: "GOLD", Description: "Antam 10g", Valuation: "12000000",
+				ValuationDate: time.Now().AddDate(0, 0, 2).Format("2006-01-02"), RegisteredBy: "credit-officer",
+			},
+			setupMocks:    func(*billingenginemocks.MockRegisterCollateralRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan already paid",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockRegisterCollateralRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_PAID}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan not found",
+			input: input,
+			setupMocks: func(mockRepo *billingenginemocks.MockRegisterCollateralRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{}, errors.New("loan 100 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockRegisterCollateralRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewRegisterCollateralInteractor(RegisterCollateralInteractorDependencies{
+				RegisterCollateralRepository: mockRepo,
+				Logger:                       zap.NewNop().Sugar(),
+				Validator:                    validator.New(),
+				SnowflakeGen:                 mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
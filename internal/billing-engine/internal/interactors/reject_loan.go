@@ -0,0 +1,74 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.RejectLoanUsecase = (*RejectLoanInteractor)(nil)
+
+type (
+	RejectLoanRepository interface {
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error
+	}
+
+	RejectLoanInteractorDependencies struct {
+		RejectLoanRepository RejectLoanRepository
+		Logger               *zap.SugaredLogger
+		Validator            *validator.Validate
+	}
+
+	RejectLoanInteractor struct {
+		repository RejectLoanRepository `validate:"required"`
+		logger     *zap.SugaredLogger   `validate:"required"`
+		validator  *validator.Validate  `validate:"required"`
+	}
+)
+
+func NewRejectLoanInteractor(
+	deps RejectLoanInteractorDependencies,
+) *RejectLoanInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &RejectLoanInteractor{
+		repository: deps.RejectLoanRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.RejectLoanUsecase.
+func (r *RejectLoanInteractor) Execute(ctx context.Context, input usecases.RejectLoanInput) (usecases.LoanOutput, error) {
+	if err := r.validator.Struct(input); err != nil {
+		r.logger.Errorw("invalid input", "error", err)
+		return usecases.LoanOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	loan, err := r.repository.GetLoan(ctx, input.LoanID)
+	if err != nil {
+		r.logger.Errorw("failed to get loan", "error", err, "loan_id", input.LoanID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	transition, err := newLoanTransition(loan, entity.LOAN_REJECTED, input.RejectedBy, input.Reason)
+	if err != nil {
+		return usecases.LoanOutput{}, err
+	}
+
+	if err := r.repository.TransitionLoanStatus(ctx, transition); err != nil {
+		r.logger.Errorw("failed to reject loan", "error", err, "loan_id", loan.ID)
+		return usecases.LoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	loan.Status = entity.LOAN_REJECTED
+	return toLoanOutput(loan), nil
+}
@@ -0,0 +1,95 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRejectLoanInteractor_Execute(t *testing.T) {
+	appliedLoan := entity.Loan{
+		ID: 100, CustomerID: 1, PrincipalAmount: decimal.NewFromInt(5000000), InterestRate: decimal.NewFromFloat(0.1),
+		TermWeeks: 50, Status: entity.LOAN_APPLIED, RequestedBy: "sales-agent",
+	}
+
+	tests := []struct {
+		name           string
+		input          usecases.RejectLoanInput
+		setupMocks     func(*billingenginemocks.MockRejectLoanRepository)
+		expectedOutput usecases.LoanOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - application rejected",
+			input: usecases.RejectLoanInput{LoanID: 100, RejectedBy: "credit-officer", Reason: "insufficient income"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRejectLoanRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(appliedLoan, nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.MatchedBy(func(transition entity.LoanStatusTransition) bool {
+					return transition.LoanID == 100 && transition.ToStatus == entity.LOAN_REJECTED &&
+						transition.Actor == "credit-officer" && transition.Reason == "insufficient income"
+				})).Return(nil)
+			},
+			expectedOutput: usecases.LoanOutput{
+				ID: 100, CustomerID: 1, PrincipalAmount: "5000000", InterestRate: "0.1", TermWeeks: 50,
+				Status: "REJECTED", RequestedBy: "sales-agent",
+			},
+		},
+		{
+			name:          "error - validation error (missing reason)",
+			input:         usecases.RejectLoanInput{LoanID: 100, RejectedBy: "credit-officer"},
+			setupMocks:    func(*billingenginemocks.MockRejectLoanRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - illegal transition from an approved loan",
+			input: usecases.RejectLoanInput{LoanID: 100, RejectedBy: "credit-officer", Reason: "insufficient income"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRejectLoanRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_APPROVED}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on GetLoan",
+			input: usecases.RejectLoanInput{LoanID: 100, RejectedBy: "credit-officer", Reason: "insufficient income"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRejectLoanRepository) {
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{}, errors.New("loan 100 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockRejectLoanRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewRejectLoanInteractor(RejectLoanInteractorDependencies{
+				RejectLoanRepository: mockRepo,
+				Logger:               zap.NewNop().Sugar(),
+				Validator:            validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
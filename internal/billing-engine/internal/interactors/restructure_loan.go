@@ -0,0 +1,373 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.RestructureLoanUsecase = (*RestructureLoanInteractor)(nil)
+
+type (
+	RestructureLoanRepository interface {
+		PeriodLockRepository
+		TransactionRepository
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		GetAllInstallments(ctx context.Context, loanID uint64) ([]entity.Installment, error)
+		CreateLoan(ctx context.Context, loan entity.Loan) (entity.Loan, error)
+		CreateInstallments(ctx context.Context, installments []entity.Installment) error
+		SetOpenInstallmentsStatus(ctx context.Context, loanID uint64, status entity.InstallmentStatus) (int64, error)
+		TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error
+		CreateLoanRestructure(ctx context.Context, restructure entity.LoanRestructure) (entity.LoanRestructure, error)
+		GetLoanAccountBalance(ctx context.Context, loanID uint64, accountCode string) (decimal.Decimal, error)
+		CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error)
+	}
+
+	RestructureLoanInteractorDependencies struct {
+		RestructureLoanRepository RestructureLoanRepository
+		Logger                    *zap.SugaredLogger
+		Validator                 *validator.Validate
+		SnowflakeGen              pkguid.Snowflake
+	}
+
+	RestructureLoanInteractor struct {
+		repository   RestructureLoanRepository `validate:"required"`
+		logger       *zap.SugaredLogger        `validate:"required"`
+		validator    *validator.Validate       `validate:"required"`
+		snowflakeGen pkguid.Snowflake          `validate:"required"`
+	}
+
+	// openSchedule summarises the installments of a loan that are still due.
+	openSchedule struct {
+		outstanding       decimal.Decimal // amounts due and fees
+		fees              decimal.Decimal
+		holidayInterest   decimal.Decimal // moratorium interest in the amounts due
+		arrears           decimal.Decimal
+		pendingCount      int64
+		installmentAmount decimal.Decimal
+	}
+)
+
+func NewRestructureLoanInteractor(
+	deps RestructureLoanInteractorDependencies,
+) *RestructureLoanInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &RestructureLoanInteractor{
+		repository:   deps.RestructureLoanRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.RestructureLoanUsecase.
+//
+// The remaining PENDING and MISSED installments of the loan are closed and
+// their total is rescheduled on a new loan that links back to the original,
+// which is marked RESTRUCTURED. The receivables of the original loan move onto
+// the new loan in the ledger, all in one transaction. Depending on the type
+// the new schedule:
+//   - EXTEND_TERM spreads the outstanding over a longer term,
+//   - REDUCE_INSTALLMENT keeps paying the requested amount until settled,
+//   - CAPITALISE_ARREARS folds the missed installments into the remaining weeks.
+func (r *RestructureLoanInteractor) Execute(ctx context.Context, input usecases.RestructureLoanInput) (usecases.RestructureLoanOutput, error) {
+	if err := r.validator.Struct(input); err != nil {
+		r.logger.Errorw("invalid input", "error", err)
+		return usecases.RestructureLoanOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	startDate, err := parseAsOfDate(input.StartDate)
+	if err != nil {
+		return usecases.RestructureLoanOutput{}, err
+	}
+
+	if err := ensurePeriodOpen(ctx, r.repository, startDate); err != nil {
+		r.logger.Errorw("failed to check period lock", "error", err, "loan_id", input.LoanID, "start_date", startDate.Format(dateLayout))
+		return usecases.RestructureLoanOutput{}, err
+	}
+
+	loan, err := r.repository.GetLoan(ctx, input.LoanID)
+	if err != nil {
+		r.logger.Errorw("failed to get loan", "error", err, "loan_id", input.LoanID)
+		return usecases.RestructureLoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	restructuredBy := input.RestructuredBy
+	if restructuredBy == "" {
+		restructuredBy = entity.SYSTEM_ACTOR
+	}
+
+	transition, err := newLoanTransition(loan, entity.LOAN_RESTRUCTURED, restructuredBy, input.Reason)
+	if err != nil {
+		return usecases.RestructureLoanOutput{}, err
+	}
+
+	installments, err := r.repository.GetAllInstallments(ctx, loan.ID)
+	if err != nil {
+		r.logger.Errorw("failed to get installments", "error", err, "loan_id", loan.ID)
+		return usecases.RestructureLoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	schedule, err := summariseOpenSchedule(installments)
+	if err != nil {
+		r.logger.Errorw("failed to parse installment amount", "error", err, "loan_id", loan.ID)
+		return usecases.RestructureLoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !schedule.outstanding.IsPositive() {
+		return usecases.RestructureLoanOutput{}, pkgerror.NewBusinessError("loan has no outstanding balance")
+	}
+
+	amounts, err := newScheduleAmounts(entity.RestructureType(input.Type), input, schedule)
+	if err != nil {
+		return usecases.RestructureLoanOutput{}, err
+	}
+
+	newLoan := entity.Loan{
+		ID:              r.snowflakeGen.Generate(),
+		CustomerID:      loan.CustomerID,
+		PrincipalAmount: schedule.outstanding,
+		// the outstanding already carries the interest of the original loan
+		InterestRate:           decimal.Zero,
+		TermWeeks:              int64(len(amounts)),
+		StartDate:              startDate,
+		Status:                 entity.LOAN_DISBURSED,
+		ProductCode:            loan.ProductCode,
+		RestructuredFromLoanID: loan.ID,
+		CreditLineID:           loan.CreditLineID,
+	}
+
+	// The original loan is moved first, so a concurrent restructure fails its
+	// compare-and-swap before writing anything, and a failure midway rolls the
+	// whole restructure back
+	var (
+		newInstallments []entity.Installment
+		restructure     entity.LoanRestructure
+	)
+	err = r.repository.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := r.repository.TransitionLoanStatus(ctx, transition); err != nil {
+			r.logger.Errorw("failed to mark loan as restructured", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		newLoan, err = r.repository.CreateLoan(ctx, newLoan)
+		if err != nil {
+			r.logger.Errorw("failed to create restructured loan", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		newInstallments = entity.NewWeeklySchedule(newLoan.ID, startDate, amounts)
+		if err := r.repository.CreateInstallments(ctx, newInstallments); err != nil {
+			r.logger.Errorw("failed to create restructured installments", "error", err, "loan_id", newLoan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		closed, err := r.repository.SetOpenInstallmentsStatus(ctx, loan.ID, entity.INSTALLMENT_CLOSED)
+		if err != nil {
+			r.logger.Errorw("failed to close open installments", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		restructure = entity.LoanRestructure{
+			ID:                   r.snowflakeGen.Generate(),
+			LoanID:               loan.ID,
+			NewLoanID:            newLoan.ID,
+			Type:                 entity.RestructureType(input.Type),
+			OutstandingAmount:    schedule.outstanding,
+			ArrearsAmount:        schedule.arrears,
+			ClosedInstallments:   closed,
+			NewTermWeeks:         newLoan.TermWeeks,
+			NewInstallmentAmount: amounts[0],
+			Reason:               input.Reason,
+			RestructuredAt:       time.Now(),
+		}
+
+		if err := r.postRestructure(ctx, restructure, startDate); err != nil {
+			r.logger.Errorw("failed to post restructure journal entries", "error", err, "loan_id", loan.ID)
+			return err
+		}
+
+		restructure, err = r.repository.CreateLoanRestructure(ctx, restructure)
+		if err != nil {
+			r.logger.Errorw("failed to create loan restructure", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return usecases.RestructureLoanOutput{}, err
+	}
+
+	output := usecases.RestructureLoanOutput{
+		Restructure:  toLoanRestructureOutput(restructure),
+		NewLoan:      toLoanOutput(newLoan),
+		Installments: make([]usecases.GetInstallmentsOutput, len(newInstallments)),
+	}
+
+	for i, installment := range newInstallments {
+		output.Installments[i] = usecases.GetInstallmentsOutput{
+			LoanID:     installment.LoanID,
+			WeekNumber: installment.WeekNumber,
+			DueDate:    installment.DueDate,
+			AmountDue:  installment.AmountDue,
+			FeeAmount:  installment.FeeAmount,
+			Status:     string(installment.Status),
+		}
+	}
+
+	return output, nil
+}
+
+// postRestructure moves the receivables the original loan still carries in
+// the ledger onto the new loan.
+func (r *RestructureLoanInteractor) postRestructure(ctx context.Context, restructure entity.LoanRestructure, effectiveDate time.Time) error {
+	balances := make(map[string]decimal.Decimal)
+	for _, accountCode := range []string{entity.ACCOUNT_PRINCIPAL_RECEIVABLE, entity.ACCOUNT_INTEREST_RECEIVABLE, entity.ACCOUNT_FEE_RECEIVABLE} {
+		balance, err := r.repository.GetLoanAccountBalance(ctx, restructure.LoanID, accountCode)
+		if err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		balances[accountCode] = balance
+	}
+
+	closing, opening := entity.NewRestructureEntries(
+		r.snowflakeGen.Generate(), r.snowflakeGen.Generate(), restructure,
+		balances[entity.ACCOUNT_PRINCIPAL_RECEIVABLE], balances[entity.ACCOUNT_INTEREST_RECEIVABLE], balances[entity.ACCOUNT_FEE_RECEIVABLE],
+		effectiveDate,
+	)
+
+	for _, entry := range []entity.JournalEntry{closing, opening} {
+		if _, err := r.repository.CreateJournalEntry(ctx, entry); err != nil {
+			return pkgerror.BusinessErrorFrom(err)
+		}
+	}
+
+	return nil
+}
+
+func summariseOpenSchedule(installments []entity.Installment) (openSchedule, error) {
+	schedule := openSchedule{
+		outstanding:     decimal.Zero,
+		fees:            decimal.Zero,
+		holidayInterest: decimal.Zero,
+		arrears:         decimal.Zero,
+	}
+
+	for _, installment := range installments {
+		if installment.Status != entity.INSTALLMENT_PENDING && installment.Status != entity.INSTALLMENT_MISSED {
+			continue
+		}
+
+		amount, err := installment.TotalDue()
+		if err != nil {
+			return openSchedule{}, err
+		}
+
+		fee, err := installment.Fee()
+		if err != nil {
+			return openSchedule{}, err
+		}
+
+		holiday, err := installment.Holiday()
+		if err != nil {
+			return openSchedule{}, err
+		}
+
+		schedule.outstanding = schedule.outstanding.Add(amount)
+		schedule.fees = schedule.fees.Add(fee)
+		schedule.holidayInterest = schedule.holidayInterest.Add(holiday)
+
+		if installment.Status == entity.INSTALLMENT_MISSED {
+			schedule.arrears = schedule.arrears.Add(amount)
+			continue
+		}
+
+		if schedule.pendingCount == 0 {
+			schedule.installmentAmount = amount
+		}
+		schedule.pendingCount++
+	}
+
+	return schedule, nil
+}
+
+func newScheduleAmounts(restructureType entity.RestructureType, input usecases.RestructureLoanInput, schedule openSchedule) ([]decimal.Decimal, error) {
+	switch restructureType {
+	case entity.RESTRUCTURE_EXTEND_TERM:
+		if input.TermWeeks <= schedule.pendingCount {
+			return nil, pkgerror.NewBusinessError("term weeks must be longer than the remaining schedule")
+		}
+
+		return entity.SplitEvenly(schedule.outstanding, input.TermWeeks), nil
+
+	case entity.RESTRUCTURE_REDUCE_INSTALLMENT:
+		amount, err := decimal.NewFromString(input.InstallmentAmount)
+		if err != nil {
+			return nil, pkgerror.ValidationErrorFrom(err)
+		}
+
+		if !amount.IsPositive() || amount.Exponent() < -2 {
+			return nil, pkgerror.NewValidationError("installment amount must be a positive amount with at most 2 decimals")
+		}
+
+		if schedule.installmentAmount.IsPositive() && amount.GreaterThanOrEqual(schedule.installmentAmount) {
+			return nil, pkgerror.NewBusinessError("installment amount must be lower than the current installment")
+		}
+
+		// A tiny installment would schedule the outstanding over decades
+		termWeeks := schedule.outstanding.Div(amount).Ceil()
+		if termWeeks.GreaterThan(decimal.NewFromInt(entity.MAX_TERM_WEEKS)) {
+			return nil, pkgerror.NewBusinessError(fmt.Sprintf(
+				"installments of %s would take %s weeks to settle %s, more than the %d weeks allowed",
+				amount.StringFixed(2), termWeeks.String(), schedule.outstanding.StringFixed(2), entity.MAX_TERM_WEEKS,
+			))
+		}
+
+		return entity.SplitByInstallmentAmount(schedule.outstanding, amount), nil
+
+	case entity.RESTRUCTURE_CAPITALISE_ARREARS:
+		if !schedule.arrears.IsPositive() {
+			return nil, pkgerror.NewBusinessError("loan has no arrears to capitalise")
+		}
+
+		termWeeks := schedule.pendingCount
+		if termWeeks == 0 {
+			termWeeks = 1
+		}
+
+		return entity.SplitEvenly(schedule.outstanding, termWeeks), nil
+
+	default:
+		return nil, pkgerror.NewValidationError("unknown restructure type")
+	}
+}
+
+func toLoanRestructureOutput(restructure entity.LoanRestructure) usecases.LoanRestructureOutput {
+	return usecases.LoanRestructureOutput{
+		ID:                   restructure.ID,
+		LoanID:               restructure.LoanID,
+		NewLoanID:            restructure.NewLoanID,
+		Type:                 string(restructure.Type),
+		OutstandingAmount:    restructure.OutstandingAmount.StringFixed(2),
+		ArrearsAmount:        restructure.ArrearsAmount.StringFixed(2),
+		ClosedInstallments:   restructure.ClosedInstallments,
+		NewTermWeeks:         restructure.NewTermWeeks,
+		NewInstallmentAmount: restructure.NewInstallmentAmount.StringFixed(2),
+		Reason:               restructure.Reason,
+		RestructuredAt:       restructure.RestructuredAt.Format(time.RFC3339),
+	}
+}
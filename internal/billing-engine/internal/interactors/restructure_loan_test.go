@@ -0,0 +1,275 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRestructureLoanInteractor_Execute(t *testing.T) {
+	disbursedLoan := entity.Loan{ID: 100, CustomerID: 1, TermWeeks: 4, Status: entity.LOAN_DISBURSED}
+	installments := []entity.Installment{
+		{ID: 1, LoanID: 100, WeekNumber: 1, AmountDue: "100000.00", Status: entity.INSTALLMENT_PAID},
+		{ID: 2, LoanID: 100, WeekNumber: 2, AmountDue: "100000.00", Status: entity.INSTALLMENT_MISSED},
+		{ID: 3, LoanID: 100, WeekNumber: 3, AmountDue: "100000.00", Status: entity.INSTALLMENT_PENDING},
+		{ID: 4, LoanID: 100, WeekNumber: 4, AmountDue: "100000.00", Status: entity.INSTALLMENT_PENDING},
+	}
+
+	samePostings := func(want, got []entity.Posting) bool {
+		if len(want) != len(got) {
+			return false
+		}
+		for i := range want {
+			if want[i].AccountCode != got[i].AccountCode || want[i].Direction != got[i].Direction || !want[i].Amount.Equal(got[i].Amount) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// The original loan still carries 250,000 of principal and 20,000 of
+	// accrued interest, the other 30,000 of interest in its outstanding is not
+	// accrued yet
+	setupLedger := func(mockRepo *billingenginemocks.MockRestructureLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+		mockRepo.On("GetLoanAccountBalance", mock.Anything, uint64(100), entity.ACCOUNT_PRINCIPAL_RECEIVABLE).Return(decimal.NewFromInt(250000), nil)
+		mockRepo.On("GetLoanAccountBalance", mock.Anything, uint64(100), entity.ACCOUNT_INTEREST_RECEIVABLE).Return(decimal.NewFromInt(20000), nil)
+		mockRepo.On("GetLoanAccountBalance", mock.Anything, uint64(100), entity.ACCOUNT_FEE_RECEIVABLE).Return(decimal.Zero, nil)
+		mockSnowflake.On("Generate").Return(uint64(400))
+		mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+			return entry.LoanID == 100 && entry.Event == entity.JOURNAL_RESTRUCTURE && entry.IsBalanced() &&
+				samePostings([]entity.Posting{
+					{AccountCode: entity.ACCOUNT_RESTRUCTURE_CLEARING, Direction: entity.POSTING_DEBIT, Amount: decimal.NewFromInt(270000)},
+					{AccountCode: entity.ACCOUNT_PRINCIPAL_RECEIVABLE, Direction: entity.POSTING_CREDIT, Amount: decimal.NewFromInt(250000)},
+					{AccountCode: entity.ACCOUNT_INTEREST_RECEIVABLE, Direction: entity.POSTING_CREDIT, Amount: decimal.NewFromInt(20000)},
+				}, entry.Postings)
+		})).Return(entity.JournalEntry{}, nil).Once()
+		mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+			return entry.LoanID == 200 && entry.Event == entity.JOURNAL_RESTRUCTURE && entry.IsBalanced() &&
+				samePostings([]entity.Posting{
+					{AccountCode: entity.ACCOUNT_PRINCIPAL_RECEIVABLE, Direction: entity.POSTING_DEBIT, Amount: decimal.NewFromInt(300000)},
+					{AccountCode: entity.ACCOUNT_RESTRUCTURE_CLEARING, Direction: entity.POSTING_CREDIT, Amount: decimal.NewFromInt(270000)},
+					{AccountCode: entity.ACCOUNT_INTEREST_INCOME, Direction: entity.POSTING_CREDIT, Amount: decimal.NewFromInt(30000)},
+				}, entry.Postings)
+		})).Return(entity.JournalEntry{}, nil).Once()
+	}
+
+	echoLoan := func(_ context.Context, loan entity.Loan) (entity.Loan, error) { return loan, nil }
+	echoRestructure := func(_ context.Context, restructure entity.LoanRestructure) (entity.LoanRestructure, error) {
+		return restructure, nil
+	}
+
+	tests := []struct {
+		name                string
+		input               usecases.RestructureLoanInput
+		setupMocks          func(*billingenginemocks.MockRestructureLoanRepository, *pkgmocks.MockSnowflake)
+		expectedAmounts     []string
+		expectedRestructure usecases.LoanRestructureOutput
+		expectedError       error
+	}{
+		{
+			name:  "success - extend term spreads the outstanding over the new term",
+			input: usecases.RestructureLoanInput{LoanID: 100, Type: "EXTEND_TERM", TermWeeks: 7, StartDate: "2024-03-01", Reason: "hardship"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRestructureLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+				mockSnowflake.On("Generate").Return(uint64(200)).Once()
+				mockRepo.EXPECT().CreateLoan(mock.Anything, mock.MatchedBy(func(loan entity.Loan) bool {
+					return loan.RestructuredFromLoanID == 100 && loan.PrincipalAmount.Equal(decimal.NewFromInt(300000)) && loan.TermWeeks == 7
+				})).RunAndReturn(echoLoan)
+				mockRepo.On("CreateInstallments", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("SetOpenInstallmentsStatus", mock.Anything, uint64(100), entity.INSTALLMENT_CLOSED).Return(int64(3), nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.MatchedBy(func(transition entity.LoanStatusTransition) bool {
+					return transition.LoanID == 100 && transition.ToStatus == entity.LOAN_RESTRUCTURED && transition.Actor == entity.SYSTEM_ACTOR
+				})).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(300)).Once()
+				setupLedger(mockRepo, mockSnowflake)
+				mockRepo.EXPECT().CreateLoanRestructure(mock.Anything, mock.Anything).RunAndReturn(echoRestructure)
+			},
+			expectedAmounts: []string{"42857.14", "42857.14", "42857.14", "42857.14", "42857.14", "42857.14", "42857.16"},
+			expectedRestructure: usecases.LoanRestructureOutput{
+				ID: 300, LoanID: 100, NewLoanID: 200, Type: "EXTEND_TERM",
+				OutstandingAmount: "300000.00", ArrearsAmount: "100000.00", ClosedInstallments: 3,
+				NewTermWeeks: 7, NewInstallmentAmount: "42857.14", Reason: "hardship",
+			},
+		},
+		{
+			name:  "success - reduce installment keeps the requested amount until settled",
+			input: usecases.RestructureLoanInput{LoanID: 100, Type: "REDUCE_INSTALLMENT", InstallmentAmount: "80000", StartDate: "2024-03-01", Reason: "hardship"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRestructureLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+				mockSnowflake.On("Generate").Return(uint64(200)).Once()
+				mockRepo.EXPECT().CreateLoan(mock.Anything, mock.Anything).RunAndReturn(echoLoan)
+				mockRepo.On("CreateInstallments", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("SetOpenInstallmentsStatus", mock.Anything, uint64(100), entity.INSTALLMENT_CLOSED).Return(int64(3), nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.MatchedBy(func(transition entity.LoanStatusTransition) bool {
+					return transition.LoanID == 100 && transition.ToStatus == entity.LOAN_RESTRUCTURED
+				})).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(300)).Once()
+				setupLedger(mockRepo, mockSnowflake)
+				mockRepo.EXPECT().CreateLoanRestructure(mock.Anything, mock.Anything).RunAndReturn(echoRestructure)
+			},
+			expectedAmounts: []string{"80000.00", "80000.00", "80000.00", "60000.00"},
+			expectedRestructure: usecases.LoanRestructureOutput{
+				ID: 300, LoanID: 100, NewLoanID: 200, Type: "REDUCE_INSTALLMENT",
+				OutstandingAmount: "300000.00", ArrearsAmount: "100000.00", ClosedInstallments: 3,
+				NewTermWeeks: 4, NewInstallmentAmount: "80000.00", Reason: "hardship",
+			},
+		},
+		{
+			name:  "success - capitalise arrears folds missed installments into the remaining weeks",
+			input: usecases.RestructureLoanInput{LoanID: 100, Type: "CAPITALISE_ARREARS", StartDate: "2024-03-01", Reason: "hardship"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRestructureLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+				mockSnowflake.On("Generate").Return(uint64(200)).Once()
+				mockRepo.EXPECT().CreateLoan(mock.Anything, mock.Anything).RunAndReturn(echoLoan)
+				mockRepo.On("CreateInstallments", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("SetOpenInstallmentsStatus", mock.Anything, uint64(100), entity.INSTALLMENT_CLOSED).Return(int64(3), nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.MatchedBy(func(transition entity.LoanStatusTransition) bool {
+					return transition.LoanID == 100 && transition.ToStatus == entity.LOAN_RESTRUCTURED
+				})).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(300)).Once()
+				setupLedger(mockRepo, mockSnowflake)
+				mockRepo.EXPECT().CreateLoanRestructure(mock.Anything, mock.Anything).RunAndReturn(echoRestructure)
+			},
+			expectedAmounts: []string{"150000.00", "150000.00"},
+			expectedRestructure: usecases.LoanRestructureOutput{
+				ID: 300, LoanID: 100, NewLoanID: 200, Type: "CAPITALISE_ARREARS",
+				OutstandingAmount: "300000.00", ArrearsAmount: "100000.00", ClosedInstallments: 3,
+				NewTermWeeks: 2, NewInstallmentAmount: "150000.00", Reason: "hardship",
+			},
+		},
+		{
+			name:          "error - missing reason",
+			input:         usecases.RestructureLoanInput{LoanID: 100, Type: "CAPITALISE_ARREARS"},
+			setupMocks:    func(*billingenginemocks.MockRestructureLoanRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan is not disbursed",
+			input: usecases.RestructureLoanInput{LoanID: 100, Type: "CAPITALISE_ARREARS", Reason: "hardship"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRestructureLoanRepository, _ *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).
+					Return(entity.Loan{ID: 100, Status: entity.LOAN_PAID}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - new term does not extend the remaining schedule",
+			input: usecases.RestructureLoanInput{LoanID: 100, Type: "EXTEND_TERM", TermWeeks: 2, Reason: "hardship"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRestructureLoanRepository, _ *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - reduced installment too small to settle within the maximum term",
+			input: usecases.RestructureLoanInput{LoanID: 100, Type: "REDUCE_INSTALLMENT", InstallmentAmount: "0.01", Reason: "hardship"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRestructureLoanRepository, _ *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on create loan",
+			input: usecases.RestructureLoanInput{LoanID: 100, Type: "CAPITALISE_ARREARS", Reason: "hardship"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRestructureLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+				mockSnowflake.On("Generate").Return(uint64(200))
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("CreateLoan", mock.Anything, mock.Anything).Return(entity.Loan{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - restructure rolled back when its journal entries fail",
+			input: usecases.RestructureLoanInput{LoanID: 100, Type: "CAPITALISE_ARREARS", Reason: "hardship"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRestructureLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+				mockSnowflake.On("Generate").Return(uint64(200))
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.EXPECT().CreateLoan(mock.Anything, mock.Anything).RunAndReturn(echoLoan)
+				mockRepo.On("CreateInstallments", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("SetOpenInstallmentsStatus", mock.Anything, uint64(100), entity.INSTALLMENT_CLOSED).Return(int64(3), nil)
+				mockRepo.On("GetLoanAccountBalance", mock.Anything, uint64(100), mock.Anything).Return(decimal.NewFromInt(100000), nil)
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.Anything).Return(entity.JournalEntry{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan restructured concurrently, nothing is created",
+			input: usecases.RestructureLoanInput{LoanID: 100, Type: "CAPITALISE_ARREARS", Reason: "hardship"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRestructureLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+				mockSnowflake.On("Generate").Return(uint64(200))
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.Anything).Return(errors.New("loan 100 is no longer DISBURSED"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockRestructureLoanRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction).Maybe()
+
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewRestructureLoanInteractor(RestructureLoanInteractorDependencies{
+				RestructureLoanRepository: mockRepo,
+				Logger:                    zap.NewNop().Sugar(),
+				Validator:                 validator.New(),
+				SnowflakeGen:              mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			amounts := make([]string, len(output.Installments))
+			for i, installment := range output.Installments {
+				amounts[i] = installment.AmountDue
+			}
+			assert.Equal(t, tt.expectedAmounts, amounts)
+			assert.Equal(t, "2024-03-08", output.Installments[0].DueDate)
+			assert.Equal(t, uint64(200), output.NewLoan.ID)
+
+			restructure := output.Restructure
+			restructure.RestructuredAt = ""
+			assert.Equal(t, tt.expectedRestructure, restructure)
+		})
+	}
+}
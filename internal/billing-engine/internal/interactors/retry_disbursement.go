@@ -0,0 +1,96 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.RetryDisbursementUsecase = (*RetryDisbursementInteractor)(nil)
+
+type (
+	RetryDisbursementRepository interface {
+		DisbursementRepository
+		GetDisbursement(ctx context.Context, disbursementID uint64) (entity.Disbursement, error)
+	}
+
+	RetryDisbursementInteractorDependencies struct {
+		RetryDisbursementRepository RetryDisbursementRepository
+		PayoutProvider              PayoutProvider
+		Logger                      *zap.SugaredLogger
+		Validator                   *validator.Validate
+		SnowflakeGen                pkguid.Snowflake
+	}
+
+	RetryDisbursementInteractor struct {
+		repository   RetryDisbursementRepository `validate:"required"`
+		provider     PayoutProvider              `validate:"required"`
+		logger       *zap.SugaredLogger          `validate:"required"`
+		validator    *validator.Validate         `validate:"required"`
+		snowflakeGen pkguid.Snowflake            `validate:"required"`
+	}
+)
+
+func NewRetryDisbursementInteractor(
+	deps RetryDisbursementInteractorDependencies,
+) *RetryDisbursementInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &RetryDisbursementInteractor{
+		repository:   deps.RetryDisbursementRepository,
+		provider:     deps.PayoutProvider,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.RetryDisbursementUsecase.
+//
+// A failed payout is handed to the provider again, to the same account.
+func (r *RetryDisbursementInteractor) Execute(ctx context.Context, input usecases.RetryDisbursementInput) (usecases.DisbursementOutput, error) {
+	if err := r.validator.Struct(input); err != nil {
+		r.logger.Errorw("invalid input", "error", err)
+		return usecases.DisbursementOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	disbursement, err := r.repository.GetDisbursement(ctx, input.DisbursementID)
+	if err != nil {
+		r.logger.Errorw("failed to get disbursement", "error", err, "disbursement_id", input.DisbursementID)
+		return usecases.DisbursementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !disbursement.Status.CanTransitionTo(entity.DISBURSEMENT_PENDING) {
+		return usecases.DisbursementOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("disbursement %d is %s, only failed disbursements can be retried", disbursement.ID, disbursement.Status),
+		)
+	}
+
+	failed := disbursement.Status
+	disbursement.Status = entity.DISBURSEMENT_PENDING
+	disbursement.FailureReason = ""
+	disbursement.UpdatedAt = time.Now()
+
+	if err := r.repository.UpdateDisbursement(ctx, disbursement, failed); err != nil {
+		r.logger.Errorw("failed to reset disbursement", "error", err, "disbursement_id", disbursement.ID)
+		return usecases.DisbursementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	sent, err := sendPayout(ctx, r.repository, r.provider, r.snowflakeGen, r.logger, disbursement)
+	if err != nil {
+		r.logger.Errorw("failed to send payout", "error", err, "disbursement_id", disbursement.ID)
+		return usecases.DisbursementOutput{}, err
+	}
+
+	return toDisbursementOutput(sent), nil
+}
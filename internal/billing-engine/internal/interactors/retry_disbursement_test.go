@@ -0,0 +1,102 @@
+package interactors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRetryDisbursementInteractor_Execute(t *testing.T) {
+	failed := entity.Disbursement{
+		ID: 300, LoanID: 100, CustomerID: 1, Amount: decimal.NewFromInt(5000000),
+		Status: entity.DISBURSEMENT_FAILED, FailureReason: "account rejected", Attempts: 1,
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.RetryDisbursementInput
+		setupMocks    func(*billingenginemocks.MockRetryDisbursementRepository, *billingenginemocks.MockPayoutProvider)
+		expectedCheck func(*testing.T, usecases.DisbursementOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - retried payout is sent again",
+			input: usecases.RetryDisbursementInput{DisbursementID: 300},
+			setupMocks: func(mockRepo *billingenginemocks.MockRetryDisbursementRepository, mockProvider *billingenginemocks.MockPayoutProvider) {
+				mockRepo.On("GetDisbursement", mock.Anything, uint64(300)).Return(failed, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Status == entity.DISBURSEMENT_PENDING && d.FailureReason == ""
+				}), entity.DISBURSEMENT_FAILED).Return(nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Status == entity.DISBURSEMENT_SENT && d.ProviderReference == "" && d.Attempts == 2
+				}), entity.DISBURSEMENT_PENDING).Return(nil)
+				mockProvider.On("Send", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.ID == 300 && d.Attempts == 2
+				})).Return(entity.PayoutResult{Reference: "ref-2", Status: entity.DISBURSEMENT_SENT}, nil)
+				mockRepo.On("UpdateDisbursement", mock.Anything, mock.MatchedBy(func(d entity.Disbursement) bool {
+					return d.Status == entity.DISBURSEMENT_SENT && d.ProviderReference == "ref-2" && d.Attempts == 2
+				}), entity.DISBURSEMENT_SENT).Return(nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.DisbursementOutput) {
+				assert.Equal(t, "SENT", output.Status)
+				assert.Equal(t, int64(2), output.Attempts)
+				assert.Empty(t, output.FailureReason)
+			},
+		},
+		{
+			name:          "error - validation error (missing disbursement_id)",
+			input:         usecases.RetryDisbursementInput{},
+			setupMocks:    func(*billingenginemocks.MockRetryDisbursementRepository, *billingenginemocks.MockPayoutProvider) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - only failed disbursements can be retried",
+			input: usecases.RetryDisbursementInput{DisbursementID: 300},
+			setupMocks: func(mockRepo *billingenginemocks.MockRetryDisbursementRepository, mockProvider *billingenginemocks.MockPayoutProvider) {
+				sent := failed
+				sent.Status = entity.DISBURSEMENT_SENT
+				mockRepo.On("GetDisbursement", mock.Anything, uint64(300)).Return(sent, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockRetryDisbursementRepository(t)
+			mockProvider := billingenginemocks.NewMockPayoutProvider(t)
+
+			mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction).Maybe()
+			tt.setupMocks(mockRepo, mockProvider)
+
+			interactor := NewRetryDisbursementInteractor(RetryDisbursementInteractorDependencies{
+				RetryDisbursementRepository: mockRepo,
+				PayoutProvider:              mockProvider,
+				Logger:                      zap.NewNop().Sugar(),
+				Validator:                   validator.New(),
+				SnowflakeGen:                pkgmocks.NewMockSnowflake(t),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
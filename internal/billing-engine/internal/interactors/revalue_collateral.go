@@ -0,0 +1,93 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.RevalueCollateralUsecase = (*RevalueCollateralInteractor)(nil)
+
+type (
+	RevalueCollateralRepository interface {
+		GetCollateral(ctx context.Context, collateralID uint64) (entity.Collateral, error)
+		UpdateCollateralValuation(ctx context.Context, collateralID uint64, valuation decimal.Decimal, valuationDate time.Time) error
+	}
+
+	RevalueCollateralInteractorDependencies struct {
+		RevalueCollateralRepository RevalueCollateralRepository
+		Logger                      *zap.SugaredLogger
+		Validator                   *validator.Validate
+	}
+
+	RevalueCollateralInteractor struct {
+		repository RevalueCollateralRepository `validate:"required"`
+		logger     *zap.SugaredLogger          `validate:"required"`
+		validator  *validator.Validate         `validate:"required"`
+	}
+)
+
+func NewRevalueCollateralInteractor(
+	deps RevalueCollateralInteractorDependencies,
+) *RevalueCollateralInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &RevalueCollateralInteractor{
+		repository: deps.RevalueCollateralRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.RevalueCollateralUsecase.
+//
+// A collateral still held is revalued with a valuation no older than the one
+// it replaces.
+func (r *RevalueCollateralInteractor) Execute(ctx context.Context, input usecases.RevalueCollateralInput) (usecases.CollateralOutput, error) {
+	if err := r.validator.Struct(input); err != nil {
+		r.logger.Errorw("invalid input", "error", err)
+		return usecases.CollateralOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	valuation, valuationDate, err := parseValuation(input.Valuation, input.ValuationDate)
+	if err != nil {
+		return usecases.CollateralOutput{}, err
+	}
+
+	collateral, err := r.repository.GetCollateral(ctx, input.CollateralID)
+	if err != nil {
+		r.logger.Errorw("failed to get collateral", "error", err, "collateral_id", input.CollateralID)
+		return usecases.CollateralOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !collateral.IsHeld() {
+		return usecases.CollateralOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("collateral %d is released", collateral.ID),
+		)
+	}
+
+	if valuationDate.Before(collateral.ValuationDate) {
+		return usecases.CollateralOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("collateral %d was valued on %s, after %s", collateral.ID, collateral.ValuationDate.Format(dateLayout), input.ValuationDate),
+		)
+	}
+
+	if err := r.repository.UpdateCollateralValuation(ctx, collateral.ID, valuation, valuationDate); err != nil {
+		r.logger.Errorw("failed to update collateral valuation", "error", err, "collateral_id", collateral.ID)
+		return usecases.CollateralOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	collateral.Valuation = valuation
+	collateral.ValuationDate = valuationDate
+	return toCollateralOutput(collateral), nil
+}
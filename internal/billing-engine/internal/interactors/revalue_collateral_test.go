@@ -0,0 +1,104 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRevalueCollateralInteractor_Execute(t *testing.T) {
+	valuedOn := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	gold := entity.Collateral{
+		ID: 500, LoanID: 100, Type: entity.COLLATERAL_GOLD, Description: "Antam 10g",
+		Valuation: decimal.NewFromInt(12000000), ValuationDate: valuedOn, LienStatus: entity.LIEN_REGISTERED,
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.RevalueCollateralInput
+		setupMocks    func(*billingenginemocks.MockRevalueCollateralRepository)
+		expectedCheck func(*testing.T, usecases.CollateralOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - gold revalued",
+			input: usecases.RevalueCollateralInput{CollateralID: 500, Valuation: "13500000", ValuationDate: "2024-06-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRevalueCollateralRepository) {
+				mockRepo.On("GetCollateral", mock.Anything, uint64(500)).Return(gold, nil)
+				mockRepo.On("UpdateCollateralValuation", mock.Anything, uint64(500), decimal.NewFromInt(13500000), valuedOn.AddDate(0, 3, 0)).Return(nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CollateralOutput) {
+				assert.Equal(t, "13500000.00", output.Valuation)
+				assert.Equal(t, "2024-06-01", output.ValuationDate)
+				assert.Equal(t, "REGISTERED", output.LienStatus)
+			},
+		},
+		{
+			name:          "error - validation error (bad date)",
+			input:         usecases.RevalueCollateralInput{CollateralID: 500, Valuation: "13500000", ValuationDate: "01-06-2024"},
+			setupMocks:    func(*billingenginemocks.MockRevalueCollateralRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - valuation older than the current one",
+			input: usecases.RevalueCollateralInput{CollateralID: 500, Valuation: "11000000", ValuationDate: "2024-02-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRevalueCollateralRepository) {
+				mockRepo.On("GetCollateral", mock.Anything, uint64(500)).Return(gold, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - collateral released",
+			input: usecases.RevalueCollateralInput{CollateralID: 500, Valuation: "13500000", ValuationDate: "2024-06-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRevalueCollateralRepository) {
+				released := gold
+				released.LienStatus = entity.LIEN_RELEASED
+				mockRepo.On("GetCollateral", mock.Anything, uint64(500)).Return(released, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - collateral not found",
+			input: usecases.RevalueCollateralInput{CollateralID: 404, Valuation: "13500000", ValuationDate: "2024-06-01"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRevalueCollateralRepository) {
+				mockRepo.On("GetCollateral", mock.Anything, uint64(404)).Return(entity.Collateral{}, errors.New("collateral 404 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockRevalueCollateralRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewRevalueCollateralInteractor(RevalueCollateralInteractorDependencies{
+				RevalueCollateralRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+				Validator:                   validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.ReversePaymentUsecase = (*ReversePaymentInteractor)(nil)
+
+type (
+	ReversePaymentRepository interface {
+		PeriodLockRepository
+		TransactionRepository
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		GetUnreversedJournalEntry(ctx context.Context, loanID uint64, event entity.JournalEvent, reference string) (entity.JournalEntry, error)
+		ReversePayment(ctx context.Context, loanID uint64, weekNumber int64, asOf time.Time) (string, entity.InstallmentStatus, error)
+		CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error)
+		PledgeLoanCollaterals(ctx context.Context, loanID uint64, pledgedAt time.Time) (int64, error)
+	}
+
+	ReversePaymentInteractorDependencies struct {
+		ReversePaymentRepository ReversePaymentRepository
+		Logger                   *zap.SugaredLogger
+		Validator                *validator.Validate
+		SnowflakeGen             pkguid.Snowflake
+	}
+
+	ReversePaymentInteractor struct {
+		repository   ReversePaymentRepository `validate:"required"`
+		logger       *zap.SugaredLogger       `validate:"required"`
+		validator    *validator.Validate      `validate:"required"`
+		snowflakeGen pkguid.Snowflake         `validate:"required"`
+	}
+)
+
+func NewReversePaymentInteractor(
+	deps ReversePaymentInteractorDependencies,
+) *ReversePaymentInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &ReversePaymentInteractor{
+		repository:   deps.ReversePaymentRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.ReversePaymentUsecase.
+//
+// The latest payment of the installment is undone, e.g. after a bounced
+// transfer, and its journal entry is cancelled by a REVERSAL entry effective
+// on the reversal date, both in one transaction with the liens of a paid loan
+// it reopens pledged again. The original payment may sit in a closed period;
+// only the reversal date has to be open.
+func (r *ReversePaymentInteractor) Execute(ctx context.Context, input usecases.ReversePaymentInput) (usecases.ReversePaymentOutput, error) {
+	if err := r.validator.Struct(input); err != nil {
+		r.logger.Errorw("invalid input", "error", err)
+		return usecases.ReversePaymentOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	effectiveDate, err := parseEffectiveDate(input.EffectiveDate)
+	if err != nil {
+		return usecases.ReversePaymentOutput{}, err
+	}
+
+	if err := ensurePeriodOpen(ctx, r.repository, effectiveDate); err != nil {
+		r.logger.Errorw("failed to check period lock", "error", err, "loan_id", input.LoanID, "effective_date", effectiveDate.Format(dateLayout))
+		return usecases.ReversePaymentOutput{}, err
+	}
+
+	loan, err := r.repository.GetLoan(ctx, input.LoanID)
+	if err != nil {
+		r.logger.Errorw("failed to get loan", "error", err, "loan_id", input.LoanID)
+		return usecases.ReversePaymentOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if loan.Status != entity.LOAN_DISBURSED && loan.Status != entity.LOAN_PAID {
+		return usecases.ReversePaymentOutput{}, pkgerror.NewBusinessError("payments can only be reversed on disbursed or paid loans")
+	}
+
+	original, err := r.repository.GetUnreversedJournalEntry(ctx, loan.ID, entity.JOURNAL_PAYMENT, entity.PaymentReference(input.WeekNumber))
+	if err != nil {
+		r.logger.Errorw("failed to get payment journal entry", "error", err, "loan_id", loan.ID, "week_number", input.WeekNumber)
+		return usecases.ReversePaymentOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	var (
+		amount   string
+		status   entity.InstallmentStatus
+		reversal entity.JournalEntry
+	)
+	err = r.repository.WithinTransaction(ctx, func(ctx context.Context) error {
+		amount, status, err = r.repository.ReversePayment(ctx, loan.ID, input.WeekNumber, effectiveDate)
+		if err != nil {
+			r.logger.Errorw("failed to reverse payment", "error", err, "loan_id", loan.ID, "week_number", input.WeekNumber)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		// A paid loan is owed again, the liens released when it was paid off
+		// are pledged again
+		if loan.Status == entity.LOAN_PAID {
+			if _, err := r.repository.PledgeLoanCollaterals(ctx, loan.ID, effectiveDate); err != nil {
+				r.logger.Errorw("failed to pledge loan collaterals", "error", err, "loan_id", loan.ID)
+				return pkgerror.BusinessErrorFrom(err)
+			}
+		}
+
+		reversal, err = r.repository.CreateJournalEntry(ctx, original.Reversal(r.snowflakeGen.Generate(), effectiveDate, "Payment reversal: "+input.Reason))
+		if err != nil {
+			r.logger.Errorw("failed to create reversal journal entry", "error", err, "loan_id", loan.ID, "week_number", input.WeekNumber)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return usecases.ReversePaymentOutput{}, err
+	}
+
+	return usecases.ReversePaymentOutput{
+		LoanID:            loan.ID,
+		WeekNumber:        input.WeekNumber,
+		Amount:            amount,
+		InstallmentStatus: string(status),
+		JournalEntry:      toJournalEntryOutput(reversal),
+	}, nil
+}
@@ -0,0 +1,185 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestReversePaymentInteractor_Execute(t *testing.T) {
+	paymentEntry := entity.NewPaymentEntry(400, 100, 3, decimal.NewFromInt(100000), decimal.NewFromInt(10000), decimal.Zero, time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local))
+
+	echoEntry := func(_ context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) { return entry, nil }
+
+	tests := []struct {
+		name          string
+		input         usecases.ReversePaymentInput
+		setupMocks    func(*billingenginemocks.MockReversePaymentRepository, *pkgmocks.MockSnowflake)
+		expectedError error
+	}{
+		{
+			name:  "success - payment reversed, its journal entry cancelled and the liens of the reopened loan pledged again",
+			input: usecases.ReversePaymentInput{LoanID: 100, WeekNumber: 3, Reason: "bounced transfer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockReversePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_PAID}, nil)
+				mockRepo.On("GetUnreversedJournalEntry", mock.Anything, uint64(100), entity.JOURNAL_PAYMENT, "week-3").Return(paymentEntry, nil)
+				mockRepo.On("ReversePayment", mock.Anything, uint64(100), int64(3), mock.Anything).Return("110000.00", entity.INSTALLMENT_MISSED, nil)
+				mockRepo.On("PledgeLoanCollaterals", mock.Anything, uint64(100), mock.Anything).Return(int64(1), nil)
+				mockSnowflake.On("Generate").Return(uint64(401))
+				mockRepo.EXPECT().CreateJournalEntry(mock.Anything, mock.Anything).RunAndReturn(echoEntry)
+			},
+		},
+		{
+			name:  "success - payment reversed on a disbursed loan, its liens left alone",
+			input: usecases.ReversePaymentInput{LoanID: 100, WeekNumber: 3, Reason: "bounced transfer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockReversePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("GetUnreversedJournalEntry", mock.Anything, uint64(100), entity.JOURNAL_PAYMENT, "week-3").Return(paymentEntry, nil)
+				mockRepo.On("ReversePayment", mock.Anything, uint64(100), int64(3), mock.Anything).Return("110000.00", entity.INSTALLMENT_MISSED, nil)
+				mockSnowflake.On("Generate").Return(uint64(401))
+				mockRepo.EXPECT().CreateJournalEntry(mock.Anything, mock.Anything).RunAndReturn(echoEntry)
+			},
+		},
+		{
+			name:  "success - backdated reversal effective on its date",
+			input: usecases.ReversePaymentInput{LoanID: 100, WeekNumber: 3, Reason: "bounced transfer", EffectiveDate: "2024-03-05"},
+			setupMocks: func(mockRepo *billingenginemocks.MockReversePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				effectiveDate := time.Date(2024, 3, 5, 0, 0, 0, 0, time.Local)
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_PAID}, nil)
+				mockRepo.On("GetUnreversedJournalEntry", mock.Anything, uint64(100), entity.JOURNAL_PAYMENT, "week-3").Return(paymentEntry, nil)
+				mockRepo.On("ReversePayment", mock.Anything, uint64(100), int64(3), effectiveDate).Return("110000.00", entity.INSTALLMENT_MISSED, nil)
+				mockRepo.On("PledgeLoanCollaterals", mock.Anything, uint64(100), effectiveDate).Return(int64(1), nil)
+				mockSnowflake.On("Generate").Return(uint64(401))
+				mockRepo.EXPECT().CreateJournalEntry(mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.EffectiveDate.Equal(effectiveDate)
+				})).RunAndReturn(echoEntry)
+			},
+		},
+		{
+			name:  "error - reversal backdated into a closed period",
+			input: usecases.ReversePaymentInput{LoanID: 100, WeekNumber: 3, Reason: "bounced transfer", EffectiveDate: "2024-02-29"},
+			setupMocks: func(mockRepo *billingenginemocks.MockReversePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (missing reason)",
+			input:         usecases.ReversePaymentInput{LoanID: 100, WeekNumber: 3},
+			setupMocks:    func(*billingenginemocks.MockReversePaymentRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan is written off",
+			input: usecases.ReversePaymentInput{LoanID: 100, WeekNumber: 3, Reason: "bounced transfer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockReversePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_WRITTEN_OFF}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - no payment entry to reverse",
+			input: usecases.ReversePaymentInput{LoanID: 100, WeekNumber: 3, Reason: "bounced transfer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockReversePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("GetUnreversedJournalEntry", mock.Anything, uint64(100), entity.JOURNAL_PAYMENT, "week-3").
+					Return(entity.JournalEntry{}, errors.New("no PAYMENT entry week-3 to reverse on loan 100"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on ReversePayment",
+			input: usecases.ReversePaymentInput{LoanID: 100, WeekNumber: 3, Reason: "bounced transfer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockReversePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("GetUnreversedJournalEntry", mock.Anything, uint64(100), entity.JOURNAL_PAYMENT, "week-3").Return(paymentEntry, nil)
+				mockRepo.On("ReversePayment", mock.Anything, uint64(100), int64(3), mock.Anything).
+					Return("", entity.InstallmentStatus(""), errors.New("installment for loan 100 week 3 is not paid"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - reversal rolled back when its journal entry fails",
+			input: usecases.ReversePaymentInput{LoanID: 100, WeekNumber: 3, Reason: "bounced transfer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockReversePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_PAID}, nil)
+				mockRepo.On("GetUnreversedJournalEntry", mock.Anything, uint64(100), entity.JOURNAL_PAYMENT, "week-3").Return(paymentEntry, nil)
+				mockRepo.On("ReversePayment", mock.Anything, uint64(100), int64(3), mock.Anything).Return("110000.00", entity.INSTALLMENT_MISSED, nil)
+				mockRepo.On("PledgeLoanCollaterals", mock.Anything, uint64(100), mock.Anything).Return(int64(1), nil)
+				mockSnowflake.On("Generate").Return(uint64(401))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.Anything).Return(entity.JournalEntry{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - reversal rolled back when the liens cannot be pledged again",
+			input: usecases.ReversePaymentInput{LoanID: 100, WeekNumber: 3, Reason: "bounced transfer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockReversePaymentRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_PAID}, nil)
+				mockRepo.On("GetUnreversedJournalEntry", mock.Anything, uint64(100), entity.JOURNAL_PAYMENT, "week-3").Return(paymentEntry, nil)
+				mockRepo.On("ReversePayment", mock.Anything, uint64(100), int64(3), mock.Anything).Return("110000.00", entity.INSTALLMENT_MISSED, nil)
+				mockRepo.On("PledgeLoanCollaterals", mock.Anything, uint64(100), mock.Anything).Return(int64(0), errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockReversePaymentRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction).Maybe()
+
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewReversePaymentInteractor(ReversePaymentInteractorDependencies{
+				ReversePaymentRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+				Validator:                validator.New(),
+				SnowflakeGen:             mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "110000.00", output.Amount)
+			assert.Equal(t, "MISSED", output.InstallmentStatus)
+
+			reversal := output.JournalEntry
+			assert.Equal(t, uint64(401), reversal.ID)
+			assert.Equal(t, "REVERSAL", reversal.Event)
+			assert.Equal(t, uint64(400), reversal.ReversesEntryID)
+			assert.Equal(t, []usecases.PostingOutput{
+				{AccountCode: entity.ACCOUNT_CASH, Direction: "CREDIT", Amount: "110000.00"},
+				{AccountCode: entity.ACCOUNT_PRINCIPAL_RECEIVABLE, Direction: "DEBIT", Amount: "100000.00"},
+				{AccountCode: entity.ACCOUNT_INTEREST_RECEIVABLE, Direction: "DEBIT", Amount: "10000.00"},
+			}, reversal.Postings)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.RunInterestAccrualUsecase = (*RunInterestAccrualInteractor)(nil)
+
+type (
+	RunInterestAccrualRepository interface {
+		PeriodLockRepository
+		TransactionRepository
+		GetLoanAccrualStates(ctx context.Context) ([]entity.LoanAccrualState, error)
+		GetAllInstallments(ctx context.Context, loanID uint64) ([]entity.Installment, error)
+		CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error)
+		CreateInterestAccrual(ctx context.Context, accrual entity.InterestAccrual) (entity.InterestAccrual, error)
+	}
+
+	RunInterestAccrualInteractorDependencies struct {
+		RunInterestAccrualRepository RunInterestAccrualRepository
+		Logger                       *zap.SugaredLogger
+		Validator                    *validator.Validate
+		SnowflakeGen                 pkguid.Snowflake
+	}
+
+	RunInterestAccrualInteractor struct {
+		repository   RunInterestAccrualRepository `validate:"required"`
+		logger       *zap.SugaredLogger           `validate:"required"`
+		validator    *validator.Validate          `validate:"required"`
+		snowflakeGen pkguid.Snowflake             `validate:"required"`
+	}
+)
+
+func NewRunInterestAccrualInteractor(
+	deps RunInterestAccrualInteractorDependencies,
+) *RunInterestAccrualInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &RunInterestAccrualInteractor{
+		repository:   deps.RunInterestAccrualRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.RunInterestAccrualUsecase.
+//
+// Every DISBURSED loan bearing interest accrues each day from the day after
+// its last accrual, or after its start date, through as_of or its last due
+// date, whichever comes first. Days missed by earlier runs are caught up and
+// days already accrued are skipped, so the run can be repeated safely. Each
+// day is written in its own transaction, its accrual row first, so a
+// concurrent run accruing the same day fails before posting anything. A loan
+// that fails is logged and counted, and the run moves on to the next loan.
+func (r *RunInterestAccrualInteractor) Execute(ctx context.Context, input usecases.RunInterestAccrualInput) (usecases.RunInterestAccrualOutput, error) {
+	if err := r.validator.Struct(input); err != nil {
+		r.logger.Errorw("invalid input", "error", err)
+		return usecases.RunInterestAccrualOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	asOf, err := parseAsOfDate(input.AsOf)
+	if err != nil {
+		return usecases.RunInterestAccrualOutput{}, err
+	}
+
+	if err := ensurePeriodOpen(ctx, r.repository, asOf); err != nil {
+		r.logger.Errorw("failed to check period lock", "error", err, "as_of", asOf.Format(dateLayout))
+		return usecases.RunInterestAccrualOutput{}, err
+	}
+
+	states, err := r.repository.GetLoanAccrualStates(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to get loan accrual states", "error", err)
+		return usecases.RunInterestAccrualOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	output := usecases.RunInterestAccrualOutput{AsOf: asOf.Format(dateLayout)}
+	total := decimal.Zero
+
+	for _, state := range states {
+		days, accrued, err := r.accrueLoan(ctx, state, asOf)
+		if err != nil {
+			r.logger.Errorw("failed to accrue loan interest", "error", err, "loan_id", state.Loan.ID)
+			output.Failed++
+		}
+
+		if days > 0 {
+			output.LoansAccrued++
+			output.DaysAccrued += days
+			total = total.Add(accrued)
+		}
+	}
+
+	output.TotalAccrued = total.StringFixed(2)
+
+	return output, nil
+}
+
+// accrueLoan accrues the pending days of one loan and returns how many days
+// were accrued and their total, including the days accrued before a failure.
+func (r *RunInterestAccrualInteractor) accrueLoan(ctx context.Context, state entity.LoanAccrualState, asOf time.Time) (int, decimal.Decimal, error) {
+	loan := state.Loan
+	total := decimal.Zero
+
+	installments, err := r.repository.GetAllInstallments(ctx, loan.ID)
+	if err != nil {
+		return 0, total, err
+	}
+
+	lastDue, err := entity.LastDueDate(installments)
+	if err != nil {
+		return 0, total, err
+	}
+
+	from := startOfDay(loan.StartDate).AddDate(0, 0, 1)
+	if !state.LastAccrualDate.IsZero() {
+		from = startOfDay(state.LastAccrualDate).AddDate(0, 0, 1)
+	}
+
+	to := asOf
+	if lastDue.Before(to) {
+		to = lastDue
+	}
+
+	days := 0
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		amount, err := entity.DailyInterest(installments, loan.InterestRate, day)
+		if err != nil {
+			return days, total, err
+		}
+
+		accrual := entity.InterestAccrual{
+			ID:          r.snowflakeGen.Generate(),
+			LoanID:      loan.ID,
+			AccrualDate: day,
+			Type:        entity.ACCRUAL_DAILY,
+			Amount:      amount,
+			CreatedAt:   time.Now(),
+		}
+
+		if amount.IsPositive() {
+			accrual.JournalEntryID = r.snowflakeGen.Generate()
+		}
+
+		err = r.repository.WithinTransaction(ctx, func(ctx context.Context) error {
+			if _, err := r.repository.CreateInterestAccrual(ctx, accrual); err != nil {
+				return err
+			}
+
+			if accrual.JournalEntryID == 0 {
+				return nil
+			}
+
+			_, err := r.repository.CreateJournalEntry(ctx, entity.NewAccrualEntry(accrual.JournalEntryID, loan.ID, day, amount))
+			return err
+		})
+		if err != nil {
+			return days, total, err
+		}
+
+		days++
+		total = total.Add(amount)
+	}
+
+	return days, total, nil
+}
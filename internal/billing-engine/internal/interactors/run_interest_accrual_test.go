@@ -0,0 +1,147 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRunInterestAccrualInteractor_Execute(t *testing.T) {
+	loan := entity.Loan{ID: 1, InterestRate: decimal.NewFromFloat(0.1), StartDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local), Status: entity.LOAN_DISBURSED}
+	installments := []entity.Installment{
+		{ID: 1, LoanID: 1, WeekNumber: 1, AmountDue: "110000.00", DueDate: "2024-03-08T00:00:00Z", Status: entity.INSTALLMENT_PENDING},
+		{ID: 2, LoanID: 1, WeekNumber: 2, AmountDue: "110000.00", DueDate: "2024-03-15T00:00:00Z", Status: entity.INSTALLMENT_PENDING},
+	}
+
+	tests := []struct {
+		name           string
+		input          usecases.RunInterestAccrualInput
+		setupMocks     func(*billingenginemocks.MockRunInterestAccrualRepository, *pkgmocks.MockSnowflake)
+		expectedOutput usecases.RunInterestAccrualOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - pending days are caught up through the due date",
+			input: usecases.RunInterestAccrualInput{AsOf: "2024-03-08"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRunInterestAccrualRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoanAccrualStates", mock.Anything).Return([]entity.LoanAccrualState{
+					{Loan: loan, LastAccrualDate: time.Date(2024, 3, 6, 0, 0, 0, 0, time.Local)},
+				}, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(1)).Return(installments, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.ID == 900 && entry.Event == entity.JOURNAL_ACCRUAL && entry.IsBalanced() &&
+						entry.Postings[0].AccountCode == entity.ACCOUNT_INTEREST_RECEIVABLE
+				})).Return(entity.JournalEntry{ID: 900}, nil).Twice()
+				mockRepo.On("CreateInterestAccrual", mock.Anything, mock.MatchedBy(func(accrual entity.InterestAccrual) bool {
+					return accrual.AccrualDate.Equal(time.Date(2024, 3, 7, 0, 0, 0, 0, time.Local)) && accrual.Amount.Equal(decimal.RequireFromString("1428.57")) && accrual.JournalEntryID == 900
+				})).Return(entity.InterestAccrual{}, nil).Once()
+				mockRepo.On("CreateInterestAccrual", mock.Anything, mock.MatchedBy(func(accrual entity.InterestAccrual) bool {
+					return accrual.AccrualDate.Equal(time.Date(2024, 3, 8, 0, 0, 0, 0, time.Local)) && accrual.Amount.Equal(decimal.RequireFromString("1428.58"))
+				})).Return(entity.InterestAccrual{}, nil).Once()
+			},
+			expectedOutput: usecases.RunInterestAccrualOutput{AsOf: "2024-03-08", LoansAccrued: 1, DaysAccrued: 2, TotalAccrued: "2857.15"},
+		},
+		{
+			name:  "success - loan already accrued through as_of is skipped",
+			input: usecases.RunInterestAccrualInput{AsOf: "2024-03-08"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRunInterestAccrualRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoanAccrualStates", mock.Anything).Return([]entity.LoanAccrualState{
+					{Loan: loan, LastAccrualDate: time.Date(2024, 3, 8, 0, 0, 0, 0, time.Local)},
+				}, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(1)).Return(installments, nil)
+			},
+			expectedOutput: usecases.RunInterestAccrualOutput{AsOf: "2024-03-08", TotalAccrued: "0.00"},
+		},
+		{
+			name:  "success - day accrued by a concurrent run posts nothing",
+			input: usecases.RunInterestAccrualInput{AsOf: "2024-03-08"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRunInterestAccrualRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoanAccrualStates", mock.Anything).Return([]entity.LoanAccrualState{
+					{Loan: loan, LastAccrualDate: time.Date(2024, 3, 6, 0, 0, 0, 0, time.Local)},
+				}, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(1)).Return(installments, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("CreateInterestAccrual", mock.Anything, mock.Anything).
+					Return(entity.InterestAccrual{}, errors.New("duplicate key value violates unique constraint")).Once()
+			},
+			expectedOutput: usecases.RunInterestAccrualOutput{AsOf: "2024-03-08", TotalAccrued: "0.00", Failed: 1},
+		},
+		{
+			name:  "success - failing loan is counted and skipped",
+			input: usecases.RunInterestAccrualInput{AsOf: "2024-03-08"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRunInterestAccrualRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoanAccrualStates", mock.Anything).Return([]entity.LoanAccrualState{{Loan: loan}}, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(1)).Return(nil, errors.New("db error"))
+			},
+			expectedOutput: usecases.RunInterestAccrualOutput{AsOf: "2024-03-08", TotalAccrued: "0.00", Failed: 1},
+		},
+		{
+			name:  "error - as_of in a closed period",
+			input: usecases.RunInterestAccrualInput{AsOf: "2024-03-08"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRunInterestAccrualRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-03", Status: entity.PERIOD_CLOSED}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (invalid as_of)",
+			input:         usecases.RunInterestAccrualInput{AsOf: "08-03-2024"},
+			setupMocks:    func(*billingenginemocks.MockRunInterestAccrualRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on GetLoanAccrualStates",
+			input: usecases.RunInterestAccrualInput{AsOf: "2024-03-08"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRunInterestAccrualRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoanAccrualStates", mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockRunInterestAccrualRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+
+			mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction).Maybe()
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewRunInterestAccrualInteractor(RunInterestAccrualInteractorDependencies{
+				RunInterestAccrualRepository: mockRepo,
+				Logger:                       zap.NewNop().Sugar(),
+				Validator:                    validator.New(),
+				SnowflakeGen:                 mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.RunProvisioningUsecase = (*RunProvisioningInteractor)(nil)
+
+type (
+	RunProvisioningRepository interface {
+		PeriodLockRepository
+		GetProvisionRates(ctx context.Context) ([]entity.ProvisionRate, error)
+		GetLoanExposures(ctx context.Context, asOf time.Time) ([]entity.LoanExposure, error)
+		ReplaceLoanProvisions(ctx context.Context, period string, provisions []entity.LoanProvision) error
+	}
+
+	RunProvisioningInteractorDependencies struct {
+		RunProvisioningRepository RunProvisioningRepository
+		Logger                    *zap.SugaredLogger
+		Validator                 *validator.Validate
+		SnowflakeGen              pkguid.Snowflake
+	}
+
+	RunProvisioningInteractor struct {
+		repository   RunProvisioningRepository `validate:"required"`
+		logger       *zap.SugaredLogger        `validate:"required"`
+		validator    *validator.Validate       `validate:"required"`
+		snowflakeGen pkguid.Snowflake          `validate:"required"`
+	}
+)
+
+func NewRunProvisioningInteractor(
+	deps RunProvisioningInteractorDependencies,
+) *RunProvisioningInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &RunProvisioningInteractor{
+		repository:   deps.RunProvisioningRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.RunProvisioningUsecase.
+//
+// Every disbursed loan is staged and provisioned as of the last day of the
+// month. Running a month again replaces its earlier provisions.
+func (r *RunProvisioningInteractor) Execute(ctx context.Context, input usecases.RunProvisioningInput) (usecases.RunProvisioningOutput, error) {
+	if err := r.validator.Struct(input); err != nil {
+		r.logger.Errorw("invalid input", "error", err)
+		return usecases.RunProvisioningOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	month, err := parseMonth(input.Month)
+	if err != nil {
+		return usecases.RunProvisioningOutput{}, err
+	}
+	asOf := endOfMonth(month)
+
+	// The provisions of a closed month are part of its books
+	if err := ensurePeriodOpen(ctx, r.repository, asOf); err != nil {
+		r.logger.Errorw("failed to check period lock", "error", err, "month", input.Month)
+		return usecases.RunProvisioningOutput{}, err
+	}
+
+	rates, err := r.repository.GetProvisionRates(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to get provision rates", "error", err)
+		return usecases.RunProvisioningOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	exposures, err := r.repository.GetLoanExposures(ctx, asOf)
+	if err != nil {
+		r.logger.Errorw("failed to get loan exposures", "error", err, "as_of", asOf)
+		return usecases.RunProvisioningOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	period := month.Format(monthLayout)
+	provisionRates := entity.NewProvisionRates(rates)
+	provisions := make([]entity.LoanProvision, len(exposures))
+	totalExposure, totalECL := decimal.Zero, decimal.Zero
+
+	for i, exposure := range exposures {
+		provision, err := entity.NewLoanProvision(r.snowflakeGen.Generate(), period, exposure, asOf, provisionRates)
+		if err != nil {
+			r.logger.Errorw("failed to provision loan", "error", err, "loan_id", exposure.Loan.ID)
+			return usecases.RunProvisioningOutput{}, pkgerror.BusinessErrorFrom(err)
+		}
+
+		provisions[i] = provision
+		totalExposure = totalExposure.Add(provision.ExposureAtDefault)
+		totalECL = totalECL.Add(provision.ECL)
+	}
+
+	if err := r.repository.ReplaceLoanProvisions(ctx, period, provisions); err != nil {
+		r.logger.Errorw("failed to store loan provisions", "error", err, "period", period)
+		return usecases.RunProvisioningOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return usecases.RunProvisioningOutput{
+		Month:            period,
+		AsOf:             asOf.Format(dateLayout),
+		LoansProvisioned: len(provisions),
+		TotalExposure:    totalExposure.StringFixed(2),
+		TotalECL:         totalECL.StringFixed(2),
+	}, nil
+}
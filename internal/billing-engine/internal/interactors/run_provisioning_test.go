@@ -0,0 +1,120 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRunProvisioningInteractor_Execute(t *testing.T) {
+	rates := []entity.ProvisionRate{
+		{ProductCode: entity.PROVISION_RATE_DEFAULT_PRODUCT, Stage: entity.ECL_STAGE_1, PD: decimal.NewFromFloat(0.02), LGD: decimal.NewFromFloat(0.45)},
+		{ProductCode: entity.PROVISION_RATE_DEFAULT_PRODUCT, Stage: entity.ECL_STAGE_2, PD: decimal.NewFromFloat(0.15), LGD: decimal.NewFromFloat(0.45)},
+		{ProductCode: entity.PROVISION_RATE_DEFAULT_PRODUCT, Stage: entity.ECL_STAGE_3, PD: decimal.NewFromInt(1), LGD: decimal.NewFromFloat(0.6)},
+	}
+	exposures := []entity.LoanExposure{
+		{
+			Loan:                 entity.Loan{ID: 1, CustomerID: 10, ProductCode: entity.DEFAULT_LOAN_PRODUCT},
+			OutstandingPrincipal: decimal.NewFromInt(1000000),
+		},
+		{
+			Loan:                 entity.Loan{ID: 2, CustomerID: 20, ProductCode: entity.DEFAULT_LOAN_PRODUCT},
+			OutstandingPrincipal: decimal.NewFromInt(500000),
+			OldestUnpaidDue:      time.Date(2024, 2, 15, 0, 0, 0, 0, time.Local),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		input          usecases.RunProvisioningInput
+		setupMocks     func(*billingenginemocks.MockRunProvisioningRepository, *pkgmocks.MockSnowflake)
+		expectedOutput usecases.RunProvisioningOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - loans staged and provisioned at month end",
+			input: usecases.RunProvisioningInput{Month: "2024-03"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRunProvisioningRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+				mockRepo.On("GetProvisionRates", mock.Anything).Return(rates, nil)
+				mockRepo.On("GetLoanExposures", mock.Anything, time.Date(2024, 3, 31, 0, 0, 0, 0, time.Local)).Return(exposures, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("ReplaceLoanProvisions", mock.Anything, "2024-03", mock.MatchedBy(func(provisions []entity.LoanProvision) bool {
+					return len(provisions) == 2 && provisions[0].Stage == entity.ECL_STAGE_1 && provisions[1].Stage == entity.ECL_STAGE_2
+				})).Return(nil)
+			},
+			expectedOutput: usecases.RunProvisioningOutput{
+				Month:            "2024-03",
+				AsOf:             "2024-03-31",
+				LoansProvisioned: 2,
+				TotalExposure:    "1500000.00",
+				TotalECL:         "42750.00",
+			},
+		},
+		{
+			name:          "error - validation error (invalid month)",
+			input:         usecases.RunProvisioningInput{Month: "03-2024"},
+			setupMocks:    func(*billingenginemocks.MockRunProvisioningRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - month already closed",
+			input: usecases.RunProvisioningInput{Month: "2024-02"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRunProvisioningRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{Period: "2024-02", Status: entity.PERIOD_CLOSED}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on ReplaceLoanProvisions",
+			input: usecases.RunProvisioningInput{Month: "2024-03"},
+			setupMocks: func(mockRepo *billingenginemocks.MockRunProvisioningRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetProvisionRates", mock.Anything).Return(rates, nil)
+				mockRepo.On("GetLoanExposures", mock.Anything, mock.Anything).Return(exposures, nil)
+				mockSnowflake.On("Generate").Return(uint64(900))
+				mockRepo.On("ReplaceLoanProvisions", mock.Anything, "2024-03", mock.Anything).Return(errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockRunProvisioningRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewRunProvisioningInteractor(RunProvisioningInteractorDependencies{
+				RunProvisioningRepository: mockRepo,
+				Logger:                    zap.NewNop().Sugar(),
+				Validator:                 validator.New(),
+				SnowflakeGen:              mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.SaveCreditLimitUsecase = (*SaveCreditLimitInteractor)(nil)
+
+type (
+	SaveCreditLimitRepository interface {
+		IsCustomerExist(ctx context.Context, customerID uint64) (bool, error)
+		SaveCreditLimit(ctx context.Context, limit entity.CreditLimit) (entity.CreditLimit, error)
+	}
+
+	SaveCreditLimitInteractorDependencies struct {
+		SaveCreditLimitRepository SaveCreditLimitRepository
+		Logger                    *zap.SugaredLogger
+		Validator                 *validator.Validate
+	}
+
+	SaveCreditLimitInteractor struct {
+		repository SaveCreditLimitRepository `validate:"required"`
+		logger     *zap.SugaredLogger        `validate:"required"`
+		validator  *validator.Validate       `validate:"required"`
+	}
+)
+
+func NewSaveCreditLimitInteractor(
+	deps SaveCreditLimitInteractorDependencies,
+) *SaveCreditLimitInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &SaveCreditLimitInteractor{
+		repository: deps.SaveCreditLimitRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.SaveCreditLimitUsecase.
+//
+// Lowering the limit below what the customer already owes leaves their
+// loans untouched, it only refuses new ones.
+func (s *SaveCreditLimitInteractor) Execute(ctx context.Context, input usecases.SaveCreditLimitInput) (usecases.CreditLimitOutput, error) {
+	if err := s.validator.Struct(input); err != nil {
+		s.logger.Errorw("invalid input", "error", err)
+		return usecases.CreditLimitOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	limit, err := decimal.NewFromString(input.CreditLimit)
+	if err != nil {
+		return usecases.CreditLimitOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if !limit.IsPositive() || limit.Exponent() < -2 {
+		return usecases.CreditLimitOutput{}, pkgerror.NewValidationError("credit limit must be a positive amount with at most 2 decimals")
+	}
+
+	isCustomerExist, err := s.repository.IsCustomerExist(ctx, input.CustomerID)
+	if err != nil {
+		s.logger.Errorw("failed to check if customer exists", "error", err, "customer_id", input.CustomerID)
+		return usecases.CreditLimitOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !isCustomerExist {
+		return usecases.CreditLimitOutput{}, pkgerror.NewBusinessError("customer not found")
+	}
+
+	saved, err := s.repository.SaveCreditLimit(ctx, entity.CreditLimit{
+		CustomerID:     input.CustomerID,
+		Limit:          limit,
+		MaxActiveLoans: input.MaxActiveLoans,
+		UpdatedBy:      input.UpdatedBy,
+		UpdatedAt:      time.Now(),
+	})
+	if err != nil {
+		s.logger.Errorw("failed to save credit limit", "error", err, "customer_id", input.CustomerID)
+		return usecases.CreditLimitOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toCreditLimitOutput(saved), nil
+}
+
+func toCreditLimitOutput(limit entity.CreditLimit) usecases.CreditLimitOutput {
+	output := usecases.CreditLimitOutput{
+		CustomerID:     limit.CustomerID,
+		CreditLimit:    limit.Limit.StringFixed(2),
+		MaxActiveLoans: limit.MaxActiveLoans,
+		UpdatedBy:      limit.UpdatedBy,
+	}
+	if !limit.UpdatedAt.IsZero() {
+		output.UpdatedAt = limit.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return output
+}
@@ -0,0 +1,104 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestSaveCreditLimitInteractor_Execute(t *testing.T) {
+	echoLimit := func(_ context.Context, limit entity.CreditLimit) (entity.CreditLimit, error) { return limit, nil }
+
+	tests := []struct {
+		name           string
+		input          usecases.SaveCreditLimitInput
+		setupMocks     func(*billingenginemocks.MockSaveCreditLimitRepository)
+		expectedOutput usecases.CreditLimitOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - credit limit set",
+			input: usecases.SaveCreditLimitInput{CustomerID: 1, CreditLimit: "25000000", MaxActiveLoans: 3, UpdatedBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveCreditLimitRepository) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(1)).Return(true, nil)
+				mockRepo.EXPECT().SaveCreditLimit(mock.Anything, mock.MatchedBy(func(limit entity.CreditLimit) bool {
+					return limit.CustomerID == 1 && limit.Limit.Equal(decimal.NewFromInt(25000000)) && limit.MaxActiveLoans == 3 &&
+						!limit.UpdatedAt.IsZero()
+				})).RunAndReturn(echoLimit)
+			},
+			expectedOutput: usecases.CreditLimitOutput{CustomerID: 1, CreditLimit: "25000000.00", MaxActiveLoans: 3, UpdatedBy: "risk-officer"},
+		},
+		{
+			name:          "error - validation error (no active loan allowed)",
+			input:         usecases.SaveCreditLimitInput{CustomerID: 1, CreditLimit: "25000000", UpdatedBy: "risk-officer"},
+			setupMocks:    func(*billingenginemocks.MockSaveCreditLimitRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - limit is not positive",
+			input:         usecases.SaveCreditLimitInput{CustomerID: 1, CreditLimit: "-1", MaxActiveLoans: 1, UpdatedBy: "risk-officer"},
+			setupMocks:    func(*billingenginemocks.MockSaveCreditLimitRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - limit with fractions of a cent",
+			input:         usecases.SaveCreditLimitInput{CustomerID: 1, CreditLimit: "1000.001", MaxActiveLoans: 1, UpdatedBy: "risk-officer"},
+			setupMocks:    func(*billingenginemocks.MockSaveCreditLimitRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer not found",
+			input: usecases.SaveCreditLimitInput{CustomerID: 404, CreditLimit: "25000000", MaxActiveLoans: 1, UpdatedBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveCreditLimitRepository) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(404)).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on SaveCreditLimit",
+			input: usecases.SaveCreditLimitInput{CustomerID: 1, CreditLimit: "25000000", MaxActiveLoans: 1, UpdatedBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveCreditLimitRepository) {
+				mockRepo.On("IsCustomerExist", mock.Anything, uint64(1)).Return(true, nil)
+				mockRepo.On("SaveCreditLimit", mock.Anything, mock.Anything).Return(entity.CreditLimit{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockSaveCreditLimitRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewSaveCreditLimitInteractor(SaveCreditLimitInteractorDependencies{
+				SaveCreditLimitRepository: mockRepo,
+				Logger:                    zap.NewNop().Sugar(),
+				Validator:                 validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			output.UpdatedAt = ""
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.SaveGLMappingUsecase = (*SaveGLMappingInteractor)(nil)
+
+type (
+	SaveGLMappingRepository interface {
+		GetLedgerAccounts(ctx context.Context) ([]entity.LedgerAccount, error)
+		SaveGLMapping(ctx context.Context, mapping entity.GLMapping) (entity.GLMapping, error)
+	}
+
+	SaveGLMappingInteractorDependencies struct {
+		SaveGLMappingRepository SaveGLMappingRepository
+		Logger                  *zap.SugaredLogger
+		Validator               *validator.Validate
+	}
+
+	SaveGLMappingInteractor struct {
+		repository SaveGLMappingRepository `validate:"required"`
+		logger     *zap.SugaredLogger      `validate:"required"`
+		validator  *validator.Validate     `validate:"required"`
+	}
+)
+
+func NewSaveGLMappingInteractor(
+	deps SaveGLMappingInteractorDependencies,
+) *SaveGLMappingInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &SaveGLMappingInteractor{
+		repository: deps.SaveGLMappingRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.SaveGLMappingUsecase.
+func (s *SaveGLMappingInteractor) Execute(ctx context.Context, input usecases.SaveGLMappingInput) (usecases.GLMappingOutput, error) {
+	if err := s.validator.Struct(input); err != nil {
+		s.logger.Errorw("invalid input", "error", err)
+		return usecases.GLMappingOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	event := entity.JournalEvent(input.Event)
+	if !entity.IsGLMappingEvent(event) {
+		return usecases.GLMappingOutput{}, pkgerror.NewValidationError(fmt.Sprintf("unknown journal event %s", input.Event))
+	}
+
+	accounts, err := s.repository.GetLedgerAccounts(ctx)
+	if err != nil {
+		s.logger.Errorw("failed to get ledger accounts", "error", err)
+		return usecases.GLMappingOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !hasLedgerAccount(accounts, input.AccountCode) {
+		return usecases.GLMappingOutput{}, pkgerror.NewValidationError(fmt.Sprintf("unknown ledger account %s", input.AccountCode))
+	}
+
+	mapping, err := s.repository.SaveGLMapping(ctx, entity.GLMapping{
+		Event:       event,
+		AccountCode: input.AccountCode,
+		GLCode:      input.GLCode,
+		UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		s.logger.Errorw("failed to save GL mapping", "error", err, "event", input.Event, "account_code", input.AccountCode)
+		return usecases.GLMappingOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toGLMappingOutput(mapping), nil
+}
+
+func hasLedgerAccount(accounts []entity.LedgerAccount, code string) bool {
+	for _, account := range accounts {
+		if account.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestSaveGLMappingInteractor_Execute(t *testing.T) {
+	accounts := []entity.LedgerAccount{
+		{Code: entity.ACCOUNT_CASH, Name: "Cash", Type: entity.ACCOUNT_ASSET},
+		{Code: entity.ACCOUNT_PRINCIPAL_RECEIVABLE, Name: "Loan principal receivable", Type: entity.ACCOUNT_ASSET},
+	}
+
+	echoMapping := func(_ context.Context, mapping entity.GLMapping) (entity.GLMapping, error) { return mapping, nil }
+
+	tests := []struct {
+		name           string
+		input          usecases.SaveGLMappingInput
+		setupMocks     func(*billingenginemocks.MockSaveGLMappingRepository)
+		expectedOutput usecases.GLMappingOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - principal repayment mapped to its own GL code",
+			input: usecases.SaveGLMappingInput{Event: "PAYMENT", AccountCode: "PRINCIPAL_RECEIVABLE", GLCode: "1301-02"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveGLMappingRepository) {
+				mockRepo.On("GetLedgerAccounts", mock.Anything).Return(accounts, nil)
+				mockRepo.EXPECT().SaveGLMapping(mock.Anything, mock.Anything).RunAndReturn(echoMapping)
+			},
+			expectedOutput: usecases.GLMappingOutput{Event: "PAYMENT", AccountCode: "PRINCIPAL_RECEIVABLE", GLCode: "1301-02"},
+		},
+		{
+			name:          "error - unknown journal event",
+			input:         usecases.SaveGLMappingInput{Event: "REFUND", AccountCode: "CASH", GLCode: "1101"},
+			setupMocks:    func(*billingenginemocks.MockSaveGLMappingRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - unknown ledger account",
+			input: usecases.SaveGLMappingInput{Event: "DEFAULT", AccountCode: "SUSPENSE", GLCode: "1999"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveGLMappingRepository) {
+				mockRepo.On("GetLedgerAccounts", mock.Anything).Return(accounts, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (missing gl_code)",
+			input:         usecases.SaveGLMappingInput{Event: "DEFAULT", AccountCode: "CASH"},
+			setupMocks:    func(*billingenginemocks.MockSaveGLMappingRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on SaveGLMapping",
+			input: usecases.SaveGLMappingInput{Event: "DEFAULT", AccountCode: "CASH", GLCode: "1101"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveGLMappingRepository) {
+				mockRepo.On("GetLedgerAccounts", mock.Anything).Return(accounts, nil)
+				mockRepo.On("SaveGLMapping", mock.Anything, mock.Anything).Return(entity.GLMapping{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockSaveGLMappingRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewSaveGLMappingInteractor(SaveGLMappingInteractorDependencies{
+				SaveGLMappingRepository: mockRepo,
+				Logger:                  zap.NewNop().Sugar(),
+				Validator:               validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			output.UpdatedAt = ""
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
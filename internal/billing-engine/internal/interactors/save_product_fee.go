@@ -0,0 +1,86 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.SaveProductFeeUsecase = (*SaveProductFeeInteractor)(nil)
+
+type (
+	SaveProductFeeRepository interface {
+		SaveProductFee(ctx context.Context, fee entity.ProductFee) (entity.ProductFee, error)
+	}
+
+	SaveProductFeeInteractorDependencies struct {
+		SaveProductFeeRepository SaveProductFeeRepository
+		Logger                   *zap.SugaredLogger
+		Validator                *validator.Validate
+	}
+
+	SaveProductFeeInteractor struct {
+		repository SaveProductFeeRepository `validate:"required"`
+		logger     *zap.SugaredLogger       `validate:"required"`
+		validator  *validator.Validate      `validate:"required"`
+	}
+)
+
+func NewSaveProductFeeInteractor(
+	deps SaveProductFeeInteractorDependencies,
+) *SaveProductFeeInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &SaveProductFeeInteractor{
+		repository: deps.SaveProductFeeRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.SaveProductFeeUsecase.
+//
+// The fee applies to the loans of the product disbursed from now on.
+func (s *SaveProductFeeInteractor) Execute(ctx context.Context, input usecases.SaveProductFeeInput) (usecases.ProductFeeOutput, error) {
+	if err := s.validator.Struct(input); err != nil {
+		s.logger.Errorw("invalid input", "error", err)
+		return usecases.ProductFeeOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	value, err := decimal.NewFromString(input.Value)
+	if err != nil {
+		return usecases.ProductFeeOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	calculation := entity.FeeCalculation(input.Calculation)
+	switch {
+	case calculation == entity.FEE_PERCENTAGE && (!value.IsPositive() || !isRatio(value)):
+		return usecases.ProductFeeOutput{}, pkgerror.NewValidationError("a percentage fee must be above 0 and at most 1")
+	case calculation == entity.FEE_FLAT && (!value.IsPositive() || value.Exponent() < -2):
+		return usecases.ProductFeeOutput{}, pkgerror.NewValidationError("a flat fee must be a positive amount with at most 2 decimals")
+	}
+
+	fee, err := s.repository.SaveProductFee(ctx, entity.ProductFee{
+		ProductCode: input.ProductCode,
+		FeeType:     entity.FeeType(input.FeeType),
+		Calculation: calculation,
+		Value:       value,
+		Collection:  entity.FeeCollection(input.Collection),
+		UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		s.logger.Errorw("failed to save product fee", "error", err, "product_code", input.ProductCode, "fee_type", input.FeeType)
+		return usecases.ProductFeeOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toProductFeeOutput(fee), nil
+}
@@ -0,0 +1,98 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestSaveProductFeeInteractor_Execute(t *testing.T) {
+	echoFee := func(_ context.Context, fee entity.ProductFee) (entity.ProductFee, error) { return fee, nil }
+
+	tests := []struct {
+		name           string
+		input          usecases.SaveProductFeeInput
+		setupMocks     func(*billingenginemocks.MockSaveProductFeeRepository)
+		expectedOutput usecases.ProductFeeOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - percentage fee deducted at disbursement",
+			input: usecases.SaveProductFeeInput{ProductCode: "DEFAULT", FeeType: "ORIGINATION", Calculation: "PERCENTAGE", Value: "0.02", Collection: "DEDUCTED"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveProductFeeRepository) {
+				mockRepo.EXPECT().SaveProductFee(mock.Anything, mock.Anything).RunAndReturn(echoFee)
+			},
+			expectedOutput: usecases.ProductFeeOutput{ProductCode: "DEFAULT", FeeType: "ORIGINATION", Calculation: "PERCENTAGE", Value: "0.02", Collection: "DEDUCTED"},
+		},
+		{
+			name:  "success - flat fee spread over the installments",
+			input: usecases.SaveProductFeeInput{ProductCode: "DEFAULT", FeeType: "INSURANCE", Calculation: "FLAT", Value: "75000", Collection: "AMORTISED"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveProductFeeRepository) {
+				mockRepo.EXPECT().SaveProductFee(mock.Anything, mock.Anything).RunAndReturn(echoFee)
+			},
+			expectedOutput: usecases.ProductFeeOutput{ProductCode: "DEFAULT", FeeType: "INSURANCE", Calculation: "FLAT", Value: "75000", Collection: "AMORTISED"},
+		},
+		{
+			name:          "error - percentage above one",
+			input:         usecases.SaveProductFeeInput{ProductCode: "DEFAULT", FeeType: "ADMIN", Calculation: "PERCENTAGE", Value: "2", Collection: "DEDUCTED"},
+			setupMocks:    func(*billingenginemocks.MockSaveProductFeeRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - flat fee with fractions of a cent",
+			input:         usecases.SaveProductFeeInput{ProductCode: "DEFAULT", FeeType: "ADMIN", Calculation: "FLAT", Value: "1000.005", Collection: "DEDUCTED"},
+			setupMocks:    func(*billingenginemocks.MockSaveProductFeeRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (unknown collection)",
+			input:         usecases.SaveProductFeeInput{ProductCode: "DEFAULT", FeeType: "ADMIN", Calculation: "FLAT", Value: "1000", Collection: "UPFRONT"},
+			setupMocks:    func(*billingenginemocks.MockSaveProductFeeRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on SaveProductFee",
+			input: usecases.SaveProductFeeInput{ProductCode: "DEFAULT", FeeType: "ADMIN", Calculation: "FLAT", Value: "1000", Collection: "DEDUCTED"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveProductFeeRepository) {
+				mockRepo.On("SaveProductFee", mock.Anything, mock.Anything).Return(entity.ProductFee{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockSaveProductFeeRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewSaveProductFeeInteractor(SaveProductFeeInteractorDependencies{
+				SaveProductFeeRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+				Validator:                validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			output.UpdatedAt = ""
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.SaveProvisionRateUsecase = (*SaveProvisionRateInteractor)(nil)
+
+type (
+	SaveProvisionRateRepository interface {
+		SaveProvisionRate(ctx context.Context, rate entity.ProvisionRate) (entity.ProvisionRate, error)
+	}
+
+	SaveProvisionRateInteractorDependencies struct {
+		SaveProvisionRateRepository SaveProvisionRateRepository
+		Logger                      *zap.SugaredLogger
+		Validator                   *validator.Validate
+	}
+
+	SaveProvisionRateInteractor struct {
+		repository SaveProvisionRateRepository `validate:"required"`
+		logger     *zap.SugaredLogger          `validate:"required"`
+		validator  *validator.Validate         `validate:"required"`
+	}
+)
+
+func NewSaveProvisionRateInteractor(
+	deps SaveProvisionRateInteractorDependencies,
+) *SaveProvisionRateInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &SaveProvisionRateInteractor{
+		repository: deps.SaveProvisionRateRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.SaveProvisionRateUsecase.
+func (s *SaveProvisionRateInteractor) Execute(ctx context.Context, input usecases.SaveProvisionRateInput) (usecases.ProvisionRateOutput, error) {
+	if err := s.validator.Struct(input); err != nil {
+		s.logger.Errorw("invalid input", "error", err)
+		return usecases.ProvisionRateOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	pd, err := decimal.NewFromString(input.PD)
+	if err != nil {
+		return usecases.ProvisionRateOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	lgd, err := decimal.NewFromString(input.LGD)
+	if err != nil {
+		return usecases.ProvisionRateOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	if !isRatio(pd) || !isRatio(lgd) {
+		return usecases.ProvisionRateOutput{}, pkgerror.NewValidationError("pd and lgd must be between 0 and 1")
+	}
+
+	rate, err := s.repository.SaveProvisionRate(ctx, entity.ProvisionRate{
+		ProductCode: input.ProductCode,
+		Stage:       entity.ECLStage(input.Stage),
+		PD:          pd,
+		LGD:         lgd,
+		UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		s.logger.Errorw("failed to save provision rate", "error", err, "product_code", input.ProductCode, "stage", input.Stage)
+		return usecases.ProvisionRateOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toProvisionRateOutput(rate), nil
+}
+
+// isRatio reports whether value lies between 0 and 1 inclusive.
+func isRatio(value decimal.Decimal) bool {
+	return !value.IsNegative() && value.LessThanOrEqual(decimal.NewFromInt(1))
+}
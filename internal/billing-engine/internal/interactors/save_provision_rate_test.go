@@ -0,0 +1,84 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestSaveProvisionRateInteractor_Execute(t *testing.T) {
+	echoRate := func(_ context.Context, rate entity.ProvisionRate) (entity.ProvisionRate, error) { return rate, nil }
+
+	tests := []struct {
+		name           string
+		input          usecases.SaveProvisionRateInput
+		setupMocks     func(*billingenginemocks.MockSaveProvisionRateRepository)
+		expectedOutput usecases.ProvisionRateOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - product rate saved",
+			input: usecases.SaveProvisionRateInput{ProductCode: "STANDARD", Stage: 2, PD: "0.2", LGD: "0.45"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveProvisionRateRepository) {
+				mockRepo.EXPECT().SaveProvisionRate(mock.Anything, mock.Anything).RunAndReturn(echoRate)
+			},
+			expectedOutput: usecases.ProvisionRateOutput{ProductCode: "STANDARD", Stage: 2, PD: "0.2000", LGD: "0.4500"},
+		},
+		{
+			name:          "error - pd above one",
+			input:         usecases.SaveProvisionRateInput{ProductCode: "STANDARD", Stage: 3, PD: "1.5", LGD: "0.45"},
+			setupMocks:    func(*billingenginemocks.MockSaveProvisionRateRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (unknown stage)",
+			input:         usecases.SaveProvisionRateInput{ProductCode: "STANDARD", Stage: 4, PD: "0.2", LGD: "0.45"},
+			setupMocks:    func(*billingenginemocks.MockSaveProvisionRateRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on SaveProvisionRate",
+			input: usecases.SaveProvisionRateInput{ProductCode: "DEFAULT", Stage: 1, PD: "0.02", LGD: "0.45"},
+			setupMocks: func(mockRepo *billingenginemocks.MockSaveProvisionRateRepository) {
+				mockRepo.On("SaveProvisionRate", mock.Anything, mock.Anything).Return(entity.ProvisionRate{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockSaveProvisionRateRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewSaveProvisionRateInteractor(SaveProvisionRateInteractorDependencies{
+				SaveProvisionRateRepository: mockRepo,
+				Logger:                      zap.NewNop().Sugar(),
+				Validator:                   validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			output.UpdatedAt = ""
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
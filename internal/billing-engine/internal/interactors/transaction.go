@@ -0,0 +1,12 @@
+package interactors
+
+import "context"
+
+// TransactionRepository is embedded by the repositories of the write paths
+// whose statements must succeed or fail together.
+type TransactionRepository interface {
+	// WithinTransaction runs fn in one database transaction, rolled back when
+	// fn fails. Only the repository calls made with the context handed to fn
+	// join it.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,109 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.UpdateCollateralLienUsecase = (*UpdateCollateralLienInteractor)(nil)
+
+type (
+	UpdateCollateralLienRepository interface {
+		GetCollateral(ctx context.Context, collateralID uint64) (entity.Collateral, error)
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		UpdateCollateralLien(ctx context.Context, collateralID uint64, from entity.LienStatus, to entity.LienStatus, updatedBy string, updatedAt time.Time) (bool, error)
+	}
+
+	UpdateCollateralLienInteractorDependencies struct {
+		UpdateCollateralLienRepository UpdateCollateralLienRepository
+		Logger                         *zap.SugaredLogger
+		Validator                      *validator.Validate
+	}
+
+	UpdateCollateralLienInteractor struct {
+		repository UpdateCollateralLienRepository `validate:"required"`
+		logger     *zap.SugaredLogger             `validate:"required"`
+		validator  *validator.Validate            `validate:"required"`
+	}
+)
+
+func NewUpdateCollateralLienInteractor(
+	deps UpdateCollateralLienInteractorDependencies,
+) *UpdateCollateralLienInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &UpdateCollateralLienInteractor{
+		repository: deps.UpdateCollateralLienRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.UpdateCollateralLienUsecase.
+//
+// A lien is registered while its loan is active and is not released while the
+// loan is disbursed; a paid loan releases its collateral by itself.
+func (u *UpdateCollateralLienInteractor) Execute(ctx context.Context, input usecases.UpdateCollateralLienInput) (usecases.CollateralOutput, error) {
+	if err := u.validator.Struct(input); err != nil {
+		u.logger.Errorw("invalid input", "error", err)
+		return usecases.CollateralOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	collateral, err := u.repository.GetCollateral(ctx, input.CollateralID)
+	if err != nil {
+		u.logger.Errorw("failed to get collateral", "error", err, "collateral_id", input.CollateralID)
+		return usecases.CollateralOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	to := entity.LienStatus(input.LienStatus)
+	if !collateral.LienStatus.CanTransitionTo(to) {
+		return usecases.CollateralOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("the lien of collateral %d cannot move from %s to %s", collateral.ID, collateral.LienStatus, to),
+		)
+	}
+
+	loan, err := u.repository.GetLoan(ctx, collateral.LoanID)
+	if err != nil {
+		u.logger.Errorw("failed to get loan", "error", err, "loan_id", collateral.LoanID)
+		return usecases.CollateralOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	switch {
+	case to == entity.LIEN_RELEASED && loan.Status == entity.LOAN_DISBURSED:
+		return usecases.CollateralOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("loan %d is still owed, its collateral is released once it is paid", loan.ID),
+		)
+	case to == entity.LIEN_REGISTERED && !loan.Status.IsActive():
+		return usecases.CollateralOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("loan %d is %s, liens are registered on active loans", loan.ID, loan.Status),
+		)
+	}
+
+	updatedAt := time.Now()
+	updated, err := u.repository.UpdateCollateralLien(ctx, collateral.ID, collateral.LienStatus, to, input.UpdatedBy, updatedAt)
+	if err != nil {
+		u.logger.Errorw("failed to update collateral lien", "error", err, "collateral_id", collateral.ID)
+		return usecases.CollateralOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !updated {
+		return usecases.CollateralOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("the lien of collateral %d is no longer %s", collateral.ID, collateral.LienStatus),
+		)
+	}
+
+	collateral.LienStatus = to
+	collateral.LienUpdatedBy = input.UpdatedBy
+	collateral.LienUpdatedAt = updatedAt
+	return toCollateralOutput(collateral), nil
+}
@@ -0,0 +1,129 @@
+package interactors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestUpdateCollateralLienInteractor_Execute(t *testing.T) {
+	collateralIn := func(status entity.LienStatus) entity.Collateral {
+		return entity.Collateral{
+			ID: 500, LoanID: 100, Type: entity.COLLATERAL_DEPOSIT, Description: "Deposit 0012345",
+			Valuation: decimal.NewFromInt(20000000), LienStatus: status,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.UpdateCollateralLienInput
+		setupMocks    func(*billingenginemocks.MockUpdateCollateralLienRepository)
+		expectedCheck func(*testing.T, usecases.CollateralOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - lien registered on an approved loan",
+			input: usecases.UpdateCollateralLienInput{CollateralID: 500, LienStatus: "REGISTERED", UpdatedBy: "legal"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCollateralLienRepository) {
+				mockRepo.On("GetCollateral", mock.Anything, uint64(500)).Return(collateralIn(entity.LIEN_PENDING), nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_APPROVED}, nil)
+				mockRepo.On("UpdateCollateralLien", mock.Anything, uint64(500), entity.LIEN_PENDING, entity.LIEN_REGISTERED, "legal", mock.Anything).
+					Return(true, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CollateralOutput) {
+				assert.Equal(t, "REGISTERED", output.LienStatus)
+				assert.Equal(t, "legal", output.LienUpdatedBy)
+				assert.NotEmpty(t, output.LienUpdatedAt)
+			},
+		},
+		{
+			name:  "success - lien released on a cancelled loan",
+			input: usecases.UpdateCollateralLienInput{CollateralID: 500, LienStatus: "RELEASED", UpdatedBy: "legal"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCollateralLienRepository) {
+				mockRepo.On("GetCollateral", mock.Anything, uint64(500)).Return(collateralIn(entity.LIEN_REGISTERED), nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_CANCELLED}, nil)
+				mockRepo.On("UpdateCollateralLien", mock.Anything, uint64(500), entity.LIEN_REGISTERED, entity.LIEN_RELEASED, "legal", mock.Anything).
+					Return(true, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CollateralOutput) {
+				assert.Equal(t, "RELEASED", output.LienStatus)
+			},
+		},
+		{
+			name:  "error - lien released while the loan is disbursed",
+			input: usecases.UpdateCollateralLienInput{CollateralID: 500, LienStatus: "RELEASED", UpdatedBy: "legal"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCollateralLienRepository) {
+				mockRepo.On("GetCollateral", mock.Anything, uint64(500)).Return(collateralIn(entity.LIEN_REGISTERED), nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_DISBURSED}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - lien registered again on a paid loan",
+			input: usecases.UpdateCollateralLienInput{CollateralID: 500, LienStatus: "REGISTERED", UpdatedBy: "legal"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCollateralLienRepository) {
+				mockRepo.On("GetCollateral", mock.Anything, uint64(500)).Return(collateralIn(entity.LIEN_RELEASED), nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_PAID}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - lien already registered",
+			input: usecases.UpdateCollateralLienInput{CollateralID: 500, LienStatus: "REGISTERED", UpdatedBy: "legal"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCollateralLienRepository) {
+				mockRepo.On("GetCollateral", mock.Anything, uint64(500)).Return(collateralIn(entity.LIEN_REGISTERED), nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - lien moved concurrently",
+			input: usecases.UpdateCollateralLienInput{CollateralID: 500, LienStatus: "REGISTERED", UpdatedBy: "legal"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCollateralLienRepository) {
+				mockRepo.On("GetCollateral", mock.Anything, uint64(500)).Return(collateralIn(entity.LIEN_PENDING), nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_DISBURSED}, nil)
+				mockRepo.On("UpdateCollateralLien", mock.Anything, uint64(500), entity.LIEN_PENDING, entity.LIEN_REGISTERED, "legal", mock.Anything).
+					Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (pending is not a target)",
+			input:         usecases.UpdateCollateralLienInput{CollateralID: 500, LienStatus: "PENDING", UpdatedBy: "legal"},
+			setupMocks:    func(*billingenginemocks.MockUpdateCollateralLienRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockUpdateCollateralLienRepository(t)
+			tt.setupMocks(mockRepo)
+
+			interactor := NewUpdateCollateralLienInteractor(UpdateCollateralLienInteractorDependencies{
+				UpdateCollateralLienRepository: mockRepo,
+				Logger:                         zap.NewNop().Sugar(),
+				Validator:                      validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
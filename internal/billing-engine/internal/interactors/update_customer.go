@@ -0,0 +1,117 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.UpdateCustomerUsecase = (*UpdateCustomerInteractor)(nil)
+
+type (
+	UpdateCustomerRepository interface {
+		GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error)
+		UpdateCustomer(ctx context.Context, customer entity.Customer) (entity.Customer, error)
+	}
+
+	UpdateCustomerInteractorDependencies struct {
+		UpdateCustomerRepository UpdateCustomerRepository
+		Logger                   *zap.SugaredLogger
+		Validator                *validator.Validate
+	}
+
+	UpdateCustomerInteractor struct {
+		repository UpdateCustomerRepository `validate:"required"`
+		logger     *zap.SugaredLogger       `validate:"required"`
+		validator  *validator.Validate      `validate:"required"`
+	}
+)
+
+func NewUpdateCustomerInteractor(
+	deps UpdateCustomerInteractorDependencies,
+) *UpdateCustomerInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &UpdateCustomerInteractor{
+		repository: deps.UpdateCustomerRepository,
+		logger:     deps.Logger,
+		validator:  deps.Validator,
+	}
+}
+
+// Execute implements usecases.UpdateCustomerUsecase.
+//
+// Changing the NIK or date of birth of a verified customer sends them back
+// to PENDING, unless the KYC status is set along with it.
+func (u *UpdateCustomerInteractor) Execute(ctx context.Context, input usecases.UpdateCustomerInput) (usecases.CustomerProfileOutput, error) {
+	if err := u.validator.Struct(input); err != nil {
+		u.logger.Errorw("invalid input", "error", err)
+		return usecases.CustomerProfileOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	dateOfBirth, err := parseOptionalDate(input.DateOfBirth)
+	if err != nil {
+		return usecases.CustomerProfileOutput{}, err
+	}
+
+	customer, err := u.repository.GetCustomer(ctx, input.CustomerID)
+	if err != nil {
+		u.logger.Errorw("failed to get customer", "error", err, "customer_id", input.CustomerID)
+		return usecases.CustomerProfileOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	identityChanged := (input.NIK != "" && input.NIK != customer.NIK) ||
+		(!dateOfBirth.IsZero() && !dateOfBirth.Equal(customer.DateOfBirth))
+
+	if input.Name != "" {
+		customer.Name = input.Name
+	}
+	if input.Email != "" {
+		customer.Email = input.Email
+	}
+	if input.Phone != "" {
+		customer.Phone = input.Phone
+	}
+	if input.Language != "" {
+		customer.Language = entity.Language(input.Language)
+	}
+	if input.NIK != "" {
+		customer.NIK = input.NIK
+	}
+	if !dateOfBirth.IsZero() {
+		customer.DateOfBirth = dateOfBirth
+	}
+	if input.Address != "" {
+		customer.Address = input.Address
+	}
+
+	switch {
+	case input.KYCStatus != "":
+		customer.KYCStatus = entity.KYCStatus(input.KYCStatus)
+	case identityChanged && customer.KYCStatus == entity.KYC_VERIFIED:
+		customer.KYCStatus = entity.KYC_PENDING
+	}
+
+	if err := validateCustomerIdentity(customer); err != nil {
+		return usecases.CustomerProfileOutput{}, err
+	}
+
+	if customer.KYCStatus == entity.KYC_VERIFIED && customer.NIK == "" {
+		return usecases.CustomerProfileOutput{}, pkgerror.NewValidationError("a customer without a NIK cannot be verified")
+	}
+
+	updated, err := u.repository.UpdateCustomer(ctx, customer)
+	if err != nil {
+		u.logger.Errorw("failed to update customer", "error", err, "customer_id", input.CustomerID)
+		return usecases.CustomerProfileOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	return toCustomerProfileOutput(updated), nil
+}
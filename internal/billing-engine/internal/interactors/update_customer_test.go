@@ -0,0 +1,138 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestUpdateCustomerInteractor_Execute(t *testing.T) {
+	verified := entity.Customer{
+		ID: 1, Name: "Budi", Email: "budi@example.com", Language: entity.LANGUAGE_INDONESIAN,
+		NIK: "3171011705900001", DateOfBirth: time.Date(1990, 5, 17, 0, 0, 0, 0, time.Local), KYCStatus: entity.KYC_VERIFIED,
+	}
+	unverified := entity.Customer{ID: 2, Name: "Ani", Email: "ani@example.com", Language: entity.LANGUAGE_INDONESIAN, KYCStatus: entity.KYC_UNVERIFIED}
+	echoCustomer := func(_ context.Context, customer entity.Customer) (entity.Customer, error) { return customer, nil }
+
+	tests := []struct {
+		name          string
+		input         usecases.UpdateCustomerInput
+		setupMocks    func(*billingenginemocks.MockUpdateCustomerRepository)
+		expectedCheck func(*testing.T, usecases.CustomerProfileOutput)
+		expectedError error
+	}{
+		{
+			name:  "success - only the fields set change",
+			input: usecases.UpdateCustomerInput{CustomerID: 1, Address: "Jl. Sudirman No. 5, Jakarta", Language: "en"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCustomerRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(verified, nil)
+				mockRepo.EXPECT().UpdateCustomer(mock.Anything, mock.Anything).RunAndReturn(echoCustomer)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CustomerProfileOutput) {
+				assert.Equal(t, "Budi", output.Name)
+				assert.Equal(t, "Jl. Sudirman No. 5, Jakarta", output.Address)
+				assert.Equal(t, "en", output.Language)
+				assert.Equal(t, "3171011705900001", output.NIK)
+				assert.Equal(t, "VERIFIED", output.KYCStatus)
+			},
+		},
+		{
+			name:  "success - identity submitted for review",
+			input: usecases.UpdateCustomerInput{CustomerID: 2, NIK: "3273025108850003", DateOfBirth: "1985-08-11", KYCStatus: "PENDING"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCustomerRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(2)).Return(unverified, nil)
+				mockRepo.EXPECT().UpdateCustomer(mock.Anything, mock.Anything).RunAndReturn(echoCustomer)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CustomerProfileOutput) {
+				assert.Equal(t, "3273025108850003", output.NIK)
+				assert.Equal(t, "1985-08-11", output.DateOfBirth)
+				assert.Equal(t, "PENDING", output.KYCStatus)
+			},
+		},
+		{
+			name:  "success - changing the NIK of a verified customer sends them back to review",
+			input: usecases.UpdateCustomerInput{CustomerID: 1, NIK: "3171011705900002"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCustomerRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(verified, nil)
+				mockRepo.EXPECT().UpdateCustomer(mock.Anything, mock.Anything).RunAndReturn(echoCustomer)
+			},
+			expectedCheck: func(t *testing.T, output usecases.CustomerProfileOutput) {
+				assert.Equal(t, "PENDING", output.KYCStatus)
+			},
+		},
+		{
+			name:  "error - new date of birth does not match the NIK",
+			input: usecases.UpdateCustomerInput{CustomerID: 1, DateOfBirth: "1991-05-17"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCustomerRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(verified, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer without a NIK cannot be verified",
+			input: usecases.UpdateCustomerInput{CustomerID: 2, KYCStatus: "VERIFIED"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCustomerRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(2)).Return(unverified, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:          "error - validation error (invalid kyc status)",
+			input:         usecases.UpdateCustomerInput{CustomerID: 1, KYCStatus: "APPROVED"},
+			setupMocks:    func(*billingenginemocks.MockUpdateCustomerRepository) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - customer not found or deleted",
+			input: usecases.UpdateCustomerInput{CustomerID: 404, Name: "Nobody"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCustomerRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(404)).Return(entity.Customer{}, errors.New("customer 404 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on UpdateCustomer",
+			input: usecases.UpdateCustomerInput{CustomerID: 1, Email: "taken@example.com"},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateCustomerRepository) {
+				mockRepo.On("GetCustomer", mock.Anything, uint64(1)).Return(verified, nil)
+				mockRepo.On("UpdateCustomer", mock.Anything, mock.Anything).Return(entity.Customer{}, errors.New("duplicate email"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockUpdateCustomerRepository(t)
+
+			tt.setupMocks(mockRepo)
+
+			interactor := NewUpdateCustomerInteractor(UpdateCustomerInteractorDependencies{
+				UpdateCustomerRepository: mockRepo,
+				Logger:                   zap.NewNop().Sugar(),
+				Validator:                validator.New(),
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
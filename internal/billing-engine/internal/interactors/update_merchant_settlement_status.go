@@ -0,0 +1,128 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+var _ usecases.UpdateMerchantSettlementStatusUsecase = (*UpdateMerchantSettlementStatusInteractor)(nil)
+
+type (
+	UpdateMerchantSettlementStatusRepository interface {
+		PeriodLockRepository
+		GetMerchantSettlement(ctx context.Context, settlementID uint64) (entity.MerchantSettlement, error)
+		UpdateMerchantSettlement(ctx context.Context, settlement entity.MerchantSettlement, from entity.SettlementStatus) (bool, error)
+		CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error)
+	}
+
+	UpdateMerchantSettlementStatusInteractorDependencies struct {
+		UpdateMerchantSettlementStatusRepository UpdateMerchantSettlementStatusRepository
+		Logger                                   *zap.SugaredLogger
+		Validator                                *validator.Validate
+		SnowflakeGen                             pkguid.Snowflake
+	}
+
+	UpdateMerchantSettlementStatusInteractor struct {
+		repository   UpdateMerchantSettlementStatusRepository `validate:"required"`
+		logger       *zap.SugaredLogger                       `validate:"required"`
+		validator    *validator.Validate                      `validate:"required"`
+		snowflakeGen pkguid.Snowflake                         `validate:"required"`
+	}
+)
+
+func NewUpdateMerchantSettlementStatusInteractor(
+	deps UpdateMerchantSettlementStatusInteractorDependencies,
+) *UpdateMerchantSettlementStatusInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &UpdateMerchantSettlementStatusInteractor{
+		repository:   deps.UpdateMerchantSettlementStatusRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.UpdateMerchantSettlementStatusUsecase.
+//
+// A SETTLED batch has paid the merchant what it was owed: the payable of each
+// of its loans is settled against cash on the effective date.
+func (u *UpdateMerchantSettlementStatusInteractor) Execute(ctx context.Context, input usecases.UpdateMerchantSettlementStatusInput) (usecases.MerchantSettlementOutput, error) {
+	if err := u.validator.Struct(input); err != nil {
+		u.logger.Errorw("invalid input", "error", err)
+		return usecases.MerchantSettlementOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	settlement, err := u.repository.GetMerchantSettlement(ctx, input.SettlementID)
+	if err != nil {
+		u.logger.Errorw("failed to get merchant settlement", "error", err, "settlement_id", input.SettlementID)
+		return usecases.MerchantSettlementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	from := settlement.Status
+	to := entity.SettlementStatus(input.Status)
+	if !from.CanTransitionTo(to) {
+		return usecases.MerchantSettlementOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("merchant settlement %d cannot move from %s to %s", settlement.ID, from, to),
+		)
+	}
+
+	if to == entity.SETTLEMENT_SETTLED {
+		settledAt, err := parseEffectiveDate(input.EffectiveDate)
+		if err != nil {
+			return usecases.MerchantSettlementOutput{}, err
+		}
+
+		if err := ensurePeriodOpen(ctx, u.repository, settledAt); err != nil {
+			return usecases.MerchantSettlementOutput{}, err
+		}
+
+		settlement.SettledAt = settledAt
+	}
+
+	settlement.Status = to
+	if input.Reference != "" {
+		settlement.Reference = input.Reference
+	}
+	settlement.FailureReason = ""
+	if to == entity.SETTLEMENT_FAILED {
+		settlement.FailureReason = input.FailureReason
+	}
+	settlement.UpdatedBy = input.UpdatedBy
+	settlement.UpdatedAt = time.Now()
+
+	updated, err := u.repository.UpdateMerchantSettlement(ctx, settlement, from)
+	if err != nil {
+		u.logger.Errorw("failed to update merchant settlement", "error", err, "settlement_id", settlement.ID)
+		return usecases.MerchantSettlementOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !updated {
+		return usecases.MerchantSettlementOutput{}, pkgerror.NewBusinessError(
+			fmt.Sprintf("merchant settlement %d is no longer %s", settlement.ID, from),
+		)
+	}
+
+	if to == entity.SETTLEMENT_SETTLED {
+		for _, item := range settlement.Items {
+			entry := entity.NewSettlementEntry(u.snowflakeGen.Generate(), settlement.ID, item, settlement.SettledAt)
+			if _, err := u.repository.CreateJournalEntry(ctx, entry); err != nil {
+				u.logger.Errorw("failed to create settlement entry", "error", err, "settlement_id", settlement.ID, "loan_id", item.LoanID)
+				return usecases.MerchantSettlementOutput{}, pkgerror.BusinessErrorFrom(err)
+			}
+		}
+	}
+
+	return toMerchantSettlementOutput(settlement), nil
+}
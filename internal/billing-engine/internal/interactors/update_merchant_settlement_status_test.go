@@ -0,0 +1,149 @@
+package interactors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestUpdateMerchantSettlementStatusInteractor_Execute(t *testing.T) {
+	settledOn := time.Date(2024, 3, 6, 0, 0, 0, 0, time.Local)
+	sent := entity.MerchantSettlement{
+		ID: 1000, MerchantID: 900, SettlementDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.Local),
+		GrossAmount: decimal.NewFromInt(3000000), MDRAmount: decimal.NewFromInt(75000), NetAmount: decimal.NewFromInt(2925000),
+		ItemCount: 2, Status: entity.SETTLEMENT_SENT, Reference: "TRF-1", UpdatedBy: "finance",
+		Items: []entity.MerchantSettlementItem{
+			{ID: 1, SettlementID: 1000, MerchantID: 900, LoanID: 100, NetAmount: decimal.NewFromInt(975000)},
+			{ID: 2, SettlementID: 1000, MerchantID: 900, LoanID: 101, NetAmount: decimal.NewFromInt(1950000)},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		input         usecases.UpdateMerchantSettlementStatusInput
+		setupMocks    func(*billingenginemocks.MockUpdateMerchantSettlementStatusRepository, *pkgmocks.MockSnowflake)
+		expectedCheck func(*testing.T, usecases.MerchantSettlementOutput)
+		expectedError error
+	}{
+		{
+			name: "success - settled batch pays the payable of each loan",
+			input: usecases.UpdateMerchantSettlementStatusInput{
+				SettlementID: 1000, Status: "SETTLED", EffectiveDate: "2024-03-06", UpdatedBy: "treasury",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateMerchantSettlementStatusRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetMerchantSettlement", mock.Anything, uint64(1000)).Return(sent, nil)
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("UpdateMerchantSettlement", mock.Anything, mock.MatchedBy(func(settlement entity.MerchantSettlement) bool {
+					return settlement.Status == entity.SETTLEMENT_SETTLED && settlement.SettledAt.Equal(settledOn) &&
+						settlement.Reference == "TRF-1" && settlement.UpdatedBy == "treasury"
+				}), entity.SETTLEMENT_SENT).Return(true, nil)
+				mockSnowflake.On("Generate").Return(uint64(2000)).Twice()
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return isBalancedEntry(entity.JOURNAL_SETTLEMENT)(entry) && entry.Reference == "settlement-1000" &&
+						entry.EffectiveDate.Equal(settledOn) && entry.Postings[0].AccountCode == entity.ACCOUNT_MERCHANT_PAYABLE
+				})).Return(entity.JournalEntry{}, nil).Twice()
+			},
+			expectedCheck: func(t *testing.T, output usecases.MerchantSettlementOutput) {
+				assert.Equal(t, "SETTLED", output.Status)
+				assert.Equal(t, settledOn.Format(time.RFC3339), output.SettledAt)
+			},
+		},
+		{
+			name: "success - failed batch records why",
+			input: usecases.UpdateMerchantSettlementStatusInput{
+				SettlementID: 1000, Status: "FAILED", FailureReason: "account closed", UpdatedBy: "treasury",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateMerchantSettlementStatusRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetMerchantSettlement", mock.Anything, uint64(1000)).Return(sent, nil)
+				mockRepo.On("UpdateMerchantSettlement", mock.Anything, mock.MatchedBy(func(settlement entity.MerchantSettlement) bool {
+					return settlement.Status == entity.SETTLEMENT_FAILED && settlement.FailureReason == "account closed" &&
+						settlement.SettledAt.IsZero()
+				}), entity.SETTLEMENT_SENT).Return(true, nil)
+			},
+			expectedCheck: func(t *testing.T, output usecases.MerchantSettlementOutput) {
+				assert.Equal(t, "FAILED", output.Status)
+				assert.Equal(t, "account closed", output.FailureReason)
+				assert.Empty(t, output.SettledAt)
+			},
+		},
+		{
+			name: "error - validation error (failure without a reason)",
+			input: usecases.UpdateMerchantSettlementStatusInput{
+				SettlementID: 1000, Status: "FAILED", UpdatedBy: "treasury",
+			},
+			setupMocks:    func(*billingenginemocks.MockUpdateMerchantSettlementStatusRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - sent batch cannot go back to pending",
+			input: usecases.UpdateMerchantSettlementStatusInput{
+				SettlementID: 1000, Status: "PENDING", UpdatedBy: "treasury",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateMerchantSettlementStatusRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetMerchantSettlement", mock.Anything, uint64(1000)).Return(sent, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - settled in a closed period",
+			input: usecases.UpdateMerchantSettlementStatusInput{
+				SettlementID: 1000, Status: "SETTLED", EffectiveDate: "2024-03-06", UpdatedBy: "treasury",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateMerchantSettlementStatusRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetMerchantSettlement", mock.Anything, uint64(1000)).Return(sent, nil)
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{
+					Period: "2024-03", Status: entity.PERIOD_CLOSED,
+				}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name: "error - batch updated concurrently",
+			input: usecases.UpdateMerchantSettlementStatusInput{
+				SettlementID: 1000, Status: "FAILED", FailureReason: "account closed", UpdatedBy: "treasury",
+			},
+			setupMocks: func(mockRepo *billingenginemocks.MockUpdateMerchantSettlementStatusRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetMerchantSettlement", mock.Anything, uint64(1000)).Return(sent, nil)
+				mockRepo.On("UpdateMerchantSettlement", mock.Anything, mock.Anything, entity.SETTLEMENT_SENT).Return(false, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockUpdateMerchantSettlementStatusRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewUpdateMerchantSettlementStatusInteractor(UpdateMerchantSettlementStatusInteractorDependencies{
+				UpdateMerchantSettlementStatusRepository: mockRepo,
+				Logger:                                   zap.NewNop().Sugar(),
+				Validator:                                validator.New(),
+				SnowflakeGen:                             mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.expectedCheck(t, output)
+		})
+	}
+}
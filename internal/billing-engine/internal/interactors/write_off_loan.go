@@ -0,0 +1,213 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkguid"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ usecases.WriteOffLoanUsecase = (*WriteOffLoanInteractor)(nil)
+
+type (
+	WriteOffLoanRepository interface {
+		PeriodLockRepository
+		TransactionRepository
+		GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error)
+		GetAllInstallments(ctx context.Context, loanID uint64) ([]entity.Installment, error)
+		SetOpenInstallmentsStatus(ctx context.Context, loanID uint64, status entity.InstallmentStatus) (int64, error)
+		TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error
+		CreateWriteOff(ctx context.Context, writeOff entity.WriteOff) (entity.WriteOff, error)
+		CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error)
+		GetLoanAccountBalance(ctx context.Context, loanID uint64, accountCode string) (decimal.Decimal, error)
+		CreateInterestAccrual(ctx context.Context, accrual entity.InterestAccrual) (entity.InterestAccrual, error)
+	}
+
+	WriteOffLoanInteractorDependencies struct {
+		WriteOffLoanRepository WriteOffLoanRepository
+		Logger                 *zap.SugaredLogger
+		Validator              *validator.Validate
+		SnowflakeGen           pkguid.Snowflake
+	}
+
+	WriteOffLoanInteractor struct {
+		repository   WriteOffLoanRepository `validate:"required"`
+		logger       *zap.SugaredLogger     `validate:"required"`
+		validator    *validator.Validate    `validate:"required"`
+		snowflakeGen pkguid.Snowflake       `validate:"required"`
+	}
+)
+
+func NewWriteOffLoanInteractor(
+	deps WriteOffLoanInteractorDependencies,
+) *WriteOffLoanInteractor {
+	validate := validator.New()
+	if err := validate.Struct(deps); err != nil {
+		panic(err)
+	}
+
+	return &WriteOffLoanInteractor{
+		repository:   deps.WriteOffLoanRepository,
+		logger:       deps.Logger,
+		validator:    deps.Validator,
+		snowflakeGen: deps.SnowflakeGen,
+	}
+}
+
+// Execute implements usecases.WriteOffLoanUsecase.
+//
+// The remaining PENDING and MISSED installments are marked WRITTEN_OFF and
+// their total, split into principal, interest and fees, is recorded against
+// the loan, which is marked WRITTEN_OFF, and expensed in the ledger. Interest
+// accrued but not paid is reversed out of income, all in one transaction.
+// The write-off is booked today, which must fall in an open accounting
+// period.
+func (w *WriteOffLoanInteractor) Execute(ctx context.Context, input usecases.WriteOffLoanInput) (usecases.WriteOffLoanOutput, error) {
+	if err := w.validator.Struct(input); err != nil {
+		w.logger.Errorw("invalid input", "error", err)
+		return usecases.WriteOffLoanOutput{}, pkgerror.ValidationErrorFrom(err)
+	}
+
+	writtenOffAt := time.Now()
+	if err := ensurePeriodOpen(ctx, w.repository, startOfDay(writtenOffAt)); err != nil {
+		w.logger.Errorw("failed to check period lock", "error", err, "loan_id", input.LoanID, "effective_date", writtenOffAt.Format(dateLayout))
+		return usecases.WriteOffLoanOutput{}, err
+	}
+
+	loan, err := w.repository.GetLoan(ctx, input.LoanID)
+	if err != nil {
+		w.logger.Errorw("failed to get loan", "error", err, "loan_id", input.LoanID)
+		return usecases.WriteOffLoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	transition, err := newLoanTransition(loan, entity.LOAN_WRITTEN_OFF, input.WrittenOffBy, input.Reason)
+	if err != nil {
+		return usecases.WriteOffLoanOutput{}, err
+	}
+
+	installments, err := w.repository.GetAllInstallments(ctx, loan.ID)
+	if err != nil {
+		w.logger.Errorw("failed to get installments", "error", err, "loan_id", loan.ID)
+		return usecases.WriteOffLoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	schedule, err := summariseOpenSchedule(installments)
+	if err != nil {
+		w.logger.Errorw("failed to parse installment amount", "error", err, "loan_id", loan.ID)
+		return usecases.WriteOffLoanOutput{}, pkgerror.BusinessErrorFrom(err)
+	}
+
+	if !schedule.outstanding.IsPositive() {
+		return usecases.WriteOffLoanOutput{}, pkgerror.NewBusinessError("loan has no outstanding balance")
+	}
+
+	principal, interest := entity.SplitPrincipalInterest(schedule.outstanding.Sub(schedule.fees).Sub(schedule.holidayInterest), loan.InterestRate)
+	interest = interest.Add(schedule.holidayInterest)
+
+	// The loan is moved first, so a concurrent write-off or payment fails its
+	// compare-and-swap before writing anything, and a failure midway rolls the
+	// whole write-off back
+	var (
+		writtenOff int64
+		writeOff   entity.WriteOff
+	)
+	err = w.repository.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := w.repository.TransitionLoanStatus(ctx, transition); err != nil {
+			w.logger.Errorw("failed to mark loan as written off", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		writtenOff, err = w.repository.SetOpenInstallmentsStatus(ctx, loan.ID, entity.INSTALLMENT_WRITTEN_OFF)
+		if err != nil {
+			w.logger.Errorw("failed to write off open installments", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		writeOff, err = w.repository.CreateWriteOff(ctx, entity.WriteOff{
+			ID:              w.snowflakeGen.Generate(),
+			LoanID:          loan.ID,
+			CustomerID:      loan.CustomerID,
+			PrincipalAmount: principal,
+			InterestAmount:  interest,
+			FeeAmount:       schedule.fees,
+			Reason:          input.Reason,
+			WrittenOffBy:    input.WrittenOffBy,
+			WrittenOffAt:    writtenOffAt,
+		})
+		if err != nil {
+			w.logger.Errorw("failed to create write-off", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		if _, err := w.repository.CreateJournalEntry(ctx, entity.NewWriteOffEntry(w.snowflakeGen.Generate(), writeOff)); err != nil {
+			w.logger.Errorw("failed to post write-off journal entry", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		if err := w.reverseAccruedInterest(ctx, loan.ID, startOfDay(writeOff.WrittenOffAt)); err != nil {
+			w.logger.Errorw("failed to reverse accrued interest", "error", err, "loan_id", loan.ID)
+			return pkgerror.BusinessErrorFrom(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return usecases.WriteOffLoanOutput{}, err
+	}
+
+	return usecases.WriteOffLoanOutput{
+		WriteOff:               toWriteOffOutput(writeOff),
+		WrittenOffInstallments: writtenOff,
+	}, nil
+}
+
+// reverseAccruedInterest takes the interest receivable left on the loan back
+// out of income and records the reversal in the accrual history.
+func (w *WriteOffLoanInteractor) reverseAccruedInterest(ctx context.Context, loanID uint64, day time.Time) error {
+	accrued, err := w.repository.GetLoanAccountBalance(ctx, loanID, entity.ACCOUNT_INTEREST_RECEIVABLE)
+	if err != nil {
+		return err
+	}
+
+	if !accrued.IsPositive() {
+		return nil
+	}
+
+	entry, err := w.repository.CreateJournalEntry(ctx, entity.NewAccrualReversalEntry(w.snowflakeGen.Generate(), loanID, day, accrued))
+	if err != nil {
+		return err
+	}
+
+	_, err = w.repository.CreateInterestAccrual(ctx, entity.InterestAccrual{
+		ID:             w.snowflakeGen.Generate(),
+		LoanID:         loanID,
+		AccrualDate:    day,
+		Type:           entity.ACCRUAL_REVERSAL,
+		Amount:         accrued,
+		JournalEntryID: entry.ID,
+		CreatedAt:      time.Now(),
+	})
+
+	return err
+}
+
+func toWriteOffOutput(writeOff entity.WriteOff) usecases.WriteOffOutput {
+	return usecases.WriteOffOutput{
+		ID:              writeOff.ID,
+		LoanID:          writeOff.LoanID,
+		CustomerID:      writeOff.CustomerID,
+		PrincipalAmount: writeOff.PrincipalAmount.StringFixed(2),
+		InterestAmount:  writeOff.InterestAmount.StringFixed(2),
+		FeeAmount:       writeOff.FeeAmount.StringFixed(2),
+		TotalAmount:     writeOff.Total().StringFixed(2),
+		Reason:          writeOff.Reason,
+		WrittenOffBy:    writeOff.WrittenOffBy,
+		WrittenOffAt:    writeOff.WrittenOffAt.Format(time.RFC3339),
+	}
+}
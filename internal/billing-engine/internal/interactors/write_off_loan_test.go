@@ -0,0 +1,199 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	billingenginemocks "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/mocks"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgerror"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgmocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestWriteOffLoanInteractor_Execute(t *testing.T) {
+	disbursedLoan := entity.Loan{ID: 100, CustomerID: 1, InterestRate: decimal.NewFromFloat(0.1), Status: entity.LOAN_DISBURSED}
+	installments := []entity.Installment{
+		{ID: 1, LoanID: 100, WeekNumber: 1, AmountDue: "110000.00", Status: entity.INSTALLMENT_PAID},
+		{ID: 2, LoanID: 100, WeekNumber: 2, AmountDue: "110000.00", Status: entity.INSTALLMENT_MISSED},
+		{ID: 3, LoanID: 100, WeekNumber: 3, AmountDue: "110000.00", Status: entity.INSTALLMENT_PENDING},
+	}
+
+	echoWriteOff := func(_ context.Context, writeOff entity.WriteOff) (entity.WriteOff, error) { return writeOff, nil }
+
+	tests := []struct {
+		name           string
+		input          usecases.WriteOffLoanInput
+		setupMocks     func(*billingenginemocks.MockWriteOffLoanRepository, *pkgmocks.MockSnowflake)
+		expectedOutput usecases.WriteOffLoanOutput
+		expectedError  error
+	}{
+		{
+			name:  "success - outstanding is written off as principal and interest",
+			input: usecases.WriteOffLoanInput{LoanID: 100, Reason: "deceased", WrittenOffBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockWriteOffLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+				mockRepo.On("SetOpenInstallmentsStatus", mock.Anything, uint64(100), entity.INSTALLMENT_WRITTEN_OFF).Return(int64(2), nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.MatchedBy(func(transition entity.LoanStatusTransition) bool {
+					return transition.LoanID == 100 && transition.ToStatus == entity.LOAN_WRITTEN_OFF
+				})).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(500))
+				mockRepo.EXPECT().CreateWriteOff(mock.Anything, mock.Anything).RunAndReturn(echoWriteOff)
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.IsBalanced() && entry.TotalDebit().Equal(decimal.NewFromInt(200000))
+				})).Return(entity.JournalEntry{}, nil)
+				mockRepo.On("GetLoanAccountBalance", mock.Anything, uint64(100), entity.ACCOUNT_INTEREST_RECEIVABLE).Return(decimal.Zero, nil)
+			},
+			expectedOutput: usecases.WriteOffLoanOutput{
+				WriteOff: usecases.WriteOffOutput{
+					ID: 500, LoanID: 100, CustomerID: 1,
+					PrincipalAmount: "200000.00", InterestAmount: "20000.00", FeeAmount: "0.00", TotalAmount: "220000.00",
+					Reason: "deceased", WrittenOffBy: "risk-officer",
+				},
+				WrittenOffInstallments: 2,
+			},
+		},
+		{
+			name:  "success - accrued interest is reversed out of income",
+			input: usecases.WriteOffLoanInput{LoanID: 100, Reason: "deceased", WrittenOffBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockWriteOffLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+				mockRepo.On("SetOpenInstallmentsStatus", mock.Anything, uint64(100), entity.INSTALLMENT_WRITTEN_OFF).Return(int64(2), nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.MatchedBy(func(transition entity.LoanStatusTransition) bool {
+					return transition.LoanID == 100 && transition.ToStatus == entity.LOAN_WRITTEN_OFF
+				})).Return(nil)
+				mockSnowflake.On("Generate").Return(uint64(500))
+				mockRepo.EXPECT().CreateWriteOff(mock.Anything, mock.Anything).RunAndReturn(echoWriteOff)
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.Event == entity.JOURNAL_WRITE_OFF
+				})).Return(entity.JournalEntry{}, nil)
+				mockRepo.On("GetLoanAccountBalance", mock.Anything, uint64(100), entity.ACCOUNT_INTEREST_RECEIVABLE).Return(decimal.NewFromInt(1500), nil)
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.MatchedBy(func(entry entity.JournalEntry) bool {
+					return entry.Event == entity.JOURNAL_ACCRUAL_REVERSAL && entry.IsBalanced() &&
+						entry.Postings[0].AccountCode == entity.ACCOUNT_INTEREST_INCOME && entry.TotalDebit().Equal(decimal.NewFromInt(1500))
+				})).Return(entity.JournalEntry{ID: 700}, nil)
+				mockRepo.On("CreateInterestAccrual", mock.Anything, mock.MatchedBy(func(accrual entity.InterestAccrual) bool {
+					return accrual.Type == entity.ACCRUAL_REVERSAL && accrual.Amount.Equal(decimal.NewFromInt(1500)) && accrual.JournalEntryID == 700
+				})).Return(entity.InterestAccrual{}, nil)
+			},
+			expectedOutput: usecases.WriteOffLoanOutput{
+				WriteOff: usecases.WriteOffOutput{
+					ID: 500, LoanID: 100, CustomerID: 1,
+					PrincipalAmount: "200000.00", InterestAmount: "20000.00", FeeAmount: "0.00", TotalAmount: "220000.00",
+					Reason: "deceased", WrittenOffBy: "risk-officer",
+				},
+				WrittenOffInstallments: 2,
+			},
+		},
+		{
+			name:          "error - validation error (missing reason)",
+			input:         usecases.WriteOffLoanInput{LoanID: 100, WrittenOffBy: "risk-officer"},
+			setupMocks:    func(*billingenginemocks.MockWriteOffLoanRepository, *pkgmocks.MockSnowflake) {},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - current accounting period already closed",
+			input: usecases.WriteOffLoanInput{LoanID: 100, Reason: "deceased", WrittenOffBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockWriteOffLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).
+					Return(entity.AccountingPeriod{Period: entity.PeriodOf(time.Now()), Status: entity.PERIOD_CLOSED}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - illegal transition from a written off loan",
+			input: usecases.WriteOffLoanInput{LoanID: 100, Reason: "deceased", WrittenOffBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockWriteOffLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{ID: 100, Status: entity.LOAN_WRITTEN_OFF}, nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan has no outstanding balance",
+			input: usecases.WriteOffLoanInput{LoanID: 100, Reason: "deceased", WrittenOffBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockWriteOffLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments[:1], nil)
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - loan written off concurrently, nothing is written",
+			input: usecases.WriteOffLoanInput{LoanID: 100, Reason: "deceased", WrittenOffBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockWriteOffLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.Anything).Return(errors.New("loan 100 is no longer DISBURSED"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - write-off rolled back when its journal entry fails",
+			input: usecases.WriteOffLoanInput{LoanID: 100, Reason: "deceased", WrittenOffBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockWriteOffLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(disbursedLoan, nil)
+				mockRepo.On("GetAllInstallments", mock.Anything, uint64(100)).Return(installments, nil)
+				mockRepo.On("TransitionLoanStatus", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("SetOpenInstallmentsStatus", mock.Anything, uint64(100), entity.INSTALLMENT_WRITTEN_OFF).Return(int64(2), nil)
+				mockSnowflake.On("Generate").Return(uint64(500))
+				mockRepo.EXPECT().CreateWriteOff(mock.Anything, mock.Anything).RunAndReturn(echoWriteOff)
+				mockRepo.On("CreateJournalEntry", mock.Anything, mock.Anything).Return(entity.JournalEntry{}, errors.New("db error"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+		{
+			name:  "error - repository error on GetLoan",
+			input: usecases.WriteOffLoanInput{LoanID: 100, Reason: "deceased", WrittenOffBy: "risk-officer"},
+			setupMocks: func(mockRepo *billingenginemocks.MockWriteOffLoanRepository, mockSnowflake *pkgmocks.MockSnowflake) {
+				mockRepo.On("GetLatestClosedPeriod", mock.Anything).Return(entity.AccountingPeriod{}, nil)
+				mockRepo.On("GetLoan", mock.Anything, uint64(100)).Return(entity.Loan{}, errors.New("loan 100 not found"))
+			},
+			expectedError: &pkgerror.Error{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := billingenginemocks.NewMockWriteOffLoanRepository(t)
+			mockSnowflake := pkgmocks.NewMockSnowflake(t)
+
+			mockRepo.EXPECT().WithinTransaction(mock.Anything, mock.Anything).RunAndReturn(runInTransaction).Maybe()
+			tt.setupMocks(mockRepo, mockSnowflake)
+
+			interactor := NewWriteOffLoanInteractor(WriteOffLoanInteractorDependencies{
+				WriteOffLoanRepository: mockRepo,
+				Logger:                 zap.NewNop().Sugar(),
+				Validator:              validator.New(),
+				SnowflakeGen:           mockSnowflake,
+			})
+
+			output, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			output.WriteOff.WrittenOffAt = ""
+			assert.Equal(t, tt.expectedOutput, output)
+		})
+	}
+}
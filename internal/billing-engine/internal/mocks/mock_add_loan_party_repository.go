@@ -0,0 +1,268 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAddLoanPartyRepository is an autogenerated mock type for the AddLoanPartyRepository type
+type MockAddLoanPartyRepository struct {
+	mock.Mock
+}
+
+type MockAddLoanPartyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAddLoanPartyRepository) EXPECT() *MockAddLoanPartyRepository_Expecter {
+	return &MockAddLoanPartyRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddLoanParty provides a mock function with given fields: ctx, party
+func (_m *MockAddLoanPartyRepository) AddLoanParty(ctx context.Context, party entity.LoanParty) (entity.LoanParty, error) {
+	ret := _m.Called(ctx, party)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddLoanParty")
+	}
+
+	var r0 entity.LoanParty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanParty) (entity.LoanParty, error)); ok {
+		return rf(ctx, party)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanParty) entity.LoanParty); ok {
+		r0 = rf(ctx, party)
+	} else {
+		r0 = ret.Get(0).(entity.LoanParty)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.LoanParty) error); ok {
+		r1 = rf(ctx, party)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAddLoanPartyRepository_AddLoanParty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddLoanParty'
+type MockAddLoanPartyRepository_AddLoanParty_Call struct {
+	*mock.Call
+}
+
+// AddLoanParty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - party entity.LoanParty
+func (_e *MockAddLoanPartyRepository_Expecter) AddLoanParty(ctx interface{}, party interface{}) *MockAddLoanPartyRepository_AddLoanParty_Call {
+	return &MockAddLoanPartyRepository_AddLoanParty_Call{Call: _e.mock.On("AddLoanParty", ctx, party)}
+}
+
+func (_c *MockAddLoanPartyRepository_AddLoanParty_Call) Run(run func(ctx context.Context, party entity.LoanParty)) *MockAddLoanPartyRepository_AddLoanParty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanParty))
+	})
+	return _c
+}
+
+func (_c *MockAddLoanPartyRepository_AddLoanParty_Call) Return(_a0 entity.LoanParty, _a1 error) *MockAddLoanPartyRepository_AddLoanParty_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAddLoanPartyRepository_AddLoanParty_Call) RunAndReturn(run func(context.Context, entity.LoanParty) (entity.LoanParty, error)) *MockAddLoanPartyRepository_AddLoanParty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomer provides a mock function with given fields: ctx, customerID
+func (_m *MockAddLoanPartyRepository) GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomer")
+	}
+
+	var r0 entity.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Customer, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Customer); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.Customer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAddLoanPartyRepository_GetCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomer'
+type MockAddLoanPartyRepository_GetCustomer_Call struct {
+	*mock.Call
+}
+
+// GetCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockAddLoanPartyRepository_Expecter) GetCustomer(ctx interface{}, customerID interface{}) *MockAddLoanPartyRepository_GetCustomer_Call {
+	return &MockAddLoanPartyRepository_GetCustomer_Call{Call: _e.mock.On("GetCustomer", ctx, customerID)}
+}
+
+func (_c *MockAddLoanPartyRepository_GetCustomer_Call) Run(run func(ctx context.Context, customerID uint64)) *MockAddLoanPartyRepository_GetCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAddLoanPartyRepository_GetCustomer_Call) Return(_a0 entity.Customer, _a1 error) *MockAddLoanPartyRepository_GetCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAddLoanPartyRepository_GetCustomer_Call) RunAndReturn(run func(context.Context, uint64) (entity.Customer, error)) *MockAddLoanPartyRepository_GetCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockAddLoanPartyRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAddLoanPartyRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockAddLoanPartyRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockAddLoanPartyRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockAddLoanPartyRepository_GetLoan_Call {
+	return &MockAddLoanPartyRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockAddLoanPartyRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockAddLoanPartyRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAddLoanPartyRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockAddLoanPartyRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAddLoanPartyRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockAddLoanPartyRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanParties provides a mock function with given fields: ctx, loanID
+func (_m *MockAddLoanPartyRepository) GetLoanParties(ctx context.Context, loanID uint64) ([]entity.LoanParty, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanParties")
+	}
+
+	var r0 []entity.LoanParty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanParty, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanParty); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanParty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAddLoanPartyRepository_GetLoanParties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanParties'
+type MockAddLoanPartyRepository_GetLoanParties_Call struct {
+	*mock.Call
+}
+
+// GetLoanParties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockAddLoanPartyRepository_Expecter) GetLoanParties(ctx interface{}, loanID interface{}) *MockAddLoanPartyRepository_GetLoanParties_Call {
+	return &MockAddLoanPartyRepository_GetLoanParties_Call{Call: _e.mock.On("GetLoanParties", ctx, loanID)}
+}
+
+func (_c *MockAddLoanPartyRepository_GetLoanParties_Call) Run(run func(ctx context.Context, loanID uint64)) *MockAddLoanPartyRepository_GetLoanParties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAddLoanPartyRepository_GetLoanParties_Call) Return(_a0 []entity.LoanParty, _a1 error) *MockAddLoanPartyRepository_GetLoanParties_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAddLoanPartyRepository_GetLoanParties_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanParty, error)) *MockAddLoanPartyRepository_GetLoanParties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAddLoanPartyRepository creates a new instance of MockAddLoanPartyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAddLoanPartyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAddLoanPartyRepository {
+	mock := &MockAddLoanPartyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAddLoanPartyUsecase is an autogenerated mock type for the AddLoanPartyUsecase type
+type MockAddLoanPartyUsecase struct {
+	mock.Mock
+}
+
+type MockAddLoanPartyUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAddLoanPartyUsecase) EXPECT() *MockAddLoanPartyUsecase_Expecter {
+	return &MockAddLoanPartyUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockAddLoanPartyUsecase) Execute(ctx context.Context, input usecases.AddLoanPartyInput) (usecases.LoanPartyOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.LoanPartyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.AddLoanPartyInput) (usecases.LoanPartyOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.AddLoanPartyInput) usecases.LoanPartyOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.LoanPartyOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.AddLoanPartyInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAddLoanPartyUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockAddLoanPartyUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.AddLoanPartyInput
+func (_e *MockAddLoanPartyUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockAddLoanPartyUsecase_Execute_Call {
+	return &MockAddLoanPartyUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockAddLoanPartyUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.AddLoanPartyInput)) *MockAddLoanPartyUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.AddLoanPartyInput))
+	})
+	return _c
+}
+
+func (_c *MockAddLoanPartyUsecase_Execute_Call) Return(_a0 usecases.LoanPartyOutput, _a1 error) *MockAddLoanPartyUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAddLoanPartyUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.AddLoanPartyInput) (usecases.LoanPartyOutput, error)) *MockAddLoanPartyUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAddLoanPartyUsecase creates a new instance of MockAddLoanPartyUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAddLoanPartyUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAddLoanPartyUsecase {
+	mock := &MockAddLoanPartyUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,201 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockApproveLoanRepository is an autogenerated mock type for the ApproveLoanRepository type
+type MockApproveLoanRepository struct {
+	mock.Mock
+}
+
+type MockApproveLoanRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockApproveLoanRepository) EXPECT() *MockApproveLoanRepository_Expecter {
+	return &MockApproveLoanRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockApproveLoanRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockApproveLoanRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockApproveLoanRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockApproveLoanRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockApproveLoanRepository_GetLoan_Call {
+	return &MockApproveLoanRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockApproveLoanRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockApproveLoanRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockApproveLoanRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockApproveLoanRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockApproveLoanRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockApproveLoanRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanParties provides a mock function with given fields: ctx, loanID
+func (_m *MockApproveLoanRepository) GetLoanParties(ctx context.Context, loanID uint64) ([]entity.LoanParty, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanParties")
+	}
+
+	var r0 []entity.LoanParty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanParty, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanParty); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanParty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockApproveLoanRepository_GetLoanParties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanParties'
+type MockApproveLoanRepository_GetLoanParties_Call struct {
+	*mock.Call
+}
+
+// GetLoanParties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockApproveLoanRepository_Expecter) GetLoanParties(ctx interface{}, loanID interface{}) *MockApproveLoanRepository_GetLoanParties_Call {
+	return &MockApproveLoanRepository_GetLoanParties_Call{Call: _e.mock.On("GetLoanParties", ctx, loanID)}
+}
+
+func (_c *MockApproveLoanRepository_GetLoanParties_Call) Run(run func(ctx context.Context, loanID uint64)) *MockApproveLoanRepository_GetLoanParties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockApproveLoanRepository_GetLoanParties_Call) Return(_a0 []entity.LoanParty, _a1 error) *MockApproveLoanRepository_GetLoanParties_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockApproveLoanRepository_GetLoanParties_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanParty, error)) *MockApproveLoanRepository_GetLoanParties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionLoanStatus provides a mock function with given fields: ctx, transition
+func (_m *MockApproveLoanRepository) TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error {
+	ret := _m.Called(ctx, transition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionLoanStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanStatusTransition) error); ok {
+		r0 = rf(ctx, transition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockApproveLoanRepository_TransitionLoanStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionLoanStatus'
+type MockApproveLoanRepository_TransitionLoanStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionLoanStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transition entity.LoanStatusTransition
+func (_e *MockApproveLoanRepository_Expecter) TransitionLoanStatus(ctx interface{}, transition interface{}) *MockApproveLoanRepository_TransitionLoanStatus_Call {
+	return &MockApproveLoanRepository_TransitionLoanStatus_Call{Call: _e.mock.On("TransitionLoanStatus", ctx, transition)}
+}
+
+func (_c *MockApproveLoanRepository_TransitionLoanStatus_Call) Run(run func(ctx context.Context, transition entity.LoanStatusTransition)) *MockApproveLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanStatusTransition))
+	})
+	return _c
+}
+
+func (_c *MockApproveLoanRepository_TransitionLoanStatus_Call) Return(_a0 error) *MockApproveLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApproveLoanRepository_TransitionLoanStatus_Call) RunAndReturn(run func(context.Context, entity.LoanStatusTransition) error) *MockApproveLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockApproveLoanRepository creates a new instance of MockApproveLoanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockApproveLoanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockApproveLoanRepository {
+	mock := &MockApproveLoanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
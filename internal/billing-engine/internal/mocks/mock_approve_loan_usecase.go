@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockApproveLoanUsecase is an autogenerated mock type for the ApproveLoanUsecase type
+type MockApproveLoanUsecase struct {
+	mock.Mock
+}
+
+type MockApproveLoanUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockApproveLoanUsecase) EXPECT() *MockApproveLoanUsecase_Expecter {
+	return &MockApproveLoanUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockApproveLoanUsecase) Execute(ctx context.Context, input usecases.ApproveLoanInput) (usecases.LoanOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.LoanOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ApproveLoanInput) (usecases.LoanOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ApproveLoanInput) usecases.LoanOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.LoanOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.ApproveLoanInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockApproveLoanUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockApproveLoanUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.ApproveLoanInput
+func (_e *MockApproveLoanUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockApproveLoanUsecase_Execute_Call {
+	return &MockApproveLoanUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockApproveLoanUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.ApproveLoanInput)) *MockApproveLoanUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.ApproveLoanInput))
+	})
+	return _c
+}
+
+func (_c *MockApproveLoanUsecase_Execute_Call) Return(_a0 usecases.LoanOutput, _a1 error) *MockApproveLoanUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockApproveLoanUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.ApproveLoanInput) (usecases.LoanOutput, error)) *MockApproveLoanUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockApproveLoanUsecase creates a new instance of MockApproveLoanUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockApproveLoanUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockApproveLoanUsecase {
+	mock := &MockApproveLoanUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,390 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCheckAccountingPeriodRepository is an autogenerated mock type for the CheckAccountingPeriodRepository type
+type MockCheckAccountingPeriodRepository struct {
+	mock.Mock
+}
+
+type MockCheckAccountingPeriodRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCheckAccountingPeriodRepository) EXPECT() *MockCheckAccountingPeriodRepository_Expecter {
+	return &MockCheckAccountingPeriodRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAccountTotals provides a mock function with given fields: ctx, asOf
+func (_m *MockCheckAccountingPeriodRepository) GetAccountTotals(ctx context.Context, asOf time.Time) ([]entity.AccountTotal, error) {
+	ret := _m.Called(ctx, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountTotals")
+	}
+
+	var r0 []entity.AccountTotal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.AccountTotal, error)); ok {
+		return rf(ctx, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.AccountTotal); ok {
+		r0 = rf(ctx, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AccountTotal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCheckAccountingPeriodRepository_GetAccountTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountTotals'
+type MockCheckAccountingPeriodRepository_GetAccountTotals_Call struct {
+	*mock.Call
+}
+
+// GetAccountTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asOf time.Time
+func (_e *MockCheckAccountingPeriodRepository_Expecter) GetAccountTotals(ctx interface{}, asOf interface{}) *MockCheckAccountingPeriodRepository_GetAccountTotals_Call {
+	return &MockCheckAccountingPeriodRepository_GetAccountTotals_Call{Call: _e.mock.On("GetAccountTotals", ctx, asOf)}
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetAccountTotals_Call) Run(run func(ctx context.Context, asOf time.Time)) *MockCheckAccountingPeriodRepository_GetAccountTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetAccountTotals_Call) Return(_a0 []entity.AccountTotal, _a1 error) *MockCheckAccountingPeriodRepository_GetAccountTotals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetAccountTotals_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.AccountTotal, error)) *MockCheckAccountingPeriodRepository_GetAccountTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllInstallments provides a mock function with given fields: ctx, loanID
+func (_m *MockCheckAccountingPeriodRepository) GetAllInstallments(ctx context.Context, loanID uint64) ([]entity.Installment, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllInstallments")
+	}
+
+	var r0 []entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.Installment, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.Installment); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCheckAccountingPeriodRepository_GetAllInstallments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllInstallments'
+type MockCheckAccountingPeriodRepository_GetAllInstallments_Call struct {
+	*mock.Call
+}
+
+// GetAllInstallments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockCheckAccountingPeriodRepository_Expecter) GetAllInstallments(ctx interface{}, loanID interface{}) *MockCheckAccountingPeriodRepository_GetAllInstallments_Call {
+	return &MockCheckAccountingPeriodRepository_GetAllInstallments_Call{Call: _e.mock.On("GetAllInstallments", ctx, loanID)}
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetAllInstallments_Call) Run(run func(ctx context.Context, loanID uint64)) *MockCheckAccountingPeriodRepository_GetAllInstallments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetAllInstallments_Call) Return(_a0 []entity.Installment, _a1 error) *MockCheckAccountingPeriodRepository_GetAllInstallments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetAllInstallments_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.Installment, error)) *MockCheckAccountingPeriodRepository_GetAllInstallments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockCheckAccountingPeriodRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCheckAccountingPeriodRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockCheckAccountingPeriodRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCheckAccountingPeriodRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockCheckAccountingPeriodRepository_GetLatestClosedPeriod_Call {
+	return &MockCheckAccountingPeriodRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockCheckAccountingPeriodRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockCheckAccountingPeriodRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockCheckAccountingPeriodRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanAccrualStates provides a mock function with given fields: ctx
+func (_m *MockCheckAccountingPeriodRepository) GetLoanAccrualStates(ctx context.Context) ([]entity.LoanAccrualState, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanAccrualStates")
+	}
+
+	var r0 []entity.LoanAccrualState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.LoanAccrualState, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.LoanAccrualState); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanAccrualState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCheckAccountingPeriodRepository_GetLoanAccrualStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanAccrualStates'
+type MockCheckAccountingPeriodRepository_GetLoanAccrualStates_Call struct {
+	*mock.Call
+}
+
+// GetLoanAccrualStates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCheckAccountingPeriodRepository_Expecter) GetLoanAccrualStates(ctx interface{}) *MockCheckAccountingPeriodRepository_GetLoanAccrualStates_Call {
+	return &MockCheckAccountingPeriodRepository_GetLoanAccrualStates_Call{Call: _e.mock.On("GetLoanAccrualStates", ctx)}
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLoanAccrualStates_Call) Run(run func(ctx context.Context)) *MockCheckAccountingPeriodRepository_GetLoanAccrualStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLoanAccrualStates_Call) Return(_a0 []entity.LoanAccrualState, _a1 error) *MockCheckAccountingPeriodRepository_GetLoanAccrualStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLoanAccrualStates_Call) RunAndReturn(run func(context.Context) ([]entity.LoanAccrualState, error)) *MockCheckAccountingPeriodRepository_GetLoanAccrualStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanExposures provides a mock function with given fields: ctx, asOf
+func (_m *MockCheckAccountingPeriodRepository) GetLoanExposures(ctx context.Context, asOf time.Time) ([]entity.LoanExposure, error) {
+	ret := _m.Called(ctx, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanExposures")
+	}
+
+	var r0 []entity.LoanExposure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.LoanExposure, error)); ok {
+		return rf(ctx, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.LoanExposure); ok {
+		r0 = rf(ctx, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanExposure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCheckAccountingPeriodRepository_GetLoanExposures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanExposures'
+type MockCheckAccountingPeriodRepository_GetLoanExposures_Call struct {
+	*mock.Call
+}
+
+// GetLoanExposures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asOf time.Time
+func (_e *MockCheckAccountingPeriodRepository_Expecter) GetLoanExposures(ctx interface{}, asOf interface{}) *MockCheckAccountingPeriodRepository_GetLoanExposures_Call {
+	return &MockCheckAccountingPeriodRepository_GetLoanExposures_Call{Call: _e.mock.On("GetLoanExposures", ctx, asOf)}
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLoanExposures_Call) Run(run func(ctx context.Context, asOf time.Time)) *MockCheckAccountingPeriodRepository_GetLoanExposures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLoanExposures_Call) Return(_a0 []entity.LoanExposure, _a1 error) *MockCheckAccountingPeriodRepository_GetLoanExposures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLoanExposures_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.LoanExposure, error)) *MockCheckAccountingPeriodRepository_GetLoanExposures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanProvisions provides a mock function with given fields: ctx, period
+func (_m *MockCheckAccountingPeriodRepository) GetLoanProvisions(ctx context.Context, period string) ([]entity.LoanProvision, error) {
+	ret := _m.Called(ctx, period)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanProvisions")
+	}
+
+	var r0 []entity.LoanProvision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.LoanProvision, error)); ok {
+		return rf(ctx, period)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.LoanProvision); ok {
+		r0 = rf(ctx, period)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanProvision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCheckAccountingPeriodRepository_GetLoanProvisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanProvisions'
+type MockCheckAccountingPeriodRepository_GetLoanProvisions_Call struct {
+	*mock.Call
+}
+
+// GetLoanProvisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - period string
+func (_e *MockCheckAccountingPeriodRepository_Expecter) GetLoanProvisions(ctx interface{}, period interface{}) *MockCheckAccountingPeriodRepository_GetLoanProvisions_Call {
+	return &MockCheckAccountingPeriodRepository_GetLoanProvisions_Call{Call: _e.mock.On("GetLoanProvisions", ctx, period)}
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLoanProvisions_Call) Run(run func(ctx context.Context, period string)) *MockCheckAccountingPeriodRepository_GetLoanProvisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLoanProvisions_Call) Return(_a0 []entity.LoanProvision, _a1 error) *MockCheckAccountingPeriodRepository_GetLoanProvisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodRepository_GetLoanProvisions_Call) RunAndReturn(run func(context.Context, string) ([]entity.LoanProvision, error)) *MockCheckAccountingPeriodRepository_GetLoanProvisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCheckAccountingPeriodRepository creates a new instance of MockCheckAccountingPeriodRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCheckAccountingPeriodRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCheckAccountingPeriodRepository {
+	mock := &MockCheckAccountingPeriodRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
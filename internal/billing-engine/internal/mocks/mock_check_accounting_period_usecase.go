@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCheckAccountingPeriodUsecase is an autogenerated mock type for the CheckAccountingPeriodUsecase type
+type MockCheckAccountingPeriodUsecase struct {
+	mock.Mock
+}
+
+type MockCheckAccountingPeriodUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCheckAccountingPeriodUsecase) EXPECT() *MockCheckAccountingPeriodUsecase_Expecter {
+	return &MockCheckAccountingPeriodUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockCheckAccountingPeriodUsecase) Execute(ctx context.Context, input usecases.CheckAccountingPeriodInput) (usecases.CheckAccountingPeriodOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CheckAccountingPeriodOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CheckAccountingPeriodInput) (usecases.CheckAccountingPeriodOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CheckAccountingPeriodInput) usecases.CheckAccountingPeriodOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CheckAccountingPeriodOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.CheckAccountingPeriodInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCheckAccountingPeriodUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCheckAccountingPeriodUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.CheckAccountingPeriodInput
+func (_e *MockCheckAccountingPeriodUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockCheckAccountingPeriodUsecase_Execute_Call {
+	return &MockCheckAccountingPeriodUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockCheckAccountingPeriodUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.CheckAccountingPeriodInput)) *MockCheckAccountingPeriodUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.CheckAccountingPeriodInput))
+	})
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodUsecase_Execute_Call) Return(_a0 usecases.CheckAccountingPeriodOutput, _a1 error) *MockCheckAccountingPeriodUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCheckAccountingPeriodUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.CheckAccountingPeriodInput) (usecases.CheckAccountingPeriodOutput, error)) *MockCheckAccountingPeriodUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCheckAccountingPeriodUsecase creates a new instance of MockCheckAccountingPeriodUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCheckAccountingPeriodUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCheckAccountingPeriodUsecase {
+	mock := &MockCheckAccountingPeriodUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
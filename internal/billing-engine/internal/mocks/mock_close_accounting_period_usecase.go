@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCloseAccountingPeriodUsecase is an autogenerated mock type for the CloseAccountingPeriodUsecase type
+type MockCloseAccountingPeriodUsecase struct {
+	mock.Mock
+}
+
+type MockCloseAccountingPeriodUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCloseAccountingPeriodUsecase) EXPECT() *MockCloseAccountingPeriodUsecase_Expecter {
+	return &MockCloseAccountingPeriodUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockCloseAccountingPeriodUsecase) Execute(ctx context.Context, input usecases.CloseAccountingPeriodInput) (usecases.CloseAccountingPeriodOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CloseAccountingPeriodOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CloseAccountingPeriodInput) (usecases.CloseAccountingPeriodOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CloseAccountingPeriodInput) usecases.CloseAccountingPeriodOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CloseAccountingPeriodOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.CloseAccountingPeriodInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCloseAccountingPeriodUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCloseAccountingPeriodUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.CloseAccountingPeriodInput
+func (_e *MockCloseAccountingPeriodUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockCloseAccountingPeriodUsecase_Execute_Call {
+	return &MockCloseAccountingPeriodUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockCloseAccountingPeriodUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.CloseAccountingPeriodInput)) *MockCloseAccountingPeriodUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.CloseAccountingPeriodInput))
+	})
+	return _c
+}
+
+func (_c *MockCloseAccountingPeriodUsecase_Execute_Call) Return(_a0 usecases.CloseAccountingPeriodOutput, _a1 error) *MockCloseAccountingPeriodUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCloseAccountingPeriodUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.CloseAccountingPeriodInput) (usecases.CloseAccountingPeriodOutput, error)) *MockCloseAccountingPeriodUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCloseAccountingPeriodUsecase creates a new instance of MockCloseAccountingPeriodUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCloseAccountingPeriodUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCloseAccountingPeriodUsecase {
+	mock := &MockCloseAccountingPeriodUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
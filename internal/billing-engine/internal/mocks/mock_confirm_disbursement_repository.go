@@ -0,0 +1,678 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockConfirmDisbursementRepository is an autogenerated mock type for the ConfirmDisbursementRepository type
+type MockConfirmDisbursementRepository struct {
+	mock.Mock
+}
+
+type MockConfirmDisbursementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConfirmDisbursementRepository) EXPECT() *MockConfirmDisbursementRepository_Expecter {
+	return &MockConfirmDisbursementRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateInstallmentFromLoan provides a mock function with given fields: ctx, loan, fees
+func (_m *MockConfirmDisbursementRepository) CreateInstallmentFromLoan(ctx context.Context, loan *entity.Loan, fees []entity.LoanFee) (bool, error) {
+	ret := _m.Called(ctx, loan, fees)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstallmentFromLoan")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Loan, []entity.LoanFee) (bool, error)); ok {
+		return rf(ctx, loan, fees)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Loan, []entity.LoanFee) bool); ok {
+		r0 = rf(ctx, loan, fees)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Loan, []entity.LoanFee) error); ok {
+		r1 = rf(ctx, loan, fees)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfirmDisbursementRepository_CreateInstallmentFromLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstallmentFromLoan'
+type MockConfirmDisbursementRepository_CreateInstallmentFromLoan_Call struct {
+	*mock.Call
+}
+
+// CreateInstallmentFromLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loan *entity.Loan
+//   - fees []entity.LoanFee
+func (_e *MockConfirmDisbursementRepository_Expecter) CreateInstallmentFromLoan(ctx interface{}, loan interface{}, fees interface{}) *MockConfirmDisbursementRepository_CreateInstallmentFromLoan_Call {
+	return &MockConfirmDisbursementRepository_CreateInstallmentFromLoan_Call{Call: _e.mock.On("CreateInstallmentFromLoan", ctx, loan, fees)}
+}
+
+func (_c *MockConfirmDisbursementRepository_CreateInstallmentFromLoan_Call) Run(run func(ctx context.Context, loan *entity.Loan, fees []entity.LoanFee)) *MockConfirmDisbursementRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Loan), args[2].([]entity.LoanFee))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_CreateInstallmentFromLoan_Call) Return(_a0 bool, _a1 error) *MockConfirmDisbursementRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_CreateInstallmentFromLoan_Call) RunAndReturn(run func(context.Context, *entity.Loan, []entity.LoanFee) (bool, error)) *MockConfirmDisbursementRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockConfirmDisbursementRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfirmDisbursementRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockConfirmDisbursementRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockConfirmDisbursementRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockConfirmDisbursementRepository_CreateJournalEntry_Call {
+	return &MockConfirmDisbursementRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockConfirmDisbursementRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockConfirmDisbursementRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockConfirmDisbursementRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockConfirmDisbursementRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMerchantSettlementItem provides a mock function with given fields: ctx, item
+func (_m *MockConfirmDisbursementRepository) CreateMerchantSettlementItem(ctx context.Context, item entity.MerchantSettlementItem) error {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMerchantSettlementItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchantSettlementItem) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConfirmDisbursementRepository_CreateMerchantSettlementItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMerchantSettlementItem'
+type MockConfirmDisbursementRepository_CreateMerchantSettlementItem_Call struct {
+	*mock.Call
+}
+
+// CreateMerchantSettlementItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item entity.MerchantSettlementItem
+func (_e *MockConfirmDisbursementRepository_Expecter) CreateMerchantSettlementItem(ctx interface{}, item interface{}) *MockConfirmDisbursementRepository_CreateMerchantSettlementItem_Call {
+	return &MockConfirmDisbursementRepository_CreateMerchantSettlementItem_Call{Call: _e.mock.On("CreateMerchantSettlementItem", ctx, item)}
+}
+
+func (_c *MockConfirmDisbursementRepository_CreateMerchantSettlementItem_Call) Run(run func(ctx context.Context, item entity.MerchantSettlementItem)) *MockConfirmDisbursementRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchantSettlementItem))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_CreateMerchantSettlementItem_Call) Return(_a0 error) *MockConfirmDisbursementRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_CreateMerchantSettlementItem_Call) RunAndReturn(run func(context.Context, entity.MerchantSettlementItem) error) *MockConfirmDisbursementRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDisbursement provides a mock function with given fields: ctx, disbursementID
+func (_m *MockConfirmDisbursementRepository) GetDisbursement(ctx context.Context, disbursementID uint64) (entity.Disbursement, error) {
+	ret := _m.Called(ctx, disbursementID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDisbursement")
+	}
+
+	var r0 entity.Disbursement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Disbursement, error)); ok {
+		return rf(ctx, disbursementID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Disbursement); ok {
+		r0 = rf(ctx, disbursementID)
+	} else {
+		r0 = ret.Get(0).(entity.Disbursement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, disbursementID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfirmDisbursementRepository_GetDisbursement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDisbursement'
+type MockConfirmDisbursementRepository_GetDisbursement_Call struct {
+	*mock.Call
+}
+
+// GetDisbursement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disbursementID uint64
+func (_e *MockConfirmDisbursementRepository_Expecter) GetDisbursement(ctx interface{}, disbursementID interface{}) *MockConfirmDisbursementRepository_GetDisbursement_Call {
+	return &MockConfirmDisbursementRepository_GetDisbursement_Call{Call: _e.mock.On("GetDisbursement", ctx, disbursementID)}
+}
+
+func (_c *MockConfirmDisbursementRepository_GetDisbursement_Call) Run(run func(ctx context.Context, disbursementID uint64)) *MockConfirmDisbursementRepository_GetDisbursement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_GetDisbursement_Call) Return(_a0 entity.Disbursement, _a1 error) *MockConfirmDisbursementRepository_GetDisbursement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_GetDisbursement_Call) RunAndReturn(run func(context.Context, uint64) (entity.Disbursement, error)) *MockConfirmDisbursementRepository_GetDisbursement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockConfirmDisbursementRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfirmDisbursementRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockConfirmDisbursementRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockConfirmDisbursementRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockConfirmDisbursementRepository_GetLatestClosedPeriod_Call {
+	return &MockConfirmDisbursementRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockConfirmDisbursementRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockConfirmDisbursementRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockConfirmDisbursementRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockConfirmDisbursementRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockConfirmDisbursementRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfirmDisbursementRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockConfirmDisbursementRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockConfirmDisbursementRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockConfirmDisbursementRepository_GetLoan_Call {
+	return &MockConfirmDisbursementRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockConfirmDisbursementRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockConfirmDisbursementRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockConfirmDisbursementRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockConfirmDisbursementRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanFees provides a mock function with given fields: ctx, loanID
+func (_m *MockConfirmDisbursementRepository) GetLoanFees(ctx context.Context, loanID uint64) ([]entity.LoanFee, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanFees")
+	}
+
+	var r0 []entity.LoanFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanFee, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanFee); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfirmDisbursementRepository_GetLoanFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanFees'
+type MockConfirmDisbursementRepository_GetLoanFees_Call struct {
+	*mock.Call
+}
+
+// GetLoanFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockConfirmDisbursementRepository_Expecter) GetLoanFees(ctx interface{}, loanID interface{}) *MockConfirmDisbursementRepository_GetLoanFees_Call {
+	return &MockConfirmDisbursementRepository_GetLoanFees_Call{Call: _e.mock.On("GetLoanFees", ctx, loanID)}
+}
+
+func (_c *MockConfirmDisbursementRepository_GetLoanFees_Call) Run(run func(ctx context.Context, loanID uint64)) *MockConfirmDisbursementRepository_GetLoanFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_GetLoanFees_Call) Return(_a0 []entity.LoanFee, _a1 error) *MockConfirmDisbursementRepository_GetLoanFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_GetLoanFees_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanFee, error)) *MockConfirmDisbursementRepository_GetLoanFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchant provides a mock function with given fields: ctx, merchantID
+func (_m *MockConfirmDisbursementRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Merchant, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Merchant); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfirmDisbursementRepository_GetMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchant'
+type MockConfirmDisbursementRepository_GetMerchant_Call struct {
+	*mock.Call
+}
+
+// GetMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockConfirmDisbursementRepository_Expecter) GetMerchant(ctx interface{}, merchantID interface{}) *MockConfirmDisbursementRepository_GetMerchant_Call {
+	return &MockConfirmDisbursementRepository_GetMerchant_Call{Call: _e.mock.On("GetMerchant", ctx, merchantID)}
+}
+
+func (_c *MockConfirmDisbursementRepository_GetMerchant_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockConfirmDisbursementRepository_GetMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_GetMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockConfirmDisbursementRepository_GetMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_GetMerchant_Call) RunAndReturn(run func(context.Context, uint64) (entity.Merchant, error)) *MockConfirmDisbursementRepository_GetMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLoanStartDate provides a mock function with given fields: ctx, loanID, startDate
+func (_m *MockConfirmDisbursementRepository) SetLoanStartDate(ctx context.Context, loanID uint64, startDate time.Time) error {
+	ret := _m.Called(ctx, loanID, startDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLoanStartDate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) error); ok {
+		r0 = rf(ctx, loanID, startDate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConfirmDisbursementRepository_SetLoanStartDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLoanStartDate'
+type MockConfirmDisbursementRepository_SetLoanStartDate_Call struct {
+	*mock.Call
+}
+
+// SetLoanStartDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - startDate time.Time
+func (_e *MockConfirmDisbursementRepository_Expecter) SetLoanStartDate(ctx interface{}, loanID interface{}, startDate interface{}) *MockConfirmDisbursementRepository_SetLoanStartDate_Call {
+	return &MockConfirmDisbursementRepository_SetLoanStartDate_Call{Call: _e.mock.On("SetLoanStartDate", ctx, loanID, startDate)}
+}
+
+func (_c *MockConfirmDisbursementRepository_SetLoanStartDate_Call) Run(run func(ctx context.Context, loanID uint64, startDate time.Time)) *MockConfirmDisbursementRepository_SetLoanStartDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_SetLoanStartDate_Call) Return(_a0 error) *MockConfirmDisbursementRepository_SetLoanStartDate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_SetLoanStartDate_Call) RunAndReturn(run func(context.Context, uint64, time.Time) error) *MockConfirmDisbursementRepository_SetLoanStartDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionLoanStatus provides a mock function with given fields: ctx, transition
+func (_m *MockConfirmDisbursementRepository) TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error {
+	ret := _m.Called(ctx, transition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionLoanStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanStatusTransition) error); ok {
+		r0 = rf(ctx, transition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConfirmDisbursementRepository_TransitionLoanStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionLoanStatus'
+type MockConfirmDisbursementRepository_TransitionLoanStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionLoanStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transition entity.LoanStatusTransition
+func (_e *MockConfirmDisbursementRepository_Expecter) TransitionLoanStatus(ctx interface{}, transition interface{}) *MockConfirmDisbursementRepository_TransitionLoanStatus_Call {
+	return &MockConfirmDisbursementRepository_TransitionLoanStatus_Call{Call: _e.mock.On("TransitionLoanStatus", ctx, transition)}
+}
+
+func (_c *MockConfirmDisbursementRepository_TransitionLoanStatus_Call) Run(run func(ctx context.Context, transition entity.LoanStatusTransition)) *MockConfirmDisbursementRepository_TransitionLoanStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanStatusTransition))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_TransitionLoanStatus_Call) Return(_a0 error) *MockConfirmDisbursementRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_TransitionLoanStatus_Call) RunAndReturn(run func(context.Context, entity.LoanStatusTransition) error) *MockConfirmDisbursementRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDisbursement provides a mock function with given fields: ctx, disbursement, from
+func (_m *MockConfirmDisbursementRepository) UpdateDisbursement(ctx context.Context, disbursement entity.Disbursement, from entity.DisbursementStatus) error {
+	ret := _m.Called(ctx, disbursement, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDisbursement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Disbursement, entity.DisbursementStatus) error); ok {
+		r0 = rf(ctx, disbursement, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConfirmDisbursementRepository_UpdateDisbursement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDisbursement'
+type MockConfirmDisbursementRepository_UpdateDisbursement_Call struct {
+	*mock.Call
+}
+
+// UpdateDisbursement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disbursement entity.Disbursement
+//   - from entity.DisbursementStatus
+func (_e *MockConfirmDisbursementRepository_Expecter) UpdateDisbursement(ctx interface{}, disbursement interface{}, from interface{}) *MockConfirmDisbursementRepository_UpdateDisbursement_Call {
+	return &MockConfirmDisbursementRepository_UpdateDisbursement_Call{Call: _e.mock.On("UpdateDisbursement", ctx, disbursement, from)}
+}
+
+func (_c *MockConfirmDisbursementRepository_UpdateDisbursement_Call) Run(run func(ctx context.Context, disbursement entity.Disbursement, from entity.DisbursementStatus)) *MockConfirmDisbursementRepository_UpdateDisbursement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Disbursement), args[2].(entity.DisbursementStatus))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_UpdateDisbursement_Call) Return(_a0 error) *MockConfirmDisbursementRepository_UpdateDisbursement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_UpdateDisbursement_Call) RunAndReturn(run func(context.Context, entity.Disbursement, entity.DisbursementStatus) error) *MockConfirmDisbursementRepository_UpdateDisbursement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockConfirmDisbursementRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConfirmDisbursementRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockConfirmDisbursementRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockConfirmDisbursementRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockConfirmDisbursementRepository_WithinTransaction_Call {
+	return &MockConfirmDisbursementRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockConfirmDisbursementRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockConfirmDisbursementRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_WithinTransaction_Call) Return(_a0 error) *MockConfirmDisbursementRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockConfirmDisbursementRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConfirmDisbursementRepository creates a new instance of MockConfirmDisbursementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConfirmDisbursementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConfirmDisbursementRepository {
+	mock := &MockConfirmDisbursementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
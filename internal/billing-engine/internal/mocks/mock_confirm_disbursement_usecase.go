@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockConfirmDisbursementUsecase is an autogenerated mock type for the ConfirmDisbursementUsecase type
+type MockConfirmDisbursementUsecase struct {
+	mock.Mock
+}
+
+type MockConfirmDisbursementUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConfirmDisbursementUsecase) EXPECT() *MockConfirmDisbursementUsecase_Expecter {
+	return &MockConfirmDisbursementUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockConfirmDisbursementUsecase) Execute(ctx context.Context, input usecases.ConfirmDisbursementInput) (usecases.DisbursementOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.DisbursementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ConfirmDisbursementInput) (usecases.DisbursementOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ConfirmDisbursementInput) usecases.DisbursementOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.DisbursementOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.ConfirmDisbursementInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfirmDisbursementUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockConfirmDisbursementUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.ConfirmDisbursementInput
+func (_e *MockConfirmDisbursementUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockConfirmDisbursementUsecase_Execute_Call {
+	return &MockConfirmDisbursementUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockConfirmDisbursementUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.ConfirmDisbursementInput)) *MockConfirmDisbursementUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.ConfirmDisbursementInput))
+	})
+	return _c
+}
+
+func (_c *MockConfirmDisbursementUsecase_Execute_Call) Return(_a0 usecases.DisbursementOutput, _a1 error) *MockConfirmDisbursementUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfirmDisbursementUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.ConfirmDisbursementInput) (usecases.DisbursementOutput, error)) *MockConfirmDisbursementUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConfirmDisbursementUsecase creates a new instance of MockConfirmDisbursementUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConfirmDisbursementUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConfirmDisbursementUsecase {
+	mock := &MockConfirmDisbursementUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateCollectionAgentRepository is an autogenerated mock type for the CreateCollectionAgentRepository type
+type MockCreateCollectionAgentRepository struct {
+	mock.Mock
+}
+
+type MockCreateCollectionAgentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateCollectionAgentRepository) EXPECT() *MockCreateCollectionAgentRepository_Expecter {
+	return &MockCreateCollectionAgentRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateCollectionAgent provides a mock function with given fields: ctx, agent
+func (_m *MockCreateCollectionAgentRepository) CreateCollectionAgent(ctx context.Context, agent entity.CollectionAgent) (entity.CollectionAgent, error) {
+	ret := _m.Called(ctx, agent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollectionAgent")
+	}
+
+	var r0 entity.CollectionAgent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CollectionAgent) (entity.CollectionAgent, error)); ok {
+		return rf(ctx, agent)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CollectionAgent) entity.CollectionAgent); ok {
+		r0 = rf(ctx, agent)
+	} else {
+		r0 = ret.Get(0).(entity.CollectionAgent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CollectionAgent) error); ok {
+		r1 = rf(ctx, agent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateCollectionAgentRepository_CreateCollectionAgent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionAgent'
+type MockCreateCollectionAgentRepository_CreateCollectionAgent_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionAgent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - agent entity.CollectionAgent
+func (_e *MockCreateCollectionAgentRepository_Expecter) CreateCollectionAgent(ctx interface{}, agent interface{}) *MockCreateCollectionAgentRepository_CreateCollectionAgent_Call {
+	return &MockCreateCollectionAgentRepository_CreateCollectionAgent_Call{Call: _e.mock.On("CreateCollectionAgent", ctx, agent)}
+}
+
+func (_c *MockCreateCollectionAgentRepository_CreateCollectionAgent_Call) Run(run func(ctx context.Context, agent entity.CollectionAgent)) *MockCreateCollectionAgentRepository_CreateCollectionAgent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CollectionAgent))
+	})
+	return _c
+}
+
+func (_c *MockCreateCollectionAgentRepository_CreateCollectionAgent_Call) Return(_a0 entity.CollectionAgent, _a1 error) *MockCreateCollectionAgentRepository_CreateCollectionAgent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateCollectionAgentRepository_CreateCollectionAgent_Call) RunAndReturn(run func(context.Context, entity.CollectionAgent) (entity.CollectionAgent, error)) *MockCreateCollectionAgentRepository_CreateCollectionAgent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateCollectionAgentRepository creates a new instance of MockCreateCollectionAgentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateCollectionAgentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateCollectionAgentRepository {
+	mock := &MockCreateCollectionAgentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
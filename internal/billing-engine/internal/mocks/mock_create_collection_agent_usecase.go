@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateCollectionAgentUsecase is an autogenerated mock type for the CreateCollectionAgentUsecase type
+type MockCreateCollectionAgentUsecase struct {
+	mock.Mock
+}
+
+type MockCreateCollectionAgentUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateCollectionAgentUsecase) EXPECT() *MockCreateCollectionAgentUsecase_Expecter {
+	return &MockCreateCollectionAgentUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockCreateCollectionAgentUsecase) Execute(ctx context.Context, input usecases.CreateCollectionAgentInput) (usecases.CollectionAgentOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CollectionAgentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CreateCollectionAgentInput) (usecases.CollectionAgentOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CreateCollectionAgentInput) usecases.CollectionAgentOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CollectionAgentOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.CreateCollectionAgentInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateCollectionAgentUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCreateCollectionAgentUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.CreateCollectionAgentInput
+func (_e *MockCreateCollectionAgentUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockCreateCollectionAgentUsecase_Execute_Call {
+	return &MockCreateCollectionAgentUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockCreateCollectionAgentUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.CreateCollectionAgentInput)) *MockCreateCollectionAgentUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.CreateCollectionAgentInput))
+	})
+	return _c
+}
+
+func (_c *MockCreateCollectionAgentUsecase_Execute_Call) Return(_a0 usecases.CollectionAgentOutput, _a1 error) *MockCreateCollectionAgentUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateCollectionAgentUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.CreateCollectionAgentInput) (usecases.CollectionAgentOutput, error)) *MockCreateCollectionAgentUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateCollectionAgentUsecase creates a new instance of MockCreateCollectionAgentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateCollectionAgentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateCollectionAgentUsecase {
+	mock := &MockCreateCollectionAgentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -80,6 +80,112 @@ func (_c *MockCreateCustomerRepository_CreateCustomer_Call) RunAndReturn(run fun
 	return _c
 }
 
+// CreateCustomerDuplicates provides a mock function with given fields: ctx, duplicates
+func (_m *MockCreateCustomerRepository) CreateCustomerDuplicates(ctx context.Context, duplicates []entity.CustomerDuplicate) error {
+	ret := _m.Called(ctx, duplicates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCustomerDuplicates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.CustomerDuplicate) error); ok {
+		r0 = rf(ctx, duplicates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCreateCustomerRepository_CreateCustomerDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCustomerDuplicates'
+type MockCreateCustomerRepository_CreateCustomerDuplicates_Call struct {
+	*mock.Call
+}
+
+// CreateCustomerDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - duplicates []entity.CustomerDuplicate
+func (_e *MockCreateCustomerRepository_Expecter) CreateCustomerDuplicates(ctx interface{}, duplicates interface{}) *MockCreateCustomerRepository_CreateCustomerDuplicates_Call {
+	return &MockCreateCustomerRepository_CreateCustomerDuplicates_Call{Call: _e.mock.On("CreateCustomerDuplicates", ctx, duplicates)}
+}
+
+func (_c *MockCreateCustomerRepository_CreateCustomerDuplicates_Call) Run(run func(ctx context.Context, duplicates []entity.CustomerDuplicate)) *MockCreateCustomerRepository_CreateCustomerDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]entity.CustomerDuplicate))
+	})
+	return _c
+}
+
+func (_c *MockCreateCustomerRepository_CreateCustomerDuplicates_Call) Return(_a0 error) *MockCreateCustomerRepository_CreateCustomerDuplicates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCreateCustomerRepository_CreateCustomerDuplicates_Call) RunAndReturn(run func(context.Context, []entity.CustomerDuplicate) error) *MockCreateCustomerRepository_CreateCustomerDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDuplicateCandidates provides a mock function with given fields: ctx, customer
+func (_m *MockCreateCustomerRepository) FindDuplicateCandidates(ctx context.Context, customer entity.Customer) ([]entity.Customer, error) {
+	ret := _m.Called(ctx, customer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDuplicateCandidates")
+	}
+
+	var r0 []entity.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Customer) ([]entity.Customer, error)); ok {
+		return rf(ctx, customer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Customer) []entity.Customer); ok {
+		r0 = rf(ctx, customer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Customer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Customer) error); ok {
+		r1 = rf(ctx, customer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateCustomerRepository_FindDuplicateCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDuplicateCandidates'
+type MockCreateCustomerRepository_FindDuplicateCandidates_Call struct {
+	*mock.Call
+}
+
+// FindDuplicateCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customer entity.Customer
+func (_e *MockCreateCustomerRepository_Expecter) FindDuplicateCandidates(ctx interface{}, customer interface{}) *MockCreateCustomerRepository_FindDuplicateCandidates_Call {
+	return &MockCreateCustomerRepository_FindDuplicateCandidates_Call{Call: _e.mock.On("FindDuplicateCandidates", ctx, customer)}
+}
+
+func (_c *MockCreateCustomerRepository_FindDuplicateCandidates_Call) Run(run func(ctx context.Context, customer entity.Customer)) *MockCreateCustomerRepository_FindDuplicateCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Customer))
+	})
+	return _c
+}
+
+func (_c *MockCreateCustomerRepository_FindDuplicateCandidates_Call) Return(_a0 []entity.Customer, _a1 error) *MockCreateCustomerRepository_FindDuplicateCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateCustomerRepository_FindDuplicateCandidates_Call) RunAndReturn(run func(context.Context, entity.Customer) ([]entity.Customer, error)) *MockCreateCustomerRepository_FindDuplicateCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCreateCustomerRepository creates a new instance of MockCreateCustomerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCreateCustomerRepository(t interface {
@@ -23,26 +23,26 @@ func (_m *MockCreateLoanRepository) EXPECT() *MockCreateLoanRepository_Expecter
 	return &MockCreateLoanRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateInstallmentFromLoan provides a mock function with given fields: ctx, loan
-func (_m *MockCreateLoanRepository) CreateInstallmentFromLoan(ctx context.Context, loan *entity.Loan) (bool, error) {
+// CreateLoanApplication provides a mock function with given fields: ctx, loan
+func (_m *MockCreateLoanRepository) CreateLoanApplication(ctx context.Context, loan entity.Loan) (entity.Loan, error) {
 	ret := _m.Called(ctx, loan)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateInstallmentFromLoan")
+		panic("no return value specified for CreateLoanApplication")
 	}
 
-	var r0 bool
+	var r0 entity.Loan
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.Loan) (bool, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Loan) (entity.Loan, error)); ok {
 		return rf(ctx, loan)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.Loan) bool); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Loan) entity.Loan); ok {
 		r0 = rf(ctx, loan)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(entity.Loan)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *entity.Loan) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Loan) error); ok {
 		r1 = rf(ctx, loan)
 	} else {
 		r1 = ret.Error(1)
@@ -51,56 +51,103 @@ func (_m *MockCreateLoanRepository) CreateInstallmentFromLoan(ctx context.Contex
 	return r0, r1
 }
 
-// MockCreateLoanRepository_CreateInstallmentFromLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstallmentFromLoan'
-type MockCreateLoanRepository_CreateInstallmentFromLoan_Call struct {
+// MockCreateLoanRepository_CreateLoanApplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoanApplication'
+type MockCreateLoanRepository_CreateLoanApplication_Call struct {
 	*mock.Call
 }
 
-// CreateInstallmentFromLoan is a helper method to define mock.On call
+// CreateLoanApplication is a helper method to define mock.On call
 //   - ctx context.Context
-//   - loan *entity.Loan
-func (_e *MockCreateLoanRepository_Expecter) CreateInstallmentFromLoan(ctx interface{}, loan interface{}) *MockCreateLoanRepository_CreateInstallmentFromLoan_Call {
-	return &MockCreateLoanRepository_CreateInstallmentFromLoan_Call{Call: _e.mock.On("CreateInstallmentFromLoan", ctx, loan)}
+//   - loan entity.Loan
+func (_e *MockCreateLoanRepository_Expecter) CreateLoanApplication(ctx interface{}, loan interface{}) *MockCreateLoanRepository_CreateLoanApplication_Call {
+	return &MockCreateLoanRepository_CreateLoanApplication_Call{Call: _e.mock.On("CreateLoanApplication", ctx, loan)}
 }
 
-func (_c *MockCreateLoanRepository_CreateInstallmentFromLoan_Call) Run(run func(ctx context.Context, loan *entity.Loan)) *MockCreateLoanRepository_CreateInstallmentFromLoan_Call {
+func (_c *MockCreateLoanRepository_CreateLoanApplication_Call) Run(run func(ctx context.Context, loan entity.Loan)) *MockCreateLoanRepository_CreateLoanApplication_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*entity.Loan))
+		run(args[0].(context.Context), args[1].(entity.Loan))
 	})
 	return _c
 }
 
-func (_c *MockCreateLoanRepository_CreateInstallmentFromLoan_Call) Return(_a0 bool, _a1 error) *MockCreateLoanRepository_CreateInstallmentFromLoan_Call {
+func (_c *MockCreateLoanRepository_CreateLoanApplication_Call) Return(_a0 entity.Loan, _a1 error) *MockCreateLoanRepository_CreateLoanApplication_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCreateLoanRepository_CreateInstallmentFromLoan_Call) RunAndReturn(run func(context.Context, *entity.Loan) (bool, error)) *MockCreateLoanRepository_CreateInstallmentFromLoan_Call {
+func (_c *MockCreateLoanRepository_CreateLoanApplication_Call) RunAndReturn(run func(context.Context, entity.Loan) (entity.Loan, error)) *MockCreateLoanRepository_CreateLoanApplication_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateLoan provides a mock function with given fields: ctx, loan
-func (_m *MockCreateLoanRepository) CreateLoan(ctx context.Context, loan entity.Loan) (entity.Loan, error) {
-	ret := _m.Called(ctx, loan)
+// CreateLoanDisclosure provides a mock function with given fields: ctx, disclosure
+func (_m *MockCreateLoanRepository) CreateLoanDisclosure(ctx context.Context, disclosure entity.LoanDisclosure) error {
+	ret := _m.Called(ctx, disclosure)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateLoan")
+		panic("no return value specified for CreateLoanDisclosure")
 	}
 
-	var r0 entity.Loan
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanDisclosure) error); ok {
+		r0 = rf(ctx, disclosure)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCreateLoanRepository_CreateLoanDisclosure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoanDisclosure'
+type MockCreateLoanRepository_CreateLoanDisclosure_Call struct {
+	*mock.Call
+}
+
+// CreateLoanDisclosure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disclosure entity.LoanDisclosure
+func (_e *MockCreateLoanRepository_Expecter) CreateLoanDisclosure(ctx interface{}, disclosure interface{}) *MockCreateLoanRepository_CreateLoanDisclosure_Call {
+	return &MockCreateLoanRepository_CreateLoanDisclosure_Call{Call: _e.mock.On("CreateLoanDisclosure", ctx, disclosure)}
+}
+
+func (_c *MockCreateLoanRepository_CreateLoanDisclosure_Call) Run(run func(ctx context.Context, disclosure entity.LoanDisclosure)) *MockCreateLoanRepository_CreateLoanDisclosure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanDisclosure))
+	})
+	return _c
+}
+
+func (_c *MockCreateLoanRepository_CreateLoanDisclosure_Call) Return(_a0 error) *MockCreateLoanRepository_CreateLoanDisclosure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCreateLoanRepository_CreateLoanDisclosure_Call) RunAndReturn(run func(context.Context, entity.LoanDisclosure) error) *MockCreateLoanRepository_CreateLoanDisclosure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLimit provides a mock function with given fields: ctx, customerID
+func (_m *MockCreateLoanRepository) GetCreditLimit(ctx context.Context, customerID uint64) (entity.CreditLimit, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLimit")
+	}
+
+	var r0 entity.CreditLimit
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.Loan) (entity.Loan, error)); ok {
-		return rf(ctx, loan)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLimit, error)); ok {
+		return rf(ctx, customerID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, entity.Loan) entity.Loan); ok {
-		r0 = rf(ctx, loan)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLimit); ok {
+		r0 = rf(ctx, customerID)
 	} else {
-		r0 = ret.Get(0).(entity.Loan)
+		r0 = ret.Get(0).(entity.CreditLimit)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, entity.Loan) error); ok {
-		r1 = rf(ctx, loan)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -108,31 +155,204 @@ func (_m *MockCreateLoanRepository) CreateLoan(ctx context.Context, loan entity.
 	return r0, r1
 }
 
-// MockCreateLoanRepository_CreateLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoan'
-type MockCreateLoanRepository_CreateLoan_Call struct {
+// MockCreateLoanRepository_GetCreditLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLimit'
+type MockCreateLoanRepository_GetCreditLimit_Call struct {
 	*mock.Call
 }
 
-// CreateLoan is a helper method to define mock.On call
+// GetCreditLimit is a helper method to define mock.On call
 //   - ctx context.Context
-//   - loan entity.Loan
-func (_e *MockCreateLoanRepository_Expecter) CreateLoan(ctx interface{}, loan interface{}) *MockCreateLoanRepository_CreateLoan_Call {
-	return &MockCreateLoanRepository_CreateLoan_Call{Call: _e.mock.On("CreateLoan", ctx, loan)}
+//   - customerID uint64
+func (_e *MockCreateLoanRepository_Expecter) GetCreditLimit(ctx interface{}, customerID interface{}) *MockCreateLoanRepository_GetCreditLimit_Call {
+	return &MockCreateLoanRepository_GetCreditLimit_Call{Call: _e.mock.On("GetCreditLimit", ctx, customerID)}
 }
 
-func (_c *MockCreateLoanRepository_CreateLoan_Call) Run(run func(ctx context.Context, loan entity.Loan)) *MockCreateLoanRepository_CreateLoan_Call {
+func (_c *MockCreateLoanRepository_GetCreditLimit_Call) Run(run func(ctx context.Context, customerID uint64)) *MockCreateLoanRepository_GetCreditLimit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entity.Loan))
+		run(args[0].(context.Context), args[1].(uint64))
 	})
 	return _c
 }
 
-func (_c *MockCreateLoanRepository_CreateLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockCreateLoanRepository_CreateLoan_Call {
+func (_c *MockCreateLoanRepository_GetCreditLimit_Call) Return(_a0 entity.CreditLimit, _a1 error) *MockCreateLoanRepository_GetCreditLimit_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCreateLoanRepository_CreateLoan_Call) RunAndReturn(run func(context.Context, entity.Loan) (entity.Loan, error)) *MockCreateLoanRepository_CreateLoan_Call {
+func (_c *MockCreateLoanRepository_GetCreditLimit_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLimit, error)) *MockCreateLoanRepository_GetCreditLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomerExposure provides a mock function with given fields: ctx, customerID
+func (_m *MockCreateLoanRepository) GetCustomerExposure(ctx context.Context, customerID uint64) (entity.CustomerExposure, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomerExposure")
+	}
+
+	var r0 entity.CustomerExposure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CustomerExposure, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CustomerExposure); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CustomerExposure)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateLoanRepository_GetCustomerExposure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomerExposure'
+type MockCreateLoanRepository_GetCustomerExposure_Call struct {
+	*mock.Call
+}
+
+// GetCustomerExposure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockCreateLoanRepository_Expecter) GetCustomerExposure(ctx interface{}, customerID interface{}) *MockCreateLoanRepository_GetCustomerExposure_Call {
+	return &MockCreateLoanRepository_GetCustomerExposure_Call{Call: _e.mock.On("GetCustomerExposure", ctx, customerID)}
+}
+
+func (_c *MockCreateLoanRepository_GetCustomerExposure_Call) Run(run func(ctx context.Context, customerID uint64)) *MockCreateLoanRepository_GetCustomerExposure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockCreateLoanRepository_GetCustomerExposure_Call) Return(_a0 entity.CustomerExposure, _a1 error) *MockCreateLoanRepository_GetCustomerExposure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateLoanRepository_GetCustomerExposure_Call) RunAndReturn(run func(context.Context, uint64) (entity.CustomerExposure, error)) *MockCreateLoanRepository_GetCustomerExposure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchant provides a mock function with given fields: ctx, merchantID
+func (_m *MockCreateLoanRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Merchant, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Merchant); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateLoanRepository_GetMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchant'
+type MockCreateLoanRepository_GetMerchant_Call struct {
+	*mock.Call
+}
+
+// GetMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockCreateLoanRepository_Expecter) GetMerchant(ctx interface{}, merchantID interface{}) *MockCreateLoanRepository_GetMerchant_Call {
+	return &MockCreateLoanRepository_GetMerchant_Call{Call: _e.mock.On("GetMerchant", ctx, merchantID)}
+}
+
+func (_c *MockCreateLoanRepository_GetMerchant_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockCreateLoanRepository_GetMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockCreateLoanRepository_GetMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockCreateLoanRepository_GetMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateLoanRepository_GetMerchant_Call) RunAndReturn(run func(context.Context, uint64) (entity.Merchant, error)) *MockCreateLoanRepository_GetMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductFees provides a mock function with given fields: ctx, productCode
+func (_m *MockCreateLoanRepository) GetProductFees(ctx context.Context, productCode string) ([]entity.ProductFee, error) {
+	ret := _m.Called(ctx, productCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductFees")
+	}
+
+	var r0 []entity.ProductFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.ProductFee, error)); ok {
+		return rf(ctx, productCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.ProductFee); ok {
+		r0 = rf(ctx, productCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProductFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateLoanRepository_GetProductFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductFees'
+type MockCreateLoanRepository_GetProductFees_Call struct {
+	*mock.Call
+}
+
+// GetProductFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productCode string
+func (_e *MockCreateLoanRepository_Expecter) GetProductFees(ctx interface{}, productCode interface{}) *MockCreateLoanRepository_GetProductFees_Call {
+	return &MockCreateLoanRepository_GetProductFees_Call{Call: _e.mock.On("GetProductFees", ctx, productCode)}
+}
+
+func (_c *MockCreateLoanRepository_GetProductFees_Call) Run(run func(ctx context.Context, productCode string)) *MockCreateLoanRepository_GetProductFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCreateLoanRepository_GetProductFees_Call) Return(_a0 []entity.ProductFee, _a1 error) *MockCreateLoanRepository_GetProductFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateLoanRepository_GetProductFees_Call) RunAndReturn(run func(context.Context, string) ([]entity.ProductFee, error)) *MockCreateLoanRepository_GetProductFees_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -194,12 +414,12 @@ func (_c *MockCreateLoanRepository_IsCustomerExist_Call) RunAndReturn(run func(c
 	return _c
 }
 
-// IsCustomerHasNonPaidLoan provides a mock function with given fields: ctx, customerID
-func (_m *MockCreateLoanRepository) IsCustomerHasNonPaidLoan(ctx context.Context, customerID uint64) (bool, error) {
+// IsCustomerHasWrittenOffLoan provides a mock function with given fields: ctx, customerID
+func (_m *MockCreateLoanRepository) IsCustomerHasWrittenOffLoan(ctx context.Context, customerID uint64) (bool, error) {
 	ret := _m.Called(ctx, customerID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsCustomerHasNonPaidLoan")
+		panic("no return value specified for IsCustomerHasWrittenOffLoan")
 	}
 
 	var r0 bool
@@ -222,31 +442,89 @@ func (_m *MockCreateLoanRepository) IsCustomerHasNonPaidLoan(ctx context.Context
 	return r0, r1
 }
 
-// MockCreateLoanRepository_IsCustomerHasNonPaidLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCustomerHasNonPaidLoan'
-type MockCreateLoanRepository_IsCustomerHasNonPaidLoan_Call struct {
+// MockCreateLoanRepository_IsCustomerHasWrittenOffLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCustomerHasWrittenOffLoan'
+type MockCreateLoanRepository_IsCustomerHasWrittenOffLoan_Call struct {
 	*mock.Call
 }
 
-// IsCustomerHasNonPaidLoan is a helper method to define mock.On call
+// IsCustomerHasWrittenOffLoan is a helper method to define mock.On call
 //   - ctx context.Context
 //   - customerID uint64
-func (_e *MockCreateLoanRepository_Expecter) IsCustomerHasNonPaidLoan(ctx interface{}, customerID interface{}) *MockCreateLoanRepository_IsCustomerHasNonPaidLoan_Call {
-	return &MockCreateLoanRepository_IsCustomerHasNonPaidLoan_Call{Call: _e.mock.On("IsCustomerHasNonPaidLoan", ctx, customerID)}
+func (_e *MockCreateLoanRepository_Expecter) IsCustomerHasWrittenOffLoan(ctx interface{}, customerID interface{}) *MockCreateLoanRepository_IsCustomerHasWrittenOffLoan_Call {
+	return &MockCreateLoanRepository_IsCustomerHasWrittenOffLoan_Call{Call: _e.mock.On("IsCustomerHasWrittenOffLoan", ctx, customerID)}
 }
 
-func (_c *MockCreateLoanRepository_IsCustomerHasNonPaidLoan_Call) Run(run func(ctx context.Context, customerID uint64)) *MockCreateLoanRepository_IsCustomerHasNonPaidLoan_Call {
+func (_c *MockCreateLoanRepository_IsCustomerHasWrittenOffLoan_Call) Run(run func(ctx context.Context, customerID uint64)) *MockCreateLoanRepository_IsCustomerHasWrittenOffLoan_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint64))
 	})
 	return _c
 }
 
-func (_c *MockCreateLoanRepository_IsCustomerHasNonPaidLoan_Call) Return(_a0 bool, _a1 error) *MockCreateLoanRepository_IsCustomerHasNonPaidLoan_Call {
+func (_c *MockCreateLoanRepository_IsCustomerHasWrittenOffLoan_Call) Return(_a0 bool, _a1 error) *MockCreateLoanRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateLoanRepository_IsCustomerHasWrittenOffLoan_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockCreateLoanRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMerchantOrderExist provides a mock function with given fields: ctx, merchantID, orderRef
+func (_m *MockCreateLoanRepository) IsMerchantOrderExist(ctx context.Context, merchantID uint64, orderRef string) (bool, error) {
+	ret := _m.Called(ctx, merchantID, orderRef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMerchantOrderExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (bool, error)); ok {
+		return rf(ctx, merchantID, orderRef)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) bool); ok {
+		r0 = rf(ctx, merchantID, orderRef)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, merchantID, orderRef)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateLoanRepository_IsMerchantOrderExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMerchantOrderExist'
+type MockCreateLoanRepository_IsMerchantOrderExist_Call struct {
+	*mock.Call
+}
+
+// IsMerchantOrderExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+//   - orderRef string
+func (_e *MockCreateLoanRepository_Expecter) IsMerchantOrderExist(ctx interface{}, merchantID interface{}, orderRef interface{}) *MockCreateLoanRepository_IsMerchantOrderExist_Call {
+	return &MockCreateLoanRepository_IsMerchantOrderExist_Call{Call: _e.mock.On("IsMerchantOrderExist", ctx, merchantID, orderRef)}
+}
+
+func (_c *MockCreateLoanRepository_IsMerchantOrderExist_Call) Run(run func(ctx context.Context, merchantID uint64, orderRef string)) *MockCreateLoanRepository_IsMerchantOrderExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCreateLoanRepository_IsMerchantOrderExist_Call) Return(_a0 bool, _a1 error) *MockCreateLoanRepository_IsMerchantOrderExist_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCreateLoanRepository_IsCustomerHasNonPaidLoan_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockCreateLoanRepository_IsCustomerHasNonPaidLoan_Call {
+func (_c *MockCreateLoanRepository_IsMerchantOrderExist_Call) RunAndReturn(run func(context.Context, uint64, string) (bool, error)) *MockCreateLoanRepository_IsMerchantOrderExist_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -22,27 +22,27 @@ func (_m *MockCreateLoanUsecase) EXPECT() *MockCreateLoanUsecase_Expecter {
 	return &MockCreateLoanUsecase_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function with given fields: ctx, customerID
-func (_m *MockCreateLoanUsecase) Execute(ctx context.Context, customerID uint64) (usecases.CreateLoanOutput, error) {
-	ret := _m.Called(ctx, customerID)
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockCreateLoanUsecase) Execute(ctx context.Context, input usecases.CreateLoanInput) (usecases.LoanOutput, error) {
+	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
-	var r0 usecases.CreateLoanOutput
+	var r0 usecases.LoanOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) (usecases.CreateLoanOutput, error)); ok {
-		return rf(ctx, customerID)
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CreateLoanInput) (usecases.LoanOutput, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) usecases.CreateLoanOutput); ok {
-		r0 = rf(ctx, customerID)
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CreateLoanInput) usecases.LoanOutput); ok {
+		r0 = rf(ctx, input)
 	} else {
-		r0 = ret.Get(0).(usecases.CreateLoanOutput)
+		r0 = ret.Get(0).(usecases.LoanOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, customerID)
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.CreateLoanInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,24 +57,24 @@ type MockCreateLoanUsecase_Execute_Call struct {
 
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
-//   - customerID uint64
-func (_e *MockCreateLoanUsecase_Expecter) Execute(ctx interface{}, customerID interface{}) *MockCreateLoanUsecase_Execute_Call {
-	return &MockCreateLoanUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, customerID)}
+//   - input usecases.CreateLoanInput
+func (_e *MockCreateLoanUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockCreateLoanUsecase_Execute_Call {
+	return &MockCreateLoanUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
 }
 
-func (_c *MockCreateLoanUsecase_Execute_Call) Run(run func(ctx context.Context, customerID uint64)) *MockCreateLoanUsecase_Execute_Call {
+func (_c *MockCreateLoanUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.CreateLoanInput)) *MockCreateLoanUsecase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(usecases.CreateLoanInput))
 	})
 	return _c
 }
 
-func (_c *MockCreateLoanUsecase_Execute_Call) Return(_a0 usecases.CreateLoanOutput, _a1 error) *MockCreateLoanUsecase_Execute_Call {
+func (_c *MockCreateLoanUsecase_Execute_Call) Return(_a0 usecases.LoanOutput, _a1 error) *MockCreateLoanUsecase_Execute_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCreateLoanUsecase_Execute_Call) RunAndReturn(run func(context.Context, uint64) (usecases.CreateLoanOutput, error)) *MockCreateLoanUsecase_Execute_Call {
+func (_c *MockCreateLoanUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.CreateLoanInput) (usecases.LoanOutput, error)) *MockCreateLoanUsecase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
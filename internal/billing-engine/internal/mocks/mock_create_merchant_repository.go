@@ -0,0 +1,152 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateMerchantRepository is an autogenerated mock type for the CreateMerchantRepository type
+type MockCreateMerchantRepository struct {
+	mock.Mock
+}
+
+type MockCreateMerchantRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateMerchantRepository) EXPECT() *MockCreateMerchantRepository_Expecter {
+	return &MockCreateMerchantRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateMerchant provides a mock function with given fields: ctx, merchant
+func (_m *MockCreateMerchantRepository) CreateMerchant(ctx context.Context, merchant entity.Merchant) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Merchant) (entity.Merchant, error)); ok {
+		return rf(ctx, merchant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Merchant) entity.Merchant); ok {
+		r0 = rf(ctx, merchant)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Merchant) error); ok {
+		r1 = rf(ctx, merchant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateMerchantRepository_CreateMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMerchant'
+type MockCreateMerchantRepository_CreateMerchant_Call struct {
+	*mock.Call
+}
+
+// CreateMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchant entity.Merchant
+func (_e *MockCreateMerchantRepository_Expecter) CreateMerchant(ctx interface{}, merchant interface{}) *MockCreateMerchantRepository_CreateMerchant_Call {
+	return &MockCreateMerchantRepository_CreateMerchant_Call{Call: _e.mock.On("CreateMerchant", ctx, merchant)}
+}
+
+func (_c *MockCreateMerchantRepository_CreateMerchant_Call) Run(run func(ctx context.Context, merchant entity.Merchant)) *MockCreateMerchantRepository_CreateMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Merchant))
+	})
+	return _c
+}
+
+func (_c *MockCreateMerchantRepository_CreateMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockCreateMerchantRepository_CreateMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateMerchantRepository_CreateMerchant_Call) RunAndReturn(run func(context.Context, entity.Merchant) (entity.Merchant, error)) *MockCreateMerchantRepository_CreateMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMerchantCodeExist provides a mock function with given fields: ctx, code
+func (_m *MockCreateMerchantRepository) IsMerchantCodeExist(ctx context.Context, code string) (bool, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMerchantCodeExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateMerchantRepository_IsMerchantCodeExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMerchantCodeExist'
+type MockCreateMerchantRepository_IsMerchantCodeExist_Call struct {
+	*mock.Call
+}
+
+// IsMerchantCodeExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *MockCreateMerchantRepository_Expecter) IsMerchantCodeExist(ctx interface{}, code interface{}) *MockCreateMerchantRepository_IsMerchantCodeExist_Call {
+	return &MockCreateMerchantRepository_IsMerchantCodeExist_Call{Call: _e.mock.On("IsMerchantCodeExist", ctx, code)}
+}
+
+func (_c *MockCreateMerchantRepository_IsMerchantCodeExist_Call) Run(run func(ctx context.Context, code string)) *MockCreateMerchantRepository_IsMerchantCodeExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCreateMerchantRepository_IsMerchantCodeExist_Call) Return(_a0 bool, _a1 error) *MockCreateMerchantRepository_IsMerchantCodeExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateMerchantRepository_IsMerchantCodeExist_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockCreateMerchantRepository_IsMerchantCodeExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateMerchantRepository creates a new instance of MockCreateMerchantRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateMerchantRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateMerchantRepository {
+	mock := &MockCreateMerchantRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateMerchantUsecase is an autogenerated mock type for the CreateMerchantUsecase type
+type MockCreateMerchantUsecase struct {
+	mock.Mock
+}
+
+type MockCreateMerchantUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateMerchantUsecase) EXPECT() *MockCreateMerchantUsecase_Expecter {
+	return &MockCreateMerchantUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockCreateMerchantUsecase) Execute(ctx context.Context, input usecases.CreateMerchantInput) (usecases.MerchantOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.MerchantOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CreateMerchantInput) (usecases.MerchantOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CreateMerchantInput) usecases.MerchantOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.MerchantOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.CreateMerchantInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateMerchantUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCreateMerchantUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.CreateMerchantInput
+func (_e *MockCreateMerchantUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockCreateMerchantUsecase_Execute_Call {
+	return &MockCreateMerchantUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockCreateMerchantUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.CreateMerchantInput)) *MockCreateMerchantUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.CreateMerchantInput))
+	})
+	return _c
+}
+
+func (_c *MockCreateMerchantUsecase_Execute_Call) Return(_a0 usecases.MerchantOutput, _a1 error) *MockCreateMerchantUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateMerchantUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.CreateMerchantInput) (usecases.MerchantOutput, error)) *MockCreateMerchantUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateMerchantUsecase creates a new instance of MockCreateMerchantUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateMerchantUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateMerchantUsecase {
+	mock := &MockCreateMerchantUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
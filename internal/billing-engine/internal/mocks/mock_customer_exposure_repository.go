@@ -0,0 +1,152 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCustomerExposureRepository is an autogenerated mock type for the CustomerExposureRepository type
+type MockCustomerExposureRepository struct {
+	mock.Mock
+}
+
+type MockCustomerExposureRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCustomerExposureRepository) EXPECT() *MockCustomerExposureRepository_Expecter {
+	return &MockCustomerExposureRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCreditLimit provides a mock function with given fields: ctx, customerID
+func (_m *MockCustomerExposureRepository) GetCreditLimit(ctx context.Context, customerID uint64) (entity.CreditLimit, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLimit")
+	}
+
+	var r0 entity.CreditLimit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLimit, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLimit); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLimit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerExposureRepository_GetCreditLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLimit'
+type MockCustomerExposureRepository_GetCreditLimit_Call struct {
+	*mock.Call
+}
+
+// GetCreditLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockCustomerExposureRepository_Expecter) GetCreditLimit(ctx interface{}, customerID interface{}) *MockCustomerExposureRepository_GetCreditLimit_Call {
+	return &MockCustomerExposureRepository_GetCreditLimit_Call{Call: _e.mock.On("GetCreditLimit", ctx, customerID)}
+}
+
+func (_c *MockCustomerExposureRepository_GetCreditLimit_Call) Run(run func(ctx context.Context, customerID uint64)) *MockCustomerExposureRepository_GetCreditLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockCustomerExposureRepository_GetCreditLimit_Call) Return(_a0 entity.CreditLimit, _a1 error) *MockCustomerExposureRepository_GetCreditLimit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerExposureRepository_GetCreditLimit_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLimit, error)) *MockCustomerExposureRepository_GetCreditLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomerExposure provides a mock function with given fields: ctx, customerID
+func (_m *MockCustomerExposureRepository) GetCustomerExposure(ctx context.Context, customerID uint64) (entity.CustomerExposure, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomerExposure")
+	}
+
+	var r0 entity.CustomerExposure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CustomerExposure, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CustomerExposure); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CustomerExposure)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerExposureRepository_GetCustomerExposure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomerExposure'
+type MockCustomerExposureRepository_GetCustomerExposure_Call struct {
+	*mock.Call
+}
+
+// GetCustomerExposure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockCustomerExposureRepository_Expecter) GetCustomerExposure(ctx interface{}, customerID interface{}) *MockCustomerExposureRepository_GetCustomerExposure_Call {
+	return &MockCustomerExposureRepository_GetCustomerExposure_Call{Call: _e.mock.On("GetCustomerExposure", ctx, customerID)}
+}
+
+func (_c *MockCustomerExposureRepository_GetCustomerExposure_Call) Run(run func(ctx context.Context, customerID uint64)) *MockCustomerExposureRepository_GetCustomerExposure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockCustomerExposureRepository_GetCustomerExposure_Call) Return(_a0 entity.CustomerExposure, _a1 error) *MockCustomerExposureRepository_GetCustomerExposure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerExposureRepository_GetCustomerExposure_Call) RunAndReturn(run func(context.Context, uint64) (entity.CustomerExposure, error)) *MockCustomerExposureRepository_GetCustomerExposure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCustomerExposureRepository creates a new instance of MockCustomerExposureRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCustomerExposureRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCustomerExposureRepository {
+	mock := &MockCustomerExposureRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
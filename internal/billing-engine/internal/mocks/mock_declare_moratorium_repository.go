@@ -0,0 +1,649 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	decimal "github.com/shopspring/decimal"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeclareMoratoriumRepository is an autogenerated mock type for the DeclareMoratoriumRepository type
+type MockDeclareMoratoriumRepository struct {
+	mock.Mock
+}
+
+type MockDeclareMoratoriumRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeclareMoratoriumRepository) EXPECT() *MockDeclareMoratoriumRepository_Expecter {
+	return &MockDeclareMoratoriumRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockDeclareMoratoriumRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeclareMoratoriumRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockDeclareMoratoriumRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockDeclareMoratoriumRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockDeclareMoratoriumRepository_CreateJournalEntry_Call {
+	return &MockDeclareMoratoriumRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockDeclareMoratoriumRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockDeclareMoratoriumRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockDeclareMoratoriumRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMoratorium provides a mock function with given fields: ctx, moratorium
+func (_m *MockDeclareMoratoriumRepository) CreateMoratorium(ctx context.Context, moratorium entity.Moratorium) (entity.Moratorium, error) {
+	ret := _m.Called(ctx, moratorium)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMoratorium")
+	}
+
+	var r0 entity.Moratorium
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Moratorium) (entity.Moratorium, error)); ok {
+		return rf(ctx, moratorium)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Moratorium) entity.Moratorium); ok {
+		r0 = rf(ctx, moratorium)
+	} else {
+		r0 = ret.Get(0).(entity.Moratorium)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Moratorium) error); ok {
+		r1 = rf(ctx, moratorium)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeclareMoratoriumRepository_CreateMoratorium_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMoratorium'
+type MockDeclareMoratoriumRepository_CreateMoratorium_Call struct {
+	*mock.Call
+}
+
+// CreateMoratorium is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moratorium entity.Moratorium
+func (_e *MockDeclareMoratoriumRepository_Expecter) CreateMoratorium(ctx interface{}, moratorium interface{}) *MockDeclareMoratoriumRepository_CreateMoratorium_Call {
+	return &MockDeclareMoratoriumRepository_CreateMoratorium_Call{Call: _e.mock.On("CreateMoratorium", ctx, moratorium)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_CreateMoratorium_Call) Run(run func(ctx context.Context, moratorium entity.Moratorium)) *MockDeclareMoratoriumRepository_CreateMoratorium_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Moratorium))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_CreateMoratorium_Call) Return(_a0 entity.Moratorium, _a1 error) *MockDeclareMoratoriumRepository_CreateMoratorium_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_CreateMoratorium_Call) RunAndReturn(run func(context.Context, entity.Moratorium) (entity.Moratorium, error)) *MockDeclareMoratoriumRepository_CreateMoratorium_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMoratoriumInstallment provides a mock function with given fields: ctx, change
+func (_m *MockDeclareMoratoriumRepository) CreateMoratoriumInstallment(ctx context.Context, change entity.MoratoriumInstallment) error {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMoratoriumInstallment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MoratoriumInstallment) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeclareMoratoriumRepository_CreateMoratoriumInstallment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMoratoriumInstallment'
+type MockDeclareMoratoriumRepository_CreateMoratoriumInstallment_Call struct {
+	*mock.Call
+}
+
+// CreateMoratoriumInstallment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change entity.MoratoriumInstallment
+func (_e *MockDeclareMoratoriumRepository_Expecter) CreateMoratoriumInstallment(ctx interface{}, change interface{}) *MockDeclareMoratoriumRepository_CreateMoratoriumInstallment_Call {
+	return &MockDeclareMoratoriumRepository_CreateMoratoriumInstallment_Call{Call: _e.mock.On("CreateMoratoriumInstallment", ctx, change)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_CreateMoratoriumInstallment_Call) Run(run func(ctx context.Context, change entity.MoratoriumInstallment)) *MockDeclareMoratoriumRepository_CreateMoratoriumInstallment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MoratoriumInstallment))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_CreateMoratoriumInstallment_Call) Return(_a0 error) *MockDeclareMoratoriumRepository_CreateMoratoriumInstallment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_CreateMoratoriumInstallment_Call) RunAndReturn(run func(context.Context, entity.MoratoriumInstallment) error) *MockDeclareMoratoriumRepository_CreateMoratoriumInstallment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDisbursedLoansStartedBetween provides a mock function with given fields: ctx, from, to
+func (_m *MockDeclareMoratoriumRepository) GetDisbursedLoansStartedBetween(ctx context.Context, from time.Time, to time.Time) ([]entity.Loan, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDisbursedLoansStartedBetween")
+	}
+
+	var r0 []entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.Loan, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.Loan); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Loan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeclareMoratoriumRepository_GetDisbursedLoansStartedBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDisbursedLoansStartedBetween'
+type MockDeclareMoratoriumRepository_GetDisbursedLoansStartedBetween_Call struct {
+	*mock.Call
+}
+
+// GetDisbursedLoansStartedBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *MockDeclareMoratoriumRepository_Expecter) GetDisbursedLoansStartedBetween(ctx interface{}, from interface{}, to interface{}) *MockDeclareMoratoriumRepository_GetDisbursedLoansStartedBetween_Call {
+	return &MockDeclareMoratoriumRepository_GetDisbursedLoansStartedBetween_Call{Call: _e.mock.On("GetDisbursedLoansStartedBetween", ctx, from, to)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetDisbursedLoansStartedBetween_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockDeclareMoratoriumRepository_GetDisbursedLoansStartedBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetDisbursedLoansStartedBetween_Call) Return(_a0 []entity.Loan, _a1 error) *MockDeclareMoratoriumRepository_GetDisbursedLoansStartedBetween_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetDisbursedLoansStartedBetween_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]entity.Loan, error)) *MockDeclareMoratoriumRepository_GetDisbursedLoansStartedBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockDeclareMoratoriumRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeclareMoratoriumRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockDeclareMoratoriumRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDeclareMoratoriumRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockDeclareMoratoriumRepository_GetLatestClosedPeriod_Call {
+	return &MockDeclareMoratoriumRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockDeclareMoratoriumRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockDeclareMoratoriumRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockDeclareMoratoriumRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockDeclareMoratoriumRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeclareMoratoriumRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockDeclareMoratoriumRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockDeclareMoratoriumRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockDeclareMoratoriumRepository_GetLoan_Call {
+	return &MockDeclareMoratoriumRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockDeclareMoratoriumRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockDeclareMoratoriumRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockDeclareMoratoriumRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOutstanding provides a mock function with given fields: ctx, loanID
+func (_m *MockDeclareMoratoriumRepository) GetOutstanding(ctx context.Context, loanID uint64) (decimal.Decimal, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutstanding")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (decimal.Decimal, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) decimal.Decimal); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeclareMoratoriumRepository_GetOutstanding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOutstanding'
+type MockDeclareMoratoriumRepository_GetOutstanding_Call struct {
+	*mock.Call
+}
+
+// GetOutstanding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockDeclareMoratoriumRepository_Expecter) GetOutstanding(ctx interface{}, loanID interface{}) *MockDeclareMoratoriumRepository_GetOutstanding_Call {
+	return &MockDeclareMoratoriumRepository_GetOutstanding_Call{Call: _e.mock.On("GetOutstanding", ctx, loanID)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetOutstanding_Call) Run(run func(ctx context.Context, loanID uint64)) *MockDeclareMoratoriumRepository_GetOutstanding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetOutstanding_Call) Return(_a0 decimal.Decimal, _a1 error) *MockDeclareMoratoriumRepository_GetOutstanding_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetOutstanding_Call) RunAndReturn(run func(context.Context, uint64) (decimal.Decimal, error)) *MockDeclareMoratoriumRepository_GetOutstanding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnpaidInstallmentsDueFrom provides a mock function with given fields: ctx, loanID, from
+func (_m *MockDeclareMoratoriumRepository) GetUnpaidInstallmentsDueFrom(ctx context.Context, loanID uint64, from time.Time) ([]entity.Installment, error) {
+	ret := _m.Called(ctx, loanID, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnpaidInstallmentsDueFrom")
+	}
+
+	var r0 []entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) ([]entity.Installment, error)); ok {
+		return rf(ctx, loanID, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) []entity.Installment); ok {
+		r0 = rf(ctx, loanID, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time) error); ok {
+		r1 = rf(ctx, loanID, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeclareMoratoriumRepository_GetUnpaidInstallmentsDueFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnpaidInstallmentsDueFrom'
+type MockDeclareMoratoriumRepository_GetUnpaidInstallmentsDueFrom_Call struct {
+	*mock.Call
+}
+
+// GetUnpaidInstallmentsDueFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - from time.Time
+func (_e *MockDeclareMoratoriumRepository_Expecter) GetUnpaidInstallmentsDueFrom(ctx interface{}, loanID interface{}, from interface{}) *MockDeclareMoratoriumRepository_GetUnpaidInstallmentsDueFrom_Call {
+	return &MockDeclareMoratoriumRepository_GetUnpaidInstallmentsDueFrom_Call{Call: _e.mock.On("GetUnpaidInstallmentsDueFrom", ctx, loanID, from)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetUnpaidInstallmentsDueFrom_Call) Run(run func(ctx context.Context, loanID uint64, from time.Time)) *MockDeclareMoratoriumRepository_GetUnpaidInstallmentsDueFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetUnpaidInstallmentsDueFrom_Call) Return(_a0 []entity.Installment, _a1 error) *MockDeclareMoratoriumRepository_GetUnpaidInstallmentsDueFrom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_GetUnpaidInstallmentsDueFrom_Call) RunAndReturn(run func(context.Context, uint64, time.Time) ([]entity.Installment, error)) *MockDeclareMoratoriumRepository_GetUnpaidInstallmentsDueFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasOverlappingMoratorium provides a mock function with given fields: ctx, loanID, from, to
+func (_m *MockDeclareMoratoriumRepository) HasOverlappingMoratorium(ctx context.Context, loanID uint64, from time.Time, to time.Time) (bool, error) {
+	ret := _m.Called(ctx, loanID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasOverlappingMoratorium")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, time.Time) (bool, error)); ok {
+		return rf(ctx, loanID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, time.Time) bool); ok {
+		r0 = rf(ctx, loanID, from, to)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, loanID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeclareMoratoriumRepository_HasOverlappingMoratorium_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasOverlappingMoratorium'
+type MockDeclareMoratoriumRepository_HasOverlappingMoratorium_Call struct {
+	*mock.Call
+}
+
+// HasOverlappingMoratorium is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - from time.Time
+//   - to time.Time
+func (_e *MockDeclareMoratoriumRepository_Expecter) HasOverlappingMoratorium(ctx interface{}, loanID interface{}, from interface{}, to interface{}) *MockDeclareMoratoriumRepository_HasOverlappingMoratorium_Call {
+	return &MockDeclareMoratoriumRepository_HasOverlappingMoratorium_Call{Call: _e.mock.On("HasOverlappingMoratorium", ctx, loanID, from, to)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_HasOverlappingMoratorium_Call) Run(run func(ctx context.Context, loanID uint64, from time.Time, to time.Time)) *MockDeclareMoratoriumRepository_HasOverlappingMoratorium_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_HasOverlappingMoratorium_Call) Return(_a0 bool, _a1 error) *MockDeclareMoratoriumRepository_HasOverlappingMoratorium_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_HasOverlappingMoratorium_Call) RunAndReturn(run func(context.Context, uint64, time.Time, time.Time) (bool, error)) *MockDeclareMoratoriumRepository_HasOverlappingMoratorium_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateInstallmentSchedule provides a mock function with given fields: ctx, installmentID, dueDate, amountDue, holidayInterest, status
+func (_m *MockDeclareMoratoriumRepository) UpdateInstallmentSchedule(ctx context.Context, installmentID uint64, dueDate time.Time, amountDue decimal.Decimal, holidayInterest decimal.Decimal, status entity.InstallmentStatus) error {
+	ret := _m.Called(ctx, installmentID, dueDate, amountDue, holidayInterest, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateInstallmentSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, decimal.Decimal, decimal.Decimal, entity.InstallmentStatus) error); ok {
+		r0 = rf(ctx, installmentID, dueDate, amountDue, holidayInterest, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeclareMoratoriumRepository_UpdateInstallmentSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInstallmentSchedule'
+type MockDeclareMoratoriumRepository_UpdateInstallmentSchedule_Call struct {
+	*mock.Call
+}
+
+// UpdateInstallmentSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - installmentID uint64
+//   - dueDate time.Time
+//   - amountDue decimal.Decimal
+//   - holidayInterest decimal.Decimal
+//   - status entity.InstallmentStatus
+func (_e *MockDeclareMoratoriumRepository_Expecter) UpdateInstallmentSchedule(ctx interface{}, installmentID interface{}, dueDate interface{}, amountDue interface{}, holidayInterest interface{}, status interface{}) *MockDeclareMoratoriumRepository_UpdateInstallmentSchedule_Call {
+	return &MockDeclareMoratoriumRepository_UpdateInstallmentSchedule_Call{Call: _e.mock.On("UpdateInstallmentSchedule", ctx, installmentID, dueDate, amountDue, holidayInterest, status)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_UpdateInstallmentSchedule_Call) Run(run func(ctx context.Context, installmentID uint64, dueDate time.Time, amountDue decimal.Decimal, holidayInterest decimal.Decimal, status entity.InstallmentStatus)) *MockDeclareMoratoriumRepository_UpdateInstallmentSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time), args[3].(decimal.Decimal), args[4].(decimal.Decimal), args[5].(entity.InstallmentStatus))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_UpdateInstallmentSchedule_Call) Return(_a0 error) *MockDeclareMoratoriumRepository_UpdateInstallmentSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_UpdateInstallmentSchedule_Call) RunAndReturn(run func(context.Context, uint64, time.Time, decimal.Decimal, decimal.Decimal, entity.InstallmentStatus) error) *MockDeclareMoratoriumRepository_UpdateInstallmentSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDeclareMoratoriumRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeclareMoratoriumRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockDeclareMoratoriumRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockDeclareMoratoriumRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockDeclareMoratoriumRepository_WithinTransaction_Call {
+	return &MockDeclareMoratoriumRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockDeclareMoratoriumRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockDeclareMoratoriumRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_WithinTransaction_Call) Return(_a0 error) *MockDeclareMoratoriumRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockDeclareMoratoriumRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeclareMoratoriumRepository creates a new instance of MockDeclareMoratoriumRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeclareMoratoriumRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeclareMoratoriumRepository {
+	mock := &MockDeclareMoratoriumRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
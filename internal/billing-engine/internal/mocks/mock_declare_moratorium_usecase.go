@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeclareMoratoriumUsecase is an autogenerated mock type for the DeclareMoratoriumUsecase type
+type MockDeclareMoratoriumUsecase struct {
+	mock.Mock
+}
+
+type MockDeclareMoratoriumUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeclareMoratoriumUsecase) EXPECT() *MockDeclareMoratoriumUsecase_Expecter {
+	return &MockDeclareMoratoriumUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockDeclareMoratoriumUsecase) Execute(ctx context.Context, input usecases.DeclareMoratoriumInput) (usecases.MoratoriumOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.MoratoriumOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DeclareMoratoriumInput) (usecases.MoratoriumOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DeclareMoratoriumInput) usecases.MoratoriumOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.MoratoriumOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.DeclareMoratoriumInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeclareMoratoriumUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDeclareMoratoriumUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.DeclareMoratoriumInput
+func (_e *MockDeclareMoratoriumUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockDeclareMoratoriumUsecase_Execute_Call {
+	return &MockDeclareMoratoriumUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockDeclareMoratoriumUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.DeclareMoratoriumInput)) *MockDeclareMoratoriumUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.DeclareMoratoriumInput))
+	})
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumUsecase_Execute_Call) Return(_a0 usecases.MoratoriumOutput, _a1 error) *MockDeclareMoratoriumUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeclareMoratoriumUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.DeclareMoratoriumInput) (usecases.MoratoriumOutput, error)) *MockDeclareMoratoriumUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeclareMoratoriumUsecase creates a new instance of MockDeclareMoratoriumUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeclareMoratoriumUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeclareMoratoriumUsecase {
+	mock := &MockDeclareMoratoriumUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,212 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeleteCustomerRepository is an autogenerated mock type for the DeleteCustomerRepository type
+type MockDeleteCustomerRepository struct {
+	mock.Mock
+}
+
+type MockDeleteCustomerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteCustomerRepository) EXPECT() *MockDeleteCustomerRepository_Expecter {
+	return &MockDeleteCustomerRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteCustomer provides a mock function with given fields: ctx, customerID, deletedAt
+func (_m *MockDeleteCustomerRepository) DeleteCustomer(ctx context.Context, customerID uint64, deletedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, customerID, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCustomer")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) (bool, error)); ok {
+		return rf(ctx, customerID, deletedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) bool); ok {
+		r0 = rf(ctx, customerID, deletedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time) error); ok {
+		r1 = rf(ctx, customerID, deletedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeleteCustomerRepository_DeleteCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCustomer'
+type MockDeleteCustomerRepository_DeleteCustomer_Call struct {
+	*mock.Call
+}
+
+// DeleteCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+//   - deletedAt time.Time
+func (_e *MockDeleteCustomerRepository_Expecter) DeleteCustomer(ctx interface{}, customerID interface{}, deletedAt interface{}) *MockDeleteCustomerRepository_DeleteCustomer_Call {
+	return &MockDeleteCustomerRepository_DeleteCustomer_Call{Call: _e.mock.On("DeleteCustomer", ctx, customerID, deletedAt)}
+}
+
+func (_c *MockDeleteCustomerRepository_DeleteCustomer_Call) Run(run func(ctx context.Context, customerID uint64, deletedAt time.Time)) *MockDeleteCustomerRepository_DeleteCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDeleteCustomerRepository_DeleteCustomer_Call) Return(_a0 bool, _a1 error) *MockDeleteCustomerRepository_DeleteCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeleteCustomerRepository_DeleteCustomer_Call) RunAndReturn(run func(context.Context, uint64, time.Time) (bool, error)) *MockDeleteCustomerRepository_DeleteCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomerExposure provides a mock function with given fields: ctx, customerID
+func (_m *MockDeleteCustomerRepository) GetCustomerExposure(ctx context.Context, customerID uint64) (entity.CustomerExposure, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomerExposure")
+	}
+
+	var r0 entity.CustomerExposure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CustomerExposure, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CustomerExposure); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CustomerExposure)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeleteCustomerRepository_GetCustomerExposure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomerExposure'
+type MockDeleteCustomerRepository_GetCustomerExposure_Call struct {
+	*mock.Call
+}
+
+// GetCustomerExposure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockDeleteCustomerRepository_Expecter) GetCustomerExposure(ctx interface{}, customerID interface{}) *MockDeleteCustomerRepository_GetCustomerExposure_Call {
+	return &MockDeleteCustomerRepository_GetCustomerExposure_Call{Call: _e.mock.On("GetCustomerExposure", ctx, customerID)}
+}
+
+func (_c *MockDeleteCustomerRepository_GetCustomerExposure_Call) Run(run func(ctx context.Context, customerID uint64)) *MockDeleteCustomerRepository_GetCustomerExposure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDeleteCustomerRepository_GetCustomerExposure_Call) Return(_a0 entity.CustomerExposure, _a1 error) *MockDeleteCustomerRepository_GetCustomerExposure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeleteCustomerRepository_GetCustomerExposure_Call) RunAndReturn(run func(context.Context, uint64) (entity.CustomerExposure, error)) *MockDeleteCustomerRepository_GetCustomerExposure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCustomerExist provides a mock function with given fields: ctx, customerID
+func (_m *MockDeleteCustomerRepository) IsCustomerExist(ctx context.Context, customerID uint64) (bool, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCustomerExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeleteCustomerRepository_IsCustomerExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCustomerExist'
+type MockDeleteCustomerRepository_IsCustomerExist_Call struct {
+	*mock.Call
+}
+
+// IsCustomerExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockDeleteCustomerRepository_Expecter) IsCustomerExist(ctx interface{}, customerID interface{}) *MockDeleteCustomerRepository_IsCustomerExist_Call {
+	return &MockDeleteCustomerRepository_IsCustomerExist_Call{Call: _e.mock.On("IsCustomerExist", ctx, customerID)}
+}
+
+func (_c *MockDeleteCustomerRepository_IsCustomerExist_Call) Run(run func(ctx context.Context, customerID uint64)) *MockDeleteCustomerRepository_IsCustomerExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDeleteCustomerRepository_IsCustomerExist_Call) Return(_a0 bool, _a1 error) *MockDeleteCustomerRepository_IsCustomerExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeleteCustomerRepository_IsCustomerExist_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockDeleteCustomerRepository_IsCustomerExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteCustomerRepository creates a new instance of MockDeleteCustomerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteCustomerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteCustomerRepository {
+	mock := &MockDeleteCustomerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,841 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDisburseLoanRepository is an autogenerated mock type for the DisburseLoanRepository type
+type MockDisburseLoanRepository struct {
+	mock.Mock
+}
+
+type MockDisburseLoanRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDisburseLoanRepository) EXPECT() *MockDisburseLoanRepository_Expecter {
+	return &MockDisburseLoanRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateDisbursement provides a mock function with given fields: ctx, disbursement
+func (_m *MockDisburseLoanRepository) CreateDisbursement(ctx context.Context, disbursement entity.Disbursement) (entity.Disbursement, error) {
+	ret := _m.Called(ctx, disbursement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDisbursement")
+	}
+
+	var r0 entity.Disbursement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Disbursement) (entity.Disbursement, error)); ok {
+		return rf(ctx, disbursement)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Disbursement) entity.Disbursement); ok {
+		r0 = rf(ctx, disbursement)
+	} else {
+		r0 = ret.Get(0).(entity.Disbursement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Disbursement) error); ok {
+		r1 = rf(ctx, disbursement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisburseLoanRepository_CreateDisbursement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDisbursement'
+type MockDisburseLoanRepository_CreateDisbursement_Call struct {
+	*mock.Call
+}
+
+// CreateDisbursement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disbursement entity.Disbursement
+func (_e *MockDisburseLoanRepository_Expecter) CreateDisbursement(ctx interface{}, disbursement interface{}) *MockDisburseLoanRepository_CreateDisbursement_Call {
+	return &MockDisburseLoanRepository_CreateDisbursement_Call{Call: _e.mock.On("CreateDisbursement", ctx, disbursement)}
+}
+
+func (_c *MockDisburseLoanRepository_CreateDisbursement_Call) Run(run func(ctx context.Context, disbursement entity.Disbursement)) *MockDisburseLoanRepository_CreateDisbursement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Disbursement))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_CreateDisbursement_Call) Return(_a0 entity.Disbursement, _a1 error) *MockDisburseLoanRepository_CreateDisbursement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_CreateDisbursement_Call) RunAndReturn(run func(context.Context, entity.Disbursement) (entity.Disbursement, error)) *MockDisburseLoanRepository_CreateDisbursement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInstallmentFromLoan provides a mock function with given fields: ctx, loan, fees
+func (_m *MockDisburseLoanRepository) CreateInstallmentFromLoan(ctx context.Context, loan *entity.Loan, fees []entity.LoanFee) (bool, error) {
+	ret := _m.Called(ctx, loan, fees)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstallmentFromLoan")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Loan, []entity.LoanFee) (bool, error)); ok {
+		return rf(ctx, loan, fees)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Loan, []entity.LoanFee) bool); ok {
+		r0 = rf(ctx, loan, fees)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Loan, []entity.LoanFee) error); ok {
+		r1 = rf(ctx, loan, fees)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisburseLoanRepository_CreateInstallmentFromLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstallmentFromLoan'
+type MockDisburseLoanRepository_CreateInstallmentFromLoan_Call struct {
+	*mock.Call
+}
+
+// CreateInstallmentFromLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loan *entity.Loan
+//   - fees []entity.LoanFee
+func (_e *MockDisburseLoanRepository_Expecter) CreateInstallmentFromLoan(ctx interface{}, loan interface{}, fees interface{}) *MockDisburseLoanRepository_CreateInstallmentFromLoan_Call {
+	return &MockDisburseLoanRepository_CreateInstallmentFromLoan_Call{Call: _e.mock.On("CreateInstallmentFromLoan", ctx, loan, fees)}
+}
+
+func (_c *MockDisburseLoanRepository_CreateInstallmentFromLoan_Call) Run(run func(ctx context.Context, loan *entity.Loan, fees []entity.LoanFee)) *MockDisburseLoanRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Loan), args[2].([]entity.LoanFee))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_CreateInstallmentFromLoan_Call) Return(_a0 bool, _a1 error) *MockDisburseLoanRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_CreateInstallmentFromLoan_Call) RunAndReturn(run func(context.Context, *entity.Loan, []entity.LoanFee) (bool, error)) *MockDisburseLoanRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockDisburseLoanRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisburseLoanRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockDisburseLoanRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockDisburseLoanRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockDisburseLoanRepository_CreateJournalEntry_Call {
+	return &MockDisburseLoanRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockDisburseLoanRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockDisburseLoanRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockDisburseLoanRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockDisburseLoanRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLoanFees provides a mock function with given fields: ctx, fees
+func (_m *MockDisburseLoanRepository) CreateLoanFees(ctx context.Context, fees []entity.LoanFee) error {
+	ret := _m.Called(ctx, fees)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoanFees")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.LoanFee) error); ok {
+		r0 = rf(ctx, fees)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisburseLoanRepository_CreateLoanFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoanFees'
+type MockDisburseLoanRepository_CreateLoanFees_Call struct {
+	*mock.Call
+}
+
+// CreateLoanFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fees []entity.LoanFee
+func (_e *MockDisburseLoanRepository_Expecter) CreateLoanFees(ctx interface{}, fees interface{}) *MockDisburseLoanRepository_CreateLoanFees_Call {
+	return &MockDisburseLoanRepository_CreateLoanFees_Call{Call: _e.mock.On("CreateLoanFees", ctx, fees)}
+}
+
+func (_c *MockDisburseLoanRepository_CreateLoanFees_Call) Run(run func(ctx context.Context, fees []entity.LoanFee)) *MockDisburseLoanRepository_CreateLoanFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]entity.LoanFee))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_CreateLoanFees_Call) Return(_a0 error) *MockDisburseLoanRepository_CreateLoanFees_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_CreateLoanFees_Call) RunAndReturn(run func(context.Context, []entity.LoanFee) error) *MockDisburseLoanRepository_CreateLoanFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMerchantSettlementItem provides a mock function with given fields: ctx, item
+func (_m *MockDisburseLoanRepository) CreateMerchantSettlementItem(ctx context.Context, item entity.MerchantSettlementItem) error {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMerchantSettlementItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchantSettlementItem) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisburseLoanRepository_CreateMerchantSettlementItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMerchantSettlementItem'
+type MockDisburseLoanRepository_CreateMerchantSettlementItem_Call struct {
+	*mock.Call
+}
+
+// CreateMerchantSettlementItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item entity.MerchantSettlementItem
+func (_e *MockDisburseLoanRepository_Expecter) CreateMerchantSettlementItem(ctx interface{}, item interface{}) *MockDisburseLoanRepository_CreateMerchantSettlementItem_Call {
+	return &MockDisburseLoanRepository_CreateMerchantSettlementItem_Call{Call: _e.mock.On("CreateMerchantSettlementItem", ctx, item)}
+}
+
+func (_c *MockDisburseLoanRepository_CreateMerchantSettlementItem_Call) Run(run func(ctx context.Context, item entity.MerchantSettlementItem)) *MockDisburseLoanRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchantSettlementItem))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_CreateMerchantSettlementItem_Call) Return(_a0 error) *MockDisburseLoanRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_CreateMerchantSettlementItem_Call) RunAndReturn(run func(context.Context, entity.MerchantSettlementItem) error) *MockDisburseLoanRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockDisburseLoanRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisburseLoanRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockDisburseLoanRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDisburseLoanRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockDisburseLoanRepository_GetLatestClosedPeriod_Call {
+	return &MockDisburseLoanRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockDisburseLoanRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockDisburseLoanRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockDisburseLoanRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockDisburseLoanRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockDisburseLoanRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisburseLoanRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockDisburseLoanRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockDisburseLoanRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockDisburseLoanRepository_GetLoan_Call {
+	return &MockDisburseLoanRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockDisburseLoanRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockDisburseLoanRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockDisburseLoanRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockDisburseLoanRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanFees provides a mock function with given fields: ctx, loanID
+func (_m *MockDisburseLoanRepository) GetLoanFees(ctx context.Context, loanID uint64) ([]entity.LoanFee, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanFees")
+	}
+
+	var r0 []entity.LoanFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanFee, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanFee); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisburseLoanRepository_GetLoanFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanFees'
+type MockDisburseLoanRepository_GetLoanFees_Call struct {
+	*mock.Call
+}
+
+// GetLoanFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockDisburseLoanRepository_Expecter) GetLoanFees(ctx interface{}, loanID interface{}) *MockDisburseLoanRepository_GetLoanFees_Call {
+	return &MockDisburseLoanRepository_GetLoanFees_Call{Call: _e.mock.On("GetLoanFees", ctx, loanID)}
+}
+
+func (_c *MockDisburseLoanRepository_GetLoanFees_Call) Run(run func(ctx context.Context, loanID uint64)) *MockDisburseLoanRepository_GetLoanFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_GetLoanFees_Call) Return(_a0 []entity.LoanFee, _a1 error) *MockDisburseLoanRepository_GetLoanFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_GetLoanFees_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanFee, error)) *MockDisburseLoanRepository_GetLoanFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchant provides a mock function with given fields: ctx, merchantID
+func (_m *MockDisburseLoanRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Merchant, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Merchant); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisburseLoanRepository_GetMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchant'
+type MockDisburseLoanRepository_GetMerchant_Call struct {
+	*mock.Call
+}
+
+// GetMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockDisburseLoanRepository_Expecter) GetMerchant(ctx interface{}, merchantID interface{}) *MockDisburseLoanRepository_GetMerchant_Call {
+	return &MockDisburseLoanRepository_GetMerchant_Call{Call: _e.mock.On("GetMerchant", ctx, merchantID)}
+}
+
+func (_c *MockDisburseLoanRepository_GetMerchant_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockDisburseLoanRepository_GetMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_GetMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockDisburseLoanRepository_GetMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_GetMerchant_Call) RunAndReturn(run func(context.Context, uint64) (entity.Merchant, error)) *MockDisburseLoanRepository_GetMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductFees provides a mock function with given fields: ctx, productCode
+func (_m *MockDisburseLoanRepository) GetProductFees(ctx context.Context, productCode string) ([]entity.ProductFee, error) {
+	ret := _m.Called(ctx, productCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductFees")
+	}
+
+	var r0 []entity.ProductFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.ProductFee, error)); ok {
+		return rf(ctx, productCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.ProductFee); ok {
+		r0 = rf(ctx, productCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProductFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisburseLoanRepository_GetProductFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductFees'
+type MockDisburseLoanRepository_GetProductFees_Call struct {
+	*mock.Call
+}
+
+// GetProductFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productCode string
+func (_e *MockDisburseLoanRepository_Expecter) GetProductFees(ctx interface{}, productCode interface{}) *MockDisburseLoanRepository_GetProductFees_Call {
+	return &MockDisburseLoanRepository_GetProductFees_Call{Call: _e.mock.On("GetProductFees", ctx, productCode)}
+}
+
+func (_c *MockDisburseLoanRepository_GetProductFees_Call) Run(run func(ctx context.Context, productCode string)) *MockDisburseLoanRepository_GetProductFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_GetProductFees_Call) Return(_a0 []entity.ProductFee, _a1 error) *MockDisburseLoanRepository_GetProductFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_GetProductFees_Call) RunAndReturn(run func(context.Context, string) ([]entity.ProductFee, error)) *MockDisburseLoanRepository_GetProductFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsLoanHasDisbursement provides a mock function with given fields: ctx, loanID
+func (_m *MockDisburseLoanRepository) IsLoanHasDisbursement(ctx context.Context, loanID uint64) (bool, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsLoanHasDisbursement")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisburseLoanRepository_IsLoanHasDisbursement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsLoanHasDisbursement'
+type MockDisburseLoanRepository_IsLoanHasDisbursement_Call struct {
+	*mock.Call
+}
+
+// IsLoanHasDisbursement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockDisburseLoanRepository_Expecter) IsLoanHasDisbursement(ctx interface{}, loanID interface{}) *MockDisburseLoanRepository_IsLoanHasDisbursement_Call {
+	return &MockDisburseLoanRepository_IsLoanHasDisbursement_Call{Call: _e.mock.On("IsLoanHasDisbursement", ctx, loanID)}
+}
+
+func (_c *MockDisburseLoanRepository_IsLoanHasDisbursement_Call) Run(run func(ctx context.Context, loanID uint64)) *MockDisburseLoanRepository_IsLoanHasDisbursement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_IsLoanHasDisbursement_Call) Return(_a0 bool, _a1 error) *MockDisburseLoanRepository_IsLoanHasDisbursement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_IsLoanHasDisbursement_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockDisburseLoanRepository_IsLoanHasDisbursement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLoanStartDate provides a mock function with given fields: ctx, loanID, startDate
+func (_m *MockDisburseLoanRepository) SetLoanStartDate(ctx context.Context, loanID uint64, startDate time.Time) error {
+	ret := _m.Called(ctx, loanID, startDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLoanStartDate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) error); ok {
+		r0 = rf(ctx, loanID, startDate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisburseLoanRepository_SetLoanStartDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLoanStartDate'
+type MockDisburseLoanRepository_SetLoanStartDate_Call struct {
+	*mock.Call
+}
+
+// SetLoanStartDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - startDate time.Time
+func (_e *MockDisburseLoanRepository_Expecter) SetLoanStartDate(ctx interface{}, loanID interface{}, startDate interface{}) *MockDisburseLoanRepository_SetLoanStartDate_Call {
+	return &MockDisburseLoanRepository_SetLoanStartDate_Call{Call: _e.mock.On("SetLoanStartDate", ctx, loanID, startDate)}
+}
+
+func (_c *MockDisburseLoanRepository_SetLoanStartDate_Call) Run(run func(ctx context.Context, loanID uint64, startDate time.Time)) *MockDisburseLoanRepository_SetLoanStartDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_SetLoanStartDate_Call) Return(_a0 error) *MockDisburseLoanRepository_SetLoanStartDate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_SetLoanStartDate_Call) RunAndReturn(run func(context.Context, uint64, time.Time) error) *MockDisburseLoanRepository_SetLoanStartDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionLoanStatus provides a mock function with given fields: ctx, transition
+func (_m *MockDisburseLoanRepository) TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error {
+	ret := _m.Called(ctx, transition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionLoanStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanStatusTransition) error); ok {
+		r0 = rf(ctx, transition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisburseLoanRepository_TransitionLoanStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionLoanStatus'
+type MockDisburseLoanRepository_TransitionLoanStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionLoanStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transition entity.LoanStatusTransition
+func (_e *MockDisburseLoanRepository_Expecter) TransitionLoanStatus(ctx interface{}, transition interface{}) *MockDisburseLoanRepository_TransitionLoanStatus_Call {
+	return &MockDisburseLoanRepository_TransitionLoanStatus_Call{Call: _e.mock.On("TransitionLoanStatus", ctx, transition)}
+}
+
+func (_c *MockDisburseLoanRepository_TransitionLoanStatus_Call) Run(run func(ctx context.Context, transition entity.LoanStatusTransition)) *MockDisburseLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanStatusTransition))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_TransitionLoanStatus_Call) Return(_a0 error) *MockDisburseLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_TransitionLoanStatus_Call) RunAndReturn(run func(context.Context, entity.LoanStatusTransition) error) *MockDisburseLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDisbursement provides a mock function with given fields: ctx, disbursement, from
+func (_m *MockDisburseLoanRepository) UpdateDisbursement(ctx context.Context, disbursement entity.Disbursement, from entity.DisbursementStatus) error {
+	ret := _m.Called(ctx, disbursement, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDisbursement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Disbursement, entity.DisbursementStatus) error); ok {
+		r0 = rf(ctx, disbursement, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisburseLoanRepository_UpdateDisbursement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDisbursement'
+type MockDisburseLoanRepository_UpdateDisbursement_Call struct {
+	*mock.Call
+}
+
+// UpdateDisbursement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disbursement entity.Disbursement
+//   - from entity.DisbursementStatus
+func (_e *MockDisburseLoanRepository_Expecter) UpdateDisbursement(ctx interface{}, disbursement interface{}, from interface{}) *MockDisburseLoanRepository_UpdateDisbursement_Call {
+	return &MockDisburseLoanRepository_UpdateDisbursement_Call{Call: _e.mock.On("UpdateDisbursement", ctx, disbursement, from)}
+}
+
+func (_c *MockDisburseLoanRepository_UpdateDisbursement_Call) Run(run func(ctx context.Context, disbursement entity.Disbursement, from entity.DisbursementStatus)) *MockDisburseLoanRepository_UpdateDisbursement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Disbursement), args[2].(entity.DisbursementStatus))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_UpdateDisbursement_Call) Return(_a0 error) *MockDisburseLoanRepository_UpdateDisbursement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_UpdateDisbursement_Call) RunAndReturn(run func(context.Context, entity.Disbursement, entity.DisbursementStatus) error) *MockDisburseLoanRepository_UpdateDisbursement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDisburseLoanRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisburseLoanRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockDisburseLoanRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockDisburseLoanRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockDisburseLoanRepository_WithinTransaction_Call {
+	return &MockDisburseLoanRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockDisburseLoanRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockDisburseLoanRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_WithinTransaction_Call) Return(_a0 error) *MockDisburseLoanRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisburseLoanRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockDisburseLoanRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDisburseLoanRepository creates a new instance of MockDisburseLoanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDisburseLoanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDisburseLoanRepository {
+	mock := &MockDisburseLoanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDisburseLoanUsecase is an autogenerated mock type for the DisburseLoanUsecase type
+type MockDisburseLoanUsecase struct {
+	mock.Mock
+}
+
+type MockDisburseLoanUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDisburseLoanUsecase) EXPECT() *MockDisburseLoanUsecase_Expecter {
+	return &MockDisburseLoanUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockDisburseLoanUsecase) Execute(ctx context.Context, input usecases.DisburseLoanInput) (usecases.DisbursementOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.DisbursementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DisburseLoanInput) (usecases.DisbursementOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DisburseLoanInput) usecases.DisbursementOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.DisbursementOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.DisburseLoanInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisburseLoanUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDisburseLoanUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.DisburseLoanInput
+func (_e *MockDisburseLoanUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockDisburseLoanUsecase_Execute_Call {
+	return &MockDisburseLoanUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockDisburseLoanUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.DisburseLoanInput)) *MockDisburseLoanUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.DisburseLoanInput))
+	})
+	return _c
+}
+
+func (_c *MockDisburseLoanUsecase_Execute_Call) Return(_a0 usecases.DisbursementOutput, _a1 error) *MockDisburseLoanUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisburseLoanUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.DisburseLoanInput) (usecases.DisbursementOutput, error)) *MockDisburseLoanUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDisburseLoanUsecase creates a new instance of MockDisburseLoanUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDisburseLoanUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDisburseLoanUsecase {
+	mock := &MockDisburseLoanUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,621 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDisbursementRepository is an autogenerated mock type for the DisbursementRepository type
+type MockDisbursementRepository struct {
+	mock.Mock
+}
+
+type MockDisbursementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDisbursementRepository) EXPECT() *MockDisbursementRepository_Expecter {
+	return &MockDisbursementRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateInstallmentFromLoan provides a mock function with given fields: ctx, loan, fees
+func (_m *MockDisbursementRepository) CreateInstallmentFromLoan(ctx context.Context, loan *entity.Loan, fees []entity.LoanFee) (bool, error) {
+	ret := _m.Called(ctx, loan, fees)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstallmentFromLoan")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Loan, []entity.LoanFee) (bool, error)); ok {
+		return rf(ctx, loan, fees)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Loan, []entity.LoanFee) bool); ok {
+		r0 = rf(ctx, loan, fees)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Loan, []entity.LoanFee) error); ok {
+		r1 = rf(ctx, loan, fees)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisbursementRepository_CreateInstallmentFromLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstallmentFromLoan'
+type MockDisbursementRepository_CreateInstallmentFromLoan_Call struct {
+	*mock.Call
+}
+
+// CreateInstallmentFromLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loan *entity.Loan
+//   - fees []entity.LoanFee
+func (_e *MockDisbursementRepository_Expecter) CreateInstallmentFromLoan(ctx interface{}, loan interface{}, fees interface{}) *MockDisbursementRepository_CreateInstallmentFromLoan_Call {
+	return &MockDisbursementRepository_CreateInstallmentFromLoan_Call{Call: _e.mock.On("CreateInstallmentFromLoan", ctx, loan, fees)}
+}
+
+func (_c *MockDisbursementRepository_CreateInstallmentFromLoan_Call) Run(run func(ctx context.Context, loan *entity.Loan, fees []entity.LoanFee)) *MockDisbursementRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Loan), args[2].([]entity.LoanFee))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_CreateInstallmentFromLoan_Call) Return(_a0 bool, _a1 error) *MockDisbursementRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_CreateInstallmentFromLoan_Call) RunAndReturn(run func(context.Context, *entity.Loan, []entity.LoanFee) (bool, error)) *MockDisbursementRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockDisbursementRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisbursementRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockDisbursementRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockDisbursementRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockDisbursementRepository_CreateJournalEntry_Call {
+	return &MockDisbursementRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockDisbursementRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockDisbursementRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockDisbursementRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockDisbursementRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMerchantSettlementItem provides a mock function with given fields: ctx, item
+func (_m *MockDisbursementRepository) CreateMerchantSettlementItem(ctx context.Context, item entity.MerchantSettlementItem) error {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMerchantSettlementItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchantSettlementItem) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisbursementRepository_CreateMerchantSettlementItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMerchantSettlementItem'
+type MockDisbursementRepository_CreateMerchantSettlementItem_Call struct {
+	*mock.Call
+}
+
+// CreateMerchantSettlementItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item entity.MerchantSettlementItem
+func (_e *MockDisbursementRepository_Expecter) CreateMerchantSettlementItem(ctx interface{}, item interface{}) *MockDisbursementRepository_CreateMerchantSettlementItem_Call {
+	return &MockDisbursementRepository_CreateMerchantSettlementItem_Call{Call: _e.mock.On("CreateMerchantSettlementItem", ctx, item)}
+}
+
+func (_c *MockDisbursementRepository_CreateMerchantSettlementItem_Call) Run(run func(ctx context.Context, item entity.MerchantSettlementItem)) *MockDisbursementRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchantSettlementItem))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_CreateMerchantSettlementItem_Call) Return(_a0 error) *MockDisbursementRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_CreateMerchantSettlementItem_Call) RunAndReturn(run func(context.Context, entity.MerchantSettlementItem) error) *MockDisbursementRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockDisbursementRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisbursementRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockDisbursementRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDisbursementRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockDisbursementRepository_GetLatestClosedPeriod_Call {
+	return &MockDisbursementRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockDisbursementRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockDisbursementRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockDisbursementRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockDisbursementRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockDisbursementRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisbursementRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockDisbursementRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockDisbursementRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockDisbursementRepository_GetLoan_Call {
+	return &MockDisbursementRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockDisbursementRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockDisbursementRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockDisbursementRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockDisbursementRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanFees provides a mock function with given fields: ctx, loanID
+func (_m *MockDisbursementRepository) GetLoanFees(ctx context.Context, loanID uint64) ([]entity.LoanFee, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanFees")
+	}
+
+	var r0 []entity.LoanFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanFee, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanFee); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisbursementRepository_GetLoanFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanFees'
+type MockDisbursementRepository_GetLoanFees_Call struct {
+	*mock.Call
+}
+
+// GetLoanFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockDisbursementRepository_Expecter) GetLoanFees(ctx interface{}, loanID interface{}) *MockDisbursementRepository_GetLoanFees_Call {
+	return &MockDisbursementRepository_GetLoanFees_Call{Call: _e.mock.On("GetLoanFees", ctx, loanID)}
+}
+
+func (_c *MockDisbursementRepository_GetLoanFees_Call) Run(run func(ctx context.Context, loanID uint64)) *MockDisbursementRepository_GetLoanFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_GetLoanFees_Call) Return(_a0 []entity.LoanFee, _a1 error) *MockDisbursementRepository_GetLoanFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_GetLoanFees_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanFee, error)) *MockDisbursementRepository_GetLoanFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchant provides a mock function with given fields: ctx, merchantID
+func (_m *MockDisbursementRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Merchant, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Merchant); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDisbursementRepository_GetMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchant'
+type MockDisbursementRepository_GetMerchant_Call struct {
+	*mock.Call
+}
+
+// GetMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockDisbursementRepository_Expecter) GetMerchant(ctx interface{}, merchantID interface{}) *MockDisbursementRepository_GetMerchant_Call {
+	return &MockDisbursementRepository_GetMerchant_Call{Call: _e.mock.On("GetMerchant", ctx, merchantID)}
+}
+
+func (_c *MockDisbursementRepository_GetMerchant_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockDisbursementRepository_GetMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_GetMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockDisbursementRepository_GetMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_GetMerchant_Call) RunAndReturn(run func(context.Context, uint64) (entity.Merchant, error)) *MockDisbursementRepository_GetMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLoanStartDate provides a mock function with given fields: ctx, loanID, startDate
+func (_m *MockDisbursementRepository) SetLoanStartDate(ctx context.Context, loanID uint64, startDate time.Time) error {
+	ret := _m.Called(ctx, loanID, startDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLoanStartDate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) error); ok {
+		r0 = rf(ctx, loanID, startDate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisbursementRepository_SetLoanStartDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLoanStartDate'
+type MockDisbursementRepository_SetLoanStartDate_Call struct {
+	*mock.Call
+}
+
+// SetLoanStartDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - startDate time.Time
+func (_e *MockDisbursementRepository_Expecter) SetLoanStartDate(ctx interface{}, loanID interface{}, startDate interface{}) *MockDisbursementRepository_SetLoanStartDate_Call {
+	return &MockDisbursementRepository_SetLoanStartDate_Call{Call: _e.mock.On("SetLoanStartDate", ctx, loanID, startDate)}
+}
+
+func (_c *MockDisbursementRepository_SetLoanStartDate_Call) Run(run func(ctx context.Context, loanID uint64, startDate time.Time)) *MockDisbursementRepository_SetLoanStartDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_SetLoanStartDate_Call) Return(_a0 error) *MockDisbursementRepository_SetLoanStartDate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_SetLoanStartDate_Call) RunAndReturn(run func(context.Context, uint64, time.Time) error) *MockDisbursementRepository_SetLoanStartDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionLoanStatus provides a mock function with given fields: ctx, transition
+func (_m *MockDisbursementRepository) TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error {
+	ret := _m.Called(ctx, transition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionLoanStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanStatusTransition) error); ok {
+		r0 = rf(ctx, transition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisbursementRepository_TransitionLoanStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionLoanStatus'
+type MockDisbursementRepository_TransitionLoanStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionLoanStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transition entity.LoanStatusTransition
+func (_e *MockDisbursementRepository_Expecter) TransitionLoanStatus(ctx interface{}, transition interface{}) *MockDisbursementRepository_TransitionLoanStatus_Call {
+	return &MockDisbursementRepository_TransitionLoanStatus_Call{Call: _e.mock.On("TransitionLoanStatus", ctx, transition)}
+}
+
+func (_c *MockDisbursementRepository_TransitionLoanStatus_Call) Run(run func(ctx context.Context, transition entity.LoanStatusTransition)) *MockDisbursementRepository_TransitionLoanStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanStatusTransition))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_TransitionLoanStatus_Call) Return(_a0 error) *MockDisbursementRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_TransitionLoanStatus_Call) RunAndReturn(run func(context.Context, entity.LoanStatusTransition) error) *MockDisbursementRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDisbursement provides a mock function with given fields: ctx, disbursement, from
+func (_m *MockDisbursementRepository) UpdateDisbursement(ctx context.Context, disbursement entity.Disbursement, from entity.DisbursementStatus) error {
+	ret := _m.Called(ctx, disbursement, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDisbursement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Disbursement, entity.DisbursementStatus) error); ok {
+		r0 = rf(ctx, disbursement, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisbursementRepository_UpdateDisbursement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDisbursement'
+type MockDisbursementRepository_UpdateDisbursement_Call struct {
+	*mock.Call
+}
+
+// UpdateDisbursement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disbursement entity.Disbursement
+//   - from entity.DisbursementStatus
+func (_e *MockDisbursementRepository_Expecter) UpdateDisbursement(ctx interface{}, disbursement interface{}, from interface{}) *MockDisbursementRepository_UpdateDisbursement_Call {
+	return &MockDisbursementRepository_UpdateDisbursement_Call{Call: _e.mock.On("UpdateDisbursement", ctx, disbursement, from)}
+}
+
+func (_c *MockDisbursementRepository_UpdateDisbursement_Call) Run(run func(ctx context.Context, disbursement entity.Disbursement, from entity.DisbursementStatus)) *MockDisbursementRepository_UpdateDisbursement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Disbursement), args[2].(entity.DisbursementStatus))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_UpdateDisbursement_Call) Return(_a0 error) *MockDisbursementRepository_UpdateDisbursement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_UpdateDisbursement_Call) RunAndReturn(run func(context.Context, entity.Disbursement, entity.DisbursementStatus) error) *MockDisbursementRepository_UpdateDisbursement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDisbursementRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDisbursementRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockDisbursementRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockDisbursementRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockDisbursementRepository_WithinTransaction_Call {
+	return &MockDisbursementRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockDisbursementRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockDisbursementRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockDisbursementRepository_WithinTransaction_Call) Return(_a0 error) *MockDisbursementRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDisbursementRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockDisbursementRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDisbursementRepository creates a new instance of MockDisbursementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDisbursementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDisbursementRepository {
+	mock := &MockDisbursementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDismissCustomerDuplicateRepository is an autogenerated mock type for the DismissCustomerDuplicateRepository type
+type MockDismissCustomerDuplicateRepository struct {
+	mock.Mock
+}
+
+type MockDismissCustomerDuplicateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDismissCustomerDuplicateRepository) EXPECT() *MockDismissCustomerDuplicateRepository_Expecter {
+	return &MockDismissCustomerDuplicateRepository_Expecter{mock: &_m.Mock}
+}
+
+// ResolveCustomerDuplicate provides a mock function with given fields: ctx, duplicateID, status, resolvedBy, resolvedAt
+func (_m *MockDismissCustomerDuplicateRepository) ResolveCustomerDuplicate(ctx context.Context, duplicateID uint64, status entity.DuplicateStatus, resolvedBy string, resolvedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, duplicateID, status, resolvedBy, resolvedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveCustomerDuplicate")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.DuplicateStatus, string, time.Time) (bool, error)); ok {
+		return rf(ctx, duplicateID, status, resolvedBy, resolvedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.DuplicateStatus, string, time.Time) bool); ok {
+		r0 = rf(ctx, duplicateID, status, resolvedBy, resolvedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entity.DuplicateStatus, string, time.Time) error); ok {
+		r1 = rf(ctx, duplicateID, status, resolvedBy, resolvedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDismissCustomerDuplicateRepository_ResolveCustomerDuplicate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveCustomerDuplicate'
+type MockDismissCustomerDuplicateRepository_ResolveCustomerDuplicate_Call struct {
+	*mock.Call
+}
+
+// ResolveCustomerDuplicate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - duplicateID uint64
+//   - status entity.DuplicateStatus
+//   - resolvedBy string
+//   - resolvedAt time.Time
+func (_e *MockDismissCustomerDuplicateRepository_Expecter) ResolveCustomerDuplicate(ctx interface{}, duplicateID interface{}, status interface{}, resolvedBy interface{}, resolvedAt interface{}) *MockDismissCustomerDuplicateRepository_ResolveCustomerDuplicate_Call {
+	return &MockDismissCustomerDuplicateRepository_ResolveCustomerDuplicate_Call{Call: _e.mock.On("ResolveCustomerDuplicate", ctx, duplicateID, status, resolvedBy, resolvedAt)}
+}
+
+func (_c *MockDismissCustomerDuplicateRepository_ResolveCustomerDuplicate_Call) Run(run func(ctx context.Context, duplicateID uint64, status entity.DuplicateStatus, resolvedBy string, resolvedAt time.Time)) *MockDismissCustomerDuplicateRepository_ResolveCustomerDuplicate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entity.DuplicateStatus), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDismissCustomerDuplicateRepository_ResolveCustomerDuplicate_Call) Return(_a0 bool, _a1 error) *MockDismissCustomerDuplicateRepository_ResolveCustomerDuplicate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDismissCustomerDuplicateRepository_ResolveCustomerDuplicate_Call) RunAndReturn(run func(context.Context, uint64, entity.DuplicateStatus, string, time.Time) (bool, error)) *MockDismissCustomerDuplicateRepository_ResolveCustomerDuplicate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDismissCustomerDuplicateRepository creates a new instance of MockDismissCustomerDuplicateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDismissCustomerDuplicateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDismissCustomerDuplicateRepository {
+	mock := &MockDismissCustomerDuplicateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
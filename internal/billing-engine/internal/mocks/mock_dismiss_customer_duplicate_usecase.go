@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDismissCustomerDuplicateUsecase is an autogenerated mock type for the DismissCustomerDuplicateUsecase type
+type MockDismissCustomerDuplicateUsecase struct {
+	mock.Mock
+}
+
+type MockDismissCustomerDuplicateUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDismissCustomerDuplicateUsecase) EXPECT() *MockDismissCustomerDuplicateUsecase_Expecter {
+	return &MockDismissCustomerDuplicateUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockDismissCustomerDuplicateUsecase) Execute(ctx context.Context, input usecases.DismissCustomerDuplicateInput) (usecases.DismissCustomerDuplicateOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.DismissCustomerDuplicateOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DismissCustomerDuplicateInput) (usecases.DismissCustomerDuplicateOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DismissCustomerDuplicateInput) usecases.DismissCustomerDuplicateOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.DismissCustomerDuplicateOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.DismissCustomerDuplicateInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDismissCustomerDuplicateUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDismissCustomerDuplicateUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.DismissCustomerDuplicateInput
+func (_e *MockDismissCustomerDuplicateUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockDismissCustomerDuplicateUsecase_Execute_Call {
+	return &MockDismissCustomerDuplicateUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockDismissCustomerDuplicateUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.DismissCustomerDuplicateInput)) *MockDismissCustomerDuplicateUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.DismissCustomerDuplicateInput))
+	})
+	return _c
+}
+
+func (_c *MockDismissCustomerDuplicateUsecase_Execute_Call) Return(_a0 usecases.DismissCustomerDuplicateOutput, _a1 error) *MockDismissCustomerDuplicateUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDismissCustomerDuplicateUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.DismissCustomerDuplicateInput) (usecases.DismissCustomerDuplicateOutput, error)) *MockDismissCustomerDuplicateUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDismissCustomerDuplicateUsecase creates a new instance of MockDismissCustomerDuplicateUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDismissCustomerDuplicateUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDismissCustomerDuplicateUsecase {
+	mock := &MockDismissCustomerDuplicateUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,433 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDispatchNotificationsRepository is an autogenerated mock type for the DispatchNotificationsRepository type
+type MockDispatchNotificationsRepository struct {
+	mock.Mock
+}
+
+type MockDispatchNotificationsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDispatchNotificationsRepository) EXPECT() *MockDispatchNotificationsRepository_Expecter {
+	return &MockDispatchNotificationsRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateCommunication provides a mock function with given fields: ctx, communication
+func (_m *MockDispatchNotificationsRepository) CreateCommunication(ctx context.Context, communication entity.Communication) (entity.Communication, error) {
+	ret := _m.Called(ctx, communication)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCommunication")
+	}
+
+	var r0 entity.Communication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Communication) (entity.Communication, error)); ok {
+		return rf(ctx, communication)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Communication) entity.Communication); ok {
+		r0 = rf(ctx, communication)
+	} else {
+		r0 = ret.Get(0).(entity.Communication)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Communication) error); ok {
+		r1 = rf(ctx, communication)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDispatchNotificationsRepository_CreateCommunication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCommunication'
+type MockDispatchNotificationsRepository_CreateCommunication_Call struct {
+	*mock.Call
+}
+
+// CreateCommunication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - communication entity.Communication
+func (_e *MockDispatchNotificationsRepository_Expecter) CreateCommunication(ctx interface{}, communication interface{}) *MockDispatchNotificationsRepository_CreateCommunication_Call {
+	return &MockDispatchNotificationsRepository_CreateCommunication_Call{Call: _e.mock.On("CreateCommunication", ctx, communication)}
+}
+
+func (_c *MockDispatchNotificationsRepository_CreateCommunication_Call) Run(run func(ctx context.Context, communication entity.Communication)) *MockDispatchNotificationsRepository_CreateCommunication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Communication))
+	})
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_CreateCommunication_Call) Return(_a0 entity.Communication, _a1 error) *MockDispatchNotificationsRepository_CreateCommunication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_CreateCommunication_Call) RunAndReturn(run func(context.Context, entity.Communication) (entity.Communication, error)) *MockDispatchNotificationsRepository_CreateCommunication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomer provides a mock function with given fields: ctx, customerID
+func (_m *MockDispatchNotificationsRepository) GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomer")
+	}
+
+	var r0 entity.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Customer, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Customer); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.Customer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDispatchNotificationsRepository_GetCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomer'
+type MockDispatchNotificationsRepository_GetCustomer_Call struct {
+	*mock.Call
+}
+
+// GetCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockDispatchNotificationsRepository_Expecter) GetCustomer(ctx interface{}, customerID interface{}) *MockDispatchNotificationsRepository_GetCustomer_Call {
+	return &MockDispatchNotificationsRepository_GetCustomer_Call{Call: _e.mock.On("GetCustomer", ctx, customerID)}
+}
+
+func (_c *MockDispatchNotificationsRepository_GetCustomer_Call) Run(run func(ctx context.Context, customerID uint64)) *MockDispatchNotificationsRepository_GetCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_GetCustomer_Call) Return(_a0 entity.Customer, _a1 error) *MockDispatchNotificationsRepository_GetCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_GetCustomer_Call) RunAndReturn(run func(context.Context, uint64) (entity.Customer, error)) *MockDispatchNotificationsRepository_GetCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelinquentLoans provides a mock function with given fields: ctx
+func (_m *MockDispatchNotificationsRepository) GetDelinquentLoans(ctx context.Context) ([]entity.DelinquentLoan, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelinquentLoans")
+	}
+
+	var r0 []entity.DelinquentLoan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.DelinquentLoan, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.DelinquentLoan); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.DelinquentLoan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDispatchNotificationsRepository_GetDelinquentLoans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelinquentLoans'
+type MockDispatchNotificationsRepository_GetDelinquentLoans_Call struct {
+	*mock.Call
+}
+
+// GetDelinquentLoans is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDispatchNotificationsRepository_Expecter) GetDelinquentLoans(ctx interface{}) *MockDispatchNotificationsRepository_GetDelinquentLoans_Call {
+	return &MockDispatchNotificationsRepository_GetDelinquentLoans_Call{Call: _e.mock.On("GetDelinquentLoans", ctx)}
+}
+
+func (_c *MockDispatchNotificationsRepository_GetDelinquentLoans_Call) Run(run func(ctx context.Context)) *MockDispatchNotificationsRepository_GetDelinquentLoans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_GetDelinquentLoans_Call) Return(_a0 []entity.DelinquentLoan, _a1 error) *MockDispatchNotificationsRepository_GetDelinquentLoans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_GetDelinquentLoans_Call) RunAndReturn(run func(context.Context) ([]entity.DelinquentLoan, error)) *MockDispatchNotificationsRepository_GetDelinquentLoans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMissedInstallmentTargets provides a mock function with given fields: ctx
+func (_m *MockDispatchNotificationsRepository) GetMissedInstallmentTargets(ctx context.Context) ([]entity.NotificationTarget, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMissedInstallmentTargets")
+	}
+
+	var r0 []entity.NotificationTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.NotificationTarget, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.NotificationTarget); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.NotificationTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDispatchNotificationsRepository_GetMissedInstallmentTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMissedInstallmentTargets'
+type MockDispatchNotificationsRepository_GetMissedInstallmentTargets_Call struct {
+	*mock.Call
+}
+
+// GetMissedInstallmentTargets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDispatchNotificationsRepository_Expecter) GetMissedInstallmentTargets(ctx interface{}) *MockDispatchNotificationsRepository_GetMissedInstallmentTargets_Call {
+	return &MockDispatchNotificationsRepository_GetMissedInstallmentTargets_Call{Call: _e.mock.On("GetMissedInstallmentTargets", ctx)}
+}
+
+func (_c *MockDispatchNotificationsRepository_GetMissedInstallmentTargets_Call) Run(run func(ctx context.Context)) *MockDispatchNotificationsRepository_GetMissedInstallmentTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_GetMissedInstallmentTargets_Call) Return(_a0 []entity.NotificationTarget, _a1 error) *MockDispatchNotificationsRepository_GetMissedInstallmentTargets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_GetMissedInstallmentTargets_Call) RunAndReturn(run func(context.Context) ([]entity.NotificationTarget, error)) *MockDispatchNotificationsRepository_GetMissedInstallmentTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingInstallmentTargetsDueOn provides a mock function with given fields: ctx, dueDate
+func (_m *MockDispatchNotificationsRepository) GetPendingInstallmentTargetsDueOn(ctx context.Context, dueDate time.Time) ([]entity.NotificationTarget, error) {
+	ret := _m.Called(ctx, dueDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingInstallmentTargetsDueOn")
+	}
+
+	var r0 []entity.NotificationTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.NotificationTarget, error)); ok {
+		return rf(ctx, dueDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.NotificationTarget); ok {
+		r0 = rf(ctx, dueDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.NotificationTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, dueDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDispatchNotificationsRepository_GetPendingInstallmentTargetsDueOn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingInstallmentTargetsDueOn'
+type MockDispatchNotificationsRepository_GetPendingInstallmentTargetsDueOn_Call struct {
+	*mock.Call
+}
+
+// GetPendingInstallmentTargetsDueOn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dueDate time.Time
+func (_e *MockDispatchNotificationsRepository_Expecter) GetPendingInstallmentTargetsDueOn(ctx interface{}, dueDate interface{}) *MockDispatchNotificationsRepository_GetPendingInstallmentTargetsDueOn_Call {
+	return &MockDispatchNotificationsRepository_GetPendingInstallmentTargetsDueOn_Call{Call: _e.mock.On("GetPendingInstallmentTargetsDueOn", ctx, dueDate)}
+}
+
+func (_c *MockDispatchNotificationsRepository_GetPendingInstallmentTargetsDueOn_Call) Run(run func(ctx context.Context, dueDate time.Time)) *MockDispatchNotificationsRepository_GetPendingInstallmentTargetsDueOn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_GetPendingInstallmentTargetsDueOn_Call) Return(_a0 []entity.NotificationTarget, _a1 error) *MockDispatchNotificationsRepository_GetPendingInstallmentTargetsDueOn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_GetPendingInstallmentTargetsDueOn_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.NotificationTarget, error)) *MockDispatchNotificationsRepository_GetPendingInstallmentTargetsDueOn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCommunicationSent provides a mock function with given fields: ctx, eventKey
+func (_m *MockDispatchNotificationsRepository) IsCommunicationSent(ctx context.Context, eventKey string) (bool, error) {
+	ret := _m.Called(ctx, eventKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCommunicationSent")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, eventKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, eventKey)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDispatchNotificationsRepository_IsCommunicationSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCommunicationSent'
+type MockDispatchNotificationsRepository_IsCommunicationSent_Call struct {
+	*mock.Call
+}
+
+// IsCommunicationSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventKey string
+func (_e *MockDispatchNotificationsRepository_Expecter) IsCommunicationSent(ctx interface{}, eventKey interface{}) *MockDispatchNotificationsRepository_IsCommunicationSent_Call {
+	return &MockDispatchNotificationsRepository_IsCommunicationSent_Call{Call: _e.mock.On("IsCommunicationSent", ctx, eventKey)}
+}
+
+func (_c *MockDispatchNotificationsRepository_IsCommunicationSent_Call) Run(run func(ctx context.Context, eventKey string)) *MockDispatchNotificationsRepository_IsCommunicationSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_IsCommunicationSent_Call) Return(_a0 bool, _a1 error) *MockDispatchNotificationsRepository_IsCommunicationSent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_IsCommunicationSent_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockDispatchNotificationsRepository_IsCommunicationSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkMissedInstallments provides a mock function with given fields: ctx, asOf
+func (_m *MockDispatchNotificationsRepository) MarkMissedInstallments(ctx context.Context, asOf time.Time) error {
+	ret := _m.Called(ctx, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkMissedInstallments")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, asOf)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDispatchNotificationsRepository_MarkMissedInstallments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkMissedInstallments'
+type MockDispatchNotificationsRepository_MarkMissedInstallments_Call struct {
+	*mock.Call
+}
+
+// MarkMissedInstallments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asOf time.Time
+func (_e *MockDispatchNotificationsRepository_Expecter) MarkMissedInstallments(ctx interface{}, asOf interface{}) *MockDispatchNotificationsRepository_MarkMissedInstallments_Call {
+	return &MockDispatchNotificationsRepository_MarkMissedInstallments_Call{Call: _e.mock.On("MarkMissedInstallments", ctx, asOf)}
+}
+
+func (_c *MockDispatchNotificationsRepository_MarkMissedInstallments_Call) Run(run func(ctx context.Context, asOf time.Time)) *MockDispatchNotificationsRepository_MarkMissedInstallments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_MarkMissedInstallments_Call) Return(_a0 error) *MockDispatchNotificationsRepository_MarkMissedInstallments_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDispatchNotificationsRepository_MarkMissedInstallments_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockDispatchNotificationsRepository_MarkMissedInstallments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDispatchNotificationsRepository creates a new instance of MockDispatchNotificationsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDispatchNotificationsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDispatchNotificationsRepository {
+	mock := &MockDispatchNotificationsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
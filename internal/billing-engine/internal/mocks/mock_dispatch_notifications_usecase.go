@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDispatchNotificationsUsecase is an autogenerated mock type for the DispatchNotificationsUsecase type
+type MockDispatchNotificationsUsecase struct {
+	mock.Mock
+}
+
+type MockDispatchNotificationsUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDispatchNotificationsUsecase) EXPECT() *MockDispatchNotificationsUsecase_Expecter {
+	return &MockDispatchNotificationsUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockDispatchNotificationsUsecase) Execute(ctx context.Context, input usecases.DispatchNotificationsInput) (usecases.DispatchNotificationsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.DispatchNotificationsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DispatchNotificationsInput) (usecases.DispatchNotificationsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DispatchNotificationsInput) usecases.DispatchNotificationsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.DispatchNotificationsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.DispatchNotificationsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDispatchNotificationsUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDispatchNotificationsUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.DispatchNotificationsInput
+func (_e *MockDispatchNotificationsUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockDispatchNotificationsUsecase_Execute_Call {
+	return &MockDispatchNotificationsUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockDispatchNotificationsUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.DispatchNotificationsInput)) *MockDispatchNotificationsUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.DispatchNotificationsInput))
+	})
+	return _c
+}
+
+func (_c *MockDispatchNotificationsUsecase_Execute_Call) Return(_a0 usecases.DispatchNotificationsOutput, _a1 error) *MockDispatchNotificationsUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDispatchNotificationsUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.DispatchNotificationsInput) (usecases.DispatchNotificationsOutput, error)) *MockDispatchNotificationsUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDispatchNotificationsUsecase creates a new instance of MockDispatchNotificationsUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDispatchNotificationsUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDispatchNotificationsUsecase {
+	mock := &MockDispatchNotificationsUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
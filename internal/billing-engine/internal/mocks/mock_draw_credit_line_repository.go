@@ -0,0 +1,763 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	decimal "github.com/shopspring/decimal"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDrawCreditLineRepository is an autogenerated mock type for the DrawCreditLineRepository type
+type MockDrawCreditLineRepository struct {
+	mock.Mock
+}
+
+type MockDrawCreditLineRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDrawCreditLineRepository) EXPECT() *MockDrawCreditLineRepository_Expecter {
+	return &MockDrawCreditLineRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateLoanApplication provides a mock function with given fields: ctx, loan
+func (_m *MockDrawCreditLineRepository) CreateLoanApplication(ctx context.Context, loan entity.Loan) (entity.Loan, error) {
+	ret := _m.Called(ctx, loan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoanApplication")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Loan) (entity.Loan, error)); ok {
+		return rf(ctx, loan)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Loan) entity.Loan); ok {
+		r0 = rf(ctx, loan)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Loan) error); ok {
+		r1 = rf(ctx, loan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_CreateLoanApplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoanApplication'
+type MockDrawCreditLineRepository_CreateLoanApplication_Call struct {
+	*mock.Call
+}
+
+// CreateLoanApplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loan entity.Loan
+func (_e *MockDrawCreditLineRepository_Expecter) CreateLoanApplication(ctx interface{}, loan interface{}) *MockDrawCreditLineRepository_CreateLoanApplication_Call {
+	return &MockDrawCreditLineRepository_CreateLoanApplication_Call{Call: _e.mock.On("CreateLoanApplication", ctx, loan)}
+}
+
+func (_c *MockDrawCreditLineRepository_CreateLoanApplication_Call) Run(run func(ctx context.Context, loan entity.Loan)) *MockDrawCreditLineRepository_CreateLoanApplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Loan))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_CreateLoanApplication_Call) Return(_a0 entity.Loan, _a1 error) *MockDrawCreditLineRepository_CreateLoanApplication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_CreateLoanApplication_Call) RunAndReturn(run func(context.Context, entity.Loan) (entity.Loan, error)) *MockDrawCreditLineRepository_CreateLoanApplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLoanDisclosure provides a mock function with given fields: ctx, disclosure
+func (_m *MockDrawCreditLineRepository) CreateLoanDisclosure(ctx context.Context, disclosure entity.LoanDisclosure) error {
+	ret := _m.Called(ctx, disclosure)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoanDisclosure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanDisclosure) error); ok {
+		r0 = rf(ctx, disclosure)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDrawCreditLineRepository_CreateLoanDisclosure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoanDisclosure'
+type MockDrawCreditLineRepository_CreateLoanDisclosure_Call struct {
+	*mock.Call
+}
+
+// CreateLoanDisclosure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disclosure entity.LoanDisclosure
+func (_e *MockDrawCreditLineRepository_Expecter) CreateLoanDisclosure(ctx interface{}, disclosure interface{}) *MockDrawCreditLineRepository_CreateLoanDisclosure_Call {
+	return &MockDrawCreditLineRepository_CreateLoanDisclosure_Call{Call: _e.mock.On("CreateLoanDisclosure", ctx, disclosure)}
+}
+
+func (_c *MockDrawCreditLineRepository_CreateLoanDisclosure_Call) Run(run func(ctx context.Context, disclosure entity.LoanDisclosure)) *MockDrawCreditLineRepository_CreateLoanDisclosure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanDisclosure))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_CreateLoanDisclosure_Call) Return(_a0 error) *MockDrawCreditLineRepository_CreateLoanDisclosure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_CreateLoanDisclosure_Call) RunAndReturn(run func(context.Context, entity.LoanDisclosure) error) *MockDrawCreditLineRepository_CreateLoanDisclosure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLimit provides a mock function with given fields: ctx, customerID
+func (_m *MockDrawCreditLineRepository) GetCreditLimit(ctx context.Context, customerID uint64) (entity.CreditLimit, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLimit")
+	}
+
+	var r0 entity.CreditLimit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLimit, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLimit); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLimit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_GetCreditLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLimit'
+type MockDrawCreditLineRepository_GetCreditLimit_Call struct {
+	*mock.Call
+}
+
+// GetCreditLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockDrawCreditLineRepository_Expecter) GetCreditLimit(ctx interface{}, customerID interface{}) *MockDrawCreditLineRepository_GetCreditLimit_Call {
+	return &MockDrawCreditLineRepository_GetCreditLimit_Call{Call: _e.mock.On("GetCreditLimit", ctx, customerID)}
+}
+
+func (_c *MockDrawCreditLineRepository_GetCreditLimit_Call) Run(run func(ctx context.Context, customerID uint64)) *MockDrawCreditLineRepository_GetCreditLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetCreditLimit_Call) Return(_a0 entity.CreditLimit, _a1 error) *MockDrawCreditLineRepository_GetCreditLimit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetCreditLimit_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLimit, error)) *MockDrawCreditLineRepository_GetCreditLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLine provides a mock function with given fields: ctx, creditLineID
+func (_m *MockDrawCreditLineRepository) GetCreditLine(ctx context.Context, creditLineID uint64) (entity.CreditLine, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLine")
+	}
+
+	var r0 entity.CreditLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLine, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLine); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLine)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_GetCreditLine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLine'
+type MockDrawCreditLineRepository_GetCreditLine_Call struct {
+	*mock.Call
+}
+
+// GetCreditLine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockDrawCreditLineRepository_Expecter) GetCreditLine(ctx interface{}, creditLineID interface{}) *MockDrawCreditLineRepository_GetCreditLine_Call {
+	return &MockDrawCreditLineRepository_GetCreditLine_Call{Call: _e.mock.On("GetCreditLine", ctx, creditLineID)}
+}
+
+func (_c *MockDrawCreditLineRepository_GetCreditLine_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockDrawCreditLineRepository_GetCreditLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetCreditLine_Call) Return(_a0 entity.CreditLine, _a1 error) *MockDrawCreditLineRepository_GetCreditLine_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetCreditLine_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLine, error)) *MockDrawCreditLineRepository_GetCreditLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLineUsed provides a mock function with given fields: ctx, creditLineID
+func (_m *MockDrawCreditLineRepository) GetCreditLineUsed(ctx context.Context, creditLineID uint64) (decimal.Decimal, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLineUsed")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (decimal.Decimal, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) decimal.Decimal); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_GetCreditLineUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLineUsed'
+type MockDrawCreditLineRepository_GetCreditLineUsed_Call struct {
+	*mock.Call
+}
+
+// GetCreditLineUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockDrawCreditLineRepository_Expecter) GetCreditLineUsed(ctx interface{}, creditLineID interface{}) *MockDrawCreditLineRepository_GetCreditLineUsed_Call {
+	return &MockDrawCreditLineRepository_GetCreditLineUsed_Call{Call: _e.mock.On("GetCreditLineUsed", ctx, creditLineID)}
+}
+
+func (_c *MockDrawCreditLineRepository_GetCreditLineUsed_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockDrawCreditLineRepository_GetCreditLineUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetCreditLineUsed_Call) Return(_a0 decimal.Decimal, _a1 error) *MockDrawCreditLineRepository_GetCreditLineUsed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetCreditLineUsed_Call) RunAndReturn(run func(context.Context, uint64) (decimal.Decimal, error)) *MockDrawCreditLineRepository_GetCreditLineUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomerExposure provides a mock function with given fields: ctx, customerID
+func (_m *MockDrawCreditLineRepository) GetCustomerExposure(ctx context.Context, customerID uint64) (entity.CustomerExposure, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomerExposure")
+	}
+
+	var r0 entity.CustomerExposure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CustomerExposure, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CustomerExposure); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CustomerExposure)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_GetCustomerExposure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomerExposure'
+type MockDrawCreditLineRepository_GetCustomerExposure_Call struct {
+	*mock.Call
+}
+
+// GetCustomerExposure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockDrawCreditLineRepository_Expecter) GetCustomerExposure(ctx interface{}, customerID interface{}) *MockDrawCreditLineRepository_GetCustomerExposure_Call {
+	return &MockDrawCreditLineRepository_GetCustomerExposure_Call{Call: _e.mock.On("GetCustomerExposure", ctx, customerID)}
+}
+
+func (_c *MockDrawCreditLineRepository_GetCustomerExposure_Call) Run(run func(ctx context.Context, customerID uint64)) *MockDrawCreditLineRepository_GetCustomerExposure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetCustomerExposure_Call) Return(_a0 entity.CustomerExposure, _a1 error) *MockDrawCreditLineRepository_GetCustomerExposure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetCustomerExposure_Call) RunAndReturn(run func(context.Context, uint64) (entity.CustomerExposure, error)) *MockDrawCreditLineRepository_GetCustomerExposure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchant provides a mock function with given fields: ctx, merchantID
+func (_m *MockDrawCreditLineRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Merchant, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Merchant); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_GetMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchant'
+type MockDrawCreditLineRepository_GetMerchant_Call struct {
+	*mock.Call
+}
+
+// GetMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockDrawCreditLineRepository_Expecter) GetMerchant(ctx interface{}, merchantID interface{}) *MockDrawCreditLineRepository_GetMerchant_Call {
+	return &MockDrawCreditLineRepository_GetMerchant_Call{Call: _e.mock.On("GetMerchant", ctx, merchantID)}
+}
+
+func (_c *MockDrawCreditLineRepository_GetMerchant_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockDrawCreditLineRepository_GetMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockDrawCreditLineRepository_GetMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetMerchant_Call) RunAndReturn(run func(context.Context, uint64) (entity.Merchant, error)) *MockDrawCreditLineRepository_GetMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductFees provides a mock function with given fields: ctx, productCode
+func (_m *MockDrawCreditLineRepository) GetProductFees(ctx context.Context, productCode string) ([]entity.ProductFee, error) {
+	ret := _m.Called(ctx, productCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductFees")
+	}
+
+	var r0 []entity.ProductFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.ProductFee, error)); ok {
+		return rf(ctx, productCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.ProductFee); ok {
+		r0 = rf(ctx, productCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProductFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_GetProductFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductFees'
+type MockDrawCreditLineRepository_GetProductFees_Call struct {
+	*mock.Call
+}
+
+// GetProductFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productCode string
+func (_e *MockDrawCreditLineRepository_Expecter) GetProductFees(ctx interface{}, productCode interface{}) *MockDrawCreditLineRepository_GetProductFees_Call {
+	return &MockDrawCreditLineRepository_GetProductFees_Call{Call: _e.mock.On("GetProductFees", ctx, productCode)}
+}
+
+func (_c *MockDrawCreditLineRepository_GetProductFees_Call) Run(run func(ctx context.Context, productCode string)) *MockDrawCreditLineRepository_GetProductFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetProductFees_Call) Return(_a0 []entity.ProductFee, _a1 error) *MockDrawCreditLineRepository_GetProductFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_GetProductFees_Call) RunAndReturn(run func(context.Context, string) ([]entity.ProductFee, error)) *MockDrawCreditLineRepository_GetProductFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCreditLineOverdue provides a mock function with given fields: ctx, creditLineID
+func (_m *MockDrawCreditLineRepository) IsCreditLineOverdue(ctx context.Context, creditLineID uint64) (bool, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCreditLineOverdue")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_IsCreditLineOverdue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCreditLineOverdue'
+type MockDrawCreditLineRepository_IsCreditLineOverdue_Call struct {
+	*mock.Call
+}
+
+// IsCreditLineOverdue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockDrawCreditLineRepository_Expecter) IsCreditLineOverdue(ctx interface{}, creditLineID interface{}) *MockDrawCreditLineRepository_IsCreditLineOverdue_Call {
+	return &MockDrawCreditLineRepository_IsCreditLineOverdue_Call{Call: _e.mock.On("IsCreditLineOverdue", ctx, creditLineID)}
+}
+
+func (_c *MockDrawCreditLineRepository_IsCreditLineOverdue_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockDrawCreditLineRepository_IsCreditLineOverdue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_IsCreditLineOverdue_Call) Return(_a0 bool, _a1 error) *MockDrawCreditLineRepository_IsCreditLineOverdue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_IsCreditLineOverdue_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockDrawCreditLineRepository_IsCreditLineOverdue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCustomerExist provides a mock function with given fields: ctx, customerID
+func (_m *MockDrawCreditLineRepository) IsCustomerExist(ctx context.Context, customerID uint64) (bool, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCustomerExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_IsCustomerExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCustomerExist'
+type MockDrawCreditLineRepository_IsCustomerExist_Call struct {
+	*mock.Call
+}
+
+// IsCustomerExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockDrawCreditLineRepository_Expecter) IsCustomerExist(ctx interface{}, customerID interface{}) *MockDrawCreditLineRepository_IsCustomerExist_Call {
+	return &MockDrawCreditLineRepository_IsCustomerExist_Call{Call: _e.mock.On("IsCustomerExist", ctx, customerID)}
+}
+
+func (_c *MockDrawCreditLineRepository_IsCustomerExist_Call) Run(run func(ctx context.Context, customerID uint64)) *MockDrawCreditLineRepository_IsCustomerExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_IsCustomerExist_Call) Return(_a0 bool, _a1 error) *MockDrawCreditLineRepository_IsCustomerExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_IsCustomerExist_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockDrawCreditLineRepository_IsCustomerExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCustomerHasWrittenOffLoan provides a mock function with given fields: ctx, customerID
+func (_m *MockDrawCreditLineRepository) IsCustomerHasWrittenOffLoan(ctx context.Context, customerID uint64) (bool, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCustomerHasWrittenOffLoan")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_IsCustomerHasWrittenOffLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCustomerHasWrittenOffLoan'
+type MockDrawCreditLineRepository_IsCustomerHasWrittenOffLoan_Call struct {
+	*mock.Call
+}
+
+// IsCustomerHasWrittenOffLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockDrawCreditLineRepository_Expecter) IsCustomerHasWrittenOffLoan(ctx interface{}, customerID interface{}) *MockDrawCreditLineRepository_IsCustomerHasWrittenOffLoan_Call {
+	return &MockDrawCreditLineRepository_IsCustomerHasWrittenOffLoan_Call{Call: _e.mock.On("IsCustomerHasWrittenOffLoan", ctx, customerID)}
+}
+
+func (_c *MockDrawCreditLineRepository_IsCustomerHasWrittenOffLoan_Call) Run(run func(ctx context.Context, customerID uint64)) *MockDrawCreditLineRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_IsCustomerHasWrittenOffLoan_Call) Return(_a0 bool, _a1 error) *MockDrawCreditLineRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_IsCustomerHasWrittenOffLoan_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockDrawCreditLineRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMerchantOrderExist provides a mock function with given fields: ctx, merchantID, orderRef
+func (_m *MockDrawCreditLineRepository) IsMerchantOrderExist(ctx context.Context, merchantID uint64, orderRef string) (bool, error) {
+	ret := _m.Called(ctx, merchantID, orderRef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMerchantOrderExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (bool, error)); ok {
+		return rf(ctx, merchantID, orderRef)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) bool); ok {
+		r0 = rf(ctx, merchantID, orderRef)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, merchantID, orderRef)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineRepository_IsMerchantOrderExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMerchantOrderExist'
+type MockDrawCreditLineRepository_IsMerchantOrderExist_Call struct {
+	*mock.Call
+}
+
+// IsMerchantOrderExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+//   - orderRef string
+func (_e *MockDrawCreditLineRepository_Expecter) IsMerchantOrderExist(ctx interface{}, merchantID interface{}, orderRef interface{}) *MockDrawCreditLineRepository_IsMerchantOrderExist_Call {
+	return &MockDrawCreditLineRepository_IsMerchantOrderExist_Call{Call: _e.mock.On("IsMerchantOrderExist", ctx, merchantID, orderRef)}
+}
+
+func (_c *MockDrawCreditLineRepository_IsMerchantOrderExist_Call) Run(run func(ctx context.Context, merchantID uint64, orderRef string)) *MockDrawCreditLineRepository_IsMerchantOrderExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_IsMerchantOrderExist_Call) Return(_a0 bool, _a1 error) *MockDrawCreditLineRepository_IsMerchantOrderExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_IsMerchantOrderExist_Call) RunAndReturn(run func(context.Context, uint64, string) (bool, error)) *MockDrawCreditLineRepository_IsMerchantOrderExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionLoanStatus provides a mock function with given fields: ctx, transition
+func (_m *MockDrawCreditLineRepository) TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error {
+	ret := _m.Called(ctx, transition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionLoanStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanStatusTransition) error); ok {
+		r0 = rf(ctx, transition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDrawCreditLineRepository_TransitionLoanStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionLoanStatus'
+type MockDrawCreditLineRepository_TransitionLoanStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionLoanStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transition entity.LoanStatusTransition
+func (_e *MockDrawCreditLineRepository_Expecter) TransitionLoanStatus(ctx interface{}, transition interface{}) *MockDrawCreditLineRepository_TransitionLoanStatus_Call {
+	return &MockDrawCreditLineRepository_TransitionLoanStatus_Call{Call: _e.mock.On("TransitionLoanStatus", ctx, transition)}
+}
+
+func (_c *MockDrawCreditLineRepository_TransitionLoanStatus_Call) Run(run func(ctx context.Context, transition entity.LoanStatusTransition)) *MockDrawCreditLineRepository_TransitionLoanStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanStatusTransition))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_TransitionLoanStatus_Call) Return(_a0 error) *MockDrawCreditLineRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDrawCreditLineRepository_TransitionLoanStatus_Call) RunAndReturn(run func(context.Context, entity.LoanStatusTransition) error) *MockDrawCreditLineRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDrawCreditLineRepository creates a new instance of MockDrawCreditLineRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDrawCreditLineRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDrawCreditLineRepository {
+	mock := &MockDrawCreditLineRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
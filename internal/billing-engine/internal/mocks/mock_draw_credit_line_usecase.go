@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDrawCreditLineUsecase is an autogenerated mock type for the DrawCreditLineUsecase type
+type MockDrawCreditLineUsecase struct {
+	mock.Mock
+}
+
+type MockDrawCreditLineUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDrawCreditLineUsecase) EXPECT() *MockDrawCreditLineUsecase_Expecter {
+	return &MockDrawCreditLineUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockDrawCreditLineUsecase) Execute(ctx context.Context, input usecases.DrawCreditLineInput) (usecases.LoanOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.LoanOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DrawCreditLineInput) (usecases.LoanOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DrawCreditLineInput) usecases.LoanOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.LoanOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.DrawCreditLineInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDrawCreditLineUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDrawCreditLineUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.DrawCreditLineInput
+func (_e *MockDrawCreditLineUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockDrawCreditLineUsecase_Execute_Call {
+	return &MockDrawCreditLineUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockDrawCreditLineUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.DrawCreditLineInput)) *MockDrawCreditLineUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.DrawCreditLineInput))
+	})
+	return _c
+}
+
+func (_c *MockDrawCreditLineUsecase_Execute_Call) Return(_a0 usecases.LoanOutput, _a1 error) *MockDrawCreditLineUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDrawCreditLineUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.DrawCreditLineInput) (usecases.LoanOutput, error)) *MockDrawCreditLineUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDrawCreditLineUsecase creates a new instance of MockDrawCreditLineUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDrawCreditLineUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDrawCreditLineUsecase {
+	mock := &MockDrawCreditLineUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
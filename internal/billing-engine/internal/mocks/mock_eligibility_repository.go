@@ -0,0 +1,209 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEligibilityRepository is an autogenerated mock type for the EligibilityRepository type
+type MockEligibilityRepository struct {
+	mock.Mock
+}
+
+type MockEligibilityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEligibilityRepository) EXPECT() *MockEligibilityRepository_Expecter {
+	return &MockEligibilityRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCreditLimit provides a mock function with given fields: ctx, customerID
+func (_m *MockEligibilityRepository) GetCreditLimit(ctx context.Context, customerID uint64) (entity.CreditLimit, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLimit")
+	}
+
+	var r0 entity.CreditLimit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLimit, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLimit); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLimit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEligibilityRepository_GetCreditLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLimit'
+type MockEligibilityRepository_GetCreditLimit_Call struct {
+	*mock.Call
+}
+
+// GetCreditLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockEligibilityRepository_Expecter) GetCreditLimit(ctx interface{}, customerID interface{}) *MockEligibilityRepository_GetCreditLimit_Call {
+	return &MockEligibilityRepository_GetCreditLimit_Call{Call: _e.mock.On("GetCreditLimit", ctx, customerID)}
+}
+
+func (_c *MockEligibilityRepository_GetCreditLimit_Call) Run(run func(ctx context.Context, customerID uint64)) *MockEligibilityRepository_GetCreditLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockEligibilityRepository_GetCreditLimit_Call) Return(_a0 entity.CreditLimit, _a1 error) *MockEligibilityRepository_GetCreditLimit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEligibilityRepository_GetCreditLimit_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLimit, error)) *MockEligibilityRepository_GetCreditLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomerExposure provides a mock function with given fields: ctx, customerID
+func (_m *MockEligibilityRepository) GetCustomerExposure(ctx context.Context, customerID uint64) (entity.CustomerExposure, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomerExposure")
+	}
+
+	var r0 entity.CustomerExposure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CustomerExposure, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CustomerExposure); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CustomerExposure)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEligibilityRepository_GetCustomerExposure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomerExposure'
+type MockEligibilityRepository_GetCustomerExposure_Call struct {
+	*mock.Call
+}
+
+// GetCustomerExposure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockEligibilityRepository_Expecter) GetCustomerExposure(ctx interface{}, customerID interface{}) *MockEligibilityRepository_GetCustomerExposure_Call {
+	return &MockEligibilityRepository_GetCustomerExposure_Call{Call: _e.mock.On("GetCustomerExposure", ctx, customerID)}
+}
+
+func (_c *MockEligibilityRepository_GetCustomerExposure_Call) Run(run func(ctx context.Context, customerID uint64)) *MockEligibilityRepository_GetCustomerExposure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockEligibilityRepository_GetCustomerExposure_Call) Return(_a0 entity.CustomerExposure, _a1 error) *MockEligibilityRepository_GetCustomerExposure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEligibilityRepository_GetCustomerExposure_Call) RunAndReturn(run func(context.Context, uint64) (entity.CustomerExposure, error)) *MockEligibilityRepository_GetCustomerExposure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCustomerHasWrittenOffLoan provides a mock function with given fields: ctx, customerID
+func (_m *MockEligibilityRepository) IsCustomerHasWrittenOffLoan(ctx context.Context, customerID uint64) (bool, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCustomerHasWrittenOffLoan")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEligibilityRepository_IsCustomerHasWrittenOffLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCustomerHasWrittenOffLoan'
+type MockEligibilityRepository_IsCustomerHasWrittenOffLoan_Call struct {
+	*mock.Call
+}
+
+// IsCustomerHasWrittenOffLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockEligibilityRepository_Expecter) IsCustomerHasWrittenOffLoan(ctx interface{}, customerID interface{}) *MockEligibilityRepository_IsCustomerHasWrittenOffLoan_Call {
+	return &MockEligibilityRepository_IsCustomerHasWrittenOffLoan_Call{Call: _e.mock.On("IsCustomerHasWrittenOffLoan", ctx, customerID)}
+}
+
+func (_c *MockEligibilityRepository_IsCustomerHasWrittenOffLoan_Call) Run(run func(ctx context.Context, customerID uint64)) *MockEligibilityRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockEligibilityRepository_IsCustomerHasWrittenOffLoan_Call) Return(_a0 bool, _a1 error) *MockEligibilityRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEligibilityRepository_IsCustomerHasWrittenOffLoan_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockEligibilityRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEligibilityRepository creates a new instance of MockEligibilityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEligibilityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEligibilityRepository {
+	mock := &MockEligibilityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,207 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	decimal "github.com/shopspring/decimal"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEvaluatePromisesToPayRepository is an autogenerated mock type for the EvaluatePromisesToPayRepository type
+type MockEvaluatePromisesToPayRepository struct {
+	mock.Mock
+}
+
+type MockEvaluatePromisesToPayRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEvaluatePromisesToPayRepository) EXPECT() *MockEvaluatePromisesToPayRepository_Expecter {
+	return &MockEvaluatePromisesToPayRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetPendingPromisesToPayDueBefore provides a mock function with given fields: ctx, asOf
+func (_m *MockEvaluatePromisesToPayRepository) GetPendingPromisesToPayDueBefore(ctx context.Context, asOf time.Time) ([]entity.PromiseToPay, error) {
+	ret := _m.Called(ctx, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingPromisesToPayDueBefore")
+	}
+
+	var r0 []entity.PromiseToPay
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.PromiseToPay, error)); ok {
+		return rf(ctx, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.PromiseToPay); ok {
+		r0 = rf(ctx, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PromiseToPay)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEvaluatePromisesToPayRepository_GetPendingPromisesToPayDueBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingPromisesToPayDueBefore'
+type MockEvaluatePromisesToPayRepository_GetPendingPromisesToPayDueBefore_Call struct {
+	*mock.Call
+}
+
+// GetPendingPromisesToPayDueBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asOf time.Time
+func (_e *MockEvaluatePromisesToPayRepository_Expecter) GetPendingPromisesToPayDueBefore(ctx interface{}, asOf interface{}) *MockEvaluatePromisesToPayRepository_GetPendingPromisesToPayDueBefore_Call {
+	return &MockEvaluatePromisesToPayRepository_GetPendingPromisesToPayDueBefore_Call{Call: _e.mock.On("GetPendingPromisesToPayDueBefore", ctx, asOf)}
+}
+
+func (_c *MockEvaluatePromisesToPayRepository_GetPendingPromisesToPayDueBefore_Call) Run(run func(ctx context.Context, asOf time.Time)) *MockEvaluatePromisesToPayRepository_GetPendingPromisesToPayDueBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockEvaluatePromisesToPayRepository_GetPendingPromisesToPayDueBefore_Call) Return(_a0 []entity.PromiseToPay, _a1 error) *MockEvaluatePromisesToPayRepository_GetPendingPromisesToPayDueBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEvaluatePromisesToPayRepository_GetPendingPromisesToPayDueBefore_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.PromiseToPay, error)) *MockEvaluatePromisesToPayRepository_GetPendingPromisesToPayDueBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTotalPaidForLoanBetween provides a mock function with given fields: ctx, loanID, from, to
+func (_m *MockEvaluatePromisesToPayRepository) GetTotalPaidForLoanBetween(ctx context.Context, loanID uint64, from time.Time, to time.Time) (decimal.Decimal, error) {
+	ret := _m.Called(ctx, loanID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalPaidForLoanBetween")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, time.Time) (decimal.Decimal, error)); ok {
+		return rf(ctx, loanID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, time.Time) decimal.Decimal); ok {
+		r0 = rf(ctx, loanID, from, to)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, loanID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEvaluatePromisesToPayRepository_GetTotalPaidForLoanBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotalPaidForLoanBetween'
+type MockEvaluatePromisesToPayRepository_GetTotalPaidForLoanBetween_Call struct {
+	*mock.Call
+}
+
+// GetTotalPaidForLoanBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - from time.Time
+//   - to time.Time
+func (_e *MockEvaluatePromisesToPayRepository_Expecter) GetTotalPaidForLoanBetween(ctx interface{}, loanID interface{}, from interface{}, to interface{}) *MockEvaluatePromisesToPayRepository_GetTotalPaidForLoanBetween_Call {
+	return &MockEvaluatePromisesToPayRepository_GetTotalPaidForLoanBetween_Call{Call: _e.mock.On("GetTotalPaidForLoanBetween", ctx, loanID, from, to)}
+}
+
+func (_c *MockEvaluatePromisesToPayRepository_GetTotalPaidForLoanBetween_Call) Run(run func(ctx context.Context, loanID uint64, from time.Time, to time.Time)) *MockEvaluatePromisesToPayRepository_GetTotalPaidForLoanBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockEvaluatePromisesToPayRepository_GetTotalPaidForLoanBetween_Call) Return(_a0 decimal.Decimal, _a1 error) *MockEvaluatePromisesToPayRepository_GetTotalPaidForLoanBetween_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEvaluatePromisesToPayRepository_GetTotalPaidForLoanBetween_Call) RunAndReturn(run func(context.Context, uint64, time.Time, time.Time) (decimal.Decimal, error)) *MockEvaluatePromisesToPayRepository_GetTotalPaidForLoanBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePromiseToPayStatus provides a mock function with given fields: ctx, promiseID, status
+func (_m *MockEvaluatePromisesToPayRepository) UpdatePromiseToPayStatus(ctx context.Context, promiseID uint64, status entity.PromiseToPayStatus) error {
+	ret := _m.Called(ctx, promiseID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePromiseToPayStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.PromiseToPayStatus) error); ok {
+		r0 = rf(ctx, promiseID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEvaluatePromisesToPayRepository_UpdatePromiseToPayStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePromiseToPayStatus'
+type MockEvaluatePromisesToPayRepository_UpdatePromiseToPayStatus_Call struct {
+	*mock.Call
+}
+
+// UpdatePromiseToPayStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - promiseID uint64
+//   - status entity.PromiseToPayStatus
+func (_e *MockEvaluatePromisesToPayRepository_Expecter) UpdatePromiseToPayStatus(ctx interface{}, promiseID interface{}, status interface{}) *MockEvaluatePromisesToPayRepository_UpdatePromiseToPayStatus_Call {
+	return &MockEvaluatePromisesToPayRepository_UpdatePromiseToPayStatus_Call{Call: _e.mock.On("UpdatePromiseToPayStatus", ctx, promiseID, status)}
+}
+
+func (_c *MockEvaluatePromisesToPayRepository_UpdatePromiseToPayStatus_Call) Run(run func(ctx context.Context, promiseID uint64, status entity.PromiseToPayStatus)) *MockEvaluatePromisesToPayRepository_UpdatePromiseToPayStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entity.PromiseToPayStatus))
+	})
+	return _c
+}
+
+func (_c *MockEvaluatePromisesToPayRepository_UpdatePromiseToPayStatus_Call) Return(_a0 error) *MockEvaluatePromisesToPayRepository_UpdatePromiseToPayStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEvaluatePromisesToPayRepository_UpdatePromiseToPayStatus_Call) RunAndReturn(run func(context.Context, uint64, entity.PromiseToPayStatus) error) *MockEvaluatePromisesToPayRepository_UpdatePromiseToPayStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEvaluatePromisesToPayRepository creates a new instance of MockEvaluatePromisesToPayRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEvaluatePromisesToPayRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEvaluatePromisesToPayRepository {
+	mock := &MockEvaluatePromisesToPayRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEvaluatePromisesToPayUsecase is an autogenerated mock type for the EvaluatePromisesToPayUsecase type
+type MockEvaluatePromisesToPayUsecase struct {
+	mock.Mock
+}
+
+type MockEvaluatePromisesToPayUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEvaluatePromisesToPayUsecase) EXPECT() *MockEvaluatePromisesToPayUsecase_Expecter {
+	return &MockEvaluatePromisesToPayUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockEvaluatePromisesToPayUsecase) Execute(ctx context.Context, input usecases.EvaluatePromisesToPayInput) (usecases.EvaluatePromisesToPayOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.EvaluatePromisesToPayOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.EvaluatePromisesToPayInput) (usecases.EvaluatePromisesToPayOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.EvaluatePromisesToPayInput) usecases.EvaluatePromisesToPayOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.EvaluatePromisesToPayOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.EvaluatePromisesToPayInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEvaluatePromisesToPayUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockEvaluatePromisesToPayUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.EvaluatePromisesToPayInput
+func (_e *MockEvaluatePromisesToPayUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockEvaluatePromisesToPayUsecase_Execute_Call {
+	return &MockEvaluatePromisesToPayUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockEvaluatePromisesToPayUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.EvaluatePromisesToPayInput)) *MockEvaluatePromisesToPayUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.EvaluatePromisesToPayInput))
+	})
+	return _c
+}
+
+func (_c *MockEvaluatePromisesToPayUsecase_Execute_Call) Return(_a0 usecases.EvaluatePromisesToPayOutput, _a1 error) *MockEvaluatePromisesToPayUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEvaluatePromisesToPayUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.EvaluatePromisesToPayInput) (usecases.EvaluatePromisesToPayOutput, error)) *MockEvaluatePromisesToPayUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEvaluatePromisesToPayUsecase creates a new instance of MockEvaluatePromisesToPayUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEvaluatePromisesToPayUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEvaluatePromisesToPayUsecase {
+	mock := &MockEvaluatePromisesToPayUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
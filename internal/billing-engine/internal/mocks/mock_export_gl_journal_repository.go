@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockExportGLJournalRepository is an autogenerated mock type for the ExportGLJournalRepository type
+type MockExportGLJournalRepository struct {
+	mock.Mock
+}
+
+type MockExportGLJournalRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportGLJournalRepository) EXPECT() *MockExportGLJournalRepository_Expecter {
+	return &MockExportGLJournalRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetGLMappings provides a mock function with given fields: ctx
+func (_m *MockExportGLJournalRepository) GetGLMappings(ctx context.Context) ([]entity.GLMapping, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGLMappings")
+	}
+
+	var r0 []entity.GLMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.GLMapping, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.GLMapping); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.GLMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExportGLJournalRepository_GetGLMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGLMappings'
+type MockExportGLJournalRepository_GetGLMappings_Call struct {
+	*mock.Call
+}
+
+// GetGLMappings is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockExportGLJournalRepository_Expecter) GetGLMappings(ctx interface{}) *MockExportGLJournalRepository_GetGLMappings_Call {
+	return &MockExportGLJournalRepository_GetGLMappings_Call{Call: _e.mock.On("GetGLMappings", ctx)}
+}
+
+func (_c *MockExportGLJournalRepository_GetGLMappings_Call) Run(run func(ctx context.Context)) *MockExportGLJournalRepository_GetGLMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockExportGLJournalRepository_GetGLMappings_Call) Return(_a0 []entity.GLMapping, _a1 error) *MockExportGLJournalRepository_GetGLMappings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExportGLJournalRepository_GetGLMappings_Call) RunAndReturn(run func(context.Context) ([]entity.GLMapping, error)) *MockExportGLJournalRepository_GetGLMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJournalEntriesByDate provides a mock function with given fields: ctx, date
+func (_m *MockExportGLJournalRepository) GetJournalEntriesByDate(ctx context.Context, date time.Time) ([]entity.JournalEntry, error) {
+	ret := _m.Called(ctx, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJournalEntriesByDate")
+	}
+
+	var r0 []entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.JournalEntry, error)); ok {
+		return rf(ctx, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.JournalEntry); ok {
+		r0 = rf(ctx, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.JournalEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExportGLJournalRepository_GetJournalEntriesByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJournalEntriesByDate'
+type MockExportGLJournalRepository_GetJournalEntriesByDate_Call struct {
+	*mock.Call
+}
+
+// GetJournalEntriesByDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - date time.Time
+func (_e *MockExportGLJournalRepository_Expecter) GetJournalEntriesByDate(ctx interface{}, date interface{}) *MockExportGLJournalRepository_GetJournalEntriesByDate_Call {
+	return &MockExportGLJournalRepository_GetJournalEntriesByDate_Call{Call: _e.mock.On("GetJournalEntriesByDate", ctx, date)}
+}
+
+func (_c *MockExportGLJournalRepository_GetJournalEntriesByDate_Call) Run(run func(ctx context.Context, date time.Time)) *MockExportGLJournalRepository_GetJournalEntriesByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockExportGLJournalRepository_GetJournalEntriesByDate_Call) Return(_a0 []entity.JournalEntry, _a1 error) *MockExportGLJournalRepository_GetJournalEntriesByDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExportGLJournalRepository_GetJournalEntriesByDate_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.JournalEntry, error)) *MockExportGLJournalRepository_GetJournalEntriesByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportGLJournalRepository creates a new instance of MockExportGLJournalRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportGLJournalRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportGLJournalRepository {
+	mock := &MockExportGLJournalRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
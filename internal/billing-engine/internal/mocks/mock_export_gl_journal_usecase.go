@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockExportGLJournalUsecase is an autogenerated mock type for the ExportGLJournalUsecase type
+type MockExportGLJournalUsecase struct {
+	mock.Mock
+}
+
+type MockExportGLJournalUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportGLJournalUsecase) EXPECT() *MockExportGLJournalUsecase_Expecter {
+	return &MockExportGLJournalUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockExportGLJournalUsecase) Execute(ctx context.Context, input usecases.ExportGLJournalInput) (usecases.ExportGLJournalOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.ExportGLJournalOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ExportGLJournalInput) (usecases.ExportGLJournalOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ExportGLJournalInput) usecases.ExportGLJournalOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.ExportGLJournalOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.ExportGLJournalInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExportGLJournalUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockExportGLJournalUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.ExportGLJournalInput
+func (_e *MockExportGLJournalUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockExportGLJournalUsecase_Execute_Call {
+	return &MockExportGLJournalUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockExportGLJournalUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.ExportGLJournalInput)) *MockExportGLJournalUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.ExportGLJournalInput))
+	})
+	return _c
+}
+
+func (_c *MockExportGLJournalUsecase_Execute_Call) Return(_a0 usecases.ExportGLJournalOutput, _a1 error) *MockExportGLJournalUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExportGLJournalUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.ExportGLJournalInput) (usecases.ExportGLJournalOutput, error)) *MockExportGLJournalUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportGLJournalUsecase creates a new instance of MockExportGLJournalUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportGLJournalUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportGLJournalUsecase {
+	mock := &MockExportGLJournalUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,156 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockExportMerchantSettlementsRepository is an autogenerated mock type for the ExportMerchantSettlementsRepository type
+type MockExportMerchantSettlementsRepository struct {
+	mock.Mock
+}
+
+type MockExportMerchantSettlementsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportMerchantSettlementsRepository) EXPECT() *MockExportMerchantSettlementsRepository_Expecter {
+	return &MockExportMerchantSettlementsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMerchant provides a mock function with given fields: ctx, merchantID
+func (_m *MockExportMerchantSettlementsRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Merchant, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Merchant); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExportMerchantSettlementsRepository_GetMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchant'
+type MockExportMerchantSettlementsRepository_GetMerchant_Call struct {
+	*mock.Call
+}
+
+// GetMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockExportMerchantSettlementsRepository_Expecter) GetMerchant(ctx interface{}, merchantID interface{}) *MockExportMerchantSettlementsRepository_GetMerchant_Call {
+	return &MockExportMerchantSettlementsRepository_GetMerchant_Call{Call: _e.mock.On("GetMerchant", ctx, merchantID)}
+}
+
+func (_c *MockExportMerchantSettlementsRepository_GetMerchant_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockExportMerchantSettlementsRepository_GetMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockExportMerchantSettlementsRepository_GetMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockExportMerchantSettlementsRepository_GetMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExportMerchantSettlementsRepository_GetMerchant_Call) RunAndReturn(run func(context.Context, uint64) (entity.Merchant, error)) *MockExportMerchantSettlementsRepository_GetMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchantSettlementsByDate provides a mock function with given fields: ctx, settlementDate
+func (_m *MockExportMerchantSettlementsRepository) GetMerchantSettlementsByDate(ctx context.Context, settlementDate time.Time) ([]entity.MerchantSettlement, error) {
+	ret := _m.Called(ctx, settlementDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchantSettlementsByDate")
+	}
+
+	var r0 []entity.MerchantSettlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.MerchantSettlement, error)); ok {
+		return rf(ctx, settlementDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.MerchantSettlement); ok {
+		r0 = rf(ctx, settlementDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MerchantSettlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, settlementDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExportMerchantSettlementsRepository_GetMerchantSettlementsByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchantSettlementsByDate'
+type MockExportMerchantSettlementsRepository_GetMerchantSettlementsByDate_Call struct {
+	*mock.Call
+}
+
+// GetMerchantSettlementsByDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settlementDate time.Time
+func (_e *MockExportMerchantSettlementsRepository_Expecter) GetMerchantSettlementsByDate(ctx interface{}, settlementDate interface{}) *MockExportMerchantSettlementsRepository_GetMerchantSettlementsByDate_Call {
+	return &MockExportMerchantSettlementsRepository_GetMerchantSettlementsByDate_Call{Call: _e.mock.On("GetMerchantSettlementsByDate", ctx, settlementDate)}
+}
+
+func (_c *MockExportMerchantSettlementsRepository_GetMerchantSettlementsByDate_Call) Run(run func(ctx context.Context, settlementDate time.Time)) *MockExportMerchantSettlementsRepository_GetMerchantSettlementsByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockExportMerchantSettlementsRepository_GetMerchantSettlementsByDate_Call) Return(_a0 []entity.MerchantSettlement, _a1 error) *MockExportMerchantSettlementsRepository_GetMerchantSettlementsByDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExportMerchantSettlementsRepository_GetMerchantSettlementsByDate_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.MerchantSettlement, error)) *MockExportMerchantSettlementsRepository_GetMerchantSettlementsByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportMerchantSettlementsRepository creates a new instance of MockExportMerchantSettlementsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportMerchantSettlementsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportMerchantSettlementsRepository {
+	mock := &MockExportMerchantSettlementsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
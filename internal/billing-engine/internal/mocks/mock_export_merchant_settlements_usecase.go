@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockExportMerchantSettlementsUsecase is an autogenerated mock type for the ExportMerchantSettlementsUsecase type
+type MockExportMerchantSettlementsUsecase struct {
+	mock.Mock
+}
+
+type MockExportMerchantSettlementsUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportMerchantSettlementsUsecase) EXPECT() *MockExportMerchantSettlementsUsecase_Expecter {
+	return &MockExportMerchantSettlementsUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockExportMerchantSettlementsUsecase) Execute(ctx context.Context, input usecases.ExportMerchantSettlementsInput) (usecases.ExportMerchantSettlementsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.ExportMerchantSettlementsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ExportMerchantSettlementsInput) (usecases.ExportMerchantSettlementsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ExportMerchantSettlementsInput) usecases.ExportMerchantSettlementsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.ExportMerchantSettlementsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.ExportMerchantSettlementsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExportMerchantSettlementsUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockExportMerchantSettlementsUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.ExportMerchantSettlementsInput
+func (_e *MockExportMerchantSettlementsUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockExportMerchantSettlementsUsecase_Execute_Call {
+	return &MockExportMerchantSettlementsUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockExportMerchantSettlementsUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.ExportMerchantSettlementsInput)) *MockExportMerchantSettlementsUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.ExportMerchantSettlementsInput))
+	})
+	return _c
+}
+
+func (_c *MockExportMerchantSettlementsUsecase_Execute_Call) Return(_a0 usecases.ExportMerchantSettlementsOutput, _a1 error) *MockExportMerchantSettlementsUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExportMerchantSettlementsUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.ExportMerchantSettlementsInput) (usecases.ExportMerchantSettlementsOutput, error)) *MockExportMerchantSettlementsUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportMerchantSettlementsUsecase creates a new instance of MockExportMerchantSettlementsUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportMerchantSettlementsUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportMerchantSettlementsUsecase {
+	mock := &MockExportMerchantSettlementsUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,374 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGenerateCollectionCasesRepository is an autogenerated mock type for the GenerateCollectionCasesRepository type
+type MockGenerateCollectionCasesRepository struct {
+	mock.Mock
+}
+
+type MockGenerateCollectionCasesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateCollectionCasesRepository) EXPECT() *MockGenerateCollectionCasesRepository_Expecter {
+	return &MockGenerateCollectionCasesRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateCollectionCase provides a mock function with given fields: ctx, collectionCase
+func (_m *MockGenerateCollectionCasesRepository) CreateCollectionCase(ctx context.Context, collectionCase entity.CollectionCase) (entity.CollectionCase, error) {
+	ret := _m.Called(ctx, collectionCase)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollectionCase")
+	}
+
+	var r0 entity.CollectionCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CollectionCase) (entity.CollectionCase, error)); ok {
+		return rf(ctx, collectionCase)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CollectionCase) entity.CollectionCase); ok {
+		r0 = rf(ctx, collectionCase)
+	} else {
+		r0 = ret.Get(0).(entity.CollectionCase)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CollectionCase) error); ok {
+		r1 = rf(ctx, collectionCase)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCollectionCasesRepository_CreateCollectionCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionCase'
+type MockGenerateCollectionCasesRepository_CreateCollectionCase_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionCase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionCase entity.CollectionCase
+func (_e *MockGenerateCollectionCasesRepository_Expecter) CreateCollectionCase(ctx interface{}, collectionCase interface{}) *MockGenerateCollectionCasesRepository_CreateCollectionCase_Call {
+	return &MockGenerateCollectionCasesRepository_CreateCollectionCase_Call{Call: _e.mock.On("CreateCollectionCase", ctx, collectionCase)}
+}
+
+func (_c *MockGenerateCollectionCasesRepository_CreateCollectionCase_Call) Run(run func(ctx context.Context, collectionCase entity.CollectionCase)) *MockGenerateCollectionCasesRepository_CreateCollectionCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CollectionCase))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_CreateCollectionCase_Call) Return(_a0 entity.CollectionCase, _a1 error) *MockGenerateCollectionCasesRepository_CreateCollectionCase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_CreateCollectionCase_Call) RunAndReturn(run func(context.Context, entity.CollectionCase) (entity.CollectionCase, error)) *MockGenerateCollectionCasesRepository_CreateCollectionCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveCollectionAgents provides a mock function with given fields: ctx
+func (_m *MockGenerateCollectionCasesRepository) GetActiveCollectionAgents(ctx context.Context) ([]entity.CollectionAgent, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveCollectionAgents")
+	}
+
+	var r0 []entity.CollectionAgent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.CollectionAgent, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.CollectionAgent); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CollectionAgent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCollectionCasesRepository_GetActiveCollectionAgents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveCollectionAgents'
+type MockGenerateCollectionCasesRepository_GetActiveCollectionAgents_Call struct {
+	*mock.Call
+}
+
+// GetActiveCollectionAgents is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGenerateCollectionCasesRepository_Expecter) GetActiveCollectionAgents(ctx interface{}) *MockGenerateCollectionCasesRepository_GetActiveCollectionAgents_Call {
+	return &MockGenerateCollectionCasesRepository_GetActiveCollectionAgents_Call{Call: _e.mock.On("GetActiveCollectionAgents", ctx)}
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetActiveCollectionAgents_Call) Run(run func(ctx context.Context)) *MockGenerateCollectionCasesRepository_GetActiveCollectionAgents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetActiveCollectionAgents_Call) Return(_a0 []entity.CollectionAgent, _a1 error) *MockGenerateCollectionCasesRepository_GetActiveCollectionAgents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetActiveCollectionAgents_Call) RunAndReturn(run func(context.Context) ([]entity.CollectionAgent, error)) *MockGenerateCollectionCasesRepository_GetActiveCollectionAgents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelinquentLoans provides a mock function with given fields: ctx
+func (_m *MockGenerateCollectionCasesRepository) GetDelinquentLoans(ctx context.Context) ([]entity.DelinquentLoan, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelinquentLoans")
+	}
+
+	var r0 []entity.DelinquentLoan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.DelinquentLoan, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.DelinquentLoan); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.DelinquentLoan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCollectionCasesRepository_GetDelinquentLoans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelinquentLoans'
+type MockGenerateCollectionCasesRepository_GetDelinquentLoans_Call struct {
+	*mock.Call
+}
+
+// GetDelinquentLoans is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGenerateCollectionCasesRepository_Expecter) GetDelinquentLoans(ctx interface{}) *MockGenerateCollectionCasesRepository_GetDelinquentLoans_Call {
+	return &MockGenerateCollectionCasesRepository_GetDelinquentLoans_Call{Call: _e.mock.On("GetDelinquentLoans", ctx)}
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetDelinquentLoans_Call) Run(run func(ctx context.Context)) *MockGenerateCollectionCasesRepository_GetDelinquentLoans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetDelinquentLoans_Call) Return(_a0 []entity.DelinquentLoan, _a1 error) *MockGenerateCollectionCasesRepository_GetDelinquentLoans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetDelinquentLoans_Call) RunAndReturn(run func(context.Context) ([]entity.DelinquentLoan, error)) *MockGenerateCollectionCasesRepository_GetDelinquentLoans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastAssignedCollectionAgentID provides a mock function with given fields: ctx
+func (_m *MockGenerateCollectionCasesRepository) GetLastAssignedCollectionAgentID(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastAssignedCollectionAgentID")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCollectionCasesRepository_GetLastAssignedCollectionAgentID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastAssignedCollectionAgentID'
+type MockGenerateCollectionCasesRepository_GetLastAssignedCollectionAgentID_Call struct {
+	*mock.Call
+}
+
+// GetLastAssignedCollectionAgentID is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGenerateCollectionCasesRepository_Expecter) GetLastAssignedCollectionAgentID(ctx interface{}) *MockGenerateCollectionCasesRepository_GetLastAssignedCollectionAgentID_Call {
+	return &MockGenerateCollectionCasesRepository_GetLastAssignedCollectionAgentID_Call{Call: _e.mock.On("GetLastAssignedCollectionAgentID", ctx)}
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetLastAssignedCollectionAgentID_Call) Run(run func(ctx context.Context)) *MockGenerateCollectionCasesRepository_GetLastAssignedCollectionAgentID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetLastAssignedCollectionAgentID_Call) Return(_a0 uint64, _a1 error) *MockGenerateCollectionCasesRepository_GetLastAssignedCollectionAgentID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetLastAssignedCollectionAgentID_Call) RunAndReturn(run func(context.Context) (uint64, error)) *MockGenerateCollectionCasesRepository_GetLastAssignedCollectionAgentID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenCollectionCaseLoanIDs provides a mock function with given fields: ctx
+func (_m *MockGenerateCollectionCasesRepository) GetOpenCollectionCaseLoanIDs(ctx context.Context) ([]uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenCollectionCaseLoanIDs")
+	}
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCollectionCasesRepository_GetOpenCollectionCaseLoanIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenCollectionCaseLoanIDs'
+type MockGenerateCollectionCasesRepository_GetOpenCollectionCaseLoanIDs_Call struct {
+	*mock.Call
+}
+
+// GetOpenCollectionCaseLoanIDs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGenerateCollectionCasesRepository_Expecter) GetOpenCollectionCaseLoanIDs(ctx interface{}) *MockGenerateCollectionCasesRepository_GetOpenCollectionCaseLoanIDs_Call {
+	return &MockGenerateCollectionCasesRepository_GetOpenCollectionCaseLoanIDs_Call{Call: _e.mock.On("GetOpenCollectionCaseLoanIDs", ctx)}
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetOpenCollectionCaseLoanIDs_Call) Run(run func(ctx context.Context)) *MockGenerateCollectionCasesRepository_GetOpenCollectionCaseLoanIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetOpenCollectionCaseLoanIDs_Call) Return(_a0 []uint64, _a1 error) *MockGenerateCollectionCasesRepository_GetOpenCollectionCaseLoanIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_GetOpenCollectionCaseLoanIDs_Call) RunAndReturn(run func(context.Context) ([]uint64, error)) *MockGenerateCollectionCasesRepository_GetOpenCollectionCaseLoanIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkMissedInstallments provides a mock function with given fields: ctx, asOf
+func (_m *MockGenerateCollectionCasesRepository) MarkMissedInstallments(ctx context.Context, asOf time.Time) error {
+	ret := _m.Called(ctx, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkMissedInstallments")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, asOf)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGenerateCollectionCasesRepository_MarkMissedInstallments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkMissedInstallments'
+type MockGenerateCollectionCasesRepository_MarkMissedInstallments_Call struct {
+	*mock.Call
+}
+
+// MarkMissedInstallments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asOf time.Time
+func (_e *MockGenerateCollectionCasesRepository_Expecter) MarkMissedInstallments(ctx interface{}, asOf interface{}) *MockGenerateCollectionCasesRepository_MarkMissedInstallments_Call {
+	return &MockGenerateCollectionCasesRepository_MarkMissedInstallments_Call{Call: _e.mock.On("MarkMissedInstallments", ctx, asOf)}
+}
+
+func (_c *MockGenerateCollectionCasesRepository_MarkMissedInstallments_Call) Run(run func(ctx context.Context, asOf time.Time)) *MockGenerateCollectionCasesRepository_MarkMissedInstallments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_MarkMissedInstallments_Call) Return(_a0 error) *MockGenerateCollectionCasesRepository_MarkMissedInstallments_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesRepository_MarkMissedInstallments_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockGenerateCollectionCasesRepository_MarkMissedInstallments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateCollectionCasesRepository creates a new instance of MockGenerateCollectionCasesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateCollectionCasesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateCollectionCasesRepository {
+	mock := &MockGenerateCollectionCasesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGenerateCollectionCasesUsecase is an autogenerated mock type for the GenerateCollectionCasesUsecase type
+type MockGenerateCollectionCasesUsecase struct {
+	mock.Mock
+}
+
+type MockGenerateCollectionCasesUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateCollectionCasesUsecase) EXPECT() *MockGenerateCollectionCasesUsecase_Expecter {
+	return &MockGenerateCollectionCasesUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGenerateCollectionCasesUsecase) Execute(ctx context.Context, input usecases.GenerateCollectionCasesInput) (usecases.GenerateCollectionCasesOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GenerateCollectionCasesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GenerateCollectionCasesInput) (usecases.GenerateCollectionCasesOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GenerateCollectionCasesInput) usecases.GenerateCollectionCasesOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GenerateCollectionCasesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GenerateCollectionCasesInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCollectionCasesUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGenerateCollectionCasesUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GenerateCollectionCasesInput
+func (_e *MockGenerateCollectionCasesUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGenerateCollectionCasesUsecase_Execute_Call {
+	return &MockGenerateCollectionCasesUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGenerateCollectionCasesUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GenerateCollectionCasesInput)) *MockGenerateCollectionCasesUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GenerateCollectionCasesInput))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesUsecase_Execute_Call) Return(_a0 usecases.GenerateCollectionCasesOutput, _a1 error) *MockGenerateCollectionCasesUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCollectionCasesUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GenerateCollectionCasesInput) (usecases.GenerateCollectionCasesOutput, error)) *MockGenerateCollectionCasesUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateCollectionCasesUsecase creates a new instance of MockGenerateCollectionCasesUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateCollectionCasesUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateCollectionCasesUsecase {
+	mock := &MockGenerateCollectionCasesUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,272 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGenerateCreditLineBillRepository is an autogenerated mock type for the GenerateCreditLineBillRepository type
+type MockGenerateCreditLineBillRepository struct {
+	mock.Mock
+}
+
+type MockGenerateCreditLineBillRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateCreditLineBillRepository) EXPECT() *MockGenerateCreditLineBillRepository_Expecter {
+	return &MockGenerateCreditLineBillRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateCreditLineBill provides a mock function with given fields: ctx, bill
+func (_m *MockGenerateCreditLineBillRepository) CreateCreditLineBill(ctx context.Context, bill entity.CreditLineBill) (entity.CreditLineBill, error) {
+	ret := _m.Called(ctx, bill)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCreditLineBill")
+	}
+
+	var r0 entity.CreditLineBill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CreditLineBill) (entity.CreditLineBill, error)); ok {
+		return rf(ctx, bill)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CreditLineBill) entity.CreditLineBill); ok {
+		r0 = rf(ctx, bill)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLineBill)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CreditLineBill) error); ok {
+		r1 = rf(ctx, bill)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCreditLineBillRepository_CreateCreditLineBill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCreditLineBill'
+type MockGenerateCreditLineBillRepository_CreateCreditLineBill_Call struct {
+	*mock.Call
+}
+
+// CreateCreditLineBill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bill entity.CreditLineBill
+func (_e *MockGenerateCreditLineBillRepository_Expecter) CreateCreditLineBill(ctx interface{}, bill interface{}) *MockGenerateCreditLineBillRepository_CreateCreditLineBill_Call {
+	return &MockGenerateCreditLineBillRepository_CreateCreditLineBill_Call{Call: _e.mock.On("CreateCreditLineBill", ctx, bill)}
+}
+
+func (_c *MockGenerateCreditLineBillRepository_CreateCreditLineBill_Call) Run(run func(ctx context.Context, bill entity.CreditLineBill)) *MockGenerateCreditLineBillRepository_CreateCreditLineBill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CreditLineBill))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCreditLineBillRepository_CreateCreditLineBill_Call) Return(_a0 entity.CreditLineBill, _a1 error) *MockGenerateCreditLineBillRepository_CreateCreditLineBill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCreditLineBillRepository_CreateCreditLineBill_Call) RunAndReturn(run func(context.Context, entity.CreditLineBill) (entity.CreditLineBill, error)) *MockGenerateCreditLineBillRepository_CreateCreditLineBill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLine provides a mock function with given fields: ctx, creditLineID
+func (_m *MockGenerateCreditLineBillRepository) GetCreditLine(ctx context.Context, creditLineID uint64) (entity.CreditLine, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLine")
+	}
+
+	var r0 entity.CreditLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLine, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLine); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLine)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCreditLineBillRepository_GetCreditLine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLine'
+type MockGenerateCreditLineBillRepository_GetCreditLine_Call struct {
+	*mock.Call
+}
+
+// GetCreditLine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockGenerateCreditLineBillRepository_Expecter) GetCreditLine(ctx interface{}, creditLineID interface{}) *MockGenerateCreditLineBillRepository_GetCreditLine_Call {
+	return &MockGenerateCreditLineBillRepository_GetCreditLine_Call{Call: _e.mock.On("GetCreditLine", ctx, creditLineID)}
+}
+
+func (_c *MockGenerateCreditLineBillRepository_GetCreditLine_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockGenerateCreditLineBillRepository_GetCreditLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCreditLineBillRepository_GetCreditLine_Call) Return(_a0 entity.CreditLine, _a1 error) *MockGenerateCreditLineBillRepository_GetCreditLine_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCreditLineBillRepository_GetCreditLine_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLine, error)) *MockGenerateCreditLineBillRepository_GetCreditLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLineUnbilledInstallments provides a mock function with given fields: ctx, creditLineID, dueBy
+func (_m *MockGenerateCreditLineBillRepository) GetCreditLineUnbilledInstallments(ctx context.Context, creditLineID uint64, dueBy time.Time) ([]entity.Installment, error) {
+	ret := _m.Called(ctx, creditLineID, dueBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLineUnbilledInstallments")
+	}
+
+	var r0 []entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) ([]entity.Installment, error)); ok {
+		return rf(ctx, creditLineID, dueBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) []entity.Installment); ok {
+		r0 = rf(ctx, creditLineID, dueBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time) error); ok {
+		r1 = rf(ctx, creditLineID, dueBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCreditLineBillRepository_GetCreditLineUnbilledInstallments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLineUnbilledInstallments'
+type MockGenerateCreditLineBillRepository_GetCreditLineUnbilledInstallments_Call struct {
+	*mock.Call
+}
+
+// GetCreditLineUnbilledInstallments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+//   - dueBy time.Time
+func (_e *MockGenerateCreditLineBillRepository_Expecter) GetCreditLineUnbilledInstallments(ctx interface{}, creditLineID interface{}, dueBy interface{}) *MockGenerateCreditLineBillRepository_GetCreditLineUnbilledInstallments_Call {
+	return &MockGenerateCreditLineBillRepository_GetCreditLineUnbilledInstallments_Call{Call: _e.mock.On("GetCreditLineUnbilledInstallments", ctx, creditLineID, dueBy)}
+}
+
+func (_c *MockGenerateCreditLineBillRepository_GetCreditLineUnbilledInstallments_Call) Run(run func(ctx context.Context, creditLineID uint64, dueBy time.Time)) *MockGenerateCreditLineBillRepository_GetCreditLineUnbilledInstallments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCreditLineBillRepository_GetCreditLineUnbilledInstallments_Call) Return(_a0 []entity.Installment, _a1 error) *MockGenerateCreditLineBillRepository_GetCreditLineUnbilledInstallments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCreditLineBillRepository_GetCreditLineUnbilledInstallments_Call) RunAndReturn(run func(context.Context, uint64, time.Time) ([]entity.Installment, error)) *MockGenerateCreditLineBillRepository_GetCreditLineUnbilledInstallments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCreditLineBilled provides a mock function with given fields: ctx, creditLineID, cycle
+func (_m *MockGenerateCreditLineBillRepository) IsCreditLineBilled(ctx context.Context, creditLineID uint64, cycle string) (bool, error) {
+	ret := _m.Called(ctx, creditLineID, cycle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCreditLineBilled")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (bool, error)); ok {
+		return rf(ctx, creditLineID, cycle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) bool); ok {
+		r0 = rf(ctx, creditLineID, cycle)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, creditLineID, cycle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCreditLineBillRepository_IsCreditLineBilled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCreditLineBilled'
+type MockGenerateCreditLineBillRepository_IsCreditLineBilled_Call struct {
+	*mock.Call
+}
+
+// IsCreditLineBilled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+//   - cycle string
+func (_e *MockGenerateCreditLineBillRepository_Expecter) IsCreditLineBilled(ctx interface{}, creditLineID interface{}, cycle interface{}) *MockGenerateCreditLineBillRepository_IsCreditLineBilled_Call {
+	return &MockGenerateCreditLineBillRepository_IsCreditLineBilled_Call{Call: _e.mock.On("IsCreditLineBilled", ctx, creditLineID, cycle)}
+}
+
+func (_c *MockGenerateCreditLineBillRepository_IsCreditLineBilled_Call) Run(run func(ctx context.Context, creditLineID uint64, cycle string)) *MockGenerateCreditLineBillRepository_IsCreditLineBilled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCreditLineBillRepository_IsCreditLineBilled_Call) Return(_a0 bool, _a1 error) *MockGenerateCreditLineBillRepository_IsCreditLineBilled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCreditLineBillRepository_IsCreditLineBilled_Call) RunAndReturn(run func(context.Context, uint64, string) (bool, error)) *MockGenerateCreditLineBillRepository_IsCreditLineBilled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateCreditLineBillRepository creates a new instance of MockGenerateCreditLineBillRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateCreditLineBillRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateCreditLineBillRepository {
+	mock := &MockGenerateCreditLineBillRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGenerateCreditLineBillUsecase is an autogenerated mock type for the GenerateCreditLineBillUsecase type
+type MockGenerateCreditLineBillUsecase struct {
+	mock.Mock
+}
+
+type MockGenerateCreditLineBillUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateCreditLineBillUsecase) EXPECT() *MockGenerateCreditLineBillUsecase_Expecter {
+	return &MockGenerateCreditLineBillUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGenerateCreditLineBillUsecase) Execute(ctx context.Context, input usecases.GenerateCreditLineBillInput) (usecases.CreditLineBillOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CreditLineBillOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GenerateCreditLineBillInput) (usecases.CreditLineBillOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GenerateCreditLineBillInput) usecases.CreditLineBillOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CreditLineBillOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GenerateCreditLineBillInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateCreditLineBillUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGenerateCreditLineBillUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GenerateCreditLineBillInput
+func (_e *MockGenerateCreditLineBillUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGenerateCreditLineBillUsecase_Execute_Call {
+	return &MockGenerateCreditLineBillUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGenerateCreditLineBillUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GenerateCreditLineBillInput)) *MockGenerateCreditLineBillUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GenerateCreditLineBillInput))
+	})
+	return _c
+}
+
+func (_c *MockGenerateCreditLineBillUsecase_Execute_Call) Return(_a0 usecases.CreditLineBillOutput, _a1 error) *MockGenerateCreditLineBillUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateCreditLineBillUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GenerateCreditLineBillInput) (usecases.CreditLineBillOutput, error)) *MockGenerateCreditLineBillUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateCreditLineBillUsecase creates a new instance of MockGenerateCreditLineBillUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateCreditLineBillUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateCreditLineBillUsecase {
+	mock := &MockGenerateCreditLineBillUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,214 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGenerateMerchantSettlementsRepository is an autogenerated mock type for the GenerateMerchantSettlementsRepository type
+type MockGenerateMerchantSettlementsRepository struct {
+	mock.Mock
+}
+
+type MockGenerateMerchantSettlementsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateMerchantSettlementsRepository) EXPECT() *MockGenerateMerchantSettlementsRepository_Expecter {
+	return &MockGenerateMerchantSettlementsRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateMerchantSettlement provides a mock function with given fields: ctx, settlement
+func (_m *MockGenerateMerchantSettlementsRepository) CreateMerchantSettlement(ctx context.Context, settlement entity.MerchantSettlement) (entity.MerchantSettlement, error) {
+	ret := _m.Called(ctx, settlement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMerchantSettlement")
+	}
+
+	var r0 entity.MerchantSettlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchantSettlement) (entity.MerchantSettlement, error)); ok {
+		return rf(ctx, settlement)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchantSettlement) entity.MerchantSettlement); ok {
+		r0 = rf(ctx, settlement)
+	} else {
+		r0 = ret.Get(0).(entity.MerchantSettlement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.MerchantSettlement) error); ok {
+		r1 = rf(ctx, settlement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateMerchantSettlementsRepository_CreateMerchantSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMerchantSettlement'
+type MockGenerateMerchantSettlementsRepository_CreateMerchantSettlement_Call struct {
+	*mock.Call
+}
+
+// CreateMerchantSettlement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settlement entity.MerchantSettlement
+func (_e *MockGenerateMerchantSettlementsRepository_Expecter) CreateMerchantSettlement(ctx interface{}, settlement interface{}) *MockGenerateMerchantSettlementsRepository_CreateMerchantSettlement_Call {
+	return &MockGenerateMerchantSettlementsRepository_CreateMerchantSettlement_Call{Call: _e.mock.On("CreateMerchantSettlement", ctx, settlement)}
+}
+
+func (_c *MockGenerateMerchantSettlementsRepository_CreateMerchantSettlement_Call) Run(run func(ctx context.Context, settlement entity.MerchantSettlement)) *MockGenerateMerchantSettlementsRepository_CreateMerchantSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchantSettlement))
+	})
+	return _c
+}
+
+func (_c *MockGenerateMerchantSettlementsRepository_CreateMerchantSettlement_Call) Return(_a0 entity.MerchantSettlement, _a1 error) *MockGenerateMerchantSettlementsRepository_CreateMerchantSettlement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateMerchantSettlementsRepository_CreateMerchantSettlement_Call) RunAndReturn(run func(context.Context, entity.MerchantSettlement) (entity.MerchantSettlement, error)) *MockGenerateMerchantSettlementsRepository_CreateMerchantSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnsettledMerchantItems provides a mock function with given fields: ctx, saleDate
+func (_m *MockGenerateMerchantSettlementsRepository) GetUnsettledMerchantItems(ctx context.Context, saleDate time.Time) ([]entity.MerchantSettlementItem, error) {
+	ret := _m.Called(ctx, saleDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnsettledMerchantItems")
+	}
+
+	var r0 []entity.MerchantSettlementItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.MerchantSettlementItem, error)); ok {
+		return rf(ctx, saleDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.MerchantSettlementItem); ok {
+		r0 = rf(ctx, saleDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MerchantSettlementItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, saleDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateMerchantSettlementsRepository_GetUnsettledMerchantItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnsettledMerchantItems'
+type MockGenerateMerchantSettlementsRepository_GetUnsettledMerchantItems_Call struct {
+	*mock.Call
+}
+
+// GetUnsettledMerchantItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - saleDate time.Time
+func (_e *MockGenerateMerchantSettlementsRepository_Expecter) GetUnsettledMerchantItems(ctx interface{}, saleDate interface{}) *MockGenerateMerchantSettlementsRepository_GetUnsettledMerchantItems_Call {
+	return &MockGenerateMerchantSettlementsRepository_GetUnsettledMerchantItems_Call{Call: _e.mock.On("GetUnsettledMerchantItems", ctx, saleDate)}
+}
+
+func (_c *MockGenerateMerchantSettlementsRepository_GetUnsettledMerchantItems_Call) Run(run func(ctx context.Context, saleDate time.Time)) *MockGenerateMerchantSettlementsRepository_GetUnsettledMerchantItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGenerateMerchantSettlementsRepository_GetUnsettledMerchantItems_Call) Return(_a0 []entity.MerchantSettlementItem, _a1 error) *MockGenerateMerchantSettlementsRepository_GetUnsettledMerchantItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateMerchantSettlementsRepository_GetUnsettledMerchantItems_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.MerchantSettlementItem, error)) *MockGenerateMerchantSettlementsRepository_GetUnsettledMerchantItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMerchantSettled provides a mock function with given fields: ctx, merchantID, settlementDate
+func (_m *MockGenerateMerchantSettlementsRepository) IsMerchantSettled(ctx context.Context, merchantID uint64, settlementDate time.Time) (bool, error) {
+	ret := _m.Called(ctx, merchantID, settlementDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMerchantSettled")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) (bool, error)); ok {
+		return rf(ctx, merchantID, settlementDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) bool); ok {
+		r0 = rf(ctx, merchantID, settlementDate)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time) error); ok {
+		r1 = rf(ctx, merchantID, settlementDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateMerchantSettlementsRepository_IsMerchantSettled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMerchantSettled'
+type MockGenerateMerchantSettlementsRepository_IsMerchantSettled_Call struct {
+	*mock.Call
+}
+
+// IsMerchantSettled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+//   - settlementDate time.Time
+func (_e *MockGenerateMerchantSettlementsRepository_Expecter) IsMerchantSettled(ctx interface{}, merchantID interface{}, settlementDate interface{}) *MockGenerateMerchantSettlementsRepository_IsMerchantSettled_Call {
+	return &MockGenerateMerchantSettlementsRepository_IsMerchantSettled_Call{Call: _e.mock.On("IsMerchantSettled", ctx, merchantID, settlementDate)}
+}
+
+func (_c *MockGenerateMerchantSettlementsRepository_IsMerchantSettled_Call) Run(run func(ctx context.Context, merchantID uint64, settlementDate time.Time)) *MockGenerateMerchantSettlementsRepository_IsMerchantSettled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGenerateMerchantSettlementsRepository_IsMerchantSettled_Call) Return(_a0 bool, _a1 error) *MockGenerateMerchantSettlementsRepository_IsMerchantSettled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateMerchantSettlementsRepository_IsMerchantSettled_Call) RunAndReturn(run func(context.Context, uint64, time.Time) (bool, error)) *MockGenerateMerchantSettlementsRepository_IsMerchantSettled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateMerchantSettlementsRepository creates a new instance of MockGenerateMerchantSettlementsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateMerchantSettlementsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateMerchantSettlementsRepository {
+	mock := &MockGenerateMerchantSettlementsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
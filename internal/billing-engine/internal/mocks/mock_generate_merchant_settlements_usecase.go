@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGenerateMerchantSettlementsUsecase is an autogenerated mock type for the GenerateMerchantSettlementsUsecase type
+type MockGenerateMerchantSettlementsUsecase struct {
+	mock.Mock
+}
+
+type MockGenerateMerchantSettlementsUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateMerchantSettlementsUsecase) EXPECT() *MockGenerateMerchantSettlementsUsecase_Expecter {
+	return &MockGenerateMerchantSettlementsUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGenerateMerchantSettlementsUsecase) Execute(ctx context.Context, input usecases.GenerateMerchantSettlementsInput) (usecases.GenerateMerchantSettlementsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GenerateMerchantSettlementsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GenerateMerchantSettlementsInput) (usecases.GenerateMerchantSettlementsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GenerateMerchantSettlementsInput) usecases.GenerateMerchantSettlementsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GenerateMerchantSettlementsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GenerateMerchantSettlementsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGenerateMerchantSettlementsUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGenerateMerchantSettlementsUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GenerateMerchantSettlementsInput
+func (_e *MockGenerateMerchantSettlementsUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGenerateMerchantSettlementsUsecase_Execute_Call {
+	return &MockGenerateMerchantSettlementsUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGenerateMerchantSettlementsUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GenerateMerchantSettlementsInput)) *MockGenerateMerchantSettlementsUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GenerateMerchantSettlementsInput))
+	})
+	return _c
+}
+
+func (_c *MockGenerateMerchantSettlementsUsecase_Execute_Call) Return(_a0 usecases.GenerateMerchantSettlementsOutput, _a1 error) *MockGenerateMerchantSettlementsUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGenerateMerchantSettlementsUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GenerateMerchantSettlementsInput) (usecases.GenerateMerchantSettlementsOutput, error)) *MockGenerateMerchantSettlementsUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateMerchantSettlementsUsecase creates a new instance of MockGenerateMerchantSettlementsUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateMerchantSettlementsUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateMerchantSettlementsUsecase {
+	mock := &MockGenerateMerchantSettlementsUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
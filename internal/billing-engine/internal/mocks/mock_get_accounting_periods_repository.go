@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetAccountingPeriodsRepository is an autogenerated mock type for the GetAccountingPeriodsRepository type
+type MockGetAccountingPeriodsRepository struct {
+	mock.Mock
+}
+
+type MockGetAccountingPeriodsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetAccountingPeriodsRepository) EXPECT() *MockGetAccountingPeriodsRepository_Expecter {
+	return &MockGetAccountingPeriodsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAccountingPeriods provides a mock function with given fields: ctx
+func (_m *MockGetAccountingPeriodsRepository) GetAccountingPeriods(ctx context.Context) ([]entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountingPeriods")
+	}
+
+	var r0 []entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AccountingPeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetAccountingPeriodsRepository_GetAccountingPeriods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountingPeriods'
+type MockGetAccountingPeriodsRepository_GetAccountingPeriods_Call struct {
+	*mock.Call
+}
+
+// GetAccountingPeriods is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGetAccountingPeriodsRepository_Expecter) GetAccountingPeriods(ctx interface{}) *MockGetAccountingPeriodsRepository_GetAccountingPeriods_Call {
+	return &MockGetAccountingPeriodsRepository_GetAccountingPeriods_Call{Call: _e.mock.On("GetAccountingPeriods", ctx)}
+}
+
+func (_c *MockGetAccountingPeriodsRepository_GetAccountingPeriods_Call) Run(run func(ctx context.Context)) *MockGetAccountingPeriodsRepository_GetAccountingPeriods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGetAccountingPeriodsRepository_GetAccountingPeriods_Call) Return(_a0 []entity.AccountingPeriod, _a1 error) *MockGetAccountingPeriodsRepository_GetAccountingPeriods_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetAccountingPeriodsRepository_GetAccountingPeriods_Call) RunAndReturn(run func(context.Context) ([]entity.AccountingPeriod, error)) *MockGetAccountingPeriodsRepository_GetAccountingPeriods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetAccountingPeriodsRepository creates a new instance of MockGetAccountingPeriodsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetAccountingPeriodsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetAccountingPeriodsRepository {
+	mock := &MockGetAccountingPeriodsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetAccountingPeriodsUsecase is an autogenerated mock type for the GetAccountingPeriodsUsecase type
+type MockGetAccountingPeriodsUsecase struct {
+	mock.Mock
+}
+
+type MockGetAccountingPeriodsUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetAccountingPeriodsUsecase) EXPECT() *MockGetAccountingPeriodsUsecase_Expecter {
+	return &MockGetAccountingPeriodsUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx
+func (_m *MockGetAccountingPeriodsUsecase) Execute(ctx context.Context) (usecases.GetAccountingPeriodsOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetAccountingPeriodsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (usecases.GetAccountingPeriodsOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) usecases.GetAccountingPeriodsOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(usecases.GetAccountingPeriodsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetAccountingPeriodsUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetAccountingPeriodsUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGetAccountingPeriodsUsecase_Expecter) Execute(ctx interface{}) *MockGetAccountingPeriodsUsecase_Execute_Call {
+	return &MockGetAccountingPeriodsUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+}
+
+func (_c *MockGetAccountingPeriodsUsecase_Execute_Call) Run(run func(ctx context.Context)) *MockGetAccountingPeriodsUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGetAccountingPeriodsUsecase_Execute_Call) Return(_a0 usecases.GetAccountingPeriodsOutput, _a1 error) *MockGetAccountingPeriodsUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetAccountingPeriodsUsecase_Execute_Call) RunAndReturn(run func(context.Context) (usecases.GetAccountingPeriodsOutput, error)) *MockGetAccountingPeriodsUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetAccountingPeriodsUsecase creates a new instance of MockGetAccountingPeriodsUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetAccountingPeriodsUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetAccountingPeriodsUsecase {
+	mock := &MockGetAccountingPeriodsUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -23,29 +23,29 @@ func (_m *MockGetAllCustomerRepository) EXPECT() *MockGetAllCustomerRepository_E
 	return &MockGetAllCustomerRepository_Expecter{mock: &_m.Mock}
 }
 
-// GetAllCustomer provides a mock function with given fields: ctx
-func (_m *MockGetAllCustomerRepository) GetAllCustomer(ctx context.Context) ([]entity.Customer, error) {
-	ret := _m.Called(ctx)
+// SearchCustomers provides a mock function with given fields: ctx, search
+func (_m *MockGetAllCustomerRepository) SearchCustomers(ctx context.Context, search entity.CustomerSearch) ([]entity.Customer, error) {
+	ret := _m.Called(ctx, search)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllCustomer")
+		panic("no return value specified for SearchCustomers")
 	}
 
 	var r0 []entity.Customer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.Customer, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CustomerSearch) ([]entity.Customer, error)); ok {
+		return rf(ctx, search)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []entity.Customer); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CustomerSearch) []entity.Customer); ok {
+		r0 = rf(ctx, search)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Customer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CustomerSearch) error); ok {
+		r1 = rf(ctx, search)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -53,30 +53,31 @@ func (_m *MockGetAllCustomerRepository) GetAllCustomer(ctx context.Context) ([]e
 	return r0, r1
 }
 
-// MockGetAllCustomerRepository_GetAllCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllCustomer'
-type MockGetAllCustomerRepository_GetAllCustomer_Call struct {
+// MockGetAllCustomerRepository_SearchCustomers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchCustomers'
+type MockGetAllCustomerRepository_SearchCustomers_Call struct {
 	*mock.Call
 }
 
-// GetAllCustomer is a helper method to define mock.On call
+// SearchCustomers is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockGetAllCustomerRepository_Expecter) GetAllCustomer(ctx interface{}) *MockGetAllCustomerRepository_GetAllCustomer_Call {
-	return &MockGetAllCustomerRepository_GetAllCustomer_Call{Call: _e.mock.On("GetAllCustomer", ctx)}
+//   - search entity.CustomerSearch
+func (_e *MockGetAllCustomerRepository_Expecter) SearchCustomers(ctx interface{}, search interface{}) *MockGetAllCustomerRepository_SearchCustomers_Call {
+	return &MockGetAllCustomerRepository_SearchCustomers_Call{Call: _e.mock.On("SearchCustomers", ctx, search)}
 }
 
-func (_c *MockGetAllCustomerRepository_GetAllCustomer_Call) Run(run func(ctx context.Context)) *MockGetAllCustomerRepository_GetAllCustomer_Call {
+func (_c *MockGetAllCustomerRepository_SearchCustomers_Call) Run(run func(ctx context.Context, search entity.CustomerSearch)) *MockGetAllCustomerRepository_SearchCustomers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(entity.CustomerSearch))
 	})
 	return _c
 }
 
-func (_c *MockGetAllCustomerRepository_GetAllCustomer_Call) Return(_a0 []entity.Customer, _a1 error) *MockGetAllCustomerRepository_GetAllCustomer_Call {
+func (_c *MockGetAllCustomerRepository_SearchCustomers_Call) Return(_a0 []entity.Customer, _a1 error) *MockGetAllCustomerRepository_SearchCustomers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGetAllCustomerRepository_GetAllCustomer_Call) RunAndReturn(run func(context.Context) ([]entity.Customer, error)) *MockGetAllCustomerRepository_GetAllCustomer_Call {
+func (_c *MockGetAllCustomerRepository_SearchCustomers_Call) RunAndReturn(run func(context.Context, entity.CustomerSearch) ([]entity.Customer, error)) *MockGetAllCustomerRepository_SearchCustomers_Call {
 	_c.Call.Return(run)
 	return _c
 }
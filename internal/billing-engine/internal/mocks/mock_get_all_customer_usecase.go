@@ -22,9 +22,9 @@ func (_m *MockGetAllCustomerUsecase) EXPECT() *MockGetAllCustomerUsecase_Expecte
 	return &MockGetAllCustomerUsecase_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function with given fields: ctx
-func (_m *MockGetAllCustomerUsecase) Execute(ctx context.Context) (usecases.GetAllCustomerOutput, error) {
-	ret := _m.Called(ctx)
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGetAllCustomerUsecase) Execute(ctx context.Context, input usecases.GetAllCustomerInput) (usecases.GetAllCustomerOutput, error) {
+	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
@@ -32,17 +32,17 @@ func (_m *MockGetAllCustomerUsecase) Execute(ctx context.Context) (usecases.GetA
 
 	var r0 usecases.GetAllCustomerOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (usecases.GetAllCustomerOutput, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetAllCustomerInput) (usecases.GetAllCustomerOutput, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) usecases.GetAllCustomerOutput); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetAllCustomerInput) usecases.GetAllCustomerOutput); ok {
+		r0 = rf(ctx, input)
 	} else {
 		r0 = ret.Get(0).(usecases.GetAllCustomerOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetAllCustomerInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,13 +57,14 @@ type MockGetAllCustomerUsecase_Execute_Call struct {
 
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockGetAllCustomerUsecase_Expecter) Execute(ctx interface{}) *MockGetAllCustomerUsecase_Execute_Call {
-	return &MockGetAllCustomerUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+//   - input usecases.GetAllCustomerInput
+func (_e *MockGetAllCustomerUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGetAllCustomerUsecase_Execute_Call {
+	return &MockGetAllCustomerUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
 }
 
-func (_c *MockGetAllCustomerUsecase_Execute_Call) Run(run func(ctx context.Context)) *MockGetAllCustomerUsecase_Execute_Call {
+func (_c *MockGetAllCustomerUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GetAllCustomerInput)) *MockGetAllCustomerUsecase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(usecases.GetAllCustomerInput))
 	})
 	return _c
 }
@@ -73,7 +74,7 @@ func (_c *MockGetAllCustomerUsecase_Execute_Call) Return(_a0 usecases.GetAllCust
 	return _c
 }
 
-func (_c *MockGetAllCustomerUsecase_Execute_Call) RunAndReturn(run func(context.Context) (usecases.GetAllCustomerOutput, error)) *MockGetAllCustomerUsecase_Execute_Call {
+func (_c *MockGetAllCustomerUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GetAllCustomerInput) (usecases.GetAllCustomerOutput, error)) *MockGetAllCustomerUsecase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCollateralReportRepository is an autogenerated mock type for the GetCollateralReportRepository type
+type MockGetCollateralReportRepository struct {
+	mock.Mock
+}
+
+type MockGetCollateralReportRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCollateralReportRepository) EXPECT() *MockGetCollateralReportRepository_Expecter {
+	return &MockGetCollateralReportRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCollateralisedLoans provides a mock function with given fields: ctx
+func (_m *MockGetCollateralReportRepository) GetCollateralisedLoans(ctx context.Context) ([]entity.CollateralisedLoan, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollateralisedLoans")
+	}
+
+	var r0 []entity.CollateralisedLoan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.CollateralisedLoan, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.CollateralisedLoan); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CollateralisedLoan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCollateralReportRepository_GetCollateralisedLoans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollateralisedLoans'
+type MockGetCollateralReportRepository_GetCollateralisedLoans_Call struct {
+	*mock.Call
+}
+
+// GetCollateralisedLoans is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGetCollateralReportRepository_Expecter) GetCollateralisedLoans(ctx interface{}) *MockGetCollateralReportRepository_GetCollateralisedLoans_Call {
+	return &MockGetCollateralReportRepository_GetCollateralisedLoans_Call{Call: _e.mock.On("GetCollateralisedLoans", ctx)}
+}
+
+func (_c *MockGetCollateralReportRepository_GetCollateralisedLoans_Call) Run(run func(ctx context.Context)) *MockGetCollateralReportRepository_GetCollateralisedLoans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGetCollateralReportRepository_GetCollateralisedLoans_Call) Return(_a0 []entity.CollateralisedLoan, _a1 error) *MockGetCollateralReportRepository_GetCollateralisedLoans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCollateralReportRepository_GetCollateralisedLoans_Call) RunAndReturn(run func(context.Context) ([]entity.CollateralisedLoan, error)) *MockGetCollateralReportRepository_GetCollateralisedLoans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCollateralReportRepository creates a new instance of MockGetCollateralReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCollateralReportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCollateralReportRepository {
+	mock := &MockGetCollateralReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,213 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCollectionCaseRepository is an autogenerated mock type for the GetCollectionCaseRepository type
+type MockGetCollectionCaseRepository struct {
+	mock.Mock
+}
+
+type MockGetCollectionCaseRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCollectionCaseRepository) EXPECT() *MockGetCollectionCaseRepository_Expecter {
+	return &MockGetCollectionCaseRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCollectionCase provides a mock function with given fields: ctx, caseID
+func (_m *MockGetCollectionCaseRepository) GetCollectionCase(ctx context.Context, caseID uint64) (entity.CollectionCase, error) {
+	ret := _m.Called(ctx, caseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionCase")
+	}
+
+	var r0 entity.CollectionCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CollectionCase, error)); ok {
+		return rf(ctx, caseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CollectionCase); ok {
+		r0 = rf(ctx, caseID)
+	} else {
+		r0 = ret.Get(0).(entity.CollectionCase)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, caseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCollectionCaseRepository_GetCollectionCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionCase'
+type MockGetCollectionCaseRepository_GetCollectionCase_Call struct {
+	*mock.Call
+}
+
+// GetCollectionCase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caseID uint64
+func (_e *MockGetCollectionCaseRepository_Expecter) GetCollectionCase(ctx interface{}, caseID interface{}) *MockGetCollectionCaseRepository_GetCollectionCase_Call {
+	return &MockGetCollectionCaseRepository_GetCollectionCase_Call{Call: _e.mock.On("GetCollectionCase", ctx, caseID)}
+}
+
+func (_c *MockGetCollectionCaseRepository_GetCollectionCase_Call) Run(run func(ctx context.Context, caseID uint64)) *MockGetCollectionCaseRepository_GetCollectionCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCollectionCaseRepository_GetCollectionCase_Call) Return(_a0 entity.CollectionCase, _a1 error) *MockGetCollectionCaseRepository_GetCollectionCase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCollectionCaseRepository_GetCollectionCase_Call) RunAndReturn(run func(context.Context, uint64) (entity.CollectionCase, error)) *MockGetCollectionCaseRepository_GetCollectionCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContactAttemptsByCase provides a mock function with given fields: ctx, caseID
+func (_m *MockGetCollectionCaseRepository) GetContactAttemptsByCase(ctx context.Context, caseID uint64) ([]entity.ContactAttempt, error) {
+	ret := _m.Called(ctx, caseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContactAttemptsByCase")
+	}
+
+	var r0 []entity.ContactAttempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.ContactAttempt, error)); ok {
+		return rf(ctx, caseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.ContactAttempt); ok {
+		r0 = rf(ctx, caseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ContactAttempt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, caseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCollectionCaseRepository_GetContactAttemptsByCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContactAttemptsByCase'
+type MockGetCollectionCaseRepository_GetContactAttemptsByCase_Call struct {
+	*mock.Call
+}
+
+// GetContactAttemptsByCase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caseID uint64
+func (_e *MockGetCollectionCaseRepository_Expecter) GetContactAttemptsByCase(ctx interface{}, caseID interface{}) *MockGetCollectionCaseRepository_GetContactAttemptsByCase_Call {
+	return &MockGetCollectionCaseRepository_GetContactAttemptsByCase_Call{Call: _e.mock.On("GetContactAttemptsByCase", ctx, caseID)}
+}
+
+func (_c *MockGetCollectionCaseRepository_GetContactAttemptsByCase_Call) Run(run func(ctx context.Context, caseID uint64)) *MockGetCollectionCaseRepository_GetContactAttemptsByCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCollectionCaseRepository_GetContactAttemptsByCase_Call) Return(_a0 []entity.ContactAttempt, _a1 error) *MockGetCollectionCaseRepository_GetContactAttemptsByCase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCollectionCaseRepository_GetContactAttemptsByCase_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.ContactAttempt, error)) *MockGetCollectionCaseRepository_GetContactAttemptsByCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPromisesToPayByCase provides a mock function with given fields: ctx, caseID
+func (_m *MockGetCollectionCaseRepository) GetPromisesToPayByCase(ctx context.Context, caseID uint64) ([]entity.PromiseToPay, error) {
+	ret := _m.Called(ctx, caseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromisesToPayByCase")
+	}
+
+	var r0 []entity.PromiseToPay
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.PromiseToPay, error)); ok {
+		return rf(ctx, caseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.PromiseToPay); ok {
+		r0 = rf(ctx, caseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PromiseToPay)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, caseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCollectionCaseRepository_GetPromisesToPayByCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromisesToPayByCase'
+type MockGetCollectionCaseRepository_GetPromisesToPayByCase_Call struct {
+	*mock.Call
+}
+
+// GetPromisesToPayByCase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caseID uint64
+func (_e *MockGetCollectionCaseRepository_Expecter) GetPromisesToPayByCase(ctx interface{}, caseID interface{}) *MockGetCollectionCaseRepository_GetPromisesToPayByCase_Call {
+	return &MockGetCollectionCaseRepository_GetPromisesToPayByCase_Call{Call: _e.mock.On("GetPromisesToPayByCase", ctx, caseID)}
+}
+
+func (_c *MockGetCollectionCaseRepository_GetPromisesToPayByCase_Call) Run(run func(ctx context.Context, caseID uint64)) *MockGetCollectionCaseRepository_GetPromisesToPayByCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCollectionCaseRepository_GetPromisesToPayByCase_Call) Return(_a0 []entity.PromiseToPay, _a1 error) *MockGetCollectionCaseRepository_GetPromisesToPayByCase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCollectionCaseRepository_GetPromisesToPayByCase_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.PromiseToPay, error)) *MockGetCollectionCaseRepository_GetPromisesToPayByCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCollectionCaseRepository creates a new instance of MockGetCollectionCaseRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCollectionCaseRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCollectionCaseRepository {
+	mock := &MockGetCollectionCaseRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
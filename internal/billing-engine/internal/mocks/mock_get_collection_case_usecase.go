@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCollectionCaseUsecase is an autogenerated mock type for the GetCollectionCaseUsecase type
+type MockGetCollectionCaseUsecase struct {
+	mock.Mock
+}
+
+type MockGetCollectionCaseUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCollectionCaseUsecase) EXPECT() *MockGetCollectionCaseUsecase_Expecter {
+	return &MockGetCollectionCaseUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, caseID
+func (_m *MockGetCollectionCaseUsecase) Execute(ctx context.Context, caseID uint64) (usecases.GetCollectionCaseOutput, error) {
+	ret := _m.Called(ctx, caseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetCollectionCaseOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (usecases.GetCollectionCaseOutput, error)); ok {
+		return rf(ctx, caseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) usecases.GetCollectionCaseOutput); ok {
+		r0 = rf(ctx, caseID)
+	} else {
+		r0 = ret.Get(0).(usecases.GetCollectionCaseOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, caseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCollectionCaseUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetCollectionCaseUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caseID uint64
+func (_e *MockGetCollectionCaseUsecase_Expecter) Execute(ctx interface{}, caseID interface{}) *MockGetCollectionCaseUsecase_Execute_Call {
+	return &MockGetCollectionCaseUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, caseID)}
+}
+
+func (_c *MockGetCollectionCaseUsecase_Execute_Call) Run(run func(ctx context.Context, caseID uint64)) *MockGetCollectionCaseUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCollectionCaseUsecase_Execute_Call) Return(_a0 usecases.GetCollectionCaseOutput, _a1 error) *MockGetCollectionCaseUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCollectionCaseUsecase_Execute_Call) RunAndReturn(run func(context.Context, uint64) (usecases.GetCollectionCaseOutput, error)) *MockGetCollectionCaseUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCollectionCaseUsecase creates a new instance of MockGetCollectionCaseUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCollectionCaseUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCollectionCaseUsecase {
+	mock := &MockGetCollectionCaseUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
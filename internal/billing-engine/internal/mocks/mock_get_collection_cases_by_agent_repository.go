@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCollectionCasesByAgentRepository is an autogenerated mock type for the GetCollectionCasesByAgentRepository type
+type MockGetCollectionCasesByAgentRepository struct {
+	mock.Mock
+}
+
+type MockGetCollectionCasesByAgentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCollectionCasesByAgentRepository) EXPECT() *MockGetCollectionCasesByAgentRepository_Expecter {
+	return &MockGetCollectionCasesByAgentRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCollectionCasesByAgent provides a mock function with given fields: ctx, agentID
+func (_m *MockGetCollectionCasesByAgentRepository) GetCollectionCasesByAgent(ctx context.Context, agentID uint64) ([]entity.CollectionCase, error) {
+	ret := _m.Called(ctx, agentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionCasesByAgent")
+	}
+
+	var r0 []entity.CollectionCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.CollectionCase, error)); ok {
+		return rf(ctx, agentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.CollectionCase); ok {
+		r0 = rf(ctx, agentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CollectionCase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, agentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCollectionCasesByAgentRepository_GetCollectionCasesByAgent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionCasesByAgent'
+type MockGetCollectionCasesByAgentRepository_GetCollectionCasesByAgent_Call struct {
+	*mock.Call
+}
+
+// GetCollectionCasesByAgent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - agentID uint64
+func (_e *MockGetCollectionCasesByAgentRepository_Expecter) GetCollectionCasesByAgent(ctx interface{}, agentID interface{}) *MockGetCollectionCasesByAgentRepository_GetCollectionCasesByAgent_Call {
+	return &MockGetCollectionCasesByAgentRepository_GetCollectionCasesByAgent_Call{Call: _e.mock.On("GetCollectionCasesByAgent", ctx, agentID)}
+}
+
+func (_c *MockGetCollectionCasesByAgentRepository_GetCollectionCasesByAgent_Call) Run(run func(ctx context.Context, agentID uint64)) *MockGetCollectionCasesByAgentRepository_GetCollectionCasesByAgent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCollectionCasesByAgentRepository_GetCollectionCasesByAgent_Call) Return(_a0 []entity.CollectionCase, _a1 error) *MockGetCollectionCasesByAgentRepository_GetCollectionCasesByAgent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCollectionCasesByAgentRepository_GetCollectionCasesByAgent_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.CollectionCase, error)) *MockGetCollectionCasesByAgentRepository_GetCollectionCasesByAgent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCollectionCasesByAgentRepository creates a new instance of MockGetCollectionCasesByAgentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCollectionCasesByAgentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCollectionCasesByAgentRepository {
+	mock := &MockGetCollectionCasesByAgentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCollectionCasesByAgentUsecase is an autogenerated mock type for the GetCollectionCasesByAgentUsecase type
+type MockGetCollectionCasesByAgentUsecase struct {
+	mock.Mock
+}
+
+type MockGetCollectionCasesByAgentUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCollectionCasesByAgentUsecase) EXPECT() *MockGetCollectionCasesByAgentUsecase_Expecter {
+	return &MockGetCollectionCasesByAgentUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, agentID
+func (_m *MockGetCollectionCasesByAgentUsecase) Execute(ctx context.Context, agentID uint64) ([]usecases.CollectionCaseOutput, error) {
+	ret := _m.Called(ctx, agentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []usecases.CollectionCaseOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]usecases.CollectionCaseOutput, error)); ok {
+		return rf(ctx, agentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []usecases.CollectionCaseOutput); ok {
+		r0 = rf(ctx, agentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]usecases.CollectionCaseOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, agentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCollectionCasesByAgentUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetCollectionCasesByAgentUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - agentID uint64
+func (_e *MockGetCollectionCasesByAgentUsecase_Expecter) Execute(ctx interface{}, agentID interface{}) *MockGetCollectionCasesByAgentUsecase_Execute_Call {
+	return &MockGetCollectionCasesByAgentUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, agentID)}
+}
+
+func (_c *MockGetCollectionCasesByAgentUsecase_Execute_Call) Run(run func(ctx context.Context, agentID uint64)) *MockGetCollectionCasesByAgentUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCollectionCasesByAgentUsecase_Execute_Call) Return(_a0 []usecases.CollectionCaseOutput, _a1 error) *MockGetCollectionCasesByAgentUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCollectionCasesByAgentUsecase_Execute_Call) RunAndReturn(run func(context.Context, uint64) ([]usecases.CollectionCaseOutput, error)) *MockGetCollectionCasesByAgentUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCollectionCasesByAgentUsecase creates a new instance of MockGetCollectionCasesByAgentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCollectionCasesByAgentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCollectionCasesByAgentUsecase {
+	mock := &MockGetCollectionCasesByAgentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,154 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCreditLineBillsRepository is an autogenerated mock type for the GetCreditLineBillsRepository type
+type MockGetCreditLineBillsRepository struct {
+	mock.Mock
+}
+
+type MockGetCreditLineBillsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCreditLineBillsRepository) EXPECT() *MockGetCreditLineBillsRepository_Expecter {
+	return &MockGetCreditLineBillsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCreditLine provides a mock function with given fields: ctx, creditLineID
+func (_m *MockGetCreditLineBillsRepository) GetCreditLine(ctx context.Context, creditLineID uint64) (entity.CreditLine, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLine")
+	}
+
+	var r0 entity.CreditLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLine, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLine); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLine)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCreditLineBillsRepository_GetCreditLine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLine'
+type MockGetCreditLineBillsRepository_GetCreditLine_Call struct {
+	*mock.Call
+}
+
+// GetCreditLine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockGetCreditLineBillsRepository_Expecter) GetCreditLine(ctx interface{}, creditLineID interface{}) *MockGetCreditLineBillsRepository_GetCreditLine_Call {
+	return &MockGetCreditLineBillsRepository_GetCreditLine_Call{Call: _e.mock.On("GetCreditLine", ctx, creditLineID)}
+}
+
+func (_c *MockGetCreditLineBillsRepository_GetCreditLine_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockGetCreditLineBillsRepository_GetCreditLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCreditLineBillsRepository_GetCreditLine_Call) Return(_a0 entity.CreditLine, _a1 error) *MockGetCreditLineBillsRepository_GetCreditLine_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCreditLineBillsRepository_GetCreditLine_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLine, error)) *MockGetCreditLineBillsRepository_GetCreditLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLineBills provides a mock function with given fields: ctx, creditLineID
+func (_m *MockGetCreditLineBillsRepository) GetCreditLineBills(ctx context.Context, creditLineID uint64) ([]entity.CreditLineBill, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLineBills")
+	}
+
+	var r0 []entity.CreditLineBill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.CreditLineBill, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.CreditLineBill); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CreditLineBill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCreditLineBillsRepository_GetCreditLineBills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLineBills'
+type MockGetCreditLineBillsRepository_GetCreditLineBills_Call struct {
+	*mock.Call
+}
+
+// GetCreditLineBills is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockGetCreditLineBillsRepository_Expecter) GetCreditLineBills(ctx interface{}, creditLineID interface{}) *MockGetCreditLineBillsRepository_GetCreditLineBills_Call {
+	return &MockGetCreditLineBillsRepository_GetCreditLineBills_Call{Call: _e.mock.On("GetCreditLineBills", ctx, creditLineID)}
+}
+
+func (_c *MockGetCreditLineBillsRepository_GetCreditLineBills_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockGetCreditLineBillsRepository_GetCreditLineBills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCreditLineBillsRepository_GetCreditLineBills_Call) Return(_a0 []entity.CreditLineBill, _a1 error) *MockGetCreditLineBillsRepository_GetCreditLineBills_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCreditLineBillsRepository_GetCreditLineBills_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.CreditLineBill, error)) *MockGetCreditLineBillsRepository_GetCreditLineBills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCreditLineBillsRepository creates a new instance of MockGetCreditLineBillsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCreditLineBillsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCreditLineBillsRepository {
+	mock := &MockGetCreditLineBillsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
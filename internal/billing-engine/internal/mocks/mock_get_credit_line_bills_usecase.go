@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCreditLineBillsUsecase is an autogenerated mock type for the GetCreditLineBillsUsecase type
+type MockGetCreditLineBillsUsecase struct {
+	mock.Mock
+}
+
+type MockGetCreditLineBillsUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCreditLineBillsUsecase) EXPECT() *MockGetCreditLineBillsUsecase_Expecter {
+	return &MockGetCreditLineBillsUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, creditLineID
+func (_m *MockGetCreditLineBillsUsecase) Execute(ctx context.Context, creditLineID uint64) ([]usecases.CreditLineBillOutput, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []usecases.CreditLineBillOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]usecases.CreditLineBillOutput, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []usecases.CreditLineBillOutput); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]usecases.CreditLineBillOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCreditLineBillsUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetCreditLineBillsUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockGetCreditLineBillsUsecase_Expecter) Execute(ctx interface{}, creditLineID interface{}) *MockGetCreditLineBillsUsecase_Execute_Call {
+	return &MockGetCreditLineBillsUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, creditLineID)}
+}
+
+func (_c *MockGetCreditLineBillsUsecase_Execute_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockGetCreditLineBillsUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCreditLineBillsUsecase_Execute_Call) Return(_a0 []usecases.CreditLineBillOutput, _a1 error) *MockGetCreditLineBillsUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCreditLineBillsUsecase_Execute_Call) RunAndReturn(run func(context.Context, uint64) ([]usecases.CreditLineBillOutput, error)) *MockGetCreditLineBillsUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCreditLineBillsUsecase creates a new instance of MockGetCreditLineBillsUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCreditLineBillsUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCreditLineBillsUsecase {
+	mock := &MockGetCreditLineBillsUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,269 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	decimal "github.com/shopspring/decimal"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCreditLineRepository is an autogenerated mock type for the GetCreditLineRepository type
+type MockGetCreditLineRepository struct {
+	mock.Mock
+}
+
+type MockGetCreditLineRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCreditLineRepository) EXPECT() *MockGetCreditLineRepository_Expecter {
+	return &MockGetCreditLineRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCreditLine provides a mock function with given fields: ctx, creditLineID
+func (_m *MockGetCreditLineRepository) GetCreditLine(ctx context.Context, creditLineID uint64) (entity.CreditLine, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLine")
+	}
+
+	var r0 entity.CreditLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLine, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLine); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLine)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCreditLineRepository_GetCreditLine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLine'
+type MockGetCreditLineRepository_GetCreditLine_Call struct {
+	*mock.Call
+}
+
+// GetCreditLine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockGetCreditLineRepository_Expecter) GetCreditLine(ctx interface{}, creditLineID interface{}) *MockGetCreditLineRepository_GetCreditLine_Call {
+	return &MockGetCreditLineRepository_GetCreditLine_Call{Call: _e.mock.On("GetCreditLine", ctx, creditLineID)}
+}
+
+func (_c *MockGetCreditLineRepository_GetCreditLine_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockGetCreditLineRepository_GetCreditLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCreditLineRepository_GetCreditLine_Call) Return(_a0 entity.CreditLine, _a1 error) *MockGetCreditLineRepository_GetCreditLine_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCreditLineRepository_GetCreditLine_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLine, error)) *MockGetCreditLineRepository_GetCreditLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLineDrawdowns provides a mock function with given fields: ctx, creditLineID
+func (_m *MockGetCreditLineRepository) GetCreditLineDrawdowns(ctx context.Context, creditLineID uint64) ([]entity.Loan, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLineDrawdowns")
+	}
+
+	var r0 []entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.Loan, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.Loan); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Loan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCreditLineRepository_GetCreditLineDrawdowns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLineDrawdowns'
+type MockGetCreditLineRepository_GetCreditLineDrawdowns_Call struct {
+	*mock.Call
+}
+
+// GetCreditLineDrawdowns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockGetCreditLineRepository_Expecter) GetCreditLineDrawdowns(ctx interface{}, creditLineID interface{}) *MockGetCreditLineRepository_GetCreditLineDrawdowns_Call {
+	return &MockGetCreditLineRepository_GetCreditLineDrawdowns_Call{Call: _e.mock.On("GetCreditLineDrawdowns", ctx, creditLineID)}
+}
+
+func (_c *MockGetCreditLineRepository_GetCreditLineDrawdowns_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockGetCreditLineRepository_GetCreditLineDrawdowns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCreditLineRepository_GetCreditLineDrawdowns_Call) Return(_a0 []entity.Loan, _a1 error) *MockGetCreditLineRepository_GetCreditLineDrawdowns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCreditLineRepository_GetCreditLineDrawdowns_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.Loan, error)) *MockGetCreditLineRepository_GetCreditLineDrawdowns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLineUsed provides a mock function with given fields: ctx, creditLineID
+func (_m *MockGetCreditLineRepository) GetCreditLineUsed(ctx context.Context, creditLineID uint64) (decimal.Decimal, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLineUsed")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (decimal.Decimal, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) decimal.Decimal); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCreditLineRepository_GetCreditLineUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLineUsed'
+type MockGetCreditLineRepository_GetCreditLineUsed_Call struct {
+	*mock.Call
+}
+
+// GetCreditLineUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockGetCreditLineRepository_Expecter) GetCreditLineUsed(ctx interface{}, creditLineID interface{}) *MockGetCreditLineRepository_GetCreditLineUsed_Call {
+	return &MockGetCreditLineRepository_GetCreditLineUsed_Call{Call: _e.mock.On("GetCreditLineUsed", ctx, creditLineID)}
+}
+
+func (_c *MockGetCreditLineRepository_GetCreditLineUsed_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockGetCreditLineRepository_GetCreditLineUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCreditLineRepository_GetCreditLineUsed_Call) Return(_a0 decimal.Decimal, _a1 error) *MockGetCreditLineRepository_GetCreditLineUsed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCreditLineRepository_GetCreditLineUsed_Call) RunAndReturn(run func(context.Context, uint64) (decimal.Decimal, error)) *MockGetCreditLineRepository_GetCreditLineUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCreditLineOverdue provides a mock function with given fields: ctx, creditLineID
+func (_m *MockGetCreditLineRepository) IsCreditLineOverdue(ctx context.Context, creditLineID uint64) (bool, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCreditLineOverdue")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCreditLineRepository_IsCreditLineOverdue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCreditLineOverdue'
+type MockGetCreditLineRepository_IsCreditLineOverdue_Call struct {
+	*mock.Call
+}
+
+// IsCreditLineOverdue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockGetCreditLineRepository_Expecter) IsCreditLineOverdue(ctx interface{}, creditLineID interface{}) *MockGetCreditLineRepository_IsCreditLineOverdue_Call {
+	return &MockGetCreditLineRepository_IsCreditLineOverdue_Call{Call: _e.mock.On("IsCreditLineOverdue", ctx, creditLineID)}
+}
+
+func (_c *MockGetCreditLineRepository_IsCreditLineOverdue_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockGetCreditLineRepository_IsCreditLineOverdue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCreditLineRepository_IsCreditLineOverdue_Call) Return(_a0 bool, _a1 error) *MockGetCreditLineRepository_IsCreditLineOverdue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCreditLineRepository_IsCreditLineOverdue_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockGetCreditLineRepository_IsCreditLineOverdue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCreditLineRepository creates a new instance of MockGetCreditLineRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCreditLineRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCreditLineRepository {
+	mock := &MockGetCreditLineRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCreditLineUsecase is an autogenerated mock type for the GetCreditLineUsecase type
+type MockGetCreditLineUsecase struct {
+	mock.Mock
+}
+
+type MockGetCreditLineUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCreditLineUsecase) EXPECT() *MockGetCreditLineUsecase_Expecter {
+	return &MockGetCreditLineUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, creditLineID
+func (_m *MockGetCreditLineUsecase) Execute(ctx context.Context, creditLineID uint64) (usecases.CreditLineOutput, error) {
+	ret := _m.Called(ctx, creditLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CreditLineOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (usecases.CreditLineOutput, error)); ok {
+		return rf(ctx, creditLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) usecases.CreditLineOutput); ok {
+		r0 = rf(ctx, creditLineID)
+	} else {
+		r0 = ret.Get(0).(usecases.CreditLineOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, creditLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCreditLineUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetCreditLineUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - creditLineID uint64
+func (_e *MockGetCreditLineUsecase_Expecter) Execute(ctx interface{}, creditLineID interface{}) *MockGetCreditLineUsecase_Execute_Call {
+	return &MockGetCreditLineUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, creditLineID)}
+}
+
+func (_c *MockGetCreditLineUsecase_Execute_Call) Run(run func(ctx context.Context, creditLineID uint64)) *MockGetCreditLineUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCreditLineUsecase_Execute_Call) Return(_a0 usecases.CreditLineOutput, _a1 error) *MockGetCreditLineUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCreditLineUsecase_Execute_Call) RunAndReturn(run func(context.Context, uint64) (usecases.CreditLineOutput, error)) *MockGetCreditLineUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCreditLineUsecase creates a new instance of MockGetCreditLineUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCreditLineUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCreditLineUsecase {
+	mock := &MockGetCreditLineUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCustomerDuplicatesRepository is an autogenerated mock type for the GetCustomerDuplicatesRepository type
+type MockGetCustomerDuplicatesRepository struct {
+	mock.Mock
+}
+
+type MockGetCustomerDuplicatesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCustomerDuplicatesRepository) EXPECT() *MockGetCustomerDuplicatesRepository_Expecter {
+	return &MockGetCustomerDuplicatesRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCustomerDuplicates provides a mock function with given fields: ctx, status, customerID
+func (_m *MockGetCustomerDuplicatesRepository) GetCustomerDuplicates(ctx context.Context, status entity.DuplicateStatus, customerID uint64) ([]entity.CustomerDuplicate, error) {
+	ret := _m.Called(ctx, status, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomerDuplicates")
+	}
+
+	var r0 []entity.CustomerDuplicate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.DuplicateStatus, uint64) ([]entity.CustomerDuplicate, error)); ok {
+		return rf(ctx, status, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.DuplicateStatus, uint64) []entity.CustomerDuplicate); ok {
+		r0 = rf(ctx, status, customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CustomerDuplicate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.DuplicateStatus, uint64) error); ok {
+		r1 = rf(ctx, status, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCustomerDuplicatesRepository_GetCustomerDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomerDuplicates'
+type MockGetCustomerDuplicatesRepository_GetCustomerDuplicates_Call struct {
+	*mock.Call
+}
+
+// GetCustomerDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status entity.DuplicateStatus
+//   - customerID uint64
+func (_e *MockGetCustomerDuplicatesRepository_Expecter) GetCustomerDuplicates(ctx interface{}, status interface{}, customerID interface{}) *MockGetCustomerDuplicatesRepository_GetCustomerDuplicates_Call {
+	return &MockGetCustomerDuplicatesRepository_GetCustomerDuplicates_Call{Call: _e.mock.On("GetCustomerDuplicates", ctx, status, customerID)}
+}
+
+func (_c *MockGetCustomerDuplicatesRepository_GetCustomerDuplicates_Call) Run(run func(ctx context.Context, status entity.DuplicateStatus, customerID uint64)) *MockGetCustomerDuplicatesRepository_GetCustomerDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.DuplicateStatus), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCustomerDuplicatesRepository_GetCustomerDuplicates_Call) Return(_a0 []entity.CustomerDuplicate, _a1 error) *MockGetCustomerDuplicatesRepository_GetCustomerDuplicates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCustomerDuplicatesRepository_GetCustomerDuplicates_Call) RunAndReturn(run func(context.Context, entity.DuplicateStatus, uint64) ([]entity.CustomerDuplicate, error)) *MockGetCustomerDuplicatesRepository_GetCustomerDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCustomerDuplicatesRepository creates a new instance of MockGetCustomerDuplicatesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCustomerDuplicatesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCustomerDuplicatesRepository {
+	mock := &MockGetCustomerDuplicatesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCustomerDuplicatesUsecase is an autogenerated mock type for the GetCustomerDuplicatesUsecase type
+type MockGetCustomerDuplicatesUsecase struct {
+	mock.Mock
+}
+
+type MockGetCustomerDuplicatesUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCustomerDuplicatesUsecase) EXPECT() *MockGetCustomerDuplicatesUsecase_Expecter {
+	return &MockGetCustomerDuplicatesUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGetCustomerDuplicatesUsecase) Execute(ctx context.Context, input usecases.GetCustomerDuplicatesInput) (usecases.GetCustomerDuplicatesOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetCustomerDuplicatesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetCustomerDuplicatesInput) (usecases.GetCustomerDuplicatesOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetCustomerDuplicatesInput) usecases.GetCustomerDuplicatesOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GetCustomerDuplicatesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetCustomerDuplicatesInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCustomerDuplicatesUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetCustomerDuplicatesUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GetCustomerDuplicatesInput
+func (_e *MockGetCustomerDuplicatesUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGetCustomerDuplicatesUsecase_Execute_Call {
+	return &MockGetCustomerDuplicatesUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGetCustomerDuplicatesUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GetCustomerDuplicatesInput)) *MockGetCustomerDuplicatesUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GetCustomerDuplicatesInput))
+	})
+	return _c
+}
+
+func (_c *MockGetCustomerDuplicatesUsecase_Execute_Call) Return(_a0 usecases.GetCustomerDuplicatesOutput, _a1 error) *MockGetCustomerDuplicatesUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCustomerDuplicatesUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GetCustomerDuplicatesInput) (usecases.GetCustomerDuplicatesOutput, error)) *MockGetCustomerDuplicatesUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCustomerDuplicatesUsecase creates a new instance of MockGetCustomerDuplicatesUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCustomerDuplicatesUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCustomerDuplicatesUsecase {
+	mock := &MockGetCustomerDuplicatesUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
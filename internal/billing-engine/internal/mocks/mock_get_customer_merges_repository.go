@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCustomerMergesRepository is an autogenerated mock type for the GetCustomerMergesRepository type
+type MockGetCustomerMergesRepository struct {
+	mock.Mock
+}
+
+type MockGetCustomerMergesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCustomerMergesRepository) EXPECT() *MockGetCustomerMergesRepository_Expecter {
+	return &MockGetCustomerMergesRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCustomerMerges provides a mock function with given fields: ctx, customerID
+func (_m *MockGetCustomerMergesRepository) GetCustomerMerges(ctx context.Context, customerID uint64) ([]entity.CustomerMerge, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomerMerges")
+	}
+
+	var r0 []entity.CustomerMerge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.CustomerMerge, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.CustomerMerge); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CustomerMerge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCustomerMergesRepository_GetCustomerMerges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomerMerges'
+type MockGetCustomerMergesRepository_GetCustomerMerges_Call struct {
+	*mock.Call
+}
+
+// GetCustomerMerges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockGetCustomerMergesRepository_Expecter) GetCustomerMerges(ctx interface{}, customerID interface{}) *MockGetCustomerMergesRepository_GetCustomerMerges_Call {
+	return &MockGetCustomerMergesRepository_GetCustomerMerges_Call{Call: _e.mock.On("GetCustomerMerges", ctx, customerID)}
+}
+
+func (_c *MockGetCustomerMergesRepository_GetCustomerMerges_Call) Run(run func(ctx context.Context, customerID uint64)) *MockGetCustomerMergesRepository_GetCustomerMerges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCustomerMergesRepository_GetCustomerMerges_Call) Return(_a0 []entity.CustomerMerge, _a1 error) *MockGetCustomerMergesRepository_GetCustomerMerges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCustomerMergesRepository_GetCustomerMerges_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.CustomerMerge, error)) *MockGetCustomerMergesRepository_GetCustomerMerges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCustomerMergesRepository creates a new instance of MockGetCustomerMergesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCustomerMergesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCustomerMergesRepository {
+	mock := &MockGetCustomerMergesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
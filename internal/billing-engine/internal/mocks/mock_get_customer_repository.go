@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCustomerRepository is an autogenerated mock type for the GetCustomerRepository type
+type MockGetCustomerRepository struct {
+	mock.Mock
+}
+
+type MockGetCustomerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCustomerRepository) EXPECT() *MockGetCustomerRepository_Expecter {
+	return &MockGetCustomerRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCustomer provides a mock function with given fields: ctx, customerID
+func (_m *MockGetCustomerRepository) GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomer")
+	}
+
+	var r0 entity.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Customer, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Customer); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.Customer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCustomerRepository_GetCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomer'
+type MockGetCustomerRepository_GetCustomer_Call struct {
+	*mock.Call
+}
+
+// GetCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockGetCustomerRepository_Expecter) GetCustomer(ctx interface{}, customerID interface{}) *MockGetCustomerRepository_GetCustomer_Call {
+	return &MockGetCustomerRepository_GetCustomer_Call{Call: _e.mock.On("GetCustomer", ctx, customerID)}
+}
+
+func (_c *MockGetCustomerRepository_GetCustomer_Call) Run(run func(ctx context.Context, customerID uint64)) *MockGetCustomerRepository_GetCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCustomerRepository_GetCustomer_Call) Return(_a0 entity.Customer, _a1 error) *MockGetCustomerRepository_GetCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCustomerRepository_GetCustomer_Call) RunAndReturn(run func(context.Context, uint64) (entity.Customer, error)) *MockGetCustomerRepository_GetCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCustomerRepository creates a new instance of MockGetCustomerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCustomerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCustomerRepository {
+	mock := &MockGetCustomerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,268 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCustomerSummaryRepository is an autogenerated mock type for the GetCustomerSummaryRepository type
+type MockGetCustomerSummaryRepository struct {
+	mock.Mock
+}
+
+type MockGetCustomerSummaryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCustomerSummaryRepository) EXPECT() *MockGetCustomerSummaryRepository_Expecter {
+	return &MockGetCustomerSummaryRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCreditLimit provides a mock function with given fields: ctx, customerID
+func (_m *MockGetCustomerSummaryRepository) GetCreditLimit(ctx context.Context, customerID uint64) (entity.CreditLimit, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLimit")
+	}
+
+	var r0 entity.CreditLimit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLimit, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLimit); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLimit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCustomerSummaryRepository_GetCreditLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLimit'
+type MockGetCustomerSummaryRepository_GetCreditLimit_Call struct {
+	*mock.Call
+}
+
+// GetCreditLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockGetCustomerSummaryRepository_Expecter) GetCreditLimit(ctx interface{}, customerID interface{}) *MockGetCustomerSummaryRepository_GetCreditLimit_Call {
+	return &MockGetCustomerSummaryRepository_GetCreditLimit_Call{Call: _e.mock.On("GetCreditLimit", ctx, customerID)}
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetCreditLimit_Call) Run(run func(ctx context.Context, customerID uint64)) *MockGetCustomerSummaryRepository_GetCreditLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetCreditLimit_Call) Return(_a0 entity.CreditLimit, _a1 error) *MockGetCustomerSummaryRepository_GetCreditLimit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetCreditLimit_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLimit, error)) *MockGetCustomerSummaryRepository_GetCreditLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomer provides a mock function with given fields: ctx, customerID
+func (_m *MockGetCustomerSummaryRepository) GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomer")
+	}
+
+	var r0 entity.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Customer, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Customer); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.Customer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCustomerSummaryRepository_GetCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomer'
+type MockGetCustomerSummaryRepository_GetCustomer_Call struct {
+	*mock.Call
+}
+
+// GetCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockGetCustomerSummaryRepository_Expecter) GetCustomer(ctx interface{}, customerID interface{}) *MockGetCustomerSummaryRepository_GetCustomer_Call {
+	return &MockGetCustomerSummaryRepository_GetCustomer_Call{Call: _e.mock.On("GetCustomer", ctx, customerID)}
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetCustomer_Call) Run(run func(ctx context.Context, customerID uint64)) *MockGetCustomerSummaryRepository_GetCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetCustomer_Call) Return(_a0 entity.Customer, _a1 error) *MockGetCustomerSummaryRepository_GetCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetCustomer_Call) RunAndReturn(run func(context.Context, uint64) (entity.Customer, error)) *MockGetCustomerSummaryRepository_GetCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomerExposure provides a mock function with given fields: ctx, customerID
+func (_m *MockGetCustomerSummaryRepository) GetCustomerExposure(ctx context.Context, customerID uint64) (entity.CustomerExposure, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomerExposure")
+	}
+
+	var r0 entity.CustomerExposure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CustomerExposure, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CustomerExposure); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CustomerExposure)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCustomerSummaryRepository_GetCustomerExposure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomerExposure'
+type MockGetCustomerSummaryRepository_GetCustomerExposure_Call struct {
+	*mock.Call
+}
+
+// GetCustomerExposure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockGetCustomerSummaryRepository_Expecter) GetCustomerExposure(ctx interface{}, customerID interface{}) *MockGetCustomerSummaryRepository_GetCustomerExposure_Call {
+	return &MockGetCustomerSummaryRepository_GetCustomerExposure_Call{Call: _e.mock.On("GetCustomerExposure", ctx, customerID)}
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetCustomerExposure_Call) Run(run func(ctx context.Context, customerID uint64)) *MockGetCustomerSummaryRepository_GetCustomerExposure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetCustomerExposure_Call) Return(_a0 entity.CustomerExposure, _a1 error) *MockGetCustomerSummaryRepository_GetCustomerExposure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetCustomerExposure_Call) RunAndReturn(run func(context.Context, uint64) (entity.CustomerExposure, error)) *MockGetCustomerSummaryRepository_GetCustomerExposure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanObligations provides a mock function with given fields: ctx, customerID
+func (_m *MockGetCustomerSummaryRepository) GetLoanObligations(ctx context.Context, customerID uint64) ([]entity.LoanObligation, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanObligations")
+	}
+
+	var r0 []entity.LoanObligation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanObligation, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanObligation); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanObligation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCustomerSummaryRepository_GetLoanObligations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanObligations'
+type MockGetCustomerSummaryRepository_GetLoanObligations_Call struct {
+	*mock.Call
+}
+
+// GetLoanObligations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockGetCustomerSummaryRepository_Expecter) GetLoanObligations(ctx interface{}, customerID interface{}) *MockGetCustomerSummaryRepository_GetLoanObligations_Call {
+	return &MockGetCustomerSummaryRepository_GetLoanObligations_Call{Call: _e.mock.On("GetLoanObligations", ctx, customerID)}
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetLoanObligations_Call) Run(run func(ctx context.Context, customerID uint64)) *MockGetCustomerSummaryRepository_GetLoanObligations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetLoanObligations_Call) Return(_a0 []entity.LoanObligation, _a1 error) *MockGetCustomerSummaryRepository_GetLoanObligations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCustomerSummaryRepository_GetLoanObligations_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanObligation, error)) *MockGetCustomerSummaryRepository_GetLoanObligations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCustomerSummaryRepository creates a new instance of MockGetCustomerSummaryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCustomerSummaryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCustomerSummaryRepository {
+	mock := &MockGetCustomerSummaryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetGLMappingsRepository is an autogenerated mock type for the GetGLMappingsRepository type
+type MockGetGLMappingsRepository struct {
+	mock.Mock
+}
+
+type MockGetGLMappingsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetGLMappingsRepository) EXPECT() *MockGetGLMappingsRepository_Expecter {
+	return &MockGetGLMappingsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetGLMappings provides a mock function with given fields: ctx
+func (_m *MockGetGLMappingsRepository) GetGLMappings(ctx context.Context) ([]entity.GLMapping, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGLMappings")
+	}
+
+	var r0 []entity.GLMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.GLMapping, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.GLMapping); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.GLMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetGLMappingsRepository_GetGLMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGLMappings'
+type MockGetGLMappingsRepository_GetGLMappings_Call struct {
+	*mock.Call
+}
+
+// GetGLMappings is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGetGLMappingsRepository_Expecter) GetGLMappings(ctx interface{}) *MockGetGLMappingsRepository_GetGLMappings_Call {
+	return &MockGetGLMappingsRepository_GetGLMappings_Call{Call: _e.mock.On("GetGLMappings", ctx)}
+}
+
+func (_c *MockGetGLMappingsRepository_GetGLMappings_Call) Run(run func(ctx context.Context)) *MockGetGLMappingsRepository_GetGLMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGetGLMappingsRepository_GetGLMappings_Call) Return(_a0 []entity.GLMapping, _a1 error) *MockGetGLMappingsRepository_GetGLMappings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetGLMappingsRepository_GetGLMappings_Call) RunAndReturn(run func(context.Context) ([]entity.GLMapping, error)) *MockGetGLMappingsRepository_GetGLMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetGLMappingsRepository creates a new instance of MockGetGLMappingsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetGLMappingsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetGLMappingsRepository {
+	mock := &MockGetGLMappingsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
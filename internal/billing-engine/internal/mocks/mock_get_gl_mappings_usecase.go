@@ -0,0 +1,93 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetGLMappingsUsecase is an autogenerated mock type for the GetGLMappingsUsecase type
+type MockGetGLMappingsUsecase struct {
+	mock.Mock
+}
+
+type MockGetGLMappingsUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetGLMappingsUsecase) EXPECT() *MockGetGLMappingsUsecase_Expecter {
+	return &MockGetGLMappingsUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx
+func (_m *MockGetGLMappingsUsecase) Execute(ctx context.Context) (usecases.GetGLMappingsOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetGLMappingsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (usecases.GetGLMappingsOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) usecases.GetGLMappingsOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(usecases.GetGLMappingsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetGLMappingsUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetGLMappingsUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGetGLMappingsUsecase_Expecter) Execute(ctx interface{}) *MockGetGLMappingsUsecase_Execute_Call {
+	return &MockGetGLMappingsUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+}
+
+func (_c *MockGetGLMappingsUsecase_Execute_Call) Run(run func(ctx context.Context)) *MockGetGLMappingsUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGetGLMappingsUsecase_Execute_Call) Return(_a0 usecases.GetGLMappingsOutput, _a1 error) *MockGetGLMappingsUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetGLMappingsUsecase_Execute_Call) RunAndReturn(run func(context.Context) (usecases.GetGLMappingsOutput, error)) *MockGetGLMappingsUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetGLMappingsUsecase creates a new instance of MockGetGLMappingsUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetGLMappingsUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetGLMappingsUsecase {
+	mock := &MockGetGLMappingsUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -82,6 +82,65 @@ func (_c *MockGetInstallmentsRepository_GetInstallments_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetLoanFees provides a mock function with given fields: ctx, loanID
+func (_m *MockGetInstallmentsRepository) GetLoanFees(ctx context.Context, loanID uint64) ([]entity.LoanFee, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanFees")
+	}
+
+	var r0 []entity.LoanFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanFee, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanFee); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetInstallmentsRepository_GetLoanFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanFees'
+type MockGetInstallmentsRepository_GetLoanFees_Call struct {
+	*mock.Call
+}
+
+// GetLoanFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetInstallmentsRepository_Expecter) GetLoanFees(ctx interface{}, loanID interface{}) *MockGetInstallmentsRepository_GetLoanFees_Call {
+	return &MockGetInstallmentsRepository_GetLoanFees_Call{Call: _e.mock.On("GetLoanFees", ctx, loanID)}
+}
+
+func (_c *MockGetInstallmentsRepository_GetLoanFees_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetInstallmentsRepository_GetLoanFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetInstallmentsRepository_GetLoanFees_Call) Return(_a0 []entity.LoanFee, _a1 error) *MockGetInstallmentsRepository_GetLoanFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetInstallmentsRepository_GetLoanFees_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanFee, error)) *MockGetInstallmentsRepository_GetLoanFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockGetInstallmentsRepository creates a new instance of MockGetInstallmentsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockGetInstallmentsRepository(t interface {
@@ -0,0 +1,101 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetInterestAccrualsRepository is an autogenerated mock type for the GetInterestAccrualsRepository type
+type MockGetInterestAccrualsRepository struct {
+	mock.Mock
+}
+
+type MockGetInterestAccrualsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetInterestAccrualsRepository) EXPECT() *MockGetInterestAccrualsRepository_Expecter {
+	return &MockGetInterestAccrualsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetInterestAccruals provides a mock function with given fields: ctx, loanID, from, to
+func (_m *MockGetInterestAccrualsRepository) GetInterestAccruals(ctx context.Context, loanID uint64, from time.Time, to time.Time) ([]entity.InterestAccrual, error) {
+	ret := _m.Called(ctx, loanID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInterestAccruals")
+	}
+
+	var r0 []entity.InterestAccrual
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, time.Time) ([]entity.InterestAccrual, error)); ok {
+		return rf(ctx, loanID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, time.Time) []entity.InterestAccrual); ok {
+		r0 = rf(ctx, loanID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.InterestAccrual)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, loanID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetInterestAccrualsRepository_GetInterestAccruals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInterestAccruals'
+type MockGetInterestAccrualsRepository_GetInterestAccruals_Call struct {
+	*mock.Call
+}
+
+// GetInterestAccruals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - from time.Time
+//   - to time.Time
+func (_e *MockGetInterestAccrualsRepository_Expecter) GetInterestAccruals(ctx interface{}, loanID interface{}, from interface{}, to interface{}) *MockGetInterestAccrualsRepository_GetInterestAccruals_Call {
+	return &MockGetInterestAccrualsRepository_GetInterestAccruals_Call{Call: _e.mock.On("GetInterestAccruals", ctx, loanID, from, to)}
+}
+
+func (_c *MockGetInterestAccrualsRepository_GetInterestAccruals_Call) Run(run func(ctx context.Context, loanID uint64, from time.Time, to time.Time)) *MockGetInterestAccrualsRepository_GetInterestAccruals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGetInterestAccrualsRepository_GetInterestAccruals_Call) Return(_a0 []entity.InterestAccrual, _a1 error) *MockGetInterestAccrualsRepository_GetInterestAccruals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetInterestAccrualsRepository_GetInterestAccruals_Call) RunAndReturn(run func(context.Context, uint64, time.Time, time.Time) ([]entity.InterestAccrual, error)) *MockGetInterestAccrualsRepository_GetInterestAccruals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetInterestAccrualsRepository creates a new instance of MockGetInterestAccrualsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetInterestAccrualsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetInterestAccrualsRepository {
+	mock := &MockGetInterestAccrualsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
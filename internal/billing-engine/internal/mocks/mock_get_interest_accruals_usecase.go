@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetInterestAccrualsUsecase is an autogenerated mock type for the GetInterestAccrualsUsecase type
+type MockGetInterestAccrualsUsecase struct {
+	mock.Mock
+}
+
+type MockGetInterestAccrualsUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetInterestAccrualsUsecase) EXPECT() *MockGetInterestAccrualsUsecase_Expecter {
+	return &MockGetInterestAccrualsUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGetInterestAccrualsUsecase) Execute(ctx context.Context, input usecases.GetInterestAccrualsInput) (usecases.GetInterestAccrualsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetInterestAccrualsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetInterestAccrualsInput) (usecases.GetInterestAccrualsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetInterestAccrualsInput) usecases.GetInterestAccrualsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GetInterestAccrualsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetInterestAccrualsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetInterestAccrualsUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetInterestAccrualsUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GetInterestAccrualsInput
+func (_e *MockGetInterestAccrualsUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGetInterestAccrualsUsecase_Execute_Call {
+	return &MockGetInterestAccrualsUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGetInterestAccrualsUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GetInterestAccrualsInput)) *MockGetInterestAccrualsUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GetInterestAccrualsInput))
+	})
+	return _c
+}
+
+func (_c *MockGetInterestAccrualsUsecase_Execute_Call) Return(_a0 usecases.GetInterestAccrualsOutput, _a1 error) *MockGetInterestAccrualsUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetInterestAccrualsUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GetInterestAccrualsInput) (usecases.GetInterestAccrualsOutput, error)) *MockGetInterestAccrualsUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetInterestAccrualsUsecase creates a new instance of MockGetInterestAccrualsUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetInterestAccrualsUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetInterestAccrualsUsecase {
+	mock := &MockGetInterestAccrualsUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,212 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	decimal "github.com/shopspring/decimal"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoanCollateralRepository is an autogenerated mock type for the GetLoanCollateralRepository type
+type MockGetLoanCollateralRepository struct {
+	mock.Mock
+}
+
+type MockGetLoanCollateralRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoanCollateralRepository) EXPECT() *MockGetLoanCollateralRepository_Expecter {
+	return &MockGetLoanCollateralRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanCollateralRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanCollateralRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockGetLoanCollateralRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanCollateralRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockGetLoanCollateralRepository_GetLoan_Call {
+	return &MockGetLoanCollateralRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockGetLoanCollateralRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanCollateralRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanCollateralRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockGetLoanCollateralRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanCollateralRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockGetLoanCollateralRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanCollaterals provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanCollateralRepository) GetLoanCollaterals(ctx context.Context, loanID uint64) ([]entity.Collateral, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanCollaterals")
+	}
+
+	var r0 []entity.Collateral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.Collateral, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.Collateral); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Collateral)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanCollateralRepository_GetLoanCollaterals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanCollaterals'
+type MockGetLoanCollateralRepository_GetLoanCollaterals_Call struct {
+	*mock.Call
+}
+
+// GetLoanCollaterals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanCollateralRepository_Expecter) GetLoanCollaterals(ctx interface{}, loanID interface{}) *MockGetLoanCollateralRepository_GetLoanCollaterals_Call {
+	return &MockGetLoanCollateralRepository_GetLoanCollaterals_Call{Call: _e.mock.On("GetLoanCollaterals", ctx, loanID)}
+}
+
+func (_c *MockGetLoanCollateralRepository_GetLoanCollaterals_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanCollateralRepository_GetLoanCollaterals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanCollateralRepository_GetLoanCollaterals_Call) Return(_a0 []entity.Collateral, _a1 error) *MockGetLoanCollateralRepository_GetLoanCollaterals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanCollateralRepository_GetLoanCollaterals_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.Collateral, error)) *MockGetLoanCollateralRepository_GetLoanCollaterals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOutstanding provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanCollateralRepository) GetOutstanding(ctx context.Context, loanID uint64) (decimal.Decimal, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutstanding")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (decimal.Decimal, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) decimal.Decimal); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanCollateralRepository_GetOutstanding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOutstanding'
+type MockGetLoanCollateralRepository_GetOutstanding_Call struct {
+	*mock.Call
+}
+
+// GetOutstanding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanCollateralRepository_Expecter) GetOutstanding(ctx interface{}, loanID interface{}) *MockGetLoanCollateralRepository_GetOutstanding_Call {
+	return &MockGetLoanCollateralRepository_GetOutstanding_Call{Call: _e.mock.On("GetOutstanding", ctx, loanID)}
+}
+
+func (_c *MockGetLoanCollateralRepository_GetOutstanding_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanCollateralRepository_GetOutstanding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanCollateralRepository_GetOutstanding_Call) Return(_a0 decimal.Decimal, _a1 error) *MockGetLoanCollateralRepository_GetOutstanding_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanCollateralRepository_GetOutstanding_Call) RunAndReturn(run func(context.Context, uint64) (decimal.Decimal, error)) *MockGetLoanCollateralRepository_GetOutstanding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoanCollateralRepository creates a new instance of MockGetLoanCollateralRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoanCollateralRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoanCollateralRepository {
+	mock := &MockGetLoanCollateralRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoanCommunicationsRepository is an autogenerated mock type for the GetLoanCommunicationsRepository type
+type MockGetLoanCommunicationsRepository struct {
+	mock.Mock
+}
+
+type MockGetLoanCommunicationsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoanCommunicationsRepository) EXPECT() *MockGetLoanCommunicationsRepository_Expecter {
+	return &MockGetLoanCommunicationsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCommunicationsByLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanCommunicationsRepository) GetCommunicationsByLoan(ctx context.Context, loanID uint64) ([]entity.Communication, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommunicationsByLoan")
+	}
+
+	var r0 []entity.Communication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.Communication, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.Communication); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Communication)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanCommunicationsRepository_GetCommunicationsByLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommunicationsByLoan'
+type MockGetLoanCommunicationsRepository_GetCommunicationsByLoan_Call struct {
+	*mock.Call
+}
+
+// GetCommunicationsByLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanCommunicationsRepository_Expecter) GetCommunicationsByLoan(ctx interface{}, loanID interface{}) *MockGetLoanCommunicationsRepository_GetCommunicationsByLoan_Call {
+	return &MockGetLoanCommunicationsRepository_GetCommunicationsByLoan_Call{Call: _e.mock.On("GetCommunicationsByLoan", ctx, loanID)}
+}
+
+func (_c *MockGetLoanCommunicationsRepository_GetCommunicationsByLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanCommunicationsRepository_GetCommunicationsByLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanCommunicationsRepository_GetCommunicationsByLoan_Call) Return(_a0 []entity.Communication, _a1 error) *MockGetLoanCommunicationsRepository_GetCommunicationsByLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanCommunicationsRepository_GetCommunicationsByLoan_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.Communication, error)) *MockGetLoanCommunicationsRepository_GetCommunicationsByLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoanCommunicationsRepository creates a new instance of MockGetLoanCommunicationsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoanCommunicationsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoanCommunicationsRepository {
+	mock := &MockGetLoanCommunicationsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
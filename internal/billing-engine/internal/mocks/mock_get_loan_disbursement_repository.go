@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoanDisbursementRepository is an autogenerated mock type for the GetLoanDisbursementRepository type
+type MockGetLoanDisbursementRepository struct {
+	mock.Mock
+}
+
+type MockGetLoanDisbursementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoanDisbursementRepository) EXPECT() *MockGetLoanDisbursementRepository_Expecter {
+	return &MockGetLoanDisbursementRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetDisbursementByLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanDisbursementRepository) GetDisbursementByLoan(ctx context.Context, loanID uint64) (entity.Disbursement, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDisbursementByLoan")
+	}
+
+	var r0 entity.Disbursement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Disbursement, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Disbursement); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Disbursement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanDisbursementRepository_GetDisbursementByLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDisbursementByLoan'
+type MockGetLoanDisbursementRepository_GetDisbursementByLoan_Call struct {
+	*mock.Call
+}
+
+// GetDisbursementByLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanDisbursementRepository_Expecter) GetDisbursementByLoan(ctx interface{}, loanID interface{}) *MockGetLoanDisbursementRepository_GetDisbursementByLoan_Call {
+	return &MockGetLoanDisbursementRepository_GetDisbursementByLoan_Call{Call: _e.mock.On("GetDisbursementByLoan", ctx, loanID)}
+}
+
+func (_c *MockGetLoanDisbursementRepository_GetDisbursementByLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanDisbursementRepository_GetDisbursementByLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanDisbursementRepository_GetDisbursementByLoan_Call) Return(_a0 entity.Disbursement, _a1 error) *MockGetLoanDisbursementRepository_GetDisbursementByLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanDisbursementRepository_GetDisbursementByLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Disbursement, error)) *MockGetLoanDisbursementRepository_GetDisbursementByLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoanDisbursementRepository creates a new instance of MockGetLoanDisbursementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoanDisbursementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoanDisbursementRepository {
+	mock := &MockGetLoanDisbursementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoanDisclosureRepository is an autogenerated mock type for the GetLoanDisclosureRepository type
+type MockGetLoanDisclosureRepository struct {
+	mock.Mock
+}
+
+type MockGetLoanDisclosureRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoanDisclosureRepository) EXPECT() *MockGetLoanDisclosureRepository_Expecter {
+	return &MockGetLoanDisclosureRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLoanDisclosure provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanDisclosureRepository) GetLoanDisclosure(ctx context.Context, loanID uint64) (entity.LoanDisclosure, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanDisclosure")
+	}
+
+	var r0 entity.LoanDisclosure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.LoanDisclosure, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.LoanDisclosure); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.LoanDisclosure)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanDisclosureRepository_GetLoanDisclosure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanDisclosure'
+type MockGetLoanDisclosureRepository_GetLoanDisclosure_Call struct {
+	*mock.Call
+}
+
+// GetLoanDisclosure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanDisclosureRepository_Expecter) GetLoanDisclosure(ctx interface{}, loanID interface{}) *MockGetLoanDisclosureRepository_GetLoanDisclosure_Call {
+	return &MockGetLoanDisclosureRepository_GetLoanDisclosure_Call{Call: _e.mock.On("GetLoanDisclosure", ctx, loanID)}
+}
+
+func (_c *MockGetLoanDisclosureRepository_GetLoanDisclosure_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanDisclosureRepository_GetLoanDisclosure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanDisclosureRepository_GetLoanDisclosure_Call) Return(_a0 entity.LoanDisclosure, _a1 error) *MockGetLoanDisclosureRepository_GetLoanDisclosure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanDisclosureRepository_GetLoanDisclosure_Call) RunAndReturn(run func(context.Context, uint64) (entity.LoanDisclosure, error)) *MockGetLoanDisclosureRepository_GetLoanDisclosure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoanDisclosureRepository creates a new instance of MockGetLoanDisclosureRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoanDisclosureRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoanDisclosureRepository {
+	mock := &MockGetLoanDisclosureRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,154 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoanFeesRepository is an autogenerated mock type for the GetLoanFeesRepository type
+type MockGetLoanFeesRepository struct {
+	mock.Mock
+}
+
+type MockGetLoanFeesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoanFeesRepository) EXPECT() *MockGetLoanFeesRepository_Expecter {
+	return &MockGetLoanFeesRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanFeesRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanFeesRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockGetLoanFeesRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanFeesRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockGetLoanFeesRepository_GetLoan_Call {
+	return &MockGetLoanFeesRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockGetLoanFeesRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanFeesRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanFeesRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockGetLoanFeesRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanFeesRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockGetLoanFeesRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanFees provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanFeesRepository) GetLoanFees(ctx context.Context, loanID uint64) ([]entity.LoanFee, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanFees")
+	}
+
+	var r0 []entity.LoanFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanFee, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanFee); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanFeesRepository_GetLoanFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanFees'
+type MockGetLoanFeesRepository_GetLoanFees_Call struct {
+	*mock.Call
+}
+
+// GetLoanFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanFeesRepository_Expecter) GetLoanFees(ctx interface{}, loanID interface{}) *MockGetLoanFeesRepository_GetLoanFees_Call {
+	return &MockGetLoanFeesRepository_GetLoanFees_Call{Call: _e.mock.On("GetLoanFees", ctx, loanID)}
+}
+
+func (_c *MockGetLoanFeesRepository_GetLoanFees_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanFeesRepository_GetLoanFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanFeesRepository_GetLoanFees_Call) Return(_a0 []entity.LoanFee, _a1 error) *MockGetLoanFeesRepository_GetLoanFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanFeesRepository_GetLoanFees_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanFee, error)) *MockGetLoanFeesRepository_GetLoanFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoanFeesRepository creates a new instance of MockGetLoanFeesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoanFeesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoanFeesRepository {
+	mock := &MockGetLoanFeesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
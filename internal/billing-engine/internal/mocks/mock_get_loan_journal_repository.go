@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoanJournalRepository is an autogenerated mock type for the GetLoanJournalRepository type
+type MockGetLoanJournalRepository struct {
+	mock.Mock
+}
+
+type MockGetLoanJournalRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoanJournalRepository) EXPECT() *MockGetLoanJournalRepository_Expecter {
+	return &MockGetLoanJournalRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetJournalEntriesByLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanJournalRepository) GetJournalEntriesByLoan(ctx context.Context, loanID uint64) ([]entity.JournalEntry, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJournalEntriesByLoan")
+	}
+
+	var r0 []entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.JournalEntry, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.JournalEntry); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.JournalEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanJournalRepository_GetJournalEntriesByLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJournalEntriesByLoan'
+type MockGetLoanJournalRepository_GetJournalEntriesByLoan_Call struct {
+	*mock.Call
+}
+
+// GetJournalEntriesByLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanJournalRepository_Expecter) GetJournalEntriesByLoan(ctx interface{}, loanID interface{}) *MockGetLoanJournalRepository_GetJournalEntriesByLoan_Call {
+	return &MockGetLoanJournalRepository_GetJournalEntriesByLoan_Call{Call: _e.mock.On("GetJournalEntriesByLoan", ctx, loanID)}
+}
+
+func (_c *MockGetLoanJournalRepository_GetJournalEntriesByLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanJournalRepository_GetJournalEntriesByLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanJournalRepository_GetJournalEntriesByLoan_Call) Return(_a0 []entity.JournalEntry, _a1 error) *MockGetLoanJournalRepository_GetJournalEntriesByLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanJournalRepository_GetJournalEntriesByLoan_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.JournalEntry, error)) *MockGetLoanJournalRepository_GetJournalEntriesByLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoanJournalRepository creates a new instance of MockGetLoanJournalRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoanJournalRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoanJournalRepository {
+	mock := &MockGetLoanJournalRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
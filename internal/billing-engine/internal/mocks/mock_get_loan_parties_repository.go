@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoanPartiesRepository is an autogenerated mock type for the GetLoanPartiesRepository type
+type MockGetLoanPartiesRepository struct {
+	mock.Mock
+}
+
+type MockGetLoanPartiesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoanPartiesRepository) EXPECT() *MockGetLoanPartiesRepository_Expecter {
+	return &MockGetLoanPartiesRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLoanParties provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanPartiesRepository) GetLoanParties(ctx context.Context, loanID uint64) ([]entity.LoanParty, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanParties")
+	}
+
+	var r0 []entity.LoanParty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanParty, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanParty); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanParty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanPartiesRepository_GetLoanParties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanParties'
+type MockGetLoanPartiesRepository_GetLoanParties_Call struct {
+	*mock.Call
+}
+
+// GetLoanParties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanPartiesRepository_Expecter) GetLoanParties(ctx interface{}, loanID interface{}) *MockGetLoanPartiesRepository_GetLoanParties_Call {
+	return &MockGetLoanPartiesRepository_GetLoanParties_Call{Call: _e.mock.On("GetLoanParties", ctx, loanID)}
+}
+
+func (_c *MockGetLoanPartiesRepository_GetLoanParties_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanPartiesRepository_GetLoanParties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanPartiesRepository_GetLoanParties_Call) Return(_a0 []entity.LoanParty, _a1 error) *MockGetLoanPartiesRepository_GetLoanParties_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanPartiesRepository_GetLoanParties_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanParty, error)) *MockGetLoanPartiesRepository_GetLoanParties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoanPartiesRepository creates a new instance of MockGetLoanPartiesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoanPartiesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoanPartiesRepository {
+	mock := &MockGetLoanPartiesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,154 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoanRecoveriesRepository is an autogenerated mock type for the GetLoanRecoveriesRepository type
+type MockGetLoanRecoveriesRepository struct {
+	mock.Mock
+}
+
+type MockGetLoanRecoveriesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoanRecoveriesRepository) EXPECT() *MockGetLoanRecoveriesRepository_Expecter {
+	return &MockGetLoanRecoveriesRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetRecoveriesByLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanRecoveriesRepository) GetRecoveriesByLoan(ctx context.Context, loanID uint64) ([]entity.Recovery, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecoveriesByLoan")
+	}
+
+	var r0 []entity.Recovery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.Recovery, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.Recovery); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Recovery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanRecoveriesRepository_GetRecoveriesByLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveriesByLoan'
+type MockGetLoanRecoveriesRepository_GetRecoveriesByLoan_Call struct {
+	*mock.Call
+}
+
+// GetRecoveriesByLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanRecoveriesRepository_Expecter) GetRecoveriesByLoan(ctx interface{}, loanID interface{}) *MockGetLoanRecoveriesRepository_GetRecoveriesByLoan_Call {
+	return &MockGetLoanRecoveriesRepository_GetRecoveriesByLoan_Call{Call: _e.mock.On("GetRecoveriesByLoan", ctx, loanID)}
+}
+
+func (_c *MockGetLoanRecoveriesRepository_GetRecoveriesByLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanRecoveriesRepository_GetRecoveriesByLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanRecoveriesRepository_GetRecoveriesByLoan_Call) Return(_a0 []entity.Recovery, _a1 error) *MockGetLoanRecoveriesRepository_GetRecoveriesByLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanRecoveriesRepository_GetRecoveriesByLoan_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.Recovery, error)) *MockGetLoanRecoveriesRepository_GetRecoveriesByLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWriteOffByLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanRecoveriesRepository) GetWriteOffByLoan(ctx context.Context, loanID uint64) (entity.WriteOff, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWriteOffByLoan")
+	}
+
+	var r0 entity.WriteOff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.WriteOff, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.WriteOff); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.WriteOff)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanRecoveriesRepository_GetWriteOffByLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWriteOffByLoan'
+type MockGetLoanRecoveriesRepository_GetWriteOffByLoan_Call struct {
+	*mock.Call
+}
+
+// GetWriteOffByLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanRecoveriesRepository_Expecter) GetWriteOffByLoan(ctx interface{}, loanID interface{}) *MockGetLoanRecoveriesRepository_GetWriteOffByLoan_Call {
+	return &MockGetLoanRecoveriesRepository_GetWriteOffByLoan_Call{Call: _e.mock.On("GetWriteOffByLoan", ctx, loanID)}
+}
+
+func (_c *MockGetLoanRecoveriesRepository_GetWriteOffByLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanRecoveriesRepository_GetWriteOffByLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanRecoveriesRepository_GetWriteOffByLoan_Call) Return(_a0 entity.WriteOff, _a1 error) *MockGetLoanRecoveriesRepository_GetWriteOffByLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanRecoveriesRepository_GetWriteOffByLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.WriteOff, error)) *MockGetLoanRecoveriesRepository_GetWriteOffByLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoanRecoveriesRepository creates a new instance of MockGetLoanRecoveriesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoanRecoveriesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoanRecoveriesRepository {
+	mock := &MockGetLoanRecoveriesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoanRestructuresRepository is an autogenerated mock type for the GetLoanRestructuresRepository type
+type MockGetLoanRestructuresRepository struct {
+	mock.Mock
+}
+
+type MockGetLoanRestructuresRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoanRestructuresRepository) EXPECT() *MockGetLoanRestructuresRepository_Expecter {
+	return &MockGetLoanRestructuresRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLoanRestructures provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanRestructuresRepository) GetLoanRestructures(ctx context.Context, loanID uint64) ([]entity.LoanRestructure, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanRestructures")
+	}
+
+	var r0 []entity.LoanRestructure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanRestructure, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanRestructure); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanRestructure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanRestructuresRepository_GetLoanRestructures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanRestructures'
+type MockGetLoanRestructuresRepository_GetLoanRestructures_Call struct {
+	*mock.Call
+}
+
+// GetLoanRestructures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanRestructuresRepository_Expecter) GetLoanRestructures(ctx interface{}, loanID interface{}) *MockGetLoanRestructuresRepository_GetLoanRestructures_Call {
+	return &MockGetLoanRestructuresRepository_GetLoanRestructures_Call{Call: _e.mock.On("GetLoanRestructures", ctx, loanID)}
+}
+
+func (_c *MockGetLoanRestructuresRepository_GetLoanRestructures_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanRestructuresRepository_GetLoanRestructures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanRestructuresRepository_GetLoanRestructures_Call) Return(_a0 []entity.LoanRestructure, _a1 error) *MockGetLoanRestructuresRepository_GetLoanRestructures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanRestructuresRepository_GetLoanRestructures_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanRestructure, error)) *MockGetLoanRestructuresRepository_GetLoanRestructures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoanRestructuresRepository creates a new instance of MockGetLoanRestructuresRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoanRestructuresRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoanRestructuresRepository {
+	mock := &MockGetLoanRestructuresRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
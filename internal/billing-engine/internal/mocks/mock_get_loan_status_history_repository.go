@@ -0,0 +1,154 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoanStatusHistoryRepository is an autogenerated mock type for the GetLoanStatusHistoryRepository type
+type MockGetLoanStatusHistoryRepository struct {
+	mock.Mock
+}
+
+type MockGetLoanStatusHistoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoanStatusHistoryRepository) EXPECT() *MockGetLoanStatusHistoryRepository_Expecter {
+	return &MockGetLoanStatusHistoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanStatusHistoryRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanStatusHistoryRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockGetLoanStatusHistoryRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanStatusHistoryRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockGetLoanStatusHistoryRepository_GetLoan_Call {
+	return &MockGetLoanStatusHistoryRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockGetLoanStatusHistoryRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanStatusHistoryRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanStatusHistoryRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockGetLoanStatusHistoryRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanStatusHistoryRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockGetLoanStatusHistoryRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanStatusTransitions provides a mock function with given fields: ctx, loanID
+func (_m *MockGetLoanStatusHistoryRepository) GetLoanStatusTransitions(ctx context.Context, loanID uint64) ([]entity.LoanStatusTransition, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanStatusTransitions")
+	}
+
+	var r0 []entity.LoanStatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanStatusTransition, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanStatusTransition); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanStatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoanStatusHistoryRepository_GetLoanStatusTransitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanStatusTransitions'
+type MockGetLoanStatusHistoryRepository_GetLoanStatusTransitions_Call struct {
+	*mock.Call
+}
+
+// GetLoanStatusTransitions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockGetLoanStatusHistoryRepository_Expecter) GetLoanStatusTransitions(ctx interface{}, loanID interface{}) *MockGetLoanStatusHistoryRepository_GetLoanStatusTransitions_Call {
+	return &MockGetLoanStatusHistoryRepository_GetLoanStatusTransitions_Call{Call: _e.mock.On("GetLoanStatusTransitions", ctx, loanID)}
+}
+
+func (_c *MockGetLoanStatusHistoryRepository_GetLoanStatusTransitions_Call) Run(run func(ctx context.Context, loanID uint64)) *MockGetLoanStatusHistoryRepository_GetLoanStatusTransitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoanStatusHistoryRepository_GetLoanStatusTransitions_Call) Return(_a0 []entity.LoanStatusTransition, _a1 error) *MockGetLoanStatusHistoryRepository_GetLoanStatusTransitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoanStatusHistoryRepository_GetLoanStatusTransitions_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanStatusTransition, error)) *MockGetLoanStatusHistoryRepository_GetLoanStatusTransitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoanStatusHistoryRepository creates a new instance of MockGetLoanStatusHistoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoanStatusHistoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoanStatusHistoryRepository {
+	mock := &MockGetLoanStatusHistoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,213 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoansRepository is an autogenerated mock type for the GetLoansRepository type
+type MockGetLoansRepository struct {
+	mock.Mock
+}
+
+type MockGetLoansRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoansRepository) EXPECT() *MockGetLoansRepository_Expecter {
+	return &MockGetLoansRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetUnpaidInstallmentsOfLoans provides a mock function with given fields: ctx, loanIDs
+func (_m *MockGetLoansRepository) GetUnpaidInstallmentsOfLoans(ctx context.Context, loanIDs []uint64) ([]entity.Installment, error) {
+	ret := _m.Called(ctx, loanIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnpaidInstallmentsOfLoans")
+	}
+
+	var r0 []entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) ([]entity.Installment, error)); ok {
+		return rf(ctx, loanIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) []entity.Installment); ok {
+		r0 = rf(ctx, loanIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, loanIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoansRepository_GetUnpaidInstallmentsOfLoans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnpaidInstallmentsOfLoans'
+type MockGetLoansRepository_GetUnpaidInstallmentsOfLoans_Call struct {
+	*mock.Call
+}
+
+// GetUnpaidInstallmentsOfLoans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanIDs []uint64
+func (_e *MockGetLoansRepository_Expecter) GetUnpaidInstallmentsOfLoans(ctx interface{}, loanIDs interface{}) *MockGetLoansRepository_GetUnpaidInstallmentsOfLoans_Call {
+	return &MockGetLoansRepository_GetUnpaidInstallmentsOfLoans_Call{Call: _e.mock.On("GetUnpaidInstallmentsOfLoans", ctx, loanIDs)}
+}
+
+func (_c *MockGetLoansRepository_GetUnpaidInstallmentsOfLoans_Call) Run(run func(ctx context.Context, loanIDs []uint64)) *MockGetLoansRepository_GetUnpaidInstallmentsOfLoans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoansRepository_GetUnpaidInstallmentsOfLoans_Call) Return(_a0 []entity.Installment, _a1 error) *MockGetLoansRepository_GetUnpaidInstallmentsOfLoans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoansRepository_GetUnpaidInstallmentsOfLoans_Call) RunAndReturn(run func(context.Context, []uint64) ([]entity.Installment, error)) *MockGetLoansRepository_GetUnpaidInstallmentsOfLoans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCustomerExist provides a mock function with given fields: ctx, customerID
+func (_m *MockGetLoansRepository) IsCustomerExist(ctx context.Context, customerID uint64) (bool, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCustomerExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoansRepository_IsCustomerExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCustomerExist'
+type MockGetLoansRepository_IsCustomerExist_Call struct {
+	*mock.Call
+}
+
+// IsCustomerExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockGetLoansRepository_Expecter) IsCustomerExist(ctx interface{}, customerID interface{}) *MockGetLoansRepository_IsCustomerExist_Call {
+	return &MockGetLoansRepository_IsCustomerExist_Call{Call: _e.mock.On("IsCustomerExist", ctx, customerID)}
+}
+
+func (_c *MockGetLoansRepository_IsCustomerExist_Call) Run(run func(ctx context.Context, customerID uint64)) *MockGetLoansRepository_IsCustomerExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetLoansRepository_IsCustomerExist_Call) Return(_a0 bool, _a1 error) *MockGetLoansRepository_IsCustomerExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoansRepository_IsCustomerExist_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockGetLoansRepository_IsCustomerExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchLoans provides a mock function with given fields: ctx, search
+func (_m *MockGetLoansRepository) SearchLoans(ctx context.Context, search entity.LoanSearch) ([]entity.Loan, error) {
+	ret := _m.Called(ctx, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchLoans")
+	}
+
+	var r0 []entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanSearch) ([]entity.Loan, error)); ok {
+		return rf(ctx, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanSearch) []entity.Loan); ok {
+		r0 = rf(ctx, search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Loan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.LoanSearch) error); ok {
+		r1 = rf(ctx, search)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoansRepository_SearchLoans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchLoans'
+type MockGetLoansRepository_SearchLoans_Call struct {
+	*mock.Call
+}
+
+// SearchLoans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search entity.LoanSearch
+func (_e *MockGetLoansRepository_Expecter) SearchLoans(ctx interface{}, search interface{}) *MockGetLoansRepository_SearchLoans_Call {
+	return &MockGetLoansRepository_SearchLoans_Call{Call: _e.mock.On("SearchLoans", ctx, search)}
+}
+
+func (_c *MockGetLoansRepository_SearchLoans_Call) Run(run func(ctx context.Context, search entity.LoanSearch)) *MockGetLoansRepository_SearchLoans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanSearch))
+	})
+	return _c
+}
+
+func (_c *MockGetLoansRepository_SearchLoans_Call) Return(_a0 []entity.Loan, _a1 error) *MockGetLoansRepository_SearchLoans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoansRepository_SearchLoans_Call) RunAndReturn(run func(context.Context, entity.LoanSearch) ([]entity.Loan, error)) *MockGetLoansRepository_SearchLoans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoansRepository creates a new instance of MockGetLoansRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoansRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoansRepository {
+	mock := &MockGetLoansRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLoansUsecase is an autogenerated mock type for the GetLoansUsecase type
+type MockGetLoansUsecase struct {
+	mock.Mock
+}
+
+type MockGetLoansUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLoansUsecase) EXPECT() *MockGetLoansUsecase_Expecter {
+	return &MockGetLoansUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGetLoansUsecase) Execute(ctx context.Context, input usecases.GetLoansInput) (usecases.GetLoansOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetLoansOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetLoansInput) (usecases.GetLoansOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetLoansInput) usecases.GetLoansOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GetLoansOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetLoansInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLoansUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetLoansUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GetLoansInput
+func (_e *MockGetLoansUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGetLoansUsecase_Execute_Call {
+	return &MockGetLoansUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGetLoansUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GetLoansInput)) *MockGetLoansUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GetLoansInput))
+	})
+	return _c
+}
+
+func (_c *MockGetLoansUsecase_Execute_Call) Return(_a0 usecases.GetLoansOutput, _a1 error) *MockGetLoansUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLoansUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GetLoansInput) (usecases.GetLoansOutput, error)) *MockGetLoansUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLoansUsecase creates a new instance of MockGetLoansUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLoansUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLoansUsecase {
+	mock := &MockGetLoansUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
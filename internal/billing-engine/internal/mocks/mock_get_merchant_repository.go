@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetMerchantRepository is an autogenerated mock type for the GetMerchantRepository type
+type MockGetMerchantRepository struct {
+	mock.Mock
+}
+
+type MockGetMerchantRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetMerchantRepository) EXPECT() *MockGetMerchantRepository_Expecter {
+	return &MockGetMerchantRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMerchant provides a mock function with given fields: ctx, merchantID
+func (_m *MockGetMerchantRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Merchant, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Merchant); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMerchantRepository_GetMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchant'
+type MockGetMerchantRepository_GetMerchant_Call struct {
+	*mock.Call
+}
+
+// GetMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockGetMerchantRepository_Expecter) GetMerchant(ctx interface{}, merchantID interface{}) *MockGetMerchantRepository_GetMerchant_Call {
+	return &MockGetMerchantRepository_GetMerchant_Call{Call: _e.mock.On("GetMerchant", ctx, merchantID)}
+}
+
+func (_c *MockGetMerchantRepository_GetMerchant_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockGetMerchantRepository_GetMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetMerchantRepository_GetMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockGetMerchantRepository_GetMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMerchantRepository_GetMerchant_Call) RunAndReturn(run func(context.Context, uint64) (entity.Merchant, error)) *MockGetMerchantRepository_GetMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetMerchantRepository creates a new instance of MockGetMerchantRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetMerchantRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetMerchantRepository {
+	mock := &MockGetMerchantRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetMerchantSettlementRepository is an autogenerated mock type for the GetMerchantSettlementRepository type
+type MockGetMerchantSettlementRepository struct {
+	mock.Mock
+}
+
+type MockGetMerchantSettlementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetMerchantSettlementRepository) EXPECT() *MockGetMerchantSettlementRepository_Expecter {
+	return &MockGetMerchantSettlementRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMerchantSettlement provides a mock function with given fields: ctx, settlementID
+func (_m *MockGetMerchantSettlementRepository) GetMerchantSettlement(ctx context.Context, settlementID uint64) (entity.MerchantSettlement, error) {
+	ret := _m.Called(ctx, settlementID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchantSettlement")
+	}
+
+	var r0 entity.MerchantSettlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.MerchantSettlement, error)); ok {
+		return rf(ctx, settlementID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.MerchantSettlement); ok {
+		r0 = rf(ctx, settlementID)
+	} else {
+		r0 = ret.Get(0).(entity.MerchantSettlement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, settlementID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMerchantSettlementRepository_GetMerchantSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchantSettlement'
+type MockGetMerchantSettlementRepository_GetMerchantSettlement_Call struct {
+	*mock.Call
+}
+
+// GetMerchantSettlement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settlementID uint64
+func (_e *MockGetMerchantSettlementRepository_Expecter) GetMerchantSettlement(ctx interface{}, settlementID interface{}) *MockGetMerchantSettlementRepository_GetMerchantSettlement_Call {
+	return &MockGetMerchantSettlementRepository_GetMerchantSettlement_Call{Call: _e.mock.On("GetMerchantSettlement", ctx, settlementID)}
+}
+
+func (_c *MockGetMerchantSettlementRepository_GetMerchantSettlement_Call) Run(run func(ctx context.Context, settlementID uint64)) *MockGetMerchantSettlementRepository_GetMerchantSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetMerchantSettlementRepository_GetMerchantSettlement_Call) Return(_a0 entity.MerchantSettlement, _a1 error) *MockGetMerchantSettlementRepository_GetMerchantSettlement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMerchantSettlementRepository_GetMerchantSettlement_Call) RunAndReturn(run func(context.Context, uint64) (entity.MerchantSettlement, error)) *MockGetMerchantSettlementRepository_GetMerchantSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetMerchantSettlementRepository creates a new instance of MockGetMerchantSettlementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetMerchantSettlementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetMerchantSettlementRepository {
+	mock := &MockGetMerchantSettlementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
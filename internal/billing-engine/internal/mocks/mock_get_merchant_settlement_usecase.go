@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetMerchantSettlementUsecase is an autogenerated mock type for the GetMerchantSettlementUsecase type
+type MockGetMerchantSettlementUsecase struct {
+	mock.Mock
+}
+
+type MockGetMerchantSettlementUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetMerchantSettlementUsecase) EXPECT() *MockGetMerchantSettlementUsecase_Expecter {
+	return &MockGetMerchantSettlementUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, settlementID
+func (_m *MockGetMerchantSettlementUsecase) Execute(ctx context.Context, settlementID uint64) (usecases.MerchantSettlementOutput, error) {
+	ret := _m.Called(ctx, settlementID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.MerchantSettlementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (usecases.MerchantSettlementOutput, error)); ok {
+		return rf(ctx, settlementID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) usecases.MerchantSettlementOutput); ok {
+		r0 = rf(ctx, settlementID)
+	} else {
+		r0 = ret.Get(0).(usecases.MerchantSettlementOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, settlementID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMerchantSettlementUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetMerchantSettlementUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settlementID uint64
+func (_e *MockGetMerchantSettlementUsecase_Expecter) Execute(ctx interface{}, settlementID interface{}) *MockGetMerchantSettlementUsecase_Execute_Call {
+	return &MockGetMerchantSettlementUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, settlementID)}
+}
+
+func (_c *MockGetMerchantSettlementUsecase_Execute_Call) Run(run func(ctx context.Context, settlementID uint64)) *MockGetMerchantSettlementUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetMerchantSettlementUsecase_Execute_Call) Return(_a0 usecases.MerchantSettlementOutput, _a1 error) *MockGetMerchantSettlementUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMerchantSettlementUsecase_Execute_Call) RunAndReturn(run func(context.Context, uint64) (usecases.MerchantSettlementOutput, error)) *MockGetMerchantSettlementUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetMerchantSettlementUsecase creates a new instance of MockGetMerchantSettlementUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetMerchantSettlementUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetMerchantSettlementUsecase {
+	mock := &MockGetMerchantSettlementUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,154 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetMerchantSettlementsRepository is an autogenerated mock type for the GetMerchantSettlementsRepository type
+type MockGetMerchantSettlementsRepository struct {
+	mock.Mock
+}
+
+type MockGetMerchantSettlementsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetMerchantSettlementsRepository) EXPECT() *MockGetMerchantSettlementsRepository_Expecter {
+	return &MockGetMerchantSettlementsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMerchant provides a mock function with given fields: ctx, merchantID
+func (_m *MockGetMerchantSettlementsRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Merchant, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Merchant); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMerchantSettlementsRepository_GetMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchant'
+type MockGetMerchantSettlementsRepository_GetMerchant_Call struct {
+	*mock.Call
+}
+
+// GetMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockGetMerchantSettlementsRepository_Expecter) GetMerchant(ctx interface{}, merchantID interface{}) *MockGetMerchantSettlementsRepository_GetMerchant_Call {
+	return &MockGetMerchantSettlementsRepository_GetMerchant_Call{Call: _e.mock.On("GetMerchant", ctx, merchantID)}
+}
+
+func (_c *MockGetMerchantSettlementsRepository_GetMerchant_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockGetMerchantSettlementsRepository_GetMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetMerchantSettlementsRepository_GetMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockGetMerchantSettlementsRepository_GetMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMerchantSettlementsRepository_GetMerchant_Call) RunAndReturn(run func(context.Context, uint64) (entity.Merchant, error)) *MockGetMerchantSettlementsRepository_GetMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchantSettlements provides a mock function with given fields: ctx, merchantID
+func (_m *MockGetMerchantSettlementsRepository) GetMerchantSettlements(ctx context.Context, merchantID uint64) ([]entity.MerchantSettlement, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchantSettlements")
+	}
+
+	var r0 []entity.MerchantSettlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.MerchantSettlement, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.MerchantSettlement); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MerchantSettlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMerchantSettlementsRepository_GetMerchantSettlements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchantSettlements'
+type MockGetMerchantSettlementsRepository_GetMerchantSettlements_Call struct {
+	*mock.Call
+}
+
+// GetMerchantSettlements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockGetMerchantSettlementsRepository_Expecter) GetMerchantSettlements(ctx interface{}, merchantID interface{}) *MockGetMerchantSettlementsRepository_GetMerchantSettlements_Call {
+	return &MockGetMerchantSettlementsRepository_GetMerchantSettlements_Call{Call: _e.mock.On("GetMerchantSettlements", ctx, merchantID)}
+}
+
+func (_c *MockGetMerchantSettlementsRepository_GetMerchantSettlements_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockGetMerchantSettlementsRepository_GetMerchantSettlements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetMerchantSettlementsRepository_GetMerchantSettlements_Call) Return(_a0 []entity.MerchantSettlement, _a1 error) *MockGetMerchantSettlementsRepository_GetMerchantSettlements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMerchantSettlementsRepository_GetMerchantSettlements_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.MerchantSettlement, error)) *MockGetMerchantSettlementsRepository_GetMerchantSettlements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetMerchantSettlementsRepository creates a new instance of MockGetMerchantSettlementsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetMerchantSettlementsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetMerchantSettlementsRepository {
+	mock := &MockGetMerchantSettlementsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
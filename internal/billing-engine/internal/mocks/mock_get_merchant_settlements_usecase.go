@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetMerchantSettlementsUsecase is an autogenerated mock type for the GetMerchantSettlementsUsecase type
+type MockGetMerchantSettlementsUsecase struct {
+	mock.Mock
+}
+
+type MockGetMerchantSettlementsUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetMerchantSettlementsUsecase) EXPECT() *MockGetMerchantSettlementsUsecase_Expecter {
+	return &MockGetMerchantSettlementsUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, merchantID
+func (_m *MockGetMerchantSettlementsUsecase) Execute(ctx context.Context, merchantID uint64) ([]usecases.MerchantSettlementOutput, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []usecases.MerchantSettlementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]usecases.MerchantSettlementOutput, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []usecases.MerchantSettlementOutput); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]usecases.MerchantSettlementOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMerchantSettlementsUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetMerchantSettlementsUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockGetMerchantSettlementsUsecase_Expecter) Execute(ctx interface{}, merchantID interface{}) *MockGetMerchantSettlementsUsecase_Execute_Call {
+	return &MockGetMerchantSettlementsUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, merchantID)}
+}
+
+func (_c *MockGetMerchantSettlementsUsecase_Execute_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockGetMerchantSettlementsUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetMerchantSettlementsUsecase_Execute_Call) Return(_a0 []usecases.MerchantSettlementOutput, _a1 error) *MockGetMerchantSettlementsUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMerchantSettlementsUsecase_Execute_Call) RunAndReturn(run func(context.Context, uint64) ([]usecases.MerchantSettlementOutput, error)) *MockGetMerchantSettlementsUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetMerchantSettlementsUsecase creates a new instance of MockGetMerchantSettlementsUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetMerchantSettlementsUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetMerchantSettlementsUsecase {
+	mock := &MockGetMerchantSettlementsUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
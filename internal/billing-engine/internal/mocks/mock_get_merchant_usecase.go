@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetMerchantUsecase is an autogenerated mock type for the GetMerchantUsecase type
+type MockGetMerchantUsecase struct {
+	mock.Mock
+}
+
+type MockGetMerchantUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetMerchantUsecase) EXPECT() *MockGetMerchantUsecase_Expecter {
+	return &MockGetMerchantUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, merchantID
+func (_m *MockGetMerchantUsecase) Execute(ctx context.Context, merchantID uint64) (usecases.MerchantOutput, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.MerchantOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (usecases.MerchantOutput, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) usecases.MerchantOutput); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(usecases.MerchantOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMerchantUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetMerchantUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockGetMerchantUsecase_Expecter) Execute(ctx interface{}, merchantID interface{}) *MockGetMerchantUsecase_Execute_Call {
+	return &MockGetMerchantUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, merchantID)}
+}
+
+func (_c *MockGetMerchantUsecase_Execute_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockGetMerchantUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetMerchantUsecase_Execute_Call) Return(_a0 usecases.MerchantOutput, _a1 error) *MockGetMerchantUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMerchantUsecase_Execute_Call) RunAndReturn(run func(context.Context, uint64) (usecases.MerchantOutput, error)) *MockGetMerchantUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetMerchantUsecase creates a new instance of MockGetMerchantUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetMerchantUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetMerchantUsecase {
+	mock := &MockGetMerchantUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
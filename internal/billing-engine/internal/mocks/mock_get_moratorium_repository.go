@@ -0,0 +1,154 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetMoratoriumRepository is an autogenerated mock type for the GetMoratoriumRepository type
+type MockGetMoratoriumRepository struct {
+	mock.Mock
+}
+
+type MockGetMoratoriumRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetMoratoriumRepository) EXPECT() *MockGetMoratoriumRepository_Expecter {
+	return &MockGetMoratoriumRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMoratorium provides a mock function with given fields: ctx, moratoriumID
+func (_m *MockGetMoratoriumRepository) GetMoratorium(ctx context.Context, moratoriumID uint64) (entity.Moratorium, error) {
+	ret := _m.Called(ctx, moratoriumID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMoratorium")
+	}
+
+	var r0 entity.Moratorium
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Moratorium, error)); ok {
+		return rf(ctx, moratoriumID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Moratorium); ok {
+		r0 = rf(ctx, moratoriumID)
+	} else {
+		r0 = ret.Get(0).(entity.Moratorium)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, moratoriumID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMoratoriumRepository_GetMoratorium_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMoratorium'
+type MockGetMoratoriumRepository_GetMoratorium_Call struct {
+	*mock.Call
+}
+
+// GetMoratorium is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moratoriumID uint64
+func (_e *MockGetMoratoriumRepository_Expecter) GetMoratorium(ctx interface{}, moratoriumID interface{}) *MockGetMoratoriumRepository_GetMoratorium_Call {
+	return &MockGetMoratoriumRepository_GetMoratorium_Call{Call: _e.mock.On("GetMoratorium", ctx, moratoriumID)}
+}
+
+func (_c *MockGetMoratoriumRepository_GetMoratorium_Call) Run(run func(ctx context.Context, moratoriumID uint64)) *MockGetMoratoriumRepository_GetMoratorium_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetMoratoriumRepository_GetMoratorium_Call) Return(_a0 entity.Moratorium, _a1 error) *MockGetMoratoriumRepository_GetMoratorium_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMoratoriumRepository_GetMoratorium_Call) RunAndReturn(run func(context.Context, uint64) (entity.Moratorium, error)) *MockGetMoratoriumRepository_GetMoratorium_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMoratoriumInstallments provides a mock function with given fields: ctx, moratoriumID
+func (_m *MockGetMoratoriumRepository) GetMoratoriumInstallments(ctx context.Context, moratoriumID uint64) ([]entity.MoratoriumInstallment, error) {
+	ret := _m.Called(ctx, moratoriumID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMoratoriumInstallments")
+	}
+
+	var r0 []entity.MoratoriumInstallment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.MoratoriumInstallment, error)); ok {
+		return rf(ctx, moratoriumID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.MoratoriumInstallment); ok {
+		r0 = rf(ctx, moratoriumID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MoratoriumInstallment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, moratoriumID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMoratoriumRepository_GetMoratoriumInstallments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMoratoriumInstallments'
+type MockGetMoratoriumRepository_GetMoratoriumInstallments_Call struct {
+	*mock.Call
+}
+
+// GetMoratoriumInstallments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moratoriumID uint64
+func (_e *MockGetMoratoriumRepository_Expecter) GetMoratoriumInstallments(ctx interface{}, moratoriumID interface{}) *MockGetMoratoriumRepository_GetMoratoriumInstallments_Call {
+	return &MockGetMoratoriumRepository_GetMoratoriumInstallments_Call{Call: _e.mock.On("GetMoratoriumInstallments", ctx, moratoriumID)}
+}
+
+func (_c *MockGetMoratoriumRepository_GetMoratoriumInstallments_Call) Run(run func(ctx context.Context, moratoriumID uint64)) *MockGetMoratoriumRepository_GetMoratoriumInstallments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetMoratoriumRepository_GetMoratoriumInstallments_Call) Return(_a0 []entity.MoratoriumInstallment, _a1 error) *MockGetMoratoriumRepository_GetMoratoriumInstallments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMoratoriumRepository_GetMoratoriumInstallments_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.MoratoriumInstallment, error)) *MockGetMoratoriumRepository_GetMoratoriumInstallments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetMoratoriumRepository creates a new instance of MockGetMoratoriumRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetMoratoriumRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetMoratoriumRepository {
+	mock := &MockGetMoratoriumRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
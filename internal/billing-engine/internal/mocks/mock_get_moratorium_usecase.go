@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetMoratoriumUsecase is an autogenerated mock type for the GetMoratoriumUsecase type
+type MockGetMoratoriumUsecase struct {
+	mock.Mock
+}
+
+type MockGetMoratoriumUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetMoratoriumUsecase) EXPECT() *MockGetMoratoriumUsecase_Expecter {
+	return &MockGetMoratoriumUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, moratoriumID
+func (_m *MockGetMoratoriumUsecase) Execute(ctx context.Context, moratoriumID uint64) (usecases.MoratoriumOutput, error) {
+	ret := _m.Called(ctx, moratoriumID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.MoratoriumOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (usecases.MoratoriumOutput, error)); ok {
+		return rf(ctx, moratoriumID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) usecases.MoratoriumOutput); ok {
+		r0 = rf(ctx, moratoriumID)
+	} else {
+		r0 = ret.Get(0).(usecases.MoratoriumOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, moratoriumID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetMoratoriumUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetMoratoriumUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moratoriumID uint64
+func (_e *MockGetMoratoriumUsecase_Expecter) Execute(ctx interface{}, moratoriumID interface{}) *MockGetMoratoriumUsecase_Execute_Call {
+	return &MockGetMoratoriumUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, moratoriumID)}
+}
+
+func (_c *MockGetMoratoriumUsecase_Execute_Call) Run(run func(ctx context.Context, moratoriumID uint64)) *MockGetMoratoriumUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetMoratoriumUsecase_Execute_Call) Return(_a0 usecases.MoratoriumOutput, _a1 error) *MockGetMoratoriumUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetMoratoriumUsecase_Execute_Call) RunAndReturn(run func(context.Context, uint64) (usecases.MoratoriumOutput, error)) *MockGetMoratoriumUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetMoratoriumUsecase creates a new instance of MockGetMoratoriumUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetMoratoriumUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetMoratoriumUsecase {
+	mock := &MockGetMoratoriumUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetProductFeesUsecase is an autogenerated mock type for the GetProductFeesUsecase type
+type MockGetProductFeesUsecase struct {
+	mock.Mock
+}
+
+type MockGetProductFeesUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetProductFeesUsecase) EXPECT() *MockGetProductFeesUsecase_Expecter {
+	return &MockGetProductFeesUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGetProductFeesUsecase) Execute(ctx context.Context, input usecases.GetProductFeesInput) (usecases.GetProductFeesOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetProductFeesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetProductFeesInput) (usecases.GetProductFeesOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetProductFeesInput) usecases.GetProductFeesOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GetProductFeesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetProductFeesInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetProductFeesUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetProductFeesUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GetProductFeesInput
+func (_e *MockGetProductFeesUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGetProductFeesUsecase_Execute_Call {
+	return &MockGetProductFeesUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGetProductFeesUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GetProductFeesInput)) *MockGetProductFeesUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GetProductFeesInput))
+	})
+	return _c
+}
+
+func (_c *MockGetProductFeesUsecase_Execute_Call) Return(_a0 usecases.GetProductFeesOutput, _a1 error) *MockGetProductFeesUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetProductFeesUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GetProductFeesInput) (usecases.GetProductFeesOutput, error)) *MockGetProductFeesUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetProductFeesUsecase creates a new instance of MockGetProductFeesUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetProductFeesUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetProductFeesUsecase {
+	mock := &MockGetProductFeesUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetProvisionRatesRepository is an autogenerated mock type for the GetProvisionRatesRepository type
+type MockGetProvisionRatesRepository struct {
+	mock.Mock
+}
+
+type MockGetProvisionRatesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetProvisionRatesRepository) EXPECT() *MockGetProvisionRatesRepository_Expecter {
+	return &MockGetProvisionRatesRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetProvisionRates provides a mock function with given fields: ctx
+func (_m *MockGetProvisionRatesRepository) GetProvisionRates(ctx context.Context) ([]entity.ProvisionRate, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProvisionRates")
+	}
+
+	var r0 []entity.ProvisionRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.ProvisionRate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.ProvisionRate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProvisionRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetProvisionRatesRepository_GetProvisionRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProvisionRates'
+type MockGetProvisionRatesRepository_GetProvisionRates_Call struct {
+	*mock.Call
+}
+
+// GetProvisionRates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGetProvisionRatesRepository_Expecter) GetProvisionRates(ctx interface{}) *MockGetProvisionRatesRepository_GetProvisionRates_Call {
+	return &MockGetProvisionRatesRepository_GetProvisionRates_Call{Call: _e.mock.On("GetProvisionRates", ctx)}
+}
+
+func (_c *MockGetProvisionRatesRepository_GetProvisionRates_Call) Run(run func(ctx context.Context)) *MockGetProvisionRatesRepository_GetProvisionRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGetProvisionRatesRepository_GetProvisionRates_Call) Return(_a0 []entity.ProvisionRate, _a1 error) *MockGetProvisionRatesRepository_GetProvisionRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetProvisionRatesRepository_GetProvisionRates_Call) RunAndReturn(run func(context.Context) ([]entity.ProvisionRate, error)) *MockGetProvisionRatesRepository_GetProvisionRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetProvisionRatesRepository creates a new instance of MockGetProvisionRatesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetProvisionRatesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetProvisionRatesRepository {
+	mock := &MockGetProvisionRatesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetProvisioningReportRepository is an autogenerated mock type for the GetProvisioningReportRepository type
+type MockGetProvisioningReportRepository struct {
+	mock.Mock
+}
+
+type MockGetProvisioningReportRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetProvisioningReportRepository) EXPECT() *MockGetProvisioningReportRepository_Expecter {
+	return &MockGetProvisioningReportRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLoanProvisions provides a mock function with given fields: ctx, period
+func (_m *MockGetProvisioningReportRepository) GetLoanProvisions(ctx context.Context, period string) ([]entity.LoanProvision, error) {
+	ret := _m.Called(ctx, period)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanProvisions")
+	}
+
+	var r0 []entity.LoanProvision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.LoanProvision, error)); ok {
+		return rf(ctx, period)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.LoanProvision); ok {
+		r0 = rf(ctx, period)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanProvision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetProvisioningReportRepository_GetLoanProvisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanProvisions'
+type MockGetProvisioningReportRepository_GetLoanProvisions_Call struct {
+	*mock.Call
+}
+
+// GetLoanProvisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - period string
+func (_e *MockGetProvisioningReportRepository_Expecter) GetLoanProvisions(ctx interface{}, period interface{}) *MockGetProvisioningReportRepository_GetLoanProvisions_Call {
+	return &MockGetProvisioningReportRepository_GetLoanProvisions_Call{Call: _e.mock.On("GetLoanProvisions", ctx, period)}
+}
+
+func (_c *MockGetProvisioningReportRepository_GetLoanProvisions_Call) Run(run func(ctx context.Context, period string)) *MockGetProvisioningReportRepository_GetLoanProvisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetProvisioningReportRepository_GetLoanProvisions_Call) Return(_a0 []entity.LoanProvision, _a1 error) *MockGetProvisioningReportRepository_GetLoanProvisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetProvisioningReportRepository_GetLoanProvisions_Call) RunAndReturn(run func(context.Context, string) ([]entity.LoanProvision, error)) *MockGetProvisioningReportRepository_GetLoanProvisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetProvisioningReportRepository creates a new instance of MockGetProvisioningReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetProvisioningReportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetProvisioningReportRepository {
+	mock := &MockGetProvisioningReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
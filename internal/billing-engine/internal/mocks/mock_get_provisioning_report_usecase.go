@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetProvisioningReportUsecase is an autogenerated mock type for the GetProvisioningReportUsecase type
+type MockGetProvisioningReportUsecase struct {
+	mock.Mock
+}
+
+type MockGetProvisioningReportUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetProvisioningReportUsecase) EXPECT() *MockGetProvisioningReportUsecase_Expecter {
+	return &MockGetProvisioningReportUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGetProvisioningReportUsecase) Execute(ctx context.Context, input usecases.GetProvisioningReportInput) (usecases.GetProvisioningReportOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetProvisioningReportOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetProvisioningReportInput) (usecases.GetProvisioningReportOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetProvisioningReportInput) usecases.GetProvisioningReportOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GetProvisioningReportOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetProvisioningReportInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetProvisioningReportUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetProvisioningReportUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GetProvisioningReportInput
+func (_e *MockGetProvisioningReportUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGetProvisioningReportUsecase_Execute_Call {
+	return &MockGetProvisioningReportUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGetProvisioningReportUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GetProvisioningReportInput)) *MockGetProvisioningReportUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GetProvisioningReportInput))
+	})
+	return _c
+}
+
+func (_c *MockGetProvisioningReportUsecase_Execute_Call) Return(_a0 usecases.GetProvisioningReportOutput, _a1 error) *MockGetProvisioningReportUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetProvisioningReportUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GetProvisioningReportInput) (usecases.GetProvisioningReportOutput, error)) *MockGetProvisioningReportUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetProvisioningReportUsecase creates a new instance of MockGetProvisioningReportUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetProvisioningReportUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetProvisioningReportUsecase {
+	mock := &MockGetProvisioningReportUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
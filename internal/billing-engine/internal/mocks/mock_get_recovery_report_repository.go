@@ -0,0 +1,100 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetRecoveryReportRepository is an autogenerated mock type for the GetRecoveryReportRepository type
+type MockGetRecoveryReportRepository struct {
+	mock.Mock
+}
+
+type MockGetRecoveryReportRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetRecoveryReportRepository) EXPECT() *MockGetRecoveryReportRepository_Expecter {
+	return &MockGetRecoveryReportRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetRecoverySummaries provides a mock function with given fields: ctx, from, to
+func (_m *MockGetRecoveryReportRepository) GetRecoverySummaries(ctx context.Context, from time.Time, to time.Time) ([]entity.LoanRecoverySummary, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecoverySummaries")
+	}
+
+	var r0 []entity.LoanRecoverySummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.LoanRecoverySummary, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.LoanRecoverySummary); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanRecoverySummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetRecoveryReportRepository_GetRecoverySummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoverySummaries'
+type MockGetRecoveryReportRepository_GetRecoverySummaries_Call struct {
+	*mock.Call
+}
+
+// GetRecoverySummaries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *MockGetRecoveryReportRepository_Expecter) GetRecoverySummaries(ctx interface{}, from interface{}, to interface{}) *MockGetRecoveryReportRepository_GetRecoverySummaries_Call {
+	return &MockGetRecoveryReportRepository_GetRecoverySummaries_Call{Call: _e.mock.On("GetRecoverySummaries", ctx, from, to)}
+}
+
+func (_c *MockGetRecoveryReportRepository_GetRecoverySummaries_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockGetRecoveryReportRepository_GetRecoverySummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGetRecoveryReportRepository_GetRecoverySummaries_Call) Return(_a0 []entity.LoanRecoverySummary, _a1 error) *MockGetRecoveryReportRepository_GetRecoverySummaries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetRecoveryReportRepository_GetRecoverySummaries_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]entity.LoanRecoverySummary, error)) *MockGetRecoveryReportRepository_GetRecoverySummaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetRecoveryReportRepository creates a new instance of MockGetRecoveryReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetRecoveryReportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetRecoveryReportRepository {
+	mock := &MockGetRecoveryReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetRecoveryReportUsecase is an autogenerated mock type for the GetRecoveryReportUsecase type
+type MockGetRecoveryReportUsecase struct {
+	mock.Mock
+}
+
+type MockGetRecoveryReportUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetRecoveryReportUsecase) EXPECT() *MockGetRecoveryReportUsecase_Expecter {
+	return &MockGetRecoveryReportUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGetRecoveryReportUsecase) Execute(ctx context.Context, input usecases.GetRecoveryReportInput) (usecases.GetRecoveryReportOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetRecoveryReportOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetRecoveryReportInput) (usecases.GetRecoveryReportOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetRecoveryReportInput) usecases.GetRecoveryReportOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GetRecoveryReportOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetRecoveryReportInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetRecoveryReportUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetRecoveryReportUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GetRecoveryReportInput
+func (_e *MockGetRecoveryReportUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGetRecoveryReportUsecase_Execute_Call {
+	return &MockGetRecoveryReportUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGetRecoveryReportUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GetRecoveryReportInput)) *MockGetRecoveryReportUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GetRecoveryReportInput))
+	})
+	return _c
+}
+
+func (_c *MockGetRecoveryReportUsecase_Execute_Call) Return(_a0 usecases.GetRecoveryReportOutput, _a1 error) *MockGetRecoveryReportUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetRecoveryReportUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GetRecoveryReportInput) (usecases.GetRecoveryReportOutput, error)) *MockGetRecoveryReportUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetRecoveryReportUsecase creates a new instance of MockGetRecoveryReportUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetRecoveryReportUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetRecoveryReportUsecase {
+	mock := &MockGetRecoveryReportUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
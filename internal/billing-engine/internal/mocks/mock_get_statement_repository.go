@@ -0,0 +1,277 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	decimal "github.com/shopspring/decimal"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetStatementRepository is an autogenerated mock type for the GetStatementRepository type
+type MockGetStatementRepository struct {
+	mock.Mock
+}
+
+type MockGetStatementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetStatementRepository) EXPECT() *MockGetStatementRepository_Expecter {
+	return &MockGetStatementRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCustomer provides a mock function with given fields: ctx, customerID
+func (_m *MockGetStatementRepository) GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomer")
+	}
+
+	var r0 entity.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Customer, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Customer); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.Customer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetStatementRepository_GetCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomer'
+type MockGetStatementRepository_GetCustomer_Call struct {
+	*mock.Call
+}
+
+// GetCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockGetStatementRepository_Expecter) GetCustomer(ctx interface{}, customerID interface{}) *MockGetStatementRepository_GetCustomer_Call {
+	return &MockGetStatementRepository_GetCustomer_Call{Call: _e.mock.On("GetCustomer", ctx, customerID)}
+}
+
+func (_c *MockGetStatementRepository_GetCustomer_Call) Run(run func(ctx context.Context, customerID uint64)) *MockGetStatementRepository_GetCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetStatementRepository_GetCustomer_Call) Return(_a0 entity.Customer, _a1 error) *MockGetStatementRepository_GetCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetStatementRepository_GetCustomer_Call) RunAndReturn(run func(context.Context, uint64) (entity.Customer, error)) *MockGetStatementRepository_GetCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatementEntries provides a mock function with given fields: ctx, customerID, loanID, from, to
+func (_m *MockGetStatementRepository) GetStatementEntries(ctx context.Context, customerID uint64, loanID uint64, from time.Time, to time.Time) ([]entity.JournalEntry, error) {
+	ret := _m.Called(ctx, customerID, loanID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatementEntries")
+	}
+
+	var r0 []entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time, time.Time) ([]entity.JournalEntry, error)); ok {
+		return rf(ctx, customerID, loanID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time, time.Time) []entity.JournalEntry); ok {
+		r0 = rf(ctx, customerID, loanID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.JournalEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, customerID, loanID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetStatementRepository_GetStatementEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatementEntries'
+type MockGetStatementRepository_GetStatementEntries_Call struct {
+	*mock.Call
+}
+
+// GetStatementEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+//   - loanID uint64
+//   - from time.Time
+//   - to time.Time
+func (_e *MockGetStatementRepository_Expecter) GetStatementEntries(ctx interface{}, customerID interface{}, loanID interface{}, from interface{}, to interface{}) *MockGetStatementRepository_GetStatementEntries_Call {
+	return &MockGetStatementRepository_GetStatementEntries_Call{Call: _e.mock.On("GetStatementEntries", ctx, customerID, loanID, from, to)}
+}
+
+func (_c *MockGetStatementRepository_GetStatementEntries_Call) Run(run func(ctx context.Context, customerID uint64, loanID uint64, from time.Time, to time.Time)) *MockGetStatementRepository_GetStatementEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGetStatementRepository_GetStatementEntries_Call) Return(_a0 []entity.JournalEntry, _a1 error) *MockGetStatementRepository_GetStatementEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetStatementRepository_GetStatementEntries_Call) RunAndReturn(run func(context.Context, uint64, uint64, time.Time, time.Time) ([]entity.JournalEntry, error)) *MockGetStatementRepository_GetStatementEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatementOpeningBalance provides a mock function with given fields: ctx, customerID, loanID, before
+func (_m *MockGetStatementRepository) GetStatementOpeningBalance(ctx context.Context, customerID uint64, loanID uint64, before time.Time) (decimal.Decimal, error) {
+	ret := _m.Called(ctx, customerID, loanID, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatementOpeningBalance")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time) (decimal.Decimal, error)); ok {
+		return rf(ctx, customerID, loanID, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time) decimal.Decimal); ok {
+		r0 = rf(ctx, customerID, loanID, before)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, time.Time) error); ok {
+		r1 = rf(ctx, customerID, loanID, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetStatementRepository_GetStatementOpeningBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatementOpeningBalance'
+type MockGetStatementRepository_GetStatementOpeningBalance_Call struct {
+	*mock.Call
+}
+
+// GetStatementOpeningBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+//   - loanID uint64
+//   - before time.Time
+func (_e *MockGetStatementRepository_Expecter) GetStatementOpeningBalance(ctx interface{}, customerID interface{}, loanID interface{}, before interface{}) *MockGetStatementRepository_GetStatementOpeningBalance_Call {
+	return &MockGetStatementRepository_GetStatementOpeningBalance_Call{Call: _e.mock.On("GetStatementOpeningBalance", ctx, customerID, loanID, before)}
+}
+
+func (_c *MockGetStatementRepository_GetStatementOpeningBalance_Call) Run(run func(ctx context.Context, customerID uint64, loanID uint64, before time.Time)) *MockGetStatementRepository_GetStatementOpeningBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGetStatementRepository_GetStatementOpeningBalance_Call) Return(_a0 decimal.Decimal, _a1 error) *MockGetStatementRepository_GetStatementOpeningBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetStatementRepository_GetStatementOpeningBalance_Call) RunAndReturn(run func(context.Context, uint64, uint64, time.Time) (decimal.Decimal, error)) *MockGetStatementRepository_GetStatementOpeningBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsLoanBelongsToCustomer provides a mock function with given fields: ctx, customerID, loanID
+func (_m *MockGetStatementRepository) IsLoanBelongsToCustomer(ctx context.Context, customerID uint64, loanID uint64) (bool, error) {
+	ret := _m.Called(ctx, customerID, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsLoanBelongsToCustomer")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (bool, error)); ok {
+		return rf(ctx, customerID, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) bool); ok {
+		r0 = rf(ctx, customerID, loanID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, customerID, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetStatementRepository_IsLoanBelongsToCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsLoanBelongsToCustomer'
+type MockGetStatementRepository_IsLoanBelongsToCustomer_Call struct {
+	*mock.Call
+}
+
+// IsLoanBelongsToCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+//   - loanID uint64
+func (_e *MockGetStatementRepository_Expecter) IsLoanBelongsToCustomer(ctx interface{}, customerID interface{}, loanID interface{}) *MockGetStatementRepository_IsLoanBelongsToCustomer_Call {
+	return &MockGetStatementRepository_IsLoanBelongsToCustomer_Call{Call: _e.mock.On("IsLoanBelongsToCustomer", ctx, customerID, loanID)}
+}
+
+func (_c *MockGetStatementRepository_IsLoanBelongsToCustomer_Call) Run(run func(ctx context.Context, customerID uint64, loanID uint64)) *MockGetStatementRepository_IsLoanBelongsToCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockGetStatementRepository_IsLoanBelongsToCustomer_Call) Return(_a0 bool, _a1 error) *MockGetStatementRepository_IsLoanBelongsToCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetStatementRepository_IsLoanBelongsToCustomer_Call) RunAndReturn(run func(context.Context, uint64, uint64) (bool, error)) *MockGetStatementRepository_IsLoanBelongsToCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetStatementRepository creates a new instance of MockGetStatementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetStatementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetStatementRepository {
+	mock := &MockGetStatementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
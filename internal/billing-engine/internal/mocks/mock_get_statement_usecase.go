@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetStatementUsecase is an autogenerated mock type for the GetStatementUsecase type
+type MockGetStatementUsecase struct {
+	mock.Mock
+}
+
+type MockGetStatementUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetStatementUsecase) EXPECT() *MockGetStatementUsecase_Expecter {
+	return &MockGetStatementUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGetStatementUsecase) Execute(ctx context.Context, input usecases.GetStatementInput) (usecases.GetStatementOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetStatementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetStatementInput) (usecases.GetStatementOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetStatementInput) usecases.GetStatementOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GetStatementOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetStatementInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetStatementUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetStatementUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GetStatementInput
+func (_e *MockGetStatementUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGetStatementUsecase_Execute_Call {
+	return &MockGetStatementUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGetStatementUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GetStatementInput)) *MockGetStatementUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GetStatementInput))
+	})
+	return _c
+}
+
+func (_c *MockGetStatementUsecase_Execute_Call) Return(_a0 usecases.GetStatementOutput, _a1 error) *MockGetStatementUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetStatementUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GetStatementInput) (usecases.GetStatementOutput, error)) *MockGetStatementUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetStatementUsecase creates a new instance of MockGetStatementUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetStatementUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetStatementUsecase {
+	mock := &MockGetStatementUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
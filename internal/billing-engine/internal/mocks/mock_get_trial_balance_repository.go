@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetTrialBalanceRepository is an autogenerated mock type for the GetTrialBalanceRepository type
+type MockGetTrialBalanceRepository struct {
+	mock.Mock
+}
+
+type MockGetTrialBalanceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetTrialBalanceRepository) EXPECT() *MockGetTrialBalanceRepository_Expecter {
+	return &MockGetTrialBalanceRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAccountTotals provides a mock function with given fields: ctx, asOf
+func (_m *MockGetTrialBalanceRepository) GetAccountTotals(ctx context.Context, asOf time.Time) ([]entity.AccountTotal, error) {
+	ret := _m.Called(ctx, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountTotals")
+	}
+
+	var r0 []entity.AccountTotal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.AccountTotal, error)); ok {
+		return rf(ctx, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.AccountTotal); ok {
+		r0 = rf(ctx, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AccountTotal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetTrialBalanceRepository_GetAccountTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountTotals'
+type MockGetTrialBalanceRepository_GetAccountTotals_Call struct {
+	*mock.Call
+}
+
+// GetAccountTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asOf time.Time
+func (_e *MockGetTrialBalanceRepository_Expecter) GetAccountTotals(ctx interface{}, asOf interface{}) *MockGetTrialBalanceRepository_GetAccountTotals_Call {
+	return &MockGetTrialBalanceRepository_GetAccountTotals_Call{Call: _e.mock.On("GetAccountTotals", ctx, asOf)}
+}
+
+func (_c *MockGetTrialBalanceRepository_GetAccountTotals_Call) Run(run func(ctx context.Context, asOf time.Time)) *MockGetTrialBalanceRepository_GetAccountTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGetTrialBalanceRepository_GetAccountTotals_Call) Return(_a0 []entity.AccountTotal, _a1 error) *MockGetTrialBalanceRepository_GetAccountTotals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetTrialBalanceRepository_GetAccountTotals_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.AccountTotal, error)) *MockGetTrialBalanceRepository_GetAccountTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLedgerAccounts provides a mock function with given fields: ctx
+func (_m *MockGetTrialBalanceRepository) GetLedgerAccounts(ctx context.Context) ([]entity.LedgerAccount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLedgerAccounts")
+	}
+
+	var r0 []entity.LedgerAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.LedgerAccount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.LedgerAccount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LedgerAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetTrialBalanceRepository_GetLedgerAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLedgerAccounts'
+type MockGetTrialBalanceRepository_GetLedgerAccounts_Call struct {
+	*mock.Call
+}
+
+// GetLedgerAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGetTrialBalanceRepository_Expecter) GetLedgerAccounts(ctx interface{}) *MockGetTrialBalanceRepository_GetLedgerAccounts_Call {
+	return &MockGetTrialBalanceRepository_GetLedgerAccounts_Call{Call: _e.mock.On("GetLedgerAccounts", ctx)}
+}
+
+func (_c *MockGetTrialBalanceRepository_GetLedgerAccounts_Call) Run(run func(ctx context.Context)) *MockGetTrialBalanceRepository_GetLedgerAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGetTrialBalanceRepository_GetLedgerAccounts_Call) Return(_a0 []entity.LedgerAccount, _a1 error) *MockGetTrialBalanceRepository_GetLedgerAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetTrialBalanceRepository_GetLedgerAccounts_Call) RunAndReturn(run func(context.Context) ([]entity.LedgerAccount, error)) *MockGetTrialBalanceRepository_GetLedgerAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetTrialBalanceRepository creates a new instance of MockGetTrialBalanceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetTrialBalanceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetTrialBalanceRepository {
+	mock := &MockGetTrialBalanceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
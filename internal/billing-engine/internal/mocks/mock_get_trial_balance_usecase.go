@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetTrialBalanceUsecase is an autogenerated mock type for the GetTrialBalanceUsecase type
+type MockGetTrialBalanceUsecase struct {
+	mock.Mock
+}
+
+type MockGetTrialBalanceUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetTrialBalanceUsecase) EXPECT() *MockGetTrialBalanceUsecase_Expecter {
+	return &MockGetTrialBalanceUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockGetTrialBalanceUsecase) Execute(ctx context.Context, input usecases.GetTrialBalanceInput) (usecases.GetTrialBalanceOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GetTrialBalanceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetTrialBalanceInput) (usecases.GetTrialBalanceOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetTrialBalanceInput) usecases.GetTrialBalanceOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GetTrialBalanceOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetTrialBalanceInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetTrialBalanceUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetTrialBalanceUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.GetTrialBalanceInput
+func (_e *MockGetTrialBalanceUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockGetTrialBalanceUsecase_Execute_Call {
+	return &MockGetTrialBalanceUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockGetTrialBalanceUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.GetTrialBalanceInput)) *MockGetTrialBalanceUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.GetTrialBalanceInput))
+	})
+	return _c
+}
+
+func (_c *MockGetTrialBalanceUsecase_Execute_Call) Return(_a0 usecases.GetTrialBalanceOutput, _a1 error) *MockGetTrialBalanceUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetTrialBalanceUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.GetTrialBalanceInput) (usecases.GetTrialBalanceOutput, error)) *MockGetTrialBalanceUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetTrialBalanceUsecase creates a new instance of MockGetTrialBalanceUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetTrialBalanceUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetTrialBalanceUsecase {
+	mock := &MockGetTrialBalanceUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,367 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockInstallmentPaymentRepository is an autogenerated mock type for the InstallmentPaymentRepository type
+type MockInstallmentPaymentRepository struct {
+	mock.Mock
+}
+
+type MockInstallmentPaymentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInstallmentPaymentRepository) EXPECT() *MockInstallmentPaymentRepository_Expecter {
+	return &MockInstallmentPaymentRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockInstallmentPaymentRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstallmentPaymentRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockInstallmentPaymentRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockInstallmentPaymentRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockInstallmentPaymentRepository_CreateJournalEntry_Call {
+	return &MockInstallmentPaymentRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockInstallmentPaymentRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockInstallmentPaymentRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockInstallmentPaymentRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockInstallmentPaymentRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstallment provides a mock function with given fields: ctx, loanID, weekNumber
+func (_m *MockInstallmentPaymentRepository) GetInstallment(ctx context.Context, loanID uint64, weekNumber int64) (entity.Installment, error) {
+	ret := _m.Called(ctx, loanID, weekNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstallment")
+	}
+
+	var r0 entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64) (entity.Installment, error)); ok {
+		return rf(ctx, loanID, weekNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64) entity.Installment); ok {
+		r0 = rf(ctx, loanID, weekNumber)
+	} else {
+		r0 = ret.Get(0).(entity.Installment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, int64) error); ok {
+		r1 = rf(ctx, loanID, weekNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstallmentPaymentRepository_GetInstallment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstallment'
+type MockInstallmentPaymentRepository_GetInstallment_Call struct {
+	*mock.Call
+}
+
+// GetInstallment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - weekNumber int64
+func (_e *MockInstallmentPaymentRepository_Expecter) GetInstallment(ctx interface{}, loanID interface{}, weekNumber interface{}) *MockInstallmentPaymentRepository_GetInstallment_Call {
+	return &MockInstallmentPaymentRepository_GetInstallment_Call{Call: _e.mock.On("GetInstallment", ctx, loanID, weekNumber)}
+}
+
+func (_c *MockInstallmentPaymentRepository_GetInstallment_Call) Run(run func(ctx context.Context, loanID uint64, weekNumber int64)) *MockInstallmentPaymentRepository_GetInstallment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_GetInstallment_Call) Return(_a0 entity.Installment, _a1 error) *MockInstallmentPaymentRepository_GetInstallment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_GetInstallment_Call) RunAndReturn(run func(context.Context, uint64, int64) (entity.Installment, error)) *MockInstallmentPaymentRepository_GetInstallment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockInstallmentPaymentRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstallmentPaymentRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockInstallmentPaymentRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockInstallmentPaymentRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockInstallmentPaymentRepository_GetLoan_Call {
+	return &MockInstallmentPaymentRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockInstallmentPaymentRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockInstallmentPaymentRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockInstallmentPaymentRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockInstallmentPaymentRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MakePayment provides a mock function with given fields: ctx, loanID, weekNumber, amount, paidAt
+func (_m *MockInstallmentPaymentRepository) MakePayment(ctx context.Context, loanID uint64, weekNumber int64, amount string, paidAt time.Time) error {
+	ret := _m.Called(ctx, loanID, weekNumber, amount, paidAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakePayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64, string, time.Time) error); ok {
+		r0 = rf(ctx, loanID, weekNumber, amount, paidAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockInstallmentPaymentRepository_MakePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakePayment'
+type MockInstallmentPaymentRepository_MakePayment_Call struct {
+	*mock.Call
+}
+
+// MakePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - weekNumber int64
+//   - amount string
+//   - paidAt time.Time
+func (_e *MockInstallmentPaymentRepository_Expecter) MakePayment(ctx interface{}, loanID interface{}, weekNumber interface{}, amount interface{}, paidAt interface{}) *MockInstallmentPaymentRepository_MakePayment_Call {
+	return &MockInstallmentPaymentRepository_MakePayment_Call{Call: _e.mock.On("MakePayment", ctx, loanID, weekNumber, amount, paidAt)}
+}
+
+func (_c *MockInstallmentPaymentRepository_MakePayment_Call) Run(run func(ctx context.Context, loanID uint64, weekNumber int64, amount string, paidAt time.Time)) *MockInstallmentPaymentRepository_MakePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int64), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_MakePayment_Call) Return(_a0 error) *MockInstallmentPaymentRepository_MakePayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_MakePayment_Call) RunAndReturn(run func(context.Context, uint64, int64, string, time.Time) error) *MockInstallmentPaymentRepository_MakePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseLoanCollaterals provides a mock function with given fields: ctx, loanID, releasedAt
+func (_m *MockInstallmentPaymentRepository) ReleaseLoanCollaterals(ctx context.Context, loanID uint64, releasedAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, loanID, releasedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseLoanCollaterals")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) (int64, error)); ok {
+		return rf(ctx, loanID, releasedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) int64); ok {
+		r0 = rf(ctx, loanID, releasedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time) error); ok {
+		r1 = rf(ctx, loanID, releasedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstallmentPaymentRepository_ReleaseLoanCollaterals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseLoanCollaterals'
+type MockInstallmentPaymentRepository_ReleaseLoanCollaterals_Call struct {
+	*mock.Call
+}
+
+// ReleaseLoanCollaterals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - releasedAt time.Time
+func (_e *MockInstallmentPaymentRepository_Expecter) ReleaseLoanCollaterals(ctx interface{}, loanID interface{}, releasedAt interface{}) *MockInstallmentPaymentRepository_ReleaseLoanCollaterals_Call {
+	return &MockInstallmentPaymentRepository_ReleaseLoanCollaterals_Call{Call: _e.mock.On("ReleaseLoanCollaterals", ctx, loanID, releasedAt)}
+}
+
+func (_c *MockInstallmentPaymentRepository_ReleaseLoanCollaterals_Call) Run(run func(ctx context.Context, loanID uint64, releasedAt time.Time)) *MockInstallmentPaymentRepository_ReleaseLoanCollaterals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_ReleaseLoanCollaterals_Call) Return(_a0 int64, _a1 error) *MockInstallmentPaymentRepository_ReleaseLoanCollaterals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_ReleaseLoanCollaterals_Call) RunAndReturn(run func(context.Context, uint64, time.Time) (int64, error)) *MockInstallmentPaymentRepository_ReleaseLoanCollaterals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockInstallmentPaymentRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockInstallmentPaymentRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockInstallmentPaymentRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockInstallmentPaymentRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockInstallmentPaymentRepository_WithinTransaction_Call {
+	return &MockInstallmentPaymentRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockInstallmentPaymentRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockInstallmentPaymentRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_WithinTransaction_Call) Return(_a0 error) *MockInstallmentPaymentRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockInstallmentPaymentRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockInstallmentPaymentRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockInstallmentPaymentRepository creates a new instance of MockInstallmentPaymentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInstallmentPaymentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInstallmentPaymentRepository {
+	mock := &MockInstallmentPaymentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,209 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLogContactAttemptRepository is an autogenerated mock type for the LogContactAttemptRepository type
+type MockLogContactAttemptRepository struct {
+	mock.Mock
+}
+
+type MockLogContactAttemptRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLogContactAttemptRepository) EXPECT() *MockLogContactAttemptRepository_Expecter {
+	return &MockLogContactAttemptRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateContactAttempt provides a mock function with given fields: ctx, attempt
+func (_m *MockLogContactAttemptRepository) CreateContactAttempt(ctx context.Context, attempt entity.ContactAttempt) (entity.ContactAttempt, error) {
+	ret := _m.Called(ctx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateContactAttempt")
+	}
+
+	var r0 entity.ContactAttempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ContactAttempt) (entity.ContactAttempt, error)); ok {
+		return rf(ctx, attempt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ContactAttempt) entity.ContactAttempt); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		r0 = ret.Get(0).(entity.ContactAttempt)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.ContactAttempt) error); ok {
+		r1 = rf(ctx, attempt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLogContactAttemptRepository_CreateContactAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateContactAttempt'
+type MockLogContactAttemptRepository_CreateContactAttempt_Call struct {
+	*mock.Call
+}
+
+// CreateContactAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attempt entity.ContactAttempt
+func (_e *MockLogContactAttemptRepository_Expecter) CreateContactAttempt(ctx interface{}, attempt interface{}) *MockLogContactAttemptRepository_CreateContactAttempt_Call {
+	return &MockLogContactAttemptRepository_CreateContactAttempt_Call{Call: _e.mock.On("CreateContactAttempt", ctx, attempt)}
+}
+
+func (_c *MockLogContactAttemptRepository_CreateContactAttempt_Call) Run(run func(ctx context.Context, attempt entity.ContactAttempt)) *MockLogContactAttemptRepository_CreateContactAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.ContactAttempt))
+	})
+	return _c
+}
+
+func (_c *MockLogContactAttemptRepository_CreateContactAttempt_Call) Return(_a0 entity.ContactAttempt, _a1 error) *MockLogContactAttemptRepository_CreateContactAttempt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLogContactAttemptRepository_CreateContactAttempt_Call) RunAndReturn(run func(context.Context, entity.ContactAttempt) (entity.ContactAttempt, error)) *MockLogContactAttemptRepository_CreateContactAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePromiseToPay provides a mock function with given fields: ctx, promise
+func (_m *MockLogContactAttemptRepository) CreatePromiseToPay(ctx context.Context, promise entity.PromiseToPay) (entity.PromiseToPay, error) {
+	ret := _m.Called(ctx, promise)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePromiseToPay")
+	}
+
+	var r0 entity.PromiseToPay
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.PromiseToPay) (entity.PromiseToPay, error)); ok {
+		return rf(ctx, promise)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.PromiseToPay) entity.PromiseToPay); ok {
+		r0 = rf(ctx, promise)
+	} else {
+		r0 = ret.Get(0).(entity.PromiseToPay)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.PromiseToPay) error); ok {
+		r1 = rf(ctx, promise)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLogContactAttemptRepository_CreatePromiseToPay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePromiseToPay'
+type MockLogContactAttemptRepository_CreatePromiseToPay_Call struct {
+	*mock.Call
+}
+
+// CreatePromiseToPay is a helper method to define mock.On call
+//   - ctx context.Context
+//   - promise entity.PromiseToPay
+func (_e *MockLogContactAttemptRepository_Expecter) CreatePromiseToPay(ctx interface{}, promise interface{}) *MockLogContactAttemptRepository_CreatePromiseToPay_Call {
+	return &MockLogContactAttemptRepository_CreatePromiseToPay_Call{Call: _e.mock.On("CreatePromiseToPay", ctx, promise)}
+}
+
+func (_c *MockLogContactAttemptRepository_CreatePromiseToPay_Call) Run(run func(ctx context.Context, promise entity.PromiseToPay)) *MockLogContactAttemptRepository_CreatePromiseToPay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.PromiseToPay))
+	})
+	return _c
+}
+
+func (_c *MockLogContactAttemptRepository_CreatePromiseToPay_Call) Return(_a0 entity.PromiseToPay, _a1 error) *MockLogContactAttemptRepository_CreatePromiseToPay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLogContactAttemptRepository_CreatePromiseToPay_Call) RunAndReturn(run func(context.Context, entity.PromiseToPay) (entity.PromiseToPay, error)) *MockLogContactAttemptRepository_CreatePromiseToPay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionCase provides a mock function with given fields: ctx, caseID
+func (_m *MockLogContactAttemptRepository) GetCollectionCase(ctx context.Context, caseID uint64) (entity.CollectionCase, error) {
+	ret := _m.Called(ctx, caseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionCase")
+	}
+
+	var r0 entity.CollectionCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CollectionCase, error)); ok {
+		return rf(ctx, caseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CollectionCase); ok {
+		r0 = rf(ctx, caseID)
+	} else {
+		r0 = ret.Get(0).(entity.CollectionCase)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, caseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLogContactAttemptRepository_GetCollectionCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionCase'
+type MockLogContactAttemptRepository_GetCollectionCase_Call struct {
+	*mock.Call
+}
+
+// GetCollectionCase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caseID uint64
+func (_e *MockLogContactAttemptRepository_Expecter) GetCollectionCase(ctx interface{}, caseID interface{}) *MockLogContactAttemptRepository_GetCollectionCase_Call {
+	return &MockLogContactAttemptRepository_GetCollectionCase_Call{Call: _e.mock.On("GetCollectionCase", ctx, caseID)}
+}
+
+func (_c *MockLogContactAttemptRepository_GetCollectionCase_Call) Run(run func(ctx context.Context, caseID uint64)) *MockLogContactAttemptRepository_GetCollectionCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockLogContactAttemptRepository_GetCollectionCase_Call) Return(_a0 entity.CollectionCase, _a1 error) *MockLogContactAttemptRepository_GetCollectionCase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLogContactAttemptRepository_GetCollectionCase_Call) RunAndReturn(run func(context.Context, uint64) (entity.CollectionCase, error)) *MockLogContactAttemptRepository_GetCollectionCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLogContactAttemptRepository creates a new instance of MockLogContactAttemptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLogContactAttemptRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLogContactAttemptRepository {
+	mock := &MockLogContactAttemptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLogContactAttemptUsecase is an autogenerated mock type for the LogContactAttemptUsecase type
+type MockLogContactAttemptUsecase struct {
+	mock.Mock
+}
+
+type MockLogContactAttemptUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLogContactAttemptUsecase) EXPECT() *MockLogContactAttemptUsecase_Expecter {
+	return &MockLogContactAttemptUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockLogContactAttemptUsecase) Execute(ctx context.Context, input usecases.LogContactAttemptInput) (usecases.LogContactAttemptOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.LogContactAttemptOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.LogContactAttemptInput) (usecases.LogContactAttemptOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.LogContactAttemptInput) usecases.LogContactAttemptOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.LogContactAttemptOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.LogContactAttemptInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLogContactAttemptUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockLogContactAttemptUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.LogContactAttemptInput
+func (_e *MockLogContactAttemptUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockLogContactAttemptUsecase_Execute_Call {
+	return &MockLogContactAttemptUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockLogContactAttemptUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.LogContactAttemptInput)) *MockLogContactAttemptUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.LogContactAttemptInput))
+	})
+	return _c
+}
+
+func (_c *MockLogContactAttemptUsecase_Execute_Call) Return(_a0 usecases.LogContactAttemptOutput, _a1 error) *MockLogContactAttemptUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLogContactAttemptUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.LogContactAttemptInput) (usecases.LogContactAttemptOutput, error)) *MockLogContactAttemptUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLogContactAttemptUsecase creates a new instance of MockLogContactAttemptUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLogContactAttemptUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLogContactAttemptUsecase {
+	mock := &MockLogContactAttemptUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
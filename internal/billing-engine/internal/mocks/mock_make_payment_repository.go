@@ -5,6 +5,11 @@ package billingenginemocks
 import (
 	context "context"
 
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	decimal "github.com/shopspring/decimal"
+
+	time "time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,6 +26,291 @@ func (_m *MockMakePaymentRepository) EXPECT() *MockMakePaymentRepository_Expecte
 	return &MockMakePaymentRepository_Expecter{mock: &_m.Mock}
 }
 
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockMakePaymentRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMakePaymentRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockMakePaymentRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockMakePaymentRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockMakePaymentRepository_CreateJournalEntry_Call {
+	return &MockMakePaymentRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockMakePaymentRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockMakePaymentRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockMakePaymentRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockMakePaymentRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRecovery provides a mock function with given fields: ctx, recovery
+func (_m *MockMakePaymentRepository) CreateRecovery(ctx context.Context, recovery entity.Recovery) (entity.Recovery, error) {
+	ret := _m.Called(ctx, recovery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRecovery")
+	}
+
+	var r0 entity.Recovery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Recovery) (entity.Recovery, error)); ok {
+		return rf(ctx, recovery)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Recovery) entity.Recovery); ok {
+		r0 = rf(ctx, recovery)
+	} else {
+		r0 = ret.Get(0).(entity.Recovery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Recovery) error); ok {
+		r1 = rf(ctx, recovery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMakePaymentRepository_CreateRecovery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRecovery'
+type MockMakePaymentRepository_CreateRecovery_Call struct {
+	*mock.Call
+}
+
+// CreateRecovery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recovery entity.Recovery
+func (_e *MockMakePaymentRepository_Expecter) CreateRecovery(ctx interface{}, recovery interface{}) *MockMakePaymentRepository_CreateRecovery_Call {
+	return &MockMakePaymentRepository_CreateRecovery_Call{Call: _e.mock.On("CreateRecovery", ctx, recovery)}
+}
+
+func (_c *MockMakePaymentRepository_CreateRecovery_Call) Run(run func(ctx context.Context, recovery entity.Recovery)) *MockMakePaymentRepository_CreateRecovery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Recovery))
+	})
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_CreateRecovery_Call) Return(_a0 entity.Recovery, _a1 error) *MockMakePaymentRepository_CreateRecovery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_CreateRecovery_Call) RunAndReturn(run func(context.Context, entity.Recovery) (entity.Recovery, error)) *MockMakePaymentRepository_CreateRecovery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstallment provides a mock function with given fields: ctx, loanID, weekNumber
+func (_m *MockMakePaymentRepository) GetInstallment(ctx context.Context, loanID uint64, weekNumber int64) (entity.Installment, error) {
+	ret := _m.Called(ctx, loanID, weekNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstallment")
+	}
+
+	var r0 entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64) (entity.Installment, error)); ok {
+		return rf(ctx, loanID, weekNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64) entity.Installment); ok {
+		r0 = rf(ctx, loanID, weekNumber)
+	} else {
+		r0 = ret.Get(0).(entity.Installment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, int64) error); ok {
+		r1 = rf(ctx, loanID, weekNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMakePaymentRepository_GetInstallment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstallment'
+type MockMakePaymentRepository_GetInstallment_Call struct {
+	*mock.Call
+}
+
+// GetInstallment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - weekNumber int64
+func (_e *MockMakePaymentRepository_Expecter) GetInstallment(ctx interface{}, loanID interface{}, weekNumber interface{}) *MockMakePaymentRepository_GetInstallment_Call {
+	return &MockMakePaymentRepository_GetInstallment_Call{Call: _e.mock.On("GetInstallment", ctx, loanID, weekNumber)}
+}
+
+func (_c *MockMakePaymentRepository_GetInstallment_Call) Run(run func(ctx context.Context, loanID uint64, weekNumber int64)) *MockMakePaymentRepository_GetInstallment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_GetInstallment_Call) Return(_a0 entity.Installment, _a1 error) *MockMakePaymentRepository_GetInstallment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_GetInstallment_Call) RunAndReturn(run func(context.Context, uint64, int64) (entity.Installment, error)) *MockMakePaymentRepository_GetInstallment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockMakePaymentRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMakePaymentRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockMakePaymentRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockMakePaymentRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockMakePaymentRepository_GetLatestClosedPeriod_Call {
+	return &MockMakePaymentRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockMakePaymentRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockMakePaymentRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockMakePaymentRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockMakePaymentRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockMakePaymentRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMakePaymentRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockMakePaymentRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockMakePaymentRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockMakePaymentRepository_GetLoan_Call {
+	return &MockMakePaymentRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockMakePaymentRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockMakePaymentRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockMakePaymentRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockMakePaymentRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOutstandingString provides a mock function with given fields: ctx, loanID
 func (_m *MockMakePaymentRepository) GetOutstandingString(ctx context.Context, loanID uint64) (string, error) {
 	ret := _m.Called(ctx, loanID)
@@ -78,6 +368,120 @@ func (_c *MockMakePaymentRepository_GetOutstandingString_Call) RunAndReturn(run
 	return _c
 }
 
+// GetTotalRecovered provides a mock function with given fields: ctx, loanID
+func (_m *MockMakePaymentRepository) GetTotalRecovered(ctx context.Context, loanID uint64) (decimal.Decimal, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalRecovered")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (decimal.Decimal, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) decimal.Decimal); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMakePaymentRepository_GetTotalRecovered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotalRecovered'
+type MockMakePaymentRepository_GetTotalRecovered_Call struct {
+	*mock.Call
+}
+
+// GetTotalRecovered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockMakePaymentRepository_Expecter) GetTotalRecovered(ctx interface{}, loanID interface{}) *MockMakePaymentRepository_GetTotalRecovered_Call {
+	return &MockMakePaymentRepository_GetTotalRecovered_Call{Call: _e.mock.On("GetTotalRecovered", ctx, loanID)}
+}
+
+func (_c *MockMakePaymentRepository_GetTotalRecovered_Call) Run(run func(ctx context.Context, loanID uint64)) *MockMakePaymentRepository_GetTotalRecovered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_GetTotalRecovered_Call) Return(_a0 decimal.Decimal, _a1 error) *MockMakePaymentRepository_GetTotalRecovered_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_GetTotalRecovered_Call) RunAndReturn(run func(context.Context, uint64) (decimal.Decimal, error)) *MockMakePaymentRepository_GetTotalRecovered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWriteOffByLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockMakePaymentRepository) GetWriteOffByLoan(ctx context.Context, loanID uint64) (entity.WriteOff, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWriteOffByLoan")
+	}
+
+	var r0 entity.WriteOff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.WriteOff, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.WriteOff); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.WriteOff)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMakePaymentRepository_GetWriteOffByLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWriteOffByLoan'
+type MockMakePaymentRepository_GetWriteOffByLoan_Call struct {
+	*mock.Call
+}
+
+// GetWriteOffByLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockMakePaymentRepository_Expecter) GetWriteOffByLoan(ctx interface{}, loanID interface{}) *MockMakePaymentRepository_GetWriteOffByLoan_Call {
+	return &MockMakePaymentRepository_GetWriteOffByLoan_Call{Call: _e.mock.On("GetWriteOffByLoan", ctx, loanID)}
+}
+
+func (_c *MockMakePaymentRepository_GetWriteOffByLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockMakePaymentRepository_GetWriteOffByLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_GetWriteOffByLoan_Call) Return(_a0 entity.WriteOff, _a1 error) *MockMakePaymentRepository_GetWriteOffByLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_GetWriteOffByLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.WriteOff, error)) *MockMakePaymentRepository_GetWriteOffByLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsCustomerExist provides a mock function with given fields: ctx, customerID
 func (_m *MockMakePaymentRepository) IsCustomerExist(ctx context.Context, customerID uint64) (bool, error) {
 	ret := _m.Called(ctx, customerID)
@@ -193,17 +597,17 @@ func (_c *MockMakePaymentRepository_IsLoanBelongsToCustomer_Call) RunAndReturn(r
 	return _c
 }
 
-// MakePayment provides a mock function with given fields: ctx, loanID, weekNumber, amount
-func (_m *MockMakePaymentRepository) MakePayment(ctx context.Context, loanID uint64, weekNumber int64, amount string) error {
-	ret := _m.Called(ctx, loanID, weekNumber, amount)
+// MakePayment provides a mock function with given fields: ctx, loanID, weekNumber, amount, paidAt
+func (_m *MockMakePaymentRepository) MakePayment(ctx context.Context, loanID uint64, weekNumber int64, amount string, paidAt time.Time) error {
+	ret := _m.Called(ctx, loanID, weekNumber, amount, paidAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for MakePayment")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64, string) error); ok {
-		r0 = rf(ctx, loanID, weekNumber, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64, string, time.Time) error); ok {
+		r0 = rf(ctx, loanID, weekNumber, amount, paidAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -221,13 +625,14 @@ type MockMakePaymentRepository_MakePayment_Call struct {
 //   - loanID uint64
 //   - weekNumber int64
 //   - amount string
-func (_e *MockMakePaymentRepository_Expecter) MakePayment(ctx interface{}, loanID interface{}, weekNumber interface{}, amount interface{}) *MockMakePaymentRepository_MakePayment_Call {
-	return &MockMakePaymentRepository_MakePayment_Call{Call: _e.mock.On("MakePayment", ctx, loanID, weekNumber, amount)}
+//   - paidAt time.Time
+func (_e *MockMakePaymentRepository_Expecter) MakePayment(ctx interface{}, loanID interface{}, weekNumber interface{}, amount interface{}, paidAt interface{}) *MockMakePaymentRepository_MakePayment_Call {
+	return &MockMakePaymentRepository_MakePayment_Call{Call: _e.mock.On("MakePayment", ctx, loanID, weekNumber, amount, paidAt)}
 }
 
-func (_c *MockMakePaymentRepository_MakePayment_Call) Run(run func(ctx context.Context, loanID uint64, weekNumber int64, amount string)) *MockMakePaymentRepository_MakePayment_Call {
+func (_c *MockMakePaymentRepository_MakePayment_Call) Run(run func(ctx context.Context, loanID uint64, weekNumber int64, amount string, paidAt time.Time)) *MockMakePaymentRepository_MakePayment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(int64), args[3].(string))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int64), args[3].(string), args[4].(time.Time))
 	})
 	return _c
 }
@@ -237,7 +642,112 @@ func (_c *MockMakePaymentRepository_MakePayment_Call) Return(_a0 error) *MockMak
 	return _c
 }
 
-func (_c *MockMakePaymentRepository_MakePayment_Call) RunAndReturn(run func(context.Context, uint64, int64, string) error) *MockMakePaymentRepository_MakePayment_Call {
+func (_c *MockMakePaymentRepository_MakePayment_Call) RunAndReturn(run func(context.Context, uint64, int64, string, time.Time) error) *MockMakePaymentRepository_MakePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseLoanCollaterals provides a mock function with given fields: ctx, loanID, releasedAt
+func (_m *MockMakePaymentRepository) ReleaseLoanCollaterals(ctx context.Context, loanID uint64, releasedAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, loanID, releasedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseLoanCollaterals")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) (int64, error)); ok {
+		return rf(ctx, loanID, releasedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) int64); ok {
+		r0 = rf(ctx, loanID, releasedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time) error); ok {
+		r1 = rf(ctx, loanID, releasedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMakePaymentRepository_ReleaseLoanCollaterals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseLoanCollaterals'
+type MockMakePaymentRepository_ReleaseLoanCollaterals_Call struct {
+	*mock.Call
+}
+
+// ReleaseLoanCollaterals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - releasedAt time.Time
+func (_e *MockMakePaymentRepository_Expecter) ReleaseLoanCollaterals(ctx interface{}, loanID interface{}, releasedAt interface{}) *MockMakePaymentRepository_ReleaseLoanCollaterals_Call {
+	return &MockMakePaymentRepository_ReleaseLoanCollaterals_Call{Call: _e.mock.On("ReleaseLoanCollaterals", ctx, loanID, releasedAt)}
+}
+
+func (_c *MockMakePaymentRepository_ReleaseLoanCollaterals_Call) Run(run func(ctx context.Context, loanID uint64, releasedAt time.Time)) *MockMakePaymentRepository_ReleaseLoanCollaterals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_ReleaseLoanCollaterals_Call) Return(_a0 int64, _a1 error) *MockMakePaymentRepository_ReleaseLoanCollaterals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_ReleaseLoanCollaterals_Call) RunAndReturn(run func(context.Context, uint64, time.Time) (int64, error)) *MockMakePaymentRepository_ReleaseLoanCollaterals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockMakePaymentRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMakePaymentRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockMakePaymentRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockMakePaymentRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockMakePaymentRepository_WithinTransaction_Call {
+	return &MockMakePaymentRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockMakePaymentRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockMakePaymentRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_WithinTransaction_Call) Return(_a0 error) *MockMakePaymentRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMakePaymentRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockMakePaymentRepository_WithinTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,216 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMarkOverdueCreditLineBillsRepository is an autogenerated mock type for the MarkOverdueCreditLineBillsRepository type
+type MockMarkOverdueCreditLineBillsRepository struct {
+	mock.Mock
+}
+
+type MockMarkOverdueCreditLineBillsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMarkOverdueCreditLineBillsRepository) EXPECT() *MockMarkOverdueCreditLineBillsRepository_Expecter {
+	return &MockMarkOverdueCreditLineBillsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetOverdueCreditLineBills provides a mock function with given fields: ctx, asOf
+func (_m *MockMarkOverdueCreditLineBillsRepository) GetOverdueCreditLineBills(ctx context.Context, asOf time.Time) ([]entity.CreditLineBill, error) {
+	ret := _m.Called(ctx, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOverdueCreditLineBills")
+	}
+
+	var r0 []entity.CreditLineBill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.CreditLineBill, error)); ok {
+		return rf(ctx, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.CreditLineBill); ok {
+		r0 = rf(ctx, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CreditLineBill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMarkOverdueCreditLineBillsRepository_GetOverdueCreditLineBills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOverdueCreditLineBills'
+type MockMarkOverdueCreditLineBillsRepository_GetOverdueCreditLineBills_Call struct {
+	*mock.Call
+}
+
+// GetOverdueCreditLineBills is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asOf time.Time
+func (_e *MockMarkOverdueCreditLineBillsRepository_Expecter) GetOverdueCreditLineBills(ctx interface{}, asOf interface{}) *MockMarkOverdueCreditLineBillsRepository_GetOverdueCreditLineBills_Call {
+	return &MockMarkOverdueCreditLineBillsRepository_GetOverdueCreditLineBills_Call{Call: _e.mock.On("GetOverdueCreditLineBills", ctx, asOf)}
+}
+
+func (_c *MockMarkOverdueCreditLineBillsRepository_GetOverdueCreditLineBills_Call) Run(run func(ctx context.Context, asOf time.Time)) *MockMarkOverdueCreditLineBillsRepository_GetOverdueCreditLineBills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMarkOverdueCreditLineBillsRepository_GetOverdueCreditLineBills_Call) Return(_a0 []entity.CreditLineBill, _a1 error) *MockMarkOverdueCreditLineBillsRepository_GetOverdueCreditLineBills_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMarkOverdueCreditLineBillsRepository_GetOverdueCreditLineBills_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.CreditLineBill, error)) *MockMarkOverdueCreditLineBillsRepository_GetOverdueCreditLineBills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MissCreditLineBillInstallments provides a mock function with given fields: ctx, billID
+func (_m *MockMarkOverdueCreditLineBillsRepository) MissCreditLineBillInstallments(ctx context.Context, billID uint64) (int64, error) {
+	ret := _m.Called(ctx, billID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MissCreditLineBillInstallments")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (int64, error)); ok {
+		return rf(ctx, billID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) int64); ok {
+		r0 = rf(ctx, billID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, billID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMarkOverdueCreditLineBillsRepository_MissCreditLineBillInstallments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MissCreditLineBillInstallments'
+type MockMarkOverdueCreditLineBillsRepository_MissCreditLineBillInstallments_Call struct {
+	*mock.Call
+}
+
+// MissCreditLineBillInstallments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - billID uint64
+func (_e *MockMarkOverdueCreditLineBillsRepository_Expecter) MissCreditLineBillInstallments(ctx interface{}, billID interface{}) *MockMarkOverdueCreditLineBillsRepository_MissCreditLineBillInstallments_Call {
+	return &MockMarkOverdueCreditLineBillsRepository_MissCreditLineBillInstallments_Call{Call: _e.mock.On("MissCreditLineBillInstallments", ctx, billID)}
+}
+
+func (_c *MockMarkOverdueCreditLineBillsRepository_MissCreditLineBillInstallments_Call) Run(run func(ctx context.Context, billID uint64)) *MockMarkOverdueCreditLineBillsRepository_MissCreditLineBillInstallments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockMarkOverdueCreditLineBillsRepository_MissCreditLineBillInstallments_Call) Return(_a0 int64, _a1 error) *MockMarkOverdueCreditLineBillsRepository_MissCreditLineBillInstallments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMarkOverdueCreditLineBillsRepository_MissCreditLineBillInstallments_Call) RunAndReturn(run func(context.Context, uint64) (int64, error)) *MockMarkOverdueCreditLineBillsRepository_MissCreditLineBillInstallments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCreditLineBillStatus provides a mock function with given fields: ctx, billID, from, to, paidAt
+func (_m *MockMarkOverdueCreditLineBillsRepository) UpdateCreditLineBillStatus(ctx context.Context, billID uint64, from entity.CreditLineBillStatus, to entity.CreditLineBillStatus, paidAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, billID, from, to, paidAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCreditLineBillStatus")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.CreditLineBillStatus, entity.CreditLineBillStatus, time.Time) (bool, error)); ok {
+		return rf(ctx, billID, from, to, paidAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.CreditLineBillStatus, entity.CreditLineBillStatus, time.Time) bool); ok {
+		r0 = rf(ctx, billID, from, to, paidAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entity.CreditLineBillStatus, entity.CreditLineBillStatus, time.Time) error); ok {
+		r1 = rf(ctx, billID, from, to, paidAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMarkOverdueCreditLineBillsRepository_UpdateCreditLineBillStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCreditLineBillStatus'
+type MockMarkOverdueCreditLineBillsRepository_UpdateCreditLineBillStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateCreditLineBillStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - billID uint64
+//   - from entity.CreditLineBillStatus
+//   - to entity.CreditLineBillStatus
+//   - paidAt time.Time
+func (_e *MockMarkOverdueCreditLineBillsRepository_Expecter) UpdateCreditLineBillStatus(ctx interface{}, billID interface{}, from interface{}, to interface{}, paidAt interface{}) *MockMarkOverdueCreditLineBillsRepository_UpdateCreditLineBillStatus_Call {
+	return &MockMarkOverdueCreditLineBillsRepository_UpdateCreditLineBillStatus_Call{Call: _e.mock.On("UpdateCreditLineBillStatus", ctx, billID, from, to, paidAt)}
+}
+
+func (_c *MockMarkOverdueCreditLineBillsRepository_UpdateCreditLineBillStatus_Call) Run(run func(ctx context.Context, billID uint64, from entity.CreditLineBillStatus, to entity.CreditLineBillStatus, paidAt time.Time)) *MockMarkOverdueCreditLineBillsRepository_UpdateCreditLineBillStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entity.CreditLineBillStatus), args[3].(entity.CreditLineBillStatus), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMarkOverdueCreditLineBillsRepository_UpdateCreditLineBillStatus_Call) Return(_a0 bool, _a1 error) *MockMarkOverdueCreditLineBillsRepository_UpdateCreditLineBillStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMarkOverdueCreditLineBillsRepository_UpdateCreditLineBillStatus_Call) RunAndReturn(run func(context.Context, uint64, entity.CreditLineBillStatus, entity.CreditLineBillStatus, time.Time) (bool, error)) *MockMarkOverdueCreditLineBillsRepository_UpdateCreditLineBillStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMarkOverdueCreditLineBillsRepository creates a new instance of MockMarkOverdueCreditLineBillsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMarkOverdueCreditLineBillsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMarkOverdueCreditLineBillsRepository {
+	mock := &MockMarkOverdueCreditLineBillsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMarkOverdueCreditLineBillsUsecase is an autogenerated mock type for the MarkOverdueCreditLineBillsUsecase type
+type MockMarkOverdueCreditLineBillsUsecase struct {
+	mock.Mock
+}
+
+type MockMarkOverdueCreditLineBillsUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMarkOverdueCreditLineBillsUsecase) EXPECT() *MockMarkOverdueCreditLineBillsUsecase_Expecter {
+	return &MockMarkOverdueCreditLineBillsUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockMarkOverdueCreditLineBillsUsecase) Execute(ctx context.Context, input usecases.MarkOverdueCreditLineBillsInput) (usecases.MarkOverdueCreditLineBillsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.MarkOverdueCreditLineBillsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.MarkOverdueCreditLineBillsInput) (usecases.MarkOverdueCreditLineBillsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.MarkOverdueCreditLineBillsInput) usecases.MarkOverdueCreditLineBillsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.MarkOverdueCreditLineBillsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.MarkOverdueCreditLineBillsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMarkOverdueCreditLineBillsUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockMarkOverdueCreditLineBillsUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.MarkOverdueCreditLineBillsInput
+func (_e *MockMarkOverdueCreditLineBillsUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockMarkOverdueCreditLineBillsUsecase_Execute_Call {
+	return &MockMarkOverdueCreditLineBillsUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockMarkOverdueCreditLineBillsUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.MarkOverdueCreditLineBillsInput)) *MockMarkOverdueCreditLineBillsUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.MarkOverdueCreditLineBillsInput))
+	})
+	return _c
+}
+
+func (_c *MockMarkOverdueCreditLineBillsUsecase_Execute_Call) Return(_a0 usecases.MarkOverdueCreditLineBillsOutput, _a1 error) *MockMarkOverdueCreditLineBillsUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMarkOverdueCreditLineBillsUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.MarkOverdueCreditLineBillsInput) (usecases.MarkOverdueCreditLineBillsOutput, error)) *MockMarkOverdueCreditLineBillsUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMarkOverdueCreditLineBillsUsecase creates a new instance of MockMarkOverdueCreditLineBillsUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMarkOverdueCreditLineBillsUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMarkOverdueCreditLineBillsUsecase {
+	mock := &MockMarkOverdueCreditLineBillsUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMerchantOrderRepository is an autogenerated mock type for the MerchantOrderRepository type
+type MockMerchantOrderRepository struct {
+	mock.Mock
+}
+
+type MockMerchantOrderRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMerchantOrderRepository) EXPECT() *MockMerchantOrderRepository_Expecter {
+	return &MockMerchantOrderRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMerchant provides a mock function with given fields: ctx, merchantID
+func (_m *MockMerchantOrderRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Merchant, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Merchant); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMerchantOrderRepository_GetMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchant'
+type MockMerchantOrderRepository_GetMerchant_Call struct {
+	*mock.Call
+}
+
+// GetMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockMerchantOrderRepository_Expecter) GetMerchant(ctx interface{}, merchantID interface{}) *MockMerchantOrderRepository_GetMerchant_Call {
+	return &MockMerchantOrderRepository_GetMerchant_Call{Call: _e.mock.On("GetMerchant", ctx, merchantID)}
+}
+
+func (_c *MockMerchantOrderRepository_GetMerchant_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockMerchantOrderRepository_GetMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockMerchantOrderRepository_GetMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockMerchantOrderRepository_GetMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMerchantOrderRepository_GetMerchant_Call) RunAndReturn(run func(context.Context, uint64) (entity.Merchant, error)) *MockMerchantOrderRepository_GetMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMerchantOrderExist provides a mock function with given fields: ctx, merchantID, orderRef
+func (_m *MockMerchantOrderRepository) IsMerchantOrderExist(ctx context.Context, merchantID uint64, orderRef string) (bool, error) {
+	ret := _m.Called(ctx, merchantID, orderRef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMerchantOrderExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (bool, error)); ok {
+		return rf(ctx, merchantID, orderRef)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) bool); ok {
+		r0 = rf(ctx, merchantID, orderRef)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, merchantID, orderRef)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMerchantOrderRepository_IsMerchantOrderExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMerchantOrderExist'
+type MockMerchantOrderRepository_IsMerchantOrderExist_Call struct {
+	*mock.Call
+}
+
+// IsMerchantOrderExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+//   - orderRef string
+func (_e *MockMerchantOrderRepository_Expecter) IsMerchantOrderExist(ctx interface{}, merchantID interface{}, orderRef interface{}) *MockMerchantOrderRepository_IsMerchantOrderExist_Call {
+	return &MockMerchantOrderRepository_IsMerchantOrderExist_Call{Call: _e.mock.On("IsMerchantOrderExist", ctx, merchantID, orderRef)}
+}
+
+func (_c *MockMerchantOrderRepository_IsMerchantOrderExist_Call) Run(run func(ctx context.Context, merchantID uint64, orderRef string)) *MockMerchantOrderRepository_IsMerchantOrderExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockMerchantOrderRepository_IsMerchantOrderExist_Call) Return(_a0 bool, _a1 error) *MockMerchantOrderRepository_IsMerchantOrderExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMerchantOrderRepository_IsMerchantOrderExist_Call) RunAndReturn(run func(context.Context, uint64, string) (bool, error)) *MockMerchantOrderRepository_IsMerchantOrderExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMerchantOrderRepository creates a new instance of MockMerchantOrderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMerchantOrderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMerchantOrderRepository {
+	mock := &MockMerchantOrderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
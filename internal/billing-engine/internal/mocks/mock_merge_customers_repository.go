@@ -0,0 +1,319 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMergeCustomersRepository is an autogenerated mock type for the MergeCustomersRepository type
+type MockMergeCustomersRepository struct {
+	mock.Mock
+}
+
+type MockMergeCustomersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMergeCustomersRepository) EXPECT() *MockMergeCustomersRepository_Expecter {
+	return &MockMergeCustomersRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteCustomer provides a mock function with given fields: ctx, customerID, deletedAt
+func (_m *MockMergeCustomersRepository) DeleteCustomer(ctx context.Context, customerID uint64, deletedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, customerID, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCustomer")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) (bool, error)); ok {
+		return rf(ctx, customerID, deletedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) bool); ok {
+		r0 = rf(ctx, customerID, deletedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time) error); ok {
+		r1 = rf(ctx, customerID, deletedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMergeCustomersRepository_DeleteCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCustomer'
+type MockMergeCustomersRepository_DeleteCustomer_Call struct {
+	*mock.Call
+}
+
+// DeleteCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+//   - deletedAt time.Time
+func (_e *MockMergeCustomersRepository_Expecter) DeleteCustomer(ctx interface{}, customerID interface{}, deletedAt interface{}) *MockMergeCustomersRepository_DeleteCustomer_Call {
+	return &MockMergeCustomersRepository_DeleteCustomer_Call{Call: _e.mock.On("DeleteCustomer", ctx, customerID, deletedAt)}
+}
+
+func (_c *MockMergeCustomersRepository_DeleteCustomer_Call) Run(run func(ctx context.Context, customerID uint64, deletedAt time.Time)) *MockMergeCustomersRepository_DeleteCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMergeCustomersRepository_DeleteCustomer_Call) Return(_a0 bool, _a1 error) *MockMergeCustomersRepository_DeleteCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMergeCustomersRepository_DeleteCustomer_Call) RunAndReturn(run func(context.Context, uint64, time.Time) (bool, error)) *MockMergeCustomersRepository_DeleteCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomer provides a mock function with given fields: ctx, customerID
+func (_m *MockMergeCustomersRepository) GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomer")
+	}
+
+	var r0 entity.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Customer, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Customer); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.Customer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMergeCustomersRepository_GetCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomer'
+type MockMergeCustomersRepository_GetCustomer_Call struct {
+	*mock.Call
+}
+
+// GetCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockMergeCustomersRepository_Expecter) GetCustomer(ctx interface{}, customerID interface{}) *MockMergeCustomersRepository_GetCustomer_Call {
+	return &MockMergeCustomersRepository_GetCustomer_Call{Call: _e.mock.On("GetCustomer", ctx, customerID)}
+}
+
+func (_c *MockMergeCustomersRepository_GetCustomer_Call) Run(run func(ctx context.Context, customerID uint64)) *MockMergeCustomersRepository_GetCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockMergeCustomersRepository_GetCustomer_Call) Return(_a0 entity.Customer, _a1 error) *MockMergeCustomersRepository_GetCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMergeCustomersRepository_GetCustomer_Call) RunAndReturn(run func(context.Context, uint64) (entity.Customer, error)) *MockMergeCustomersRepository_GetCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeCustomer provides a mock function with given fields: ctx, merge
+func (_m *MockMergeCustomersRepository) MergeCustomer(ctx context.Context, merge entity.CustomerMerge) (entity.CustomerMerge, error) {
+	ret := _m.Called(ctx, merge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeCustomer")
+	}
+
+	var r0 entity.CustomerMerge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CustomerMerge) (entity.CustomerMerge, error)); ok {
+		return rf(ctx, merge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CustomerMerge) entity.CustomerMerge); ok {
+		r0 = rf(ctx, merge)
+	} else {
+		r0 = ret.Get(0).(entity.CustomerMerge)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CustomerMerge) error); ok {
+		r1 = rf(ctx, merge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMergeCustomersRepository_MergeCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeCustomer'
+type MockMergeCustomersRepository_MergeCustomer_Call struct {
+	*mock.Call
+}
+
+// MergeCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merge entity.CustomerMerge
+func (_e *MockMergeCustomersRepository_Expecter) MergeCustomer(ctx interface{}, merge interface{}) *MockMergeCustomersRepository_MergeCustomer_Call {
+	return &MockMergeCustomersRepository_MergeCustomer_Call{Call: _e.mock.On("MergeCustomer", ctx, merge)}
+}
+
+func (_c *MockMergeCustomersRepository_MergeCustomer_Call) Run(run func(ctx context.Context, merge entity.CustomerMerge)) *MockMergeCustomersRepository_MergeCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CustomerMerge))
+	})
+	return _c
+}
+
+func (_c *MockMergeCustomersRepository_MergeCustomer_Call) Return(_a0 entity.CustomerMerge, _a1 error) *MockMergeCustomersRepository_MergeCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMergeCustomersRepository_MergeCustomer_Call) RunAndReturn(run func(context.Context, entity.CustomerMerge) (entity.CustomerMerge, error)) *MockMergeCustomersRepository_MergeCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveCustomerDuplicatesOf provides a mock function with given fields: ctx, customerID, status, resolvedBy, resolvedAt
+func (_m *MockMergeCustomersRepository) ResolveCustomerDuplicatesOf(ctx context.Context, customerID uint64, status entity.DuplicateStatus, resolvedBy string, resolvedAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, customerID, status, resolvedBy, resolvedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveCustomerDuplicatesOf")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.DuplicateStatus, string, time.Time) (int64, error)); ok {
+		return rf(ctx, customerID, status, resolvedBy, resolvedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.DuplicateStatus, string, time.Time) int64); ok {
+		r0 = rf(ctx, customerID, status, resolvedBy, resolvedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entity.DuplicateStatus, string, time.Time) error); ok {
+		r1 = rf(ctx, customerID, status, resolvedBy, resolvedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMergeCustomersRepository_ResolveCustomerDuplicatesOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveCustomerDuplicatesOf'
+type MockMergeCustomersRepository_ResolveCustomerDuplicatesOf_Call struct {
+	*mock.Call
+}
+
+// ResolveCustomerDuplicatesOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+//   - status entity.DuplicateStatus
+//   - resolvedBy string
+//   - resolvedAt time.Time
+func (_e *MockMergeCustomersRepository_Expecter) ResolveCustomerDuplicatesOf(ctx interface{}, customerID interface{}, status interface{}, resolvedBy interface{}, resolvedAt interface{}) *MockMergeCustomersRepository_ResolveCustomerDuplicatesOf_Call {
+	return &MockMergeCustomersRepository_ResolveCustomerDuplicatesOf_Call{Call: _e.mock.On("ResolveCustomerDuplicatesOf", ctx, customerID, status, resolvedBy, resolvedAt)}
+}
+
+func (_c *MockMergeCustomersRepository_ResolveCustomerDuplicatesOf_Call) Run(run func(ctx context.Context, customerID uint64, status entity.DuplicateStatus, resolvedBy string, resolvedAt time.Time)) *MockMergeCustomersRepository_ResolveCustomerDuplicatesOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entity.DuplicateStatus), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMergeCustomersRepository_ResolveCustomerDuplicatesOf_Call) Return(_a0 int64, _a1 error) *MockMergeCustomersRepository_ResolveCustomerDuplicatesOf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMergeCustomersRepository_ResolveCustomerDuplicatesOf_Call) RunAndReturn(run func(context.Context, uint64, entity.DuplicateStatus, string, time.Time) (int64, error)) *MockMergeCustomersRepository_ResolveCustomerDuplicatesOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockMergeCustomersRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMergeCustomersRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockMergeCustomersRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockMergeCustomersRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockMergeCustomersRepository_WithinTransaction_Call {
+	return &MockMergeCustomersRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockMergeCustomersRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockMergeCustomersRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockMergeCustomersRepository_WithinTransaction_Call) Return(_a0 error) *MockMergeCustomersRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMergeCustomersRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockMergeCustomersRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMergeCustomersRepository creates a new instance of MockMergeCustomersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMergeCustomersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMergeCustomersRepository {
+	mock := &MockMergeCustomersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMergeCustomersUsecase is an autogenerated mock type for the MergeCustomersUsecase type
+type MockMergeCustomersUsecase struct {
+	mock.Mock
+}
+
+type MockMergeCustomersUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMergeCustomersUsecase) EXPECT() *MockMergeCustomersUsecase_Expecter {
+	return &MockMergeCustomersUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockMergeCustomersUsecase) Execute(ctx context.Context, input usecases.MergeCustomersInput) (usecases.CustomerMergeOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CustomerMergeOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.MergeCustomersInput) (usecases.CustomerMergeOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.MergeCustomersInput) usecases.CustomerMergeOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CustomerMergeOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.MergeCustomersInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMergeCustomersUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockMergeCustomersUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.MergeCustomersInput
+func (_e *MockMergeCustomersUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockMergeCustomersUsecase_Execute_Call {
+	return &MockMergeCustomersUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockMergeCustomersUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.MergeCustomersInput)) *MockMergeCustomersUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.MergeCustomersInput))
+	})
+	return _c
+}
+
+func (_c *MockMergeCustomersUsecase_Execute_Call) Return(_a0 usecases.CustomerMergeOutput, _a1 error) *MockMergeCustomersUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMergeCustomersUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.MergeCustomersInput) (usecases.CustomerMergeOutput, error)) *MockMergeCustomersUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMergeCustomersUsecase creates a new instance of MockMergeCustomersUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMergeCustomersUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMergeCustomersUsecase {
+	mock := &MockMergeCustomersUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
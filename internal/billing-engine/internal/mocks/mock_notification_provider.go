@@ -0,0 +1,140 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockNotificationProvider is an autogenerated mock type for the NotificationProvider type
+type MockNotificationProvider struct {
+	mock.Mock
+}
+
+type MockNotificationProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationProvider) EXPECT() *MockNotificationProvider_Expecter {
+	return &MockNotificationProvider_Expecter{mock: &_m.Mock}
+}
+
+// Channel provides a mock function with no fields
+func (_m *MockNotificationProvider) Channel() entity.NotificationChannel {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Channel")
+	}
+
+	var r0 entity.NotificationChannel
+	if rf, ok := ret.Get(0).(func() entity.NotificationChannel); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(entity.NotificationChannel)
+	}
+
+	return r0
+}
+
+// MockNotificationProvider_Channel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Channel'
+type MockNotificationProvider_Channel_Call struct {
+	*mock.Call
+}
+
+// Channel is a helper method to define mock.On call
+func (_e *MockNotificationProvider_Expecter) Channel() *MockNotificationProvider_Channel_Call {
+	return &MockNotificationProvider_Channel_Call{Call: _e.mock.On("Channel")}
+}
+
+func (_c *MockNotificationProvider_Channel_Call) Run(run func()) *MockNotificationProvider_Channel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockNotificationProvider_Channel_Call) Return(_a0 entity.NotificationChannel) *MockNotificationProvider_Channel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationProvider_Channel_Call) RunAndReturn(run func() entity.NotificationChannel) *MockNotificationProvider_Channel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: ctx, message
+func (_m *MockNotificationProvider) Send(ctx context.Context, message entity.NotificationMessage) (string, error) {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.NotificationMessage) (string, error)); ok {
+		return rf(ctx, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.NotificationMessage) string); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.NotificationMessage) error); ok {
+		r1 = rf(ctx, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotificationProvider_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockNotificationProvider_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message entity.NotificationMessage
+func (_e *MockNotificationProvider_Expecter) Send(ctx interface{}, message interface{}) *MockNotificationProvider_Send_Call {
+	return &MockNotificationProvider_Send_Call{Call: _e.mock.On("Send", ctx, message)}
+}
+
+func (_c *MockNotificationProvider_Send_Call) Run(run func(ctx context.Context, message entity.NotificationMessage)) *MockNotificationProvider_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.NotificationMessage))
+	})
+	return _c
+}
+
+func (_c *MockNotificationProvider_Send_Call) Return(_a0 string, _a1 error) *MockNotificationProvider_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotificationProvider_Send_Call) RunAndReturn(run func(context.Context, entity.NotificationMessage) (string, error)) *MockNotificationProvider_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationProvider creates a new instance of MockNotificationProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationProvider {
+	mock := &MockNotificationProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,323 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOpenCreditLineRepository is an autogenerated mock type for the OpenCreditLineRepository type
+type MockOpenCreditLineRepository struct {
+	mock.Mock
+}
+
+type MockOpenCreditLineRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOpenCreditLineRepository) EXPECT() *MockOpenCreditLineRepository_Expecter {
+	return &MockOpenCreditLineRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateCreditLine provides a mock function with given fields: ctx, line
+func (_m *MockOpenCreditLineRepository) CreateCreditLine(ctx context.Context, line entity.CreditLine) (entity.CreditLine, error) {
+	ret := _m.Called(ctx, line)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCreditLine")
+	}
+
+	var r0 entity.CreditLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CreditLine) (entity.CreditLine, error)); ok {
+		return rf(ctx, line)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CreditLine) entity.CreditLine); ok {
+		r0 = rf(ctx, line)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLine)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CreditLine) error); ok {
+		r1 = rf(ctx, line)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOpenCreditLineRepository_CreateCreditLine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCreditLine'
+type MockOpenCreditLineRepository_CreateCreditLine_Call struct {
+	*mock.Call
+}
+
+// CreateCreditLine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - line entity.CreditLine
+func (_e *MockOpenCreditLineRepository_Expecter) CreateCreditLine(ctx interface{}, line interface{}) *MockOpenCreditLineRepository_CreateCreditLine_Call {
+	return &MockOpenCreditLineRepository_CreateCreditLine_Call{Call: _e.mock.On("CreateCreditLine", ctx, line)}
+}
+
+func (_c *MockOpenCreditLineRepository_CreateCreditLine_Call) Run(run func(ctx context.Context, line entity.CreditLine)) *MockOpenCreditLineRepository_CreateCreditLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CreditLine))
+	})
+	return _c
+}
+
+func (_c *MockOpenCreditLineRepository_CreateCreditLine_Call) Return(_a0 entity.CreditLine, _a1 error) *MockOpenCreditLineRepository_CreateCreditLine_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOpenCreditLineRepository_CreateCreditLine_Call) RunAndReturn(run func(context.Context, entity.CreditLine) (entity.CreditLine, error)) *MockOpenCreditLineRepository_CreateCreditLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLimit provides a mock function with given fields: ctx, customerID
+func (_m *MockOpenCreditLineRepository) GetCreditLimit(ctx context.Context, customerID uint64) (entity.CreditLimit, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLimit")
+	}
+
+	var r0 entity.CreditLimit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLimit, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLimit); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLimit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOpenCreditLineRepository_GetCreditLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLimit'
+type MockOpenCreditLineRepository_GetCreditLimit_Call struct {
+	*mock.Call
+}
+
+// GetCreditLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockOpenCreditLineRepository_Expecter) GetCreditLimit(ctx interface{}, customerID interface{}) *MockOpenCreditLineRepository_GetCreditLimit_Call {
+	return &MockOpenCreditLineRepository_GetCreditLimit_Call{Call: _e.mock.On("GetCreditLimit", ctx, customerID)}
+}
+
+func (_c *MockOpenCreditLineRepository_GetCreditLimit_Call) Run(run func(ctx context.Context, customerID uint64)) *MockOpenCreditLineRepository_GetCreditLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOpenCreditLineRepository_GetCreditLimit_Call) Return(_a0 entity.CreditLimit, _a1 error) *MockOpenCreditLineRepository_GetCreditLimit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOpenCreditLineRepository_GetCreditLimit_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLimit, error)) *MockOpenCreditLineRepository_GetCreditLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomerExposure provides a mock function with given fields: ctx, customerID
+func (_m *MockOpenCreditLineRepository) GetCustomerExposure(ctx context.Context, customerID uint64) (entity.CustomerExposure, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomerExposure")
+	}
+
+	var r0 entity.CustomerExposure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CustomerExposure, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CustomerExposure); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.CustomerExposure)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOpenCreditLineRepository_GetCustomerExposure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomerExposure'
+type MockOpenCreditLineRepository_GetCustomerExposure_Call struct {
+	*mock.Call
+}
+
+// GetCustomerExposure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockOpenCreditLineRepository_Expecter) GetCustomerExposure(ctx interface{}, customerID interface{}) *MockOpenCreditLineRepository_GetCustomerExposure_Call {
+	return &MockOpenCreditLineRepository_GetCustomerExposure_Call{Call: _e.mock.On("GetCustomerExposure", ctx, customerID)}
+}
+
+func (_c *MockOpenCreditLineRepository_GetCustomerExposure_Call) Run(run func(ctx context.Context, customerID uint64)) *MockOpenCreditLineRepository_GetCustomerExposure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOpenCreditLineRepository_GetCustomerExposure_Call) Return(_a0 entity.CustomerExposure, _a1 error) *MockOpenCreditLineRepository_GetCustomerExposure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOpenCreditLineRepository_GetCustomerExposure_Call) RunAndReturn(run func(context.Context, uint64) (entity.CustomerExposure, error)) *MockOpenCreditLineRepository_GetCustomerExposure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCustomerExist provides a mock function with given fields: ctx, customerID
+func (_m *MockOpenCreditLineRepository) IsCustomerExist(ctx context.Context, customerID uint64) (bool, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCustomerExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOpenCreditLineRepository_IsCustomerExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCustomerExist'
+type MockOpenCreditLineRepository_IsCustomerExist_Call struct {
+	*mock.Call
+}
+
+// IsCustomerExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockOpenCreditLineRepository_Expecter) IsCustomerExist(ctx interface{}, customerID interface{}) *MockOpenCreditLineRepository_IsCustomerExist_Call {
+	return &MockOpenCreditLineRepository_IsCustomerExist_Call{Call: _e.mock.On("IsCustomerExist", ctx, customerID)}
+}
+
+func (_c *MockOpenCreditLineRepository_IsCustomerExist_Call) Run(run func(ctx context.Context, customerID uint64)) *MockOpenCreditLineRepository_IsCustomerExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOpenCreditLineRepository_IsCustomerExist_Call) Return(_a0 bool, _a1 error) *MockOpenCreditLineRepository_IsCustomerExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOpenCreditLineRepository_IsCustomerExist_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockOpenCreditLineRepository_IsCustomerExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCustomerHasWrittenOffLoan provides a mock function with given fields: ctx, customerID
+func (_m *MockOpenCreditLineRepository) IsCustomerHasWrittenOffLoan(ctx context.Context, customerID uint64) (bool, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCustomerHasWrittenOffLoan")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOpenCreditLineRepository_IsCustomerHasWrittenOffLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCustomerHasWrittenOffLoan'
+type MockOpenCreditLineRepository_IsCustomerHasWrittenOffLoan_Call struct {
+	*mock.Call
+}
+
+// IsCustomerHasWrittenOffLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockOpenCreditLineRepository_Expecter) IsCustomerHasWrittenOffLoan(ctx interface{}, customerID interface{}) *MockOpenCreditLineRepository_IsCustomerHasWrittenOffLoan_Call {
+	return &MockOpenCreditLineRepository_IsCustomerHasWrittenOffLoan_Call{Call: _e.mock.On("IsCustomerHasWrittenOffLoan", ctx, customerID)}
+}
+
+func (_c *MockOpenCreditLineRepository_IsCustomerHasWrittenOffLoan_Call) Run(run func(ctx context.Context, customerID uint64)) *MockOpenCreditLineRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOpenCreditLineRepository_IsCustomerHasWrittenOffLoan_Call) Return(_a0 bool, _a1 error) *MockOpenCreditLineRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOpenCreditLineRepository_IsCustomerHasWrittenOffLoan_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockOpenCreditLineRepository_IsCustomerHasWrittenOffLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOpenCreditLineRepository creates a new instance of MockOpenCreditLineRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOpenCreditLineRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOpenCreditLineRepository {
+	mock := &MockOpenCreditLineRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
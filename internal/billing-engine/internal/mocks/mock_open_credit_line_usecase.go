@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOpenCreditLineUsecase is an autogenerated mock type for the OpenCreditLineUsecase type
+type MockOpenCreditLineUsecase struct {
+	mock.Mock
+}
+
+type MockOpenCreditLineUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOpenCreditLineUsecase) EXPECT() *MockOpenCreditLineUsecase_Expecter {
+	return &MockOpenCreditLineUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockOpenCreditLineUsecase) Execute(ctx context.Context, input usecases.OpenCreditLineInput) (usecases.CreditLineOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CreditLineOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.OpenCreditLineInput) (usecases.CreditLineOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.OpenCreditLineInput) usecases.CreditLineOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CreditLineOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.OpenCreditLineInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOpenCreditLineUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockOpenCreditLineUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.OpenCreditLineInput
+func (_e *MockOpenCreditLineUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockOpenCreditLineUsecase_Execute_Call {
+	return &MockOpenCreditLineUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockOpenCreditLineUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.OpenCreditLineInput)) *MockOpenCreditLineUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.OpenCreditLineInput))
+	})
+	return _c
+}
+
+func (_c *MockOpenCreditLineUsecase_Execute_Call) Return(_a0 usecases.CreditLineOutput, _a1 error) *MockOpenCreditLineUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOpenCreditLineUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.OpenCreditLineInput) (usecases.CreditLineOutput, error)) *MockOpenCreditLineUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOpenCreditLineUsecase creates a new instance of MockOpenCreditLineUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOpenCreditLineUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOpenCreditLineUsecase {
+	mock := &MockOpenCreditLineUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
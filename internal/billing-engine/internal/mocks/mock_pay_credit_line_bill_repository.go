@@ -0,0 +1,540 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPayCreditLineBillRepository is an autogenerated mock type for the PayCreditLineBillRepository type
+type MockPayCreditLineBillRepository struct {
+	mock.Mock
+}
+
+type MockPayCreditLineBillRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPayCreditLineBillRepository) EXPECT() *MockPayCreditLineBillRepository_Expecter {
+	return &MockPayCreditLineBillRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockPayCreditLineBillRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayCreditLineBillRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockPayCreditLineBillRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockPayCreditLineBillRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockPayCreditLineBillRepository_CreateJournalEntry_Call {
+	return &MockPayCreditLineBillRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockPayCreditLineBillRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockPayCreditLineBillRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockPayCreditLineBillRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockPayCreditLineBillRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditLineBill provides a mock function with given fields: ctx, billID
+func (_m *MockPayCreditLineBillRepository) GetCreditLineBill(ctx context.Context, billID uint64) (entity.CreditLineBill, error) {
+	ret := _m.Called(ctx, billID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditLineBill")
+	}
+
+	var r0 entity.CreditLineBill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.CreditLineBill, error)); ok {
+		return rf(ctx, billID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.CreditLineBill); ok {
+		r0 = rf(ctx, billID)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLineBill)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, billID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayCreditLineBillRepository_GetCreditLineBill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditLineBill'
+type MockPayCreditLineBillRepository_GetCreditLineBill_Call struct {
+	*mock.Call
+}
+
+// GetCreditLineBill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - billID uint64
+func (_e *MockPayCreditLineBillRepository_Expecter) GetCreditLineBill(ctx interface{}, billID interface{}) *MockPayCreditLineBillRepository_GetCreditLineBill_Call {
+	return &MockPayCreditLineBillRepository_GetCreditLineBill_Call{Call: _e.mock.On("GetCreditLineBill", ctx, billID)}
+}
+
+func (_c *MockPayCreditLineBillRepository_GetCreditLineBill_Call) Run(run func(ctx context.Context, billID uint64)) *MockPayCreditLineBillRepository_GetCreditLineBill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_GetCreditLineBill_Call) Return(_a0 entity.CreditLineBill, _a1 error) *MockPayCreditLineBillRepository_GetCreditLineBill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_GetCreditLineBill_Call) RunAndReturn(run func(context.Context, uint64) (entity.CreditLineBill, error)) *MockPayCreditLineBillRepository_GetCreditLineBill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstallment provides a mock function with given fields: ctx, loanID, weekNumber
+func (_m *MockPayCreditLineBillRepository) GetInstallment(ctx context.Context, loanID uint64, weekNumber int64) (entity.Installment, error) {
+	ret := _m.Called(ctx, loanID, weekNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstallment")
+	}
+
+	var r0 entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64) (entity.Installment, error)); ok {
+		return rf(ctx, loanID, weekNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64) entity.Installment); ok {
+		r0 = rf(ctx, loanID, weekNumber)
+	} else {
+		r0 = ret.Get(0).(entity.Installment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, int64) error); ok {
+		r1 = rf(ctx, loanID, weekNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayCreditLineBillRepository_GetInstallment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstallment'
+type MockPayCreditLineBillRepository_GetInstallment_Call struct {
+	*mock.Call
+}
+
+// GetInstallment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - weekNumber int64
+func (_e *MockPayCreditLineBillRepository_Expecter) GetInstallment(ctx interface{}, loanID interface{}, weekNumber interface{}) *MockPayCreditLineBillRepository_GetInstallment_Call {
+	return &MockPayCreditLineBillRepository_GetInstallment_Call{Call: _e.mock.On("GetInstallment", ctx, loanID, weekNumber)}
+}
+
+func (_c *MockPayCreditLineBillRepository_GetInstallment_Call) Run(run func(ctx context.Context, loanID uint64, weekNumber int64)) *MockPayCreditLineBillRepository_GetInstallment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_GetInstallment_Call) Return(_a0 entity.Installment, _a1 error) *MockPayCreditLineBillRepository_GetInstallment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_GetInstallment_Call) RunAndReturn(run func(context.Context, uint64, int64) (entity.Installment, error)) *MockPayCreditLineBillRepository_GetInstallment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockPayCreditLineBillRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayCreditLineBillRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockPayCreditLineBillRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPayCreditLineBillRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockPayCreditLineBillRepository_GetLatestClosedPeriod_Call {
+	return &MockPayCreditLineBillRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockPayCreditLineBillRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockPayCreditLineBillRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockPayCreditLineBillRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockPayCreditLineBillRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockPayCreditLineBillRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayCreditLineBillRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockPayCreditLineBillRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockPayCreditLineBillRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockPayCreditLineBillRepository_GetLoan_Call {
+	return &MockPayCreditLineBillRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockPayCreditLineBillRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockPayCreditLineBillRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockPayCreditLineBillRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockPayCreditLineBillRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MakePayment provides a mock function with given fields: ctx, loanID, weekNumber, amount, paidAt
+func (_m *MockPayCreditLineBillRepository) MakePayment(ctx context.Context, loanID uint64, weekNumber int64, amount string, paidAt time.Time) error {
+	ret := _m.Called(ctx, loanID, weekNumber, amount, paidAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakePayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64, string, time.Time) error); ok {
+		r0 = rf(ctx, loanID, weekNumber, amount, paidAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPayCreditLineBillRepository_MakePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakePayment'
+type MockPayCreditLineBillRepository_MakePayment_Call struct {
+	*mock.Call
+}
+
+// MakePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - weekNumber int64
+//   - amount string
+//   - paidAt time.Time
+func (_e *MockPayCreditLineBillRepository_Expecter) MakePayment(ctx interface{}, loanID interface{}, weekNumber interface{}, amount interface{}, paidAt interface{}) *MockPayCreditLineBillRepository_MakePayment_Call {
+	return &MockPayCreditLineBillRepository_MakePayment_Call{Call: _e.mock.On("MakePayment", ctx, loanID, weekNumber, amount, paidAt)}
+}
+
+func (_c *MockPayCreditLineBillRepository_MakePayment_Call) Run(run func(ctx context.Context, loanID uint64, weekNumber int64, amount string, paidAt time.Time)) *MockPayCreditLineBillRepository_MakePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int64), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_MakePayment_Call) Return(_a0 error) *MockPayCreditLineBillRepository_MakePayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_MakePayment_Call) RunAndReturn(run func(context.Context, uint64, int64, string, time.Time) error) *MockPayCreditLineBillRepository_MakePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseLoanCollaterals provides a mock function with given fields: ctx, loanID, releasedAt
+func (_m *MockPayCreditLineBillRepository) ReleaseLoanCollaterals(ctx context.Context, loanID uint64, releasedAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, loanID, releasedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseLoanCollaterals")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) (int64, error)); ok {
+		return rf(ctx, loanID, releasedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) int64); ok {
+		r0 = rf(ctx, loanID, releasedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time) error); ok {
+		r1 = rf(ctx, loanID, releasedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayCreditLineBillRepository_ReleaseLoanCollaterals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseLoanCollaterals'
+type MockPayCreditLineBillRepository_ReleaseLoanCollaterals_Call struct {
+	*mock.Call
+}
+
+// ReleaseLoanCollaterals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - releasedAt time.Time
+func (_e *MockPayCreditLineBillRepository_Expecter) ReleaseLoanCollaterals(ctx interface{}, loanID interface{}, releasedAt interface{}) *MockPayCreditLineBillRepository_ReleaseLoanCollaterals_Call {
+	return &MockPayCreditLineBillRepository_ReleaseLoanCollaterals_Call{Call: _e.mock.On("ReleaseLoanCollaterals", ctx, loanID, releasedAt)}
+}
+
+func (_c *MockPayCreditLineBillRepository_ReleaseLoanCollaterals_Call) Run(run func(ctx context.Context, loanID uint64, releasedAt time.Time)) *MockPayCreditLineBillRepository_ReleaseLoanCollaterals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_ReleaseLoanCollaterals_Call) Return(_a0 int64, _a1 error) *MockPayCreditLineBillRepository_ReleaseLoanCollaterals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_ReleaseLoanCollaterals_Call) RunAndReturn(run func(context.Context, uint64, time.Time) (int64, error)) *MockPayCreditLineBillRepository_ReleaseLoanCollaterals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCreditLineBillStatus provides a mock function with given fields: ctx, billID, from, to, paidAt
+func (_m *MockPayCreditLineBillRepository) UpdateCreditLineBillStatus(ctx context.Context, billID uint64, from entity.CreditLineBillStatus, to entity.CreditLineBillStatus, paidAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, billID, from, to, paidAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCreditLineBillStatus")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.CreditLineBillStatus, entity.CreditLineBillStatus, time.Time) (bool, error)); ok {
+		return rf(ctx, billID, from, to, paidAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.CreditLineBillStatus, entity.CreditLineBillStatus, time.Time) bool); ok {
+		r0 = rf(ctx, billID, from, to, paidAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entity.CreditLineBillStatus, entity.CreditLineBillStatus, time.Time) error); ok {
+		r1 = rf(ctx, billID, from, to, paidAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayCreditLineBillRepository_UpdateCreditLineBillStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCreditLineBillStatus'
+type MockPayCreditLineBillRepository_UpdateCreditLineBillStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateCreditLineBillStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - billID uint64
+//   - from entity.CreditLineBillStatus
+//   - to entity.CreditLineBillStatus
+//   - paidAt time.Time
+func (_e *MockPayCreditLineBillRepository_Expecter) UpdateCreditLineBillStatus(ctx interface{}, billID interface{}, from interface{}, to interface{}, paidAt interface{}) *MockPayCreditLineBillRepository_UpdateCreditLineBillStatus_Call {
+	return &MockPayCreditLineBillRepository_UpdateCreditLineBillStatus_Call{Call: _e.mock.On("UpdateCreditLineBillStatus", ctx, billID, from, to, paidAt)}
+}
+
+func (_c *MockPayCreditLineBillRepository_UpdateCreditLineBillStatus_Call) Run(run func(ctx context.Context, billID uint64, from entity.CreditLineBillStatus, to entity.CreditLineBillStatus, paidAt time.Time)) *MockPayCreditLineBillRepository_UpdateCreditLineBillStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entity.CreditLineBillStatus), args[3].(entity.CreditLineBillStatus), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_UpdateCreditLineBillStatus_Call) Return(_a0 bool, _a1 error) *MockPayCreditLineBillRepository_UpdateCreditLineBillStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_UpdateCreditLineBillStatus_Call) RunAndReturn(run func(context.Context, uint64, entity.CreditLineBillStatus, entity.CreditLineBillStatus, time.Time) (bool, error)) *MockPayCreditLineBillRepository_UpdateCreditLineBillStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockPayCreditLineBillRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPayCreditLineBillRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockPayCreditLineBillRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockPayCreditLineBillRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockPayCreditLineBillRepository_WithinTransaction_Call {
+	return &MockPayCreditLineBillRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockPayCreditLineBillRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockPayCreditLineBillRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_WithinTransaction_Call) Return(_a0 error) *MockPayCreditLineBillRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPayCreditLineBillRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockPayCreditLineBillRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPayCreditLineBillRepository creates a new instance of MockPayCreditLineBillRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPayCreditLineBillRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPayCreditLineBillRepository {
+	mock := &MockPayCreditLineBillRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
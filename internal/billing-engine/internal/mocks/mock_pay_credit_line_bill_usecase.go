@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPayCreditLineBillUsecase is an autogenerated mock type for the PayCreditLineBillUsecase type
+type MockPayCreditLineBillUsecase struct {
+	mock.Mock
+}
+
+type MockPayCreditLineBillUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPayCreditLineBillUsecase) EXPECT() *MockPayCreditLineBillUsecase_Expecter {
+	return &MockPayCreditLineBillUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockPayCreditLineBillUsecase) Execute(ctx context.Context, input usecases.PayCreditLineBillInput) (usecases.CreditLineBillOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CreditLineBillOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.PayCreditLineBillInput) (usecases.CreditLineBillOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.PayCreditLineBillInput) usecases.CreditLineBillOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CreditLineBillOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.PayCreditLineBillInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayCreditLineBillUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockPayCreditLineBillUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.PayCreditLineBillInput
+func (_e *MockPayCreditLineBillUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockPayCreditLineBillUsecase_Execute_Call {
+	return &MockPayCreditLineBillUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockPayCreditLineBillUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.PayCreditLineBillInput)) *MockPayCreditLineBillUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.PayCreditLineBillInput))
+	})
+	return _c
+}
+
+func (_c *MockPayCreditLineBillUsecase_Execute_Call) Return(_a0 usecases.CreditLineBillOutput, _a1 error) *MockPayCreditLineBillUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayCreditLineBillUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.PayCreditLineBillInput) (usecases.CreditLineBillOutput, error)) *MockPayCreditLineBillUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPayCreditLineBillUsecase creates a new instance of MockPayCreditLineBillUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPayCreditLineBillUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPayCreditLineBillUsecase {
+	mock := &MockPayCreditLineBillUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPayoutProvider is an autogenerated mock type for the PayoutProvider type
+type MockPayoutProvider struct {
+	mock.Mock
+}
+
+type MockPayoutProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPayoutProvider) EXPECT() *MockPayoutProvider_Expecter {
+	return &MockPayoutProvider_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, disbursement
+func (_m *MockPayoutProvider) Send(ctx context.Context, disbursement entity.Disbursement) (entity.PayoutResult, error) {
+	ret := _m.Called(ctx, disbursement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 entity.PayoutResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Disbursement) (entity.PayoutResult, error)); ok {
+		return rf(ctx, disbursement)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Disbursement) entity.PayoutResult); ok {
+		r0 = rf(ctx, disbursement)
+	} else {
+		r0 = ret.Get(0).(entity.PayoutResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Disbursement) error); ok {
+		r1 = rf(ctx, disbursement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayoutProvider_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockPayoutProvider_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disbursement entity.Disbursement
+func (_e *MockPayoutProvider_Expecter) Send(ctx interface{}, disbursement interface{}) *MockPayoutProvider_Send_Call {
+	return &MockPayoutProvider_Send_Call{Call: _e.mock.On("Send", ctx, disbursement)}
+}
+
+func (_c *MockPayoutProvider_Send_Call) Run(run func(ctx context.Context, disbursement entity.Disbursement)) *MockPayoutProvider_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Disbursement))
+	})
+	return _c
+}
+
+func (_c *MockPayoutProvider_Send_Call) Return(_a0 entity.PayoutResult, _a1 error) *MockPayoutProvider_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayoutProvider_Send_Call) RunAndReturn(run func(context.Context, entity.Disbursement) (entity.PayoutResult, error)) *MockPayoutProvider_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPayoutProvider creates a new instance of MockPayoutProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPayoutProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPayoutProvider {
+	mock := &MockPayoutProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProductFeeRepository is an autogenerated mock type for the ProductFeeRepository type
+type MockProductFeeRepository struct {
+	mock.Mock
+}
+
+type MockProductFeeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductFeeRepository) EXPECT() *MockProductFeeRepository_Expecter {
+	return &MockProductFeeRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetProductFees provides a mock function with given fields: ctx, productCode
+func (_m *MockProductFeeRepository) GetProductFees(ctx context.Context, productCode string) ([]entity.ProductFee, error) {
+	ret := _m.Called(ctx, productCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductFees")
+	}
+
+	var r0 []entity.ProductFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.ProductFee, error)); ok {
+		return rf(ctx, productCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.ProductFee); ok {
+		r0 = rf(ctx, productCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProductFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductFeeRepository_GetProductFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductFees'
+type MockProductFeeRepository_GetProductFees_Call struct {
+	*mock.Call
+}
+
+// GetProductFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productCode string
+func (_e *MockProductFeeRepository_Expecter) GetProductFees(ctx interface{}, productCode interface{}) *MockProductFeeRepository_GetProductFees_Call {
+	return &MockProductFeeRepository_GetProductFees_Call{Call: _e.mock.On("GetProductFees", ctx, productCode)}
+}
+
+func (_c *MockProductFeeRepository_GetProductFees_Call) Run(run func(ctx context.Context, productCode string)) *MockProductFeeRepository_GetProductFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProductFeeRepository_GetProductFees_Call) Return(_a0 []entity.ProductFee, _a1 error) *MockProductFeeRepository_GetProductFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductFeeRepository_GetProductFees_Call) RunAndReturn(run func(context.Context, string) ([]entity.ProductFee, error)) *MockProductFeeRepository_GetProductFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProductFeeRepository creates a new instance of MockProductFeeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductFeeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProductFeeRepository {
+	mock := &MockProductFeeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockQuoteLoanRepository is an autogenerated mock type for the QuoteLoanRepository type
+type MockQuoteLoanRepository struct {
+	mock.Mock
+}
+
+type MockQuoteLoanRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQuoteLoanRepository) EXPECT() *MockQuoteLoanRepository_Expecter {
+	return &MockQuoteLoanRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetProductFees provides a mock function with given fields: ctx, productCode
+func (_m *MockQuoteLoanRepository) GetProductFees(ctx context.Context, productCode string) ([]entity.ProductFee, error) {
+	ret := _m.Called(ctx, productCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductFees")
+	}
+
+	var r0 []entity.ProductFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.ProductFee, error)); ok {
+		return rf(ctx, productCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.ProductFee); ok {
+		r0 = rf(ctx, productCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProductFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuoteLoanRepository_GetProductFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductFees'
+type MockQuoteLoanRepository_GetProductFees_Call struct {
+	*mock.Call
+}
+
+// GetProductFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productCode string
+func (_e *MockQuoteLoanRepository_Expecter) GetProductFees(ctx interface{}, productCode interface{}) *MockQuoteLoanRepository_GetProductFees_Call {
+	return &MockQuoteLoanRepository_GetProductFees_Call{Call: _e.mock.On("GetProductFees", ctx, productCode)}
+}
+
+func (_c *MockQuoteLoanRepository_GetProductFees_Call) Run(run func(ctx context.Context, productCode string)) *MockQuoteLoanRepository_GetProductFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuoteLoanRepository_GetProductFees_Call) Return(_a0 []entity.ProductFee, _a1 error) *MockQuoteLoanRepository_GetProductFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuoteLoanRepository_GetProductFees_Call) RunAndReturn(run func(context.Context, string) ([]entity.ProductFee, error)) *MockQuoteLoanRepository_GetProductFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockQuoteLoanRepository creates a new instance of MockQuoteLoanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQuoteLoanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQuoteLoanRepository {
+	mock := &MockQuoteLoanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockQuoteLoanUsecase is an autogenerated mock type for the QuoteLoanUsecase type
+type MockQuoteLoanUsecase struct {
+	mock.Mock
+}
+
+type MockQuoteLoanUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQuoteLoanUsecase) EXPECT() *MockQuoteLoanUsecase_Expecter {
+	return &MockQuoteLoanUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockQuoteLoanUsecase) Execute(ctx context.Context, input usecases.QuoteLoanInput) (usecases.LoanQuoteOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.LoanQuoteOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.QuoteLoanInput) (usecases.LoanQuoteOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.QuoteLoanInput) usecases.LoanQuoteOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.LoanQuoteOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.QuoteLoanInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuoteLoanUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockQuoteLoanUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.QuoteLoanInput
+func (_e *MockQuoteLoanUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockQuoteLoanUsecase_Execute_Call {
+	return &MockQuoteLoanUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockQuoteLoanUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.QuoteLoanInput)) *MockQuoteLoanUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.QuoteLoanInput))
+	})
+	return _c
+}
+
+func (_c *MockQuoteLoanUsecase_Execute_Call) Return(_a0 usecases.LoanQuoteOutput, _a1 error) *MockQuoteLoanUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuoteLoanUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.QuoteLoanInput) (usecases.LoanQuoteOutput, error)) *MockQuoteLoanUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockQuoteLoanUsecase creates a new instance of MockQuoteLoanUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQuoteLoanUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQuoteLoanUsecase {
+	mock := &MockQuoteLoanUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
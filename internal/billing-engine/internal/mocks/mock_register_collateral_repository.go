@@ -0,0 +1,152 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRegisterCollateralRepository is an autogenerated mock type for the RegisterCollateralRepository type
+type MockRegisterCollateralRepository struct {
+	mock.Mock
+}
+
+type MockRegisterCollateralRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRegisterCollateralRepository) EXPECT() *MockRegisterCollateralRepository_Expecter {
+	return &MockRegisterCollateralRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateCollateral provides a mock function with given fields: ctx, collateral
+func (_m *MockRegisterCollateralRepository) CreateCollateral(ctx context.Context, collateral entity.Collateral) (entity.Collateral, error) {
+	ret := _m.Called(ctx, collateral)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollateral")
+	}
+
+	var r0 entity.Collateral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Collateral) (entity.Collateral, error)); ok {
+		return rf(ctx, collateral)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Collateral) entity.Collateral); ok {
+		r0 = rf(ctx, collateral)
+	} else {
+		r0 = ret.Get(0).(entity.Collateral)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Collateral) error); ok {
+		r1 = rf(ctx, collateral)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRegisterCollateralRepository_CreateCollateral_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollateral'
+type MockRegisterCollateralRepository_CreateCollateral_Call struct {
+	*mock.Call
+}
+
+// CreateCollateral is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collateral entity.Collateral
+func (_e *MockRegisterCollateralRepository_Expecter) CreateCollateral(ctx interface{}, collateral interface{}) *MockRegisterCollateralRepository_CreateCollateral_Call {
+	return &MockRegisterCollateralRepository_CreateCollateral_Call{Call: _e.mock.On("CreateCollateral", ctx, collateral)}
+}
+
+func (_c *MockRegisterCollateralRepository_CreateCollateral_Call) Run(run func(ctx context.Context, collateral entity.Collateral)) *MockRegisterCollateralRepository_CreateCollateral_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Collateral))
+	})
+	return _c
+}
+
+func (_c *MockRegisterCollateralRepository_CreateCollateral_Call) Return(_a0 entity.Collateral, _a1 error) *MockRegisterCollateralRepository_CreateCollateral_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRegisterCollateralRepository_CreateCollateral_Call) RunAndReturn(run func(context.Context, entity.Collateral) (entity.Collateral, error)) *MockRegisterCollateralRepository_CreateCollateral_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockRegisterCollateralRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRegisterCollateralRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockRegisterCollateralRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockRegisterCollateralRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockRegisterCollateralRepository_GetLoan_Call {
+	return &MockRegisterCollateralRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockRegisterCollateralRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockRegisterCollateralRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRegisterCollateralRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockRegisterCollateralRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRegisterCollateralRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockRegisterCollateralRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRegisterCollateralRepository creates a new instance of MockRegisterCollateralRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRegisterCollateralRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRegisterCollateralRepository {
+	mock := &MockRegisterCollateralRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
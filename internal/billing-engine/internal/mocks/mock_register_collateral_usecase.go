@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRegisterCollateralUsecase is an autogenerated mock type for the RegisterCollateralUsecase type
+type MockRegisterCollateralUsecase struct {
+	mock.Mock
+}
+
+type MockRegisterCollateralUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRegisterCollateralUsecase) EXPECT() *MockRegisterCollateralUsecase_Expecter {
+	return &MockRegisterCollateralUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockRegisterCollateralUsecase) Execute(ctx context.Context, input usecases.RegisterCollateralInput) (usecases.CollateralOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CollateralOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RegisterCollateralInput) (usecases.CollateralOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RegisterCollateralInput) usecases.CollateralOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CollateralOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.RegisterCollateralInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRegisterCollateralUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRegisterCollateralUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.RegisterCollateralInput
+func (_e *MockRegisterCollateralUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockRegisterCollateralUsecase_Execute_Call {
+	return &MockRegisterCollateralUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockRegisterCollateralUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.RegisterCollateralInput)) *MockRegisterCollateralUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.RegisterCollateralInput))
+	})
+	return _c
+}
+
+func (_c *MockRegisterCollateralUsecase_Execute_Call) Return(_a0 usecases.CollateralOutput, _a1 error) *MockRegisterCollateralUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRegisterCollateralUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.RegisterCollateralInput) (usecases.CollateralOutput, error)) *MockRegisterCollateralUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRegisterCollateralUsecase creates a new instance of MockRegisterCollateralUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRegisterCollateralUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRegisterCollateralUsecase {
+	mock := &MockRegisterCollateralUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRejectLoanUsecase is an autogenerated mock type for the RejectLoanUsecase type
+type MockRejectLoanUsecase struct {
+	mock.Mock
+}
+
+type MockRejectLoanUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRejectLoanUsecase) EXPECT() *MockRejectLoanUsecase_Expecter {
+	return &MockRejectLoanUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockRejectLoanUsecase) Execute(ctx context.Context, input usecases.RejectLoanInput) (usecases.LoanOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.LoanOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RejectLoanInput) (usecases.LoanOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RejectLoanInput) usecases.LoanOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.LoanOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.RejectLoanInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRejectLoanUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRejectLoanUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.RejectLoanInput
+func (_e *MockRejectLoanUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockRejectLoanUsecase_Execute_Call {
+	return &MockRejectLoanUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockRejectLoanUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.RejectLoanInput)) *MockRejectLoanUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.RejectLoanInput))
+	})
+	return _c
+}
+
+func (_c *MockRejectLoanUsecase_Execute_Call) Return(_a0 usecases.LoanOutput, _a1 error) *MockRejectLoanUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRejectLoanUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.RejectLoanInput) (usecases.LoanOutput, error)) *MockRejectLoanUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRejectLoanUsecase creates a new instance of MockRejectLoanUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRejectLoanUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRejectLoanUsecase {
+	mock := &MockRejectLoanUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
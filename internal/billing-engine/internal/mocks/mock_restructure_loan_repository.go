@@ -0,0 +1,639 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	decimal "github.com/shopspring/decimal"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRestructureLoanRepository is an autogenerated mock type for the RestructureLoanRepository type
+type MockRestructureLoanRepository struct {
+	mock.Mock
+}
+
+type MockRestructureLoanRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestructureLoanRepository) EXPECT() *MockRestructureLoanRepository_Expecter {
+	return &MockRestructureLoanRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateInstallments provides a mock function with given fields: ctx, installments
+func (_m *MockRestructureLoanRepository) CreateInstallments(ctx context.Context, installments []entity.Installment) error {
+	ret := _m.Called(ctx, installments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstallments")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.Installment) error); ok {
+		r0 = rf(ctx, installments)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRestructureLoanRepository_CreateInstallments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstallments'
+type MockRestructureLoanRepository_CreateInstallments_Call struct {
+	*mock.Call
+}
+
+// CreateInstallments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - installments []entity.Installment
+func (_e *MockRestructureLoanRepository_Expecter) CreateInstallments(ctx interface{}, installments interface{}) *MockRestructureLoanRepository_CreateInstallments_Call {
+	return &MockRestructureLoanRepository_CreateInstallments_Call{Call: _e.mock.On("CreateInstallments", ctx, installments)}
+}
+
+func (_c *MockRestructureLoanRepository_CreateInstallments_Call) Run(run func(ctx context.Context, installments []entity.Installment)) *MockRestructureLoanRepository_CreateInstallments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]entity.Installment))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_CreateInstallments_Call) Return(_a0 error) *MockRestructureLoanRepository_CreateInstallments_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_CreateInstallments_Call) RunAndReturn(run func(context.Context, []entity.Installment) error) *MockRestructureLoanRepository_CreateInstallments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockRestructureLoanRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRestructureLoanRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockRestructureLoanRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockRestructureLoanRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockRestructureLoanRepository_CreateJournalEntry_Call {
+	return &MockRestructureLoanRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockRestructureLoanRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockRestructureLoanRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockRestructureLoanRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockRestructureLoanRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLoan provides a mock function with given fields: ctx, loan
+func (_m *MockRestructureLoanRepository) CreateLoan(ctx context.Context, loan entity.Loan) (entity.Loan, error) {
+	ret := _m.Called(ctx, loan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Loan) (entity.Loan, error)); ok {
+		return rf(ctx, loan)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Loan) entity.Loan); ok {
+		r0 = rf(ctx, loan)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Loan) error); ok {
+		r1 = rf(ctx, loan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRestructureLoanRepository_CreateLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoan'
+type MockRestructureLoanRepository_CreateLoan_Call struct {
+	*mock.Call
+}
+
+// CreateLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loan entity.Loan
+func (_e *MockRestructureLoanRepository_Expecter) CreateLoan(ctx interface{}, loan interface{}) *MockRestructureLoanRepository_CreateLoan_Call {
+	return &MockRestructureLoanRepository_CreateLoan_Call{Call: _e.mock.On("CreateLoan", ctx, loan)}
+}
+
+func (_c *MockRestructureLoanRepository_CreateLoan_Call) Run(run func(ctx context.Context, loan entity.Loan)) *MockRestructureLoanRepository_CreateLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Loan))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_CreateLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockRestructureLoanRepository_CreateLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_CreateLoan_Call) RunAndReturn(run func(context.Context, entity.Loan) (entity.Loan, error)) *MockRestructureLoanRepository_CreateLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLoanRestructure provides a mock function with given fields: ctx, restructure
+func (_m *MockRestructureLoanRepository) CreateLoanRestructure(ctx context.Context, restructure entity.LoanRestructure) (entity.LoanRestructure, error) {
+	ret := _m.Called(ctx, restructure)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoanRestructure")
+	}
+
+	var r0 entity.LoanRestructure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanRestructure) (entity.LoanRestructure, error)); ok {
+		return rf(ctx, restructure)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanRestructure) entity.LoanRestructure); ok {
+		r0 = rf(ctx, restructure)
+	} else {
+		r0 = ret.Get(0).(entity.LoanRestructure)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.LoanRestructure) error); ok {
+		r1 = rf(ctx, restructure)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRestructureLoanRepository_CreateLoanRestructure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoanRestructure'
+type MockRestructureLoanRepository_CreateLoanRestructure_Call struct {
+	*mock.Call
+}
+
+// CreateLoanRestructure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - restructure entity.LoanRestructure
+func (_e *MockRestructureLoanRepository_Expecter) CreateLoanRestructure(ctx interface{}, restructure interface{}) *MockRestructureLoanRepository_CreateLoanRestructure_Call {
+	return &MockRestructureLoanRepository_CreateLoanRestructure_Call{Call: _e.mock.On("CreateLoanRestructure", ctx, restructure)}
+}
+
+func (_c *MockRestructureLoanRepository_CreateLoanRestructure_Call) Run(run func(ctx context.Context, restructure entity.LoanRestructure)) *MockRestructureLoanRepository_CreateLoanRestructure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanRestructure))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_CreateLoanRestructure_Call) Return(_a0 entity.LoanRestructure, _a1 error) *MockRestructureLoanRepository_CreateLoanRestructure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_CreateLoanRestructure_Call) RunAndReturn(run func(context.Context, entity.LoanRestructure) (entity.LoanRestructure, error)) *MockRestructureLoanRepository_CreateLoanRestructure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllInstallments provides a mock function with given fields: ctx, loanID
+func (_m *MockRestructureLoanRepository) GetAllInstallments(ctx context.Context, loanID uint64) ([]entity.Installment, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllInstallments")
+	}
+
+	var r0 []entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.Installment, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.Installment); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRestructureLoanRepository_GetAllInstallments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllInstallments'
+type MockRestructureLoanRepository_GetAllInstallments_Call struct {
+	*mock.Call
+}
+
+// GetAllInstallments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockRestructureLoanRepository_Expecter) GetAllInstallments(ctx interface{}, loanID interface{}) *MockRestructureLoanRepository_GetAllInstallments_Call {
+	return &MockRestructureLoanRepository_GetAllInstallments_Call{Call: _e.mock.On("GetAllInstallments", ctx, loanID)}
+}
+
+func (_c *MockRestructureLoanRepository_GetAllInstallments_Call) Run(run func(ctx context.Context, loanID uint64)) *MockRestructureLoanRepository_GetAllInstallments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_GetAllInstallments_Call) Return(_a0 []entity.Installment, _a1 error) *MockRestructureLoanRepository_GetAllInstallments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_GetAllInstallments_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.Installment, error)) *MockRestructureLoanRepository_GetAllInstallments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockRestructureLoanRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRestructureLoanRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockRestructureLoanRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRestructureLoanRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockRestructureLoanRepository_GetLatestClosedPeriod_Call {
+	return &MockRestructureLoanRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockRestructureLoanRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockRestructureLoanRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockRestructureLoanRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockRestructureLoanRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockRestructureLoanRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRestructureLoanRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockRestructureLoanRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockRestructureLoanRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockRestructureLoanRepository_GetLoan_Call {
+	return &MockRestructureLoanRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockRestructureLoanRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockRestructureLoanRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockRestructureLoanRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockRestructureLoanRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanAccountBalance provides a mock function with given fields: ctx, loanID, accountCode
+func (_m *MockRestructureLoanRepository) GetLoanAccountBalance(ctx context.Context, loanID uint64, accountCode string) (decimal.Decimal, error) {
+	ret := _m.Called(ctx, loanID, accountCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanAccountBalance")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (decimal.Decimal, error)); ok {
+		return rf(ctx, loanID, accountCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) decimal.Decimal); ok {
+		r0 = rf(ctx, loanID, accountCode)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, loanID, accountCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRestructureLoanRepository_GetLoanAccountBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanAccountBalance'
+type MockRestructureLoanRepository_GetLoanAccountBalance_Call struct {
+	*mock.Call
+}
+
+// GetLoanAccountBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - accountCode string
+func (_e *MockRestructureLoanRepository_Expecter) GetLoanAccountBalance(ctx interface{}, loanID interface{}, accountCode interface{}) *MockRestructureLoanRepository_GetLoanAccountBalance_Call {
+	return &MockRestructureLoanRepository_GetLoanAccountBalance_Call{Call: _e.mock.On("GetLoanAccountBalance", ctx, loanID, accountCode)}
+}
+
+func (_c *MockRestructureLoanRepository_GetLoanAccountBalance_Call) Run(run func(ctx context.Context, loanID uint64, accountCode string)) *MockRestructureLoanRepository_GetLoanAccountBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_GetLoanAccountBalance_Call) Return(_a0 decimal.Decimal, _a1 error) *MockRestructureLoanRepository_GetLoanAccountBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_GetLoanAccountBalance_Call) RunAndReturn(run func(context.Context, uint64, string) (decimal.Decimal, error)) *MockRestructureLoanRepository_GetLoanAccountBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOpenInstallmentsStatus provides a mock function with given fields: ctx, loanID, status
+func (_m *MockRestructureLoanRepository) SetOpenInstallmentsStatus(ctx context.Context, loanID uint64, status entity.InstallmentStatus) (int64, error) {
+	ret := _m.Called(ctx, loanID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOpenInstallmentsStatus")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.InstallmentStatus) (int64, error)); ok {
+		return rf(ctx, loanID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.InstallmentStatus) int64); ok {
+		r0 = rf(ctx, loanID, status)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entity.InstallmentStatus) error); ok {
+		r1 = rf(ctx, loanID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRestructureLoanRepository_SetOpenInstallmentsStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOpenInstallmentsStatus'
+type MockRestructureLoanRepository_SetOpenInstallmentsStatus_Call struct {
+	*mock.Call
+}
+
+// SetOpenInstallmentsStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - status entity.InstallmentStatus
+func (_e *MockRestructureLoanRepository_Expecter) SetOpenInstallmentsStatus(ctx interface{}, loanID interface{}, status interface{}) *MockRestructureLoanRepository_SetOpenInstallmentsStatus_Call {
+	return &MockRestructureLoanRepository_SetOpenInstallmentsStatus_Call{Call: _e.mock.On("SetOpenInstallmentsStatus", ctx, loanID, status)}
+}
+
+func (_c *MockRestructureLoanRepository_SetOpenInstallmentsStatus_Call) Run(run func(ctx context.Context, loanID uint64, status entity.InstallmentStatus)) *MockRestructureLoanRepository_SetOpenInstallmentsStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entity.InstallmentStatus))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_SetOpenInstallmentsStatus_Call) Return(_a0 int64, _a1 error) *MockRestructureLoanRepository_SetOpenInstallmentsStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_SetOpenInstallmentsStatus_Call) RunAndReturn(run func(context.Context, uint64, entity.InstallmentStatus) (int64, error)) *MockRestructureLoanRepository_SetOpenInstallmentsStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionLoanStatus provides a mock function with given fields: ctx, transition
+func (_m *MockRestructureLoanRepository) TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error {
+	ret := _m.Called(ctx, transition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionLoanStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanStatusTransition) error); ok {
+		r0 = rf(ctx, transition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRestructureLoanRepository_TransitionLoanStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionLoanStatus'
+type MockRestructureLoanRepository_TransitionLoanStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionLoanStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transition entity.LoanStatusTransition
+func (_e *MockRestructureLoanRepository_Expecter) TransitionLoanStatus(ctx interface{}, transition interface{}) *MockRestructureLoanRepository_TransitionLoanStatus_Call {
+	return &MockRestructureLoanRepository_TransitionLoanStatus_Call{Call: _e.mock.On("TransitionLoanStatus", ctx, transition)}
+}
+
+func (_c *MockRestructureLoanRepository_TransitionLoanStatus_Call) Run(run func(ctx context.Context, transition entity.LoanStatusTransition)) *MockRestructureLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanStatusTransition))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_TransitionLoanStatus_Call) Return(_a0 error) *MockRestructureLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_TransitionLoanStatus_Call) RunAndReturn(run func(context.Context, entity.LoanStatusTransition) error) *MockRestructureLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockRestructureLoanRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRestructureLoanRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockRestructureLoanRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockRestructureLoanRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockRestructureLoanRepository_WithinTransaction_Call {
+	return &MockRestructureLoanRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockRestructureLoanRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockRestructureLoanRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_WithinTransaction_Call) Return(_a0 error) *MockRestructureLoanRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRestructureLoanRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockRestructureLoanRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestructureLoanRepository creates a new instance of MockRestructureLoanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestructureLoanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestructureLoanRepository {
+	mock := &MockRestructureLoanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRestructureLoanUsecase is an autogenerated mock type for the RestructureLoanUsecase type
+type MockRestructureLoanUsecase struct {
+	mock.Mock
+}
+
+type MockRestructureLoanUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestructureLoanUsecase) EXPECT() *MockRestructureLoanUsecase_Expecter {
+	return &MockRestructureLoanUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockRestructureLoanUsecase) Execute(ctx context.Context, input usecases.RestructureLoanInput) (usecases.RestructureLoanOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.RestructureLoanOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RestructureLoanInput) (usecases.RestructureLoanOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RestructureLoanInput) usecases.RestructureLoanOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.RestructureLoanOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.RestructureLoanInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRestructureLoanUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRestructureLoanUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.RestructureLoanInput
+func (_e *MockRestructureLoanUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockRestructureLoanUsecase_Execute_Call {
+	return &MockRestructureLoanUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockRestructureLoanUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.RestructureLoanInput)) *MockRestructureLoanUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.RestructureLoanInput))
+	})
+	return _c
+}
+
+func (_c *MockRestructureLoanUsecase_Execute_Call) Return(_a0 usecases.RestructureLoanOutput, _a1 error) *MockRestructureLoanUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRestructureLoanUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.RestructureLoanInput) (usecases.RestructureLoanOutput, error)) *MockRestructureLoanUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestructureLoanUsecase creates a new instance of MockRestructureLoanUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestructureLoanUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestructureLoanUsecase {
+	mock := &MockRestructureLoanUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,678 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRetryDisbursementRepository is an autogenerated mock type for the RetryDisbursementRepository type
+type MockRetryDisbursementRepository struct {
+	mock.Mock
+}
+
+type MockRetryDisbursementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRetryDisbursementRepository) EXPECT() *MockRetryDisbursementRepository_Expecter {
+	return &MockRetryDisbursementRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateInstallmentFromLoan provides a mock function with given fields: ctx, loan, fees
+func (_m *MockRetryDisbursementRepository) CreateInstallmentFromLoan(ctx context.Context, loan *entity.Loan, fees []entity.LoanFee) (bool, error) {
+	ret := _m.Called(ctx, loan, fees)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstallmentFromLoan")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Loan, []entity.LoanFee) (bool, error)); ok {
+		return rf(ctx, loan, fees)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Loan, []entity.LoanFee) bool); ok {
+		r0 = rf(ctx, loan, fees)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Loan, []entity.LoanFee) error); ok {
+		r1 = rf(ctx, loan, fees)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRetryDisbursementRepository_CreateInstallmentFromLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstallmentFromLoan'
+type MockRetryDisbursementRepository_CreateInstallmentFromLoan_Call struct {
+	*mock.Call
+}
+
+// CreateInstallmentFromLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loan *entity.Loan
+//   - fees []entity.LoanFee
+func (_e *MockRetryDisbursementRepository_Expecter) CreateInstallmentFromLoan(ctx interface{}, loan interface{}, fees interface{}) *MockRetryDisbursementRepository_CreateInstallmentFromLoan_Call {
+	return &MockRetryDisbursementRepository_CreateInstallmentFromLoan_Call{Call: _e.mock.On("CreateInstallmentFromLoan", ctx, loan, fees)}
+}
+
+func (_c *MockRetryDisbursementRepository_CreateInstallmentFromLoan_Call) Run(run func(ctx context.Context, loan *entity.Loan, fees []entity.LoanFee)) *MockRetryDisbursementRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Loan), args[2].([]entity.LoanFee))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_CreateInstallmentFromLoan_Call) Return(_a0 bool, _a1 error) *MockRetryDisbursementRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_CreateInstallmentFromLoan_Call) RunAndReturn(run func(context.Context, *entity.Loan, []entity.LoanFee) (bool, error)) *MockRetryDisbursementRepository_CreateInstallmentFromLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockRetryDisbursementRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRetryDisbursementRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockRetryDisbursementRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockRetryDisbursementRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockRetryDisbursementRepository_CreateJournalEntry_Call {
+	return &MockRetryDisbursementRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockRetryDisbursementRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockRetryDisbursementRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockRetryDisbursementRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockRetryDisbursementRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMerchantSettlementItem provides a mock function with given fields: ctx, item
+func (_m *MockRetryDisbursementRepository) CreateMerchantSettlementItem(ctx context.Context, item entity.MerchantSettlementItem) error {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMerchantSettlementItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchantSettlementItem) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRetryDisbursementRepository_CreateMerchantSettlementItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMerchantSettlementItem'
+type MockRetryDisbursementRepository_CreateMerchantSettlementItem_Call struct {
+	*mock.Call
+}
+
+// CreateMerchantSettlementItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item entity.MerchantSettlementItem
+func (_e *MockRetryDisbursementRepository_Expecter) CreateMerchantSettlementItem(ctx interface{}, item interface{}) *MockRetryDisbursementRepository_CreateMerchantSettlementItem_Call {
+	return &MockRetryDisbursementRepository_CreateMerchantSettlementItem_Call{Call: _e.mock.On("CreateMerchantSettlementItem", ctx, item)}
+}
+
+func (_c *MockRetryDisbursementRepository_CreateMerchantSettlementItem_Call) Run(run func(ctx context.Context, item entity.MerchantSettlementItem)) *MockRetryDisbursementRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchantSettlementItem))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_CreateMerchantSettlementItem_Call) Return(_a0 error) *MockRetryDisbursementRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_CreateMerchantSettlementItem_Call) RunAndReturn(run func(context.Context, entity.MerchantSettlementItem) error) *MockRetryDisbursementRepository_CreateMerchantSettlementItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDisbursement provides a mock function with given fields: ctx, disbursementID
+func (_m *MockRetryDisbursementRepository) GetDisbursement(ctx context.Context, disbursementID uint64) (entity.Disbursement, error) {
+	ret := _m.Called(ctx, disbursementID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDisbursement")
+	}
+
+	var r0 entity.Disbursement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Disbursement, error)); ok {
+		return rf(ctx, disbursementID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Disbursement); ok {
+		r0 = rf(ctx, disbursementID)
+	} else {
+		r0 = ret.Get(0).(entity.Disbursement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, disbursementID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRetryDisbursementRepository_GetDisbursement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDisbursement'
+type MockRetryDisbursementRepository_GetDisbursement_Call struct {
+	*mock.Call
+}
+
+// GetDisbursement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disbursementID uint64
+func (_e *MockRetryDisbursementRepository_Expecter) GetDisbursement(ctx interface{}, disbursementID interface{}) *MockRetryDisbursementRepository_GetDisbursement_Call {
+	return &MockRetryDisbursementRepository_GetDisbursement_Call{Call: _e.mock.On("GetDisbursement", ctx, disbursementID)}
+}
+
+func (_c *MockRetryDisbursementRepository_GetDisbursement_Call) Run(run func(ctx context.Context, disbursementID uint64)) *MockRetryDisbursementRepository_GetDisbursement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_GetDisbursement_Call) Return(_a0 entity.Disbursement, _a1 error) *MockRetryDisbursementRepository_GetDisbursement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_GetDisbursement_Call) RunAndReturn(run func(context.Context, uint64) (entity.Disbursement, error)) *MockRetryDisbursementRepository_GetDisbursement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockRetryDisbursementRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRetryDisbursementRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockRetryDisbursementRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRetryDisbursementRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockRetryDisbursementRepository_GetLatestClosedPeriod_Call {
+	return &MockRetryDisbursementRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockRetryDisbursementRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockRetryDisbursementRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockRetryDisbursementRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockRetryDisbursementRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockRetryDisbursementRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRetryDisbursementRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockRetryDisbursementRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockRetryDisbursementRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockRetryDisbursementRepository_GetLoan_Call {
+	return &MockRetryDisbursementRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockRetryDisbursementRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockRetryDisbursementRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockRetryDisbursementRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockRetryDisbursementRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanFees provides a mock function with given fields: ctx, loanID
+func (_m *MockRetryDisbursementRepository) GetLoanFees(ctx context.Context, loanID uint64) ([]entity.LoanFee, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanFees")
+	}
+
+	var r0 []entity.LoanFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.LoanFee, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.LoanFee); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRetryDisbursementRepository_GetLoanFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanFees'
+type MockRetryDisbursementRepository_GetLoanFees_Call struct {
+	*mock.Call
+}
+
+// GetLoanFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockRetryDisbursementRepository_Expecter) GetLoanFees(ctx interface{}, loanID interface{}) *MockRetryDisbursementRepository_GetLoanFees_Call {
+	return &MockRetryDisbursementRepository_GetLoanFees_Call{Call: _e.mock.On("GetLoanFees", ctx, loanID)}
+}
+
+func (_c *MockRetryDisbursementRepository_GetLoanFees_Call) Run(run func(ctx context.Context, loanID uint64)) *MockRetryDisbursementRepository_GetLoanFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_GetLoanFees_Call) Return(_a0 []entity.LoanFee, _a1 error) *MockRetryDisbursementRepository_GetLoanFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_GetLoanFees_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.LoanFee, error)) *MockRetryDisbursementRepository_GetLoanFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchant provides a mock function with given fields: ctx, merchantID
+func (_m *MockRetryDisbursementRepository) GetMerchant(ctx context.Context, merchantID uint64) (entity.Merchant, error) {
+	ret := _m.Called(ctx, merchantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchant")
+	}
+
+	var r0 entity.Merchant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Merchant, error)); ok {
+		return rf(ctx, merchantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Merchant); ok {
+		r0 = rf(ctx, merchantID)
+	} else {
+		r0 = ret.Get(0).(entity.Merchant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, merchantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRetryDisbursementRepository_GetMerchant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchant'
+type MockRetryDisbursementRepository_GetMerchant_Call struct {
+	*mock.Call
+}
+
+// GetMerchant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantID uint64
+func (_e *MockRetryDisbursementRepository_Expecter) GetMerchant(ctx interface{}, merchantID interface{}) *MockRetryDisbursementRepository_GetMerchant_Call {
+	return &MockRetryDisbursementRepository_GetMerchant_Call{Call: _e.mock.On("GetMerchant", ctx, merchantID)}
+}
+
+func (_c *MockRetryDisbursementRepository_GetMerchant_Call) Run(run func(ctx context.Context, merchantID uint64)) *MockRetryDisbursementRepository_GetMerchant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_GetMerchant_Call) Return(_a0 entity.Merchant, _a1 error) *MockRetryDisbursementRepository_GetMerchant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_GetMerchant_Call) RunAndReturn(run func(context.Context, uint64) (entity.Merchant, error)) *MockRetryDisbursementRepository_GetMerchant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLoanStartDate provides a mock function with given fields: ctx, loanID, startDate
+func (_m *MockRetryDisbursementRepository) SetLoanStartDate(ctx context.Context, loanID uint64, startDate time.Time) error {
+	ret := _m.Called(ctx, loanID, startDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLoanStartDate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) error); ok {
+		r0 = rf(ctx, loanID, startDate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRetryDisbursementRepository_SetLoanStartDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLoanStartDate'
+type MockRetryDisbursementRepository_SetLoanStartDate_Call struct {
+	*mock.Call
+}
+
+// SetLoanStartDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - startDate time.Time
+func (_e *MockRetryDisbursementRepository_Expecter) SetLoanStartDate(ctx interface{}, loanID interface{}, startDate interface{}) *MockRetryDisbursementRepository_SetLoanStartDate_Call {
+	return &MockRetryDisbursementRepository_SetLoanStartDate_Call{Call: _e.mock.On("SetLoanStartDate", ctx, loanID, startDate)}
+}
+
+func (_c *MockRetryDisbursementRepository_SetLoanStartDate_Call) Run(run func(ctx context.Context, loanID uint64, startDate time.Time)) *MockRetryDisbursementRepository_SetLoanStartDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_SetLoanStartDate_Call) Return(_a0 error) *MockRetryDisbursementRepository_SetLoanStartDate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_SetLoanStartDate_Call) RunAndReturn(run func(context.Context, uint64, time.Time) error) *MockRetryDisbursementRepository_SetLoanStartDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionLoanStatus provides a mock function with given fields: ctx, transition
+func (_m *MockRetryDisbursementRepository) TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error {
+	ret := _m.Called(ctx, transition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionLoanStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanStatusTransition) error); ok {
+		r0 = rf(ctx, transition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRetryDisbursementRepository_TransitionLoanStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionLoanStatus'
+type MockRetryDisbursementRepository_TransitionLoanStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionLoanStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transition entity.LoanStatusTransition
+func (_e *MockRetryDisbursementRepository_Expecter) TransitionLoanStatus(ctx interface{}, transition interface{}) *MockRetryDisbursementRepository_TransitionLoanStatus_Call {
+	return &MockRetryDisbursementRepository_TransitionLoanStatus_Call{Call: _e.mock.On("TransitionLoanStatus", ctx, transition)}
+}
+
+func (_c *MockRetryDisbursementRepository_TransitionLoanStatus_Call) Run(run func(ctx context.Context, transition entity.LoanStatusTransition)) *MockRetryDisbursementRepository_TransitionLoanStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanStatusTransition))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_TransitionLoanStatus_Call) Return(_a0 error) *MockRetryDisbursementRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_TransitionLoanStatus_Call) RunAndReturn(run func(context.Context, entity.LoanStatusTransition) error) *MockRetryDisbursementRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDisbursement provides a mock function with given fields: ctx, disbursement, from
+func (_m *MockRetryDisbursementRepository) UpdateDisbursement(ctx context.Context, disbursement entity.Disbursement, from entity.DisbursementStatus) error {
+	ret := _m.Called(ctx, disbursement, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDisbursement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Disbursement, entity.DisbursementStatus) error); ok {
+		r0 = rf(ctx, disbursement, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRetryDisbursementRepository_UpdateDisbursement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDisbursement'
+type MockRetryDisbursementRepository_UpdateDisbursement_Call struct {
+	*mock.Call
+}
+
+// UpdateDisbursement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disbursement entity.Disbursement
+//   - from entity.DisbursementStatus
+func (_e *MockRetryDisbursementRepository_Expecter) UpdateDisbursement(ctx interface{}, disbursement interface{}, from interface{}) *MockRetryDisbursementRepository_UpdateDisbursement_Call {
+	return &MockRetryDisbursementRepository_UpdateDisbursement_Call{Call: _e.mock.On("UpdateDisbursement", ctx, disbursement, from)}
+}
+
+func (_c *MockRetryDisbursementRepository_UpdateDisbursement_Call) Run(run func(ctx context.Context, disbursement entity.Disbursement, from entity.DisbursementStatus)) *MockRetryDisbursementRepository_UpdateDisbursement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Disbursement), args[2].(entity.DisbursementStatus))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_UpdateDisbursement_Call) Return(_a0 error) *MockRetryDisbursementRepository_UpdateDisbursement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_UpdateDisbursement_Call) RunAndReturn(run func(context.Context, entity.Disbursement, entity.DisbursementStatus) error) *MockRetryDisbursementRepository_UpdateDisbursement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockRetryDisbursementRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRetryDisbursementRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockRetryDisbursementRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockRetryDisbursementRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockRetryDisbursementRepository_WithinTransaction_Call {
+	return &MockRetryDisbursementRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockRetryDisbursementRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockRetryDisbursementRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_WithinTransaction_Call) Return(_a0 error) *MockRetryDisbursementRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRetryDisbursementRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockRetryDisbursementRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRetryDisbursementRepository creates a new instance of MockRetryDisbursementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRetryDisbursementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRetryDisbursementRepository {
+	mock := &MockRetryDisbursementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
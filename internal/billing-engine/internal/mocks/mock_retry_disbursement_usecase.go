@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRetryDisbursementUsecase is an autogenerated mock type for the RetryDisbursementUsecase type
+type MockRetryDisbursementUsecase struct {
+	mock.Mock
+}
+
+type MockRetryDisbursementUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRetryDisbursementUsecase) EXPECT() *MockRetryDisbursementUsecase_Expecter {
+	return &MockRetryDisbursementUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockRetryDisbursementUsecase) Execute(ctx context.Context, input usecases.RetryDisbursementInput) (usecases.DisbursementOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.DisbursementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RetryDisbursementInput) (usecases.DisbursementOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RetryDisbursementInput) usecases.DisbursementOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.DisbursementOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.RetryDisbursementInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRetryDisbursementUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRetryDisbursementUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.RetryDisbursementInput
+func (_e *MockRetryDisbursementUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockRetryDisbursementUsecase_Execute_Call {
+	return &MockRetryDisbursementUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockRetryDisbursementUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.RetryDisbursementInput)) *MockRetryDisbursementUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.RetryDisbursementInput))
+	})
+	return _c
+}
+
+func (_c *MockRetryDisbursementUsecase_Execute_Call) Return(_a0 usecases.DisbursementOutput, _a1 error) *MockRetryDisbursementUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRetryDisbursementUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.RetryDisbursementInput) (usecases.DisbursementOutput, error)) *MockRetryDisbursementUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRetryDisbursementUsecase creates a new instance of MockRetryDisbursementUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRetryDisbursementUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRetryDisbursementUsecase {
+	mock := &MockRetryDisbursementUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,147 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	decimal "github.com/shopspring/decimal"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRevalueCollateralRepository is an autogenerated mock type for the RevalueCollateralRepository type
+type MockRevalueCollateralRepository struct {
+	mock.Mock
+}
+
+type MockRevalueCollateralRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevalueCollateralRepository) EXPECT() *MockRevalueCollateralRepository_Expecter {
+	return &MockRevalueCollateralRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCollateral provides a mock function with given fields: ctx, collateralID
+func (_m *MockRevalueCollateralRepository) GetCollateral(ctx context.Context, collateralID uint64) (entity.Collateral, error) {
+	ret := _m.Called(ctx, collateralID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollateral")
+	}
+
+	var r0 entity.Collateral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Collateral, error)); ok {
+		return rf(ctx, collateralID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Collateral); ok {
+		r0 = rf(ctx, collateralID)
+	} else {
+		r0 = ret.Get(0).(entity.Collateral)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, collateralID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRevalueCollateralRepository_GetCollateral_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollateral'
+type MockRevalueCollateralRepository_GetCollateral_Call struct {
+	*mock.Call
+}
+
+// GetCollateral is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collateralID uint64
+func (_e *MockRevalueCollateralRepository_Expecter) GetCollateral(ctx interface{}, collateralID interface{}) *MockRevalueCollateralRepository_GetCollateral_Call {
+	return &MockRevalueCollateralRepository_GetCollateral_Call{Call: _e.mock.On("GetCollateral", ctx, collateralID)}
+}
+
+func (_c *MockRevalueCollateralRepository_GetCollateral_Call) Run(run func(ctx context.Context, collateralID uint64)) *MockRevalueCollateralRepository_GetCollateral_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRevalueCollateralRepository_GetCollateral_Call) Return(_a0 entity.Collateral, _a1 error) *MockRevalueCollateralRepository_GetCollateral_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRevalueCollateralRepository_GetCollateral_Call) RunAndReturn(run func(context.Context, uint64) (entity.Collateral, error)) *MockRevalueCollateralRepository_GetCollateral_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCollateralValuation provides a mock function with given fields: ctx, collateralID, valuation, valuationDate
+func (_m *MockRevalueCollateralRepository) UpdateCollateralValuation(ctx context.Context, collateralID uint64, valuation decimal.Decimal, valuationDate time.Time) error {
+	ret := _m.Called(ctx, collateralID, valuation, valuationDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCollateralValuation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, decimal.Decimal, time.Time) error); ok {
+		r0 = rf(ctx, collateralID, valuation, valuationDate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRevalueCollateralRepository_UpdateCollateralValuation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCollateralValuation'
+type MockRevalueCollateralRepository_UpdateCollateralValuation_Call struct {
+	*mock.Call
+}
+
+// UpdateCollateralValuation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collateralID uint64
+//   - valuation decimal.Decimal
+//   - valuationDate time.Time
+func (_e *MockRevalueCollateralRepository_Expecter) UpdateCollateralValuation(ctx interface{}, collateralID interface{}, valuation interface{}, valuationDate interface{}) *MockRevalueCollateralRepository_UpdateCollateralValuation_Call {
+	return &MockRevalueCollateralRepository_UpdateCollateralValuation_Call{Call: _e.mock.On("UpdateCollateralValuation", ctx, collateralID, valuation, valuationDate)}
+}
+
+func (_c *MockRevalueCollateralRepository_UpdateCollateralValuation_Call) Run(run func(ctx context.Context, collateralID uint64, valuation decimal.Decimal, valuationDate time.Time)) *MockRevalueCollateralRepository_UpdateCollateralValuation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(decimal.Decimal), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRevalueCollateralRepository_UpdateCollateralValuation_Call) Return(_a0 error) *MockRevalueCollateralRepository_UpdateCollateralValuation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRevalueCollateralRepository_UpdateCollateralValuation_Call) RunAndReturn(run func(context.Context, uint64, decimal.Decimal, time.Time) error) *MockRevalueCollateralRepository_UpdateCollateralValuation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRevalueCollateralRepository creates a new instance of MockRevalueCollateralRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevalueCollateralRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevalueCollateralRepository {
+	mock := &MockRevalueCollateralRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRevalueCollateralUsecase is an autogenerated mock type for the RevalueCollateralUsecase type
+type MockRevalueCollateralUsecase struct {
+	mock.Mock
+}
+
+type MockRevalueCollateralUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevalueCollateralUsecase) EXPECT() *MockRevalueCollateralUsecase_Expecter {
+	return &MockRevalueCollateralUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockRevalueCollateralUsecase) Execute(ctx context.Context, input usecases.RevalueCollateralInput) (usecases.CollateralOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CollateralOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RevalueCollateralInput) (usecases.CollateralOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RevalueCollateralInput) usecases.CollateralOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CollateralOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.RevalueCollateralInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRevalueCollateralUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRevalueCollateralUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.RevalueCollateralInput
+func (_e *MockRevalueCollateralUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockRevalueCollateralUsecase_Execute_Call {
+	return &MockRevalueCollateralUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockRevalueCollateralUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.RevalueCollateralInput)) *MockRevalueCollateralUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.RevalueCollateralInput))
+	})
+	return _c
+}
+
+func (_c *MockRevalueCollateralUsecase_Execute_Call) Return(_a0 usecases.CollateralOutput, _a1 error) *MockRevalueCollateralUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRevalueCollateralUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.RevalueCollateralInput) (usecases.CollateralOutput, error)) *MockRevalueCollateralUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRevalueCollateralUsecase creates a new instance of MockRevalueCollateralUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevalueCollateralUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevalueCollateralUsecase {
+	mock := &MockRevalueCollateralUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
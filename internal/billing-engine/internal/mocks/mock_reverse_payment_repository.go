@@ -0,0 +1,440 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReversePaymentRepository is an autogenerated mock type for the ReversePaymentRepository type
+type MockReversePaymentRepository struct {
+	mock.Mock
+}
+
+type MockReversePaymentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReversePaymentRepository) EXPECT() *MockReversePaymentRepository_Expecter {
+	return &MockReversePaymentRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockReversePaymentRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReversePaymentRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockReversePaymentRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockReversePaymentRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockReversePaymentRepository_CreateJournalEntry_Call {
+	return &MockReversePaymentRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockReversePaymentRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockReversePaymentRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockReversePaymentRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockReversePaymentRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockReversePaymentRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReversePaymentRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockReversePaymentRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReversePaymentRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockReversePaymentRepository_GetLatestClosedPeriod_Call {
+	return &MockReversePaymentRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockReversePaymentRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockReversePaymentRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockReversePaymentRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockReversePaymentRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockReversePaymentRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReversePaymentRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockReversePaymentRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockReversePaymentRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockReversePaymentRepository_GetLoan_Call {
+	return &MockReversePaymentRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockReversePaymentRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockReversePaymentRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockReversePaymentRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockReversePaymentRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnreversedJournalEntry provides a mock function with given fields: ctx, loanID, event, reference
+func (_m *MockReversePaymentRepository) GetUnreversedJournalEntry(ctx context.Context, loanID uint64, event entity.JournalEvent, reference string) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, loanID, event, reference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnreversedJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.JournalEvent, string) (entity.JournalEntry, error)); ok {
+		return rf(ctx, loanID, event, reference)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.JournalEvent, string) entity.JournalEntry); ok {
+		r0 = rf(ctx, loanID, event, reference)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entity.JournalEvent, string) error); ok {
+		r1 = rf(ctx, loanID, event, reference)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReversePaymentRepository_GetUnreversedJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnreversedJournalEntry'
+type MockReversePaymentRepository_GetUnreversedJournalEntry_Call struct {
+	*mock.Call
+}
+
+// GetUnreversedJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - event entity.JournalEvent
+//   - reference string
+func (_e *MockReversePaymentRepository_Expecter) GetUnreversedJournalEntry(ctx interface{}, loanID interface{}, event interface{}, reference interface{}) *MockReversePaymentRepository_GetUnreversedJournalEntry_Call {
+	return &MockReversePaymentRepository_GetUnreversedJournalEntry_Call{Call: _e.mock.On("GetUnreversedJournalEntry", ctx, loanID, event, reference)}
+}
+
+func (_c *MockReversePaymentRepository_GetUnreversedJournalEntry_Call) Run(run func(ctx context.Context, loanID uint64, event entity.JournalEvent, reference string)) *MockReversePaymentRepository_GetUnreversedJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entity.JournalEvent), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_GetUnreversedJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockReversePaymentRepository_GetUnreversedJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_GetUnreversedJournalEntry_Call) RunAndReturn(run func(context.Context, uint64, entity.JournalEvent, string) (entity.JournalEntry, error)) *MockReversePaymentRepository_GetUnreversedJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PledgeLoanCollaterals provides a mock function with given fields: ctx, loanID, pledgedAt
+func (_m *MockReversePaymentRepository) PledgeLoanCollaterals(ctx context.Context, loanID uint64, pledgedAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, loanID, pledgedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PledgeLoanCollaterals")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) (int64, error)); ok {
+		return rf(ctx, loanID, pledgedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) int64); ok {
+		r0 = rf(ctx, loanID, pledgedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Time) error); ok {
+		r1 = rf(ctx, loanID, pledgedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReversePaymentRepository_PledgeLoanCollaterals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PledgeLoanCollaterals'
+type MockReversePaymentRepository_PledgeLoanCollaterals_Call struct {
+	*mock.Call
+}
+
+// PledgeLoanCollaterals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - pledgedAt time.Time
+func (_e *MockReversePaymentRepository_Expecter) PledgeLoanCollaterals(ctx interface{}, loanID interface{}, pledgedAt interface{}) *MockReversePaymentRepository_PledgeLoanCollaterals_Call {
+	return &MockReversePaymentRepository_PledgeLoanCollaterals_Call{Call: _e.mock.On("PledgeLoanCollaterals", ctx, loanID, pledgedAt)}
+}
+
+func (_c *MockReversePaymentRepository_PledgeLoanCollaterals_Call) Run(run func(ctx context.Context, loanID uint64, pledgedAt time.Time)) *MockReversePaymentRepository_PledgeLoanCollaterals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_PledgeLoanCollaterals_Call) Return(_a0 int64, _a1 error) *MockReversePaymentRepository_PledgeLoanCollaterals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_PledgeLoanCollaterals_Call) RunAndReturn(run func(context.Context, uint64, time.Time) (int64, error)) *MockReversePaymentRepository_PledgeLoanCollaterals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReversePayment provides a mock function with given fields: ctx, loanID, weekNumber, asOf
+func (_m *MockReversePaymentRepository) ReversePayment(ctx context.Context, loanID uint64, weekNumber int64, asOf time.Time) (string, entity.InstallmentStatus, error) {
+	ret := _m.Called(ctx, loanID, weekNumber, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReversePayment")
+	}
+
+	var r0 string
+	var r1 entity.InstallmentStatus
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64, time.Time) (string, entity.InstallmentStatus, error)); ok {
+		return rf(ctx, loanID, weekNumber, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64, time.Time) string); ok {
+		r0 = rf(ctx, loanID, weekNumber, asOf)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, int64, time.Time) entity.InstallmentStatus); ok {
+		r1 = rf(ctx, loanID, weekNumber, asOf)
+	} else {
+		r1 = ret.Get(1).(entity.InstallmentStatus)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, int64, time.Time) error); ok {
+		r2 = rf(ctx, loanID, weekNumber, asOf)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockReversePaymentRepository_ReversePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReversePayment'
+type MockReversePaymentRepository_ReversePayment_Call struct {
+	*mock.Call
+}
+
+// ReversePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - weekNumber int64
+//   - asOf time.Time
+func (_e *MockReversePaymentRepository_Expecter) ReversePayment(ctx interface{}, loanID interface{}, weekNumber interface{}, asOf interface{}) *MockReversePaymentRepository_ReversePayment_Call {
+	return &MockReversePaymentRepository_ReversePayment_Call{Call: _e.mock.On("ReversePayment", ctx, loanID, weekNumber, asOf)}
+}
+
+func (_c *MockReversePaymentRepository_ReversePayment_Call) Run(run func(ctx context.Context, loanID uint64, weekNumber int64, asOf time.Time)) *MockReversePaymentRepository_ReversePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_ReversePayment_Call) Return(_a0 string, _a1 entity.InstallmentStatus, _a2 error) *MockReversePaymentRepository_ReversePayment_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_ReversePayment_Call) RunAndReturn(run func(context.Context, uint64, int64, time.Time) (string, entity.InstallmentStatus, error)) *MockReversePaymentRepository_ReversePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockReversePaymentRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReversePaymentRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockReversePaymentRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockReversePaymentRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockReversePaymentRepository_WithinTransaction_Call {
+	return &MockReversePaymentRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockReversePaymentRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockReversePaymentRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_WithinTransaction_Call) Return(_a0 error) *MockReversePaymentRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReversePaymentRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockReversePaymentRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReversePaymentRepository creates a new instance of MockReversePaymentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReversePaymentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReversePaymentRepository {
+	mock := &MockReversePaymentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReversePaymentUsecase is an autogenerated mock type for the ReversePaymentUsecase type
+type MockReversePaymentUsecase struct {
+	mock.Mock
+}
+
+type MockReversePaymentUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReversePaymentUsecase) EXPECT() *MockReversePaymentUsecase_Expecter {
+	return &MockReversePaymentUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockReversePaymentUsecase) Execute(ctx context.Context, input usecases.ReversePaymentInput) (usecases.ReversePaymentOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.ReversePaymentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ReversePaymentInput) (usecases.ReversePaymentOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ReversePaymentInput) usecases.ReversePaymentOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.ReversePaymentOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.ReversePaymentInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReversePaymentUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockReversePaymentUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.ReversePaymentInput
+func (_e *MockReversePaymentUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockReversePaymentUsecase_Execute_Call {
+	return &MockReversePaymentUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockReversePaymentUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.ReversePaymentInput)) *MockReversePaymentUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.ReversePaymentInput))
+	})
+	return _c
+}
+
+func (_c *MockReversePaymentUsecase_Execute_Call) Return(_a0 usecases.ReversePaymentOutput, _a1 error) *MockReversePaymentUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReversePaymentUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.ReversePaymentInput) (usecases.ReversePaymentOutput, error)) *MockReversePaymentUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReversePaymentUsecase creates a new instance of MockReversePaymentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReversePaymentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReversePaymentUsecase {
+	mock := &MockReversePaymentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
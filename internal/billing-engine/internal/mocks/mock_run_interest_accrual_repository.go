@@ -0,0 +1,372 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRunInterestAccrualRepository is an autogenerated mock type for the RunInterestAccrualRepository type
+type MockRunInterestAccrualRepository struct {
+	mock.Mock
+}
+
+type MockRunInterestAccrualRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRunInterestAccrualRepository) EXPECT() *MockRunInterestAccrualRepository_Expecter {
+	return &MockRunInterestAccrualRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateInterestAccrual provides a mock function with given fields: ctx, accrual
+func (_m *MockRunInterestAccrualRepository) CreateInterestAccrual(ctx context.Context, accrual entity.InterestAccrual) (entity.InterestAccrual, error) {
+	ret := _m.Called(ctx, accrual)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInterestAccrual")
+	}
+
+	var r0 entity.InterestAccrual
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.InterestAccrual) (entity.InterestAccrual, error)); ok {
+		return rf(ctx, accrual)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.InterestAccrual) entity.InterestAccrual); ok {
+		r0 = rf(ctx, accrual)
+	} else {
+		r0 = ret.Get(0).(entity.InterestAccrual)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.InterestAccrual) error); ok {
+		r1 = rf(ctx, accrual)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRunInterestAccrualRepository_CreateInterestAccrual_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInterestAccrual'
+type MockRunInterestAccrualRepository_CreateInterestAccrual_Call struct {
+	*mock.Call
+}
+
+// CreateInterestAccrual is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accrual entity.InterestAccrual
+func (_e *MockRunInterestAccrualRepository_Expecter) CreateInterestAccrual(ctx interface{}, accrual interface{}) *MockRunInterestAccrualRepository_CreateInterestAccrual_Call {
+	return &MockRunInterestAccrualRepository_CreateInterestAccrual_Call{Call: _e.mock.On("CreateInterestAccrual", ctx, accrual)}
+}
+
+func (_c *MockRunInterestAccrualRepository_CreateInterestAccrual_Call) Run(run func(ctx context.Context, accrual entity.InterestAccrual)) *MockRunInterestAccrualRepository_CreateInterestAccrual_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.InterestAccrual))
+	})
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_CreateInterestAccrual_Call) Return(_a0 entity.InterestAccrual, _a1 error) *MockRunInterestAccrualRepository_CreateInterestAccrual_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_CreateInterestAccrual_Call) RunAndReturn(run func(context.Context, entity.InterestAccrual) (entity.InterestAccrual, error)) *MockRunInterestAccrualRepository_CreateInterestAccrual_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockRunInterestAccrualRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRunInterestAccrualRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockRunInterestAccrualRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockRunInterestAccrualRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockRunInterestAccrualRepository_CreateJournalEntry_Call {
+	return &MockRunInterestAccrualRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockRunInterestAccrualRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockRunInterestAccrualRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockRunInterestAccrualRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockRunInterestAccrualRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllInstallments provides a mock function with given fields: ctx, loanID
+func (_m *MockRunInterestAccrualRepository) GetAllInstallments(ctx context.Context, loanID uint64) ([]entity.Installment, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllInstallments")
+	}
+
+	var r0 []entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.Installment, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.Installment); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRunInterestAccrualRepository_GetAllInstallments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllInstallments'
+type MockRunInterestAccrualRepository_GetAllInstallments_Call struct {
+	*mock.Call
+}
+
+// GetAllInstallments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockRunInterestAccrualRepository_Expecter) GetAllInstallments(ctx interface{}, loanID interface{}) *MockRunInterestAccrualRepository_GetAllInstallments_Call {
+	return &MockRunInterestAccrualRepository_GetAllInstallments_Call{Call: _e.mock.On("GetAllInstallments", ctx, loanID)}
+}
+
+func (_c *MockRunInterestAccrualRepository_GetAllInstallments_Call) Run(run func(ctx context.Context, loanID uint64)) *MockRunInterestAccrualRepository_GetAllInstallments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_GetAllInstallments_Call) Return(_a0 []entity.Installment, _a1 error) *MockRunInterestAccrualRepository_GetAllInstallments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_GetAllInstallments_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.Installment, error)) *MockRunInterestAccrualRepository_GetAllInstallments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockRunInterestAccrualRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRunInterestAccrualRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockRunInterestAccrualRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRunInterestAccrualRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockRunInterestAccrualRepository_GetLatestClosedPeriod_Call {
+	return &MockRunInterestAccrualRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockRunInterestAccrualRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockRunInterestAccrualRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockRunInterestAccrualRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockRunInterestAccrualRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanAccrualStates provides a mock function with given fields: ctx
+func (_m *MockRunInterestAccrualRepository) GetLoanAccrualStates(ctx context.Context) ([]entity.LoanAccrualState, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanAccrualStates")
+	}
+
+	var r0 []entity.LoanAccrualState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.LoanAccrualState, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.LoanAccrualState); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanAccrualState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRunInterestAccrualRepository_GetLoanAccrualStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanAccrualStates'
+type MockRunInterestAccrualRepository_GetLoanAccrualStates_Call struct {
+	*mock.Call
+}
+
+// GetLoanAccrualStates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRunInterestAccrualRepository_Expecter) GetLoanAccrualStates(ctx interface{}) *MockRunInterestAccrualRepository_GetLoanAccrualStates_Call {
+	return &MockRunInterestAccrualRepository_GetLoanAccrualStates_Call{Call: _e.mock.On("GetLoanAccrualStates", ctx)}
+}
+
+func (_c *MockRunInterestAccrualRepository_GetLoanAccrualStates_Call) Run(run func(ctx context.Context)) *MockRunInterestAccrualRepository_GetLoanAccrualStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_GetLoanAccrualStates_Call) Return(_a0 []entity.LoanAccrualState, _a1 error) *MockRunInterestAccrualRepository_GetLoanAccrualStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_GetLoanAccrualStates_Call) RunAndReturn(run func(context.Context) ([]entity.LoanAccrualState, error)) *MockRunInterestAccrualRepository_GetLoanAccrualStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockRunInterestAccrualRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRunInterestAccrualRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockRunInterestAccrualRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockRunInterestAccrualRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockRunInterestAccrualRepository_WithinTransaction_Call {
+	return &MockRunInterestAccrualRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockRunInterestAccrualRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockRunInterestAccrualRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_WithinTransaction_Call) Return(_a0 error) *MockRunInterestAccrualRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRunInterestAccrualRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockRunInterestAccrualRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRunInterestAccrualRepository creates a new instance of MockRunInterestAccrualRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRunInterestAccrualRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRunInterestAccrualRepository {
+	mock := &MockRunInterestAccrualRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRunInterestAccrualUsecase is an autogenerated mock type for the RunInterestAccrualUsecase type
+type MockRunInterestAccrualUsecase struct {
+	mock.Mock
+}
+
+type MockRunInterestAccrualUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRunInterestAccrualUsecase) EXPECT() *MockRunInterestAccrualUsecase_Expecter {
+	return &MockRunInterestAccrualUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockRunInterestAccrualUsecase) Execute(ctx context.Context, input usecases.RunInterestAccrualInput) (usecases.RunInterestAccrualOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.RunInterestAccrualOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RunInterestAccrualInput) (usecases.RunInterestAccrualOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RunInterestAccrualInput) usecases.RunInterestAccrualOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.RunInterestAccrualOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.RunInterestAccrualInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRunInterestAccrualUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRunInterestAccrualUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.RunInterestAccrualInput
+func (_e *MockRunInterestAccrualUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockRunInterestAccrualUsecase_Execute_Call {
+	return &MockRunInterestAccrualUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockRunInterestAccrualUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.RunInterestAccrualInput)) *MockRunInterestAccrualUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.RunInterestAccrualInput))
+	})
+	return _c
+}
+
+func (_c *MockRunInterestAccrualUsecase_Execute_Call) Return(_a0 usecases.RunInterestAccrualOutput, _a1 error) *MockRunInterestAccrualUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRunInterestAccrualUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.RunInterestAccrualInput) (usecases.RunInterestAccrualOutput, error)) *MockRunInterestAccrualUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRunInterestAccrualUsecase creates a new instance of MockRunInterestAccrualUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRunInterestAccrualUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRunInterestAccrualUsecase {
+	mock := &MockRunInterestAccrualUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,261 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRunProvisioningRepository is an autogenerated mock type for the RunProvisioningRepository type
+type MockRunProvisioningRepository struct {
+	mock.Mock
+}
+
+type MockRunProvisioningRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRunProvisioningRepository) EXPECT() *MockRunProvisioningRepository_Expecter {
+	return &MockRunProvisioningRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockRunProvisioningRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRunProvisioningRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockRunProvisioningRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRunProvisioningRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockRunProvisioningRepository_GetLatestClosedPeriod_Call {
+	return &MockRunProvisioningRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockRunProvisioningRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockRunProvisioningRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRunProvisioningRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockRunProvisioningRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRunProvisioningRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockRunProvisioningRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanExposures provides a mock function with given fields: ctx, asOf
+func (_m *MockRunProvisioningRepository) GetLoanExposures(ctx context.Context, asOf time.Time) ([]entity.LoanExposure, error) {
+	ret := _m.Called(ctx, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanExposures")
+	}
+
+	var r0 []entity.LoanExposure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.LoanExposure, error)); ok {
+		return rf(ctx, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.LoanExposure); ok {
+		r0 = rf(ctx, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanExposure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRunProvisioningRepository_GetLoanExposures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanExposures'
+type MockRunProvisioningRepository_GetLoanExposures_Call struct {
+	*mock.Call
+}
+
+// GetLoanExposures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asOf time.Time
+func (_e *MockRunProvisioningRepository_Expecter) GetLoanExposures(ctx interface{}, asOf interface{}) *MockRunProvisioningRepository_GetLoanExposures_Call {
+	return &MockRunProvisioningRepository_GetLoanExposures_Call{Call: _e.mock.On("GetLoanExposures", ctx, asOf)}
+}
+
+func (_c *MockRunProvisioningRepository_GetLoanExposures_Call) Run(run func(ctx context.Context, asOf time.Time)) *MockRunProvisioningRepository_GetLoanExposures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRunProvisioningRepository_GetLoanExposures_Call) Return(_a0 []entity.LoanExposure, _a1 error) *MockRunProvisioningRepository_GetLoanExposures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRunProvisioningRepository_GetLoanExposures_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.LoanExposure, error)) *MockRunProvisioningRepository_GetLoanExposures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProvisionRates provides a mock function with given fields: ctx
+func (_m *MockRunProvisioningRepository) GetProvisionRates(ctx context.Context) ([]entity.ProvisionRate, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProvisionRates")
+	}
+
+	var r0 []entity.ProvisionRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.ProvisionRate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.ProvisionRate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProvisionRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRunProvisioningRepository_GetProvisionRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProvisionRates'
+type MockRunProvisioningRepository_GetProvisionRates_Call struct {
+	*mock.Call
+}
+
+// GetProvisionRates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRunProvisioningRepository_Expecter) GetProvisionRates(ctx interface{}) *MockRunProvisioningRepository_GetProvisionRates_Call {
+	return &MockRunProvisioningRepository_GetProvisionRates_Call{Call: _e.mock.On("GetProvisionRates", ctx)}
+}
+
+func (_c *MockRunProvisioningRepository_GetProvisionRates_Call) Run(run func(ctx context.Context)) *MockRunProvisioningRepository_GetProvisionRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRunProvisioningRepository_GetProvisionRates_Call) Return(_a0 []entity.ProvisionRate, _a1 error) *MockRunProvisioningRepository_GetProvisionRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRunProvisioningRepository_GetProvisionRates_Call) RunAndReturn(run func(context.Context) ([]entity.ProvisionRate, error)) *MockRunProvisioningRepository_GetProvisionRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceLoanProvisions provides a mock function with given fields: ctx, period, provisions
+func (_m *MockRunProvisioningRepository) ReplaceLoanProvisions(ctx context.Context, period string, provisions []entity.LoanProvision) error {
+	ret := _m.Called(ctx, period, provisions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceLoanProvisions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []entity.LoanProvision) error); ok {
+		r0 = rf(ctx, period, provisions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRunProvisioningRepository_ReplaceLoanProvisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceLoanProvisions'
+type MockRunProvisioningRepository_ReplaceLoanProvisions_Call struct {
+	*mock.Call
+}
+
+// ReplaceLoanProvisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - period string
+//   - provisions []entity.LoanProvision
+func (_e *MockRunProvisioningRepository_Expecter) ReplaceLoanProvisions(ctx interface{}, period interface{}, provisions interface{}) *MockRunProvisioningRepository_ReplaceLoanProvisions_Call {
+	return &MockRunProvisioningRepository_ReplaceLoanProvisions_Call{Call: _e.mock.On("ReplaceLoanProvisions", ctx, period, provisions)}
+}
+
+func (_c *MockRunProvisioningRepository_ReplaceLoanProvisions_Call) Run(run func(ctx context.Context, period string, provisions []entity.LoanProvision)) *MockRunProvisioningRepository_ReplaceLoanProvisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]entity.LoanProvision))
+	})
+	return _c
+}
+
+func (_c *MockRunProvisioningRepository_ReplaceLoanProvisions_Call) Return(_a0 error) *MockRunProvisioningRepository_ReplaceLoanProvisions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRunProvisioningRepository_ReplaceLoanProvisions_Call) RunAndReturn(run func(context.Context, string, []entity.LoanProvision) error) *MockRunProvisioningRepository_ReplaceLoanProvisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRunProvisioningRepository creates a new instance of MockRunProvisioningRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRunProvisioningRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRunProvisioningRepository {
+	mock := &MockRunProvisioningRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
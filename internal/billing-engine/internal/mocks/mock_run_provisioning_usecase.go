@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRunProvisioningUsecase is an autogenerated mock type for the RunProvisioningUsecase type
+type MockRunProvisioningUsecase struct {
+	mock.Mock
+}
+
+type MockRunProvisioningUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRunProvisioningUsecase) EXPECT() *MockRunProvisioningUsecase_Expecter {
+	return &MockRunProvisioningUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockRunProvisioningUsecase) Execute(ctx context.Context, input usecases.RunProvisioningInput) (usecases.RunProvisioningOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.RunProvisioningOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RunProvisioningInput) (usecases.RunProvisioningOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.RunProvisioningInput) usecases.RunProvisioningOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.RunProvisioningOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.RunProvisioningInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRunProvisioningUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRunProvisioningUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.RunProvisioningInput
+func (_e *MockRunProvisioningUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockRunProvisioningUsecase_Execute_Call {
+	return &MockRunProvisioningUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockRunProvisioningUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.RunProvisioningInput)) *MockRunProvisioningUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.RunProvisioningInput))
+	})
+	return _c
+}
+
+func (_c *MockRunProvisioningUsecase_Execute_Call) Return(_a0 usecases.RunProvisioningOutput, _a1 error) *MockRunProvisioningUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRunProvisioningUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.RunProvisioningInput) (usecases.RunProvisioningOutput, error)) *MockRunProvisioningUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRunProvisioningUsecase creates a new instance of MockRunProvisioningUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRunProvisioningUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRunProvisioningUsecase {
+	mock := &MockRunProvisioningUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
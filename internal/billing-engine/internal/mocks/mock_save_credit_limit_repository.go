@@ -0,0 +1,152 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSaveCreditLimitRepository is an autogenerated mock type for the SaveCreditLimitRepository type
+type MockSaveCreditLimitRepository struct {
+	mock.Mock
+}
+
+type MockSaveCreditLimitRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSaveCreditLimitRepository) EXPECT() *MockSaveCreditLimitRepository_Expecter {
+	return &MockSaveCreditLimitRepository_Expecter{mock: &_m.Mock}
+}
+
+// IsCustomerExist provides a mock function with given fields: ctx, customerID
+func (_m *MockSaveCreditLimitRepository) IsCustomerExist(ctx context.Context, customerID uint64) (bool, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCustomerExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSaveCreditLimitRepository_IsCustomerExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCustomerExist'
+type MockSaveCreditLimitRepository_IsCustomerExist_Call struct {
+	*mock.Call
+}
+
+// IsCustomerExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockSaveCreditLimitRepository_Expecter) IsCustomerExist(ctx interface{}, customerID interface{}) *MockSaveCreditLimitRepository_IsCustomerExist_Call {
+	return &MockSaveCreditLimitRepository_IsCustomerExist_Call{Call: _e.mock.On("IsCustomerExist", ctx, customerID)}
+}
+
+func (_c *MockSaveCreditLimitRepository_IsCustomerExist_Call) Run(run func(ctx context.Context, customerID uint64)) *MockSaveCreditLimitRepository_IsCustomerExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSaveCreditLimitRepository_IsCustomerExist_Call) Return(_a0 bool, _a1 error) *MockSaveCreditLimitRepository_IsCustomerExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSaveCreditLimitRepository_IsCustomerExist_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockSaveCreditLimitRepository_IsCustomerExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCreditLimit provides a mock function with given fields: ctx, limit
+func (_m *MockSaveCreditLimitRepository) SaveCreditLimit(ctx context.Context, limit entity.CreditLimit) (entity.CreditLimit, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCreditLimit")
+	}
+
+	var r0 entity.CreditLimit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CreditLimit) (entity.CreditLimit, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CreditLimit) entity.CreditLimit); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(entity.CreditLimit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CreditLimit) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSaveCreditLimitRepository_SaveCreditLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCreditLimit'
+type MockSaveCreditLimitRepository_SaveCreditLimit_Call struct {
+	*mock.Call
+}
+
+// SaveCreditLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit entity.CreditLimit
+func (_e *MockSaveCreditLimitRepository_Expecter) SaveCreditLimit(ctx interface{}, limit interface{}) *MockSaveCreditLimitRepository_SaveCreditLimit_Call {
+	return &MockSaveCreditLimitRepository_SaveCreditLimit_Call{Call: _e.mock.On("SaveCreditLimit", ctx, limit)}
+}
+
+func (_c *MockSaveCreditLimitRepository_SaveCreditLimit_Call) Run(run func(ctx context.Context, limit entity.CreditLimit)) *MockSaveCreditLimitRepository_SaveCreditLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CreditLimit))
+	})
+	return _c
+}
+
+func (_c *MockSaveCreditLimitRepository_SaveCreditLimit_Call) Return(_a0 entity.CreditLimit, _a1 error) *MockSaveCreditLimitRepository_SaveCreditLimit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSaveCreditLimitRepository_SaveCreditLimit_Call) RunAndReturn(run func(context.Context, entity.CreditLimit) (entity.CreditLimit, error)) *MockSaveCreditLimitRepository_SaveCreditLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSaveCreditLimitRepository creates a new instance of MockSaveCreditLimitRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSaveCreditLimitRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSaveCreditLimitRepository {
+	mock := &MockSaveCreditLimitRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSaveCreditLimitUsecase is an autogenerated mock type for the SaveCreditLimitUsecase type
+type MockSaveCreditLimitUsecase struct {
+	mock.Mock
+}
+
+type MockSaveCreditLimitUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSaveCreditLimitUsecase) EXPECT() *MockSaveCreditLimitUsecase_Expecter {
+	return &MockSaveCreditLimitUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockSaveCreditLimitUsecase) Execute(ctx context.Context, input usecases.SaveCreditLimitInput) (usecases.CreditLimitOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CreditLimitOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.SaveCreditLimitInput) (usecases.CreditLimitOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.SaveCreditLimitInput) usecases.CreditLimitOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CreditLimitOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.SaveCreditLimitInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSaveCreditLimitUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockSaveCreditLimitUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.SaveCreditLimitInput
+func (_e *MockSaveCreditLimitUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockSaveCreditLimitUsecase_Execute_Call {
+	return &MockSaveCreditLimitUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockSaveCreditLimitUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.SaveCreditLimitInput)) *MockSaveCreditLimitUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.SaveCreditLimitInput))
+	})
+	return _c
+}
+
+func (_c *MockSaveCreditLimitUsecase_Execute_Call) Return(_a0 usecases.CreditLimitOutput, _a1 error) *MockSaveCreditLimitUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSaveCreditLimitUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.SaveCreditLimitInput) (usecases.CreditLimitOutput, error)) *MockSaveCreditLimitUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSaveCreditLimitUsecase creates a new instance of MockSaveCreditLimitUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSaveCreditLimitUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSaveCreditLimitUsecase {
+	mock := &MockSaveCreditLimitUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
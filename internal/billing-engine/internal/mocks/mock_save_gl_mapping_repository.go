@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSaveGLMappingRepository is an autogenerated mock type for the SaveGLMappingRepository type
+type MockSaveGLMappingRepository struct {
+	mock.Mock
+}
+
+type MockSaveGLMappingRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSaveGLMappingRepository) EXPECT() *MockSaveGLMappingRepository_Expecter {
+	return &MockSaveGLMappingRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLedgerAccounts provides a mock function with given fields: ctx
+func (_m *MockSaveGLMappingRepository) GetLedgerAccounts(ctx context.Context) ([]entity.LedgerAccount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLedgerAccounts")
+	}
+
+	var r0 []entity.LedgerAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.LedgerAccount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.LedgerAccount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LedgerAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSaveGLMappingRepository_GetLedgerAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLedgerAccounts'
+type MockSaveGLMappingRepository_GetLedgerAccounts_Call struct {
+	*mock.Call
+}
+
+// GetLedgerAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSaveGLMappingRepository_Expecter) GetLedgerAccounts(ctx interface{}) *MockSaveGLMappingRepository_GetLedgerAccounts_Call {
+	return &MockSaveGLMappingRepository_GetLedgerAccounts_Call{Call: _e.mock.On("GetLedgerAccounts", ctx)}
+}
+
+func (_c *MockSaveGLMappingRepository_GetLedgerAccounts_Call) Run(run func(ctx context.Context)) *MockSaveGLMappingRepository_GetLedgerAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSaveGLMappingRepository_GetLedgerAccounts_Call) Return(_a0 []entity.LedgerAccount, _a1 error) *MockSaveGLMappingRepository_GetLedgerAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSaveGLMappingRepository_GetLedgerAccounts_Call) RunAndReturn(run func(context.Context) ([]entity.LedgerAccount, error)) *MockSaveGLMappingRepository_GetLedgerAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveGLMapping provides a mock function with given fields: ctx, mapping
+func (_m *MockSaveGLMappingRepository) SaveGLMapping(ctx context.Context, mapping entity.GLMapping) (entity.GLMapping, error) {
+	ret := _m.Called(ctx, mapping)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveGLMapping")
+	}
+
+	var r0 entity.GLMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.GLMapping) (entity.GLMapping, error)); ok {
+		return rf(ctx, mapping)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.GLMapping) entity.GLMapping); ok {
+		r0 = rf(ctx, mapping)
+	} else {
+		r0 = ret.Get(0).(entity.GLMapping)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.GLMapping) error); ok {
+		r1 = rf(ctx, mapping)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSaveGLMappingRepository_SaveGLMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveGLMapping'
+type MockSaveGLMappingRepository_SaveGLMapping_Call struct {
+	*mock.Call
+}
+
+// SaveGLMapping is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapping entity.GLMapping
+func (_e *MockSaveGLMappingRepository_Expecter) SaveGLMapping(ctx interface{}, mapping interface{}) *MockSaveGLMappingRepository_SaveGLMapping_Call {
+	return &MockSaveGLMappingRepository_SaveGLMapping_Call{Call: _e.mock.On("SaveGLMapping", ctx, mapping)}
+}
+
+func (_c *MockSaveGLMappingRepository_SaveGLMapping_Call) Run(run func(ctx context.Context, mapping entity.GLMapping)) *MockSaveGLMappingRepository_SaveGLMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.GLMapping))
+	})
+	return _c
+}
+
+func (_c *MockSaveGLMappingRepository_SaveGLMapping_Call) Return(_a0 entity.GLMapping, _a1 error) *MockSaveGLMappingRepository_SaveGLMapping_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSaveGLMappingRepository_SaveGLMapping_Call) RunAndReturn(run func(context.Context, entity.GLMapping) (entity.GLMapping, error)) *MockSaveGLMappingRepository_SaveGLMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSaveGLMappingRepository creates a new instance of MockSaveGLMappingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSaveGLMappingRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSaveGLMappingRepository {
+	mock := &MockSaveGLMappingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
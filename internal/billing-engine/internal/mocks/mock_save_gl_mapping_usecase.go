@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSaveGLMappingUsecase is an autogenerated mock type for the SaveGLMappingUsecase type
+type MockSaveGLMappingUsecase struct {
+	mock.Mock
+}
+
+type MockSaveGLMappingUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSaveGLMappingUsecase) EXPECT() *MockSaveGLMappingUsecase_Expecter {
+	return &MockSaveGLMappingUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockSaveGLMappingUsecase) Execute(ctx context.Context, input usecases.SaveGLMappingInput) (usecases.GLMappingOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.GLMappingOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.SaveGLMappingInput) (usecases.GLMappingOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.SaveGLMappingInput) usecases.GLMappingOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.GLMappingOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.SaveGLMappingInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSaveGLMappingUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockSaveGLMappingUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.SaveGLMappingInput
+func (_e *MockSaveGLMappingUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockSaveGLMappingUsecase_Execute_Call {
+	return &MockSaveGLMappingUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockSaveGLMappingUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.SaveGLMappingInput)) *MockSaveGLMappingUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.SaveGLMappingInput))
+	})
+	return _c
+}
+
+func (_c *MockSaveGLMappingUsecase_Execute_Call) Return(_a0 usecases.GLMappingOutput, _a1 error) *MockSaveGLMappingUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSaveGLMappingUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.SaveGLMappingInput) (usecases.GLMappingOutput, error)) *MockSaveGLMappingUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSaveGLMappingUsecase creates a new instance of MockSaveGLMappingUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSaveGLMappingUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSaveGLMappingUsecase {
+	mock := &MockSaveGLMappingUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
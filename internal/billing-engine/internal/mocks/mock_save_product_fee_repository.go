@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSaveProductFeeRepository is an autogenerated mock type for the SaveProductFeeRepository type
+type MockSaveProductFeeRepository struct {
+	mock.Mock
+}
+
+type MockSaveProductFeeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSaveProductFeeRepository) EXPECT() *MockSaveProductFeeRepository_Expecter {
+	return &MockSaveProductFeeRepository_Expecter{mock: &_m.Mock}
+}
+
+// SaveProductFee provides a mock function with given fields: ctx, fee
+func (_m *MockSaveProductFeeRepository) SaveProductFee(ctx context.Context, fee entity.ProductFee) (entity.ProductFee, error) {
+	ret := _m.Called(ctx, fee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProductFee")
+	}
+
+	var r0 entity.ProductFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ProductFee) (entity.ProductFee, error)); ok {
+		return rf(ctx, fee)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ProductFee) entity.ProductFee); ok {
+		r0 = rf(ctx, fee)
+	} else {
+		r0 = ret.Get(0).(entity.ProductFee)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.ProductFee) error); ok {
+		r1 = rf(ctx, fee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSaveProductFeeRepository_SaveProductFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProductFee'
+type MockSaveProductFeeRepository_SaveProductFee_Call struct {
+	*mock.Call
+}
+
+// SaveProductFee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fee entity.ProductFee
+func (_e *MockSaveProductFeeRepository_Expecter) SaveProductFee(ctx interface{}, fee interface{}) *MockSaveProductFeeRepository_SaveProductFee_Call {
+	return &MockSaveProductFeeRepository_SaveProductFee_Call{Call: _e.mock.On("SaveProductFee", ctx, fee)}
+}
+
+func (_c *MockSaveProductFeeRepository_SaveProductFee_Call) Run(run func(ctx context.Context, fee entity.ProductFee)) *MockSaveProductFeeRepository_SaveProductFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.ProductFee))
+	})
+	return _c
+}
+
+func (_c *MockSaveProductFeeRepository_SaveProductFee_Call) Return(_a0 entity.ProductFee, _a1 error) *MockSaveProductFeeRepository_SaveProductFee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSaveProductFeeRepository_SaveProductFee_Call) RunAndReturn(run func(context.Context, entity.ProductFee) (entity.ProductFee, error)) *MockSaveProductFeeRepository_SaveProductFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSaveProductFeeRepository creates a new instance of MockSaveProductFeeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSaveProductFeeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSaveProductFeeRepository {
+	mock := &MockSaveProductFeeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSaveProductFeeUsecase is an autogenerated mock type for the SaveProductFeeUsecase type
+type MockSaveProductFeeUsecase struct {
+	mock.Mock
+}
+
+type MockSaveProductFeeUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSaveProductFeeUsecase) EXPECT() *MockSaveProductFeeUsecase_Expecter {
+	return &MockSaveProductFeeUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockSaveProductFeeUsecase) Execute(ctx context.Context, input usecases.SaveProductFeeInput) (usecases.ProductFeeOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.ProductFeeOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.SaveProductFeeInput) (usecases.ProductFeeOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.SaveProductFeeInput) usecases.ProductFeeOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.ProductFeeOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.SaveProductFeeInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSaveProductFeeUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockSaveProductFeeUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.SaveProductFeeInput
+func (_e *MockSaveProductFeeUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockSaveProductFeeUsecase_Execute_Call {
+	return &MockSaveProductFeeUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockSaveProductFeeUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.SaveProductFeeInput)) *MockSaveProductFeeUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.SaveProductFeeInput))
+	})
+	return _c
+}
+
+func (_c *MockSaveProductFeeUsecase_Execute_Call) Return(_a0 usecases.ProductFeeOutput, _a1 error) *MockSaveProductFeeUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSaveProductFeeUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.SaveProductFeeInput) (usecases.ProductFeeOutput, error)) *MockSaveProductFeeUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSaveProductFeeUsecase creates a new instance of MockSaveProductFeeUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSaveProductFeeUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSaveProductFeeUsecase {
+	mock := &MockSaveProductFeeUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSaveProvisionRateRepository is an autogenerated mock type for the SaveProvisionRateRepository type
+type MockSaveProvisionRateRepository struct {
+	mock.Mock
+}
+
+type MockSaveProvisionRateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSaveProvisionRateRepository) EXPECT() *MockSaveProvisionRateRepository_Expecter {
+	return &MockSaveProvisionRateRepository_Expecter{mock: &_m.Mock}
+}
+
+// SaveProvisionRate provides a mock function with given fields: ctx, rate
+func (_m *MockSaveProvisionRateRepository) SaveProvisionRate(ctx context.Context, rate entity.ProvisionRate) (entity.ProvisionRate, error) {
+	ret := _m.Called(ctx, rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProvisionRate")
+	}
+
+	var r0 entity.ProvisionRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ProvisionRate) (entity.ProvisionRate, error)); ok {
+		return rf(ctx, rate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ProvisionRate) entity.ProvisionRate); ok {
+		r0 = rf(ctx, rate)
+	} else {
+		r0 = ret.Get(0).(entity.ProvisionRate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.ProvisionRate) error); ok {
+		r1 = rf(ctx, rate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSaveProvisionRateRepository_SaveProvisionRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProvisionRate'
+type MockSaveProvisionRateRepository_SaveProvisionRate_Call struct {
+	*mock.Call
+}
+
+// SaveProvisionRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rate entity.ProvisionRate
+func (_e *MockSaveProvisionRateRepository_Expecter) SaveProvisionRate(ctx interface{}, rate interface{}) *MockSaveProvisionRateRepository_SaveProvisionRate_Call {
+	return &MockSaveProvisionRateRepository_SaveProvisionRate_Call{Call: _e.mock.On("SaveProvisionRate", ctx, rate)}
+}
+
+func (_c *MockSaveProvisionRateRepository_SaveProvisionRate_Call) Run(run func(ctx context.Context, rate entity.ProvisionRate)) *MockSaveProvisionRateRepository_SaveProvisionRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.ProvisionRate))
+	})
+	return _c
+}
+
+func (_c *MockSaveProvisionRateRepository_SaveProvisionRate_Call) Return(_a0 entity.ProvisionRate, _a1 error) *MockSaveProvisionRateRepository_SaveProvisionRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSaveProvisionRateRepository_SaveProvisionRate_Call) RunAndReturn(run func(context.Context, entity.ProvisionRate) (entity.ProvisionRate, error)) *MockSaveProvisionRateRepository_SaveProvisionRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSaveProvisionRateRepository creates a new instance of MockSaveProvisionRateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSaveProvisionRateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSaveProvisionRateRepository {
+	mock := &MockSaveProvisionRateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSaveProvisionRateUsecase is an autogenerated mock type for the SaveProvisionRateUsecase type
+type MockSaveProvisionRateUsecase struct {
+	mock.Mock
+}
+
+type MockSaveProvisionRateUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSaveProvisionRateUsecase) EXPECT() *MockSaveProvisionRateUsecase_Expecter {
+	return &MockSaveProvisionRateUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockSaveProvisionRateUsecase) Execute(ctx context.Context, input usecases.SaveProvisionRateInput) (usecases.ProvisionRateOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.ProvisionRateOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.SaveProvisionRateInput) (usecases.ProvisionRateOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.SaveProvisionRateInput) usecases.ProvisionRateOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.ProvisionRateOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.SaveProvisionRateInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSaveProvisionRateUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockSaveProvisionRateUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.SaveProvisionRateInput
+func (_e *MockSaveProvisionRateUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockSaveProvisionRateUsecase_Execute_Call {
+	return &MockSaveProvisionRateUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockSaveProvisionRateUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.SaveProvisionRateInput)) *MockSaveProvisionRateUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.SaveProvisionRateInput))
+	})
+	return _c
+}
+
+func (_c *MockSaveProvisionRateUsecase_Execute_Call) Return(_a0 usecases.ProvisionRateOutput, _a1 error) *MockSaveProvisionRateUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSaveProvisionRateUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.SaveProvisionRateInput) (usecases.ProvisionRateOutput, error)) *MockSaveProvisionRateUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSaveProvisionRateUsecase creates a new instance of MockSaveProvisionRateUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSaveProvisionRateUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSaveProvisionRateUsecase {
+	mock := &MockSaveProvisionRateUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,215 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUpdateCollateralLienRepository is an autogenerated mock type for the UpdateCollateralLienRepository type
+type MockUpdateCollateralLienRepository struct {
+	mock.Mock
+}
+
+type MockUpdateCollateralLienRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateCollateralLienRepository) EXPECT() *MockUpdateCollateralLienRepository_Expecter {
+	return &MockUpdateCollateralLienRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCollateral provides a mock function with given fields: ctx, collateralID
+func (_m *MockUpdateCollateralLienRepository) GetCollateral(ctx context.Context, collateralID uint64) (entity.Collateral, error) {
+	ret := _m.Called(ctx, collateralID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollateral")
+	}
+
+	var r0 entity.Collateral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Collateral, error)); ok {
+		return rf(ctx, collateralID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Collateral); ok {
+		r0 = rf(ctx, collateralID)
+	} else {
+		r0 = ret.Get(0).(entity.Collateral)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, collateralID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateCollateralLienRepository_GetCollateral_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollateral'
+type MockUpdateCollateralLienRepository_GetCollateral_Call struct {
+	*mock.Call
+}
+
+// GetCollateral is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collateralID uint64
+func (_e *MockUpdateCollateralLienRepository_Expecter) GetCollateral(ctx interface{}, collateralID interface{}) *MockUpdateCollateralLienRepository_GetCollateral_Call {
+	return &MockUpdateCollateralLienRepository_GetCollateral_Call{Call: _e.mock.On("GetCollateral", ctx, collateralID)}
+}
+
+func (_c *MockUpdateCollateralLienRepository_GetCollateral_Call) Run(run func(ctx context.Context, collateralID uint64)) *MockUpdateCollateralLienRepository_GetCollateral_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockUpdateCollateralLienRepository_GetCollateral_Call) Return(_a0 entity.Collateral, _a1 error) *MockUpdateCollateralLienRepository_GetCollateral_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateCollateralLienRepository_GetCollateral_Call) RunAndReturn(run func(context.Context, uint64) (entity.Collateral, error)) *MockUpdateCollateralLienRepository_GetCollateral_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockUpdateCollateralLienRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateCollateralLienRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockUpdateCollateralLienRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockUpdateCollateralLienRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockUpdateCollateralLienRepository_GetLoan_Call {
+	return &MockUpdateCollateralLienRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockUpdateCollateralLienRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockUpdateCollateralLienRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockUpdateCollateralLienRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockUpdateCollateralLienRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateCollateralLienRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockUpdateCollateralLienRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCollateralLien provides a mock function with given fields: ctx, collateralID, from, to, updatedBy, updatedAt
+func (_m *MockUpdateCollateralLienRepository) UpdateCollateralLien(ctx context.Context, collateralID uint64, from entity.LienStatus, to entity.LienStatus, updatedBy string, updatedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, collateralID, from, to, updatedBy, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCollateralLien")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.LienStatus, entity.LienStatus, string, time.Time) (bool, error)); ok {
+		return rf(ctx, collateralID, from, to, updatedBy, updatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.LienStatus, entity.LienStatus, string, time.Time) bool); ok {
+		r0 = rf(ctx, collateralID, from, to, updatedBy, updatedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entity.LienStatus, entity.LienStatus, string, time.Time) error); ok {
+		r1 = rf(ctx, collateralID, from, to, updatedBy, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateCollateralLienRepository_UpdateCollateralLien_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCollateralLien'
+type MockUpdateCollateralLienRepository_UpdateCollateralLien_Call struct {
+	*mock.Call
+}
+
+// UpdateCollateralLien is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collateralID uint64
+//   - from entity.LienStatus
+//   - to entity.LienStatus
+//   - updatedBy string
+//   - updatedAt time.Time
+func (_e *MockUpdateCollateralLienRepository_Expecter) UpdateCollateralLien(ctx interface{}, collateralID interface{}, from interface{}, to interface{}, updatedBy interface{}, updatedAt interface{}) *MockUpdateCollateralLienRepository_UpdateCollateralLien_Call {
+	return &MockUpdateCollateralLienRepository_UpdateCollateralLien_Call{Call: _e.mock.On("UpdateCollateralLien", ctx, collateralID, from, to, updatedBy, updatedAt)}
+}
+
+func (_c *MockUpdateCollateralLienRepository_UpdateCollateralLien_Call) Run(run func(ctx context.Context, collateralID uint64, from entity.LienStatus, to entity.LienStatus, updatedBy string, updatedAt time.Time)) *MockUpdateCollateralLienRepository_UpdateCollateralLien_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entity.LienStatus), args[3].(entity.LienStatus), args[4].(string), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUpdateCollateralLienRepository_UpdateCollateralLien_Call) Return(_a0 bool, _a1 error) *MockUpdateCollateralLienRepository_UpdateCollateralLien_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateCollateralLienRepository_UpdateCollateralLien_Call) RunAndReturn(run func(context.Context, uint64, entity.LienStatus, entity.LienStatus, string, time.Time) (bool, error)) *MockUpdateCollateralLienRepository_UpdateCollateralLien_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateCollateralLienRepository creates a new instance of MockUpdateCollateralLienRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateCollateralLienRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateCollateralLienRepository {
+	mock := &MockUpdateCollateralLienRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
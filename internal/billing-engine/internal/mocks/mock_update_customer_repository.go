@@ -0,0 +1,152 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUpdateCustomerRepository is an autogenerated mock type for the UpdateCustomerRepository type
+type MockUpdateCustomerRepository struct {
+	mock.Mock
+}
+
+type MockUpdateCustomerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateCustomerRepository) EXPECT() *MockUpdateCustomerRepository_Expecter {
+	return &MockUpdateCustomerRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCustomer provides a mock function with given fields: ctx, customerID
+func (_m *MockUpdateCustomerRepository) GetCustomer(ctx context.Context, customerID uint64) (entity.Customer, error) {
+	ret := _m.Called(ctx, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomer")
+	}
+
+	var r0 entity.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Customer, error)); ok {
+		return rf(ctx, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Customer); ok {
+		r0 = rf(ctx, customerID)
+	} else {
+		r0 = ret.Get(0).(entity.Customer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateCustomerRepository_GetCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomer'
+type MockUpdateCustomerRepository_GetCustomer_Call struct {
+	*mock.Call
+}
+
+// GetCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID uint64
+func (_e *MockUpdateCustomerRepository_Expecter) GetCustomer(ctx interface{}, customerID interface{}) *MockUpdateCustomerRepository_GetCustomer_Call {
+	return &MockUpdateCustomerRepository_GetCustomer_Call{Call: _e.mock.On("GetCustomer", ctx, customerID)}
+}
+
+func (_c *MockUpdateCustomerRepository_GetCustomer_Call) Run(run func(ctx context.Context, customerID uint64)) *MockUpdateCustomerRepository_GetCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockUpdateCustomerRepository_GetCustomer_Call) Return(_a0 entity.Customer, _a1 error) *MockUpdateCustomerRepository_GetCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateCustomerRepository_GetCustomer_Call) RunAndReturn(run func(context.Context, uint64) (entity.Customer, error)) *MockUpdateCustomerRepository_GetCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCustomer provides a mock function with given fields: ctx, customer
+func (_m *MockUpdateCustomerRepository) UpdateCustomer(ctx context.Context, customer entity.Customer) (entity.Customer, error) {
+	ret := _m.Called(ctx, customer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCustomer")
+	}
+
+	var r0 entity.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Customer) (entity.Customer, error)); ok {
+		return rf(ctx, customer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Customer) entity.Customer); ok {
+		r0 = rf(ctx, customer)
+	} else {
+		r0 = ret.Get(0).(entity.Customer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Customer) error); ok {
+		r1 = rf(ctx, customer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateCustomerRepository_UpdateCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCustomer'
+type MockUpdateCustomerRepository_UpdateCustomer_Call struct {
+	*mock.Call
+}
+
+// UpdateCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customer entity.Customer
+func (_e *MockUpdateCustomerRepository_Expecter) UpdateCustomer(ctx interface{}, customer interface{}) *MockUpdateCustomerRepository_UpdateCustomer_Call {
+	return &MockUpdateCustomerRepository_UpdateCustomer_Call{Call: _e.mock.On("UpdateCustomer", ctx, customer)}
+}
+
+func (_c *MockUpdateCustomerRepository_UpdateCustomer_Call) Run(run func(ctx context.Context, customer entity.Customer)) *MockUpdateCustomerRepository_UpdateCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Customer))
+	})
+	return _c
+}
+
+func (_c *MockUpdateCustomerRepository_UpdateCustomer_Call) Return(_a0 entity.Customer, _a1 error) *MockUpdateCustomerRepository_UpdateCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateCustomerRepository_UpdateCustomer_Call) RunAndReturn(run func(context.Context, entity.Customer) (entity.Customer, error)) *MockUpdateCustomerRepository_UpdateCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateCustomerRepository creates a new instance of MockUpdateCustomerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateCustomerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateCustomerRepository {
+	mock := &MockUpdateCustomerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
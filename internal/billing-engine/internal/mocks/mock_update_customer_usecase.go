@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUpdateCustomerUsecase is an autogenerated mock type for the UpdateCustomerUsecase type
+type MockUpdateCustomerUsecase struct {
+	mock.Mock
+}
+
+type MockUpdateCustomerUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateCustomerUsecase) EXPECT() *MockUpdateCustomerUsecase_Expecter {
+	return &MockUpdateCustomerUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockUpdateCustomerUsecase) Execute(ctx context.Context, input usecases.UpdateCustomerInput) (usecases.CustomerProfileOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.CustomerProfileOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.UpdateCustomerInput) (usecases.CustomerProfileOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.UpdateCustomerInput) usecases.CustomerProfileOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.CustomerProfileOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.UpdateCustomerInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateCustomerUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockUpdateCustomerUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.UpdateCustomerInput
+func (_e *MockUpdateCustomerUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockUpdateCustomerUsecase_Execute_Call {
+	return &MockUpdateCustomerUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockUpdateCustomerUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.UpdateCustomerInput)) *MockUpdateCustomerUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.UpdateCustomerInput))
+	})
+	return _c
+}
+
+func (_c *MockUpdateCustomerUsecase_Execute_Call) Return(_a0 usecases.CustomerProfileOutput, _a1 error) *MockUpdateCustomerUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateCustomerUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.UpdateCustomerInput) (usecases.CustomerProfileOutput, error)) *MockUpdateCustomerUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateCustomerUsecase creates a new instance of MockUpdateCustomerUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateCustomerUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateCustomerUsecase {
+	mock := &MockUpdateCustomerUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,266 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUpdateMerchantSettlementStatusRepository is an autogenerated mock type for the UpdateMerchantSettlementStatusRepository type
+type MockUpdateMerchantSettlementStatusRepository struct {
+	mock.Mock
+}
+
+type MockUpdateMerchantSettlementStatusRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateMerchantSettlementStatusRepository) EXPECT() *MockUpdateMerchantSettlementStatusRepository_Expecter {
+	return &MockUpdateMerchantSettlementStatusRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockUpdateMerchantSettlementStatusRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateMerchantSettlementStatusRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockUpdateMerchantSettlementStatusRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockUpdateMerchantSettlementStatusRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockUpdateMerchantSettlementStatusRepository_CreateJournalEntry_Call {
+	return &MockUpdateMerchantSettlementStatusRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockUpdateMerchantSettlementStatusRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockUpdateMerchantSettlementStatusRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockUpdateMerchantSettlementStatusRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockUpdateMerchantSettlementStatusRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateMerchantSettlementStatusRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockUpdateMerchantSettlementStatusRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUpdateMerchantSettlementStatusRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockUpdateMerchantSettlementStatusRepository_GetLatestClosedPeriod_Call {
+	return &MockUpdateMerchantSettlementStatusRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockUpdateMerchantSettlementStatusRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockUpdateMerchantSettlementStatusRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockUpdateMerchantSettlementStatusRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchantSettlement provides a mock function with given fields: ctx, settlementID
+func (_m *MockUpdateMerchantSettlementStatusRepository) GetMerchantSettlement(ctx context.Context, settlementID uint64) (entity.MerchantSettlement, error) {
+	ret := _m.Called(ctx, settlementID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchantSettlement")
+	}
+
+	var r0 entity.MerchantSettlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.MerchantSettlement, error)); ok {
+		return rf(ctx, settlementID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.MerchantSettlement); ok {
+		r0 = rf(ctx, settlementID)
+	} else {
+		r0 = ret.Get(0).(entity.MerchantSettlement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, settlementID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateMerchantSettlementStatusRepository_GetMerchantSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchantSettlement'
+type MockUpdateMerchantSettlementStatusRepository_GetMerchantSettlement_Call struct {
+	*mock.Call
+}
+
+// GetMerchantSettlement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settlementID uint64
+func (_e *MockUpdateMerchantSettlementStatusRepository_Expecter) GetMerchantSettlement(ctx interface{}, settlementID interface{}) *MockUpdateMerchantSettlementStatusRepository_GetMerchantSettlement_Call {
+	return &MockUpdateMerchantSettlementStatusRepository_GetMerchantSettlement_Call{Call: _e.mock.On("GetMerchantSettlement", ctx, settlementID)}
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_GetMerchantSettlement_Call) Run(run func(ctx context.Context, settlementID uint64)) *MockUpdateMerchantSettlementStatusRepository_GetMerchantSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_GetMerchantSettlement_Call) Return(_a0 entity.MerchantSettlement, _a1 error) *MockUpdateMerchantSettlementStatusRepository_GetMerchantSettlement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_GetMerchantSettlement_Call) RunAndReturn(run func(context.Context, uint64) (entity.MerchantSettlement, error)) *MockUpdateMerchantSettlementStatusRepository_GetMerchantSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMerchantSettlement provides a mock function with given fields: ctx, settlement, from
+func (_m *MockUpdateMerchantSettlementStatusRepository) UpdateMerchantSettlement(ctx context.Context, settlement entity.MerchantSettlement, from entity.SettlementStatus) (bool, error) {
+	ret := _m.Called(ctx, settlement, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMerchantSettlement")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchantSettlement, entity.SettlementStatus) (bool, error)); ok {
+		return rf(ctx, settlement, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchantSettlement, entity.SettlementStatus) bool); ok {
+		r0 = rf(ctx, settlement, from)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.MerchantSettlement, entity.SettlementStatus) error); ok {
+		r1 = rf(ctx, settlement, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateMerchantSettlementStatusRepository_UpdateMerchantSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMerchantSettlement'
+type MockUpdateMerchantSettlementStatusRepository_UpdateMerchantSettlement_Call struct {
+	*mock.Call
+}
+
+// UpdateMerchantSettlement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settlement entity.MerchantSettlement
+//   - from entity.SettlementStatus
+func (_e *MockUpdateMerchantSettlementStatusRepository_Expecter) UpdateMerchantSettlement(ctx interface{}, settlement interface{}, from interface{}) *MockUpdateMerchantSettlementStatusRepository_UpdateMerchantSettlement_Call {
+	return &MockUpdateMerchantSettlementStatusRepository_UpdateMerchantSettlement_Call{Call: _e.mock.On("UpdateMerchantSettlement", ctx, settlement, from)}
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_UpdateMerchantSettlement_Call) Run(run func(ctx context.Context, settlement entity.MerchantSettlement, from entity.SettlementStatus)) *MockUpdateMerchantSettlementStatusRepository_UpdateMerchantSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchantSettlement), args[2].(entity.SettlementStatus))
+	})
+	return _c
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_UpdateMerchantSettlement_Call) Return(_a0 bool, _a1 error) *MockUpdateMerchantSettlementStatusRepository_UpdateMerchantSettlement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateMerchantSettlementStatusRepository_UpdateMerchantSettlement_Call) RunAndReturn(run func(context.Context, entity.MerchantSettlement, entity.SettlementStatus) (bool, error)) *MockUpdateMerchantSettlementStatusRepository_UpdateMerchantSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateMerchantSettlementStatusRepository creates a new instance of MockUpdateMerchantSettlementStatusRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateMerchantSettlementStatusRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateMerchantSettlementStatusRepository {
+	mock := &MockUpdateMerchantSettlementStatusRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
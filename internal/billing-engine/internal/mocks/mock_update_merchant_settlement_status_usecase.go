@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUpdateMerchantSettlementStatusUsecase is an autogenerated mock type for the UpdateMerchantSettlementStatusUsecase type
+type MockUpdateMerchantSettlementStatusUsecase struct {
+	mock.Mock
+}
+
+type MockUpdateMerchantSettlementStatusUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateMerchantSettlementStatusUsecase) EXPECT() *MockUpdateMerchantSettlementStatusUsecase_Expecter {
+	return &MockUpdateMerchantSettlementStatusUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockUpdateMerchantSettlementStatusUsecase) Execute(ctx context.Context, input usecases.UpdateMerchantSettlementStatusInput) (usecases.MerchantSettlementOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.MerchantSettlementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.UpdateMerchantSettlementStatusInput) (usecases.MerchantSettlementOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.UpdateMerchantSettlementStatusInput) usecases.MerchantSettlementOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.MerchantSettlementOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.UpdateMerchantSettlementStatusInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateMerchantSettlementStatusUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockUpdateMerchantSettlementStatusUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.UpdateMerchantSettlementStatusInput
+func (_e *MockUpdateMerchantSettlementStatusUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockUpdateMerchantSettlementStatusUsecase_Execute_Call {
+	return &MockUpdateMerchantSettlementStatusUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockUpdateMerchantSettlementStatusUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.UpdateMerchantSettlementStatusInput)) *MockUpdateMerchantSettlementStatusUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.UpdateMerchantSettlementStatusInput))
+	})
+	return _c
+}
+
+func (_c *MockUpdateMerchantSettlementStatusUsecase_Execute_Call) Return(_a0 usecases.MerchantSettlementOutput, _a1 error) *MockUpdateMerchantSettlementStatusUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateMerchantSettlementStatusUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.UpdateMerchantSettlementStatusInput) (usecases.MerchantSettlementOutput, error)) *MockUpdateMerchantSettlementStatusUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateMerchantSettlementStatusUsecase creates a new instance of MockUpdateMerchantSettlementStatusUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateMerchantSettlementStatusUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateMerchantSettlementStatusUsecase {
+	mock := &MockUpdateMerchantSettlementStatusUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,592 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	entity "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
+	decimal "github.com/shopspring/decimal"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWriteOffLoanRepository is an autogenerated mock type for the WriteOffLoanRepository type
+type MockWriteOffLoanRepository struct {
+	mock.Mock
+}
+
+type MockWriteOffLoanRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWriteOffLoanRepository) EXPECT() *MockWriteOffLoanRepository_Expecter {
+	return &MockWriteOffLoanRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateInterestAccrual provides a mock function with given fields: ctx, accrual
+func (_m *MockWriteOffLoanRepository) CreateInterestAccrual(ctx context.Context, accrual entity.InterestAccrual) (entity.InterestAccrual, error) {
+	ret := _m.Called(ctx, accrual)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInterestAccrual")
+	}
+
+	var r0 entity.InterestAccrual
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.InterestAccrual) (entity.InterestAccrual, error)); ok {
+		return rf(ctx, accrual)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.InterestAccrual) entity.InterestAccrual); ok {
+		r0 = rf(ctx, accrual)
+	} else {
+		r0 = ret.Get(0).(entity.InterestAccrual)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.InterestAccrual) error); ok {
+		r1 = rf(ctx, accrual)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteOffLoanRepository_CreateInterestAccrual_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInterestAccrual'
+type MockWriteOffLoanRepository_CreateInterestAccrual_Call struct {
+	*mock.Call
+}
+
+// CreateInterestAccrual is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accrual entity.InterestAccrual
+func (_e *MockWriteOffLoanRepository_Expecter) CreateInterestAccrual(ctx interface{}, accrual interface{}) *MockWriteOffLoanRepository_CreateInterestAccrual_Call {
+	return &MockWriteOffLoanRepository_CreateInterestAccrual_Call{Call: _e.mock.On("CreateInterestAccrual", ctx, accrual)}
+}
+
+func (_c *MockWriteOffLoanRepository_CreateInterestAccrual_Call) Run(run func(ctx context.Context, accrual entity.InterestAccrual)) *MockWriteOffLoanRepository_CreateInterestAccrual_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.InterestAccrual))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_CreateInterestAccrual_Call) Return(_a0 entity.InterestAccrual, _a1 error) *MockWriteOffLoanRepository_CreateInterestAccrual_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_CreateInterestAccrual_Call) RunAndReturn(run func(context.Context, entity.InterestAccrual) (entity.InterestAccrual, error)) *MockWriteOffLoanRepository_CreateInterestAccrual_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJournalEntry provides a mock function with given fields: ctx, entry
+func (_m *MockWriteOffLoanRepository) CreateJournalEntry(ctx context.Context, entry entity.JournalEntry) (entity.JournalEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJournalEntry")
+	}
+
+	var r0 entity.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.JournalEntry) entity.JournalEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.JournalEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteOffLoanRepository_CreateJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournalEntry'
+type MockWriteOffLoanRepository_CreateJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.JournalEntry
+func (_e *MockWriteOffLoanRepository_Expecter) CreateJournalEntry(ctx interface{}, entry interface{}) *MockWriteOffLoanRepository_CreateJournalEntry_Call {
+	return &MockWriteOffLoanRepository_CreateJournalEntry_Call{Call: _e.mock.On("CreateJournalEntry", ctx, entry)}
+}
+
+func (_c *MockWriteOffLoanRepository_CreateJournalEntry_Call) Run(run func(ctx context.Context, entry entity.JournalEntry)) *MockWriteOffLoanRepository_CreateJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.JournalEntry))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_CreateJournalEntry_Call) Return(_a0 entity.JournalEntry, _a1 error) *MockWriteOffLoanRepository_CreateJournalEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_CreateJournalEntry_Call) RunAndReturn(run func(context.Context, entity.JournalEntry) (entity.JournalEntry, error)) *MockWriteOffLoanRepository_CreateJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWriteOff provides a mock function with given fields: ctx, writeOff
+func (_m *MockWriteOffLoanRepository) CreateWriteOff(ctx context.Context, writeOff entity.WriteOff) (entity.WriteOff, error) {
+	ret := _m.Called(ctx, writeOff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWriteOff")
+	}
+
+	var r0 entity.WriteOff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.WriteOff) (entity.WriteOff, error)); ok {
+		return rf(ctx, writeOff)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.WriteOff) entity.WriteOff); ok {
+		r0 = rf(ctx, writeOff)
+	} else {
+		r0 = ret.Get(0).(entity.WriteOff)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.WriteOff) error); ok {
+		r1 = rf(ctx, writeOff)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteOffLoanRepository_CreateWriteOff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWriteOff'
+type MockWriteOffLoanRepository_CreateWriteOff_Call struct {
+	*mock.Call
+}
+
+// CreateWriteOff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - writeOff entity.WriteOff
+func (_e *MockWriteOffLoanRepository_Expecter) CreateWriteOff(ctx interface{}, writeOff interface{}) *MockWriteOffLoanRepository_CreateWriteOff_Call {
+	return &MockWriteOffLoanRepository_CreateWriteOff_Call{Call: _e.mock.On("CreateWriteOff", ctx, writeOff)}
+}
+
+func (_c *MockWriteOffLoanRepository_CreateWriteOff_Call) Run(run func(ctx context.Context, writeOff entity.WriteOff)) *MockWriteOffLoanRepository_CreateWriteOff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.WriteOff))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_CreateWriteOff_Call) Return(_a0 entity.WriteOff, _a1 error) *MockWriteOffLoanRepository_CreateWriteOff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_CreateWriteOff_Call) RunAndReturn(run func(context.Context, entity.WriteOff) (entity.WriteOff, error)) *MockWriteOffLoanRepository_CreateWriteOff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllInstallments provides a mock function with given fields: ctx, loanID
+func (_m *MockWriteOffLoanRepository) GetAllInstallments(ctx context.Context, loanID uint64) ([]entity.Installment, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllInstallments")
+	}
+
+	var r0 []entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]entity.Installment, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []entity.Installment); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteOffLoanRepository_GetAllInstallments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllInstallments'
+type MockWriteOffLoanRepository_GetAllInstallments_Call struct {
+	*mock.Call
+}
+
+// GetAllInstallments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockWriteOffLoanRepository_Expecter) GetAllInstallments(ctx interface{}, loanID interface{}) *MockWriteOffLoanRepository_GetAllInstallments_Call {
+	return &MockWriteOffLoanRepository_GetAllInstallments_Call{Call: _e.mock.On("GetAllInstallments", ctx, loanID)}
+}
+
+func (_c *MockWriteOffLoanRepository_GetAllInstallments_Call) Run(run func(ctx context.Context, loanID uint64)) *MockWriteOffLoanRepository_GetAllInstallments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_GetAllInstallments_Call) Return(_a0 []entity.Installment, _a1 error) *MockWriteOffLoanRepository_GetAllInstallments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_GetAllInstallments_Call) RunAndReturn(run func(context.Context, uint64) ([]entity.Installment, error)) *MockWriteOffLoanRepository_GetAllInstallments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestClosedPeriod provides a mock function with given fields: ctx
+func (_m *MockWriteOffLoanRepository) GetLatestClosedPeriod(ctx context.Context) (entity.AccountingPeriod, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestClosedPeriod")
+	}
+
+	var r0 entity.AccountingPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.AccountingPeriod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.AccountingPeriod); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.AccountingPeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteOffLoanRepository_GetLatestClosedPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestClosedPeriod'
+type MockWriteOffLoanRepository_GetLatestClosedPeriod_Call struct {
+	*mock.Call
+}
+
+// GetLatestClosedPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWriteOffLoanRepository_Expecter) GetLatestClosedPeriod(ctx interface{}) *MockWriteOffLoanRepository_GetLatestClosedPeriod_Call {
+	return &MockWriteOffLoanRepository_GetLatestClosedPeriod_Call{Call: _e.mock.On("GetLatestClosedPeriod", ctx)}
+}
+
+func (_c *MockWriteOffLoanRepository_GetLatestClosedPeriod_Call) Run(run func(ctx context.Context)) *MockWriteOffLoanRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_GetLatestClosedPeriod_Call) Return(_a0 entity.AccountingPeriod, _a1 error) *MockWriteOffLoanRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_GetLatestClosedPeriod_Call) RunAndReturn(run func(context.Context) (entity.AccountingPeriod, error)) *MockWriteOffLoanRepository_GetLatestClosedPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoan provides a mock function with given fields: ctx, loanID
+func (_m *MockWriteOffLoanRepository) GetLoan(ctx context.Context, loanID uint64) (entity.Loan, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoan")
+	}
+
+	var r0 entity.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (entity.Loan, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) entity.Loan); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteOffLoanRepository_GetLoan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoan'
+type MockWriteOffLoanRepository_GetLoan_Call struct {
+	*mock.Call
+}
+
+// GetLoan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+func (_e *MockWriteOffLoanRepository_Expecter) GetLoan(ctx interface{}, loanID interface{}) *MockWriteOffLoanRepository_GetLoan_Call {
+	return &MockWriteOffLoanRepository_GetLoan_Call{Call: _e.mock.On("GetLoan", ctx, loanID)}
+}
+
+func (_c *MockWriteOffLoanRepository_GetLoan_Call) Run(run func(ctx context.Context, loanID uint64)) *MockWriteOffLoanRepository_GetLoan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_GetLoan_Call) Return(_a0 entity.Loan, _a1 error) *MockWriteOffLoanRepository_GetLoan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_GetLoan_Call) RunAndReturn(run func(context.Context, uint64) (entity.Loan, error)) *MockWriteOffLoanRepository_GetLoan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanAccountBalance provides a mock function with given fields: ctx, loanID, accountCode
+func (_m *MockWriteOffLoanRepository) GetLoanAccountBalance(ctx context.Context, loanID uint64, accountCode string) (decimal.Decimal, error) {
+	ret := _m.Called(ctx, loanID, accountCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanAccountBalance")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (decimal.Decimal, error)); ok {
+		return rf(ctx, loanID, accountCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) decimal.Decimal); ok {
+		r0 = rf(ctx, loanID, accountCode)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, loanID, accountCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteOffLoanRepository_GetLoanAccountBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanAccountBalance'
+type MockWriteOffLoanRepository_GetLoanAccountBalance_Call struct {
+	*mock.Call
+}
+
+// GetLoanAccountBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - accountCode string
+func (_e *MockWriteOffLoanRepository_Expecter) GetLoanAccountBalance(ctx interface{}, loanID interface{}, accountCode interface{}) *MockWriteOffLoanRepository_GetLoanAccountBalance_Call {
+	return &MockWriteOffLoanRepository_GetLoanAccountBalance_Call{Call: _e.mock.On("GetLoanAccountBalance", ctx, loanID, accountCode)}
+}
+
+func (_c *MockWriteOffLoanRepository_GetLoanAccountBalance_Call) Run(run func(ctx context.Context, loanID uint64, accountCode string)) *MockWriteOffLoanRepository_GetLoanAccountBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_GetLoanAccountBalance_Call) Return(_a0 decimal.Decimal, _a1 error) *MockWriteOffLoanRepository_GetLoanAccountBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_GetLoanAccountBalance_Call) RunAndReturn(run func(context.Context, uint64, string) (decimal.Decimal, error)) *MockWriteOffLoanRepository_GetLoanAccountBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOpenInstallmentsStatus provides a mock function with given fields: ctx, loanID, status
+func (_m *MockWriteOffLoanRepository) SetOpenInstallmentsStatus(ctx context.Context, loanID uint64, status entity.InstallmentStatus) (int64, error) {
+	ret := _m.Called(ctx, loanID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOpenInstallmentsStatus")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.InstallmentStatus) (int64, error)); ok {
+		return rf(ctx, loanID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entity.InstallmentStatus) int64); ok {
+		r0 = rf(ctx, loanID, status)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entity.InstallmentStatus) error); ok {
+		r1 = rf(ctx, loanID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteOffLoanRepository_SetOpenInstallmentsStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOpenInstallmentsStatus'
+type MockWriteOffLoanRepository_SetOpenInstallmentsStatus_Call struct {
+	*mock.Call
+}
+
+// SetOpenInstallmentsStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID uint64
+//   - status entity.InstallmentStatus
+func (_e *MockWriteOffLoanRepository_Expecter) SetOpenInstallmentsStatus(ctx interface{}, loanID interface{}, status interface{}) *MockWriteOffLoanRepository_SetOpenInstallmentsStatus_Call {
+	return &MockWriteOffLoanRepository_SetOpenInstallmentsStatus_Call{Call: _e.mock.On("SetOpenInstallmentsStatus", ctx, loanID, status)}
+}
+
+func (_c *MockWriteOffLoanRepository_SetOpenInstallmentsStatus_Call) Run(run func(ctx context.Context, loanID uint64, status entity.InstallmentStatus)) *MockWriteOffLoanRepository_SetOpenInstallmentsStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entity.InstallmentStatus))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_SetOpenInstallmentsStatus_Call) Return(_a0 int64, _a1 error) *MockWriteOffLoanRepository_SetOpenInstallmentsStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_SetOpenInstallmentsStatus_Call) RunAndReturn(run func(context.Context, uint64, entity.InstallmentStatus) (int64, error)) *MockWriteOffLoanRepository_SetOpenInstallmentsStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionLoanStatus provides a mock function with given fields: ctx, transition
+func (_m *MockWriteOffLoanRepository) TransitionLoanStatus(ctx context.Context, transition entity.LoanStatusTransition) error {
+	ret := _m.Called(ctx, transition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionLoanStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoanStatusTransition) error); ok {
+		r0 = rf(ctx, transition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWriteOffLoanRepository_TransitionLoanStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionLoanStatus'
+type MockWriteOffLoanRepository_TransitionLoanStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionLoanStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transition entity.LoanStatusTransition
+func (_e *MockWriteOffLoanRepository_Expecter) TransitionLoanStatus(ctx interface{}, transition interface{}) *MockWriteOffLoanRepository_TransitionLoanStatus_Call {
+	return &MockWriteOffLoanRepository_TransitionLoanStatus_Call{Call: _e.mock.On("TransitionLoanStatus", ctx, transition)}
+}
+
+func (_c *MockWriteOffLoanRepository_TransitionLoanStatus_Call) Run(run func(ctx context.Context, transition entity.LoanStatusTransition)) *MockWriteOffLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.LoanStatusTransition))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_TransitionLoanStatus_Call) Return(_a0 error) *MockWriteOffLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_TransitionLoanStatus_Call) RunAndReturn(run func(context.Context, entity.LoanStatusTransition) error) *MockWriteOffLoanRepository_TransitionLoanStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockWriteOffLoanRepository) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWriteOffLoanRepository_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type MockWriteOffLoanRepository_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockWriteOffLoanRepository_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *MockWriteOffLoanRepository_WithinTransaction_Call {
+	return &MockWriteOffLoanRepository_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *MockWriteOffLoanRepository_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockWriteOffLoanRepository_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_WithinTransaction_Call) Return(_a0 error) *MockWriteOffLoanRepository_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWriteOffLoanRepository_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockWriteOffLoanRepository_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWriteOffLoanRepository creates a new instance of MockWriteOffLoanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWriteOffLoanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWriteOffLoanRepository {
+	mock := &MockWriteOffLoanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
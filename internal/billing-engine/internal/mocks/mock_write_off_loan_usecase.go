@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package billingenginemocks
+
+import (
+	context "context"
+
+	usecases "github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/usecases"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWriteOffLoanUsecase is an autogenerated mock type for the WriteOffLoanUsecase type
+type MockWriteOffLoanUsecase struct {
+	mock.Mock
+}
+
+type MockWriteOffLoanUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWriteOffLoanUsecase) EXPECT() *MockWriteOffLoanUsecase_Expecter {
+	return &MockWriteOffLoanUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *MockWriteOffLoanUsecase) Execute(ctx context.Context, input usecases.WriteOffLoanInput) (usecases.WriteOffLoanOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 usecases.WriteOffLoanOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.WriteOffLoanInput) (usecases.WriteOffLoanOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.WriteOffLoanInput) usecases.WriteOffLoanOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(usecases.WriteOffLoanOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.WriteOffLoanInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteOffLoanUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockWriteOffLoanUsecase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecases.WriteOffLoanInput
+func (_e *MockWriteOffLoanUsecase_Expecter) Execute(ctx interface{}, input interface{}) *MockWriteOffLoanUsecase_Execute_Call {
+	return &MockWriteOffLoanUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockWriteOffLoanUsecase_Execute_Call) Run(run func(ctx context.Context, input usecases.WriteOffLoanInput)) *MockWriteOffLoanUsecase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.WriteOffLoanInput))
+	})
+	return _c
+}
+
+func (_c *MockWriteOffLoanUsecase_Execute_Call) Return(_a0 usecases.WriteOffLoanOutput, _a1 error) *MockWriteOffLoanUsecase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteOffLoanUsecase_Execute_Call) RunAndReturn(run func(context.Context, usecases.WriteOffLoanInput) (usecases.WriteOffLoanOutput, error)) *MockWriteOffLoanUsecase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWriteOffLoanUsecase creates a new instance of MockWriteOffLoanUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWriteOffLoanUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWriteOffLoanUsecase {
+	mock := &MockWriteOffLoanUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,25 @@
+package usecases
+
+import "context"
+
+type (
+	AddLoanPartyUsecase interface {
+		Execute(ctx context.Context, input AddLoanPartyInput) (LoanPartyOutput, error)
+	}
+
+	AddLoanPartyInput struct {
+		LoanID     uint64 `json:"loan_id" validate:"required"`
+		CustomerID uint64 `json:"customer_id" validate:"required"` // anyone but the borrower
+		Role       string `json:"role" validate:"required,oneof=CO_BORROWER GUARANTOR"`
+		AddedBy    string `json:"added_by" validate:"required,max=100"`
+	}
+
+	LoanPartyOutput struct {
+		ID         uint64 `json:"id"`
+		LoanID     uint64 `json:"loan_id"`
+		CustomerID uint64 `json:"customer_id"`
+		Role       string `json:"role"`
+		AddedBy    string `json:"added_by"`
+		AddedAt    string `json:"added_at"` // format RFC3339
+	}
+)
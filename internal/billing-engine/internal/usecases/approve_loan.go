@@ -0,0 +1,15 @@
+package usecases
+
+import "context"
+
+type (
+	ApproveLoanUsecase interface {
+		Execute(ctx context.Context, input ApproveLoanInput) (LoanOutput, error)
+	}
+
+	ApproveLoanInput struct {
+		LoanID     uint64 `json:"loan_id" validate:"required"`
+		ApprovedBy string `json:"approved_by" validate:"required,max=100"`
+		Note       string `json:"note" validate:"max=500"` // optional
+	}
+)
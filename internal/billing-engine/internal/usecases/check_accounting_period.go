@@ -0,0 +1,25 @@
+package usecases
+
+import "context"
+
+type (
+	CheckAccountingPeriodUsecase interface {
+		Execute(ctx context.Context, input CheckAccountingPeriodInput) (CheckAccountingPeriodOutput, error)
+	}
+
+	CheckAccountingPeriodInput struct {
+		Month string `json:"month" validate:"required,datetime=2006-01"` // format YYYY-MM
+	}
+
+	CheckAccountingPeriodOutput struct {
+		Month  string              `json:"month"`
+		Passed bool                `json:"passed"`
+		Checks []PeriodCheckOutput `json:"checks"`
+	}
+
+	PeriodCheckOutput struct {
+		Name   string `json:"name"`
+		Passed bool   `json:"passed"`
+		Detail string `json:"detail"`
+	}
+)
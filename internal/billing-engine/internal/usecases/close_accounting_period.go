@@ -0,0 +1,19 @@
+package usecases
+
+import "context"
+
+type (
+	CloseAccountingPeriodUsecase interface {
+		Execute(ctx context.Context, input CloseAccountingPeriodInput) (CloseAccountingPeriodOutput, error)
+	}
+
+	CloseAccountingPeriodInput struct {
+		Month    string `json:"month" validate:"required,datetime=2006-01"` // format YYYY-MM
+		ClosedBy string `json:"closed_by" validate:"required,max=100"`
+	}
+
+	CloseAccountingPeriodOutput struct {
+		Period AccountingPeriodOutput `json:"period"`
+		Checks []PeriodCheckOutput    `json:"checks"`
+	}
+)
@@ -0,0 +1,16 @@
+package usecases
+
+import "context"
+
+type (
+	ConfirmDisbursementUsecase interface {
+		Execute(ctx context.Context, input ConfirmDisbursementInput) (DisbursementOutput, error)
+	}
+
+	ConfirmDisbursementInput struct {
+		DisbursementID uint64 `json:"disbursement_id" validate:"required"`
+		Status         string `json:"status" validate:"required,oneof=COMPLETED FAILED"`
+		FailureReason  string `json:"failure_reason" validate:"required_if=Status FAILED,max=500"`
+		EffectiveDate  string `json:"effective_date"` // format YYYY-MM-DD, defaults to today
+	}
+)
@@ -0,0 +1,21 @@
+package usecases
+
+import "context"
+
+type (
+	CreateCollectionAgentUsecase interface {
+		Execute(ctx context.Context, input CreateCollectionAgentInput) (CollectionAgentOutput, error)
+	}
+
+	CreateCollectionAgentInput struct {
+		Name   string `json:"name" validate:"required"`
+		Bucket string `json:"bucket" validate:"required,oneof=DPD_1_30 DPD_31_60 DPD_61_90 DPD_90_UP ANY"`
+	}
+
+	CollectionAgentOutput struct {
+		ID     uint64 `json:"id"`
+		Name   string `json:"name"`
+		Bucket string `json:"bucket"`
+		Active bool   `json:"active"`
+	}
+)
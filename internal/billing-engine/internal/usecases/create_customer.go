@@ -8,13 +8,28 @@ type (
 	}
 
 	CreateCustomerInput struct {
-		Name  string `json:"name" validate:"required"`
-		Email string `json:"email" validate:"required,email"`
+		Name        string `json:"name" validate:"required"`
+		Email       string `json:"email" validate:"required,email"`
+		Phone       string `json:"phone" validate:"omitempty,e164"`
+		Language    string `json:"language" validate:"omitempty,oneof=id en"` // defaults to id
+		NIK         string `json:"nik" validate:"omitempty,len=16,numeric"`
+		DateOfBirth string `json:"date_of_birth" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD
+		Address     string `json:"address" validate:"omitempty,max=500"`
 	}
 
 	CreateCustomerOutput struct {
-		ID    uint64 `json:"id"`
-		Name  string `json:"name"`
-		Email string `json:"email"`
+		ID          uint64 `json:"id"`
+		Name        string `json:"name"`
+		Email       string `json:"email"`
+		Phone       string `json:"phone,omitempty"`
+		Language    string `json:"language,omitempty"`
+		NIK         string `json:"nik,omitempty"`
+		DateOfBirth string `json:"date_of_birth,omitempty"`
+		Address     string `json:"address,omitempty"`
+		KYCStatus   string `json:"kyc_status,omitempty"`
+
+		// Duplicates are the existing customers this one matched, flagged for
+		// review
+		Duplicates []CustomerDuplicateOutput `json:"duplicates,omitempty"`
 	}
 )
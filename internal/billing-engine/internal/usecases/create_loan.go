@@ -6,16 +6,33 @@ import (
 
 type (
 	CreateLoanUsecase interface {
-		Execute(ctx context.Context, customerID uint64) (CreateLoanOutput, error)
+		Execute(ctx context.Context, input CreateLoanInput) (LoanOutput, error)
 	}
 
-	CreateLoanOutput struct {
+	CreateLoanInput struct {
+		CustomerID      uint64 `json:"customer_id" validate:"required"`
+		RequestedBy     string `json:"requested_by" validate:"required,max=100"`
+		ProductCode     string `json:"product_code" validate:"omitempty,max=50"`      // STANDARD when empty
+		PrincipalAmount string `json:"principal_amount" validate:"omitempty,numeric"` // 5000000 when empty
+		TermWeeks       int64  `json:"term_weeks" validate:"omitempty,min=1,max=520"` // 50 when empty
+		MerchantID      uint64 `json:"merchant_id"`                                   // the merchant whose checkout sold the loan
+		OrderRef        string `json:"order_ref" validate:"required_with=MerchantID,max=100"`
+	}
+
+	LoanOutput struct {
 		ID              uint64 `json:"id"`
 		CustomerID      uint64 `json:"customer_id"`
 		PrincipalAmount string `json:"principal_amount"`
 		InterestRate    string `json:"interest_rate"`
 		TermWeeks       int64  `json:"term_weeks"`
-		StartDate       string `json:"start_date"` // format RFC3339
+		StartDate       string `json:"start_date"` // format RFC3339, empty until disbursed
 		Status          string `json:"status"`
+		RequestedBy     string `json:"requested_by"`
+		ProductCode     string `json:"product_code"`
+		CreditLineID    uint64 `json:"credit_line_id,omitempty"` // the credit line of a drawdown
+		MerchantID      uint64 `json:"merchant_id,omitempty"`
+		OrderRef        string `json:"order_ref,omitempty"`
+
+		Disclosure *LoanDisclosureOutput `json:"disclosure,omitempty"` // the terms disclosed when applying
 	}
 )
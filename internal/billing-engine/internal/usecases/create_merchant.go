@@ -0,0 +1,31 @@
+package usecases
+
+import "context"
+
+type (
+	CreateMerchantUsecase interface {
+		Execute(ctx context.Context, input CreateMerchantInput) (MerchantOutput, error)
+	}
+
+	CreateMerchantInput struct {
+		Code          string `json:"code" validate:"required,max=50"`
+		Name          string `json:"name" validate:"required,max=100"`
+		MDRRate       string `json:"mdr_rate" validate:"required,numeric"` // fraction of the sale kept, 0.025 for 2.5%
+		BankCode      string `json:"bank_code" validate:"required,max=20"`
+		AccountNumber string `json:"account_number" validate:"required,numeric,max=34"`
+		AccountName   string `json:"account_name" validate:"required,max=100"`
+		CreatedBy     string `json:"created_by" validate:"required,max=100"`
+	}
+
+	MerchantOutput struct {
+		ID            uint64 `json:"id"`
+		Code          string `json:"code"`
+		Name          string `json:"name"`
+		MDRRate       string `json:"mdr_rate"`
+		BankCode      string `json:"bank_code"`
+		AccountNumber string `json:"account_number"`
+		AccountName   string `json:"account_name"`
+		CreatedBy     string `json:"created_by"`
+		CreatedAt     string `json:"created_at"` // format RFC3339
+	}
+)
@@ -0,0 +1,50 @@
+package usecases
+
+import "context"
+
+type (
+	DeclareMoratoriumUsecase interface {
+		Execute(ctx context.Context, input DeclareMoratoriumInput) (MoratoriumOutput, error)
+	}
+
+	DeclareMoratoriumInput struct {
+		Scope            string `json:"scope" validate:"required,oneof=LOAN SEGMENT"`
+		LoanID           uint64 `json:"loan_id" validate:"required_if=Scope LOAN"`
+		SegmentStartFrom string `json:"segment_start_from" validate:"omitempty,datetime=2006-01-02"` // loans started on or after, format YYYY-MM-DD
+		SegmentStartTo   string `json:"segment_start_to" validate:"omitempty,datetime=2006-01-02"`   // loans started on or before, format YYYY-MM-DD
+		StartDate        string `json:"start_date" validate:"required,datetime=2006-01-02"`
+		EndDate          string `json:"end_date" validate:"required,datetime=2006-01-02"`
+		AccrueInterest   bool   `json:"accrue_interest"`
+		Reason           string `json:"reason" validate:"required,max=500"`
+	}
+
+	MoratoriumOutput struct {
+		ID                   uint64                        `json:"id"`
+		Scope                string                        `json:"scope"`
+		LoanID               uint64                        `json:"loan_id,omitempty"`
+		SegmentStartFrom     string                        `json:"segment_start_from,omitempty"`
+		SegmentStartTo       string                        `json:"segment_start_to,omitempty"`
+		StartDate            string                        `json:"start_date"`
+		EndDate              string                        `json:"end_date"`
+		AccrueInterest       bool                          `json:"accrue_interest"`
+		Reason               string                        `json:"reason"`
+		ShiftWeeks           int64                         `json:"shift_weeks"`
+		AffectedLoans        int64                         `json:"affected_loans"`
+		AffectedInstallments int64                         `json:"affected_installments"`
+		CreatedAt            string                        `json:"created_at"` // format RFC3339
+		Installments         []MoratoriumInstallmentOutput `json:"installments"`
+		SkippedLoanIDs       []uint64                      `json:"skipped_loan_ids,omitempty"` // segment loans already under an overlapping moratorium
+	}
+
+	MoratoriumInstallmentOutput struct {
+		InstallmentID   uint64 `json:"installment_id"`
+		LoanID          uint64 `json:"loan_id"`
+		WeekNumber      int64  `json:"week_number"`
+		PreviousStatus  string `json:"previous_status"`
+		OriginalDueDate string `json:"original_due_date"`
+		NewDueDate      string `json:"new_due_date"`
+		OriginalAmount  string `json:"original_amount"`
+		NewAmount       string `json:"new_amount"`
+		AccruedInterest string `json:"accrued_interest"`
+	}
+)
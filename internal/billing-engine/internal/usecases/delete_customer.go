@@ -0,0 +1,14 @@
+package usecases
+
+import "context"
+
+type (
+	DeleteCustomerUsecase interface {
+		Execute(ctx context.Context, customerID uint64) (DeleteCustomerOutput, error)
+	}
+
+	DeleteCustomerOutput struct {
+		ID        uint64 `json:"id"`
+		DeletedAt string `json:"deleted_at"`
+	}
+)
@@ -0,0 +1,35 @@
+package usecases
+
+import "context"
+
+type (
+	DisburseLoanUsecase interface {
+		Execute(ctx context.Context, input DisburseLoanInput) (DisbursementOutput, error)
+	}
+
+	DisburseLoanInput struct {
+		LoanID        uint64 `json:"loan_id" validate:"required"`
+		DisbursedBy   string `json:"disbursed_by" validate:"required,max=100"`
+		BankCode      string `json:"bank_code" validate:"omitempty,max=20"` // the borrower's account, merchant loans are paid to the merchant
+		AccountNumber string `json:"account_number" validate:"omitempty,numeric,max=34"`
+		AccountName   string `json:"account_name" validate:"omitempty,max=100"`
+	}
+
+	DisbursementOutput struct {
+		ID                uint64 `json:"id"`
+		LoanID            uint64 `json:"loan_id"`
+		CustomerID        uint64 `json:"customer_id"`
+		BankCode          string `json:"bank_code"`
+		AccountNumber     string `json:"account_number"`
+		AccountName       string `json:"account_name"`
+		Amount            string `json:"amount"`
+		FeeAmount         string `json:"fee_amount"`
+		Status            string `json:"status"`
+		ProviderReference string `json:"provider_reference"`
+		FailureReason     string `json:"failure_reason"`
+		Attempts          int64  `json:"attempts"`
+		RequestedBy       string `json:"requested_by"`
+		CreatedAt         string `json:"created_at"`   // format RFC3339
+		CompletedAt       string `json:"completed_at"` // format RFC3339, empty until completed
+	}
+)
@@ -0,0 +1,21 @@
+package usecases
+
+import "context"
+
+type (
+	DismissCustomerDuplicateUsecase interface {
+		Execute(ctx context.Context, input DismissCustomerDuplicateInput) (DismissCustomerDuplicateOutput, error)
+	}
+
+	DismissCustomerDuplicateInput struct {
+		DuplicateID uint64 `json:"duplicate_id" validate:"required"`
+		DismissedBy string `json:"dismissed_by" validate:"required,max=100"`
+	}
+
+	DismissCustomerDuplicateOutput struct {
+		ID          uint64 `json:"id"`
+		Status      string `json:"status"`
+		DismissedBy string `json:"dismissed_by"`
+		DismissedAt string `json:"dismissed_at"`
+	}
+)
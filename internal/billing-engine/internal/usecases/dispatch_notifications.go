@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	DispatchNotificationsUsecase interface {
+		Execute(ctx context.Context, input DispatchNotificationsInput) (DispatchNotificationsOutput, error)
+	}
+
+	DispatchNotificationsInput struct {
+		AsOf string `json:"as_of" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today, never in the future
+	}
+
+	DispatchNotificationsOutput struct {
+		AsOf           string                `json:"as_of"`
+		Sent           int                   `json:"sent"`
+		Failed         int                   `json:"failed"`
+		Communications []CommunicationOutput `json:"communications"`
+	}
+
+	CommunicationOutput struct {
+		ID                uint64    `json:"id"`
+		LoanID            uint64    `json:"loan_id"`
+		CustomerID        uint64    `json:"customer_id"`
+		InstallmentID     uint64    `json:"installment_id,omitempty"`
+		Event             string    `json:"event"`
+		Channel           string    `json:"channel"`
+		Language          string    `json:"language"`
+		Recipient         string    `json:"recipient"`
+		Subject           string    `json:"subject"`
+		Body              string    `json:"body"`
+		Status            string    `json:"status"`
+		ProviderMessageID string    `json:"provider_message_id,omitempty"`
+		ErrorMessage      string    `json:"error_message,omitempty"`
+		SentAt            time.Time `json:"sent_at"`
+	}
+)
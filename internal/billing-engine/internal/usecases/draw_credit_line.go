@@ -0,0 +1,18 @@
+package usecases
+
+import "context"
+
+type (
+	DrawCreditLineUsecase interface {
+		Execute(ctx context.Context, input DrawCreditLineInput) (LoanOutput, error)
+	}
+
+	DrawCreditLineInput struct {
+		CreditLineID uint64 `json:"credit_line_id" validate:"required"`
+		Amount       string `json:"amount" validate:"required,numeric"`
+		TermWeeks    int64  `json:"term_weeks" validate:"required,min=1,max=520"`
+		RequestedBy  string `json:"requested_by" validate:"required,max=100"`
+		MerchantID   uint64 `json:"merchant_id"` // the merchant whose checkout the drawdown pays
+		OrderRef     string `json:"order_ref" validate:"required_with=MerchantID,max=100"`
+	}
+)
@@ -0,0 +1,19 @@
+package usecases
+
+import "context"
+
+type (
+	EvaluatePromisesToPayUsecase interface {
+		Execute(ctx context.Context, input EvaluatePromisesToPayInput) (EvaluatePromisesToPayOutput, error)
+	}
+
+	EvaluatePromisesToPayInput struct {
+		AsOf string `json:"as_of" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today
+	}
+
+	EvaluatePromisesToPayOutput struct {
+		AsOf   string               `json:"as_of"`
+		Kept   []PromiseToPayOutput `json:"kept"`
+		Broken []PromiseToPayOutput `json:"broken"`
+	}
+)
@@ -0,0 +1,25 @@
+package usecases
+
+import "context"
+
+type (
+	ExportGLJournalUsecase interface {
+		Execute(ctx context.Context, input ExportGLJournalInput) (ExportGLJournalOutput, error)
+	}
+
+	ExportGLJournalInput struct {
+		Date   string `json:"date" validate:"required,datetime=2006-01-02"` // format YYYY-MM-DD
+		Format string `json:"format" validate:"omitempty,oneof=csv json"`   // defaults to json
+	}
+
+	ExportGLJournalOutput struct {
+		Date         string `json:"date"`
+		Format       string `json:"format"`
+		FileName     string `json:"file_name"`
+		ContentType  string `json:"content_type"`
+		Content      []byte `json:"-"`
+		Batches      int    `json:"batches"`
+		LineCount    int    `json:"line_count"`
+		ControlTotal string `json:"control_total"`
+	}
+)
@@ -0,0 +1,22 @@
+package usecases
+
+import "context"
+
+type (
+	ExportMerchantSettlementsUsecase interface {
+		Execute(ctx context.Context, input ExportMerchantSettlementsInput) (ExportMerchantSettlementsOutput, error)
+	}
+
+	ExportMerchantSettlementsInput struct {
+		Date string `json:"date" validate:"required,datetime=2006-01-02"` // format YYYY-MM-DD
+	}
+
+	ExportMerchantSettlementsOutput struct {
+		Date            string `json:"date"`
+		FileName        string `json:"file_name"`
+		ContentType     string `json:"content_type"`
+		Content         []byte `json:"-"`
+		SettlementCount int    `json:"settlement_count"`
+		ControlTotal    string `json:"control_total"` // sum of the net amounts
+	}
+)
@@ -0,0 +1,33 @@
+package usecases
+
+import "context"
+
+type (
+	GenerateCollectionCasesUsecase interface {
+		Execute(ctx context.Context, input GenerateCollectionCasesInput) (GenerateCollectionCasesOutput, error)
+	}
+
+	GenerateCollectionCasesInput struct {
+		Strategy string `json:"strategy" validate:"required,oneof=ROUND_ROBIN BY_BUCKET"`
+		AsOf     string `json:"as_of" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today, never in the future
+	}
+
+	GenerateCollectionCasesOutput struct {
+		AsOf       string                 `json:"as_of"`
+		Strategy   string                 `json:"strategy"`
+		Created    []CollectionCaseOutput `json:"created"`
+		Unassigned []uint64               `json:"unassigned_loan_ids,omitempty"`
+	}
+
+	CollectionCaseOutput struct {
+		ID                 uint64 `json:"id"`
+		LoanID             uint64 `json:"loan_id"`
+		CustomerID         uint64 `json:"customer_id"`
+		AgentID            uint64 `json:"agent_id"`
+		Bucket             string `json:"bucket"`
+		DaysPastDue        int64  `json:"days_past_due"`
+		MissedInstallments int64  `json:"missed_installments"`
+		Status             string `json:"status"`
+		OpenedAt           string `json:"opened_at"` // format RFC3339
+	}
+)
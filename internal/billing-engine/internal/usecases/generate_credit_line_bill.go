@@ -0,0 +1,35 @@
+package usecases
+
+import "context"
+
+type (
+	GenerateCreditLineBillUsecase interface {
+		Execute(ctx context.Context, input GenerateCreditLineBillInput) (CreditLineBillOutput, error)
+	}
+
+	GenerateCreditLineBillInput struct {
+		CreditLineID uint64 `json:"credit_line_id" validate:"required"`
+		Cycle        string `json:"cycle" validate:"required,datetime=2006-01"` // format YYYY-MM
+	}
+
+	CreditLineBillOutput struct {
+		ID           uint64                     `json:"id"`
+		CreditLineID uint64                     `json:"credit_line_id"`
+		Cycle        string                     `json:"cycle"`    // format YYYY-MM
+		DueDate      string                     `json:"due_date"` // format YYYY-MM-DD
+		Amount       string                     `json:"amount"`
+		Outstanding  string                     `json:"outstanding"`
+		Status       string                     `json:"status"`
+		CreatedAt    string                     `json:"created_at"`        // format RFC3339
+		PaidAt       string                     `json:"paid_at,omitempty"` // format RFC3339
+		Items        []CreditLineBillItemOutput `json:"items"`
+	}
+
+	CreditLineBillItemOutput struct {
+		InstallmentID uint64 `json:"installment_id"`
+		LoanID        uint64 `json:"loan_id"`
+		WeekNumber    int64  `json:"week_number"`
+		Amount        string `json:"amount"`
+		Status        string `json:"status"` // current status of the installment
+	}
+)
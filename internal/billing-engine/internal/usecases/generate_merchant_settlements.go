@@ -0,0 +1,46 @@
+package usecases
+
+import "context"
+
+type (
+	GenerateMerchantSettlementsUsecase interface {
+		Execute(ctx context.Context, input GenerateMerchantSettlementsInput) (GenerateMerchantSettlementsOutput, error)
+	}
+
+	GenerateMerchantSettlementsInput struct {
+		SettlementDate string `json:"settlement_date" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today
+		GeneratedBy    string `json:"generated_by" validate:"required,max=100"`
+	}
+
+	GenerateMerchantSettlementsOutput struct {
+		SettlementDate string                     `json:"settlement_date"`
+		Settlements    []MerchantSettlementOutput `json:"settlements"`
+	}
+
+	MerchantSettlementOutput struct {
+		ID             uint64                         `json:"id"`
+		MerchantID     uint64                         `json:"merchant_id"`
+		SettlementDate string                         `json:"settlement_date"` // format YYYY-MM-DD
+		GrossAmount    string                         `json:"gross_amount"`
+		MDRAmount      string                         `json:"mdr_amount"`
+		NetAmount      string                         `json:"net_amount"` // owed to the merchant
+		ItemCount      int64                          `json:"item_count"`
+		Status         string                         `json:"status"`
+		Reference      string                         `json:"reference"`
+		FailureReason  string                         `json:"failure_reason"`
+		UpdatedBy      string                         `json:"updated_by"`
+		CreatedAt      string                         `json:"created_at"`           // format RFC3339
+		UpdatedAt      string                         `json:"updated_at"`           // format RFC3339
+		SettledAt      string                         `json:"settled_at,omitempty"` // format RFC3339
+		Items          []MerchantSettlementItemOutput `json:"items"`
+	}
+
+	MerchantSettlementItemOutput struct {
+		LoanID      uint64 `json:"loan_id"`
+		OrderRef    string `json:"order_ref"`
+		SaleDate    string `json:"sale_date"` // format YYYY-MM-DD
+		GrossAmount string `json:"gross_amount"`
+		MDRAmount   string `json:"mdr_amount"`
+		NetAmount   string `json:"net_amount"`
+	}
+)
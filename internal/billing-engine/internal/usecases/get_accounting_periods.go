@@ -0,0 +1,21 @@
+package usecases
+
+import "context"
+
+type (
+	GetAccountingPeriodsUsecase interface {
+		Execute(ctx context.Context) (GetAccountingPeriodsOutput, error)
+	}
+
+	GetAccountingPeriodsOutput struct {
+		LockedThrough string                   `json:"locked_through"` // format YYYY-MM, empty when no period is closed
+		Periods       []AccountingPeriodOutput `json:"periods"`
+	}
+
+	AccountingPeriodOutput struct {
+		Period   string `json:"period"` // format YYYY-MM
+		Status   string `json:"status"`
+		ClosedBy string `json:"closed_by"`
+		ClosedAt string `json:"closed_at"` // format RFC3339
+	}
+)
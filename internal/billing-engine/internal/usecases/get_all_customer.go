@@ -4,16 +4,28 @@ import "context"
 
 type (
 	GetAllCustomerUsecase interface {
-		Execute(ctx context.Context) (GetAllCustomerOutput, error)
+		Execute(ctx context.Context, input GetAllCustomerInput) (GetAllCustomerOutput, error)
+	}
+
+	GetAllCustomerInput struct {
+		Cursor        string `json:"cursor"`                                   // next_cursor of the previous page, first page when empty
+		Limit         int64  `json:"limit" validate:"omitempty,min=1,max=200"` // 50 when empty
+		Name          string `json:"name" validate:"omitempty,max=255"`        // name prefix, case insensitive
+		Email         string `json:"email" validate:"omitempty,email"`         // case insensitive
+		KYCStatus     string `json:"kyc_status" validate:"omitempty,oneof=UNVERIFIED PENDING VERIFIED REJECTED"`
+		HasActiveLoan string `json:"has_active_loan" validate:"omitempty,oneof=true false"` // an applied, approved or disbursed loan
+		Sort          string `json:"sort" validate:"omitempty,oneof=id -id name -name"`     // id when empty, - for descending
 	}
 
 	GetAllCustomerOutput struct {
-		Customers []CustomerOutput `json:"customers"`
+		Customers  []CustomerOutput `json:"customers"`
+		NextCursor string           `json:"next_cursor,omitempty"` // empty on the last page
 	}
 
 	CustomerOutput struct {
-		ID    uint64 `json:"id"`
-		Name  string `json:"name"`
-		Email string `json:"email"`
+		ID        uint64 `json:"id"`
+		Name      string `json:"name"`
+		Email     string `json:"email"`
+		KYCStatus string `json:"kyc_status,omitempty"`
 	}
 )
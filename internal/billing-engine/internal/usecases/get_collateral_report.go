@@ -0,0 +1,25 @@
+package usecases
+
+import "context"
+
+type (
+	GetCollateralReportUsecase interface {
+		Execute(ctx context.Context) (CollateralReportOutput, error)
+	}
+
+	CollateralReportOutput struct {
+		Exposure    string                     `json:"exposure"`
+		Valuation   string                     `json:"valuation"`
+		LoanToValue string                     `json:"loan_to_value"` // of the whole secured book
+		Loans       []CollateralisedLoanOutput `json:"loans"`         // highest loan-to-value first
+	}
+
+	CollateralisedLoanOutput struct {
+		LoanID      uint64 `json:"loan_id"`
+		CustomerID  uint64 `json:"customer_id"`
+		LoanStatus  string `json:"loan_status"`
+		Exposure    string `json:"exposure"`
+		Valuation   string `json:"valuation"`
+		LoanToValue string `json:"loan_to_value"`
+	}
+)
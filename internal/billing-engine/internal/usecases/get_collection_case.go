@@ -0,0 +1,15 @@
+package usecases
+
+import "context"
+
+type (
+	GetCollectionCaseUsecase interface {
+		Execute(ctx context.Context, caseID uint64) (GetCollectionCaseOutput, error)
+	}
+
+	GetCollectionCaseOutput struct {
+		Case     CollectionCaseOutput   `json:"case"`
+		Attempts []ContactAttemptOutput `json:"attempts"`
+		Promises []PromiseToPayOutput   `json:"promises"`
+	}
+)
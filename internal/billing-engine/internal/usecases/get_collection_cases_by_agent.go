@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetCollectionCasesByAgentUsecase interface {
+		Execute(ctx context.Context, agentID uint64) ([]CollectionCaseOutput, error)
+	}
+)
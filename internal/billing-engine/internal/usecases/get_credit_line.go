@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetCreditLineUsecase interface {
+		Execute(ctx context.Context, creditLineID uint64) (CreditLineOutput, error)
+	}
+)
@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetCreditLineBillsUsecase interface {
+		Execute(ctx context.Context, creditLineID uint64) ([]CreditLineBillOutput, error)
+	}
+)
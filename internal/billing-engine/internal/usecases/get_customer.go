@@ -0,0 +1,21 @@
+package usecases
+
+import "context"
+
+type (
+	GetCustomerUsecase interface {
+		Execute(ctx context.Context, customerID uint64) (CustomerProfileOutput, error)
+	}
+
+	CustomerProfileOutput struct {
+		ID          uint64 `json:"id"`
+		Name        string `json:"name"`
+		Email       string `json:"email"`
+		Phone       string `json:"phone,omitempty"`
+		Language    string `json:"language"`
+		NIK         string `json:"nik,omitempty"`
+		DateOfBirth string `json:"date_of_birth,omitempty"` // format YYYY-MM-DD
+		Address     string `json:"address,omitempty"`
+		KYCStatus   string `json:"kyc_status"`
+	}
+)
@@ -0,0 +1,29 @@
+package usecases
+
+import "context"
+
+type (
+	GetCustomerDuplicatesUsecase interface {
+		Execute(ctx context.Context, input GetCustomerDuplicatesInput) (GetCustomerDuplicatesOutput, error)
+	}
+
+	GetCustomerDuplicatesInput struct {
+		Status     string `json:"status" validate:"omitempty,oneof=OPEN MERGED DISMISSED"` // OPEN when empty
+		CustomerID uint64 `json:"customer_id"`                                             // on either side, every customer when zero
+	}
+
+	GetCustomerDuplicatesOutput struct {
+		Duplicates []CustomerDuplicateOutput `json:"duplicates"`
+	}
+
+	CustomerDuplicateOutput struct {
+		ID                uint64 `json:"id"`
+		CustomerID        uint64 `json:"customer_id"`
+		MatchedCustomerID uint64 `json:"matched_customer_id"`
+		Match             string `json:"match"`
+		Status            string `json:"status"`
+		CreatedAt         string `json:"created_at"`
+		ResolvedBy        string `json:"resolved_by,omitempty"`
+		ResolvedAt        string `json:"resolved_at,omitempty"`
+	}
+)
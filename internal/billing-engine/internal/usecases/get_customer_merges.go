@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetCustomerMergesUsecase interface {
+		Execute(ctx context.Context, customerID uint64) ([]CustomerMergeOutput, error)
+	}
+)
@@ -0,0 +1,34 @@
+package usecases
+
+import "context"
+
+type (
+	GetCustomerSummaryUsecase interface {
+		Execute(ctx context.Context, customerID uint64) (CustomerSummaryOutput, error)
+	}
+
+	CustomerSummaryOutput struct {
+		CustomerID  uint64            `json:"customer_id"`
+		Name        string            `json:"name"`
+		Email       string            `json:"email"`
+		CreditLimit CreditLimitOutput `json:"credit_limit"`
+		ActiveLoans int64             `json:"active_loans"` // applied, approved and disbursed
+		Outstanding string            `json:"outstanding"`  // unpaid installments and fees of disbursed loans
+		Committed   string            `json:"committed"`    // principal of applications and approved loans
+		CoBorrowed  string            `json:"co_borrowed"`  // obligations on loans co-borrowed with other customers
+		Guaranteed  string            `json:"guaranteed"`   // obligations on loans guaranteed for other customers
+		Utilised    string            `json:"utilised"`
+		Available   string            `json:"available"`
+		Utilisation string            `json:"utilisation"` // utilised over the credit limit, e.g. 0.25 for 25%
+
+		Obligations []LoanObligationOutput `json:"obligations"` // the loans of other customers they co-borrow or guarantee
+	}
+
+	LoanObligationOutput struct {
+		LoanID     uint64 `json:"loan_id"`
+		BorrowerID uint64 `json:"borrower_id"`
+		Role       string `json:"role"`
+		LoanStatus string `json:"loan_status"`
+		Amount     string `json:"amount"`
+	}
+)
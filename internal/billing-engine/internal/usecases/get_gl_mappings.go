@@ -0,0 +1,20 @@
+package usecases
+
+import "context"
+
+type (
+	GetGLMappingsUsecase interface {
+		Execute(ctx context.Context) (GetGLMappingsOutput, error)
+	}
+
+	GetGLMappingsOutput struct {
+		Mappings []GLMappingOutput `json:"mappings"`
+	}
+
+	GLMappingOutput struct {
+		Event       string `json:"event"`
+		AccountCode string `json:"account_code"`
+		GLCode      string `json:"gl_code"`
+		UpdatedAt   string `json:"updated_at"`
+	}
+)
@@ -8,11 +8,18 @@ type (
 	}
 
 	GetInstallmentsOutput struct {
-		ID         uint64 `json:"id"`
-		LoanID     uint64 `json:"loan_id"`
-		WeekNumber int64  `json:"week_number"`
-		DueDate    string `json:"due_date"`
-		AmountDue  string `json:"amount_due"`
-		Status     string `json:"status"`
+		ID         uint64                 `json:"id"`
+		LoanID     uint64                 `json:"loan_id"`
+		WeekNumber int64                  `json:"week_number"`
+		DueDate    string                 `json:"due_date"`
+		AmountDue  string                 `json:"amount_due"`
+		FeeAmount  string                 `json:"fee_amount"`
+		Fees       []InstallmentFeeOutput `json:"fees,omitempty"` // one line per amortised fee
+		Status     string                 `json:"status"`
+	}
+
+	InstallmentFeeOutput struct {
+		FeeType string `json:"fee_type"`
+		Amount  string `json:"amount"`
 	}
 )
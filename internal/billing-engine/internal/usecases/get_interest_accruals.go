@@ -0,0 +1,32 @@
+package usecases
+
+import "context"
+
+type (
+	GetInterestAccrualsUsecase interface {
+		Execute(ctx context.Context, input GetInterestAccrualsInput) (GetInterestAccrualsOutput, error)
+	}
+
+	GetInterestAccrualsInput struct {
+		LoanID uint64 `json:"loan_id"`                                      // optional, all loans when empty
+		From   string `json:"from" validate:"required,datetime=2006-01-02"` // format YYYY-MM-DD, inclusive
+		To     string `json:"to" validate:"required,datetime=2006-01-02"`   // format YYYY-MM-DD, inclusive
+	}
+
+	GetInterestAccrualsOutput struct {
+		From          string                  `json:"from"`
+		To            string                  `json:"to"`
+		Accruals      []InterestAccrualOutput `json:"accruals"`
+		TotalAccrued  string                  `json:"total_accrued"`
+		TotalReversed string                  `json:"total_reversed"`
+	}
+
+	InterestAccrualOutput struct {
+		ID             uint64 `json:"id"`
+		LoanID         uint64 `json:"loan_id"`
+		AccrualDate    string `json:"accrual_date"` // format YYYY-MM-DD
+		Type           string `json:"type"`
+		Amount         string `json:"amount"`
+		JournalEntryID uint64 `json:"journal_entry_id,omitempty"`
+	}
+)
@@ -0,0 +1,18 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoanCollateralUsecase interface {
+		Execute(ctx context.Context, loanID uint64) (LoanCollateralOutput, error)
+	}
+
+	LoanCollateralOutput struct {
+		LoanID      uint64             `json:"loan_id"`
+		LoanStatus  string             `json:"loan_status"`
+		Exposure    string             `json:"exposure"`      // principal until paid out, then the outstanding
+		Valuation   string             `json:"valuation"`     // of the collateral still held
+		LoanToValue string             `json:"loan_to_value"` // exposure over valuation, e.g. 0.8000 for 80%
+		Collaterals []CollateralOutput `json:"collaterals"`
+	}
+)
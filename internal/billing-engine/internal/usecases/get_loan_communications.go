@@ -0,0 +1,14 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoanCommunicationsUsecase interface {
+		Execute(ctx context.Context, loanID uint64) (GetLoanCommunicationsOutput, error)
+	}
+
+	GetLoanCommunicationsOutput struct {
+		LoanID         uint64                `json:"loan_id"`
+		Communications []CommunicationOutput `json:"communications"`
+	}
+)
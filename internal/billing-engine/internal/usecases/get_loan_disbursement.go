@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoanDisbursementUsecase interface {
+		Execute(ctx context.Context, loanID uint64) (DisbursementOutput, error)
+	}
+)
@@ -0,0 +1,23 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoanDisclosureUsecase interface {
+		Execute(ctx context.Context, loanID uint64) (LoanDisclosureOutput, error)
+	}
+
+	LoanDisclosureOutput struct {
+		LoanID              uint64 `json:"loan_id"`
+		ProductCode         string `json:"product_code"`
+		PrincipalAmount     string `json:"principal_amount"`
+		InterestRate        string `json:"interest_rate"`
+		TermWeeks           int64  `json:"term_weeks"`
+		AmountReceived      string `json:"amount_received"`
+		TotalInterest       string `json:"total_interest"`
+		TotalFees           string `json:"total_fees"`
+		TotalRepayment      string `json:"total_repayment"`
+		EffectiveAnnualRate string `json:"effective_annual_rate"`
+		DisclosedAt         string `json:"disclosed_at"`
+	}
+)
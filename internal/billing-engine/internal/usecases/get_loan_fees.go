@@ -0,0 +1,29 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoanFeesUsecase interface {
+		Execute(ctx context.Context, loanID uint64) (GetLoanFeesOutput, error)
+	}
+
+	GetLoanFeesOutput struct {
+		LoanID              uint64          `json:"loan_id"`
+		PrincipalAmount     string          `json:"principal_amount"`
+		InterestRate        string          `json:"interest_rate"`
+		TotalDeducted       string          `json:"total_deducted"`
+		TotalAmortised      string          `json:"total_amortised"`
+		EffectiveAnnualRate string          `json:"effective_annual_rate"` // interest and every fee, compounded weekly
+		Fees                []LoanFeeOutput `json:"fees"`
+	}
+
+	LoanFeeOutput struct {
+		ID          uint64 `json:"id"`
+		FeeType     string `json:"fee_type"`
+		Calculation string `json:"calculation"`
+		Value       string `json:"value"`
+		Collection  string `json:"collection"`
+		Amount      string `json:"amount"`
+		CreatedAt   string `json:"created_at"`
+	}
+)
@@ -0,0 +1,27 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoanJournalUsecase interface {
+		Execute(ctx context.Context, loanID uint64) ([]JournalEntryOutput, error)
+	}
+
+	JournalEntryOutput struct {
+		ID              uint64          `json:"id"`
+		LoanID          uint64          `json:"loan_id"`
+		Event           string          `json:"event"`
+		Reference       string          `json:"reference"`
+		Description     string          `json:"description"`
+		EffectiveDate   string          `json:"effective_date"` // format YYYY-MM-DD
+		ReversesEntryID uint64          `json:"reverses_entry_id,omitempty"`
+		CreatedAt       string          `json:"created_at"` // format RFC3339
+		Postings        []PostingOutput `json:"postings"`
+	}
+
+	PostingOutput struct {
+		AccountCode string `json:"account_code"`
+		Direction   string `json:"direction"`
+		Amount      string `json:"amount"`
+	}
+)
@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoanPartiesUsecase interface {
+		Execute(ctx context.Context, loanID uint64) ([]LoanPartyOutput, error)
+	}
+)
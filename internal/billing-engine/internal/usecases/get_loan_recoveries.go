@@ -0,0 +1,24 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoanRecoveriesUsecase interface {
+		Execute(ctx context.Context, loanID uint64) (GetLoanRecoveriesOutput, error)
+	}
+
+	GetLoanRecoveriesOutput struct {
+		WriteOff        WriteOffOutput   `json:"write_off"`
+		Recoveries      []RecoveryOutput `json:"recoveries"`
+		TotalRecovered  string           `json:"total_recovered"`
+		RemainingAmount string           `json:"remaining_amount"`
+	}
+
+	RecoveryOutput struct {
+		ID         uint64 `json:"id"`
+		LoanID     uint64 `json:"loan_id"`
+		WriteOffID uint64 `json:"write_off_id"`
+		Amount     string `json:"amount"`
+		ReceivedAt string `json:"received_at"` // format RFC3339
+	}
+)
@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoanRestructuresUsecase interface {
+		Execute(ctx context.Context, loanID uint64) ([]LoanRestructureOutput, error)
+	}
+)
@@ -0,0 +1,24 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoanStatusHistoryUsecase interface {
+		Execute(ctx context.Context, loanID uint64) (GetLoanStatusHistoryOutput, error)
+	}
+
+	GetLoanStatusHistoryOutput struct {
+		LoanID      uint64                       `json:"loan_id"`
+		Status      string                       `json:"status"`
+		Transitions []LoanStatusTransitionOutput `json:"transitions"`
+	}
+
+	LoanStatusTransitionOutput struct {
+		ID             uint64 `json:"id"`
+		FromStatus     string `json:"from_status"`
+		ToStatus       string `json:"to_status"`
+		Actor          string `json:"actor"`
+		Reason         string `json:"reason"`
+		TransitionedAt string `json:"transitioned_at"` // format RFC3339
+	}
+)
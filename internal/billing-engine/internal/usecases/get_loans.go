@@ -0,0 +1,35 @@
+package usecases
+
+import "context"
+
+type (
+	GetLoansUsecase interface {
+		Execute(ctx context.Context, input GetLoansInput) (GetLoansOutput, error)
+	}
+
+	GetLoansInput struct {
+		Cursor     string `json:"cursor"`                                   // next_cursor of the previous page, first page when empty
+		Limit      int64  `json:"limit" validate:"omitempty,min=1,max=200"` // 50 when empty
+		CustomerID uint64 `json:"customer_id"`                              // any when empty
+		Status     string `json:"status" validate:"omitempty,oneof=APPLIED APPROVED REJECTED CANCELLED DISBURSED PAID RESTRUCTURED WRITTEN_OFF"`
+		Overdue    string `json:"overdue" validate:"omitempty,oneof=true false"` // an installment due before as_of is unpaid
+		StartFrom  string `json:"start_from"`                                    // format YYYY-MM-DD, inclusive
+		StartTo    string `json:"start_to"`                                      // format YYYY-MM-DD, inclusive
+		AsOf       string `json:"as_of"`                                         // format YYYY-MM-DD, today when empty
+		Sort       string `json:"sort" validate:"omitempty,oneof=id -id"`        // id when empty, - for descending
+	}
+
+	GetLoansOutput struct {
+		Loans      []LoanSummaryOutput `json:"loans"`
+		NextCursor string              `json:"next_cursor,omitempty"` // empty on the last page
+	}
+
+	LoanSummaryOutput struct {
+		LoanOutput
+		Outstanding   string `json:"outstanding"`             // unpaid installments and fees
+		OverdueAmount string `json:"overdue_amount"`          // the part of it due before as_of
+		DaysPastDue   int64  `json:"days_past_due"`           // since the oldest overdue installment
+		NextDueDate   string `json:"next_due_date,omitempty"` // format YYYY-MM-DD, empty when nothing is left to pay
+		NextDueAmount string `json:"next_due_amount,omitempty"`
+	}
+)
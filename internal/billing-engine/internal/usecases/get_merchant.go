@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetMerchantUsecase interface {
+		Execute(ctx context.Context, merchantID uint64) (MerchantOutput, error)
+	}
+)
@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetMerchantSettlementUsecase interface {
+		Execute(ctx context.Context, settlementID uint64) (MerchantSettlementOutput, error)
+	}
+)
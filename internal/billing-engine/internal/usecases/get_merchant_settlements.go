@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetMerchantSettlementsUsecase interface {
+		Execute(ctx context.Context, merchantID uint64) ([]MerchantSettlementOutput, error)
+	}
+)
@@ -0,0 +1,9 @@
+package usecases
+
+import "context"
+
+type (
+	GetMoratoriumUsecase interface {
+		Execute(ctx context.Context, moratoriumID uint64) (MoratoriumOutput, error)
+	}
+)
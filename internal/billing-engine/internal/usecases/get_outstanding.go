@@ -14,12 +14,14 @@ type (
 			TotalAmount string `json:"total_amount"`
 			TotalPaid   string `json:"total_paid"`
 			TotalMissed string `json:"total_missed"`
+			TotalFees   string `json:"total_fees"` // unpaid fees, part of the total amount
 		} `json:"outstanding"`
 		Installments []struct {
 			ID         uint64 `json:"id"`
 			WeekNumber int64  `json:"week_number"`
 			DueDate    string `json:"due_date"`
 			AmountDue  string `json:"amount_due"`
+			FeeAmount  string `json:"fee_amount"`
 			Status     string `json:"status"`
 		} `json:"installments"`
 	}
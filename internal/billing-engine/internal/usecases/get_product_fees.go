@@ -0,0 +1,26 @@
+package usecases
+
+import "context"
+
+type (
+	GetProductFeesUsecase interface {
+		Execute(ctx context.Context, input GetProductFeesInput) (GetProductFeesOutput, error)
+	}
+
+	GetProductFeesInput struct {
+		ProductCode string `json:"product_code" validate:"omitempty,max=50"` // every product when empty
+	}
+
+	GetProductFeesOutput struct {
+		Fees []ProductFeeOutput `json:"fees"`
+	}
+
+	ProductFeeOutput struct {
+		ProductCode string `json:"product_code"`
+		FeeType     string `json:"fee_type"`
+		Calculation string `json:"calculation"`
+		Value       string `json:"value"`
+		Collection  string `json:"collection"`
+		UpdatedAt   string `json:"updated_at"`
+	}
+)
@@ -0,0 +1,21 @@
+package usecases
+
+import "context"
+
+type (
+	GetProvisionRatesUsecase interface {
+		Execute(ctx context.Context) (GetProvisionRatesOutput, error)
+	}
+
+	GetProvisionRatesOutput struct {
+		Rates []ProvisionRateOutput `json:"rates"`
+	}
+
+	ProvisionRateOutput struct {
+		ProductCode string `json:"product_code"`
+		Stage       int64  `json:"stage"`
+		PD          string `json:"pd"`
+		LGD         string `json:"lgd"`
+		UpdatedAt   string `json:"updated_at"`
+	}
+)
@@ -0,0 +1,39 @@
+package usecases
+
+import "context"
+
+type (
+	GetProvisioningReportUsecase interface {
+		Execute(ctx context.Context, input GetProvisioningReportInput) (GetProvisioningReportOutput, error)
+	}
+
+	GetProvisioningReportInput struct {
+		Month string `json:"month" validate:"required,datetime=2006-01"` // format YYYY-MM
+	}
+
+	GetProvisioningReportOutput struct {
+		Month         string                    `json:"month"`
+		PreviousMonth string                    `json:"previous_month"`
+		Lines         []ProvisioningLineOutput  `json:"lines"`
+		TotalExposure string                    `json:"total_exposure"`
+		TotalECL      string                    `json:"total_ecl"`
+		OpeningECL    string                    `json:"opening_ecl"`
+		Movements     []ProvisionMovementOutput `json:"movements"`
+		ClosingECL    string                    `json:"closing_ecl"`
+	}
+
+	ProvisioningLineOutput struct {
+		ProductCode       string `json:"product_code"`
+		Stage             int64  `json:"stage"`
+		Loans             int    `json:"loans"`
+		ExposureAtDefault string `json:"exposure_at_default"`
+		ECL               string `json:"ecl"`
+		CoverageRatio     string `json:"coverage_ratio"` // ECL over exposure
+	}
+
+	ProvisionMovementOutput struct {
+		Type   string `json:"type"`
+		Loans  int    `json:"loans"`
+		Amount string `json:"amount"`
+	}
+)
@@ -0,0 +1,30 @@
+package usecases
+
+import "context"
+
+type (
+	GetRecoveryReportUsecase interface {
+		Execute(ctx context.Context, input GetRecoveryReportInput) (GetRecoveryReportOutput, error)
+	}
+
+	GetRecoveryReportInput struct {
+		From string `json:"from" validate:"required,datetime=2006-01-02"` // format YYYY-MM-DD, inclusive
+		To   string `json:"to" validate:"required,datetime=2006-01-02"`   // format YYYY-MM-DD, inclusive
+	}
+
+	GetRecoveryReportOutput struct {
+		From            string                      `json:"from"`
+		To              string                      `json:"to"`
+		Loans           []LoanRecoverySummaryOutput `json:"loans"`
+		TotalRecovered  string                      `json:"total_recovered"`
+		TotalRecoveries int64                       `json:"total_recoveries"`
+	}
+
+	LoanRecoverySummaryOutput struct {
+		LoanID           uint64 `json:"loan_id"`
+		CustomerID       uint64 `json:"customer_id"`
+		WrittenOffAmount string `json:"written_off_amount"`
+		RecoveredAmount  string `json:"recovered_amount"`
+		RecoveriesCount  int64  `json:"recoveries_count"`
+	}
+)
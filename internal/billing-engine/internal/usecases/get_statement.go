@@ -0,0 +1,30 @@
+package usecases
+
+import "context"
+
+type (
+	GetStatementUsecase interface {
+		Execute(ctx context.Context, input GetStatementInput) (GetStatementOutput, error)
+	}
+
+	GetStatementInput struct {
+		CustomerID uint64 `json:"-"`
+		LoanID     uint64 `json:"loan_id"`                                        // every loan of the customer when empty
+		From       string `json:"from" validate:"omitempty,datetime=2006-01-02"`  // first day of the month of to when empty
+		To         string `json:"to" validate:"omitempty,datetime=2006-01-02"`    // today when empty
+		Format     string `json:"format" validate:"omitempty,oneof=json csv pdf"` // defaults to json
+	}
+
+	GetStatementOutput struct {
+		CustomerID     uint64 `json:"customer_id"`
+		LoanID         uint64 `json:"loan_id,omitempty"`
+		From           string `json:"from"`
+		To             string `json:"to"`
+		Format         string `json:"format"`
+		FileName       string `json:"file_name"`
+		ContentType    string `json:"content_type"`
+		Content        []byte `json:"-"`
+		OpeningBalance string `json:"opening_balance"`
+		ClosingBalance string `json:"closing_balance"`
+	}
+)
@@ -0,0 +1,30 @@
+package usecases
+
+import "context"
+
+type (
+	GetTrialBalanceUsecase interface {
+		Execute(ctx context.Context, input GetTrialBalanceInput) (GetTrialBalanceOutput, error)
+	}
+
+	GetTrialBalanceInput struct {
+		AsOf string `json:"as_of" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today
+	}
+
+	GetTrialBalanceOutput struct {
+		AsOf        string                   `json:"as_of"`
+		Accounts    []TrialBalanceLineOutput `json:"accounts"`
+		TotalDebit  string                   `json:"total_debit"`
+		TotalCredit string                   `json:"total_credit"`
+		Balanced    bool                     `json:"balanced"`
+	}
+
+	TrialBalanceLineOutput struct {
+		Code    string `json:"code"`
+		Name    string `json:"name"`
+		Type    string `json:"type"`
+		Debit   string `json:"debit"`
+		Credit  string `json:"credit"`
+		Balance string `json:"balance"` // in the account's normal direction
+	}
+)
@@ -0,0 +1,43 @@
+package usecases
+
+import "context"
+
+type (
+	LogContactAttemptUsecase interface {
+		Execute(ctx context.Context, input LogContactAttemptInput) (LogContactAttemptOutput, error)
+	}
+
+	LogContactAttemptInput struct {
+		CaseID         uint64 `json:"case_id" validate:"required"`
+		AgentID        uint64 `json:"agent_id" validate:"required"`
+		Channel        string `json:"channel" validate:"required,oneof=CALL SMS WHATSAPP EMAIL VISIT"`
+		Outcome        string `json:"outcome" validate:"required,oneof=NO_ANSWER REACHED PROMISE_TO_PAY REFUSED WRONG_NUMBER"`
+		Notes          string `json:"notes"`
+		PromisedDate   string `json:"promised_date" validate:"required_if=Outcome PROMISE_TO_PAY,omitempty,datetime=2006-01-02"`
+		PromisedAmount string `json:"promised_amount" validate:"required_if=Outcome PROMISE_TO_PAY"`
+	}
+
+	LogContactAttemptOutput struct {
+		Attempt ContactAttemptOutput `json:"attempt"`
+		Promise *PromiseToPayOutput  `json:"promise,omitempty"`
+	}
+
+	ContactAttemptOutput struct {
+		ID          uint64 `json:"id"`
+		CaseID      uint64 `json:"case_id"`
+		AgentID     uint64 `json:"agent_id"`
+		Channel     string `json:"channel"`
+		Outcome     string `json:"outcome"`
+		Notes       string `json:"notes"`
+		AttemptedAt string `json:"attempted_at"` // format RFC3339
+	}
+
+	PromiseToPayOutput struct {
+		ID             uint64 `json:"id"`
+		CaseID         uint64 `json:"case_id"`
+		LoanID         uint64 `json:"loan_id"`
+		PromisedDate   string `json:"promised_date"` // format YYYY-MM-DD
+		PromisedAmount string `json:"promised_amount"`
+		Status         string `json:"status"`
+	}
+)
@@ -8,10 +8,11 @@ type (
 	}
 
 	MakePaymentInput struct {
-		CustomerID uint64 `json:"customer_id" validate:"required"`
-		LoanID     uint64 `json:"loan_id" validate:"required"`
-		WeekNumber int64  `json:"week_number" validate:"required"`
-		Amount     string `json:"amount" validate:"required"`
+		CustomerID    uint64 `json:"customer_id" validate:"required"`
+		LoanID        uint64 `json:"loan_id" validate:"required"`
+		WeekNumber    int64  `json:"week_number" validate:"required"`
+		Amount        string `json:"amount" validate:"required"`
+		EffectiveDate string `json:"effective_date" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today
 	}
 
 	MakePaymentOutput struct {
@@ -0,0 +1,20 @@
+package usecases
+
+import "context"
+
+type (
+	MarkOverdueCreditLineBillsUsecase interface {
+		Execute(ctx context.Context, input MarkOverdueCreditLineBillsInput) (MarkOverdueCreditLineBillsOutput, error)
+	}
+
+	MarkOverdueCreditLineBillsInput struct {
+		AsOf string `json:"as_of" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today
+	}
+
+	MarkOverdueCreditLineBillsOutput struct {
+		AsOf               string   `json:"as_of"`
+		OverdueBillIDs     []uint64 `json:"overdue_bill_ids"`
+		PaidBillIDs        []uint64 `json:"paid_bill_ids"` // every installment paid one by one before the due date
+		MissedInstallments int64    `json:"missed_installments"`
+	}
+)
@@ -0,0 +1,31 @@
+package usecases
+
+import "context"
+
+type (
+	MergeCustomersUsecase interface {
+		Execute(ctx context.Context, input MergeCustomersInput) (CustomerMergeOutput, error)
+	}
+
+	MergeCustomersInput struct {
+		SourceCustomerID uint64 `json:"source_customer_id" validate:"required"`                          // soft deleted once merged
+		TargetCustomerID uint64 `json:"target_customer_id" validate:"required,nefield=SourceCustomerID"` // the surviving customer
+		Reason           string `json:"reason" validate:"required,max=500"`
+		MergedBy         string `json:"merged_by" validate:"required,max=100"`
+	}
+
+	CustomerMergeOutput struct {
+		ID               uint64                      `json:"id"`
+		SourceCustomerID uint64                      `json:"source_customer_id"`
+		TargetCustomerID uint64                      `json:"target_customer_id"`
+		Reason           string                      `json:"reason"`
+		MergedBy         string                      `json:"merged_by"`
+		MergedAt         string                      `json:"merged_at"`
+		Records          []CustomerMergeRecordOutput `json:"records"` // the rows moved to the target customer
+	}
+
+	CustomerMergeRecordOutput struct {
+		TableName string `json:"table_name"`
+		RecordID  uint64 `json:"record_id"`
+	}
+)
@@ -0,0 +1,29 @@
+package usecases
+
+import "context"
+
+type (
+	OpenCreditLineUsecase interface {
+		Execute(ctx context.Context, input OpenCreditLineInput) (CreditLineOutput, error)
+	}
+
+	OpenCreditLineInput struct {
+		CustomerID  uint64 `json:"customer_id" validate:"required"`
+		CreditLimit string `json:"credit_limit" validate:"required,numeric"`
+		ProductCode string `json:"product_code" validate:"omitempty,max=50"` // PAYLATER when empty
+		OpenedBy    string `json:"opened_by" validate:"required,max=100"`
+	}
+
+	CreditLineOutput struct {
+		ID          uint64       `json:"id"`
+		CustomerID  uint64       `json:"customer_id"`
+		CreditLimit string       `json:"credit_limit"`
+		Used        string       `json:"used"`      // exposure of the active drawdowns
+		Available   string       `json:"available"` // left to draw
+		Overdue     bool         `json:"overdue"`   // a bill went unpaid past its due date, no drawdowns until it is paid
+		ProductCode string       `json:"product_code"`
+		OpenedBy    string       `json:"opened_by"`
+		OpenedAt    string       `json:"opened_at"` // format RFC3339
+		Drawdowns   []LoanOutput `json:"drawdowns"`
+	}
+)
@@ -0,0 +1,15 @@
+package usecases
+
+import "context"
+
+type (
+	PayCreditLineBillUsecase interface {
+		Execute(ctx context.Context, input PayCreditLineBillInput) (CreditLineBillOutput, error)
+	}
+
+	PayCreditLineBillInput struct {
+		BillID        uint64 `json:"bill_id" validate:"required"`
+		Amount        string `json:"amount" validate:"required,numeric"`
+		EffectiveDate string `json:"effective_date" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today
+	}
+)
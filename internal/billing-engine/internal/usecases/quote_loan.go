@@ -0,0 +1,47 @@
+package usecases
+
+import "context"
+
+type (
+	QuoteLoanUsecase interface {
+		Execute(ctx context.Context, input QuoteLoanInput) (LoanQuoteOutput, error)
+	}
+
+	QuoteLoanInput struct {
+		ProductCode     string `json:"product_code" validate:"omitempty,max=50"` // STANDARD when empty
+		PrincipalAmount string `json:"principal_amount" validate:"required,numeric"`
+		TermWeeks       int64  `json:"term_weeks" validate:"required,min=1,max=520"`
+	}
+
+	LoanQuoteOutput struct {
+		ProductCode         string                   `json:"product_code"`
+		PrincipalAmount     string                   `json:"principal_amount"`
+		InterestRate        string                   `json:"interest_rate"`
+		TermWeeks           int64                    `json:"term_weeks"`
+		AmountReceived      string                   `json:"amount_received"` // principal less the fees deducted at disbursement
+		TotalInterest       string                   `json:"total_interest"`
+		TotalFees           string                   `json:"total_fees"`
+		TotalRepayment      string                   `json:"total_repayment"`
+		EffectiveAnnualRate string                   `json:"effective_annual_rate"` // interest and every fee, compounded weekly
+		Fees                []QuoteFeeOutput         `json:"fees"`
+		Schedule            []QuoteInstallmentOutput `json:"schedule"`
+	}
+
+	QuoteFeeOutput struct {
+		FeeType     string `json:"fee_type"`
+		Calculation string `json:"calculation"`
+		Value       string `json:"value"`
+		Collection  string `json:"collection"`
+		Amount      string `json:"amount"`
+	}
+
+	QuoteInstallmentOutput struct {
+		WeekNumber int64                  `json:"week_number"`
+		DueDate    string                 `json:"due_date"` // format YYYY-MM-DD, as if disbursed today
+		Principal  string                 `json:"principal"`
+		Interest   string                 `json:"interest"`
+		FeeAmount  string                 `json:"fee_amount"`
+		TotalDue   string                 `json:"total_due"`
+		Fees       []InstallmentFeeOutput `json:"fees,omitempty"`
+	}
+)
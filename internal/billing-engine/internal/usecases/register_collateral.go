@@ -0,0 +1,32 @@
+package usecases
+
+import "context"
+
+type (
+	RegisterCollateralUsecase interface {
+		Execute(ctx context.Context, input RegisterCollateralInput) (CollateralOutput, error)
+	}
+
+	RegisterCollateralInput struct {
+		LoanID        uint64 `json:"loan_id" validate:"required"`
+		Type          string `json:"type" validate:"required,oneof=VEHICLE_BPKB GOLD DEPOSIT"`
+		Description   string `json:"description" validate:"required,max=500"`
+		Valuation     string `json:"valuation" validate:"required"`
+		ValuationDate string `json:"valuation_date" validate:"required,datetime=2006-01-02"` // format YYYY-MM-DD
+		RegisteredBy  string `json:"registered_by" validate:"required,max=100"`
+	}
+
+	CollateralOutput struct {
+		ID            uint64 `json:"id"`
+		LoanID        uint64 `json:"loan_id"`
+		Type          string `json:"type"`
+		Description   string `json:"description"`
+		Valuation     string `json:"valuation"`
+		ValuationDate string `json:"valuation_date"` // format YYYY-MM-DD
+		LienStatus    string `json:"lien_status"`
+		RegisteredBy  string `json:"registered_by"`
+		RegisteredAt  string `json:"registered_at"` // format RFC3339
+		LienUpdatedBy string `json:"lien_updated_by,omitempty"`
+		LienUpdatedAt string `json:"lien_updated_at,omitempty"` // format RFC3339
+	}
+)
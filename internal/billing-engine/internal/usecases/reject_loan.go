@@ -0,0 +1,15 @@
+package usecases
+
+import "context"
+
+type (
+	RejectLoanUsecase interface {
+		Execute(ctx context.Context, input RejectLoanInput) (LoanOutput, error)
+	}
+
+	RejectLoanInput struct {
+		LoanID     uint64 `json:"loan_id" validate:"required"`
+		RejectedBy string `json:"rejected_by" validate:"required,max=100"`
+		Reason     string `json:"reason" validate:"required,max=500"`
+	}
+)
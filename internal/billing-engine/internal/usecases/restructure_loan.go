@@ -0,0 +1,39 @@
+package usecases
+
+import "context"
+
+type (
+	RestructureLoanUsecase interface {
+		Execute(ctx context.Context, input RestructureLoanInput) (RestructureLoanOutput, error)
+	}
+
+	RestructureLoanInput struct {
+		LoanID            uint64 `json:"loan_id" validate:"required"`
+		Type              string `json:"type" validate:"required,oneof=EXTEND_TERM REDUCE_INSTALLMENT CAPITALISE_ARREARS"`
+		TermWeeks         int64  `json:"term_weeks" validate:"required_if=Type EXTEND_TERM,gte=0,lte=520"`
+		InstallmentAmount string `json:"installment_amount" validate:"required_if=Type REDUCE_INSTALLMENT"`
+		StartDate         string `json:"start_date" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today
+		Reason            string `json:"reason" validate:"required,max=500"`
+		RestructuredBy    string `json:"restructured_by" validate:"max=100"` // defaults to system
+	}
+
+	RestructureLoanOutput struct {
+		Restructure  LoanRestructureOutput   `json:"restructure"`
+		NewLoan      LoanOutput              `json:"new_loan"`
+		Installments []GetInstallmentsOutput `json:"installments"`
+	}
+
+	LoanRestructureOutput struct {
+		ID                   uint64 `json:"id"`
+		LoanID               uint64 `json:"loan_id"`
+		NewLoanID            uint64 `json:"new_loan_id"`
+		Type                 string `json:"type"`
+		OutstandingAmount    string `json:"outstanding_amount"`
+		ArrearsAmount        string `json:"arrears_amount"`
+		ClosedInstallments   int64  `json:"closed_installments"`
+		NewTermWeeks         int64  `json:"new_term_weeks"`
+		NewInstallmentAmount string `json:"new_installment_amount"`
+		Reason               string `json:"reason"`
+		RestructuredAt       string `json:"restructured_at"` // format RFC3339
+	}
+)
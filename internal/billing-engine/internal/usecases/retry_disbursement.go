@@ -0,0 +1,13 @@
+package usecases
+
+import "context"
+
+type (
+	RetryDisbursementUsecase interface {
+		Execute(ctx context.Context, input RetryDisbursementInput) (DisbursementOutput, error)
+	}
+
+	RetryDisbursementInput struct {
+		DisbursementID uint64 `json:"disbursement_id" validate:"required"`
+	}
+)
@@ -0,0 +1,15 @@
+package usecases
+
+import "context"
+
+type (
+	RevalueCollateralUsecase interface {
+		Execute(ctx context.Context, input RevalueCollateralInput) (CollateralOutput, error)
+	}
+
+	RevalueCollateralInput struct {
+		CollateralID  uint64 `json:"collateral_id" validate:"required"`
+		Valuation     string `json:"valuation" validate:"required"`
+		ValuationDate string `json:"valuation_date" validate:"required,datetime=2006-01-02"` // format YYYY-MM-DD
+	}
+)
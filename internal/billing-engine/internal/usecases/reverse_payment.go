@@ -0,0 +1,24 @@
+package usecases
+
+import "context"
+
+type (
+	ReversePaymentUsecase interface {
+		Execute(ctx context.Context, input ReversePaymentInput) (ReversePaymentOutput, error)
+	}
+
+	ReversePaymentInput struct {
+		LoanID        uint64 `json:"loan_id" validate:"required"`
+		WeekNumber    int64  `json:"week_number" validate:"required"`
+		Reason        string `json:"reason" validate:"required,max=500"`
+		EffectiveDate string `json:"effective_date" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today
+	}
+
+	ReversePaymentOutput struct {
+		LoanID            uint64             `json:"loan_id"`
+		WeekNumber        int64              `json:"week_number"`
+		Amount            string             `json:"amount"`
+		InstallmentStatus string             `json:"installment_status"`
+		JournalEntry      JournalEntryOutput `json:"journal_entry"`
+	}
+)
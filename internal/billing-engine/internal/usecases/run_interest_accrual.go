@@ -0,0 +1,21 @@
+package usecases
+
+import "context"
+
+type (
+	RunInterestAccrualUsecase interface {
+		Execute(ctx context.Context, input RunInterestAccrualInput) (RunInterestAccrualOutput, error)
+	}
+
+	RunInterestAccrualInput struct {
+		AsOf string `json:"as_of" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, defaults to today
+	}
+
+	RunInterestAccrualOutput struct {
+		AsOf         string `json:"as_of"`
+		LoansAccrued int    `json:"loans_accrued"`
+		DaysAccrued  int    `json:"days_accrued"`
+		TotalAccrued string `json:"total_accrued"`
+		Failed       int    `json:"failed"`
+	}
+)
@@ -0,0 +1,21 @@
+package usecases
+
+import "context"
+
+type (
+	RunProvisioningUsecase interface {
+		Execute(ctx context.Context, input RunProvisioningInput) (RunProvisioningOutput, error)
+	}
+
+	RunProvisioningInput struct {
+		Month string `json:"month" validate:"required,datetime=2006-01"` // format YYYY-MM
+	}
+
+	RunProvisioningOutput struct {
+		Month            string `json:"month"`
+		AsOf             string `json:"as_of"` // last day of the month
+		LoansProvisioned int    `json:"loans_provisioned"`
+		TotalExposure    string `json:"total_exposure"`
+		TotalECL         string `json:"total_ecl"`
+	}
+)
@@ -0,0 +1,24 @@
+package usecases
+
+import "context"
+
+type (
+	SaveCreditLimitUsecase interface {
+		Execute(ctx context.Context, input SaveCreditLimitInput) (CreditLimitOutput, error)
+	}
+
+	SaveCreditLimitInput struct {
+		CustomerID     uint64 `json:"customer_id" validate:"required"`
+		CreditLimit    string `json:"credit_limit" validate:"required,numeric"`
+		MaxActiveLoans int64  `json:"max_active_loans" validate:"required,min=1,max=20"`
+		UpdatedBy      string `json:"updated_by" validate:"required,max=100"`
+	}
+
+	CreditLimitOutput struct {
+		CustomerID     uint64 `json:"customer_id"`
+		CreditLimit    string `json:"credit_limit"`
+		MaxActiveLoans int64  `json:"max_active_loans"`
+		UpdatedBy      string `json:"updated_by,omitempty"`
+		UpdatedAt      string `json:"updated_at,omitempty"` // empty while the default limit applies
+	}
+)
@@ -0,0 +1,15 @@
+package usecases
+
+import "context"
+
+type (
+	SaveGLMappingUsecase interface {
+		Execute(ctx context.Context, input SaveGLMappingInput) (GLMappingOutput, error)
+	}
+
+	SaveGLMappingInput struct {
+		Event       string `json:"event" validate:"required"` // journal event, or DEFAULT for every event without its own mapping
+		AccountCode string `json:"account_code" validate:"required"`
+		GLCode      string `json:"gl_code" validate:"required,max=50"`
+	}
+)
@@ -0,0 +1,17 @@
+package usecases
+
+import "context"
+
+type (
+	SaveProductFeeUsecase interface {
+		Execute(ctx context.Context, input SaveProductFeeInput) (ProductFeeOutput, error)
+	}
+
+	SaveProductFeeInput struct {
+		ProductCode string `json:"product_code" validate:"required,max=50"`
+		FeeType     string `json:"fee_type" validate:"required,oneof=ORIGINATION ADMIN INSURANCE"`
+		Calculation string `json:"calculation" validate:"required,oneof=PERCENTAGE FLAT"`
+		Value       string `json:"value" validate:"required,numeric"`                       // a fraction of the principal for PERCENTAGE, an amount for FLAT
+		Collection  string `json:"collection" validate:"required,oneof=DEDUCTED AMORTISED"` // taken out of the payout, or spread over the installments
+	}
+)
@@ -0,0 +1,16 @@
+package usecases
+
+import "context"
+
+type (
+	SaveProvisionRateUsecase interface {
+		Execute(ctx context.Context, input SaveProvisionRateInput) (ProvisionRateOutput, error)
+	}
+
+	SaveProvisionRateInput struct {
+		ProductCode string `json:"product_code" validate:"required,max=50"` // loan product, or DEFAULT for every product without its own rate
+		Stage       int64  `json:"stage" validate:"required,min=1,max=3"`
+		PD          string `json:"pd" validate:"required,numeric"`  // between 0 and 1
+		LGD         string `json:"lgd" validate:"required,numeric"` // between 0 and 1
+	}
+)
@@ -0,0 +1,15 @@
+package usecases
+
+import "context"
+
+type (
+	UpdateCollateralLienUsecase interface {
+		Execute(ctx context.Context, input UpdateCollateralLienInput) (CollateralOutput, error)
+	}
+
+	UpdateCollateralLienInput struct {
+		CollateralID uint64 `json:"collateral_id" validate:"required"`
+		LienStatus   string `json:"lien_status" validate:"required,oneof=REGISTERED RELEASED"`
+		UpdatedBy    string `json:"updated_by" validate:"required,max=100"`
+	}
+)
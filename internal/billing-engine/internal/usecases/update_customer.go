@@ -0,0 +1,23 @@
+package usecases
+
+import "context"
+
+type (
+	UpdateCustomerUsecase interface {
+		Execute(ctx context.Context, input UpdateCustomerInput) (CustomerProfileOutput, error)
+	}
+
+	// UpdateCustomerInput changes the fields that are set and leaves the
+	// others as they are.
+	UpdateCustomerInput struct {
+		CustomerID  uint64 `json:"-" validate:"required"` // from the path
+		Name        string `json:"name" validate:"omitempty,max=255"`
+		Email       string `json:"email" validate:"omitempty,email"`
+		Phone       string `json:"phone" validate:"omitempty,e164"`
+		Language    string `json:"language" validate:"omitempty,oneof=id en"`
+		NIK         string `json:"nik" validate:"omitempty,len=16,numeric"`
+		DateOfBirth string `json:"date_of_birth" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD
+		Address     string `json:"address" validate:"omitempty,max=500"`
+		KYCStatus   string `json:"kyc_status" validate:"omitempty,oneof=UNVERIFIED PENDING VERIFIED REJECTED"`
+	}
+)
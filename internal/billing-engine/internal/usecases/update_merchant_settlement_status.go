@@ -0,0 +1,18 @@
+package usecases
+
+import "context"
+
+type (
+	UpdateMerchantSettlementStatusUsecase interface {
+		Execute(ctx context.Context, input UpdateMerchantSettlementStatusInput) (MerchantSettlementOutput, error)
+	}
+
+	UpdateMerchantSettlementStatusInput struct {
+		SettlementID  uint64 `json:"settlement_id" validate:"required"`
+		Status        string `json:"status" validate:"required,oneof=PENDING SENT SETTLED FAILED"`
+		Reference     string `json:"reference" validate:"omitempty,max=100"` // bank transfer reference, kept when empty
+		FailureReason string `json:"failure_reason" validate:"required_if=Status FAILED"`
+		EffectiveDate string `json:"effective_date" validate:"omitempty,datetime=2006-01-02"` // format YYYY-MM-DD, the day it SETTLED, defaults to today
+		UpdatedBy     string `json:"updated_by" validate:"required,max=100"`
+	}
+)
@@ -0,0 +1,33 @@
+package usecases
+
+import "context"
+
+type (
+	WriteOffLoanUsecase interface {
+		Execute(ctx context.Context, input WriteOffLoanInput) (WriteOffLoanOutput, error)
+	}
+
+	WriteOffLoanInput struct {
+		LoanID       uint64 `json:"loan_id" validate:"required"`
+		Reason       string `json:"reason" validate:"required,max=500"`
+		WrittenOffBy string `json:"written_off_by" validate:"required,max=100"`
+	}
+
+	WriteOffLoanOutput struct {
+		WriteOff               WriteOffOutput `json:"write_off"`
+		WrittenOffInstallments int64          `json:"written_off_installments"`
+	}
+
+	WriteOffOutput struct {
+		ID              uint64 `json:"id"`
+		LoanID          uint64 `json:"loan_id"`
+		CustomerID      uint64 `json:"customer_id"`
+		PrincipalAmount string `json:"principal_amount"`
+		InterestAmount  string `json:"interest_amount"`
+		FeeAmount       string `json:"fee_amount"`
+		TotalAmount     string `json:"total_amount"`
+		Reason          string `json:"reason"`
+		WrittenOffBy    string `json:"written_off_by"`
+		WrittenOffAt    string `json:"written_off_at"` // format RFC3339
+	}
+)
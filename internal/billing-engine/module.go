@@ -2,8 +2,12 @@ package billingengine
 
 import (
 	"database/sql"
+	"strings"
 
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/entity"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/delivery"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/notification"
+	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/payout"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/gateway/repository"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/billing-engine/internal/interactors"
 	"github.com/JoshuaPangaribuan/billing-engine/internal/pkg/pkgsql"
@@ -22,6 +26,50 @@ type BillingEngineModuleDependencies struct {
 	SnowflakeGen pkguid.Snowflake
 	HttpRouter   *httprouter.Router
 	Validator    *validator.Validate
+	Notification NotificationConfig
+	Payout       PayoutConfig
+	Customer     CustomerConfig
+	Loan         LoanConfig
+}
+
+// CustomerConfig holds the onboarding rules. DuplicatePolicy is BLOCK to
+// refuse a customer matching an existing one, or REVIEW to create it and
+// flag the match for ops, BLOCK when empty.
+type CustomerConfig struct {
+	DuplicatePolicy string
+}
+
+// LoanConfig holds the product rules. GuarantorProducts are the product codes
+// whose loans are approved only with a guarantor linked.
+type LoanConfig struct {
+	GuarantorProducts []string
+}
+
+// PayoutConfig holds the bank transfer gateway loans are paid out through.
+// Without an endpoint payouts fall back to an in-memory provider that pays
+// nothing out.
+type PayoutConfig struct {
+	Endpoint string
+	APIKey   string
+}
+
+// NotificationConfig holds the provider credentials used to reach borrowers.
+// A channel without credentials falls back to an in-memory provider that only
+// logs the message.
+type NotificationConfig struct {
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
+
+	SMSEndpoint string
+	SMSAPIKey   string
+	SMSSender   string
+
+	WhatsAppEndpoint string
+	WhatsAppAPIKey   string
+	WhatsAppSender   string
 }
 
 func NewBillingEngineModule(
@@ -43,6 +91,7 @@ func NewBillingEngineModule(
 			Logger:             dependencies.Logger,
 			Validator:          dependencies.Validator,
 			SnowflakeGen:       dependencies.SnowflakeGen,
+			DuplicatePolicy:    entity.DuplicatePolicy(strings.ToUpper(dependencies.Customer.DuplicatePolicy)),
 		},
 	)
 
@@ -59,6 +108,7 @@ func NewBillingEngineModule(
 		interactors.CreateLoanInteractorDependencies{
 			CreateLoanRepository: repository,
 			Logger:               dependencies.Logger,
+			Validator:            dependencies.Validator,
 			SnowflakeGen:         dependencies.SnowflakeGen,
 		},
 	)
@@ -76,6 +126,7 @@ func NewBillingEngineModule(
 			MakePaymentRepository: repository,
 			Logger:                dependencies.Logger,
 			Validator:             dependencies.Validator,
+			SnowflakeGen:          dependencies.SnowflakeGen,
 		},
 	)
 
@@ -111,5 +162,918 @@ func NewBillingEngineModule(
 		billingEngineEndpoint,
 	)
 
+	// Customer Profile Usecases
+	getCustomerInteractor := interactors.NewGetCustomerInteractor(
+		interactors.GetCustomerInteractorDependencies{
+			GetCustomerRepository: repository,
+			Logger:                dependencies.Logger,
+		},
+	)
+
+	updateCustomerInteractor := interactors.NewUpdateCustomerInteractor(
+		interactors.UpdateCustomerInteractorDependencies{
+			UpdateCustomerRepository: repository,
+			Logger:                   dependencies.Logger,
+			Validator:                dependencies.Validator,
+		},
+	)
+
+	deleteCustomerInteractor := interactors.NewDeleteCustomerInteractor(
+		interactors.DeleteCustomerInteractorDependencies{
+			DeleteCustomerRepository: repository,
+			Logger:                   dependencies.Logger,
+		},
+	)
+
+	// Credit Limit Usecases
+	saveCreditLimitInteractor := interactors.NewSaveCreditLimitInteractor(
+		interactors.SaveCreditLimitInteractorDependencies{
+			SaveCreditLimitRepository: repository,
+			Logger:                    dependencies.Logger,
+			Validator:                 dependencies.Validator,
+		},
+	)
+
+	getCustomerSummaryInteractor := interactors.NewGetCustomerSummaryInteractor(
+		interactors.GetCustomerSummaryInteractorDependencies{
+			GetCustomerSummaryRepository: repository,
+			Logger:                       dependencies.Logger,
+		},
+	)
+
+	// Statement Usecases
+	getStatementInteractor := interactors.NewGetStatementInteractor(
+		interactors.GetStatementInteractorDependencies{
+			GetStatementRepository: repository,
+			Logger:                 dependencies.Logger,
+			Validator:              dependencies.Validator,
+		},
+	)
+
+	// Customer Duplicate Usecases
+	getCustomerDuplicatesInteractor := interactors.NewGetCustomerDuplicatesInteractor(
+		interactors.GetCustomerDuplicatesInteractorDependencies{
+			GetCustomerDuplicatesRepository: repository,
+			Logger:                          dependencies.Logger,
+			Validator:                       dependencies.Validator,
+		},
+	)
+
+	dismissCustomerDuplicateInteractor := interactors.NewDismissCustomerDuplicateInteractor(
+		interactors.DismissCustomerDuplicateInteractorDependencies{
+			DismissCustomerDuplicateRepository: repository,
+			Logger:                             dependencies.Logger,
+			Validator:                          dependencies.Validator,
+		},
+	)
+
+	mergeCustomersInteractor := interactors.NewMergeCustomersInteractor(
+		interactors.MergeCustomersInteractorDependencies{
+			MergeCustomersRepository: repository,
+			Logger:                   dependencies.Logger,
+			Validator:                dependencies.Validator,
+			SnowflakeGen:             dependencies.SnowflakeGen,
+		},
+	)
+
+	getCustomerMergesInteractor := interactors.NewGetCustomerMergesInteractor(
+		interactors.GetCustomerMergesInteractorDependencies{
+			GetCustomerMergesRepository: repository,
+			Logger:                      dependencies.Logger,
+		},
+	)
+
+	// Customer Endpoint
+	customerEndpoint := delivery.NewCustomerEndpoint(
+		getCustomerInteractor,
+		updateCustomerInteractor,
+		deleteCustomerInteractor,
+		saveCreditLimitInteractor,
+		getCustomerSummaryInteractor,
+		getStatementInteractor,
+		getCustomerDuplicatesInteractor,
+		dismissCustomerDuplicateInteractor,
+		mergeCustomersInteractor,
+		getCustomerMergesInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewCustomerHTTPGateway(
+		dependencies.HttpRouter,
+		customerEndpoint,
+	)
+
+	// Loan Application Usecases
+	approveLoanInteractor := interactors.NewApproveLoanInteractor(
+		interactors.ApproveLoanInteractorDependencies{
+			ApproveLoanRepository: repository,
+			Logger:                dependencies.Logger,
+			Validator:             dependencies.Validator,
+			GuarantorProducts:     dependencies.Loan.GuarantorProducts,
+		},
+	)
+
+	rejectLoanInteractor := interactors.NewRejectLoanInteractor(
+		interactors.RejectLoanInteractorDependencies{
+			RejectLoanRepository: repository,
+			Logger:               dependencies.Logger,
+			Validator:            dependencies.Validator,
+		},
+	)
+
+	payoutProvider := newPayoutProvider(dependencies.Payout, dependencies.Logger)
+
+	disburseLoanInteractor := interactors.NewDisburseLoanInteractor(
+		interactors.DisburseLoanInteractorDependencies{
+			DisburseLoanRepository: repository,
+			PayoutProvider:         payoutProvider,
+			Logger:                 dependencies.Logger,
+			Validator:              dependencies.Validator,
+			SnowflakeGen:           dependencies.SnowflakeGen,
+		},
+	)
+
+	confirmDisbursementInteractor := interactors.NewConfirmDisbursementInteractor(
+		interactors.ConfirmDisbursementInteractorDependencies{
+			ConfirmDisbursementRepository: repository,
+			Logger:                        dependencies.Logger,
+			Validator:                     dependencies.Validator,
+			SnowflakeGen:                  dependencies.SnowflakeGen,
+		},
+	)
+
+	retryDisbursementInteractor := interactors.NewRetryDisbursementInteractor(
+		interactors.RetryDisbursementInteractorDependencies{
+			RetryDisbursementRepository: repository,
+			PayoutProvider:              payoutProvider,
+			Logger:                      dependencies.Logger,
+			Validator:                   dependencies.Validator,
+			SnowflakeGen:                dependencies.SnowflakeGen,
+		},
+	)
+
+	getLoanDisbursementInteractor := interactors.NewGetLoanDisbursementInteractor(
+		interactors.GetLoanDisbursementInteractorDependencies{
+			GetLoanDisbursementRepository: repository,
+			Logger:                        dependencies.Logger,
+		},
+	)
+
+	quoteLoanInteractor := interactors.NewQuoteLoanInteractor(
+		interactors.QuoteLoanInteractorDependencies{
+			QuoteLoanRepository: repository,
+			Logger:              dependencies.Logger,
+			Validator:           dependencies.Validator,
+		},
+	)
+
+	getLoanDisclosureInteractor := interactors.NewGetLoanDisclosureInteractor(
+		interactors.GetLoanDisclosureInteractorDependencies{
+			GetLoanDisclosureRepository: repository,
+			Logger:                      dependencies.Logger,
+		},
+	)
+
+	// Loan Application Endpoint
+	loanApplicationEndpoint := delivery.NewLoanApplicationEndpoint(
+		approveLoanInteractor,
+		rejectLoanInteractor,
+		disburseLoanInteractor,
+		confirmDisbursementInteractor,
+		retryDisbursementInteractor,
+		getLoanDisbursementInteractor,
+		quoteLoanInteractor,
+		getLoanDisclosureInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewLoanApplicationHTTPGateway(
+		dependencies.HttpRouter,
+		loanApplicationEndpoint,
+	)
+
+	// Fee Usecases
+	saveProductFeeInteractor := interactors.NewSaveProductFeeInteractor(
+		interactors.SaveProductFeeInteractorDependencies{
+			SaveProductFeeRepository: repository,
+			Logger:                   dependencies.Logger,
+			Validator:                dependencies.Validator,
+		},
+	)
+
+	getProductFeesInteractor := interactors.NewGetProductFeesInteractor(
+		interactors.GetProductFeesInteractorDependencies{
+			GetProductFeesRepository: repository,
+			Logger:                   dependencies.Logger,
+			Validator:                dependencies.Validator,
+		},
+	)
+
+	getLoanFeesInteractor := interactors.NewGetLoanFeesInteractor(
+		interactors.GetLoanFeesInteractorDependencies{
+			GetLoanFeesRepository: repository,
+			Logger:                dependencies.Logger,
+		},
+	)
+
+	// Fee Endpoint
+	feeEndpoint := delivery.NewFeeEndpoint(
+		saveProductFeeInteractor,
+		getProductFeesInteractor,
+		getLoanFeesInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewFeeHTTPGateway(
+		dependencies.HttpRouter,
+		feeEndpoint,
+	)
+
+	// Loan Servicing Usecases
+	restructureLoanInteractor := interactors.NewRestructureLoanInteractor(
+		interactors.RestructureLoanInteractorDependencies{
+			RestructureLoanRepository: repository,
+			Logger:                    dependencies.Logger,
+			Validator:                 dependencies.Validator,
+			SnowflakeGen:              dependencies.SnowflakeGen,
+		},
+	)
+
+	getLoanRestructuresInteractor := interactors.NewGetLoanRestructuresInteractor(
+		interactors.GetLoanRestructuresInteractorDependencies{
+			GetLoanRestructuresRepository: repository,
+			Logger:                        dependencies.Logger,
+		},
+	)
+
+	reversePaymentInteractor := interactors.NewReversePaymentInteractor(
+		interactors.ReversePaymentInteractorDependencies{
+			ReversePaymentRepository: repository,
+			Logger:                   dependencies.Logger,
+			Validator:                dependencies.Validator,
+			SnowflakeGen:             dependencies.SnowflakeGen,
+		},
+	)
+
+	getLoanStatusHistoryInteractor := interactors.NewGetLoanStatusHistoryInteractor(
+		interactors.GetLoanStatusHistoryInteractorDependencies{
+			GetLoanStatusHistoryRepository: repository,
+			Logger:                         dependencies.Logger,
+		},
+	)
+
+	getLoansInteractor := interactors.NewGetLoansInteractor(
+		interactors.GetLoansInteractorDependencies{
+			GetLoansRepository: repository,
+			Logger:             dependencies.Logger,
+			Validator:          dependencies.Validator,
+		},
+	)
+
+	// Loan Party Usecases
+	addLoanPartyInteractor := interactors.NewAddLoanPartyInteractor(
+		interactors.AddLoanPartyInteractorDependencies{
+			AddLoanPartyRepository: repository,
+			Logger:                 dependencies.Logger,
+			Validator:              dependencies.Validator,
+			SnowflakeGen:           dependencies.SnowflakeGen,
+		},
+	)
+
+	getLoanPartiesInteractor := interactors.NewGetLoanPartiesInteractor(
+		interactors.GetLoanPartiesInteractorDependencies{
+			GetLoanPartiesRepository: repository,
+			Logger:                   dependencies.Logger,
+		},
+	)
+
+	// Collateral Usecases
+	registerCollateralInteractor := interactors.NewRegisterCollateralInteractor(
+		interactors.RegisterCollateralInteractorDependencies{
+			RegisterCollateralRepository: repository,
+			Logger:                       dependencies.Logger,
+			Validator:                    dependencies.Validator,
+			SnowflakeGen:                 dependencies.SnowflakeGen,
+		},
+	)
+
+	revalueCollateralInteractor := interactors.NewRevalueCollateralInteractor(
+		interactors.RevalueCollateralInteractorDependencies{
+			RevalueCollateralRepository: repository,
+			Logger:                      dependencies.Logger,
+			Validator:                   dependencies.Validator,
+		},
+	)
+
+	updateCollateralLienInteractor := interactors.NewUpdateCollateralLienInteractor(
+		interactors.UpdateCollateralLienInteractorDependencies{
+			UpdateCollateralLienRepository: repository,
+			Logger:                         dependencies.Logger,
+			Validator:                      dependencies.Validator,
+		},
+	)
+
+	getLoanCollateralInteractor := interactors.NewGetLoanCollateralInteractor(
+		interactors.GetLoanCollateralInteractorDependencies{
+			GetLoanCollateralRepository: repository,
+			Logger:                      dependencies.Logger,
+		},
+	)
+
+	getCollateralReportInteractor := interactors.NewGetCollateralReportInteractor(
+		interactors.GetCollateralReportInteractorDependencies{
+			GetCollateralReportRepository: repository,
+			Logger:                        dependencies.Logger,
+		},
+	)
+
+	// Loan Servicing Endpoint
+	loanEndpoint := delivery.NewLoanEndpoint(
+		restructureLoanInteractor,
+		getLoanRestructuresInteractor,
+		reversePaymentInteractor,
+		getLoanStatusHistoryInteractor,
+		getLoansInteractor,
+		addLoanPartyInteractor,
+		getLoanPartiesInteractor,
+		registerCollateralInteractor,
+		revalueCollateralInteractor,
+		updateCollateralLienInteractor,
+		getLoanCollateralInteractor,
+		getCollateralReportInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewLoanHTTPGateway(
+		dependencies.HttpRouter,
+		loanEndpoint,
+	)
+
+	// Write-off Usecases
+	writeOffLoanInteractor := interactors.NewWriteOffLoanInteractor(
+		interactors.WriteOffLoanInteractorDependencies{
+			WriteOffLoanRepository: repository,
+			Logger:                 dependencies.Logger,
+			Validator:              dependencies.Validator,
+			SnowflakeGen:           dependencies.SnowflakeGen,
+		},
+	)
+
+	getLoanRecoveriesInteractor := interactors.NewGetLoanRecoveriesInteractor(
+		interactors.GetLoanRecoveriesInteractorDependencies{
+			GetLoanRecoveriesRepository: repository,
+			Logger:                      dependencies.Logger,
+		},
+	)
+
+	getRecoveryReportInteractor := interactors.NewGetRecoveryReportInteractor(
+		interactors.GetRecoveryReportInteractorDependencies{
+			GetRecoveryReportRepository: repository,
+			Logger:                      dependencies.Logger,
+			Validator:                   dependencies.Validator,
+		},
+	)
+
+	// Write-off Endpoint
+	writeOffEndpoint := delivery.NewWriteOffEndpoint(
+		writeOffLoanInteractor,
+		getLoanRecoveriesInteractor,
+		getRecoveryReportInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewWriteOffHTTPGateway(
+		dependencies.HttpRouter,
+		writeOffEndpoint,
+	)
+
+	// Ledger Usecases
+	getTrialBalanceInteractor := interactors.NewGetTrialBalanceInteractor(
+		interactors.GetTrialBalanceInteractorDependencies{
+			GetTrialBalanceRepository: repository,
+			Logger:                    dependencies.Logger,
+			Validator:                 dependencies.Validator,
+		},
+	)
+
+	getLoanJournalInteractor := interactors.NewGetLoanJournalInteractor(
+		interactors.GetLoanJournalInteractorDependencies{
+			GetLoanJournalRepository: repository,
+			Logger:                   dependencies.Logger,
+		},
+	)
+
+	// Ledger Endpoint
+	ledgerEndpoint := delivery.NewLedgerEndpoint(
+		getTrialBalanceInteractor,
+		getLoanJournalInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewLedgerHTTPGateway(
+		dependencies.HttpRouter,
+		ledgerEndpoint,
+	)
+
+	// GL Export Usecases
+	getGLMappingsInteractor := interactors.NewGetGLMappingsInteractor(
+		interactors.GetGLMappingsInteractorDependencies{
+			GetGLMappingsRepository: repository,
+			Logger:                  dependencies.Logger,
+		},
+	)
+
+	saveGLMappingInteractor := interactors.NewSaveGLMappingInteractor(
+		interactors.SaveGLMappingInteractorDependencies{
+			SaveGLMappingRepository: repository,
+			Logger:                  dependencies.Logger,
+			Validator:               dependencies.Validator,
+		},
+	)
+
+	exportGLJournalInteractor := interactors.NewExportGLJournalInteractor(
+		interactors.ExportGLJournalInteractorDependencies{
+			ExportGLJournalRepository: repository,
+			Logger:                    dependencies.Logger,
+			Validator:                 dependencies.Validator,
+		},
+	)
+
+	// GL Export Endpoint
+	glExportEndpoint := delivery.NewGLExportEndpoint(
+		getGLMappingsInteractor,
+		saveGLMappingInteractor,
+		exportGLJournalInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewGLExportHTTPGateway(
+		dependencies.HttpRouter,
+		glExportEndpoint,
+	)
+
+	// Provisioning Usecases
+	runProvisioningInteractor := interactors.NewRunProvisioningInteractor(
+		interactors.RunProvisioningInteractorDependencies{
+			RunProvisioningRepository: repository,
+			Logger:                    dependencies.Logger,
+			Validator:                 dependencies.Validator,
+			SnowflakeGen:              dependencies.SnowflakeGen,
+		},
+	)
+
+	getProvisioningReportInteractor := interactors.NewGetProvisioningReportInteractor(
+		interactors.GetProvisioningReportInteractorDependencies{
+			GetProvisioningReportRepository: repository,
+			Logger:                          dependencies.Logger,
+			Validator:                       dependencies.Validator,
+		},
+	)
+
+	getProvisionRatesInteractor := interactors.NewGetProvisionRatesInteractor(
+		interactors.GetProvisionRatesInteractorDependencies{
+			GetProvisionRatesRepository: repository,
+			Logger:                      dependencies.Logger,
+		},
+	)
+
+	saveProvisionRateInteractor := interactors.NewSaveProvisionRateInteractor(
+		interactors.SaveProvisionRateInteractorDependencies{
+			SaveProvisionRateRepository: repository,
+			Logger:                      dependencies.Logger,
+			Validator:                   dependencies.Validator,
+		},
+	)
+
+	// Provisioning Endpoint
+	provisioningEndpoint := delivery.NewProvisioningEndpoint(
+		runProvisioningInteractor,
+		getProvisioningReportInteractor,
+		getProvisionRatesInteractor,
+		saveProvisionRateInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewProvisioningHTTPGateway(
+		dependencies.HttpRouter,
+		provisioningEndpoint,
+	)
+
+	// Accounting Period Usecases
+	getAccountingPeriodsInteractor := interactors.NewGetAccountingPeriodsInteractor(
+		interactors.GetAccountingPeriodsInteractorDependencies{
+			GetAccountingPeriodsRepository: repository,
+			Logger:                         dependencies.Logger,
+		},
+	)
+
+	checkAccountingPeriodInteractor := interactors.NewCheckAccountingPeriodInteractor(
+		interactors.CheckAccountingPeriodInteractorDependencies{
+			CheckAccountingPeriodRepository: repository,
+			Logger:                          dependencies.Logger,
+			Validator:                       dependencies.Validator,
+		},
+	)
+
+	closeAccountingPeriodInteractor := interactors.NewCloseAccountingPeriodInteractor(
+		interactors.CloseAccountingPeriodInteractorDependencies{
+			CloseAccountingPeriodRepository: repository,
+			Logger:                          dependencies.Logger,
+			Validator:                       dependencies.Validator,
+		},
+	)
+
+	// Accounting Period Endpoint
+	accountingPeriodEndpoint := delivery.NewAccountingPeriodEndpoint(
+		getAccountingPeriodsInteractor,
+		checkAccountingPeriodInteractor,
+		closeAccountingPeriodInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewAccountingPeriodHTTPGateway(
+		dependencies.HttpRouter,
+		accountingPeriodEndpoint,
+	)
+
+	// Interest Accrual Usecases
+	runInterestAccrualInteractor := interactors.NewRunInterestAccrualInteractor(
+		interactors.RunInterestAccrualInteractorDependencies{
+			RunInterestAccrualRepository: repository,
+			Logger:                       dependencies.Logger,
+			Validator:                    dependencies.Validator,
+			SnowflakeGen:                 dependencies.SnowflakeGen,
+		},
+	)
+
+	getInterestAccrualsInteractor := interactors.NewGetInterestAccrualsInteractor(
+		interactors.GetInterestAccrualsInteractorDependencies{
+			GetInterestAccrualsRepository: repository,
+			Logger:                        dependencies.Logger,
+			Validator:                     dependencies.Validator,
+		},
+	)
+
+	// Interest Accrual Endpoint
+	accrualEndpoint := delivery.NewAccrualEndpoint(
+		runInterestAccrualInteractor,
+		getInterestAccrualsInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewAccrualHTTPGateway(
+		dependencies.HttpRouter,
+		accrualEndpoint,
+	)
+
+	// Moratorium Usecases
+	declareMoratoriumInteractor := interactors.NewDeclareMoratoriumInteractor(
+		interactors.DeclareMoratoriumInteractorDependencies{
+			DeclareMoratoriumRepository: repository,
+			Logger:                      dependencies.Logger,
+			Validator:                   dependencies.Validator,
+			SnowflakeGen:                dependencies.SnowflakeGen,
+		},
+	)
+
+	getMoratoriumInteractor := interactors.NewGetMoratoriumInteractor(
+		interactors.GetMoratoriumInteractorDependencies{
+			GetMoratoriumRepository: repository,
+			Logger:                  dependencies.Logger,
+		},
+	)
+
+	// Moratorium Endpoint
+	moratoriumEndpoint := delivery.NewMoratoriumEndpoint(
+		declareMoratoriumInteractor,
+		getMoratoriumInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewMoratoriumHTTPGateway(
+		dependencies.HttpRouter,
+		moratoriumEndpoint,
+	)
+
+	// Credit Line Usecases
+	openCreditLineInteractor := interactors.NewOpenCreditLineInteractor(
+		interactors.OpenCreditLineInteractorDependencies{
+			OpenCreditLineRepository: repository,
+			Logger:                   dependencies.Logger,
+			Validator:                dependencies.Validator,
+			SnowflakeGen:             dependencies.SnowflakeGen,
+		},
+	)
+
+	drawCreditLineInteractor := interactors.NewDrawCreditLineInteractor(
+		interactors.DrawCreditLineInteractorDependencies{
+			DrawCreditLineRepository: repository,
+			Logger:                   dependencies.Logger,
+			Validator:                dependencies.Validator,
+			SnowflakeGen:             dependencies.SnowflakeGen,
+		},
+	)
+
+	getCreditLineInteractor := interactors.NewGetCreditLineInteractor(
+		interactors.GetCreditLineInteractorDependencies{
+			GetCreditLineRepository: repository,
+			Logger:                  dependencies.Logger,
+		},
+	)
+
+	generateCreditLineBillInteractor := interactors.NewGenerateCreditLineBillInteractor(
+		interactors.GenerateCreditLineBillInteractorDependencies{
+			GenerateCreditLineBillRepository: repository,
+			Logger:                           dependencies.Logger,
+			Validator:                        dependencies.Validator,
+			SnowflakeGen:                     dependencies.SnowflakeGen,
+		},
+	)
+
+	payCreditLineBillInteractor := interactors.NewPayCreditLineBillInteractor(
+		interactors.PayCreditLineBillInteractorDependencies{
+			PayCreditLineBillRepository: repository,
+			Logger:                      dependencies.Logger,
+			Validator:                   dependencies.Validator,
+			SnowflakeGen:                dependencies.SnowflakeGen,
+		},
+	)
+
+	markOverdueCreditLineBillsInteractor := interactors.NewMarkOverdueCreditLineBillsInteractor(
+		interactors.MarkOverdueCreditLineBillsInteractorDependencies{
+			MarkOverdueCreditLineBillsRepository: repository,
+			Logger:                               dependencies.Logger,
+			Validator:                            dependencies.Validator,
+		},
+	)
+
+	getCreditLineBillsInteractor := interactors.NewGetCreditLineBillsInteractor(
+		interactors.GetCreditLineBillsInteractorDependencies{
+			GetCreditLineBillsRepository: repository,
+			Logger:                       dependencies.Logger,
+		},
+	)
+
+	// Credit Line Endpoint
+	creditLineEndpoint := delivery.NewCreditLineEndpoint(
+		openCreditLineInteractor,
+		drawCreditLineInteractor,
+		getCreditLineInteractor,
+		generateCreditLineBillInteractor,
+		payCreditLineBillInteractor,
+		markOverdueCreditLineBillsInteractor,
+		getCreditLineBillsInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewCreditLineHTTPGateway(
+		dependencies.HttpRouter,
+		creditLineEndpoint,
+	)
+
+	// Merchant Usecases
+	createMerchantInteractor := interactors.NewCreateMerchantInteractor(
+		interactors.CreateMerchantInteractorDependencies{
+			CreateMerchantRepository: repository,
+			Logger:                   dependencies.Logger,
+			Validator:                dependencies.Validator,
+			SnowflakeGen:             dependencies.SnowflakeGen,
+		},
+	)
+
+	getMerchantInteractor := interactors.NewGetMerchantInteractor(
+		interactors.GetMerchantInteractorDependencies{
+			GetMerchantRepository: repository,
+			Logger:                dependencies.Logger,
+		},
+	)
+
+	getMerchantSettlementsInteractor := interactors.NewGetMerchantSettlementsInteractor(
+		interactors.GetMerchantSettlementsInteractorDependencies{
+			GetMerchantSettlementsRepository: repository,
+			Logger:                           dependencies.Logger,
+		},
+	)
+
+	generateMerchantSettlementsInteractor := interactors.NewGenerateMerchantSettlementsInteractor(
+		interactors.GenerateMerchantSettlementsInteractorDependencies{
+			GenerateMerchantSettlementsRepository: repository,
+			Logger:                                dependencies.Logger,
+			Validator:                             dependencies.Validator,
+			SnowflakeGen:                          dependencies.SnowflakeGen,
+		},
+	)
+
+	updateMerchantSettlementStatusInteractor := interactors.NewUpdateMerchantSettlementStatusInteractor(
+		interactors.UpdateMerchantSettlementStatusInteractorDependencies{
+			UpdateMerchantSettlementStatusRepository: repository,
+			Logger:                                   dependencies.Logger,
+			Validator:                                dependencies.Validator,
+			SnowflakeGen:                             dependencies.SnowflakeGen,
+		},
+	)
+
+	getMerchantSettlementInteractor := interactors.NewGetMerchantSettlementInteractor(
+		interactors.GetMerchantSettlementInteractorDependencies{
+			GetMerchantSettlementRepository: repository,
+			Logger:                          dependencies.Logger,
+		},
+	)
+
+	exportMerchantSettlementsInteractor := interactors.NewExportMerchantSettlementsInteractor(
+		interactors.ExportMerchantSettlementsInteractorDependencies{
+			ExportMerchantSettlementsRepository: repository,
+			Logger:                              dependencies.Logger,
+			Validator:                           dependencies.Validator,
+		},
+	)
+
+	// Merchant Endpoint
+	merchantEndpoint := delivery.NewMerchantEndpoint(
+		createMerchantInteractor,
+		getMerchantInteractor,
+		getMerchantSettlementsInteractor,
+		generateMerchantSettlementsInteractor,
+		updateMerchantSettlementStatusInteractor,
+		getMerchantSettlementInteractor,
+		exportMerchantSettlementsInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewMerchantHTTPGateway(
+		dependencies.HttpRouter,
+		merchantEndpoint,
+	)
+
+	// Collection Usecases
+	createCollectionAgentInteractor := interactors.NewCreateCollectionAgentInteractor(
+		interactors.CreateCollectionAgentInteractorDependencies{
+			CreateCollectionAgentRepository: repository,
+			Logger:                          dependencies.Logger,
+			Validator:                       dependencies.Validator,
+			SnowflakeGen:                    dependencies.SnowflakeGen,
+		},
+	)
+
+	generateCollectionCasesInteractor := interactors.NewGenerateCollectionCasesInteractor(
+		interactors.GenerateCollectionCasesInteractorDependencies{
+			GenerateCollectionCasesRepository: repository,
+			Logger:                            dependencies.Logger,
+			Validator:                         dependencies.Validator,
+			SnowflakeGen:                      dependencies.SnowflakeGen,
+		},
+	)
+
+	getCollectionCaseInteractor := interactors.NewGetCollectionCaseInteractor(
+		interactors.GetCollectionCaseInteractorDependencies{
+			GetCollectionCaseRepository: repository,
+			Logger:                      dependencies.Logger,
+		},
+	)
+
+	getCollectionCasesByAgentInteractor := interactors.NewGetCollectionCasesByAgentInteractor(
+		interactors.GetCollectionCasesByAgentInteractorDependencies{
+			GetCollectionCasesByAgentRepository: repository,
+			Logger:                              dependencies.Logger,
+		},
+	)
+
+	logContactAttemptInteractor := interactors.NewLogContactAttemptInteractor(
+		interactors.LogContactAttemptInteractorDependencies{
+			LogContactAttemptRepository: repository,
+			Logger:                      dependencies.Logger,
+			Validator:                   dependencies.Validator,
+			SnowflakeGen:                dependencies.SnowflakeGen,
+		},
+	)
+
+	evaluatePromisesToPayInteractor := interactors.NewEvaluatePromisesToPayInteractor(
+		interactors.EvaluatePromisesToPayInteractorDependencies{
+			EvaluatePromisesToPayRepository: repository,
+			Logger:                          dependencies.Logger,
+			Validator:                       dependencies.Validator,
+		},
+	)
+
+	// Collection Endpoint
+	collectionEndpoint := delivery.NewCollectionEndpoint(
+		createCollectionAgentInteractor,
+		generateCollectionCasesInteractor,
+		getCollectionCaseInteractor,
+		getCollectionCasesByAgentInteractor,
+		logContactAttemptInteractor,
+		evaluatePromisesToPayInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewCollectionHTTPGateway(
+		dependencies.HttpRouter,
+		collectionEndpoint,
+	)
+
+	// Notification Usecases
+	dispatchNotificationsInteractor := interactors.NewDispatchNotificationsInteractor(
+		interactors.DispatchNotificationsInteractorDependencies{
+			DispatchNotificationsRepository: repository,
+			NotificationProviders:           newNotificationProviders(dependencies.Notification, dependencies.Logger),
+			Logger:                          dependencies.Logger,
+			Validator:                       dependencies.Validator,
+			SnowflakeGen:                    dependencies.SnowflakeGen,
+		},
+	)
+
+	getLoanCommunicationsInteractor := interactors.NewGetLoanCommunicationsInteractor(
+		interactors.GetLoanCommunicationsInteractorDependencies{
+			GetLoanCommunicationsRepository: repository,
+			Logger:                          dependencies.Logger,
+		},
+	)
+
+	// Notification Endpoint
+	notificationEndpoint := delivery.NewNotificationEndpoint(
+		dispatchNotificationsInteractor,
+		getLoanCommunicationsInteractor,
+		dependencies.Logger,
+		dependencies.Validator,
+	)
+
+	delivery.NewNotificationHTTPGateway(
+		dependencies.HttpRouter,
+		notificationEndpoint,
+	)
+
 	return &Exposed{}
 }
+
+func newNotificationProviders(config NotificationConfig, logger *zap.SugaredLogger) []interactors.NotificationProvider {
+	var providers []interactors.NotificationProvider
+
+	if config.SMTPHost != "" {
+		providers = append(providers, notification.NewSMTPEmailProvider(notification.SMTPConfig{
+			Host:     config.SMTPHost,
+			Port:     config.SMTPPort,
+			Username: config.SMTPUsername,
+			Password: config.SMTPPassword,
+			From:     config.SMTPFrom,
+		}))
+	} else {
+		providers = append(providers, newFakeNotificationProvider(entity.NOTIFICATION_CHANNEL_EMAIL, logger))
+	}
+
+	if config.SMSEndpoint != "" {
+		providers = append(providers, notification.NewSMSProvider(notification.HTTPMessageConfig{
+			Endpoint: config.SMSEndpoint,
+			APIKey:   config.SMSAPIKey,
+			Sender:   config.SMSSender,
+		}))
+	} else {
+		providers = append(providers, newFakeNotificationProvider(entity.NOTIFICATION_CHANNEL_SMS, logger))
+	}
+
+	if config.WhatsAppEndpoint != "" {
+		providers = append(providers, notification.NewWhatsAppProvider(notification.HTTPMessageConfig{
+			Endpoint: config.WhatsAppEndpoint,
+			APIKey:   config.WhatsAppAPIKey,
+			Sender:   config.WhatsAppSender,
+		}))
+	} else {
+		providers = append(providers, newFakeNotificationProvider(entity.NOTIFICATION_CHANNEL_WHATSAPP, logger))
+	}
+
+	return providers
+}
+
+func newPayoutProvider(config PayoutConfig, logger *zap.SugaredLogger) interactors.PayoutProvider {
+	if config.Endpoint != "" {
+		return payout.NewHTTPProvider(payout.HTTPConfig{
+			Endpoint: config.Endpoint,
+			APIKey:   config.APIKey,
+		})
+	}
+
+	logger.Warnw("payout gateway not configured, payouts are completed without paying anything out")
+
+	return payout.NewFakeProvider(logger)
+}
+
+// newFakeNotificationProvider stands in for an unconfigured channel, warning
+// that its messages are only logged.
+func newFakeNotificationProvider(channel entity.NotificationChannel, logger *zap.SugaredLogger) *notification.FakeProvider {
+	logger.Warnw("notification channel not configured, its messages are only logged", "channel", channel)
+
+	return notification.NewFakeProvider(channel, logger)
+}
@@ -5,11 +5,16 @@ type Code int
 
 const (
 	Generic Code = iota
+
+	// IllegalLoanTransition is returned when a loan is moved to a status its
+	// current status cannot lead to.
+	IllegalLoanTransition
 )
 
 func codeMessage() map[Code]string {
 	return map[Code]string{
-		Generic: "Error",
+		Generic:               "Error",
+		IllegalLoanTransition: "Illegal loan status transition",
 	}
 }
 
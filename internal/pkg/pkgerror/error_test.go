@@ -135,6 +135,17 @@ func Test_ErrorFrom(t *testing.T) {
 			expectedBool:    true,
 			expectedMessage: "some error",
 		},
+		{
+			name: "illegal loan transition business error code",
+			errProvider: func() error {
+				return NewBusinessErrorCode(IllegalLoanTransition)
+			},
+			matcherFunc: func(err error) bool {
+				return IsBusinessError(err)
+			},
+			expectedBool:    true,
+			expectedMessage: "Illegal loan status transition",
+		},
 		{
 			name: "unknown business error code",
 			errProvider: func() error {
@@ -20,6 +20,54 @@ func (_m *MockGoquBuilder) EXPECT() *MockGoquBuilder_Expecter {
 	return &MockGoquBuilder_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function with given fields: table
+func (_m *MockGoquBuilder) Delete(table interface{}) *goqu.DeleteDataset {
+	ret := _m.Called(table)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *goqu.DeleteDataset
+	if rf, ok := ret.Get(0).(func(interface{}) *goqu.DeleteDataset); ok {
+		r0 = rf(table)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*goqu.DeleteDataset)
+		}
+	}
+
+	return r0
+}
+
+// MockGoquBuilder_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockGoquBuilder_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - table interface{}
+func (_e *MockGoquBuilder_Expecter) Delete(table interface{}) *MockGoquBuilder_Delete_Call {
+	return &MockGoquBuilder_Delete_Call{Call: _e.mock.On("Delete", table)}
+}
+
+func (_c *MockGoquBuilder_Delete_Call) Run(run func(table interface{})) *MockGoquBuilder_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockGoquBuilder_Delete_Call) Return(_a0 *goqu.DeleteDataset) *MockGoquBuilder_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGoquBuilder_Delete_Call) RunAndReturn(run func(interface{}) *goqu.DeleteDataset) *MockGoquBuilder_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // From provides a mock function with given fields: from
 func (_m *MockGoquBuilder) From(from ...interface{}) *goqu.SelectDataset {
 	var _ca []interface{}
@@ -0,0 +1,124 @@
+package pkgpdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Page layout of an A4 portrait page, in points.
+const (
+	pageWidth  = 595
+	pageHeight = 842
+	margin     = 40
+	fontSize   = 8
+	leading    = 11
+)
+
+// LinesPerPage is the number of lines that fit on a page.
+const LinesPerPage = (pageHeight - 2*margin) / leading
+
+// Document is a text only PDF document set in the standard Courier font, so
+// columns padded with spaces line up. It is written without any external
+// dependency.
+type Document struct {
+	title string
+	pages [][]string
+}
+
+func NewDocument(title string) *Document {
+	return &Document{title: title}
+}
+
+// AddLine writes a line at the end of the document, on a new page when the
+// last one is full.
+func (d *Document) AddLine(text string) {
+	if len(d.pages) == 0 || len(d.pages[len(d.pages)-1]) == LinesPerPage {
+		d.pages = append(d.pages, nil)
+	}
+
+	d.pages[len(d.pages)-1] = append(d.pages[len(d.pages)-1], text)
+}
+
+// AddPage starts a new page, the next line is written at its top.
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, nil)
+}
+
+// Bytes renders the document. A document without lines has a single blank
+// page.
+func (d *Document) Bytes() []byte {
+	pages := d.pages
+	if len(pages) == 0 {
+		pages = [][]string{nil}
+	}
+
+	// Objects 1 to 4 are the catalog, the page tree, the font and the
+	// document information, then every page is followed by its content.
+	var objects []string
+	pageRefs := make([]string, len(pages))
+	for i := range pages {
+		pageRefs[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(pageRefs, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Title (%s) /Producer (billing-engine) >>", escape(d.title)),
+	)
+
+	for i, lines := range pages {
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", fontSize, leading, margin, pageHeight-margin-fontSize)
+		for _, line := range lines {
+			fmt.Fprintf(&content, "(%s) Tj T*\n", escape(line))
+		}
+		content.WriteString("ET")
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+				pageWidth, pageHeight, 6+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 4 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return buf.Bytes()
+}
+
+// escape makes text safe inside a PDF string. Characters outside Latin-1,
+// which the standard fonts cannot show, are replaced with a question mark.
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\t':
+			b.WriteString("    ")
+		case r < 0x20 || r > 0xff:
+			b.WriteByte('?')
+		default:
+			b.WriteByte(byte(r))
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,76 @@
+package pkgpdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Document_Bytes(t *testing.T) {
+	tests := []struct {
+		name          string
+		lines         int
+		expectedPages int
+	}{
+		{
+			name:          "empty document has a blank page",
+			lines:         0,
+			expectedPages: 1,
+		},
+		{
+			name:          "lines fitting on one page",
+			lines:         LinesPerPage,
+			expectedPages: 1,
+		},
+		{
+			name:          "lines overflowing onto a second page",
+			lines:         LinesPerPage + 1,
+			expectedPages: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document := NewDocument("Statement")
+			for i := 0; i < tt.lines; i++ {
+				document.AddLine(fmt.Sprintf("line %d", i+1))
+			}
+
+			content := document.Bytes()
+
+			assert.True(t, bytes.HasPrefix(content, []byte("%PDF-1.4\n")))
+			assert.True(t, bytes.HasSuffix(content, []byte("%%EOF\n")))
+			assert.Contains(t, string(content), fmt.Sprintf("/Count %d", tt.expectedPages))
+			assertXrefOffsets(t, content)
+		})
+	}
+}
+
+func Test_escape(t *testing.T) {
+	assert.Equal(t, `Fee \(admin\) \\ 100`, escape(`Fee (admin) \ 100`))
+	assert.Equal(t, "Rp ?", escape("Rp €"))
+}
+
+// assertXrefOffsets checks every offset of the cross-reference table points
+// at the start of its object.
+func assertXrefOffsets(t *testing.T, content []byte) {
+	t.Helper()
+
+	start := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(content)
+	if !assert.NotNil(t, start) {
+		return
+	}
+
+	xref, _ := strconv.Atoi(string(start[1]))
+	assert.True(t, bytes.HasPrefix(content[xref:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(content[xref:], -1)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		assert.True(t, bytes.HasPrefix(content[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+}
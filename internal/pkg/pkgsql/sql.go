@@ -13,4 +13,5 @@ type GoquBuilder interface {
 	Insert(table interface{}) *goqu.InsertDataset
 	Select(cols ...interface{}) *goqu.SelectDataset
 	Update(table interface{}) *goqu.UpdateDataset
+	Delete(table interface{}) *goqu.DeleteDataset
 }
@@ -0,0 +1,63 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS collection_agents (
+    id BIGINT NOT NULL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    bucket VARCHAR(20) NOT NULL CHECK (bucket IN ('DPD_1_30', 'DPD_31_60', 'DPD_61_90', 'DPD_90_UP', 'ANY')),
+    active BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+CREATE TABLE IF NOT EXISTS collection_cases (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    customer_id BIGINT NOT NULL, -- FK to customers.id
+    agent_id BIGINT NOT NULL, -- FK to collection_agents.id
+    bucket VARCHAR(20) NOT NULL,
+    days_past_due INT NOT NULL,
+    missed_installments INT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('OPEN', 'CLOSED')),
+    opened_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS contact_attempts (
+    id BIGINT NOT NULL PRIMARY KEY,
+    case_id BIGINT NOT NULL, -- FK to collection_cases.id
+    agent_id BIGINT NOT NULL, -- FK to collection_agents.id
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('CALL', 'SMS', 'WHATSAPP', 'EMAIL', 'VISIT')),
+    outcome VARCHAR(20) NOT NULL CHECK (outcome IN ('NO_ANSWER', 'REACHED', 'PROMISE_TO_PAY', 'REFUSED', 'WRONG_NUMBER')),
+    notes TEXT NOT NULL DEFAULT '',
+    attempted_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS promises_to_pay (
+    id BIGINT NOT NULL PRIMARY KEY,
+    case_id BIGINT NOT NULL, -- FK to collection_cases.id
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    promised_date DATE NOT NULL,
+    promised_amount DECIMAL(18,2) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'KEPT', 'BROKEN')),
+    created_at TIMESTAMP NOT NULL
+);
+
+-- Only one open collection case per loan
+CREATE UNIQUE INDEX IF NOT EXISTS idx_collection_cases_open_loan
+ON collection_cases (loan_id)
+WHERE status = 'OPEN';
+
+CREATE INDEX IF NOT EXISTS idx_collection_cases_agent_id_opened_at
+ON collection_cases (agent_id, opened_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_contact_attempts_case_id
+ON contact_attempts (case_id, attempted_at);
+
+CREATE INDEX IF NOT EXISTS idx_promises_to_pay_status_promised_date
+ON promises_to_pay (status, promised_date);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_promises_to_pay_status_promised_date;
+DROP INDEX IF EXISTS idx_contact_attempts_case_id;
+DROP INDEX IF EXISTS idx_collection_cases_agent_id_opened_at;
+DROP INDEX IF EXISTS idx_collection_cases_open_loan;
+DROP TABLE IF EXISTS promises_to_pay;
+DROP TABLE IF EXISTS contact_attempts;
+DROP TABLE IF EXISTS collection_cases;
+DROP TABLE IF EXISTS collection_agents;
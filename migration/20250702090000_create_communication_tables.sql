@@ -0,0 +1,36 @@
+-- +goose Up
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS phone VARCHAR(20) NULL;
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS language VARCHAR(2) NOT NULL DEFAULT 'id' CHECK (language IN ('id', 'en'));
+
+CREATE TABLE IF NOT EXISTS communications (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    customer_id BIGINT NOT NULL, -- FK to customers.id
+    installment_id BIGINT NULL, -- FK to installments.id, empty for loan level events
+    event VARCHAR(30) NOT NULL CHECK (event IN ('REMINDER_T_MINUS_2', 'REMINDER_DUE_DAY', 'MISSED_PAYMENT', 'DELINQUENCY_ESCALATION')),
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('EMAIL', 'SMS', 'WHATSAPP')),
+    language VARCHAR(2) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('SENT', 'FAILED')),
+    provider_message_id VARCHAR(255) NOT NULL DEFAULT '',
+    error_message TEXT NOT NULL DEFAULT '',
+    event_key VARCHAR(255) NOT NULL,
+    sent_at TIMESTAMP NOT NULL
+);
+
+-- Every event is delivered at most once per channel, failed attempts may repeat.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_communications_sent_event_key
+ON communications (event_key)
+WHERE status = 'SENT';
+
+CREATE INDEX IF NOT EXISTS idx_communications_loan_id_sent_at
+ON communications (loan_id, sent_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_communications_loan_id_sent_at;
+DROP INDEX IF EXISTS idx_communications_sent_event_key;
+DROP TABLE IF EXISTS communications;
+ALTER TABLE customers DROP COLUMN IF EXISTS language;
+ALTER TABLE customers DROP COLUMN IF EXISTS phone;
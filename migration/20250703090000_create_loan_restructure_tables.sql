@@ -0,0 +1,37 @@
+-- +goose Up
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS restructured_from_loan_id BIGINT NULL; -- FK to loans.id
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS loans_status_check;
+ALTER TABLE loans ADD CONSTRAINT loans_status_check CHECK (status IN ('DISBURSED', 'PAID', 'RESTRUCTURED'));
+
+ALTER TABLE installments DROP CONSTRAINT IF EXISTS installments_status_check;
+ALTER TABLE installments ADD CONSTRAINT installments_status_check CHECK (status IN ('PENDING', 'PAID', 'MISSED', 'CLOSED'));
+
+CREATE TABLE IF NOT EXISTS loan_restructures (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL, -- FK to loans.id, the restructured loan
+    new_loan_id BIGINT NOT NULL, -- FK to loans.id, the loan carrying the new schedule
+    type VARCHAR(30) NOT NULL CHECK (type IN ('EXTEND_TERM', 'REDUCE_INSTALLMENT', 'CAPITALISE_ARREARS')),
+    outstanding_amount DECIMAL(18,2) NOT NULL,
+    arrears_amount DECIMAL(18,2) NOT NULL,
+    closed_installments INT NOT NULL,
+    new_term_weeks INT NOT NULL,
+    new_installment_amount DECIMAL(18,2) NOT NULL,
+    reason TEXT NOT NULL,
+    restructured_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_loan_restructures_loan_id
+ON loan_restructures (loan_id);
+
+CREATE INDEX IF NOT EXISTS idx_loan_restructures_new_loan_id
+ON loan_restructures (new_loan_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_loan_restructures_new_loan_id;
+DROP INDEX IF EXISTS idx_loan_restructures_loan_id;
+DROP TABLE IF EXISTS loan_restructures;
+ALTER TABLE installments DROP CONSTRAINT IF EXISTS installments_status_check;
+ALTER TABLE installments ADD CONSTRAINT installments_status_check CHECK (status IN ('PENDING', 'PAID', 'MISSED'));
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS loans_status_check;
+ALTER TABLE loans ADD CONSTRAINT loans_status_check CHECK (status IN ('DISBURSED', 'PAID'));
+ALTER TABLE loans DROP COLUMN IF EXISTS restructured_from_loan_id;
@@ -0,0 +1,47 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS moratoriums (
+    id BIGINT NOT NULL PRIMARY KEY,
+    scope VARCHAR(20) NOT NULL CHECK (scope IN ('LOAN', 'SEGMENT')),
+    loan_id BIGINT NULL, -- FK to loans.id, set for LOAN scope
+    segment_start_from DATE NULL,
+    segment_start_to DATE NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    accrue_interest BOOLEAN NOT NULL DEFAULT FALSE,
+    reason TEXT NOT NULL,
+    shift_weeks INT NOT NULL,
+    affected_loans INT NOT NULL,
+    affected_installments INT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    CHECK (end_date >= start_date)
+);
+
+CREATE TABLE IF NOT EXISTS moratorium_installments (
+    id BIGINT NOT NULL PRIMARY KEY,
+    moratorium_id BIGINT NOT NULL, -- FK to moratoriums.id
+    installment_id BIGINT NOT NULL, -- FK to installments.id
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    week_number INT NOT NULL,
+    previous_status VARCHAR(20) NOT NULL,
+    original_due_date DATE NOT NULL,
+    new_due_date DATE NOT NULL,
+    original_amount DECIMAL(18,2) NOT NULL,
+    new_amount DECIMAL(18,2) NOT NULL,
+    accrued_interest DECIMAL(18,2) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_moratoriums_start_date_end_date
+ON moratoriums (start_date, end_date);
+
+CREATE INDEX IF NOT EXISTS idx_moratorium_installments_moratorium_id
+ON moratorium_installments (moratorium_id, loan_id);
+
+CREATE INDEX IF NOT EXISTS idx_moratorium_installments_loan_id
+ON moratorium_installments (loan_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_moratorium_installments_loan_id;
+DROP INDEX IF EXISTS idx_moratorium_installments_moratorium_id;
+DROP INDEX IF EXISTS idx_moratoriums_start_date_end_date;
+DROP TABLE IF EXISTS moratorium_installments;
+DROP TABLE IF EXISTS moratoriums;
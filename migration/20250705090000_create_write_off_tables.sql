@@ -0,0 +1,42 @@
+-- +goose Up
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS loans_status_check;
+ALTER TABLE loans ADD CONSTRAINT loans_status_check CHECK (status IN ('DISBURSED', 'PAID', 'RESTRUCTURED', 'WRITTEN_OFF'));
+
+ALTER TABLE installments DROP CONSTRAINT IF EXISTS installments_status_check;
+ALTER TABLE installments ADD CONSTRAINT installments_status_check CHECK (status IN ('PENDING', 'PAID', 'MISSED', 'CLOSED', 'WRITTEN_OFF'));
+
+CREATE TABLE IF NOT EXISTS write_offs (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL UNIQUE, -- FK to loans.id
+    customer_id BIGINT NOT NULL, -- FK to customers.id
+    principal_amount DECIMAL(18,2) NOT NULL,
+    interest_amount DECIMAL(18,2) NOT NULL,
+    fee_amount DECIMAL(18,2) NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL,
+    written_off_by VARCHAR(100) NOT NULL,
+    written_off_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS recoveries (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    write_off_id BIGINT NOT NULL, -- FK to write_offs.id
+    amount DECIMAL(18,2) NOT NULL CHECK (amount > 0),
+    received_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_recoveries_loan_id
+ON recoveries (loan_id, received_at);
+
+CREATE INDEX IF NOT EXISTS idx_recoveries_received_at
+ON recoveries (received_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_recoveries_received_at;
+DROP INDEX IF EXISTS idx_recoveries_loan_id;
+DROP TABLE IF EXISTS recoveries;
+DROP TABLE IF EXISTS write_offs;
+ALTER TABLE installments DROP CONSTRAINT IF EXISTS installments_status_check;
+ALTER TABLE installments ADD CONSTRAINT installments_status_check CHECK (status IN ('PENDING', 'PAID', 'MISSED', 'CLOSED'));
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS loans_status_check;
+ALTER TABLE loans ADD CONSTRAINT loans_status_check CHECK (status IN ('DISBURSED', 'PAID', 'RESTRUCTURED'));
@@ -0,0 +1,56 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS ledger_accounts (
+    code VARCHAR(50) NOT NULL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('ASSET', 'LIABILITY', 'EQUITY', 'INCOME', 'EXPENSE'))
+);
+
+INSERT INTO ledger_accounts (code, name, type) VALUES
+    ('CASH', 'Cash', 'ASSET'),
+    ('PRINCIPAL_RECEIVABLE', 'Loan principal receivable', 'ASSET'),
+    ('FEE_RECEIVABLE', 'Fee receivable', 'ASSET'),
+    ('INTEREST_INCOME', 'Interest income', 'INCOME'),
+    ('FEE_INCOME', 'Fee income', 'INCOME'),
+    ('RECOVERY_INCOME', 'Recovery of written-off loans', 'INCOME'),
+    ('WRITE_OFF_EXPENSE', 'Loan write-off expense', 'EXPENSE')
+ON CONFLICT (code) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    event VARCHAR(20) NOT NULL CHECK (event IN ('DISBURSEMENT', 'PAYMENT', 'FEE', 'REVERSAL', 'WRITE_OFF', 'RECOVERY')),
+    reference VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL,
+    effective_date DATE NOT NULL,
+    reverses_entry_id BIGINT NULL UNIQUE, -- FK to journal_entries.id, an entry is reversed at most once
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS postings (
+    journal_entry_id BIGINT NOT NULL, -- FK to journal_entries.id
+    line_number INT NOT NULL,
+    account_code VARCHAR(50) NOT NULL REFERENCES ledger_accounts (code),
+    direction VARCHAR(10) NOT NULL CHECK (direction IN ('DEBIT', 'CREDIT')),
+    amount DECIMAL(18,2) NOT NULL CHECK (amount > 0),
+    PRIMARY KEY (journal_entry_id, line_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_journal_entries_loan_id
+ON journal_entries (loan_id, event, reference);
+
+CREATE INDEX IF NOT EXISTS idx_journal_entries_effective_date
+ON journal_entries (effective_date);
+
+CREATE INDEX IF NOT EXISTS idx_postings_account_code
+ON postings (account_code);
+
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS reversed_at TIMESTAMP NULL;
+
+-- +goose Down
+ALTER TABLE payments DROP COLUMN IF EXISTS reversed_at;
+DROP INDEX IF EXISTS idx_postings_account_code;
+DROP INDEX IF EXISTS idx_journal_entries_effective_date;
+DROP INDEX IF EXISTS idx_journal_entries_loan_id;
+DROP TABLE IF EXISTS postings;
+DROP TABLE IF EXISTS journal_entries;
+DROP TABLE IF EXISTS ledger_accounts;
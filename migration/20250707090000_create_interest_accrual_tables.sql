@@ -0,0 +1,30 @@
+-- +goose Up
+INSERT INTO ledger_accounts (code, name, type) VALUES
+    ('INTEREST_RECEIVABLE', 'Accrued interest receivable', 'ASSET')
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_event_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_event_check CHECK (event IN ('DISBURSEMENT', 'PAYMENT', 'FEE', 'REVERSAL', 'WRITE_OFF', 'RECOVERY', 'ACCRUAL', 'ACCRUAL_REVERSAL'));
+
+CREATE TABLE IF NOT EXISTS interest_accruals (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    accrual_date DATE NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('ACCRUAL', 'REVERSAL')),
+    amount DECIMAL(18,2) NOT NULL CHECK (amount >= 0),
+    journal_entry_id BIGINT NULL, -- FK to journal_entries.id, empty when nothing accrued that day
+    created_at TIMESTAMP NOT NULL,
+    UNIQUE (loan_id, accrual_date, type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_interest_accruals_accrual_date
+ON interest_accruals (accrual_date);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_interest_accruals_accrual_date;
+DROP TABLE IF EXISTS interest_accruals;
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_event_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_event_check CHECK (event IN ('DISBURSEMENT', 'PAYMENT', 'FEE', 'REVERSAL', 'WRITE_OFF', 'RECOVERY'));
+
+DELETE FROM ledger_accounts WHERE code = 'INTEREST_RECEIVABLE';
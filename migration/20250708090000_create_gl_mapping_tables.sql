@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS gl_mappings (
+    event VARCHAR(20) NOT NULL, -- journal event, or DEFAULT for every event without its own mapping
+    account_code VARCHAR(50) NOT NULL REFERENCES ledger_accounts (code),
+    gl_code VARCHAR(50) NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (event, account_code)
+);
+
+INSERT INTO gl_mappings (event, account_code, gl_code, updated_at) VALUES
+    ('DEFAULT', 'CASH', '1101', NOW()),
+    ('DEFAULT', 'PRINCIPAL_RECEIVABLE', '1301', NOW()),
+    ('DEFAULT', 'INTEREST_RECEIVABLE', '1302', NOW()),
+    ('DEFAULT', 'FEE_RECEIVABLE', '1303', NOW()),
+    ('DEFAULT', 'INTEREST_INCOME', '4101', NOW()),
+    ('DEFAULT', 'FEE_INCOME', '4102', NOW()),
+    ('DEFAULT', 'RECOVERY_INCOME', '4103', NOW()),
+    ('DEFAULT', 'WRITE_OFF_EXPENSE', '5101', NOW())
+ON CONFLICT (event, account_code) DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS gl_mappings;
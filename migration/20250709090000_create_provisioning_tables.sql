@@ -0,0 +1,38 @@
+-- +goose Up
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS product_code VARCHAR(50) NOT NULL DEFAULT 'STANDARD';
+
+CREATE TABLE IF NOT EXISTS provision_rates (
+    product_code VARCHAR(50) NOT NULL, -- loan product, or DEFAULT for every product without its own rate
+    stage INT NOT NULL CHECK (stage IN (1, 2, 3)),
+    pd DECIMAL(5,4) NOT NULL CHECK (pd BETWEEN 0 AND 1),
+    lgd DECIMAL(5,4) NOT NULL CHECK (lgd BETWEEN 0 AND 1),
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (product_code, stage)
+);
+
+INSERT INTO provision_rates (product_code, stage, pd, lgd, updated_at) VALUES
+    ('DEFAULT', 1, 0.0200, 0.4500, NOW()),
+    ('DEFAULT', 2, 0.1500, 0.4500, NOW()),
+    ('DEFAULT', 3, 1.0000, 0.6000, NOW())
+ON CONFLICT (product_code, stage) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS loan_provisions (
+    id BIGINT NOT NULL PRIMARY KEY,
+    period VARCHAR(7) NOT NULL, -- format YYYY-MM
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    customer_id BIGINT NOT NULL, -- FK to customers.id
+    product_code VARCHAR(50) NOT NULL,
+    stage INT NOT NULL CHECK (stage IN (1, 2, 3)),
+    days_past_due INT NOT NULL,
+    exposure_at_default DECIMAL(18,2) NOT NULL,
+    pd DECIMAL(5,4) NOT NULL,
+    lgd DECIMAL(5,4) NOT NULL,
+    ecl DECIMAL(18,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    UNIQUE (period, loan_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS loan_provisions;
+DROP TABLE IF EXISTS provision_rates;
+ALTER TABLE loans DROP COLUMN IF EXISTS product_code;
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS accounting_periods (
+    period VARCHAR(7) NOT NULL PRIMARY KEY, -- format YYYY-MM, months without a row are open
+    status VARCHAR(20) NOT NULL CHECK (status IN ('OPEN', 'CLOSED')),
+    closed_by VARCHAR(100) NOT NULL,
+    closed_at TIMESTAMP NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS accounting_periods;
@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS loans_status_check;
+ALTER TABLE loans ADD CONSTRAINT loans_status_check CHECK (status IN ('APPLIED', 'APPROVED', 'REJECTED', 'CANCELLED', 'DISBURSED', 'PAID', 'RESTRUCTURED', 'WRITTEN_OFF'));
+
+CREATE TABLE IF NOT EXISTS loan_status_transitions (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    from_status VARCHAR(20), -- NULL for the status the loan was created in
+    to_status VARCHAR(20) NOT NULL,
+    actor VARCHAR(100) NOT NULL,
+    reason TEXT NOT NULL,
+    transitioned_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_loan_status_transitions_loan_id
+ON loan_status_transitions (loan_id, transitioned_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_loan_status_transitions_loan_id;
+DROP TABLE IF EXISTS loan_status_transitions;
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS loans_status_check;
+ALTER TABLE loans ADD CONSTRAINT loans_status_check CHECK (status IN ('DISBURSED', 'PAID', 'RESTRUCTURED', 'WRITTEN_OFF'));
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS requested_by VARCHAR(100); -- NULL for loans booked before applications
+ALTER TABLE loans ALTER COLUMN start_date DROP NOT NULL; -- NULL until the loan is disbursed
+
+-- +goose Down
+ALTER TABLE loans ALTER COLUMN start_date SET NOT NULL;
+ALTER TABLE loans DROP COLUMN IF EXISTS requested_by;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS disbursements (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL UNIQUE, -- FK to loans.id; a loan is paid out once, retries reuse the record
+    customer_id BIGINT NOT NULL, -- FK to customers.id
+    bank_code VARCHAR(20) NOT NULL,
+    account_number VARCHAR(34) NOT NULL,
+    account_name VARCHAR(100) NOT NULL,
+    amount DECIMAL(18,2) NOT NULL, -- principal less the fees deducted
+    fee_amount DECIMAL(18,2) NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL,
+    provider_reference VARCHAR(100),
+    failure_reason VARCHAR(500),
+    attempts INT NOT NULL DEFAULT 0,
+    requested_by VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP, -- NULL until the payout completes
+    CONSTRAINT disbursements_status_check CHECK (status IN ('PENDING', 'SENT', 'FAILED', 'COMPLETED'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_disbursements_status
+ON disbursements (status);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_disbursements_status;
+DROP TABLE IF EXISTS disbursements;
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS product_fees (
+    product_code VARCHAR(50) NOT NULL, -- loan product the fee is charged on
+    fee_type VARCHAR(20) NOT NULL,
+    calculation VARCHAR(20) NOT NULL,
+    value DECIMAL(18,4) NOT NULL, -- fraction of the principal for PERCENTAGE, amount for FLAT
+    collection VARCHAR(20) NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (product_code, fee_type),
+    CONSTRAINT product_fees_fee_type_check CHECK (fee_type IN ('ORIGINATION', 'ADMIN', 'INSURANCE')),
+    CONSTRAINT product_fees_calculation_check CHECK (calculation IN ('PERCENTAGE', 'FLAT')),
+    CONSTRAINT product_fees_collection_check CHECK (collection IN ('DEDUCTED', 'AMORTISED'))
+);
+
+CREATE TABLE IF NOT EXISTS loan_fees (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    fee_type VARCHAR(20) NOT NULL,
+    calculation VARCHAR(20) NOT NULL, -- copied from product_fees when the payout is instructed
+    value DECIMAL(18,4) NOT NULL,
+    collection VARCHAR(20) NOT NULL,
+    amount DECIMAL(18,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (loan_id, fee_type) -- also serves the lookups by loan
+);
+
+ALTER TABLE installments ADD COLUMN IF NOT EXISTS fee_amount DECIMAL(18,2) NOT NULL DEFAULT 0; -- amortised fees due on top of amount_due
+
+-- +goose Down
+ALTER TABLE installments DROP COLUMN IF EXISTS fee_amount;
+DROP TABLE IF EXISTS loan_fees;
+DROP TABLE IF EXISTS product_fees;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS loan_disclosures (
+    loan_id BIGINT NOT NULL PRIMARY KEY, -- FK to loans.id
+    product_code VARCHAR(50) NOT NULL,
+    principal DECIMAL(18,2) NOT NULL,
+    annual_rate DECIMAL(5,4) NOT NULL, -- flat interest over the whole term
+    term_weeks INT NOT NULL,
+    amount_received DECIMAL(18,2) NOT NULL, -- principal less the fees deducted at disbursement
+    total_interest DECIMAL(18,2) NOT NULL,
+    total_fees DECIMAL(18,2) NOT NULL,
+    total_repayment DECIMAL(18,2) NOT NULL,
+    effective_annual_rate DECIMAL(12,6) NOT NULL, -- interest and every fee, compounded weekly
+    disclosed_at TIMESTAMP NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS loan_disclosures;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS credit_limits (
+    customer_id BIGINT NOT NULL PRIMARY KEY, -- FK to customers.id, customers without a row get the default limit
+    credit_limit DECIMAL(18,2) NOT NULL,
+    max_active_loans INT NOT NULL,
+    updated_by VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    CONSTRAINT credit_limits_credit_limit_check CHECK (credit_limit > 0),
+    CONSTRAINT credit_limits_max_active_loans_check CHECK (max_active_loans > 0)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS credit_limits;
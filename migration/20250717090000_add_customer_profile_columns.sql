@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS nik VARCHAR(16) NULL; -- national identity number
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS date_of_birth DATE NULL;
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS address VARCHAR(500) NULL;
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS kyc_status VARCHAR(20) NOT NULL DEFAULT 'UNVERIFIED'
+    CONSTRAINT customers_kyc_status_check CHECK (kyc_status IN ('UNVERIFIED', 'PENDING', 'VERIFIED', 'REJECTED'));
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP NULL; -- soft delete, deleted customers are left out of every lookup
+
+-- +goose Down
+ALTER TABLE customers DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE customers DROP COLUMN IF EXISTS kyc_status;
+ALTER TABLE customers DROP COLUMN IF EXISTS address;
+ALTER TABLE customers DROP COLUMN IF EXISTS date_of_birth;
+ALTER TABLE customers DROP COLUMN IF EXISTS nik;
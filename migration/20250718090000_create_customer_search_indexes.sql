@@ -0,0 +1,16 @@
+-- +goose Up
+-- Name prefix search, LIKE on LOWER(name) needs text_pattern_ops to use the index
+CREATE INDEX IF NOT EXISTS idx_customers_lower_name
+ON customers (LOWER(name) text_pattern_ops);
+
+-- Keyset pagination sorted by name
+CREATE INDEX IF NOT EXISTS idx_customers_name_id
+ON customers (name, id);
+
+CREATE INDEX IF NOT EXISTS idx_customers_kyc_status
+ON customers (kyc_status);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_customers_kyc_status;
+DROP INDEX IF EXISTS idx_customers_name_id;
+DROP INDEX IF EXISTS idx_customers_lower_name;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- Statements read the entries of a customer's loans over a date range
+CREATE INDEX IF NOT EXISTS idx_journal_entries_loan_id_effective_date
+ON journal_entries (loan_id, effective_date);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_journal_entries_loan_id_effective_date;
@@ -0,0 +1,73 @@
+-- +goose Up
+-- Duplicate lookups when a customer is created
+CREATE INDEX IF NOT EXISTS idx_customers_nik
+ON customers (nik);
+
+CREATE INDEX IF NOT EXISTS idx_customers_phone
+ON customers (phone);
+
+CREATE INDEX IF NOT EXISTS idx_customers_date_of_birth
+ON customers (date_of_birth);
+
+CREATE TABLE IF NOT EXISTS customer_duplicates (
+    id BIGINT NOT NULL PRIMARY KEY,
+    customer_id BIGINT NOT NULL, -- FK to customers.id, the customer created while matching
+    matched_customer_id BIGINT NOT NULL, -- FK to customers.id
+    match_type VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_by VARCHAR(100), -- NULL while OPEN
+    resolved_at TIMESTAMP,
+    CONSTRAINT customer_duplicates_match_type_check CHECK (match_type IN ('NIK', 'PHONE', 'NAME_DATE_OF_BIRTH')),
+    CONSTRAINT customer_duplicates_status_check CHECK (status IN ('OPEN', 'MERGED', 'DISMISSED'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_duplicates_status_created_at
+ON customer_duplicates (status, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_customer_duplicates_customer_id
+ON customer_duplicates (customer_id);
+
+CREATE INDEX IF NOT EXISTS idx_customer_duplicates_matched_customer_id
+ON customer_duplicates (matched_customer_id);
+
+CREATE TABLE IF NOT EXISTS customer_merges (
+    id BIGINT NOT NULL PRIMARY KEY,
+    source_customer_id BIGINT NOT NULL, -- FK to customers.id, soft deleted by the merge
+    target_customer_id BIGINT NOT NULL, -- FK to customers.id, the surviving customer
+    reason VARCHAR(500) NOT NULL,
+    merged_by VARCHAR(100) NOT NULL,
+    merged_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT customer_merges_customers_check CHECK (source_customer_id <> target_customer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_merges_source_customer_id
+ON customer_merges (source_customer_id);
+
+CREATE INDEX IF NOT EXISTS idx_customer_merges_target_customer_id
+ON customer_merges (target_customer_id);
+
+-- Every row a merge moved from the source to the target customer
+CREATE TABLE IF NOT EXISTS customer_merge_records (
+    id BIGINT NOT NULL PRIMARY KEY,
+    merge_id BIGINT NOT NULL, -- FK to customer_merges.id
+    table_name VARCHAR(50) NOT NULL,
+    record_id BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_merge_records_merge_id
+ON customer_merge_records (merge_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_customer_merge_records_merge_id;
+DROP TABLE IF EXISTS customer_merge_records;
+DROP INDEX IF EXISTS idx_customer_merges_target_customer_id;
+DROP INDEX IF EXISTS idx_customer_merges_source_customer_id;
+DROP TABLE IF EXISTS customer_merges;
+DROP INDEX IF EXISTS idx_customer_duplicates_matched_customer_id;
+DROP INDEX IF EXISTS idx_customer_duplicates_customer_id;
+DROP INDEX IF EXISTS idx_customer_duplicates_status_created_at;
+DROP TABLE IF EXISTS customer_duplicates;
+DROP INDEX IF EXISTS idx_customers_date_of_birth;
+DROP INDEX IF EXISTS idx_customers_phone;
+DROP INDEX IF EXISTS idx_customers_nik;
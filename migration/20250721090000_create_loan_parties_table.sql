@@ -0,0 +1,19 @@
+-- +goose Up
+-- Customers linked to a loan besides its borrower
+CREATE TABLE IF NOT EXISTS loan_parties (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    customer_id BIGINT NOT NULL, -- FK to customers.id
+    role VARCHAR(20) NOT NULL,
+    added_by VARCHAR(100) NOT NULL,
+    added_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (loan_id, customer_id), -- a customer takes one role on a loan
+    CONSTRAINT loan_parties_role_check CHECK (role IN ('CO_BORROWER', 'GUARANTOR'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_loan_parties_customer_id
+ON loan_parties (customer_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_loan_parties_customer_id;
+DROP TABLE IF EXISTS loan_parties;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- Collateral pledged against a loan of a secured product
+CREATE TABLE IF NOT EXISTS collaterals (
+    id BIGINT NOT NULL PRIMARY KEY,
+    loan_id BIGINT NOT NULL, -- FK to loans.id
+    type VARCHAR(20) NOT NULL,
+    description VARCHAR(500) NOT NULL, -- e.g. the plate and BPKB number, the gold weight, the deposit account
+    valuation DECIMAL(18,2) NOT NULL,
+    valuation_date DATE NOT NULL,
+    lien_status VARCHAR(20) NOT NULL,
+    registered_by VARCHAR(100) NOT NULL,
+    registered_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    lien_updated_by VARCHAR(100), -- NULL until the lien status changes
+    lien_updated_at TIMESTAMP,
+    CONSTRAINT collaterals_type_check CHECK (type IN ('VEHICLE_BPKB', 'GOLD', 'DEPOSIT')),
+    CONSTRAINT collaterals_valuation_check CHECK (valuation > 0),
+    CONSTRAINT collaterals_lien_status_check CHECK (lien_status IN ('PENDING', 'REGISTERED', 'RELEASED'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_collaterals_loan_id
+ON collaterals (loan_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_collaterals_loan_id;
+DROP TABLE IF EXISTS collaterals;
@@ -0,0 +1,59 @@
+-- +goose Up
+-- Revolving credit lines, each drawdown being a loan of its own under the line
+CREATE TABLE IF NOT EXISTS credit_lines (
+    id BIGINT NOT NULL PRIMARY KEY,
+    customer_id BIGINT NOT NULL, -- FK to customers.id
+    credit_limit DECIMAL(18,2) NOT NULL,
+    product_code VARCHAR(50) NOT NULL, -- product of the drawdowns
+    opened_by VARCHAR(100) NOT NULL,
+    opened_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credit_lines_credit_limit_check CHECK (credit_limit > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_lines_customer_id
+ON credit_lines (customer_id);
+
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS credit_line_id BIGINT NULL; -- FK to credit_lines.id, NULL for term loans
+
+CREATE INDEX IF NOT EXISTS idx_loans_credit_line_id
+ON loans (credit_line_id);
+
+-- One consolidated bill per line and monthly cycle
+CREATE TABLE IF NOT EXISTS credit_line_bills (
+    id BIGINT NOT NULL PRIMARY KEY,
+    credit_line_id BIGINT NOT NULL, -- FK to credit_lines.id
+    cycle VARCHAR(7) NOT NULL, -- YYYY-MM
+    due_date DATE NOT NULL,
+    amount DECIMAL(18,2) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    paid_at TIMESTAMP, -- NULL until PAID
+    UNIQUE (credit_line_id, cycle),
+    CONSTRAINT credit_line_bills_status_check CHECK (status IN ('OPEN', 'OVERDUE', 'PAID'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_line_bills_status_due_date
+ON credit_line_bills (status, due_date);
+
+-- The installments of the drawdowns a bill aggregates, each billed once
+CREATE TABLE IF NOT EXISTS credit_line_bill_items (
+    id BIGINT NOT NULL PRIMARY KEY,
+    bill_id BIGINT NOT NULL, -- FK to credit_line_bills.id
+    installment_id BIGINT NOT NULL UNIQUE, -- FK to installments.id
+    loan_id BIGINT NOT NULL, -- FK to loans.id, the drawdown
+    week_number INT NOT NULL,
+    amount DECIMAL(18,2) NOT NULL -- amount due and fees when billed
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_line_bill_items_bill_id
+ON credit_line_bill_items (bill_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_credit_line_bill_items_bill_id;
+DROP TABLE IF EXISTS credit_line_bill_items;
+DROP INDEX IF EXISTS idx_credit_line_bills_status_due_date;
+DROP TABLE IF EXISTS credit_line_bills;
+DROP INDEX IF EXISTS idx_loans_credit_line_id;
+ALTER TABLE loans DROP COLUMN IF EXISTS credit_line_id;
+DROP INDEX IF EXISTS idx_credit_lines_customer_id;
+DROP TABLE IF EXISTS credit_lines;
@@ -0,0 +1,95 @@
+-- +goose Up
+-- Merchant partners whose checkout creates loans, paid the sale less their
+-- merchant discount rate (MDR)
+CREATE TABLE IF NOT EXISTS merchants (
+    id BIGINT NOT NULL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    mdr_rate DECIMAL(7,4) NOT NULL, -- fraction of the sale kept, 0.0250 for 2.5%
+    bank_code VARCHAR(20) NOT NULL, -- settlement account
+    account_number VARCHAR(34) NOT NULL,
+    account_name VARCHAR(100) NOT NULL,
+    created_by VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT merchants_mdr_rate_check CHECK (mdr_rate >= 0 AND mdr_rate < 1)
+);
+
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS merchant_id BIGINT NULL; -- FK to merchants.id, NULL for loans not sold at a merchant
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS order_ref VARCHAR(100) NULL; -- the merchant's order reference
+
+-- An order is financed once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_loans_merchant_id_order_ref
+ON loans (merchant_id, order_ref);
+
+-- One settlement batch per merchant and day
+CREATE TABLE IF NOT EXISTS merchant_settlements (
+    id BIGINT NOT NULL PRIMARY KEY,
+    merchant_id BIGINT NOT NULL, -- FK to merchants.id
+    settlement_date DATE NOT NULL,
+    gross_amount DECIMAL(18,2) NOT NULL,
+    mdr_amount DECIMAL(18,2) NOT NULL,
+    net_amount DECIMAL(18,2) NOT NULL, -- owed to the merchant
+    item_count INT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    reference VARCHAR(100), -- bank transfer reference, NULL until SENT
+    failure_reason TEXT,
+    updated_by VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    settled_at TIMESTAMP, -- NULL until SETTLED
+    UNIQUE (merchant_id, settlement_date),
+    CONSTRAINT merchant_settlements_status_check CHECK (status IN ('PENDING', 'SENT', 'SETTLED', 'FAILED'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_merchant_settlements_settlement_date
+ON merchant_settlements (settlement_date);
+
+-- What is owed to the merchant for each loan paid out, batched once
+CREATE TABLE IF NOT EXISTS merchant_settlement_items (
+    id BIGINT NOT NULL PRIMARY KEY,
+    settlement_id BIGINT NULL, -- FK to merchant_settlements.id, NULL until batched
+    merchant_id BIGINT NOT NULL, -- FK to merchants.id
+    loan_id BIGINT NOT NULL UNIQUE, -- FK to loans.id
+    order_ref VARCHAR(100) NOT NULL,
+    sale_date DATE NOT NULL, -- the day the loan was disbursed
+    gross_amount DECIMAL(18,2) NOT NULL,
+    mdr_amount DECIMAL(18,2) NOT NULL,
+    net_amount DECIMAL(18,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_merchant_settlement_items_settlement_id
+ON merchant_settlement_items (settlement_id);
+
+CREATE INDEX IF NOT EXISTS idx_merchant_settlement_items_merchant_id_sale_date
+ON merchant_settlement_items (merchant_id, sale_date);
+
+INSERT INTO ledger_accounts (code, name, type) VALUES
+    ('MERCHANT_PAYABLE', 'Payable to merchants', 'LIABILITY'),
+    ('MDR_INCOME', 'Merchant discount income', 'INCOME')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO gl_mappings (event, account_code, gl_code, updated_at) VALUES
+    ('DEFAULT', 'MERCHANT_PAYABLE', '2101', NOW()),
+    ('DEFAULT', 'MDR_INCOME', '4104', NOW())
+ON CONFLICT (event, account_code) DO NOTHING;
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_event_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_event_check CHECK (event IN ('DISBURSEMENT', 'PAYMENT', 'FEE', 'REVERSAL', 'WRITE_OFF', 'RECOVERY', 'ACCRUAL', 'ACCRUAL_REVERSAL', 'SETTLEMENT'));
+
+-- +goose Down
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_event_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_event_check CHECK (event IN ('DISBURSEMENT', 'PAYMENT', 'FEE', 'REVERSAL', 'WRITE_OFF', 'RECOVERY', 'ACCRUAL', 'ACCRUAL_REVERSAL'));
+
+DELETE FROM gl_mappings WHERE account_code IN ('MERCHANT_PAYABLE', 'MDR_INCOME');
+DELETE FROM ledger_accounts WHERE code IN ('MERCHANT_PAYABLE', 'MDR_INCOME');
+
+DROP INDEX IF EXISTS idx_merchant_settlement_items_merchant_id_sale_date;
+DROP INDEX IF EXISTS idx_merchant_settlement_items_settlement_id;
+DROP TABLE IF EXISTS merchant_settlement_items;
+DROP INDEX IF EXISTS idx_merchant_settlements_settlement_date;
+DROP TABLE IF EXISTS merchant_settlements;
+DROP INDEX IF EXISTS idx_loans_merchant_id_order_ref;
+ALTER TABLE loans DROP COLUMN IF EXISTS order_ref;
+ALTER TABLE loans DROP COLUMN IF EXISTS merchant_id;
+DROP TABLE IF EXISTS merchants;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Restructure entries move the receivables of a restructured loan onto the
+-- loan carrying its new schedule, through a clearing account that nets to zero
+INSERT INTO ledger_accounts (code, name, type) VALUES
+    ('RESTRUCTURE_CLEARING', 'Loan restructure clearing', 'ASSET')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO gl_mappings (event, account_code, gl_code, updated_at) VALUES
+    ('DEFAULT', 'RESTRUCTURE_CLEARING', '1309', NOW())
+ON CONFLICT (event, account_code) DO NOTHING;
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_event_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_event_check CHECK (event IN ('DISBURSEMENT', 'PAYMENT', 'FEE', 'REVERSAL', 'WRITE_OFF', 'RECOVERY', 'ACCRUAL', 'ACCRUAL_REVERSAL', 'SETTLEMENT', 'RESTRUCTURE'));
+
+-- +goose Down
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_event_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_event_check CHECK (event IN ('DISBURSEMENT', 'PAYMENT', 'FEE', 'REVERSAL', 'WRITE_OFF', 'RECOVERY', 'ACCRUAL', 'ACCRUAL_REVERSAL', 'SETTLEMENT'));
+
+DELETE FROM gl_mappings WHERE account_code = 'RESTRUCTURE_CLEARING';
+DELETE FROM ledger_accounts WHERE code = 'RESTRUCTURE_CLEARING';
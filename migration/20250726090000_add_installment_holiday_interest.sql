@@ -0,0 +1,15 @@
+-- +goose Up
+-- Interest earned during a moratorium is recognised when the moratorium is
+-- declared and collected with the shifted installments; holiday_interest is
+-- the part of amount_due that is such interest, so payments settle it as
+-- interest rather than splitting it at the loan's flat rate
+ALTER TABLE installments ADD COLUMN IF NOT EXISTS holiday_interest DECIMAL(18,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_event_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_event_check CHECK (event IN ('DISBURSEMENT', 'PAYMENT', 'FEE', 'REVERSAL', 'WRITE_OFF', 'RECOVERY', 'ACCRUAL', 'ACCRUAL_REVERSAL', 'SETTLEMENT', 'RESTRUCTURE', 'MORATORIUM'));
+
+-- +goose Down
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_event_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_event_check CHECK (event IN ('DISBURSEMENT', 'PAYMENT', 'FEE', 'REVERSAL', 'WRITE_OFF', 'RECOVERY', 'ACCRUAL', 'ACCRUAL_REVERSAL', 'SETTLEMENT', 'RESTRUCTURE'));
+
+ALTER TABLE installments DROP COLUMN IF EXISTS holiday_interest;